@@ -0,0 +1,193 @@
+package transitdb
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// A Role is what an API key may do. Each role can do everything the
+// ones before it can.
+type Role string
+
+const (
+	// RoleRead keys may use the read-only endpoints.
+	RoleRead Role = "read"
+	// RoleWrite keys may also save offers from their Sources.
+	RoleWrite Role = "write"
+	// RoleAdmin keys may do anything, including saving offers from any
+	// source.
+	RoleAdmin Role = "admin"
+)
+
+var roleRank = map[Role]int{
+	RoleRead:  1,
+	RoleWrite: 2,
+	RoleAdmin: 3,
+}
+
+// Allows reports whether a key with role r may act as want.
+func (r Role) Allows(want Role) bool {
+	return roleRank[r] >= roleRank[want]
+}
+
+// An APIKey authorizes requests. Only a hash of the secret token is
+// stored; the token itself is shown once, when the key is created.
+type APIKey struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Role    Role     `json:"role"`
+	Sources []string `json:"sources"` // the sources a write key may save
+
+	Hash string `json:"-"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	RevokedAt time.Time `json:"revokedAt,omitempty"`
+}
+
+func (k *APIKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("missing name")
+	}
+	if _, ok := roleRank[k.Role]; !ok {
+		return fmt.Errorf("invalid role %q", k.Role)
+	}
+	if k.Role == RoleWrite && len(k.Sources) == 0 {
+		return errors.New("write keys need at least one source")
+	}
+	return nil
+}
+
+// CanWrite reports whether the key may save offers from source.
+func (k *APIKey) CanWrite(source string) bool {
+	switch k.Role {
+	case RoleAdmin:
+		return true
+	case RoleWrite:
+		for _, s := range k.Sources {
+			if s == source {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewAPIKey fills in key's Hash with that of a new random token, which
+// it returns.
+func NewAPIKey(key *APIKey) (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	key.Hash = HashAPIToken(token)
+	return token, nil
+}
+
+// HashAPIToken returns the hash of token that's stored in place of it.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type apiKeyContextKey struct{}
+
+// APIKeyFromContext returns the key that authorized a request, if the
+// handler requires keys.
+func APIKeyFromContext(ctx context.Context) (APIKey, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(APIKey)
+	return key, ok
+}
+
+// requireRole wraps a handler so that it needs a key with the given
+// role, when h.RequireAuth is set. Keys are sent as a bearer token.
+func (h *Handler) requireRole(role Role, next http.HandlerFunc) http.HandlerFunc {
+	return h.authenticate(role, false, next)
+}
+
+// requireRoleOrQueryToken is like requireRole, but also accepts the key
+// in the access_token parameter, for clients like EventSource that
+// can't set headers. Tokens in URLs leak into proxy and browser logs,
+// so it's only for the endpoints those clients need. See
+// RedactAccessTokens for the server's own logs.
+func (h *Handler) requireRoleOrQueryToken(role Role, next http.HandlerFunc) http.HandlerFunc {
+	return h.authenticate(role, true, next)
+}
+
+func (h *Handler) authenticate(role Role, queryToken bool, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !h.RequireAuth {
+			next(w, r)
+			return
+		}
+
+		var token string
+		if queryToken {
+			token = r.URL.Query().Get("access_token")
+		}
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			token = strings.TrimPrefix(auth, "Bearer ")
+		}
+		if token == "" {
+			writeError(w, ErrUnauthorized)
+			return
+		}
+
+		key, err := h.Store.LookupAPIKey(r.Context(), HashAPIToken(token))
+		if err == ErrNotFound {
+			writeError(w, ErrUnauthorized)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if !key.Role.Allows(role) {
+			writeError(w, fmt.Errorf("%w: key needs the %s role", ErrForbidden, role))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), apiKeyContextKey{}, key)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// forbiddenSource is the error for saving an offer from a source the
+// request's key may not write.
+func forbiddenSource(source string) error {
+	return fmt.Errorf("%w: key may not save offers from %q", ErrForbidden, source)
+}
+
+// canWrite reports whether the request may save offers from source.
+func canWrite(r *http.Request, source string) bool {
+	key, ok := APIKeyFromContext(r.Context())
+	if !ok {
+		return true // auth is off
+	}
+	return key.CanWrite(source)
+}
+
+// RedactAccessTokens hides access_token parameters from h, which is
+// meant to be a request logger wrapping the Handler. Only RequestURI
+// is redacted; r.URL, which the Handler reads tokens from, is not.
+func RedactAccessTokens(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if _, ok := q["access_token"]; ok {
+			q.Set("access_token", "REDACTED")
+			u := *r.URL
+			u.RawQuery = q.Encode()
+
+			r = r.WithContext(r.Context())
+			r.RequestURI = u.RequestURI()
+		}
+		h.ServeHTTP(w, r)
+	})
+}
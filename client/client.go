@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/maxhawkins/transitdb"
 )
@@ -14,6 +18,13 @@ import (
 type Client struct {
 	BaseURL    string
 	HTTPClient *http.Client
+
+	// BatchLabel, if set, labels the ingestion batch created by each
+	// SendOffers call.
+	BatchLabel string
+
+	// APIKey, if set, is sent as a bearer token.
+	APIKey string
 }
 
 func New() *Client {
@@ -22,40 +33,173 @@ func New() *Client {
 	}
 }
 
-func (c *Client) SendOffers(ctx context.Context, offers []transitdb.Offer) error {
+// SendOffers saves offers and reports what happened to each one. Line n
+// of the report is offers[n-1]. Bad offers, including ones with zero
+// cost, are reported rather than failing the call.
+func (c *Client) SendOffers(ctx context.Context, offers []transitdb.Offer) (*transitdb.IngestReport, error) {
 	buf := bytes.NewBuffer(nil)
 
 	for _, offer := range offers {
-		if offer.Cost == 0 {
-			continue
-		}
-
 		if err := json.NewEncoder(buf).Encode(offer); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	req, err := http.NewRequest("POST", c.BaseURL+"/offers", buf)
+	params := url.Values{"report": {"true"}}
+	if c.BatchLabel != "" {
+		params.Set("label", c.BatchLabel)
+	}
+
+	resp, err := c.do(ctx, "POST", "/offers?"+params.Encode(), "application/json", buf)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req = req.WithContext(ctx)
-	req.Header.Set("Content-Type", "application/json")
+	defer resp.Body.Close()
 
-	resp, err := c.HTTPClient.Do(req)
+	var report transitdb.IngestReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("transitdb reply: %s", err)
+	}
+
+	return &report, nil
+}
+
+// GetOffer returns the stored offer with the given ID.
+func (c *Client) GetOffer(ctx context.Context, id int) (*transitdb.Offer, error) {
+	resp, err := c.do(ctx, "GET", "/offers/"+strconv.Itoa(id), "", nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("send offers: %s", resp.Status)
+	var offer transitdb.Offer
+	if err := json.NewDecoder(resp.Body).Decode(&offer); err != nil {
+		return nil, fmt.Errorf("transitdb reply: %s", err)
+	}
+
+	return &offer, nil
+}
+
+// ListOffers returns the stored offers matching req. The server limits
+// the number of offers to 100 if req.Limit is zero.
+func (c *Client) ListOffers(ctx context.Context, req transitdb.ListOffersRequest) ([]transitdb.Offer, error) {
+	params := url.Values{}
+	if !req.StartDate.IsZero() {
+		params.Set("start", req.StartDate.Format("2006-01-02"))
+	}
+	if !req.EndDate.IsZero() {
+		params.Set("end", req.EndDate.Format("2006-01-02"))
+	}
+	if req.Source != "" {
+		params.Set("source", req.Source)
+	}
+	for _, code := range req.Origins {
+		params.Add("origin", code)
+	}
+	for _, code := range req.Destinations {
+		params.Add("dest", code)
+	}
+	if !req.CreatedAfter.IsZero() {
+		params.Set("created_after", req.CreatedAfter.Format(time.RFC3339))
+	}
+	if !req.CreatedBefore.IsZero() {
+		params.Set("created_before", req.CreatedBefore.Format(time.RFC3339))
+	}
+	if req.IncludeExpired {
+		params.Set("include_expired", "true")
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Offset != 0 {
+		params.Set("offset", strconv.Itoa(req.Offset))
 	}
 
-	_, err = ioutil.ReadAll(resp.Body)
+	resp, err := c.do(ctx, "GET", "/offers?"+params.Encode(), "", nil)
 	if err != nil {
-		return fmt.Errorf("transitdb reply: %s", err)
+		return nil, err
 	}
+	defer resp.Body.Close()
+
+	var offers []transitdb.Offer
+	if err := json.NewDecoder(resp.Body).Decode(&offers); err != nil {
+		return nil, fmt.Errorf("transitdb reply: %s", err)
+	}
+
+	return offers, nil
+}
 
+// DeleteOffer deletes a stored offer.
+func (c *Client) DeleteOffer(ctx context.Context, id int) error {
+	resp, err := c.do(ctx, "DELETE", "/offers/"+strconv.Itoa(id), "", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
 	return nil
 }
+
+// ExpireOffers marks a source's live offers for a route expired and
+// returns how many there were.
+func (c *Client) ExpireOffers(ctx context.Context, req transitdb.ExpireOffersRequest) (int, error) {
+	form := url.Values{
+		"source": {req.Source},
+		"origin": {req.Origin},
+		"dest":   {req.Dest},
+	}
+
+	resp, err := c.do(ctx, "POST", "/offers/expire", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var res transitdb.ExpireOffersResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return 0, fmt.Errorf("transitdb reply: %s", err)
+	}
+
+	return res.Expired, nil
+}
+
+// do sends a request to the server. Error responses are returned as
+// errors; otherwise the caller must close the response body.
+func (c *Client) do(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, decodeError(resp)
+	}
+
+	return resp, nil
+}
+
+// decodeError turns an error response into the error the server
+// reported, such as transitdb.ErrNotFound or a
+// *transitdb.ErrUnknownAirport.
+func decodeError(resp *http.Response) error {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/problem+json") {
+		return fmt.Errorf("transitdb: %s", resp.Status)
+	}
+
+	var p transitdb.Problem
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return fmt.Errorf("transitdb: %s", resp.Status)
+	}
+	return p.Err()
+}
@@ -0,0 +1,139 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/client"
+	"github.com/maxhawkins/transitdb/memstore"
+)
+
+func offer(origin, dest string, cost int) transitdb.Offer {
+	return transitdb.Offer{
+		OriginAirport:      origin,
+		DestinationAirport: dest,
+		Cost:               cost,
+		Source:             "test",
+		AvailableFrom:      transitdb.Date(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+		OfferedAt:          time.Now().Add(-time.Hour),
+		ExpiresAt:          time.Now().Add(24 * time.Hour),
+	}
+}
+
+func newClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(&transitdb.Handler{Store: memstore.New()})
+	t.Cleanup(srv.Close)
+
+	c := client.New()
+	c.BaseURL = srv.URL
+	return c
+}
+
+func TestSendOffers(t *testing.T) {
+	tests := []struct {
+		name   string
+		offers []transitdb.Offer
+
+		wantSaved, wantInvalid, wantUnknown int
+	}{
+		{
+			name:      "saved",
+			offers:    []transitdb.Offer{offer("LGB", "LAS", 100), offer("LGB", "NRT", 500)},
+			wantSaved: 2,
+		},
+		{
+			name:        "reports free offers",
+			offers:      []transitdb.Offer{offer("LGB", "LAS", 0), offer("LGB", "NRT", 500)},
+			wantSaved:   1,
+			wantInvalid: 1,
+		},
+		{
+			name:        "reports unknown airports",
+			offers:      []transitdb.Offer{offer("LGB", "XXX", 100), offer("LGB", "NRT", 500)},
+			wantSaved:   1,
+			wantUnknown: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := newClient(t).SendOffers(context.Background(), tt.offers)
+			if err != nil {
+				t.Fatalf("SendOffers: %v", err)
+			}
+			if report.Saved != tt.wantSaved || report.Invalid != tt.wantInvalid || report.UnknownAirport != tt.wantUnknown {
+				t.Errorf("report = %+v, want %d saved, %d invalid and %d unknown", report, tt.wantSaved, tt.wantInvalid, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestOffers(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+
+	if _, err := c.SendOffers(ctx, []transitdb.Offer{offer("LGB", "LAS", 100), offer("LGB", "NRT", 500)}); err != nil {
+		t.Fatalf("SendOffers: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  transitdb.ListOffersRequest
+		want []int
+	}{
+		{"all", transitdb.ListOffersRequest{}, []int{100, 500}},
+		{"dest", transitdb.ListOffersRequest{Destinations: []string{"NRT"}}, []int{500}},
+		{"origins", transitdb.ListOffersRequest{Origins: []string{"LAS", "LGB"}}, []int{100, 500}},
+		{"limit", transitdb.ListOffersRequest{Limit: 1, Offset: 1}, []int{500}},
+		{"source", transitdb.ListOffersRequest{Source: "other"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offers, err := c.ListOffers(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("ListOffers: %v", err)
+			}
+			var got []int
+			for _, o := range offers {
+				got = append(got, o.Cost)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("costs = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("costs = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	offers, err := c.ListOffers(ctx, transitdb.ListOffersRequest{Destinations: []string{"LAS"}})
+	if err != nil || len(offers) != 1 {
+		t.Fatalf("ListOffers = %v, %v", offers, err)
+	}
+	got, err := c.GetOffer(ctx, offers[0].ID)
+	if err != nil {
+		t.Fatalf("GetOffer: %v", err)
+	}
+	if got.Cost != 100 {
+		t.Errorf("GetOffer = %+v, want the LGB-LAS offer", got)
+	}
+
+	n, err := c.ExpireOffers(ctx, transitdb.ExpireOffersRequest{Source: "test", Origin: "LGB", Dest: "NRT"})
+	if err != nil || n != 1 {
+		t.Errorf("ExpireOffers = %d, %v, want 1", n, err)
+	}
+
+	if err := c.DeleteOffer(ctx, offers[0].ID); err != nil {
+		t.Fatalf("DeleteOffer: %v", err)
+	}
+	if _, err := c.GetOffer(ctx, offers[0].ID); !errors.Is(err, transitdb.ErrNotFound) {
+		t.Errorf("GetOffer of deleted offer = %v, want ErrNotFound", err)
+	}
+}
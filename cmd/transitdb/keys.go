@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/pg"
+)
+
+const keysUsage = "usage: transitdb keys create|list|revoke"
+
+func keys(dbPath string, args []string) error {
+	if len(args) < 1 {
+		return errors.New(keysUsage)
+	}
+
+	db, err := pg.Connect(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("keys create", flag.ContinueOnError)
+		var (
+			name    = fs.String("name", "", "who the key is for")
+			role    = fs.String("role", string(transitdb.RoleRead), "read, write or admin")
+			sources = fs.String("sources", "", "comma-separated sources a write key may save")
+		)
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		key := transitdb.APIKey{
+			Name: *name,
+			Role: transitdb.Role(*role),
+		}
+		if *sources != "" {
+			key.Sources = strings.Split(*sources, ",")
+		}
+		if err := key.Validate(); err != nil {
+			return err
+		}
+
+		token, err := transitdb.NewAPIKey(&key)
+		if err != nil {
+			return err
+		}
+		key, err = db.CreateAPIKey(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		// The token can't be recovered from its hash, so this is the
+		// only time it's shown.
+		fmt.Fprintf(os.Stderr, "created key %d\n", key.ID)
+		fmt.Println(token)
+		return nil
+	case "list":
+		list, err := db.ListAPIKeys(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tROLE\tSOURCES\tREVOKED")
+		for _, k := range list {
+			revoked := "-"
+			if !k.RevokedAt.IsZero() {
+				revoked = k.RevokedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", k.ID, k.Name, k.Role, strings.Join(k.Sources, ","), revoked)
+		}
+		return w.Flush()
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New("usage: transitdb keys revoke ID")
+		}
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid key id %q", args[1])
+		}
+		return db.RevokeAPIKey(ctx, id)
+	default:
+		return fmt.Errorf("unknown keys command %q", args[0])
+	}
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"math/rand"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gorilla/handlers"
@@ -15,26 +17,75 @@ import (
 	"github.com/maxhawkins/transitdb/pg"
 )
 
+const usage = `usage: transitdb [flags] [command]
+
+Commands:
+  serve                   run the HTTP server (default)
+  migrate up|down|status  manage the database schema
+  keys create|list|revoke manage API keys
+  places import FILE      load places from an OurAirports airports.csv
+
+Flags:
+`
+
 func main() {
 	var (
-		dbPath = flag.String("db", os.Getenv("DB"), "db location")
-		port   = flag.Int("port", 5030, "http port")
+		dbPath   = flag.String("db", os.Getenv("DB"), "db location")
+		port     = flag.Int("port", 5030, "http port")
+		offerKey = flag.String("offer-key", strings.Join(transitdb.DefaultOfferKey, ","), "offer fields that identify a re-reported fare")
+		auth     = flag.Bool("auth", true, "require an API key for every request; -auth=false allows anonymous reads and writes")
 	)
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	rand.Seed(time.Now().UnixNano())
 
-	db, err := pg.Open(*dbPath)
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "", "serve":
+		err = serve(*dbPath, *port, *offerKey, *auth)
+	case "migrate":
+		err = migrate(*dbPath, flag.Args()[1:])
+	case "keys":
+		err = keys(*dbPath, flag.Args()[1:])
+	case "places":
+		err = places(*dbPath, flag.Args()[1:])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
+}
+
+func serve(dbPath string, port int, offerKey string, auth bool) error {
+	key, err := transitdb.ParseOfferKey(offerKey)
+	if err != nil {
+		return err
+	}
+
+	db, err := pg.Open(dbPath)
+	if err != nil {
+		return err
+	}
 	defer db.Close()
+	db.OfferKey = key
+
+	// Post watch notifications in the background. Other instances
+	// sharing the database won't send the same ones.
+	notifier := &transitdb.Notifier{Store: db}
+	go notifier.Run(context.Background())
 
 	var handler http.Handler
-	handler = &transitdb.Handler{Store: db}
+	handler = &transitdb.Handler{Store: db, RequireAuth: auth}
 	handler = handlers.LoggingHandler(os.Stderr, handler)
+	handler = transitdb.RedactAccessTokens(handler)
 
-	addr := fmt.Sprint(":", *port)
+	addr := fmt.Sprint(":", port)
 	fmt.Fprintln(os.Stderr, "listening at", addr)
-	log.Fatal(http.ListenAndServe(addr, handler))
+	return http.ListenAndServe(addr, handler)
 }
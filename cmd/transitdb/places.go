@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/pg"
+)
+
+const placesUsage = "usage: transitdb places import [-dry-run] [-v] airports.csv"
+
+func places(dbPath string, args []string) error {
+	if len(args) < 1 {
+		return errors.New(placesUsage)
+	}
+
+	switch args[0] {
+	case "import":
+		fs := flag.NewFlagSet("places import", flag.ContinueOnError)
+		var (
+			dryRun  = fs.Bool("dry-run", false, "report changes without saving them")
+			verbose = fs.Bool("v", false, "list every change, not just the totals")
+		)
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return errors.New(placesUsage)
+		}
+		return importPlaces(dbPath, fs.Arg(0), *dryRun, *verbose)
+	default:
+		return fmt.Errorf("unknown places command %q", args[0])
+	}
+}
+
+// importPlaces upserts the places in an OurAirports airports.csv file by
+// IATA code.
+func importPlaces(dbPath, path string, dryRun, verbose bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	incoming, rowErrs, err := transitdb.ReadOurAirports(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	for _, err := range rowErrs {
+		fmt.Fprintf(os.Stderr, "%s:%v\n", path, err)
+	}
+
+	db, err := pg.Connect(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	var existing []transitdb.Place
+	for {
+		page, err := db.ListPlaces(ctx, transitdb.ListPlacesRequest{
+			IncludeInactive: true,
+			Limit:           1000,
+			Offset:          len(existing),
+		})
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 {
+			break
+		}
+		existing = append(existing, page...)
+	}
+
+	changes := transitdb.PlanPlaceImport(existing, incoming)
+
+	counts := make(map[transitdb.PlaceChangeKind]int)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	if verbose {
+		fmt.Fprintln(w, "CHANGE\tIATA\tFIELDS\tNAME")
+	}
+	for _, c := range changes {
+		if !dryRun {
+			if c.Kind == transitdb.PlaceInserted {
+				_, err = db.CreatePlace(ctx, c.New)
+			} else {
+				_, err = db.UpdatePlace(ctx, c.New)
+			}
+			if err != nil {
+				w.Flush()
+				return fmt.Errorf("%s %s: %v", c.Kind, c.New.IATACode, err)
+			}
+		}
+		counts[c.Kind]++
+
+		if verbose {
+			fields := strings.Join(c.Fields, ",")
+			if fields == "" {
+				fields = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Kind, c.New.IATACode, fields, c.New.Name)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	verb := "imported"
+	if dryRun {
+		verb = "would import"
+	}
+	fmt.Fprintf(os.Stderr, "%s %d places: %d inserted, %d updated, %d swapped, %d unchanged, %d rejected\n",
+		verb,
+		len(incoming),
+		counts[transitdb.PlaceInserted],
+		counts[transitdb.PlaceUpdated],
+		counts[transitdb.PlaceSwapped],
+		len(incoming)-len(changes),
+		len(rowErrs))
+
+	return nil
+}
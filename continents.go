@@ -0,0 +1,91 @@
+package transitdb
+
+// countriesByContinent lists the ISO 3166-1 alpha-2 countries on each
+// continent, using the two-letter continent codes from OurAirports:
+// AF (Africa), AN (Antarctica), AS (Asia), EU (Europe), NA (North
+// America), OC (Oceania) and SA (South America). Countries spanning
+// two continents are listed where OurAirports puts them, so Russia is
+// in Europe and Turkey in Asia. KS and XK are both in use for Kosovo.
+var countriesByContinent = map[string][]string{
+	"AF": {
+		"AO", "BF", "BI", "BJ", "BW", "CD", "CF", "CG", "CI", "CM",
+		"CV", "DJ", "DZ", "EG", "EH", "ER", "ET", "GA", "GH", "GM",
+		"GN", "GQ", "GW", "KE", "KM", "LR", "LS", "LY", "MA", "MG",
+		"ML", "MR", "MU", "MW", "MZ", "NA", "NE", "NG", "RE", "RW",
+		"SC", "SD", "SH", "SL", "SN", "SO", "SS", "ST", "SZ", "TD",
+		"TG", "TN", "TZ", "UG", "YT", "ZA", "ZM", "ZW",
+	},
+	"AN": {
+		"AQ", "BV", "GS", "HM", "TF",
+	},
+	"AS": {
+		"AE", "AF", "AM", "AZ", "BD", "BH", "BN", "BT", "CC", "CN",
+		"CX", "CY", "GE", "HK", "ID", "IL", "IN", "IO", "IQ", "IR",
+		"JO", "JP", "KG", "KH", "KP", "KR", "KW", "KZ", "LA", "LB",
+		"LK", "MM", "MN", "MO", "MV", "MY", "NP", "OM", "PH", "PK",
+		"PS", "QA", "SA", "SG", "SY", "TH", "TJ", "TL", "TM", "TR",
+		"TW", "UZ", "VN", "YE",
+	},
+	"EU": {
+		"AD", "AL", "AT", "AX", "BA", "BE", "BG", "BY", "CH", "CZ",
+		"DE", "DK", "EE", "ES", "FI", "FO", "FR", "GB", "GG", "GI",
+		"GR", "HR", "HU", "IE", "IM", "IS", "IT", "JE", "KS", "LI",
+		"LT", "LU", "LV", "MC", "MD", "ME", "MK", "MT", "NL", "NO",
+		"PL", "PT", "RO", "RS", "RU", "SE", "SI", "SJ", "SK", "SM",
+		"UA", "VA", "XK",
+	},
+	"NA": {
+		"AG", "AI", "AW", "BB", "BL", "BM", "BQ", "BS", "BZ", "CA",
+		"CR", "CU", "CW", "DM", "DO", "GD", "GL", "GP", "GT", "HN",
+		"HT", "JM", "KN", "KY", "LC", "MF", "MQ", "MS", "MX", "NI",
+		"PA", "PM", "PR", "SV", "SX", "TC", "TT", "US", "VC", "VG",
+		"VI",
+	},
+	"OC": {
+		"AS", "AU", "CK", "FJ", "FM", "GU", "KI", "MH", "MP", "NC",
+		"NF", "NR", "NU", "NZ", "PF", "PG", "PN", "PW", "SB", "TK",
+		"TO", "TV", "UM", "VU", "WF", "WS",
+	},
+	"SA": {
+		"AR", "BO", "BR", "CL", "CO", "EC", "FK", "GF", "GY", "PE",
+		"PY", "SR", "UY", "VE",
+	},
+}
+
+var continentOf = make(map[string]string)
+
+func init() {
+	for continent, countries := range countriesByContinent {
+		for _, country := range countries {
+			continentOf[country] = continent
+		}
+	}
+}
+
+// Continent returns the code of the continent country is on, or "" if
+// the country is unknown.
+func Continent(country string) string {
+	return continentOf[country]
+}
+
+// Countries returns the countries on each of the continents, or nil if
+// none are listed. Unknown continents have no countries.
+func Countries(continents []string) []string {
+	var countries []string
+	for _, continent := range continents {
+		countries = append(countries, countriesByContinent[continent]...)
+	}
+	return countries
+}
+
+// validCountry reports whether code is a country in
+// countriesByContinent.
+func validCountry(code string) bool {
+	return Continent(code) != ""
+}
+
+// validContinent reports whether code names a continent.
+func validContinent(code string) bool {
+	_, ok := countriesByContinent[code]
+	return ok
+}
@@ -0,0 +1,80 @@
+package transitdb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// BaseCurrency is the currency exchange rates are quoted against. Costs
+// in different currencies are compared after converting them to it.
+const BaseCurrency = "USD"
+
+// An ExchangeRate gives the number of units of Currency one unit of
+// BaseCurrency buys.
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	PerUSD    float64   `json:"perUSD"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+func (r *ExchangeRate) Validate() error {
+	if !validCurrency(r.Currency) {
+		return fmt.Errorf("invalid currency %q", r.Currency)
+	}
+	if r.PerUSD <= 0 || math.IsInf(r.PerUSD, 0) || math.IsNaN(r.PerUSD) {
+		return errors.New("invalid perUSD")
+	}
+	if r.Currency == BaseCurrency && r.PerUSD != 1 {
+		return fmt.Errorf("the %s rate is always 1", BaseCurrency)
+	}
+	return nil
+}
+
+// ExchangeRates maps currency codes to units per BaseCurrency.
+type ExchangeRates map[string]float64
+
+// NewExchangeRates indexes rates by currency. The base currency is
+// always present.
+func NewExchangeRates(rates []ExchangeRate) ExchangeRates {
+	m := ExchangeRates{BaseCurrency: 1}
+	for _, r := range rates {
+		m[r.Currency] = r.PerUSD
+	}
+	return m
+}
+
+// Convert converts amount from one currency to another, rounding to the
+// nearest unit. It reports false if either rate is unknown. An empty
+// currency means BaseCurrency.
+func (m ExchangeRates) Convert(amount int, from, to string) (int, bool) {
+	if from == "" {
+		from = BaseCurrency
+	}
+	if to == "" {
+		to = BaseCurrency
+	}
+	fromRate, ok := m[from]
+	if !ok {
+		return 0, false
+	}
+	toRate, ok := m[to]
+	if !ok {
+		return 0, false
+	}
+	return int(math.Round(float64(amount) / fromRate * toRate)), true
+}
+
+// validCurrency reports whether code looks like an ISO 4217 code.
+func validCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+package transitdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when a record asked for by ID, code or
+	// hash doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is returned when a request has a missing or
+	// invalid API key.
+	ErrUnauthorized = errors.New("missing or invalid API key")
+	// ErrForbidden is returned when an API key may not do what was
+	// asked.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict is returned when a record would clash with another,
+	// such as a place reusing an IATA code.
+	ErrConflict = errors.New("conflict")
+)
+
+// ErrUnknownAirport is returned when an offer names an airport that
+// isn't in places.
+type ErrUnknownAirport struct {
+	Code string // the IATA code
+	Role string // "origin" or "destination"
+}
+
+func (e *ErrUnknownAirport) Error() string {
+	return fmt.Sprintf("unknown %s airport %q", e.Role, e.Code)
+}
+
+// A ValidationError reports a malformed request or record.
+type ValidationError struct {
+	Msg string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Msg
+}
+
+// invalid turns err into a *ValidationError, unless it's already one of
+// the errors in this file.
+func invalid(err error) error {
+	if _, ok := problemFor(err); ok {
+		return err
+	}
+	return &ValidationError{Msg: err.Error()}
+}
+
+// A LineError reports an error on one line of a POST /offers body.
+// Lines are numbered from 1.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// CheckPage returns a *ValidationError if limit or offset is negative.
+func CheckPage(limit, offset int) error {
+	if limit < 0 {
+		return &ValidationError{Msg: "LIMIT must not be negative"}
+	}
+	if offset < 0 {
+		return &ValidationError{Msg: "OFFSET must not be negative"}
+	}
+	return nil
+}
+
+// Problem types, relative to the server's base URL.
+const (
+	ProblemNotFound       = "/problems/not-found"
+	ProblemUnknownAirport = "/problems/unknown-airport"
+	ProblemInvalid        = "/problems/invalid"
+	ProblemUnauthorized   = "/problems/unauthorized"
+	ProblemForbidden      = "/problems/forbidden"
+	ProblemConflict       = "/problems/conflict"
+	ProblemInternal       = "/problems/internal"
+)
+
+// A Problem is the body of an error response, as described in RFC 7807.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+
+	// Code and Role are set for unknown airports, and Line for errors
+	// in a POST /offers body.
+	Code string `json:"code,omitempty"`
+	Role string `json:"role,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// problemFor describes err. It reports false if err isn't one of the
+// errors in this file.
+func problemFor(err error) (Problem, bool) {
+	var p Problem
+
+	var lineErr *LineError
+	if errors.As(err, &lineErr) {
+		p.Line = lineErr.Line
+	}
+
+	var (
+		unknownAirport *ErrUnknownAirport
+		validation     *ValidationError
+	)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		p.Type, p.Status = ProblemNotFound, http.StatusNotFound
+	case errors.As(err, &unknownAirport):
+		p.Type, p.Status = ProblemUnknownAirport, http.StatusUnprocessableEntity
+		p.Code = unknownAirport.Code
+		p.Role = unknownAirport.Role
+	case errors.As(err, &validation):
+		p.Type, p.Status = ProblemInvalid, http.StatusBadRequest
+	case errors.Is(err, ErrUnauthorized):
+		p.Type, p.Status = ProblemUnauthorized, http.StatusUnauthorized
+	case errors.Is(err, ErrForbidden):
+		p.Type, p.Status = ProblemForbidden, http.StatusForbidden
+	case errors.Is(err, ErrConflict):
+		p.Type, p.Status = ProblemConflict, http.StatusConflict
+	default:
+		return Problem{Type: ProblemInternal, Status: http.StatusInternalServerError}, false
+	}
+	p.Detail = err.Error()
+
+	return p, true
+}
+
+// Err returns the error that p describes.
+func (p *Problem) Err() error {
+	var err error
+	switch p.Type {
+	case ProblemNotFound:
+		err = ErrNotFound
+	case ProblemUnknownAirport:
+		err = &ErrUnknownAirport{Code: p.Code, Role: p.Role}
+	case ProblemInvalid:
+		// Detail includes the line number, which LineError adds back.
+		msg := strings.TrimPrefix(p.Detail, fmt.Sprintf("line %d: ", p.Line))
+		err = &ValidationError{Msg: msg}
+	case ProblemUnauthorized:
+		err = ErrUnauthorized
+	case ProblemForbidden:
+		err = ErrForbidden
+	case ProblemConflict:
+		err = ErrConflict
+	default:
+		return fmt.Errorf("transitdb: %d %s: %s", p.Status, p.Title, p.Detail)
+	}
+	if p.Line != 0 {
+		err = &LineError{Line: p.Line, Err: err}
+	}
+	return err
+}
+
+// writeError replies to a request with a problem describing err.
+// Unexpected errors are logged, and their details aren't sent.
+func writeError(w http.ResponseWriter, err error) {
+	p, ok := problemFor(err)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "[error]", err)
+	}
+	p.Title = http.StatusText(p.Status)
+
+	js, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "[error]", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if p.Status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	w.Write(js)
+}
@@ -0,0 +1,104 @@
+package transitdb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// earthRadiusKm is the mean radius of the Earth.
+const earthRadiusKm = 6371.0
+
+// A Radius matches places within Km kilometers of a point, measured
+// along the surface of the Earth.
+type Radius struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Km        float64 `json:"km"`
+}
+
+// ParseRadius parses a radius from near, a "lat,lon" pair, and km. Both
+// empty means no radius, which is returned as nil.
+func ParseRadius(near, km string) (*Radius, error) {
+	if near == "" && km == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(near, ",")
+	if len(parts) != 2 {
+		return nil, errors.New("near must be lat,lon")
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return nil, errors.New("invalid latitude")
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return nil, errors.New("invalid longitude")
+	}
+	dist, err := strconv.ParseFloat(km, 64)
+	if err != nil {
+		return nil, errors.New("invalid radius")
+	}
+
+	r := &Radius{Latitude: lat, Longitude: lon, Km: dist}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Radius) Validate() error {
+	if r.Latitude < -90 || r.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if r.Longitude < -180 || r.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	if !(r.Km > 0) || math.IsInf(r.Km, 0) {
+		return fmt.Errorf("invalid radius %v km", r.Km)
+	}
+	return nil
+}
+
+// Bounds returns a box of latitudes and longitudes holding every point
+// within the radius, for cheaply ruling out places before measuring the
+// distance to them. Boxes that would cross a pole or the antimeridian
+// span every longitude instead.
+func (r *Radius) Bounds() (minLat, maxLat, minLon, maxLon float64) {
+	dLat := r.Km / earthRadiusKm * 180 / math.Pi
+	minLat, maxLat = r.Latitude-dLat, r.Latitude+dLat
+	if minLat <= -90 || maxLat >= 90 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180
+	}
+
+	dLon := math.Asin(math.Sin(r.Km/earthRadiusKm)/math.Cos(r.Latitude*math.Pi/180)) * 180 / math.Pi
+	minLon, maxLon = r.Longitude-dLon, r.Longitude+dLon
+	if minLon < -180 || maxLon > 180 {
+		return minLat, maxLat, -180, 180
+	}
+	return minLat, maxLat, minLon, maxLon
+}
+
+// Contains reports whether the point at lat and lon is within the
+// radius.
+func (r *Radius) Contains(lat, lon float64) bool {
+	minLat, maxLat, minLon, maxLon := r.Bounds()
+	if lat < minLat || lat > maxLat || lon < minLon || lon > maxLon {
+		return false
+	}
+	return GreatCircleKm(r.Latitude, r.Longitude, lat, lon) <= r.Km
+}
+
+// GreatCircleKm returns the distance between two points in kilometers
+// using the haversine formula, like great_circle_km in pg.
+func GreatCircleKm(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -0,0 +1,95 @@
+package transitdb
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRadiusBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius Radius
+		want   [4]float64 // minLat, maxLat, minLon, maxLon
+	}{
+		{
+			name:   "equator",
+			radius: Radius{Latitude: 0, Longitude: 0, Km: 111.19},
+			want:   [4]float64{-1, 1, -1, 1},
+		},
+		{
+			name:   "mid latitude widens longitudes",
+			radius: Radius{Latitude: 60, Longitude: 10, Km: 111.19},
+			want:   [4]float64{59, 61, 8, 12},
+		},
+		{
+			name:   "near a pole",
+			radius: Radius{Latitude: 89.5, Longitude: 0, Km: 111.19},
+			want:   [4]float64{88.5, 90, -180, 180},
+		},
+		{
+			name:   "across the antimeridian",
+			radius: Radius{Latitude: 0, Longitude: 179.5, Km: 111.19},
+			want:   [4]float64{-1, 1, -180, 180},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minLat, maxLat, minLon, maxLon := tt.radius.Bounds()
+			got := [4]float64{minLat, maxLat, minLon, maxLon}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-3 {
+					t.Fatalf("Bounds() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRadiusContains(t *testing.T) {
+	// Within 150 km of downtown Los Angeles.
+	r := Radius{Latitude: 34.05, Longitude: -118.24, Km: 150}
+
+	tests := []struct {
+		name     string
+		lat, lon float64
+		want     bool
+	}{
+		{"LGB", 33.8177, -118.152, true},
+		{"ONT", 34.056, -117.6012, true},
+		{"SAN", 32.7336, -117.1897, false},
+		{"LAS", 36.0801, -115.152, false},
+		{"antipode", -34.05, 61.76, false},
+	}
+	for _, tt := range tests {
+		if got := r.Contains(tt.lat, tt.lon); got != tt.want {
+			t.Errorf("Contains(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseRadius(t *testing.T) {
+	tests := []struct {
+		near, km string
+		want     *Radius
+		wantErr  bool
+	}{
+		{"", "", nil, false},
+		{"34.05,-118.24", "150", &Radius{Latitude: 34.05, Longitude: -118.24, Km: 150}, false},
+		{"34.05, -118.24", "1", &Radius{Latitude: 34.05, Longitude: -118.24, Km: 1}, false},
+		{"34.05", "150", nil, true},
+		{"34.05,-118.24", "", nil, true},
+		{"34.05,-118.24", "-1", nil, true},
+		{"91,0", "10", nil, true},
+		{"0,181", "10", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRadius(tt.near, tt.km)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseRadius(%q, %q) error = %v, want error %v", tt.near, tt.km, err, tt.wantErr)
+			continue
+		}
+		if tt.want == nil && got != nil || tt.want != nil && (got == nil || *got != *tt.want) {
+			t.Errorf("ParseRadius(%q, %q) = %+v, want %+v", tt.near, tt.km, got, tt.want)
+		}
+	}
+}
@@ -2,10 +2,16 @@ package transitdb
 
 import (
 	"bufio"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -14,93 +20,1058 @@ import (
 type Handler struct {
 	Store  Store
 	Router *mux.Router
+
+	// RequireAuth makes every endpoint require an API key with a role
+	// suited to it. Keys that write offers may only save offers from
+	// their own sources.
+	RequireAuth bool
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if h.Router == nil {
+		var (
+			read  = func(f http.HandlerFunc) http.HandlerFunc { return h.requireRole(RoleRead, f) }
+			write = func(f http.HandlerFunc) http.HandlerFunc { return h.requireRole(RoleWrite, f) }
+			admin = func(f http.HandlerFunc) http.HandlerFunc { return h.requireRole(RoleAdmin, f) }
+		)
+
 		r := mux.NewRouter()
-		r.HandleFunc("/offers", h.HandleAddOffers).Methods("POST")
-		r.HandleFunc("/quotes", h.HandleListQuotes).Methods("GET")
-		r.HandleFunc("/quotes/cheapest", h.HandleCheapestPerRoute).Methods("GET")
+		r.HandleFunc("/offers", write(h.HandleAddOffers)).Methods("POST")
+		r.HandleFunc("/offers", read(h.HandleListOffers)).Methods("GET")
+		r.HandleFunc("/offers/stream", h.requireRoleOrQueryToken(RoleRead, h.HandleStreamOffers)).Methods("GET")
+		r.HandleFunc("/offers/expire", write(h.HandleExpireOffers)).Methods("POST")
+		r.HandleFunc("/offers/{id:[0-9]+}", read(h.HandleGetOffer)).Methods("GET")
+		r.HandleFunc("/offers/{id:[0-9]+}", write(h.HandleDeleteOffer)).Methods("DELETE")
+		r.HandleFunc("/quotes", read(h.HandleListQuotes)).Methods("GET")
+		r.HandleFunc("/quotes/cheapest", read(h.HandleCheapestPerRoute)).Methods("GET")
+		r.HandleFunc("/trips/roundtrip", read(h.HandleListRoundTrips)).Methods("GET")
+		r.HandleFunc("/itineraries", read(h.HandleListItineraries)).Methods("GET")
+		r.HandleFunc("/calendar", read(h.HandlePriceCalendar)).Methods("GET")
+		r.HandleFunc("/routes/{origin}/{dest}/history", read(h.HandlePriceHistory)).Methods("GET")
+		r.HandleFunc("/places", read(h.HandleListPlaces)).Methods("GET")
+		r.HandleFunc("/places", admin(h.HandleCreatePlace)).Methods("POST")
+		r.HandleFunc("/places/{id:[0-9]+}", read(h.HandleGetPlace)).Methods("GET")
+		r.HandleFunc("/places/{id:[0-9]+}", admin(h.HandleUpdatePlace)).Methods("PUT")
+		r.HandleFunc("/places/{id:[0-9]+}", admin(h.HandleDeactivatePlace)).Methods("DELETE")
+		r.HandleFunc("/place-groups", read(h.HandleListPlaceGroups)).Methods("GET")
+		r.HandleFunc("/place-groups/{code}", read(h.HandleGetPlaceGroup)).Methods("GET")
+		r.HandleFunc("/place-groups/{code}", admin(h.HandleSavePlaceGroup)).Methods("PUT")
+		r.HandleFunc("/place-groups/{code}", admin(h.HandleDeletePlaceGroup)).Methods("DELETE")
+		r.HandleFunc("/admin/exchange-rates", admin(h.HandleSaveExchangeRates)).Methods("POST")
+		r.HandleFunc("/admin/exchange-rates", read(h.HandleListExchangeRates)).Methods("GET")
+		r.HandleFunc("/batches", read(h.HandleListBatches)).Methods("GET")
+		r.HandleFunc("/batches/{id:[0-9]+}", admin(h.HandleDeleteBatch)).Methods("DELETE")
+		r.HandleFunc("/watches", admin(h.HandleCreateWatch)).Methods("POST")
+		r.HandleFunc("/watches", read(h.HandleListWatches)).Methods("GET")
+		r.HandleFunc("/watches/deliveries", read(h.HandleListDeliveries)).Methods("GET")
+		r.HandleFunc("/watches/{id:[0-9]+}", admin(h.HandleDeleteWatch)).Methods("DELETE")
 		h.Router = r
 	}
 
 	h.Router.ServeHTTP(w, r)
 }
 
+// offerBatchSize is the number of offers HandleAddOffers hands to
+// Store.SaveOffers at a time.
+const offerBatchSize = 1000
+
+// HandleAddOffers saves offers from a body with one JSON offer per
+// line. By default it stops at the first bad line or unknown airport,
+// after saving the offers before it; if those can't be saved, it
+// replies with that error instead. A line is bad if it's malformed or
+// its currency has no exchange rate. With atomic=true the whole body is
+// saved in one transaction, so a bad line or unknown airport saves
+// nothing. With report=true it processes every line and replies with an
+// IngestReport.
 func (h *Handler) HandleAddOffers(w http.ResponseWriter, r *http.Request) {
-	var saved int
+	query := r.URL.Query()
+	report, _ := strconv.ParseBool(query.Get("report"))
+	atomic, _ := strconv.ParseBool(query.Get("atomic"))
+	if report && atomic {
+		writeError(w, &ValidationError{Msg: "'report' and 'atomic' can't be combined"})
+		return
+	}
+
+	label := query.Get("label")
+	if len(label) > maxBatchLabel {
+		writeError(w, &ValidationError{Msg: "invalid 'label'"})
+		return
+	}
+	ingest, err := h.Store.CreateBatch(r.Context(), Batch{Label: label})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	sources := make(map[string]bool)
+	defer func() {
+		h.finishBatch(ingest, sources)
+	}()
+
+	if report {
+		h.addOffersWithReport(w, r, &ingest, sources)
+		return
+	}
+
+	saveOffers := h.Store.SaveOffers
+	var tx Tx
+	if atomic {
+		tx, err = h.Store.Begin(r.Context())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		defer tx.Rollback()
+		saveOffers = tx.SaveOffers
+	}
+
+	var (
+		saved      int
+		counts     = make(map[SaveResult]int)
+		batch      []Offer
+		airports   = airportChecker{Store: h.Store}
+		currencies = currencyChecker{Store: h.Store}
+		committed  bool
+	)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := saveOffers(r.Context(), batch)
+		if err != nil {
+			return err
+		}
+		for _, res := range results {
+			counts[res]++
+		}
+		saved += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	// The batch's counts only include offers that were saved for good.
+	defer func() {
+		if tx == nil || committed {
+			ingest.Inserted = counts[OfferInserted]
+			ingest.Updated = counts[OfferUpdated]
+			ingest.Unchanged = counts[OfferUnchanged]
+		}
+	}()
+
+	// reject ends the request at a bad line. Outside a transaction the
+	// lines before it are saved first, and a failure to save them is
+	// reported in place of the bad line, so the client knows they
+	// weren't.
+	reject := func(err error) {
+		if !atomic {
+			if flushErr := flush(); flushErr != nil {
+				err = flushErr
+			}
+		}
+		writeError(w, err)
+	}
 
 	scanner := bufio.NewScanner(r.Body)
 	for line := 1; scanner.Scan(); line++ {
+		ingest.Lines++
+
 		var offer Offer
 		if err := json.Unmarshal(scanner.Bytes(), &offer); err != nil {
-			msg := fmt.Sprintf("line %d: bad json", line)
-			http.Error(w, msg, http.StatusBadRequest)
+			reject(&LineError{Line: line, Err: &ValidationError{Msg: "bad json"}})
 			return
 		}
 
 		if err := offer.Validate(); err != nil {
-			msg := fmt.Sprintf("line %d: %v", line, err)
-			http.Error(w, msg, http.StatusBadRequest)
+			reject(&LineError{Line: line, Err: invalid(err)})
 			return
 		}
+		if !canWrite(r, offer.Source) {
+			reject(&LineError{Line: line, Err: forbiddenSource(offer.Source)})
+			return
+		}
+		offer.BatchID = ingest.ID
+		sources[offer.Source] = true
 
-		if err := h.Store.SaveOffer(r.Context(), offer); err != nil {
-			fmt.Fprintln(os.Stderr, "[error]", err)
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		// Check airports and currencies before saving so that one
+		// unknown code doesn't fail the offers batched with it.
+		unknown, err := airports.check(r.Context(), offer)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if unknown != nil {
+			reject(&LineError{Line: line, Err: unknown})
+			return
+		}
+		noRate, err := currencies.check(r.Context(), offer)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if noRate != nil {
+			reject(&LineError{Line: line, Err: noRate})
 			return
 		}
 
-		saved++
+		batch = append(batch, offer)
+		if len(batch) < offerBatchSize {
+			continue
+		}
+		if err := flush(); err != nil {
+			writeError(w, err)
+			return
+		}
 	}
 	if err := scanner.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := flush(); err != nil {
+		writeError(w, err)
+		return
+	}
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			writeError(w, err)
+			return
+		}
+		committed = true
+	}
+
+	fmt.Fprintf(w, "saved %d records (%d inserted, %d updated, %d unchanged) in batch %d\n",
+		saved, counts[OfferInserted], counts[OfferUpdated], counts[OfferUnchanged], ingest.ID)
+}
+
+// finishBatch saves the counts of an ingestion batch. It runs after the
+// request may have been canceled, so it doesn't use the request's
+// context.
+func (h *Handler) finishBatch(b Batch, sources map[string]bool) {
+	b.Sources = nil
+	for source := range sources {
+		b.Sources = append(b.Sources, source)
+	}
+	sort.Strings(b.Sources)
+
+	if err := h.Store.UpdateBatch(context.Background(), b); err != nil {
 		fmt.Fprintln(os.Stderr, "[error]", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+// airportChecker looks up the airports offers name, remembering the
+// answers for the rest of the request.
+type airportChecker struct {
+	Store Store
+	known map[string]bool
+}
+
+// check returns the first of o's airports that isn't in the store, or
+// nil if both are.
+func (a *airportChecker) check(ctx context.Context, o Offer) (*ErrUnknownAirport, error) {
+	if a.known == nil {
+		a.known = make(map[string]bool)
+	}
+
+	for _, airport := range []struct{ role, code string }{
+		{"origin", o.OriginAirport},
+		{"destination", o.DestinationAirport},
+	} {
+		ok, seen := a.known[airport.code]
+		if !seen {
+			_, err := a.Store.AirportIDByIATA(ctx, airport.code)
+			if err != nil && err != ErrNotFound {
+				return nil, err
+			}
+			ok = err == nil
+			a.known[airport.code] = ok
+		}
+		if !ok {
+			return &ErrUnknownAirport{Code: airport.code, Role: airport.role}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// currencyChecker finds offers in currencies without an exchange rate,
+// which the store won't save. It loads the rates once per request.
+type currencyChecker struct {
+	Store Store
+	rates map[string]bool
+}
+
+// check returns a *ValidationError if o's currency has no rate.
+func (c *currencyChecker) check(ctx context.Context, o Offer) (*ValidationError, error) {
+	if c.rates == nil {
+		rates, err := c.Store.ListExchangeRates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.rates = make(map[string]bool)
+		for _, rate := range rates {
+			c.rates[rate.Currency] = true
+		}
+	}
+
+	currency := o.Currency
+	if currency == "" {
+		currency = BaseCurrency
+	}
+	if !c.rates[currency] {
+		return &ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", currency)}, nil
+	}
+	return nil, nil
+}
+
+func (h *Handler) addOffersWithReport(w http.ResponseWriter, r *http.Request, ingest *Batch, sources map[string]bool) {
+	var (
+		report = IngestReport{BatchID: ingest.ID}
+		batch  []Offer
+		lines  []int // the line number of each offer in batch
+	)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := h.Store.SaveOffers(r.Context(), batch)
+		if err != nil {
+			return err
+		}
+		for i, res := range results {
+			report.add(LineReport{Line: lines[i], Status: LineSaved, Result: res.String()})
+		}
+		batch = batch[:0]
+		lines = lines[:0]
+		return nil
+	}
+
+	// Check airports and currencies before saving so that one unknown
+	// code doesn't fail the whole batch.
+	airports := airportChecker{Store: h.Store}
+	currencies := currencyChecker{Store: h.Store}
+
+	// The batch's counts only include offers that were saved.
+	defer func() {
+		ingest.Inserted = report.Inserted
+		ingest.Updated = report.Updated
+		ingest.Unchanged = report.Unchanged
+	}()
+
+	scanner := bufio.NewScanner(r.Body)
+	for line := 1; scanner.Scan(); line++ {
+		ingest.Lines++
+
+		var offer Offer
+		if err := json.Unmarshal(scanner.Bytes(), &offer); err != nil {
+			report.add(LineReport{Line: line, Status: LineInvalid, Error: "bad json"})
+			continue
+		}
+
+		if err := offer.Validate(); err != nil {
+			report.add(LineReport{Line: line, Status: LineInvalid, Error: err.Error()})
+			continue
+		}
+		if !canWrite(r, offer.Source) {
+			report.add(LineReport{
+				Line:   line,
+				Status: LineForbidden,
+				Error:  forbiddenSource(offer.Source).Error(),
+			})
+			continue
+		}
+
+		unknown, err := airports.check(r.Context(), offer)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if unknown != nil {
+			report.add(LineReport{Line: line, Status: LineUnknownAirport, Error: unknown.Error()})
+			continue
+		}
+		noRate, err := currencies.check(r.Context(), offer)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if noRate != nil {
+			report.add(LineReport{Line: line, Status: LineInvalid, Error: noRate.Error()})
+			continue
+		}
+
+		offer.BatchID = ingest.ID
+		sources[offer.Source] = true
+
+		batch = append(batch, offer)
+		lines = append(lines, line)
+		if len(batch) < offerBatchSize {
+			continue
+		}
+		if err := flush(); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := flush(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	sort.Slice(report.Lines, func(i, j int) bool {
+		return report.Lines[i].Line < report.Lines[j].Line
+	})
+
+	writeJSON(w, http.StatusOK, report)
+}
+
+func (h *Handler) HandleListOffers(w http.ResponseWriter, r *http.Request) {
+	var query ListOffersRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.ListOffers(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleGetOffer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	res, err := h.Store.GetOffer(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleDeleteOffer retracts an offer. Keys may only delete offers from
+// sources they can write.
+func (h *Handler) HandleDeleteOffer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	offer, err := h.Store.GetOffer(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if !canWrite(r, offer.Source) {
+		writeError(w, forbiddenSource(offer.Source))
+		return
+	}
+
+	if err := h.Store.DeleteOffer(r.Context(), id); err != nil {
+		writeError(w, err)
 		return
 	}
 
-	fmt.Fprintf(w, "saved %d records\n", saved)
+	w.WriteHeader(http.StatusNoContent)
 }
 
+// HandleExpireOffers lets a source retract its offers for a route, for
+// example when a scrape finds the route is no longer sold.
+func (h *Handler) HandleExpireOffers(w http.ResponseWriter, r *http.Request) {
+	var query ExpireOffersRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+	if !canWrite(r, query.Source) {
+		writeError(w, forbiddenSource(query.Source))
+		return
+	}
+
+	expired, err := h.Store.ExpireOffers(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ExpireOffersResult{Expired: expired})
+}
+
+// streamKeepalive is how often HandleStreamOffers writes a comment to
+// keep idle connections from being closed by proxies.
+const streamKeepalive = 30 * time.Second
+
+// HandleStreamOffers sends newly saved offers to the client as
+// Server-Sent Events until it disconnects.
+func (h *Handler) HandleStreamOffers(w http.ResponseWriter, r *http.Request) {
+	var query StreamOffersRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	var err error
+	query.Origins, err = ExpandPlaceCodes(r.Context(), h.Store, query.Origins)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	query.Destinations, err = ExpandPlaceCodes(r.Context(), h.Store, query.Destinations)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, errors.New("streaming unsupported"))
+		return
+	}
+
+	events, err := h.Store.SubscribeOffers(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepalive := time.NewTicker(streamKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if !query.Match(e) {
+				continue
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "[error]", err)
+				return
+			}
+			fmt.Fprintf(w, "event: offer\nid: %d\ndata: %s\n\n", e.ID, data)
+		case <-keepalive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		}
+		flusher.Flush()
+	}
+}
+
+// HandleCheapestPerRoute lists the cheapest fare on each route, as CSV
+// by default or as JSON with format=json.
 func (h *Handler) HandleCheapestPerRoute(w http.ResponseWriter, r *http.Request) {
-	startDate := time.Now()
-	endDate := time.Now().Add(24 * time.Hour * 30)
+	var query CheapestPerRouteRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+	format, err := formatParam(r, "csv", "json")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
 
-	resp, err := h.Store.CheapestPerRoute(r.Context(), startDate, endDate)
+	res, err := h.Store.CheapestPerRoute(r.Context(), query)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "[error]", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+
+	if format == "json" {
+		writeJSON(w, http.StatusOK, res)
 		return
 	}
 
-	for _, r := range resp {
-		fmt.Fprintf(w, "%s,%s,%d\n", r.Origin, r.Dest, r.Cost)
+	w.Header().Set("Content-Type", "text/csv")
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"origin", "dest", "cost", "currency", "date", "offer_id", "source"})
+	for _, q := range res {
+		cw.Write([]string{
+			q.OriginIATA,
+			q.DestIATA,
+			strconv.Itoa(q.Cost),
+			q.Currency,
+			time.Time(q.Date).Format("2006-01-02"),
+			strconv.Itoa(q.OfferID),
+			q.Source,
+		})
 	}
+	cw.Flush()
 }
 
 func (h *Handler) HandleListQuotes(w http.ResponseWriter, r *http.Request) {
 	var query ListQuotesRequest
 	if err := query.FromHTTP(r); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, invalid(err))
 		return
 	}
 
-	fmt.Println(query)
-
 	res, err := h.Store.ListQuotes(r.Context(), query)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "[error]", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
-	data, err := json.MarshalIndent(res, "", "\t")
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleListRoundTrips(w http.ResponseWriter, r *http.Request) {
+	var query RoundTripRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.ListRoundTrips(r.Context(), query)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "[error]", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleListItineraries(w http.ResponseWriter, r *http.Request) {
+	var query ItineraryRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	var err error
+	query.Origins, err = ExpandPlaceCodes(r.Context(), h.Store, query.Origins)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	query.Destinations, err = ExpandPlaceCodes(r.Context(), h.Store, query.Destinations)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	offers, err := LoadItineraryOffers(r.Context(), h.Store, query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	rates, err := h.Store.ListExchangeRates(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := FindItineraries(offers, NewExchangeRates(rates), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandlePriceCalendar(w http.ResponseWriter, r *http.Request) {
+	var query PriceCalendarRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+	if _, err := formatParam(r, "json"); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.Store.PriceCalendar(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandlePriceHistory lists a route's observed prices, as JSON by
+// default or as CSV with format=csv.
+func (h *Handler) HandlePriceHistory(w http.ResponseWriter, r *http.Request) {
+	var query PriceHistoryRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+	format, err := formatParam(r, "csv", "json")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.Store.PriceHistory(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"observed_at", "source", "date", "cost", "currency", "offer_id"})
+		for _, p := range res {
+			cw.Write([]string{
+				p.ObservedAt.Format(time.RFC3339),
+				p.Source,
+				time.Time(p.Date).Format("2006-01-02"),
+				strconv.Itoa(p.Cost),
+				p.Currency,
+				strconv.Itoa(p.OfferID),
+			})
+		}
+		cw.Flush()
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleListPlaces(w http.ResponseWriter, r *http.Request) {
+	var query ListPlacesRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.ListPlaces(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleGetPlace(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	res, err := h.Store.GetPlace(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// readPlace decodes a place from a request body over the fields
+// already in place, and validates the result.
+func readPlace(r *http.Request, place *Place) error {
+	if err := json.NewDecoder(r.Body).Decode(place); err != nil {
+		return &ValidationError{Msg: "bad json"}
+	}
+	place.IATACode = strings.ToUpper(place.IATACode)
+	place.Country = strings.ToUpper(place.Country)
+	if err := place.Validate(); err != nil {
+		return invalid(err)
+	}
+	return nil
+}
+
+func (h *Handler) HandleCreatePlace(w http.ResponseWriter, r *http.Request) {
+	var place Place
+	if err := readPlace(r, &place); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.Store.CreatePlace(r.Context(), place)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, res)
+}
+
+// HandleUpdatePlace changes the fields of a place given in the body.
+// Setting active to true brings back a deactivated place.
+func (h *Handler) HandleUpdatePlace(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	place, err := h.Store.GetPlace(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := readPlace(r, &place); err != nil {
+		writeError(w, err)
+		return
+	}
+	place.ID = id
+
+	res, err := h.Store.UpdatePlace(r.Context(), place)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleDeactivatePlace stops new offers from naming a place. Its
+// existing offers are kept.
+func (h *Handler) HandleDeactivatePlace(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	if err := h.Store.DeactivatePlace(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleListPlaceGroups(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Store.ListPlaceGroups(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleGetPlaceGroup(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Store.GetPlaceGroup(r.Context(), mux.Vars(r)["code"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleSavePlaceGroup creates the group named in the URL, or replaces
+// it if it exists.
+func (h *Handler) HandleSavePlaceGroup(w http.ResponseWriter, r *http.Request) {
+	var group PlaceGroup
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		writeError(w, &ValidationError{Msg: "bad json"})
+		return
+	}
+	group.Code = mux.Vars(r)["code"]
+	for i, code := range group.Members {
+		group.Members[i] = strings.ToUpper(code)
+	}
+	if err := group.Validate(); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.SavePlaceGroup(r.Context(), group)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleDeletePlaceGroup(w http.ResponseWriter, r *http.Request) {
+	if err := h.Store.DeletePlaceGroup(r.Context(), mux.Vars(r)["code"]); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleSaveExchangeRates loads a JSON array of exchange rates,
+// replacing the stored rates for those currencies.
+func (h *Handler) HandleSaveExchangeRates(w http.ResponseWriter, r *http.Request) {
+	var rates []ExchangeRate
+	if err := json.NewDecoder(r.Body).Decode(&rates); err != nil {
+		writeError(w, &ValidationError{Msg: "bad json"})
+		return
+	}
+	for i := range rates {
+		if err := rates[i].Validate(); err != nil {
+			writeError(w, &ValidationError{Msg: fmt.Sprintf("rate %d: %v", i, err)})
+			return
+		}
+	}
+
+	if err := h.Store.SaveExchangeRates(r.Context(), rates); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	fmt.Fprintf(w, "saved %d rates\n", len(rates))
+}
+
+func (h *Handler) HandleListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Store.ListExchangeRates(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleListBatches(w http.ResponseWriter, r *http.Request) {
+	var query ListBatchesRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.ListBatches(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleDeleteBatch removes the offers an ingestion batch saved, for
+// cleaning up after a bad scrape.
+func (h *Handler) HandleDeleteBatch(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	deleted, err := h.Store.DeleteBatch(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	fmt.Fprintf(w, "deleted %d offers\n", deleted)
+}
+
+func (h *Handler) HandleCreateWatch(w http.ResponseWriter, r *http.Request) {
+	var watch Watch
+	if err := json.NewDecoder(r.Body).Decode(&watch); err != nil {
+		writeError(w, &ValidationError{Msg: "bad json"})
+		return
+	}
+	watch.Currency = strings.ToUpper(watch.Currency)
+	if watch.Currency == "" {
+		watch.Currency = BaseCurrency
+	}
+	if err := watch.Validate(); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.CreateWatch(r.Context(), watch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, res)
+}
+
+func (h *Handler) HandleListWatches(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Store.ListWatches(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) HandleDeleteWatch(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, &ValidationError{Msg: "invalid id"})
+		return
+	}
+
+	err = h.Store.DeleteWatch(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListDeliveries lists queued and past webhook deliveries. With
+// status=dead it serves as the dead-letter log.
+func (h *Handler) HandleListDeliveries(w http.ResponseWriter, r *http.Request) {
+	var query ListDeliveriesRequest
+	if err := query.FromHTTP(r); err != nil {
+		writeError(w, invalid(err))
+		return
+	}
+
+	res, err := h.Store.ListDeliveries(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// writeJSON replies to a request with v as indented JSON.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		writeError(w, err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
 	w.Write(data)
 }
+
+// formatParam returns the 'format' form value, which must be empty or
+// one of formats.
+func formatParam(r *http.Request, formats ...string) (string, error) {
+	format := r.FormValue("format")
+	if format == "" {
+		return "", nil
+	}
+	for _, f := range formats {
+		if format == f {
+			return format, nil
+		}
+	}
+	return "", &ValidationError{Msg: "invalid 'format'"}
+}
@@ -0,0 +1,294 @@
+package transitdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/memstore"
+)
+
+// offerLine returns a line of a POST /offers body with an offer from
+// origin to dest.
+func offerLine(t *testing.T, origin, dest string, cost int) string {
+	t.Helper()
+
+	js, err := json.Marshal(transitdb.Offer{
+		OriginAirport:      origin,
+		DestinationAirport: dest,
+		Cost:               cost,
+		Source:             "test",
+		AvailableFrom:      transitdb.Date(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+		OfferedAt:          time.Now().Add(-time.Hour),
+		ExpiresAt:          time.Now().Add(24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(js) + "\n"
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   func(*testing.T) string
+
+		wantStatus int
+		wantBody   string // a substring of the reply
+	}{
+		{
+			name:   "add offers",
+			method: "POST",
+			path:   "/offers",
+			body: func(t *testing.T) string {
+				return offerLine(t, "LGB", "LAS", 100) + offerLine(t, "LGB", "NRT", 500)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "saved 2 records (2 inserted, 0 updated, 0 unchanged)",
+		},
+		{
+			name:   "add offers with bad json",
+			method: "POST",
+			path:   "/offers",
+			body: func(t *testing.T) string {
+				return offerLine(t, "LGB", "LAS", 100) + "{\n"
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "line 2: bad json",
+		},
+		{
+			name:   "add offers with report",
+			method: "POST",
+			path:   "/offers?report=true",
+			body: func(t *testing.T) string {
+				return offerLine(t, "LGB", "XXX", 100) + offerLine(t, "LGB", "NRT", 500)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `"unknownAirport": 1`,
+		},
+		{
+			name:   "add offers in a currency without a rate",
+			method: "POST",
+			path:   "/offers?report=true",
+			body: func(t *testing.T) string {
+				return strings.Replace(offerLine(t, "LGB", "NRT", 500), `"cost":500`, `"cost":500,"currency":"JPY"`, 1) +
+					offerLine(t, "LGB", "LAS", 100)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `no exchange rate for \"JPY\"`,
+		},
+		{
+			name:       "list quotes",
+			method:     "GET",
+			path:       "/quotes?start=2030-01-01&end=2030-12-31&origin=LGB",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "list quotes without dates",
+			method:     "GET",
+			path:       "/quotes?origin=LGB",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid 'start'",
+		},
+		{
+			name:       "list quotes with unknown continent",
+			method:     "GET",
+			path:       "/quotes?start=2030-01-01&end=2030-12-31&dest_continent=XX",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid 'dest_continent'",
+		},
+		{
+			name:       "list quotes with unknown country",
+			method:     "GET",
+			path:       "/quotes?start=2030-01-01&end=2030-12-31&exclude_origin_country=USA",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid 'exclude_origin_country'",
+		},
+		{
+			name:       "calendar in an unknown format",
+			method:     "GET",
+			path:       "/calendar?start=2030-01-01&end=2030-01-31&origin=LGB&dest=LAS&format=csv",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid 'format'",
+		},
+		{
+			name:       "price history as CSV",
+			method:     "GET",
+			path:       "/routes/LGB/LAS/history?start=2030-01-01&end=2030-01-31&format=csv",
+			wantStatus: http.StatusOK,
+			wantBody:   "observed_at,source",
+		},
+		{
+			name:       "missing offer",
+			method:     "GET",
+			path:       "/offers/12345",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "metro group",
+			method:     "GET",
+			path:       "/place-groups/NYC",
+			wantStatus: http.StatusOK,
+			wantBody:   `"LGA"`,
+		},
+		{
+			name:   "invalid place group",
+			method: "PUT",
+			path:   "/place-groups/Bad_Code",
+			body: func(*testing.T) string {
+				return `{"name": "Bad", "members": ["LGB"]}`
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &transitdb.Handler{Store: memstore.New()}
+
+			var body string
+			if tt.body != nil {
+				body = tt.body(t)
+			}
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %q", rec.Body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandlerAuth(t *testing.T) {
+	ctx := context.Background()
+	store := memstore.New()
+
+	tokens := make(map[transitdb.Role]string)
+	for _, role := range []transitdb.Role{transitdb.RoleRead, transitdb.RoleWrite} {
+		key := transitdb.APIKey{Name: string(role), Role: role, Sources: []string{"test"}}
+		token, err := transitdb.NewAPIKey(&key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.CreateAPIKey(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+		tokens[role] = token
+	}
+
+	h := &transitdb.Handler{Store: store, RequireAuth: true}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+
+		wantStatus int
+	}{
+		{"anonymous", "GET", "/batches", "", http.StatusUnauthorized},
+		{"bad token", "GET", "/batches", "nope", http.StatusUnauthorized},
+		{"read", "GET", "/batches", tokens[transitdb.RoleRead], http.StatusOK},
+		{"read can't write", "POST", "/offers", tokens[transitdb.RoleRead], http.StatusForbidden},
+		{"write", "POST", "/offers", tokens[transitdb.RoleWrite], http.StatusOK},
+		{"write can't administer", "DELETE", "/watches/1", tokens[transitdb.RoleWrite], http.StatusForbidden},
+		{"query token", "GET", "/batches?access_token=" + tokens[transitdb.RoleRead], "", http.StatusUnauthorized},
+		{"query token on write", "POST", "/offers?access_token=" + tokens[transitdb.RoleWrite], "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(offerLine(t, "LGB", "LAS", 100)))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestHandlerAddOffersUnknownAirport(t *testing.T) {
+	store := memstore.New()
+	h := &transitdb.Handler{Store: store}
+
+	body := offerLine(t, "LGB", "LAS", 100) + offerLine(t, "LGB", "XXX", 200) + offerLine(t, "LGB", "NRT", 300)
+	req := httptest.NewRequest("POST", "/offers", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), `"line": 2`) {
+		t.Errorf("reply = %d %s, want a 422 for line 2", rec.Code, rec.Body)
+	}
+
+	// The line before the unknown airport is saved; the one after
+	// isn't.
+	quotes, err := store.ListQuotes(context.Background(), transitdb.ListQuotesRequest{
+		StartDate: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC),
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("ListQuotes: %v", err)
+	}
+	if len(quotes) != 1 || quotes[0].Cost != 100 {
+		t.Errorf("saved quotes = %+v, want only LGB-LAS", quotes)
+	}
+}
+
+func TestHandlerStreamQueryToken(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := memstore.New()
+	key := transitdb.APIKey{Name: "browser", Role: transitdb.RoleRead}
+	token, err := transitdb.NewAPIKey(&key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.CreateAPIKey(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+
+	// The token still reaches the Handler, but not the log.
+	logged := make(chan string, 1)
+	inner := &transitdb.Handler{Store: store, RequireAuth: true}
+	logger := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logged <- r.RequestURI
+		inner.ServeHTTP(w, r)
+	})
+
+	srv := httptest.NewServer(transitdb.RedactAccessTokens(logger))
+	defer srv.Close()
+
+	req, err := http.NewRequest("GET", srv.URL+"/offers/stream?access_token="+token, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := srv.Client().Do(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("GET /offers/stream: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if uri := <-logged; strings.Contains(uri, token) {
+		t.Errorf("logged request URI %q has the token", uri)
+	}
+}
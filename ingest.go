@@ -0,0 +1,113 @@
+package transitdb
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// LineStatus is the outcome of one line of a POST /offers body.
+type LineStatus string
+
+const (
+	// LineSaved lines were saved. The line's Result says how.
+	LineSaved LineStatus = "saved"
+	// LineInvalid lines weren't JSON, failed Offer.Validate or were in
+	// a currency without an exchange rate.
+	LineInvalid LineStatus = "invalid"
+	// LineUnknownAirport lines named an airport that isn't in places.
+	LineUnknownAirport LineStatus = "unknown_airport"
+	// LineForbidden lines had a source the API key may not save.
+	LineForbidden LineStatus = "forbidden"
+)
+
+// A LineReport describes what happened to one line of an ingested body.
+// Lines are numbered from 1.
+type LineReport struct {
+	Line   int        `json:"line"`
+	Status LineStatus `json:"status"`
+	Result string     `json:"result,omitempty"` // a SaveResult, for saved lines
+	Error  string     `json:"error,omitempty"`
+}
+
+// An IngestReport is the reply to POST /offers?report=true. Every line
+// is processed; bad ones are reported rather than ending the request.
+type IngestReport struct {
+	BatchID int          `json:"batchID"`
+	Lines   []LineReport `json:"lines"`
+
+	Saved          int `json:"saved"`
+	Inserted       int `json:"inserted"`
+	Updated        int `json:"updated"`
+	Unchanged      int `json:"unchanged"`
+	Invalid        int `json:"invalid"`
+	UnknownAirport int `json:"unknownAirport"`
+	Forbidden      int `json:"forbidden"`
+}
+
+// add records the outcome of a line and updates the totals.
+func (r *IngestReport) add(line LineReport) {
+	r.Lines = append(r.Lines, line)
+
+	switch line.Status {
+	case LineSaved:
+		r.Saved++
+		switch line.Result {
+		case OfferInserted.String():
+			r.Inserted++
+		case OfferUpdated.String():
+			r.Updated++
+		case OfferUnchanged.String():
+			r.Unchanged++
+		}
+	case LineInvalid:
+		r.Invalid++
+	case LineUnknownAirport:
+		r.UnknownAirport++
+	case LineForbidden:
+		r.Forbidden++
+	}
+}
+
+// A Batch records one POST /offers call. Offers point at the batch
+// that last set their price, so a bad ingest can be removed.
+type Batch struct {
+	ID      int      `json:"id"`
+	Label   string   `json:"label"`   // chosen by the client
+	Sources []string `json:"sources"` // the sources of the batch's lines
+
+	Lines     int `json:"lines"`
+	Inserted  int `json:"inserted"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+
+	// Offers is the number of stored offers that still belong to the
+	// batch. It's filled in by ListBatches.
+	Offers int `json:"offers"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	DeletedAt time.Time `json:"deletedAt,omitempty"`
+}
+
+// maxBatchLabel is the longest label a batch may have.
+const maxBatchLabel = 100
+
+// ListBatchesRequest pages through batches, newest first.
+type ListBatchesRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+func (l *ListBatchesRequest) FromHTTP(r *http.Request) error {
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	if limit == 0 {
+		limit = 100
+	}
+
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	l.Limit = limit
+	l.Offset = offset
+
+	return nil
+}
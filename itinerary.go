@@ -0,0 +1,355 @@
+package transitdb
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ItineraryRequest asks for the cheapest ways to get from one of
+// Origins to one of Destinations by chaining separately ticketed
+// offers. The first leg departs between StartDate and EndDate.
+type ItineraryRequest struct {
+	StartDate    time.Time `json:"startDate"`
+	EndDate      time.Time `json:"endDate"`
+	Origins      []string  `json:"origins"`
+	Destinations []string  `json:"destinations"`
+
+	// MaxLegs is the most offers an itinerary may chain together.
+	MaxLegs int `json:"maxLegs"`
+	// MinConnectionDays is the least time between the departure of one
+	// leg and the next.
+	MinConnectionDays int `json:"minConnectionDays"`
+	// MaxDurationDays is the most time between the departure of the
+	// first leg and the last.
+	MaxDurationDays int `json:"maxDurationDays"`
+
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+
+	// Currency is the currency legs and totals are converted to.
+	// Itineraries are ranked by their converted costs.
+	Currency string `json:"currency"`
+}
+
+func (it *ItineraryRequest) FromHTTP(r *http.Request) error {
+	var quotes ListQuotesRequest
+	if err := quotes.FromHTTP(r); err != nil {
+		return err
+	}
+	if name := quotes.regionParam(); name != "" {
+		return fmt.Errorf("'%s' isn't supported for itineraries", name)
+	}
+	if len(quotes.Origins) == 0 {
+		return errors.New("missing 'origin'")
+	}
+	if len(quotes.Destinations) == 0 {
+		return errors.New("missing 'dest'")
+	}
+
+	maxLegs, err := intParam(r, "max_legs", 2)
+	if err != nil || maxLegs < 1 {
+		return errors.New("invalid 'max_legs'")
+	}
+	minConnection, err := intParam(r, "min_connection_days", 0)
+	if err != nil || minConnection < 0 {
+		return errors.New("invalid 'min_connection_days'")
+	}
+	maxDuration, err := intParam(r, "max_duration_days", 14)
+	if err != nil || maxDuration < 0 {
+		return errors.New("invalid 'max_duration_days'")
+	}
+
+	limit := quotes.Limit
+	if r.FormValue("limit") == "" {
+		limit = 20
+	}
+
+	it.StartDate = quotes.StartDate
+	it.EndDate = quotes.EndDate
+	it.Origins = quotes.Origins
+	it.Destinations = quotes.Destinations
+	it.MaxLegs = maxLegs
+	it.MinConnectionDays = minConnection
+	it.MaxDurationDays = maxDuration
+	it.Limit = limit
+	it.Offset = quotes.Offset
+	it.Currency = quotes.Currency
+
+	return nil
+}
+
+func intParam(r *http.Request, name string, def int) (int, error) {
+	v := r.FormValue(name)
+	if v == "" {
+		return def, nil
+	}
+	return strconv.Atoi(v)
+}
+
+// An Itinerary is a sequence of offers where each leg departs from
+// where the previous one arrived. Cost is the sum of the legs. Costs
+// are in Currency; each leg's Offer keeps its original currency.
+type Itinerary struct {
+	Cost     int    `json:"cost"`
+	Currency string `json:"currency"`
+	Legs     []Leg  `json:"legs"`
+}
+
+type Leg struct {
+	Origin string `json:"origin"`
+	Dest   string `json:"dest"`
+	Date   Date   `json:"date"`
+	Cost   int    `json:"cost"`
+	Offer  Offer  `json:"offer"`
+}
+
+// maxItineraryOffers bounds the number of offers LoadItineraryOffers
+// reads for a single request.
+const maxItineraryOffers = 100000
+
+// LoadItineraryOffers reads the offers FindItineraries needs for req
+// from s: the ones departing from req's origins in its window, then
+// leg by leg the ones departing from the places reached so far before
+// the longest itinerary ends, with one query per leg. The last leg only
+// needs offers arriving at a destination. It returns a
+// *ValidationError if that's more than maxItineraryOffers.
+func LoadItineraryOffers(ctx context.Context, s Store, req ItineraryRequest) ([]Offer, error) {
+	var (
+		offers  []Offer
+		loaded  = make(map[int]bool)    // offer IDs
+		visited = make(map[string]bool) // places whose departures are loaded
+		reached = append([]string(nil), req.Origins...)
+	)
+
+	for leg := 1; leg <= req.MaxLegs && len(reached) > 0; leg++ {
+		// Only the first leg has to depart in the window.
+		q := ListOffersRequest{StartDate: req.StartDate, EndDate: req.EndDate}
+		if leg > 1 {
+			q.EndDate = req.EndDate.AddDate(0, 0, req.MaxDurationDays)
+		}
+
+		for _, code := range reached {
+			if !visited[code] {
+				visited[code] = true
+				q.Origins = append(q.Origins, code)
+			}
+		}
+		if len(q.Origins) == 0 {
+			break
+		}
+		sort.Strings(q.Origins)
+		if leg == req.MaxLegs {
+			q.Destinations = req.Destinations
+		}
+
+		// Asking for one more offer than may be loaded tells whether
+		// there are too many.
+		q.Limit = maxItineraryOffers - len(offers) + 1
+		page, err := s.ListOffers(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == q.Limit {
+			return nil, &ValidationError{Msg: "too many offers to search; narrow the dates or places"}
+		}
+
+		reached = nil
+		for _, o := range page {
+			if loaded[o.ID] {
+				continue
+			}
+			loaded[o.ID] = true
+			offers = append(offers, o)
+			reached = append(reached, o.DestinationAirport)
+		}
+	}
+
+	return offers, nil
+}
+
+// maxItinerarySteps bounds the work FindItineraries does for a single
+// request, since the number of partial itineraries grows quickly with
+// MaxLegs. A search that needs more fails rather than returning
+// results that may not be the cheapest.
+const maxItinerarySteps = 200000
+
+// FindItineraries searches offers for the cheapest itineraries matching
+// req. It treats offers as edges in a time-expanded graph over places,
+// where an offer can follow another if it departs from the previous
+// arrival airport at least MinConnectionDays later. Itineraries never
+// visit the same place twice. Results are ordered by cost in
+// req.Currency, and offers in currencies without a rate are skipped. It
+// returns a *ValidationError if req.Currency has no rate or the search
+// takes more than maxItinerarySteps.
+func FindItineraries(offers []Offer, rates ExchangeRates, req ItineraryRequest) ([]Itinerary, error) {
+	currency := req.Currency
+	if currency == "" {
+		currency = BaseCurrency
+	}
+	if _, ok := rates[currency]; !ok {
+		return nil, &ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", currency)}
+	}
+
+	origins := make(map[string]bool)
+	for _, code := range req.Origins {
+		origins[code] = true
+	}
+	dests := make(map[string]bool)
+	for _, code := range req.Destinations {
+		dests[code] = true
+	}
+
+	// Only the cheapest offer for a route on a given day can be part
+	// of a cheapest itinerary.
+	type edgeKey struct {
+		origin, dest string
+		date         Date
+	}
+	cheapest := make(map[edgeKey]edge)
+	for _, o := range offers {
+		cost, ok := rates.Convert(o.Cost, o.Currency, currency)
+		if !ok {
+			continue
+		}
+		k := edgeKey{o.OriginAirport, o.DestinationAirport, o.AvailableFrom}
+		if c, ok := cheapest[k]; !ok || cost < c.cost {
+			cheapest[k] = edge{o, cost}
+		}
+	}
+	departures := make(map[string][]edge)
+	for _, e := range cheapest {
+		departures[e.OriginAirport] = append(departures[e.OriginAirport], e)
+	}
+
+	var queue itineraryQueue
+	for origin := range origins {
+		for _, e := range departures[origin] {
+			d := time.Time(e.AvailableFrom)
+			if d.Before(req.StartDate) || d.After(req.EndDate) {
+				continue
+			}
+			heap.Push(&queue, &partialItinerary{cost: e.cost, legs: []edge{e}})
+		}
+	}
+
+	var (
+		results []Itinerary
+		skipped int
+	)
+	for steps := 0; queue.Len() > 0; steps++ {
+		if steps == maxItinerarySteps {
+			return nil, &ValidationError{Msg: "too many itineraries to search; lower 'max_legs' or narrow the dates or places"}
+		}
+
+		p := heap.Pop(&queue).(*partialItinerary)
+		last := p.legs[len(p.legs)-1]
+
+		if dests[last.DestinationAirport] {
+			if skipped < req.Offset {
+				skipped++
+				continue
+			}
+			results = append(results, p.itinerary(currency))
+			if req.Limit > 0 && len(results) >= req.Limit {
+				break
+			}
+			continue
+		}
+		if len(p.legs) >= req.MaxLegs {
+			continue
+		}
+
+		first := time.Time(p.legs[0].AvailableFrom)
+		earliest := time.Time(last.AvailableFrom).AddDate(0, 0, req.MinConnectionDays)
+		latest := first.AddDate(0, 0, req.MaxDurationDays)
+
+		for _, next := range departures[last.DestinationAirport] {
+			d := time.Time(next.AvailableFrom)
+			if d.Before(earliest) || d.After(latest) {
+				continue
+			}
+			if p.visits(next.DestinationAirport) {
+				continue
+			}
+
+			legs := make([]edge, len(p.legs), len(p.legs)+1)
+			copy(legs, p.legs)
+			heap.Push(&queue, &partialItinerary{
+				cost: p.cost + next.cost,
+				legs: append(legs, next),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// An edge is an offer with its cost converted to the requested
+// currency.
+type edge struct {
+	Offer
+	cost int
+}
+
+type partialItinerary struct {
+	cost int
+	legs []edge
+}
+
+func (p *partialItinerary) visits(place string) bool {
+	if p.legs[0].OriginAirport == place {
+		return true
+	}
+	for _, o := range p.legs {
+		if o.DestinationAirport == place {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *partialItinerary) itinerary(currency string) Itinerary {
+	it := Itinerary{Cost: p.cost, Currency: currency}
+	for _, e := range p.legs {
+		it.Legs = append(it.Legs, Leg{
+			Origin: e.OriginAirport,
+			Dest:   e.DestinationAirport,
+			Date:   e.AvailableFrom,
+			Cost:   e.cost,
+			Offer:  e.Offer,
+		})
+	}
+	return it
+}
+
+// itineraryQueue is a min-heap of partial itineraries ordered by cost,
+// then by departure date.
+type itineraryQueue []*partialItinerary
+
+func (q itineraryQueue) Len() int { return len(q) }
+
+func (q itineraryQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	a, b := time.Time(q[i].legs[0].AvailableFrom), time.Time(q[j].legs[0].AvailableFrom)
+	return a.Before(b)
+}
+
+func (q itineraryQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *itineraryQueue) Push(x interface{}) { *q = append(*q, x.(*partialItinerary)) }
+
+func (q *itineraryQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
@@ -0,0 +1,67 @@
+package transitdb_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/memstore"
+)
+
+func TestLoadItineraryOffers(t *testing.T) {
+	ctx := context.Background()
+	store := memstore.New()
+
+	offer := func(origin, dest, date string) transitdb.Offer {
+		d, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return transitdb.Offer{
+			OriginAirport:      origin,
+			DestinationAirport: dest,
+			Cost:               100,
+			Source:             "test",
+			AvailableFrom:      transitdb.Date(d),
+			OfferedAt:          time.Now().Add(-time.Hour),
+			ExpiresAt:          time.Now().Add(24 * time.Hour),
+		}
+	}
+	_, err := store.SaveOffers(ctx, []transitdb.Offer{
+		offer("LGB", "LAS", "2030-06-01"),
+		offer("LGB", "LAS", "2030-06-20"), // first leg outside the window
+		offer("LAS", "NRT", "2030-06-05"),
+		offer("LAS", "JFK", "2030-06-05"), // doesn't reach a destination
+		offer("JFK", "NRT", "2030-06-06"), // JFK isn't reachable
+		offer("LAX", "NRT", "2030-06-01"), // neither is LAX
+		offer("LAS", "NRT", "2030-07-30"), // after the longest itinerary
+	})
+	if err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+
+	offers, err := transitdb.LoadItineraryOffers(ctx, store, transitdb.ItineraryRequest{
+		StartDate:       time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC),
+		Origins:         []string{"LGB"},
+		Destinations:    []string{"NRT"},
+		MaxLegs:         2,
+		MaxDurationDays: 14,
+	})
+	if err != nil {
+		t.Fatalf("LoadItineraryOffers: %v", err)
+	}
+
+	var got []string
+	for _, o := range offers {
+		got = append(got, o.OriginAirport+"-"+o.DestinationAirport+" "+time.Time(o.AvailableFrom).Format("01-02"))
+	}
+	sort.Strings(got)
+	want := []string{"LAS-NRT 06-05", "LGB-LAS 06-01"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadItineraryOffers = %v, want %v", got, want)
+	}
+}
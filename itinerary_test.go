@@ -0,0 +1,154 @@
+package transitdb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFindItineraries(t *testing.T) {
+	day := func(s string) Date {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Date(d)
+	}
+	offer := func(origin, dest string, cost int, date string) Offer {
+		return Offer{
+			OriginAirport:      origin,
+			DestinationAirport: dest,
+			Cost:               cost,
+			Currency:           BaseCurrency,
+			AvailableFrom:      day(date),
+		}
+	}
+	euro := offer("LAS", "NRT", 100, "2030-06-03")
+	euro.Currency = "EUR"
+	yen := offer("LAS", "NRT", 1, "2030-06-03")
+	yen.Currency = "JPY" // no rate
+
+	base := ItineraryRequest{
+		StartDate:       time.Time(day("2030-06-01")),
+		EndDate:         time.Time(day("2030-06-05")),
+		Origins:         []string{"LGB"},
+		Destinations:    []string{"NRT"},
+		MaxLegs:         2,
+		MaxDurationDays: 14,
+	}
+
+	tests := []struct {
+		name   string
+		offers []Offer
+		req    func(*ItineraryRequest)
+		want   []string // itineraries as "cost:ORIG-DEST,ORIG-DEST"
+	}{
+		{
+			name: "direct and connecting, cheapest first",
+			offers: []Offer{
+				offer("LGB", "NRT", 900, "2030-06-01"),
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				offer("LAS", "NRT", 500, "2030-06-02"),
+			},
+			want: []string{"600:LGB-LAS,LAS-NRT", "900:LGB-NRT"},
+		},
+		{
+			name: "connection too soon",
+			offers: []Offer{
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				offer("LAS", "NRT", 500, "2030-06-01"),
+			},
+			req:  func(r *ItineraryRequest) { r.MinConnectionDays = 1 },
+			want: nil,
+		},
+		{
+			name: "too long",
+			offers: []Offer{
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				offer("LAS", "NRT", 500, "2030-06-20"),
+			},
+			want: nil,
+		},
+		{
+			name: "too many legs",
+			offers: []Offer{
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				offer("LAS", "JFK", 100, "2030-06-02"),
+				offer("JFK", "NRT", 100, "2030-06-03"),
+			},
+			want: nil,
+		},
+		{
+			name: "first leg outside window",
+			offers: []Offer{
+				offer("LGB", "NRT", 100, "2030-06-10"),
+			},
+			want: nil,
+		},
+		{
+			name: "no cycles",
+			offers: []Offer{
+				offer("LGB", "LAS", 10, "2030-06-01"),
+				offer("LAS", "LGB", 10, "2030-06-02"),
+				offer("LGB", "NRT", 900, "2030-06-03"),
+			},
+			req:  func(r *ItineraryRequest) { r.MaxLegs = 3 },
+			want: []string{"900:LGB-NRT"},
+		},
+		{
+			name: "converts currencies and skips unknown ones",
+			offers: []Offer{
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				euro,
+				yen,
+			},
+			want: []string{"300:LGB-LAS,LAS-NRT"},
+		},
+		{
+			name: "in the requested currency",
+			offers: []Offer{
+				offer("LGB", "LAS", 100, "2030-06-01"),
+				euro,
+			},
+			req:  func(r *ItineraryRequest) { r.Currency = "EUR" },
+			want: []string{"150:LGB-LAS,LAS-NRT"},
+		},
+		{
+			name: "paging",
+			offers: []Offer{
+				offer("LGB", "NRT", 100, "2030-06-01"),
+				offer("LGB", "NRT", 200, "2030-06-02"),
+				offer("LGB", "NRT", 300, "2030-06-03"),
+			},
+			req:  func(r *ItineraryRequest) { r.Limit, r.Offset = 1, 1 },
+			want: []string{"200:LGB-NRT"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := base
+			if tt.req != nil {
+				tt.req(&req)
+			}
+			rates := NewExchangeRates([]ExchangeRate{{Currency: "EUR", PerUSD: 0.5}})
+
+			res, err := FindItineraries(tt.offers, rates, req)
+			if err != nil {
+				t.Fatalf("FindItineraries: %v", err)
+			}
+			var got []string
+			for _, it := range res {
+				var legs []string
+				for _, l := range it.Legs {
+					legs = append(legs, l.Origin+"-"+l.Dest)
+				}
+				got = append(got, fmt.Sprintf("%d:%s", it.Cost, strings.Join(legs, ",")))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindItineraries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+//go:build ignore
+// +build ignore
+
+// genplaces reads the places.sql seed file and writes a Go source file
+// containing the same rows, so memstore can start with the same places
+// as a freshly loaded Postgres database.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	columnsRe = regexp.MustCompile(`^\s*\(([a-z_, ]+)\)\s*$`)
+	rowRe     = regexp.MustCompile(`^\s*\((.*)\),?\s*$`)
+)
+
+func main() {
+	out := flag.String("o", "places.go", "output file")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("usage: genplaces [-o places.go] places.sql")
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var (
+		columns map[string]int
+		rows    [][]string
+	)
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+
+		if columns == nil {
+			m := columnsRe.FindStringSubmatch(text)
+			if m == nil {
+				continue
+			}
+			columns = make(map[string]int)
+			for i, col := range strings.Split(m[1], ",") {
+				columns[strings.TrimSpace(col)] = i
+			}
+			continue
+		}
+
+		m := rowRe.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		values, err := splitValues(m[1])
+		if err != nil {
+			log.Fatalf("line %d: %v", line, err)
+		}
+		if len(values) != len(columns) {
+			log.Fatalf("line %d: got %d values, want %d", line, len(values), len(columns))
+		}
+		rows = append(rows, values)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if columns == nil {
+		log.Fatal("no column list found")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintln(buf, "// Code generated by genplaces.go from places.sql. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package memstore")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var seedPlaces = []place{")
+	for _, row := range rows {
+		fmt.Fprintf(buf, "\t{%q, %q, %s, %s, %q},\n",
+			row[columns["iata_code"]],
+			row[columns["country"]],
+			row[columns["latitude"]],
+			row[columns["longitude"]],
+			row[columns["name"]])
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// splitValues splits the inside of a SQL VALUES tuple into its fields,
+// unquoting string literals.
+func splitValues(s string) ([]string, error) {
+	var (
+		values []string
+		cur    []byte
+		quoted bool
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quoted && c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			cur = append(cur, '\'')
+			i++
+		case c == '\'':
+			quoted = !quoted
+		case !quoted && c == ',':
+			values = append(values, strings.TrimSpace(string(cur)))
+			cur = cur[:0]
+		default:
+			cur = append(cur, c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated string in %q", s)
+	}
+	values = append(values, strings.TrimSpace(string(cur)))
+	return values, nil
+}
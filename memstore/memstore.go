@@ -0,0 +1,1679 @@
+// Package memstore is an in-memory implementation of transitdb.Store.
+// It's seeded with the same places as places.sql and mirrors the query
+// semantics of the Postgres store, so it can stand in for pg.Store in
+// tests or when embedding transitdb in another program.
+package memstore
+
+//go:generate go run genplaces.go -o places.go ../places.sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+)
+
+type place struct {
+	iataCode  string
+	country   string
+	latitude  float64
+	longitude float64
+	name      string
+}
+
+type offer struct {
+	transitdb.Offer
+
+	originID int
+	destID   int
+}
+
+// priceChange records the cost an offer had before an update.
+type priceChange struct {
+	offerID    int
+	cost       int
+	currency   string
+	observedAt time.Time
+	replacedAt time.Time
+}
+
+// offerTable holds the offers and their price history.
+type offerTable struct {
+	offers      []offer
+	history     []priceChange
+	lastOfferID int
+}
+
+func (t *offerTable) clone() offerTable {
+	return offerTable{
+		offers:      append([]offer(nil), t.offers...),
+		history:     append([]priceChange(nil), t.history...),
+		lastOfferID: t.lastOfferID,
+	}
+}
+
+type Store struct {
+	// OfferKey identifies offers that are re-reports of a stored offer.
+	// If nil, transitdb.DefaultOfferKey is used.
+	OfferKey transitdb.OfferKey
+
+	// Now reports the current time. It's used in place of Postgres'
+	// NOW() when deciding whether an offer has expired. If nil,
+	// time.Now is used.
+	Now func() time.Time
+
+	// writeMu is held by writers to offerTable, and for the life of a
+	// Tx, so a Tx's batches see no other changes. A Tx releases it when
+	// it ends or its context is done.
+	writeMu sync.Mutex
+
+	mu       sync.Mutex
+	places   []place        // place_id is the index plus one
+	placeIDs map[string]int // IATA codes of active places
+	inactive map[int]bool   // deactivated places, by ID
+	groups   map[string]transitdb.PlaceGroup
+	offerTable
+	rates map[string]transitdb.ExchangeRate
+
+	watches        []transitdb.Watch
+	deliveries     []transitdb.Delivery
+	nextWatchID    int
+	nextDeliveryID int
+
+	subscribers map[chan transitdb.OfferEvent]bool
+
+	batches     []transitdb.Batch
+	lastBatchID int
+
+	apiKeys []transitdb.APIKey
+}
+
+func New() *Store {
+	s := &Store{
+		placeIDs: make(map[string]int),
+		inactive: make(map[int]bool),
+		groups:   make(map[string]transitdb.PlaceGroup),
+		rates: map[string]transitdb.ExchangeRate{
+			transitdb.BaseCurrency: {Currency: transitdb.BaseCurrency, PerUSD: 1},
+		},
+	}
+	for _, p := range seedPlaces {
+		s.places = append(s.places, p)
+		s.placeIDs[p.iataCode] = len(s.places)
+	}
+	for _, g := range seedPlaceGroups {
+		s.groups[g.Code] = g
+	}
+	return s
+}
+
+func (s *Store) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+func (s *Store) place(id int) place {
+	return s.places[id-1]
+}
+
+func (s *Store) AirportIDByIATA(ctx context.Context, iata string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ok := s.placeIDs[iata]
+	if !ok {
+		return 0, transitdb.ErrNotFound
+	}
+	return id, nil
+}
+
+func (s *Store) ListPlaces(ctx context.Context, q transitdb.ListPlacesRequest) ([]transitdb.Place, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := strings.ToLower(q.Query)
+
+	var matches []transitdb.Place
+	for id := range s.places {
+		p := s.placeInfo(id + 1)
+		if q.Country != "" && p.Country != q.Country {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(p.Name), query) &&
+			p.IATACode != strings.ToUpper(q.Query) {
+			continue
+		}
+		if !q.IncludeInactive && !p.Active {
+			continue
+		}
+		matches = append(matches, p)
+	}
+	// Like ORDER BY iata_code NULLS LAST, place_id.
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i].IATACode, matches[j].IATACode
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+		return a < b
+	})
+
+	if q.Offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[q.Offset:]
+	if q.Limit < len(matches) {
+		matches = matches[:q.Limit]
+	}
+
+	return matches, nil
+}
+
+func (s *Store) GetPlace(ctx context.Context, id int) (transitdb.Place, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id < 1 || id > len(s.places) {
+		return transitdb.Place{}, transitdb.ErrNotFound
+	}
+	return s.placeInfo(id), nil
+}
+
+func (s *Store) CreatePlace(ctx context.Context, p transitdb.Place) (transitdb.Place, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkIATACode(p); err != nil {
+		return p, err
+	}
+
+	s.places = append(s.places, place{
+		iataCode:  p.IATACode,
+		country:   p.Country,
+		latitude:  p.Latitude,
+		longitude: p.Longitude,
+		name:      p.Name,
+	})
+	id := len(s.places)
+	if p.IATACode != "" {
+		s.placeIDs[p.IATACode] = id
+	}
+
+	return s.placeInfo(id), nil
+}
+
+func (s *Store) UpdatePlace(ctx context.Context, p transitdb.Place) (transitdb.Place, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.ID < 1 || p.ID > len(s.places) {
+		return p, transitdb.ErrNotFound
+	}
+	if err := s.checkIATACode(p); err != nil {
+		return p, err
+	}
+
+	if old := s.place(p.ID).iataCode; s.placeIDs[old] == p.ID {
+		delete(s.placeIDs, old)
+	}
+	s.places[p.ID-1] = place{
+		iataCode:  p.IATACode,
+		country:   p.Country,
+		latitude:  p.Latitude,
+		longitude: p.Longitude,
+		name:      p.Name,
+	}
+	if p.Active {
+		delete(s.inactive, p.ID)
+		if p.IATACode != "" {
+			s.placeIDs[p.IATACode] = p.ID
+		}
+	} else {
+		s.inactive[p.ID] = true
+	}
+
+	return s.placeInfo(p.ID), nil
+}
+
+func (s *Store) DeactivatePlace(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id < 1 || id > len(s.places) {
+		return transitdb.ErrNotFound
+	}
+	s.inactive[id] = true
+	if code := s.place(id).iataCode; s.placeIDs[code] == id {
+		delete(s.placeIDs, code)
+	}
+
+	return nil
+}
+
+// checkIATACode returns transitdb.ErrConflict if a place other than p
+// has p's IATA code, as the unique index on places does. The caller
+// must hold s.mu.
+func (s *Store) checkIATACode(p transitdb.Place) error {
+	if p.IATACode == "" {
+		return nil
+	}
+	for i, other := range s.places {
+		if other.iataCode == p.IATACode && i+1 != p.ID {
+			return fmt.Errorf("%w: IATA code %q is taken", transitdb.ErrConflict, p.IATACode)
+		}
+	}
+	return nil
+}
+
+func (s *Store) ListPlaceGroups(ctx context.Context) ([]transitdb.PlaceGroup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []transitdb.PlaceGroup
+	for _, g := range s.groups {
+		results = append(results, copyPlaceGroup(g))
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Code < results[j].Code
+	})
+
+	return results, nil
+}
+
+func (s *Store) GetPlaceGroup(ctx context.Context, code string) (transitdb.PlaceGroup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.groups[code]
+	if !ok {
+		return transitdb.PlaceGroup{}, transitdb.ErrNotFound
+	}
+	return copyPlaceGroup(g), nil
+}
+
+func (s *Store) SavePlaceGroup(ctx context.Context, g transitdb.PlaceGroup) (transitdb.PlaceGroup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g = copyPlaceGroup(g)
+	s.groups[g.Code] = g
+
+	return copyPlaceGroup(g), nil
+}
+
+func (s *Store) DeletePlaceGroup(ctx context.Context, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.groups[code]; !ok {
+		return transitdb.ErrNotFound
+	}
+	delete(s.groups, code)
+
+	return nil
+}
+
+// copyPlaceGroup returns a copy of g with its members sorted, as pg
+// returns them.
+func copyPlaceGroup(g transitdb.PlaceGroup) transitdb.PlaceGroup {
+	g.Members = append([]string(nil), g.Members...)
+	sort.Strings(g.Members)
+	return g
+}
+
+// codeSet is like stringSet, but adds the members of any place groups
+// in list, like expand_place_codes in pg. The caller must hold s.mu.
+func (s *Store) codeSet(list []string) map[string]bool {
+	set := stringSet(list)
+	for _, code := range list {
+		for _, member := range s.groups[code].Members {
+			set[member] = true
+		}
+	}
+	return set
+}
+
+// placeInfo returns the place with the given ID as a transitdb.Place.
+// The caller must hold s.mu.
+func (s *Store) placeInfo(id int) transitdb.Place {
+	p := s.place(id)
+	return transitdb.Place{
+		ID:        id,
+		IATACode:  p.iataCode,
+		Name:      p.name,
+		Country:   p.country,
+		Latitude:  p.latitude,
+		Longitude: p.longitude,
+		Active:    !s.inactive[id],
+	}
+}
+
+func (s *Store) SaveOffer(ctx context.Context, o transitdb.Offer) (transitdb.SaveResult, error) {
+	results, err := s.SaveOffers(ctx, []transitdb.Offer{o})
+	if err != nil {
+		return 0, err
+	}
+	return results[0], nil
+}
+
+// SaveOffers saves a batch of offers. If any offer names an unknown
+// airport, none are saved. Offers whose key matches a stored offer
+// update it in place, as in pg.Store.
+func (s *Store) SaveOffers(ctx context.Context, offers []transitdb.Offer) ([]transitdb.SaveResult, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveOffers(&s.offerTable, offers, true)
+}
+
+// saveOffers merges offers into t. If notify is false, watches aren't
+// matched and subscribers aren't told, so t may be a scratch copy. The
+// caller must hold s.mu.
+func (s *Store) saveOffers(t *offerTable, offers []transitdb.Offer, notify bool) ([]transitdb.SaveResult, error) {
+	key := s.OfferKey
+	if key == nil {
+		key = transitdb.DefaultOfferKey
+	}
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resolved []offer
+	for _, o := range offers {
+		originID, ok := s.placeIDs[o.OriginAirport]
+		if !ok {
+			return nil, &transitdb.ErrUnknownAirport{Code: o.OriginAirport, Role: "origin"}
+		}
+		destID, ok := s.placeIDs[o.DestinationAirport]
+		if !ok {
+			return nil, &transitdb.ErrUnknownAirport{Code: o.DestinationAirport, Role: "destination"}
+		}
+
+		o.AvailableFrom = truncateDate(o.AvailableFrom)
+		o.AvailableTo = truncateDate(o.AvailableTo)
+		if o.Currency == "" {
+			o.Currency = transitdb.BaseCurrency
+		}
+		if _, ok := s.rates[o.Currency]; !ok {
+			return nil, &transitdb.ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", o.Currency)}
+		}
+
+		resolved = append(resolved, offer{
+			Offer:    o,
+			originID: originID,
+			destID:   destID,
+		})
+	}
+
+	// When the batch repeats a key, the last occurrence wins.
+	latest := make(map[offerKey]int)
+	for i, o := range resolved {
+		latest[keyOf(key, o)] = i
+	}
+
+	existing := make(map[offerKey]int)
+	for i := len(t.offers) - 1; i >= 0; i-- {
+		existing[keyOf(key, t.offers[i])] = i
+	}
+
+	results := make([]transitdb.SaveResult, len(resolved))
+	for i, o := range resolved {
+		k := keyOf(key, o)
+		if latest[k] != i {
+			results[i] = transitdb.OfferUnchanged
+			continue
+		}
+
+		j, ok := existing[k]
+		if !ok {
+			t.lastOfferID++
+			o.ID = t.lastOfferID
+			t.offers = append(t.offers, o)
+			results[i] = transitdb.OfferInserted
+			if notify {
+				s.matchWatches(o)
+				s.publish(o)
+			}
+			continue
+		}
+
+		stored := &t.offers[j]
+		results[i] = transitdb.OfferUnchanged
+		if stored.Cost != o.Cost || stored.Currency != o.Currency {
+			t.history = append(t.history, priceChange{
+				offerID:    stored.ID,
+				cost:       stored.Cost,
+				currency:   stored.Currency,
+				observedAt: stored.OfferedAt,
+				replacedAt: s.now(),
+			})
+			stored.Cost = o.Cost
+			stored.Currency = o.Currency
+			stored.BatchID = o.BatchID
+			results[i] = transitdb.OfferUpdated
+		}
+		stored.OfferedAt = o.OfferedAt
+		stored.ExpiresAt = o.ExpiresAt
+		if notify && results[i] == transitdb.OfferUpdated {
+			s.matchWatches(*stored)
+			s.publish(*stored)
+		}
+	}
+
+	return results, nil
+}
+
+func (s *Store) CreateBatch(ctx context.Context, b transitdb.Batch) (transitdb.Batch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastBatchID++
+	b.ID = s.lastBatchID
+	b.Sources = []string{} // like an empty Postgres array
+	b.CreatedAt = s.now()
+	s.batches = append(s.batches, b)
+
+	return b, nil
+}
+
+func (s *Store) UpdateBatch(ctx context.Context, b transitdb.Batch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.batches {
+		stored := &s.batches[i]
+		if stored.ID != b.ID {
+			continue
+		}
+		stored.Sources = append([]string{}, b.Sources...)
+		stored.Lines = b.Lines
+		stored.Inserted = b.Inserted
+		stored.Updated = b.Updated
+		stored.Unchanged = b.Unchanged
+		return nil
+	}
+
+	return transitdb.ErrNotFound
+}
+
+func (s *Store) ListBatches(ctx context.Context, q transitdb.ListBatchesRequest) ([]transitdb.Batch, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	offers := make(map[int]int)
+	for _, o := range s.offers {
+		if o.BatchID != 0 {
+			offers[o.BatchID]++
+		}
+	}
+
+	// Newest first. Batches are appended in order of creation.
+	var results []transitdb.Batch
+	for i := len(s.batches) - 1; i >= 0; i-- {
+		b := s.batches[i]
+		b.Offers = offers[b.ID]
+		results = append(results, b)
+	}
+
+	if q.Offset >= len(results) {
+		return nil, nil
+	}
+	results = results[q.Offset:]
+	if q.Limit < len(results) {
+		results = results[:q.Limit]
+	}
+
+	return results, nil
+}
+
+// DeleteBatch deletes the batch's offers, along with their price
+// history. It returns transitdb.ErrNotFound if there's no such batch.
+func (s *Store) DeleteBatch(ctx context.Context, id int) (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var batch *transitdb.Batch
+	for i := range s.batches {
+		if s.batches[i].ID == id {
+			batch = &s.batches[i]
+		}
+	}
+	if batch == nil {
+		return 0, transitdb.ErrNotFound
+	}
+	if batch.DeletedAt.IsZero() {
+		batch.DeletedAt = s.now()
+	}
+
+	deleted := make(map[int]bool)
+	var offers []offer
+	for _, o := range s.offers {
+		if o.BatchID == id {
+			deleted[o.ID] = true
+			continue
+		}
+		offers = append(offers, o)
+	}
+	var history []priceChange
+	for _, h := range s.history {
+		if !deleted[h.offerID] {
+			history = append(history, h)
+		}
+	}
+	s.offers = offers
+	s.history = history
+
+	return len(deleted), nil
+}
+
+func (s *Store) CreateAPIKey(ctx context.Context, k transitdb.APIKey) (transitdb.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, stored := range s.apiKeys {
+		if stored.Hash == k.Hash {
+			return k, fmt.Errorf("duplicate key hash")
+		}
+	}
+
+	k.ID = len(s.apiKeys) + 1
+	k.Sources = append([]string{}, k.Sources...)
+	k.CreatedAt = s.now()
+	s.apiKeys = append(s.apiKeys, k)
+
+	return k, nil
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context) ([]transitdb.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]transitdb.APIKey(nil), s.apiKeys...), nil
+}
+
+func (s *Store) LookupAPIKey(ctx context.Context, hash string) (transitdb.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, k := range s.apiKeys {
+		if k.Hash == hash && k.RevokedAt.IsZero() {
+			return k, nil
+		}
+	}
+
+	return transitdb.APIKey{}, transitdb.ErrNotFound
+}
+
+func (s *Store) RevokeAPIKey(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id < 1 || id > len(s.apiKeys) {
+		return transitdb.ErrNotFound
+	}
+	k := &s.apiKeys[id-1]
+	if k.RevokedAt.IsZero() {
+		k.RevokedAt = s.now()
+	}
+
+	return nil
+}
+
+// A tx works out the results of its batches on a copy of the offers,
+// then replays them against the store when committed. The store's
+// writeMu is held in between so the replay gives the same offers, but
+// not places or exchange rates, so Commit checks the replay before
+// applying it. Like a sql.Tx, a tx is rolled back when the context
+// passed to Begin is done, so an abandoned tx doesn't block writers.
+type tx struct {
+	s       *Store
+	scratch offerTable
+	batches [][]transitdb.Offer
+
+	mu   sync.Mutex // guards done; held while committing
+	done bool
+	end  chan struct{} // closed when the tx ends
+}
+
+func (s *Store) Begin(ctx context.Context) (transitdb.Tx, error) {
+	s.writeMu.Lock()
+	if err := ctx.Err(); err != nil {
+		s.writeMu.Unlock()
+		return nil, err
+	}
+
+	s.mu.Lock()
+	t := &tx{s: s, scratch: s.offerTable.clone(), end: make(chan struct{})}
+	s.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			t.Rollback()
+		case <-t.end:
+		}
+	}()
+
+	return t, nil
+}
+
+// finish ends the tx and releases the store's writeMu. The caller must
+// hold t.mu.
+func (t *tx) finish() {
+	t.done = true
+	close(t.end)
+	t.s.writeMu.Unlock()
+}
+
+func (t *tx) SaveOffers(ctx context.Context, offers []transitdb.Offer) ([]transitdb.SaveResult, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return nil, sql.ErrTxDone
+	}
+
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+
+	results, err := t.s.saveOffers(&t.scratch, offers, false)
+	if err != nil {
+		return nil, err
+	}
+	t.batches = append(t.batches, offers)
+
+	return results, nil
+}
+
+func (t *tx) Commit() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return sql.ErrTxDone
+	}
+	defer t.finish()
+
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+
+	// Places and rates may have changed since the batches were saved,
+	// so replay them on a copy first. Only if they all succeed are they
+	// applied, so a failed commit changes nothing.
+	check := t.s.offerTable.clone()
+	for _, batch := range t.batches {
+		if _, err := t.s.saveOffers(&check, batch, false); err != nil {
+			return err
+		}
+	}
+	for _, batch := range t.batches {
+		if _, err := t.s.saveOffers(&t.s.offerTable, batch, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *tx) Rollback() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.finish()
+
+	return nil
+}
+
+// offerKey holds the values of an offer's key fields. Fields that aren't
+// part of the configured key are left zero.
+type offerKey struct {
+	source    string
+	originID  int
+	destID    int
+	startTime transitdb.Date
+	endTime   transitdb.Date
+}
+
+func keyOf(key transitdb.OfferKey, o offer) offerKey {
+	var k offerKey
+	if key.Has(transitdb.KeySource) {
+		k.source = o.Source
+	}
+	if key.Has(transitdb.KeyOrigin) {
+		k.originID = o.originID
+	}
+	if key.Has(transitdb.KeyDest) {
+		k.destID = o.destID
+	}
+	if key.Has(transitdb.KeyStartTime) {
+		k.startTime = o.AvailableFrom
+	}
+	if key.Has(transitdb.KeyEndTime) {
+		k.endTime = o.AvailableTo
+	}
+	return k
+}
+
+type routeKey struct {
+	originID int
+	destID   int
+}
+
+func (s *Store) CheapestPerRoute(ctx context.Context, q transitdb.CheapestPerRouteRequest) ([]transitdb.Quote, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate, err := s.rate(q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := s.codeSet(q.Origins)
+	dests := s.codeSet(q.Destinations)
+	originRegion := newRegion(q.OriginCountries, q.ExcludeOriginCountries, q.OriginContinents, q.ExcludeOriginContinents)
+	destRegion := newRegion(q.DestCountries, q.ExcludeDestCountries, q.DestContinents, q.ExcludeDestContinents)
+
+	now := s.now()
+
+	// The cheapest live offer on each route, earliest first among
+	// equals, as in cheapestPerRouteSQL.
+	type match struct {
+		offer
+		costUSD float64
+	}
+	best := make(map[routeKey]match)
+	for _, o := range s.offers {
+		if !inRange(o.AvailableFrom, q.StartDate, q.EndDate) {
+			continue
+		}
+		origin, dest := s.place(o.originID), s.place(o.destID)
+		if origins != nil && !origins[origin.iataCode] {
+			continue
+		}
+		if dests != nil && !dests[dest.iataCode] {
+			continue
+		}
+		if !originRegion.match(origin.country) || !destRegion.match(dest.country) {
+			continue
+		}
+		if !live(o, now) {
+			continue
+		}
+		costUSD, ok := s.costUSD(o)
+		if !ok {
+			continue
+		}
+
+		m := match{o, costUSD}
+		key := routeKey{o.originID, o.destID}
+		b, ok := best[key]
+		switch {
+		case !ok,
+			m.costUSD < b.costUSD,
+			m.costUSD == b.costUSD && time.Time(o.AvailableFrom).Before(time.Time(b.AvailableFrom)),
+			m.costUSD == b.costUSD && o.AvailableFrom == b.AvailableFrom && o.ID < b.ID:
+			best[key] = m
+		}
+	}
+
+	var results []transitdb.Quote
+	for _, m := range best {
+		results = append(results, s.quote(m.offer, rate))
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Cost != b.Cost {
+			return a.Cost < b.Cost
+		}
+		if a.OriginIATA != b.OriginIATA {
+			return a.OriginIATA < b.OriginIATA
+		}
+		return a.DestIATA < b.DestIATA
+	})
+
+	if q.Offset >= len(results) {
+		return nil, nil
+	}
+	results = results[q.Offset:]
+	if q.Limit > 0 && q.Limit < len(results) {
+		results = results[:q.Limit]
+	}
+
+	return results, nil
+}
+
+func (s *Store) ListQuotes(ctx context.Context, q transitdb.ListQuotesRequest) ([]transitdb.Quote, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate, err := s.rate(q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := s.codeSet(q.Origins)
+	dests := s.codeSet(q.Destinations)
+	originRegion := newRegion(q.OriginCountries, q.ExcludeOriginCountries, q.OriginContinents, q.ExcludeOriginContinents)
+	destRegion := newRegion(q.DestCountries, q.ExcludeDestCountries, q.DestContinents, q.ExcludeDestContinents)
+	now := s.now()
+
+	type match struct {
+		offer
+		costUSD float64
+	}
+
+	// The offer that's happening the soonest with a minimal cost, for
+	// each route that has matching offers.
+	var matching []match
+	best := make(map[routeKey]match)
+	for _, o := range s.offers {
+		if !inRange(o.AvailableFrom, q.StartDate, q.EndDate) {
+			continue
+		}
+		if origins != nil && !origins[s.place(o.originID).iataCode] {
+			continue
+		}
+		if dests != nil && !dests[s.place(o.destID).iataCode] {
+			continue
+		}
+		if !near(q.OriginNear, s.place(o.originID)) || !near(q.DestNear, s.place(o.destID)) {
+			continue
+		}
+		if !originRegion.match(s.place(o.originID).country) || !destRegion.match(s.place(o.destID).country) {
+			continue
+		}
+		if !live(o, now) {
+			continue
+		}
+		costUSD, ok := s.costUSD(o)
+		if !ok {
+			continue
+		}
+
+		m := match{o, costUSD}
+		matching = append(matching, m)
+
+		key := routeKey{o.originID, o.destID}
+		b, ok := best[key]
+		switch {
+		case !ok,
+			m.costUSD < b.costUSD,
+			m.costUSD == b.costUSD && time.Time(o.AvailableFrom).Before(time.Time(b.AvailableFrom)):
+			best[key] = m
+		}
+	}
+
+	// listQuotesSQL joins the winners back against the matching offers
+	// with the same route, cost and date, so duplicate offers produce
+	// duplicate quotes. Do the same here.
+	var matches []match
+	for _, m := range matching {
+		b := best[routeKey{m.originID, m.destID}]
+		if m.costUSD != b.costUSD || m.AvailableFrom != b.AvailableFrom {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].costUSD < matches[j].costUSD
+	})
+
+	if q.Offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[q.Offset:]
+	if q.Limit < len(matches) {
+		matches = matches[:q.Limit]
+	}
+
+	var results []transitdb.Quote
+	for _, m := range matches {
+		origin, dest := s.place(m.originID), s.place(m.destID)
+		results = append(results, transitdb.Quote{
+			Cost:          int(math.Round(m.costUSD * rate.PerUSD)),
+			Currency:      rate.Currency,
+			Origin:        origin.name,
+			OriginIATA:    origin.iataCode,
+			OriginCountry: origin.country,
+			Dest:          dest.name,
+			DestIATA:      dest.iataCode,
+			DestCountry:   dest.country,
+			Date:          m.AvailableFrom,
+			OfferID:       m.ID,
+			Source:        m.Source,
+			EndDate:       m.AvailableTo,
+			OfferedAt:     m.OfferedAt,
+			ExpiresAt:     m.ExpiresAt,
+		})
+	}
+
+	return results, nil
+}
+
+func (s *Store) ListOffers(ctx context.Context, q transitdb.ListOffersRequest) ([]transitdb.Offer, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	origins := s.codeSet(q.Origins)
+	dests := s.codeSet(q.Destinations)
+
+	var matches []offer
+	for _, o := range s.offers {
+		if !within(time.Time(o.AvailableFrom), q.StartDate, q.EndDate) {
+			continue
+		}
+		if q.Source != "" && o.Source != q.Source {
+			continue
+		}
+		if origins != nil && !origins[s.place(o.originID).iataCode] {
+			continue
+		}
+		if dests != nil && !dests[s.place(o.destID).iataCode] {
+			continue
+		}
+		if !within(o.OfferedAt, q.CreatedAfter, q.CreatedBefore) {
+			continue
+		}
+		if !q.IncludeExpired && !live(o, now) {
+			continue
+		}
+		matches = append(matches, o)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return time.Time(matches[i].AvailableFrom).Before(time.Time(matches[j].AvailableFrom))
+	})
+
+	if q.Offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[q.Offset:]
+	if q.Limit > 0 && q.Limit < len(matches) {
+		matches = matches[:q.Limit]
+	}
+
+	var results []transitdb.Offer
+	for _, o := range matches {
+		results = append(results, o.Offer)
+	}
+
+	return results, nil
+}
+
+func (s *Store) GetOffer(ctx context.Context, id int) (transitdb.Offer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, o := range s.offers {
+		if o.ID == id {
+			return o.Offer, nil
+		}
+	}
+
+	return transitdb.Offer{}, transitdb.ErrNotFound
+}
+
+func (s *Store) DeleteOffer(ctx context.Context, id int) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, o := range s.offers {
+		if o.ID != id {
+			continue
+		}
+		s.offers = append(s.offers[:i:i], s.offers[i+1:]...)
+
+		var history []priceChange
+		for _, h := range s.history {
+			if h.offerID != id {
+				history = append(history, h)
+			}
+		}
+		s.history = history
+
+		return nil
+	}
+
+	return transitdb.ErrNotFound
+}
+
+func (s *Store) ExpireOffers(ctx context.Context, q transitdb.ExpireOffersRequest) (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	var expired int
+	for i := range s.offers {
+		o := &s.offers[i]
+		if o.Source != q.Source ||
+			s.place(o.originID).iataCode != q.Origin ||
+			s.place(o.destID).iataCode != q.Dest {
+			continue
+		}
+		if !live(*o, now) {
+			continue
+		}
+		o.ExpiresAt = now
+		expired++
+	}
+
+	return expired, nil
+}
+
+func (s *Store) ListRoundTrips(ctx context.Context, q transitdb.RoundTripRequest) ([]transitdb.RoundTrip, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate, err := s.rate(q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := s.codeSet(q.Origins)
+	dests := s.codeSet(q.Destinations)
+	now := s.now()
+
+	type pair struct {
+		out, back offer
+		costUSD   float64
+	}
+	var pairs []pair
+	for _, out := range s.offers {
+		if !live(out, now) || !inRange(out.AvailableFrom, q.StartDate, q.EndDate) {
+			continue
+		}
+		if origins != nil && !origins[s.place(out.originID).iataCode] {
+			continue
+		}
+		if dests != nil && !dests[s.place(out.destID).iataCode] {
+			continue
+		}
+		outUSD, ok := s.costUSD(out)
+		if !ok {
+			continue
+		}
+
+		departs := time.Time(out.AvailableFrom)
+		earliest := departs.AddDate(0, 0, q.MinStay)
+		latest := departs.AddDate(0, 0, q.MaxStay)
+
+		for _, back := range s.offers {
+			if back.originID != out.destID || back.destID != out.originID {
+				continue
+			}
+			if !live(back, now) || !inRange(back.AvailableFrom, earliest, latest) {
+				continue
+			}
+			backUSD, ok := s.costUSD(back)
+			if !ok {
+				continue
+			}
+			pairs = append(pairs, pair{out, back, outUSD + backUSD})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		if a.costUSD != b.costUSD {
+			return a.costUSD < b.costUSD
+		}
+		if a.out.AvailableFrom != b.out.AvailableFrom {
+			return time.Time(a.out.AvailableFrom).Before(time.Time(b.out.AvailableFrom))
+		}
+		if a.back.AvailableFrom != b.back.AvailableFrom {
+			return time.Time(a.back.AvailableFrom).Before(time.Time(b.back.AvailableFrom))
+		}
+		if a.out.ID != b.out.ID {
+			return a.out.ID < b.out.ID
+		}
+		return a.back.ID < b.back.ID
+	})
+
+	if q.Offset >= len(pairs) {
+		return nil, nil
+	}
+	pairs = pairs[q.Offset:]
+	if q.Limit < len(pairs) {
+		pairs = pairs[:q.Limit]
+	}
+
+	var results []transitdb.RoundTrip
+	for _, p := range pairs {
+		results = append(results, transitdb.RoundTrip{
+			Cost:     int(math.Round(p.costUSD * rate.PerUSD)),
+			Outbound: s.quote(p.out, rate),
+			Return:   s.quote(p.back, rate),
+		})
+	}
+
+	return results, nil
+}
+
+// quote describes o the way the pg store reports it, with its cost
+// converted at rate. o must have an exchange rate.
+func (s *Store) quote(o offer, rate transitdb.ExchangeRate) transitdb.Quote {
+	origin, dest := s.place(o.originID), s.place(o.destID)
+	costUSD, _ := s.costUSD(o)
+	return transitdb.Quote{
+		Cost:          int(math.Round(costUSD * rate.PerUSD)),
+		Currency:      rate.Currency,
+		Origin:        origin.name,
+		OriginIATA:    origin.iataCode,
+		OriginCountry: origin.country,
+		Dest:          dest.name,
+		DestIATA:      dest.iataCode,
+		DestCountry:   dest.country,
+		Date:          o.AvailableFrom,
+		OfferID:       o.ID,
+		Source:        o.Source,
+		EndDate:       o.AvailableTo,
+		OfferedAt:     o.OfferedAt,
+		ExpiresAt:     o.ExpiresAt,
+	}
+}
+
+func (s *Store) PriceCalendar(ctx context.Context, q transitdb.PriceCalendarRequest) ([]transitdb.CalendarDay, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate, err := s.rate(q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := s.codeSet(q.Origins)
+	dests := s.codeSet(q.Destinations)
+	now := s.now()
+
+	type day struct {
+		offer
+		costUSD float64
+	}
+	cheapest := make(map[transitdb.Date]day)
+	for _, o := range s.offers {
+		if !origins[s.place(o.originID).iataCode] || !dests[s.place(o.destID).iataCode] {
+			continue
+		}
+		if !live(o, now) {
+			continue
+		}
+		costUSD, ok := s.costUSD(o)
+		if !ok {
+			continue
+		}
+		if c, ok := cheapest[o.AvailableFrom]; !ok || costUSD < c.costUSD {
+			cheapest[o.AvailableFrom] = day{o, costUSD}
+		}
+	}
+
+	var results []transitdb.CalendarDay
+	start := time.Time(truncateDate(transitdb.Date(q.StartDate)))
+	end := time.Time(truncateDate(transitdb.Date(q.EndDate)))
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		res := transitdb.CalendarDay{Date: transitdb.Date(day)}
+		if c, ok := cheapest[res.Date]; ok {
+			quote := s.quote(c.offer, rate)
+			res.Quote = &quote
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (s *Store) PriceHistory(ctx context.Context, q transitdb.PriceHistoryRequest) ([]transitdb.PricePoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	routeOffers := make(map[int]offer)
+	for _, o := range s.offers {
+		if s.place(o.originID).iataCode != q.Origin || s.place(o.destID).iataCode != q.Dest {
+			continue
+		}
+		if !inRange(o.AvailableFrom, q.StartDate, q.EndDate) {
+			continue
+		}
+		routeOffers[o.ID] = o
+	}
+
+	var results []transitdb.PricePoint
+	for _, o := range routeOffers {
+		results = append(results, transitdb.PricePoint{
+			OfferID:    o.ID,
+			Source:     o.Source,
+			Cost:       o.Cost,
+			Currency:   o.Currency,
+			Date:       o.AvailableFrom,
+			ObservedAt: o.OfferedAt,
+		})
+	}
+	for _, h := range s.history {
+		o, ok := routeOffers[h.offerID]
+		if !ok {
+			continue
+		}
+		results = append(results, transitdb.PricePoint{
+			OfferID:    o.ID,
+			Source:     o.Source,
+			Cost:       h.cost,
+			Currency:   h.currency,
+			Date:       o.AvailableFrom,
+			ObservedAt: h.observedAt,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if !a.ObservedAt.Equal(b.ObservedAt) {
+			return a.ObservedAt.Before(b.ObservedAt)
+		}
+		if a.Date != b.Date {
+			return time.Time(a.Date).Before(time.Time(b.Date))
+		}
+		return a.OfferID < b.OfferID
+	})
+
+	return results, nil
+}
+
+func (s *Store) SaveExchangeRates(ctx context.Context, rates []transitdb.ExchangeRate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, r := range rates {
+		r.UpdatedAt = now
+		s.rates[r.Currency] = r
+	}
+
+	return nil
+}
+
+func (s *Store) ListExchangeRates(ctx context.Context) ([]transitdb.ExchangeRate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []transitdb.ExchangeRate
+	for _, r := range s.rates {
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Currency < results[j].Currency
+	})
+
+	return results, nil
+}
+
+// rate returns the exchange rate of currency, or of BaseCurrency if
+// it's empty. The caller must hold s.mu.
+func (s *Store) rate(currency string) (transitdb.ExchangeRate, error) {
+	if currency == "" {
+		currency = transitdb.BaseCurrency
+	}
+	rate, ok := s.rates[currency]
+	if !ok {
+		return rate, &transitdb.ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", currency)}
+	}
+	return rate, nil
+}
+
+func (s *Store) CreateWatch(ctx context.Context, w transitdb.Watch) (transitdb.Watch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w.Currency == "" {
+		w.Currency = transitdb.BaseCurrency
+	}
+	s.nextWatchID++
+	w.ID = s.nextWatchID
+	w.CreatedAt = s.now()
+	s.watches = append(s.watches, w)
+
+	return w, nil
+}
+
+func (s *Store) ListWatches(ctx context.Context) ([]transitdb.Watch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []transitdb.Watch
+	for _, w := range s.watches {
+		results = append(results, w)
+	}
+
+	return results, nil
+}
+
+// DeleteWatch deletes a watch and its deliveries. It returns
+// transitdb.ErrNotFound if there's no such watch.
+func (s *Store) DeleteWatch(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := false
+	var watches []transitdb.Watch
+	for _, w := range s.watches {
+		if w.ID == id {
+			found = true
+			continue
+		}
+		watches = append(watches, w)
+	}
+	if !found {
+		return transitdb.ErrNotFound
+	}
+	s.watches = watches
+
+	var deliveries []transitdb.Delivery
+	for _, d := range s.deliveries {
+		if d.WatchID != id {
+			deliveries = append(deliveries, d)
+		}
+	}
+	s.deliveries = deliveries
+
+	return nil
+}
+
+func (s *Store) ListDeliveries(ctx context.Context, q transitdb.ListDeliveriesRequest) ([]transitdb.Delivery, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Newest first. Deliveries are appended in order of creation.
+	var results []transitdb.Delivery
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		d := s.deliveries[i]
+		if q.WatchID != 0 && d.WatchID != q.WatchID {
+			continue
+		}
+		if q.Status != "" && d.Status != q.Status {
+			continue
+		}
+		results = append(results, d)
+	}
+
+	if q.Offset >= len(results) {
+		return nil, nil
+	}
+	results = results[q.Offset:]
+	if q.Limit < len(results) {
+		results = results[:q.Limit]
+	}
+
+	return results, nil
+}
+
+func (s *Store) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]transitdb.Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	var due []*transitdb.Delivery
+	for i := range s.deliveries {
+		d := &s.deliveries[i]
+		if d.Status == transitdb.DeliveryPending && !d.NextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if limit < len(due) {
+		due = due[:limit]
+	}
+
+	var results []transitdb.Delivery
+	for _, d := range due {
+		d.NextAttemptAt = now.Add(lease)
+		results = append(results, *d)
+	}
+
+	return results, nil
+}
+
+func (s *Store) UpdateDelivery(ctx context.Context, d transitdb.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.deliveries {
+		stored := &s.deliveries[i]
+		if stored.ID != d.ID {
+			continue
+		}
+		stored.Status = d.Status
+		stored.Attempts = d.Attempts
+		stored.NextAttemptAt = d.NextAttemptAt
+		stored.LastError = d.LastError
+		stored.DeliveredAt = d.DeliveredAt
+		return nil
+	}
+
+	return transitdb.ErrNotFound
+}
+
+// matchWatches queues a delivery for each watch o matches, like
+// matchWatchesSQL. The caller must hold s.mu.
+func (s *Store) matchWatches(o offer) {
+	now := s.now()
+	if !live(o, now) {
+		return
+	}
+	offerRate, ok := s.rates[o.Currency]
+	if !ok {
+		return
+	}
+	origin, dest := s.place(o.originID), s.place(o.destID)
+
+	// The delivery gets a snapshot of the offer as it was saved.
+	snapshot := o.Offer
+
+	for _, w := range s.watches {
+		if !inRange(o.AvailableFrom, time.Time(w.StartDate), time.Time(w.EndDate)) {
+			continue
+		}
+		if set := s.codeSet(w.Origins); set != nil && !set[origin.iataCode] {
+			continue
+		}
+		if set := s.codeSet(w.Destinations); set != nil && !set[dest.iataCode] {
+			continue
+		}
+		if set := stringSet(w.OriginCountries); set != nil && !set[origin.country] {
+			continue
+		}
+		if set := stringSet(w.DestCountries); set != nil && !set[dest.country] {
+			continue
+		}
+		watchRate, ok := s.rates[w.Currency]
+		if !ok {
+			continue
+		}
+		if float64(o.Cost)/offerRate.PerUSD*watchRate.PerUSD > float64(w.MaxCost) {
+			continue
+		}
+
+		s.nextDeliveryID++
+		s.deliveries = append(s.deliveries, transitdb.Delivery{
+			ID:            s.nextDeliveryID,
+			WatchID:       w.ID,
+			WebhookURL:    w.WebhookURL,
+			Offer:         snapshot,
+			Status:        transitdb.DeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
+}
+
+// subscriberBuffer is the number of events a subscriber may fall behind
+// before events are dropped.
+const subscriberBuffer = 100
+
+func (s *Store) SubscribeOffers(ctx context.Context) (<-chan transitdb.OfferEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan transitdb.OfferEvent, subscriberBuffer)
+	if s.subscribers == nil {
+		s.subscribers = make(map[chan transitdb.OfferEvent]bool)
+	}
+	s.subscribers[ch] = true
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		close(ch)
+		s.mu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// publish sends o to subscribers that have room for it. The caller must
+// hold s.mu.
+func (s *Store) publish(o offer) {
+	e := transitdb.OfferEvent{
+		Offer:         o.Offer,
+		OriginCountry: s.place(o.originID).country,
+		DestCountry:   s.place(o.destID).country,
+	}
+	for ch := range s.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// costUSD converts o's cost to dollars, like the offer_costs view. It
+// reports false if there's no exchange rate for o's currency.
+func (s *Store) costUSD(o offer) (float64, bool) {
+	rate, ok := s.rates[o.Currency]
+	if !ok {
+		return 0, false
+	}
+	return float64(o.Cost) / rate.PerUSD, true
+}
+
+// truncateDate drops the time of day, like storing into a DATE column.
+func truncateDate(d transitdb.Date) transitdb.Date {
+	t := time.Time(d)
+	if t.IsZero() {
+		return d
+	}
+	t = t.UTC()
+	return transitdb.Date(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// live reports whether o satisfies expires_at > NOW(). Offers without
+// an expiry time never do.
+func live(o offer, now time.Time) bool {
+	return !o.ExpiresAt.IsZero() && o.ExpiresAt.After(now)
+}
+
+// inRange reports whether d is BETWEEN start AND end.
+func inRange(d transitdb.Date, start, end time.Time) bool {
+	t := time.Time(d)
+	return !t.Before(start) && !t.After(end)
+}
+
+// within reports whether t is between start and end, inclusive. A zero
+// start or end leaves that end of the range open.
+func within(t, start, end time.Time) bool {
+	return (start.IsZero() || !t.Before(start)) && (end.IsZero() || !t.After(end))
+}
+
+// stringSet returns the members of list as a set, or nil if list is
+// empty to signal that anything matches.
+func stringSet(list []string) map[string]bool {
+	if len(list) == 0 {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, s := range list {
+		set[s] = true
+	}
+	return set
+}
+
+// A region filters places by country and continent, like the country
+// filters in listQuotesSQL. Nil sets match anything.
+type region struct {
+	countries, excludeCountries   map[string]bool
+	continents, excludeContinents map[string]bool
+}
+
+func newRegion(countries, excludeCountries, continents, excludeContinents []string) region {
+	return region{
+		countries:         stringSet(countries),
+		excludeCountries:  stringSet(excludeCountries),
+		continents:        stringSet(continents),
+		excludeContinents: stringSet(excludeContinents),
+	}
+}
+
+func (r region) match(country string) bool {
+	continent := transitdb.Continent(country)
+	switch {
+	case r.countries != nil && !r.countries[country],
+		r.continents != nil && !r.continents[continent],
+		r.excludeCountries[country],
+		r.excludeContinents[continent]:
+		return false
+	}
+	return true
+}
+
+// near reports whether p is within r. A nil r matches anything.
+func near(r *transitdb.Radius, p place) bool {
+	return r == nil || r.Contains(p.latitude, p.longitude)
+}
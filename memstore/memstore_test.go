@@ -0,0 +1,62 @@
+package memstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/memstore"
+	"github.com/maxhawkins/transitdb/storetest"
+)
+
+func TestStore(t *testing.T) {
+	storetest.Run(t, func() transitdb.Store { return memstore.New() })
+}
+
+func TestTxCommitAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	s := memstore.New()
+
+	offer := func(origin, dest string) transitdb.Offer {
+		return transitdb.Offer{
+			OriginAirport:      origin,
+			DestinationAirport: dest,
+			Cost:               100,
+			Source:             "test",
+			AvailableFrom:      transitdb.Date(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)),
+			OfferedAt:          time.Now().Add(-time.Hour),
+			ExpiresAt:          time.Now().Add(24 * time.Hour),
+		}
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	for _, o := range []transitdb.Offer{offer("LGB", "LAS"), offer("LGB", "NRT")} {
+		if _, err := tx.SaveOffers(ctx, []transitdb.Offer{o}); err != nil {
+			t.Fatalf("SaveOffers: %v", err)
+		}
+	}
+
+	// Deactivating NRT makes the second batch fail on replay.
+	nrt, err := s.AirportIDByIATA(ctx, "NRT")
+	if err != nil {
+		t.Fatalf("AirportIDByIATA: %v", err)
+	}
+	if err := s.DeactivatePlace(ctx, nrt); err != nil {
+		t.Fatalf("DeactivatePlace: %v", err)
+	}
+	if err := tx.Commit(); err == nil {
+		t.Fatal("Commit succeeded after NRT was deactivated")
+	}
+
+	offers, err := s.ListOffers(ctx, transitdb.ListOffersRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListOffers: %v", err)
+	}
+	if len(offers) != 0 {
+		t.Errorf("failed commit saved %+v", offers)
+	}
+}
@@ -0,0 +1,31 @@
+package memstore
+
+import "github.com/maxhawkins/transitdb"
+
+// seedPlaceGroups are the metro groups added by pg's "add place groups"
+// migration.
+var seedPlaceGroups = []transitdb.PlaceGroup{
+	{Code: "BER", Name: "Berlin", Metro: true, Members: []string{"BER", "SXF", "TXL"}},
+	{Code: "BJS", Name: "Beijing", Metro: true, Members: []string{"PEK", "PKX"}},
+	{Code: "BKK", Name: "Bangkok", Metro: true, Members: []string{"BKK", "DMK"}},
+	{Code: "BUE", Name: "Buenos Aires", Metro: true, Members: []string{"AEP", "EZE"}},
+	{Code: "CHI", Name: "Chicago", Metro: true, Members: []string{"MDW", "ORD"}},
+	{Code: "DXB", Name: "Dubai", Metro: true, Members: []string{"DWC", "DXB"}},
+	{Code: "IST", Name: "Istanbul", Metro: true, Members: []string{"IST", "SAW"}},
+	{Code: "LON", Name: "London", Metro: true, Members: []string{"LCY", "LGW", "LHR", "LTN", "SEN", "STN"}},
+	{Code: "MIL", Name: "Milan", Metro: true, Members: []string{"BGY", "LIN", "MXP"}},
+	{Code: "MOW", Name: "Moscow", Metro: true, Members: []string{"DME", "SVO", "VKO"}},
+	{Code: "NYC", Name: "New York", Metro: true, Members: []string{"EWR", "JFK", "LGA"}},
+	{Code: "OSA", Name: "Osaka", Metro: true, Members: []string{"ITM", "KIX", "UKB"}},
+	{Code: "OSL", Name: "Oslo", Metro: true, Members: []string{"OSL", "TRF"}},
+	{Code: "PAR", Name: "Paris", Metro: true, Members: []string{"BVA", "CDG", "ORY"}},
+	{Code: "RIO", Name: "Rio de Janeiro", Metro: true, Members: []string{"GIG", "SDU"}},
+	{Code: "ROM", Name: "Rome", Metro: true, Members: []string{"CIA", "FCO"}},
+	{Code: "SAO", Name: "Sao Paulo", Metro: true, Members: []string{"CGH", "GRU", "VCP"}},
+	{Code: "SEL", Name: "Seoul", Metro: true, Members: []string{"GMP", "ICN"}},
+	{Code: "SHA", Name: "Shanghai", Metro: true, Members: []string{"PVG", "SHA"}},
+	{Code: "STO", Name: "Stockholm", Metro: true, Members: []string{"ARN", "BMA", "NYO"}},
+	{Code: "TYO", Name: "Tokyo", Metro: true, Members: []string{"HND", "NRT"}},
+	{Code: "WAS", Name: "Washington", Metro: true, Members: []string{"BWI", "DCA", "IAD"}},
+	{Code: "YTO", Name: "Toronto", Metro: true, Members: []string{"YTZ", "YYZ"}},
+}
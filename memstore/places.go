@@ -0,0 +1,7900 @@
+// Code generated by genplaces.go from places.sql. DO NOT EDIT.
+
+package memstore
+
+var seedPlaces = []place{
+	{"AAA", "PF", -17.3526001, -145.5099945, "Anaa Airport"},
+	{"AAB", "AU", -26.70000076, 141.0500031, "Arrabury Airport"},
+	{"AAC", "EG", 31.07329941, 33.83580017, "El Arish International Airport"},
+	{"AAE", "DZ", 36.82220078, 7.809169769, "Annaba Airport"},
+	{"AAF", "US", 29.72750092, -85.02749634, "Apalachicola Regional Airport"},
+	{"AAG", "BR", -24.10390091, -49.78910065, "Arapoti Airport"},
+	{"AAH", "DE", 50.82305527, 6.186388969, "Aachen-Merzbrück Airport"},
+	{"AAI", "BR", -13.02388859, -46.88555527, "Arraias Airport"},
+	{"AAJ", "SR", 3.898681, -55.577907, "Cayana Airstrip"},
+	{"AAK", "KI", 0.185277998, 173.6369934, "Buariki Airport"},
+	{"AAL", "DK", 57.09275891, 9.849243164, "Aalborg Airport"},
+	{"AAM", "ZA", -24.81809998, 31.54459953, "Malamala Airport"},
+	{"AAN", "AE", 24.26169968, 55.60919952, "Al Ain International Airport"},
+	{"AAO", "VE", 9.430225372, -64.47072601, "Anaco Airport"},
+	{"AAQ", "RU", 45.0021019, 37.34730148, "Anapa Airport"},
+	{"AAR", "DK", 56.29999924, 10.61900043, "Aarhus Airport"},
+	{"AAS", "ID", -3.8832, 139.3108, "Apalapsili Airport"},
+	{"AAT", "CN", 47.74988556, 88.0858078, "Altay Air Base"},
+	{"AAU", "WS", -13.50580025, -172.628006, "Asau Airport"},
+	{"AAV", "PH", 6.366819859, 124.7509995, "Allah Valley Airport"},
+	{"AAW", "PK", 34.20000076, 73.25, "Abbottabad Airport"},
+	{"AAX", "BR", -19.5632, -46.96039963, "Araxá Airport"},
+	{"AAY", "YE", 16.19169998, 52.17499924, "Al Ghaidah International Airport"},
+	{"AAZ", "GT", 14.86559963, -91.5019989, "Quezaltenango Airport"},
+	{"ABA", "RU", 53.74000168, 91.38500214, "Abakan Airport"},
+	{"ABB", "NG", 6.203333333, 6.658888889, "Asaba International Airport"},
+	{"ABC", "ES", 38.94850159, -1.863520026, "Albacete-Los Llanos Airport"},
+	{"ABD", "IR", 30.37109947, 48.22829819, "Abadan Airport"},
+	{"ABE", "US", 40.65209961, -75.44080353, "Lehigh Valley International Airport"},
+	{"ABF", "KI", 1.798609972, 173.0410004, "Abaiang Airport"},
+	{"ABG", "AU", -17.61669922, 143.1670074, "Abingdon Downs Airport"},
+	{"ABH", "AU", -23.64609909, 146.5839996, "Alpha Airport"},
+	{"ABI", "US", 32.41130066, -99.68190002, "Abilene Regional Airport"},
+	{"ABJ", "CI", 5.261390209, -3.926290035, "Port Bouet Airport"},
+	{"ABK", "ET", 6.734000206, 44.25299835, "Kabri Dehar Airport"},
+	{"ABL", "US", 67.10630035, -157.8569946, "Ambler Airport"},
+	{"ABM", "AU", -10.95079994, 142.4589996, "Bamaga Injinoo Airport"},
+	{"ABN", "SR", 5.512720108, -54.05009842, "Albina Airport"},
+	{"ABP", "PG", -6.065555556, 141.0952778, "Atkamba Airport"},
+	{"ABQ", "US", 35.04019928, -106.6090012, "Albuquerque International Sunport Airport"},
+	{"ABR", "US", 45.44910049, -98.42179871, "Aberdeen Regional Airport"},
+	{"ABS", "EG", 22.37599945, 31.61170006, "Abu Simbel Airport"},
+	{"ABT", "SA", 20.29610062, 41.63430023, "Al Baha Airport"},
+	{"ABU", "ID", -9.073049545, 124.9049988, "Haliwen Airport"},
+	{"ABV", "NG", 9.006790161, 7.263169765, "Nnamdi Azikiwe International Airport"},
+	{"ABX", "AU", -36.06779861, 146.9579926, "Albury Airport"},
+	{"ABY", "US", 31.53549957, -84.19450378, "Southwest Georgia Regional Airport"},
+	{"ABZ", "GB", 57.20190048, -2.197779894, "Aberdeen Dyce Airport"},
+	{"ACA", "MX", 16.75709915, -99.7539978, "General Juan N Alvarez International Airport"},
+	{"ACB", "US", 44.98860168, -85.1984024, "Antrim County Airport"},
+	{"ACC", "GH", 5.6051898, -0.166786, "Kotoka International Airport"},
+	{"ACD", "CO", 8.51667, -77.3, "Alcides Fernández Airport"},
+	{"ACE", "ES", 28.94549942, -13.60519981, "Lanzarote Airport"},
+	{"ACF", "AU", -27.57029915, 153.0079956, "Brisbane Archerfield Airport"},
+	{"ACH", "CH", 47.48500061, 9.560770035, "St Gallen Altenrhein Airport"},
+	{"ACI", "GG", 49.70610046, -2.214720011, "Alderney Airport"},
+	{"ACJ", "LK", 8.30148983, 80.42790222, "Anuradhapura Air Force Base"},
+	{"ACK", "US", 41.25310135, -70.06020355, "Nantucket Memorial Airport"},
+	{"ACL", "CO", 4.753611111, -73.00277778, "Aguaclara Airport"},
+	{"ACM", "CO", -2.145437336, -71.75806046, "Arica Airport"},
+	{"ACN", "MX", 29.33289909, -101.098999, "Ciudad Acuña New International Airport"},
+	{"ACP", "IR", 37.34799957, 46.12789917, "Sahand Airport"},
+	{"ACR", "CO", -0.5833, -72.4083, "Araracuara Airport"},
+	{"ACS", "RU", 56.2682991, 90.57080078, "Achinsk Airport"},
+	{"ACT", "US", 31.61129951, -97.23049927, "Waco Regional Airport"},
+	{"ACU", "PA", 8.431659698, -77.96520233, "Achutupo Airport"},
+	{"ACV", "US", 40.97809982, -124.1090012, "Arcata Airport"},
+	{"ACX", "CN", 25.08638889, 104.9594444, "Xingyi Airport"},
+	{"ACY", "US", 39.45759964, -74.57720184, "Atlantic City International Airport"},
+	{"ACZ", "IR", 31.09830093, 61.54389954, "Zabol Airport"},
+	{"ADA", "TR", 36.98220062, 35.28039932, "Adana Airport"},
+	{"ADB", "TR", 38.29240036, 27.15699959, "Adnan Menderes International Airport"},
+	{"ADC", "PG", -7.137222222, 145.7447222, "Andakombe Airport"},
+	{"ADD", "ET", 8.977890015, 38.79930115, "Addis Ababa Bole International Airport"},
+	{"ADE", "YE", 12.8295002, 45.02880096, "Aden International Airport"},
+	{"ADF", "TR", 37.73139954, 38.46889877, "Adıyaman Airport"},
+	{"ADG", "US", 41.86769867, -84.07730103, "Lenawee County Airport"},
+	{"ADH", "RU", 58.60279846, 125.4089966, "Aldan Airport"},
+	{"ADI", "NA", -22.46220016, 14.97999954, "Arandis Airport"},
+	{"ADJ", "JO", 31.97270012, 35.99160004, "Amman-Marka International Airport"},
+	{"ADK", "US", 51.87799835, -176.6459961, "Adak Airport"},
+	{"ADL", "AU", -34.94499969, 138.5310059, "Adelaide International Airport"},
+	{"ADM", "US", 34.30301, -97.0196342, "Ardmore Municipal Airport"},
+	{"ADN", "CO", 5.66667, -75.8833, "Andes Airport"},
+	{"ADO", "AU", -30.43829918, 137.1369934, "Andamooka Airport"},
+	{"ADP", "LK", 7.33776, 81.62594, "Ampara Airport"},
+	{"ADQ", "US", 57.75, -152.4940033, "Kodiak Airport"},
+	{"ADR", "US", 33.4516983, -79.52619934, "Robert F Swinnie Airport"},
+	{"ADS", "US", 32.96860123, -96.83640289, "Addison Airport"},
+	{"ADT", "US", 34.8042984, -96.6713028, "Ada Municipal Airport"},
+	{"ADU", "IR", 38.32569885, 48.42440033, "Ardabil Airport"},
+	{"ADV", "SD", 11.4023, 26.1186, "El Daein Airport"},
+	{"ADW", "US", 38.81079865, -76.86699677, "Andrews Air Force Base"},
+	{"ADX", "GB", 56.3728981, -2.868439913, "RAF Leuchars"},
+	{"ADY", "ZA", -22.67900085, 29.05550003, "Alldays Airport"},
+	{"ADZ", "CO", 12.5836, -81.7112, "Gustavo Rojas Pinilla International Airport"},
+	{"AEA", "KI", 0.490833014, 173.8289948, "Abemama Atoll Airport"},
+	{"AEB", "CN", 23.72060013, 106.9599991, "Baise Youjiang Airport"},
+	{"AEE", "SS", 10.05361111, 32.95944444, "Adareil Airport"},
+	{"AEG", "ID", 1.400099993, 99.43049622, "Aek Godang Airport"},
+	{"AEH", "TD", 13.84700012, 20.84429932, "Abeche Airport"},
+	{"AEK", "PG", -7.350804856, 146.1938667, "Aseki Airport"},
+	{"AEL", "US", 43.68149948, -93.36720276, "Albert Lea Municipal Airport"},
+	{"AEO", "MR", 16.7112999, -9.637880325, "Aioun el Atrouss Airport"},
+	{"AEP", "AR", -34.5592, -58.4156, "Jorge Newbery Airpark"},
+	{"AER", "RU", 43.44990158, 39.95660019, "Sochi International Airport"},
+	{"AES", "NO", 62.5625, 6.119699955, "Ålesund Airport"},
+	{"AET", "US", 66.55180359, -152.621994, "Allakaket Airport"},
+	{"AEU", "IR", 25.8757, 55.03300095, "Abumusa Island Airport"},
+	{"AEX", "US", 31.32740021, -92.54979706, "Alexandria International Airport"},
+	{"AEY", "IS", 65.66000366, -18.0727005, "Akureyri Airport"},
+	{"AFA", "AR", -34.5882988, -68.40390015, "Suboficial Ay Santiago Germano Airport"},
+	{"AFD", "ZA", -33.55419922, 26.87770081, "Port Alfred Airport"},
+	{"AFF", "US", 38.96969986, -104.8130035, "USAF Academy Airfield"},
+	{"AFI", "CO", 6.91667, -75.0667, "Amalfi Airport"},
+	{"AFL", "BR", -9.866389275, -56.10499954, "Alta Floresta Airport"},
+	{"AFN", "US", 42.80509949, -72.00299835, "Jaffrey Airport Silver Ranch Airport"},
+	{"AFO", "US", 42.71120071, -110.9420013, "Afton Municipal Airport"},
+	{"AFR", "PG", -9.142222222, 148.3908333, "Afore Airstrip"},
+	{"AFS", "UZ", 41.61389923, 64.23320007, "Sugraly Airport"},
+	{"AFT", "SB", -9.191388889, 160.9486111, "Afutara Aerodrome"},
+	{"AFW", "US", 32.98759842, -97.31880188, "Fort Worth Alliance Airport"},
+	{"AFY", "TR", 38.72639847, 30.60110092, "Afyon Airport"},
+	{"AFZ", "IR", 36.16809845, 57.59519958, "Sabzevar National Airport"},
+	{"AGA", "MA", 30.32500076, -9.413069725, "Al Massira Airport"},
+	{"AGB", "DE", 48.42527771, 10.93166733, "Augsburg Airport"},
+	{"AGC", "US", 40.35440063, -79.93019867, "Allegheny County Airport"},
+	{"AGD", "ID", -1.3858, 133.8737, "Anggi Airport"},
+	{"AGE", "DE", 53.78277969, 7.913888931, "Wangerooge Airport"},
+	{"AGF", "FR", 44.17470169, 0.590556026, "Agen-La Garenne Airport"},
+	{"AGG", "PG", -4.055833333, 144.0738889, "Angoram Airport"},
+	{"AGH", "SE", 56.29610062, 12.84710026, "Ängelholm-Helsingborg Airport"},
+	{"AGI", "SR", 5.766666889, -56.6333313, "Wageningen Airstrip"},
+	{"AGJ", "JP", 26.59250069, 127.2409973, "Aguni Airport"},
+	{"AGK", "PG", -6.396388889, 143.8536111, "Kagua Airport"},
+	{"AGL", "PG", -9.3375, 149.1555556, "Wanigela Airport"},
+	{"AGO", "US", 33.22800064, -93.21700287, "Magnolia Municipal Airport"},
+	{"AGP", "ES", 36.67490005, -4.499110222, "Málaga Airport"},
+	{"AGQ", "GR", 38.60200119, 21.3512001, "Agrinion Airport"},
+	{"AGR", "IN", 27.15579987, 77.96089935, "Agra Airport"},
+	{"AGS", "US", 33.36989975, -81.96450043, "Augusta Regional At Bush Field"},
+	{"AGT", "PY", -25.45999908, -54.84000015, "Guarani International Airport"},
+	{"AGU", "MX", 21.70560074, -102.3180008, "Jesus Teran International Airport"},
+	{"AGV", "VE", 9.553375244, -69.23786926, "Oswaldo Guevara Mujica Airport"},
+	{"AGW", "AU", -12.14560032, 142.1490021, "Agnew Airport"},
+	{"AGX", "IN", 10.82369995, 72.1760025, "Agatti Airport"},
+	{"AGZ", "ZA", -29.28179932, 18.81389999, "Aggeneys Airport"},
+	{"AHB", "SA", 18.24040031, 42.65660095, "Abha Regional Airport"},
+	{"AHC", "US", 40.26620102, -120.1529999, "Amedee Army Air Field"},
+	{"AHD", "US", 34.14699936, -97.12270355, "Ardmore Downtown Executive Airport"},
+	{"AHE", "PF", -14.42809963, -146.2570038, "Ahe Airport"},
+	{"AHF", "US", 40.33950043, -99.90650177, "Arapahoe Municipal Airport"},
+	{"AHH", "US", 45.28110123, -92.37539673, "Amery Municipal Airport"},
+	{"AHI", "ID", -3.34800005, 128.9259949, "Amahai Airport"},
+	{"AHJ", "CN", 32.53154, 102.35224, "Hongyuan Airport"},
+	{"AHL", "GY", 2.486530066, -59.31340027, "Aishalton Airport"},
+	{"AHM", "US", 42.19029999, -122.6610031, "Ashland Municipal Sumner Parker Field"},
+	{"AHN", "US", 33.94860077, -83.32630157, "Athens Ben Epps Airport"},
+	{"AHO", "IT", 40.63209915, 8.290769577, "Alghero-Fertilia Airport"},
+	{"AHS", "HN", 15.47220039, -84.35220337, "Ahuas Airport"},
+	{"AHU", "MA", 35.17710114, -3.839519978, "Cherif Al Idrissi Airport"},
+	{"AHY", "MG", -20.01583333, 45.535, "Ambatolhy Airport"},
+	{"AHZ", "FR", 45.0882988, 6.084720135, "L\"alpe D\"huez Airport"},
+	{"AIA", "US", 42.05319977, -102.8040009, "Alliance Municipal Airport"},
+	{"AID", "US", 40.10860062, -85.61299896, "Anderson Municipal Darlington Field"},
+	{"AIE", "PG", -5.145699978, 144.7307003, "Aiome Airport"},
+	{"AIF", "BR", -22.64, -50.453056, "Marcelo Pires Halzhausen Airport"},
+	{"AIG", "CF", 6.519999981, 23.26000023, "Yalinga Airport"},
+	{"AIH", "PG", -7.342777778, 141.2675, "Aiambak Airport"},
+	{"AII", "DJ", 11.14688889, 42.72, "Ali-Sabieh Airport"},
+	{"AIK", "US", 33.6493988, -81.68499756, "Aiken Municipal Airport"},
+	{"AIL", "PA", 9.21366024, -78.02519989, "Alligandi Airport"},
+	{"AIM", "MH", 10.21679974, 169.9830017, "Ailuk Airport"},
+	{"AIN", "US", 70.63800049, -159.9949951, "Wainwright Airport"},
+	{"AIO", "US", 41.40729904, -95.04689789, "Atlantic Municipal Airport"},
+	{"AIP", "MH", 7.279421592, 168.8257, "Ailinglaplap Airport"},
+	{"AIR", "BR", -10.25027752, -59.38388824, "Aripuanã Airport"},
+	{"AIS", "KI", -2.616110086, 176.8029938, "Arorae Island Airport"},
+	{"AIT", "CK", -18.83090019, -159.7640076, "Aitutaki Airport"},
+	{"AIU", "CK", -19.96780014, -158.1190033, "Enua Airport"},
+	{"AIV", "US", 33.10649872, -88.19779968, "George Downer Airport"},
+	{"AIZ", "US", 38.09600067, -92.54949951, "Lee C Fine Memorial Airport"},
+	{"AJA", "FR", 41.92359924, 8.802920341, "Ajaccio-Napoléon Bonaparte Airport"},
+	{"AJF", "SA", 29.78510094, 40.09999847, "Al-Jawf Domestic Airport"},
+	{"AJI", "TR", 39.65454102, 43.02597809, "Ağrı Airport"},
+	{"AJJ", "MR", 19.73301697, -14.38324928, "Akjoujt Airport"},
+	{"AJK", "IR", 34.13809967, 49.84730148, "Arak Airport"},
+	{"AJL", "IN", 23.84059906, 92.61969757, "Lengpui Airport"},
+	{"AJN", "KM", -12.13169956, 44.43030167, "Ouani Airport"},
+	{"AJR", "SE", 65.59030151, 19.28190041, "Arvidsjaur Airport"},
+	{"AJS", "MX", 26.72719955, -113.5589981, "Abreojos Airport"},
+	{"AJU", "BR", -10.98400021, -37.07030106, "Santa Maria Airport"},
+	{"AJY", "NE", 16.9659996, 8.000109673, "Mano Dayak International Airport"},
+	{"AKA", "CN", 32.70809937, 108.9309998, "Ankang Airport"},
+	{"AKB", "US", 52.22029877, -174.2059937, "Atka Airport"},
+	{"AKC", "US", 41.03749847, -81.46690369, "Akron Fulton International Airport"},
+	{"AKD", "IN", 20.6989994, 77.05860138, "Akola Airport"},
+	{"AKE", "GA", -1.139670014, 13.90359974, "Akieni Airport"},
+	{"AKF", "LY", 24.17869949, 23.31399918, "Kufra Airport"},
+	{"AKG", "PG", -3.592803001, 142.2545166, "Anguganak Airport"},
+	{"AKH", "SA", 24.06270027, 47.58050156, "Prince Sultan Air Base"},
+	{"AKI", "US", 60.9029007, -161.2310028, "Akiak Airport"},
+	{"AKJ", "JP", 43.67079926, 142.4470062, "Asahikawa Airport"},
+	{"AKK", "US", 56.93870163, -154.1829987, "Akhiok Airport"},
+	{"AKL", "NZ", -37.0080986, 174.7920074, "Auckland International Airport"},
+	{"AKM", "TD", 10.89027778, 19.81722222, "Zakuoma Airport"},
+	{"AKN", "US", 58.67679977, -156.6490021, "King Salmon Airport"},
+	{"AKO", "US", 40.17559814, -103.2220001, "Colorado Plains Regional Airport"},
+	{"AKP", "US", 68.13359833, -151.7429962, "Anaktuvuk Pass Airport"},
+	{"AKQ", "ID", -4.611139774, 105.2320023, "Gunung Batin Airport"},
+	{"AKR", "NG", 7.246739864, 5.301010132, "Akure Airport"},
+	{"AKS", "SB", -8.702569962, 160.6820068, "Auki Airport"},
+	{"AKT", "CY", 34.5904007, 32.98789978, "RAF Akrotiri"},
+	{"AKU", "CN", 41.26250076, 80.29170227, "Aksu Airport"},
+	{"AKV", "CA", 60.8185997, -78.14859772, "Akulivik Airport"},
+	{"AKW", "IR", 30.74440002, 49.67720032, "Aghajari Airport"},
+	{"AKX", "KZ", 50.24580002, 57.20669937, "Aktobe Airport"},
+	{"AKY", "MM", 20.13269997, 92.87259674, "Sittwe Airport"},
+	{"ALA", "KZ", 43.35210037, 77.04049683, "Almaty Airport"},
+	{"ALB", "US", 42.74829865, -73.80169678, "Albany International Airport"},
+	{"ALC", "ES", 38.28219986, -0.558156013, "Alicante International Airport"},
+	{"ALD", "PE", -11.68299961, -69.33300018, "Alerta Airport"},
+	{"ALE", "US", 30.38419914, -103.6839981, "Alpine Casparis Municipal Airport"},
+	{"ALF", "NO", 69.97609711, 23.37170029, "Alta Airport"},
+	{"ALG", "DZ", 36.69100189, 3.215409994, "Houari Boumediene Airport"},
+	{"ALH", "AU", -34.94329834, 117.8089981, "Albany Airport"},
+	{"ALI", "US", 27.74090004, -98.02690125, "Alice International Airport"},
+	{"ALJ", "ZA", -28.57500076, 16.5333004, "Alexander Bay Airport"},
+	{"ALL", "IT", 44.05059814, 8.127429962, "Villanova D\"Albenga International Airport"},
+	{"ALM", "US", 32.83990097, -105.9909973, "Alamogordo White Sands Regional Airport"},
+	{"ALN", "US", 38.89030075, -90.04599762, "St Louis Regional Airport"},
+	{"ALO", "US", 42.55709839, -92.40029907, "Waterloo Regional Airport"},
+	{"ALP", "SY", 36.18069839, 37.22439957, "Aleppo International Airport"},
+	{"ALQ", "BR", -29.81270027, -55.89339828, "Alegrete Novo Airport"},
+	{"ALR", "NZ", -45.21170044, 169.3730011, "Alexandra Airport"},
+	{"ALS", "US", 37.43489838, -105.8669968, "San Luis Valley Regional Bergman Field"},
+	{"ALT", "BR", -1.917, -54.7231, "Alenquer Airport"},
+	{"ALU", "SO", 11.9582, 50.748, "Alula Airport"},
+	{"ALW", "US", 46.09489822, -118.288002, "Walla Walla Regional Airport"},
+	{"ALX", "US", 32.91469955, -85.96299744, "Thomas C Russell Field"},
+	{"ALY", "EG", 31.18390083, 29.94890022, "El Nouzha Airport"},
+	{"AMA", "US", 35.2193985, -101.7060013, "Rick Husband Amarillo International Airport"},
+	{"AMB", "MG", -13.18840027, 48.98799896, "Ambilobe Airport"},
+	{"AMC", "TD", 11.0340004, 20.27400017, "Am Timan Airport"},
+	{"AMD", "IN", 23.07719994, 72.63469696, "Sardar Vallabhbhai Patel International Airport"},
+	{"AME", "MZ", -15.61027778, 37.68138889, "Alto Molocue Airport"},
+	{"AMF", "PG", -4.101111111, 141.67, "Ama Airport"},
+	{"AMH", "ET", 6.039390087, 37.59049988, "Arba Minch Airport"},
+	{"AMI", "ID", -8.560709953, 116.0950012, "Selaparang Airport"},
+	{"AMJ", "BR", -16.18389, -40.66722, "Cirilo Queiróz Airport"},
+	{"AMK", "US", 37.20320129, -107.8690033, "Animas Air Park"},
+	{"AML", "PA", 8.268059731, -82.86489868, "Puerto Armuelles Airport"},
+	{"AMM", "JO", 31.72260094, 35.99319839, "Queen Alia International Airport"},
+	{"AMN", "US", 43.32210159, -84.68800354, "Gratiot Community Airport"},
+	{"AMO", "TD", 14.14560032, 15.31439972, "Mao Airport"},
+	{"AMP", "MG", -24.6996994, 44.73419952, "Ampanihy Airport"},
+	{"AMQ", "ID", -3.710259914, 128.0890045, "Pattimura Airport, Ambon"},
+	{"AMS", "NL", 52.30860138, 4.76388979, "Amsterdam Airport Schiphol"},
+	{"AMT", "AU", -26.10829926, 131.2070007, "Amata Airport"},
+	{"AMU", "PG", -3.586, 141.2143333, "Amanab Airport"},
+	{"AMV", "RU", 69.76329803, 61.5564003, "Amderma Airport"},
+	{"AMW", "US", 41.99200058, -93.62180328, "Ames Municipal Airport"},
+	{"AMX", "AU", -21.73830032, 135.2420044, "Ammaroo Airport"},
+	{"AMY", "MG", -17.68666667, 45.62388889, "Ambatomainty Airport"},
+	{"AMZ", "NZ", -37.02970123, 174.9730072, "Ardmore Airport"},
+	{"ANB", "US", 33.58819962, -85.85810089, "Anniston Metropolitan Airport"},
+	{"ANC", "US", 61.17440033, -149.9960022, "Ted Stevens Anchorage International Airport"},
+	{"AND", "US", 34.49459839, -82.70939636, "Anderson Regional Airport"},
+	{"ANE", "FR", 47.56029892, -0.312222004, "Angers-Loire Airport"},
+	{"ANF", "CL", -23.44449997, -70.44509888, "Cerro Moreno Airport"},
+	{"ANG", "FR", 45.72919846, 0.221456006, "Angoulême-Brie-Champniers Airport"},
+	{"ANI", "US", 61.58160019, -159.5429993, "Aniak Airport"},
+	{"ANJ", "CG", -2.849999905, 13.81700039, "Zanaga Airport"},
+	{"ANK", "TR", 39.94979858, 32.68859863, "Etimesgut Air Base"},
+	{"ANL", "AO", -11.47229958, 16.71089935, "Andulo Airport"},
+	{"ANM", "MG", -14.99940014, 50.32020187, "Antsirabato Airport"},
+	{"ANN", "US", 55.04240036, -131.5720062, "Annette Island Airport"},
+	{"ANO", "MZ", -16.18186951, 39.94521713, "Angoche Airport"},
+	{"ANP", "US", 38.94290161, -76.56839752, "Lee Airport"},
+	{"ANQ", "US", 41.63970184, -85.08350372, "Tri State Steuben County Airport"},
+	{"ANR", "BE", 51.18939972, 4.460279942, "Antwerp International Airport (Deurne)"},
+	{"ANS", "PE", -13.70639992, -73.35040283, "Andahuaylas Airport"},
+	{"ANU", "AG", 17.1367, -61.792702, "V.C. Bird International Airport"},
+	{"ANV", "US", 62.64670181, -160.1909943, "Anvik Airport"},
+	{"ANW", "US", 42.57920074, -99.99299622, "Ainsworth Municipal Airport"},
+	{"ANX", "NO", 69.29250336, 16.14419937, "Andøya Airport"},
+	{"ANY", "US", 37.15850067, -98.07959747, "Anthony Municipal Airport"},
+	{"ANZ", "AU", -25.0325, 132.2748, "Angus Downs Airport"},
+	{"AOB", "PG", -4.904166667, 144.6358333, "Annanberg Airport"},
+	{"AOC", "DE", 50.98194504, 12.50638866, "Altenburg-Nobitz Airport"},
+	{"AOD", "TD", 11.4773, 19.2874, "Abou-Deïa Airport"},
+	{"AOE", "TR", 39.80989838, 30.51939964, "Anadolu University Airport"},
+	{"AOG", "CN", 41.1053009, 122.8539963, "Anshan Air Base"},
+	{"AOH", "US", 40.70690155, -84.02670288, "Lima Allen County Airport"},
+	{"AOI", "IT", 43.61629868, 13.36229992, "Ancona Falconara Airport"},
+	{"AOJ", "JP", 40.73469925, 140.6909943, "Aomori Airport"},
+	{"AOK", "GR", 35.42139816, 27.14599991, "Karpathos Airport"},
+	{"AOL", "AR", -29.6894, -57.1521, "Paso De Los Libres Airport"},
+	{"AOM", "OM", 22.49194444, 57.38388889, "Adam Airport"},
+	{"AOO", "US", 40.29639816, -78.31999969, "Altoona Blair County Airport"},
+	{"AOP", "PE", -2.796129942, -76.46659851, "Alferez FAP Alfredo Vladimir Sara Bauer Airport"},
+	{"AOR", "MY", 6.189670086, 100.3980026, "Sultan Abdul Halim Airport"},
+	{"AOT", "IT", 45.73849869, 7.368720055, "Aosta Airport"},
+	{"AOU", "LA", 14.81471536, 106.8216991, "Attopeu Airport"},
+	{"AOY", "IR", 27.48139954, 52.6155014, "Asaloyeh Airport"},
+	{"APA", "US", 39.57009888, -104.848999, "Centennial Airport"},
+	{"APB", "BO", -14.73560047, -68.41190338, "Apolo Airport"},
+	{"APC", "US", 38.21319962, -122.2809982, "Napa County Airport"},
+	{"APE", "PE", -15.35389996, -75.167099, "San Juan Aposento Airport"},
+	{"APF", "US", 26.15259933, -81.77529907, "Naples Municipal Airport"},
+	{"APG", "US", 39.46620178, -76.16880035, "Phillips Army Air Field"},
+	{"APH", "US", 38.06890106, -77.31829834, "A P Hill Aaf (Fort A P Hill) Airport"},
+	{"API", "CO", 4.06889, -73.051903, "Apiay Airport"},
+	{"APK", "PF", -15.57359982, -146.4149933, "Apataki Airport"},
+	{"APL", "MZ", -15.10560036, 39.28179932, "Nampula Airport"},
+	{"APN", "US", 45.0780983, -83.56030273, "Alpena County Regional Airport"},
+	{"APO", "CO", 7.033, -77.199997, "Apartadó Airport"},
+	{"APP", "PG", -8.979166667, 148.1036111, "Asapa Airport"},
+	{"APQ", "BR", -9.775360107, -36.62919998, "Arapiraca Airport"},
+	{"APR", "PG", -4.676666667, 142.5401389, "April River Airport"},
+	{"APS", "BR", -16.22920036, -48.96429825, "Base Aérea Airport"},
+	{"APT", "US", 35.06069946, -85.58529663, "Marion County Brown Field"},
+	{"APU", "BR", -23.60950089, -51.3844986, "Apucarana Airport"},
+	{"APV", "US", 34.57529831, -117.185997, "Apple Valley Airport"},
+	{"APW", "WS", -13.82999992, -172.0079956, "Faleolo International Airport"},
+	{"APX", "BR", -23.35289955, -51.49169922, "Arapongas Airport"},
+	{"APY", "BR", -9.083610535, -45.95055771, "Alto Parnaíba Airport"},
+	{"APZ", "AR", -38.9754982, -70.11360168, "Zapala Airport"},
+	{"AQA", "BR", -21.81200027, -48.13299942, "Araraquara Airport"},
+	{"AQB", "GT", 15.01220036, -91.15059662, "Santa Cruz del Quiche Airport"},
+	{"AQG", "CN", 30.5821991, 117.0500031, "Anqing Airport"},
+	{"AQI", "SA", 28.33519936, 46.12509918, "Qaisumah Domestic Airport"},
+	{"AQJ", "JO", 29.61160088, 35.01810074, "Aqaba King Hussein International Airport"},
+	{"AQM", "BR", -10.17805576, -62.82555771, "Nova Vida Airport"},
+	{"AQP", "PE", -16.34110069, -71.58309937, "Rodríguez Ballón International Airport"},
+	{"AQY", "US", 60.96609879, -149.1260071, "Girdwood Airport"},
+	{"ARA", "US", 30.03779984, -91.8839035, "Acadiana Regional Airport"},
+	{"ARB", "US", 42.22299957, -83.74559784, "Ann Arbor Municipal Airport"},
+	{"ARC", "US", 68.11470032, -145.5789948, "Arctic Village Airport"},
+	{"ARD", "ID", -8.132340431, 124.5970001, "Mali Airport"},
+	{"ARE", "PR", 18.45000076, -66.6753006, "Antonio Nery Juarbe Pol Airport"},
+	{"ARF", "CO", 0.533333, -70.133333, "Acaricuara Airport"},
+	{"ARG", "US", 36.1246667, -90.9251111, "Walnut Ridge Regional Airport"},
+	{"ARH", "RU", 64.60030365, 40.71670151, "Talagi Airport"},
+	{"ARI", "CL", -18.3484993, -70.33869934, "Chacalluta Airport"},
+	{"ARJ", "ID", -2.93333292, 140.7833405, "Arso Airport"},
+	{"ARK", "TZ", -3.367789984, 36.63330078, "Arusha Airport"},
+	{"ARL", "BF", 11.59700012, 1.48300004, "Arly Airport"},
+	{"ARM", "AU", -30.52809906, 151.6170044, "Armidale Airport"},
+	{"ARN", "SE", 59.65190125, 17.91860008, "Stockholm-Arlanda Airport"},
+	{"ARO", "CO", 8.85705, -76.4244, "Arboletes Airport"},
+	{"ARP", "PG", -9.883333333, 149.4833333, "Aragip Airport"},
+	{"ARQ", "CO", 7.02106, -71.388901, "El Troncal Airport"},
+	{"ARR", "AR", -45.0135994, -70.81220245, "D. Casimiro Szlapelis Airport"},
+	{"ARS", "BR", -15.89939976, -52.24110031, "Aragarças Airport"},
+	{"ART", "US", 43.9919014, -76.021698, "Watertown International Airport"},
+	{"ARU", "BR", -21.1413002, -50.42470169, "Araçatuba Airport"},
+	{"ARV", "US", 45.92789841, -89.73090363, "Lakeland-Noble F. Lee Memorial field"},
+	{"ARW", "RO", 46.17660141, 21.26199913, "Arad International Airport"},
+	{"ARY", "AU", -37.30939865, 142.9889984, "Ararat Airport"},
+	{"ARZ", "AO", -7.259439945, 12.86310005, "N\"zeto Airport"},
+	{"ASA", "ER", 13.07180023, 42.64500046, "Assab International Airport"},
+	{"ASB", "TM", 37.98680115, 58.36100006, "Ashgabat Airport"},
+	{"ASC", "BO", -15.93029976, -63.15670013, "Ascención De Guarayos Airport"},
+	{"ASD", "BS", 24.69790077, -77.79560089, "Andros Town Airport"},
+	{"ASE", "US", 39.22320175, -106.8690033, "Aspen-Pitkin Co/Sardy Field"},
+	{"ASF", "RU", 46.28329849, 48.00630188, "Astrakhan Airport"},
+	{"ASG", "NZ", -43.90330124, 171.7969971, "Ashburton Aerodrome"},
+	{"ASH", "US", 42.78170013, -71.51480103, "Boire Field"},
+	{"ASI", "SH", -7.969600201, -14.39369965, "RAF Ascension Island"},
+	{"ASJ", "JP", 28.43059921, 129.7129974, "Amami Airport"},
+	{"ASK", "CI", 6.903170109, -5.365580082, "Yamoussoukro Airport"},
+	{"ASL", "US", 32.52050018, -94.30780029, "Harrison County Airport"},
+	{"ASM", "ER", 15.29189968, 38.91070175, "Asmara International Airport"},
+	{"ASN", "US", 33.56990051, -86.05090332, "Talladega Municipal Airport"},
+	{"ASO", "ET", 10.01850033, 34.5862999, "Asosa Airport"},
+	{"ASP", "AU", -23.80669975, 133.9019928, "Alice Springs Airport"},
+	{"ASQ", "US", 39.4679985, -117.1949997, "Austin Airport"},
+	{"ASR", "TR", 38.770401, 35.49539948, "Kayseri Erkilet Airport"},
+	{"AST", "US", 46.15800095, -123.8789978, "Astoria Regional Airport"},
+	{"ASU", "PY", -25.23999977, -57.52000046, "Silvio Pettirossi International Airport"},
+	{"ASV", "KE", -2.645050049, 37.25310135, "Amboseli Airport"},
+	{"ASW", "EG", 23.96439934, 32.81999969, "Aswan International Airport"},
+	{"ASX", "US", 46.54850006, -90.91899872, "John F Kennedy Memorial Airport"},
+	{"ASY", "US", 46.02389908, -99.3526001, "Ashley Municipal Airport"},
+	{"ASZ", "PG", -6.009722222, 150.3686111, "Asirim Airport"},
+	{"ATA", "PE", -9.347439766, -77.5983963, "Comandante FAP German Arias Graziani Airport"},
+	{"ATB", "SD", 17.71034431, 34.05701828, "Atbara Airport"},
+	{"ATC", "BS", 24.62940025, -75.67379761, "Arthur\"s Town Airport"},
+	{"ATD", "SB", -8.873330116, 161.0110016, "Uru Harbour Airport"},
+	{"ATE", "US", 34.19260025, -95.64990234, "Antlers Municipal Airport"},
+	{"ATF", "EC", -1.212069988, -78.57460022, "Chachoán Airport"},
+	{"ATG", "PK", 33.86909866, 72.40090179, "Minhas Air Base"},
+	{"ATH", "GR", 37.93640137, 23.94449997, "Eleftherios Venizelos International Airport"},
+	{"ATI", "UY", -30.40069962, -56.50790024, "Artigas International Airport"},
+	{"ATJ", "MG", -19.83922148, 47.06371307, "Antsirabe Airport"},
+	{"ATK", "US", 70.46730042, -157.4360046, "Atqasuk Edward Burnell Sr Memorial Airport"},
+	{"ATL", "US", 33.63669968, -84.42810059, "Hartsfield Jackson Atlanta International Airport"},
+	{"ATM", "BR", -3.253910065, -52.25400162, "Altamira Airport"},
+	{"ATN", "PG", -3.6695, 152.438, "Namatanai Airport"},
+	{"ATO", "US", 39.21099854, -82.23139954, "Ohio University Snyder Field"},
+	{"ATP", "PG", -3.143611111, 142.3468056, "Aitape Airport"},
+	{"ATQ", "IN", 31.70960045, 74.79730225, "Sri Guru Ram Dass Jee International Airport"},
+	{"ATR", "MR", 20.5067997, -13.04319954, "Atar International Airport"},
+	{"ATS", "US", 32.85250092, -104.4680023, "Artesia Municipal Airport"},
+	{"ATT", "US", 60.86669922, -162.272995, "Atmautluak Airport"},
+	{"ATV", "TD", 13.23890018, 18.31329918, "Ati Airport"},
+	{"ATW", "US", 44.2580986, -88.51909637, "Outagamie County Regional Airport"},
+	{"ATX", "KZ", 51.85169983, 68.36329651, "Atbasar Airport"},
+	{"ATY", "US", 44.91400146, -97.15470123, "Watertown Regional Airport"},
+	{"ATZ", "EG", 27.04649925, 31.01199913, "Assiut International Airport"},
+	{"AUA", "AW", 12.50139999, -70.01519775, "Queen Beatrix International Airport"},
+	{"AUC", "CO", 7.06888, -70.7369, "Santiago Perez Airport"},
+	{"AUD", "AU", -18.51499939, 139.878006, "Augustus Downs Airport"},
+	{"AUE", "EG", 28.89900017, 33.20249939, "Abu Rudeis Airport"},
+	{"AUF", "FR", 47.85020065, 3.49710989, "Auxerre-Branches Airport"},
+	{"AUG", "US", 44.3205986, -69.79730225, "Augusta State Airport"},
+	{"AUH", "AE", 24.43300056, 54.65110016, "Abu Dhabi International Airport"},
+	{"AUI", "PG", -1.460555556, 143.0647222, "Aua Island Airport"},
+	{"AUJ", "PG", -4.215666667, 142.8231667, "Ambunti Airport"},
+	{"AUK", "US", 62.68004227, -164.6599274, "Alakanuk Airport"},
+	{"AUL", "MH", 8.145279884, 171.1730042, "Aur Island Airport"},
+	{"AUM", "US", 43.66500092, -92.93340302, "Austin Municipal Airport"},
+	{"AUN", "US", 38.95479965, -121.0820007, "Auburn Municipal Airport"},
+	{"AUO", "US", 32.61510086, -85.43399811, "Auburn Opelika Robert G. Pitts Airport"},
+	{"AUP", "PG", -9.930833333, 149.3856667, "Agaun Airport"},
+	{"AUQ", "PF", -9.768790245, -139.0110016, "Hiva Oa-Atuona Airport"},
+	{"AUR", "FR", 44.89139938, 2.421940088, "Aurillac Airport"},
+	{"AUS", "US", 30.19449997, -97.66989899, "Austin Bergstrom International Airport"},
+	{"AUT", "TL", -8.210519791, 125.6159973, "Atauro Airport"},
+	{"AUU", "AU", -13.35389996, 141.720993, "Aurukun Airport"},
+	{"AUV", "PG", -5.731111111, 148.4452778, "Aumo Airport"},
+	{"AUW", "US", 44.92620087, -89.62660217, "Wausau Downtown Airport"},
+	{"AUX", "BR", -7.227869987, -48.2405014, "Araguaína Airport"},
+	{"AUY", "VU", -20.24920082, 169.7709961, "Aneityum Airport"},
+	{"AUZ", "US", 41.77190018, -88.47570038, "Aurora Municipal Airport"},
+	{"AVA", "CN", 26.26055556, 105.8733333, "Anshun Huangguoshu Airport"},
+	{"AVB", "IT", 46.0318985, 12.5965004, "Aviano Air Base"},
+	{"AVG", "AU", -15.69999981, 130.0, "Auvergne Airport"},
+	{"AVI", "CU", 22.02709961, -78.78959656, "Maximo Gomez Airport"},
+	{"AVK", "MN", 46.25030136, 102.802002, "Arvaikheer Airport"},
+	{"AVL", "US", 35.43619919, -82.54180145, "Asheville Regional Airport"},
+	{"AVN", "FR", 43.90729904, 4.901830196, "Avignon-Caumont Airport"},
+	{"AVO", "US", 27.59119987, -81.52780151, "Avon Park Executive Airport"},
+	{"AVP", "US", 41.33850098, -75.7233963, "Wilkes Barre Scranton International Airport"},
+	{"AVU", "SB", -9.868333333, 160.4105556, "Avu Avu Airport"},
+	{"AVV", "AU", -38.03939819, 144.4689941, "Avalon Airport"},
+	{"AVW", "US", 32.4095993, -111.2180023, "Marana Regional Airport"},
+	{"AWA", "ET", 7.066999912, 38.5, "Awassa Airport"},
+	{"AWB", "PG", -8.006110191, 142.7483521, "Awaba Airport"},
+	{"AWD", "VU", -19.2346, 169.6009, "Aniwa Airport"},
+	{"AWE", "GA", -0.538055556, 9.450277778, "Alowe Airport"},
+	{"AWK", "UM", 19.28210068, 166.6360016, "Wake Island Airfield"},
+	{"AWM", "US", 35.13510132, -90.23439789, "West Memphis Municipal Airport"},
+	{"AWN", "AU", -26.5333004, 139.2669983, "Alton Downs Airport"},
+	{"AWP", "AU", -20.5, 137.75, "Austral Downs Airport"},
+	{"AWZ", "IR", 31.33740044, 48.76200104, "Ahwaz Airport"},
+	{"AXA", "AI", 18.20479965, -63.05509949, "Wallblake Airport"},
+	{"AXC", "AU", -22.9666996, 145.2420044, "Aramac Airport"},
+	{"AXD", "GR", 40.85589981, 25.95630074, "Dimokritos Airport"},
+	{"AXE", "BR", -26.87555695, -52.3730545, "Xanxerê Airport"},
+	{"AXG", "US", 43.07789993, -94.27200317, "Algona Municipal Airport"},
+	{"AXK", "YE", 14.55130005, 46.82619858, "Ataq Airport"},
+	{"AXL", "AU", -19.06019974, 136.7100067, "Alexandria Homestead Airport"},
+	{"AXM", "CO", 4.45278, -75.7664, "El Eden Airport"},
+	{"AXN", "US", 45.86629868, -95.3946991, "Chandler Field"},
+	{"AXP", "BS", 22.44179916, -73.97090149, "Spring Point Airport"},
+	{"AXR", "PF", -15.2482996, -146.6170044, "Arutua Airport"},
+	{"AXS", "US", 34.69639969, -99.34030151, "Altus Quartz Mountain Regional Airport"},
+	{"AXT", "JP", 39.61560059, 140.2189941, "Akita Airport"},
+	{"AXU", "ET", 14.14680004, 38.77280045, "Axum Airport"},
+	{"AXV", "US", 40.49340057, -84.29889679, "Neil Armstrong Airport"},
+	{"AXX", "US", 36.42200089, -105.2900009, "Angel Fire Airport"},
+	{"AYA", "CO", 8.3, -75.15, "Ayapel Airport"},
+	{"AYC", "CO", 8.6, -73.616667, "Ayacucho Airport"},
+	{"AYD", "AU", -19.29080009, 136.0789948, "Alroy Downs Airport"},
+	{"AYG", "CO", 1.54417, -73.9333, "Yaguara Airport"},
+	{"AYI", "CO", -0.383333, -72.266667, "Yari Airport"},
+	{"AYK", "KZ", 50.3185997, 66.95279694, "Arkalyk North Airport"},
+	{"AYL", "AU", -18.01807903, 135.5350685, "Anthony Lagoon Airport"},
+	{"AYN", "CN", 36.13389969, 114.3440018, "Anyang Airport"},
+	{"AYO", "PY", -27.37000084, -56.84999847, "Juan De Ayolas Airport"},
+	{"AYP", "PE", -13.15480042, -74.20439911, "Coronel FAP Alfredo Mendivil Duarte Airport"},
+	{"AYQ", "AU", -25.18610001, 130.9759979, "Ayers Rock Connellan Airport"},
+	{"AYR", "AU", -19.58440018, 147.3289948, "Ayr Airport"},
+	{"AYS", "US", 31.24909973, -82.39550018, "Waycross Ware County Airport"},
+	{"AYT", "TR", 36.89870071, 30.80050087, "Antalya International Airport"},
+	{"AYU", "PG", -6.338055556, 145.9041667, "Aiyura Airport"},
+	{"AYW", "ID", -1.1593, 132.4633, "Ayawasi Airport"},
+	{"AZA", "US", 33.30780029, -111.6549988, "Phoenix-Mesa-Gateway Airport"},
+	{"AZB", "PG", -10.29916667, 149.3383333, "Amazon Bay Airport"},
+	{"AZD", "IR", 31.9048996, 54.2765007, "Shahid Sadooghi Airport"},
+	{"AZG", "MX", 19.09339905, -102.3939972, "Pablo L. Sidar Airport"},
+	{"AZI", "AE", 24.42830086, 54.45809937, "Bateen Airport"},
+	{"AZL", "BR", -13.46552781, -58.86693478, "Fazenda Tucunaré Airport"},
+	{"AZN", "UZ", 40.72769928, 72.29399872, "Andizhan Airport"},
+	{"AZO", "US", 42.23490143, -85.55210114, "Kalamazoo Battle Creek International Airport"},
+	{"AZP", "MX", 19.57480049, -99.2888031, "Atizapan De Zaragoza Airport"},
+	{"AZR", "DZ", 27.83760071, -0.186414003, "Touat Cheikh Sidi Mohamed Belkebir Airport"},
+	{"AZS", "DO", 19.2670002, -69.74199677, "Samaná El Catey International Airport"},
+	{"AZT", "CO", 6.816667, -73.283333, "Zapatoca Airport"},
+	{"AZZ", "AO", -7.86221981, 13.11610031, "Ambriz Airport"},
+	{"BAA", "PG", -5.330560207, 151.0079956, "Bialla Airport"},
+	{"BAB", "US", 39.13610077, -121.4369965, "Beale Air Force Base"},
+	{"BAC", "CO", 4.583333, -72.966667, "Barranca De Upia Airport"},
+	{"BAD", "US", 32.50180054, -93.66269684, "Barksdale Air Force Base"},
+	{"BAE", "FR", 44.38830185, 6.610280037, "Barcelonnette - Saint-Pons Airport"},
+	{"BAF", "US", 42.15779877, -72.71559906, "Barnes Municipal Airport"},
+	{"BAG", "PH", 16.37509918, 120.6200027, "Loakan Airport"},
+	{"BAH", "BH", 26.27079964, 50.63359833, "Bahrain International Airport"},
+	{"BAI", "CR", 9.163610458, -83.32990265, "Buenos Aires Airport"},
+	{"BAJ", "PG", -4.883333333, 149.1333333, "Bali Airport"},
+	{"BAL", "TR", 37.92900085, 41.11660004, "Batman Airport"},
+	{"BAM", "US", 40.59899902, -116.8740005, "Battle Mountain Airport"},
+	{"BAN", "CD", -4.316999912, 20.43300056, "Basongo Airport"},
+	{"BAO", "TH", 17.38299942, 102.8000031, "Udorn Air Base"},
+	{"BAQ", "CO", 10.8896, -74.7808, "Ernesto Cortissoz International Airport"},
+	{"BAS", "SB", -6.990745, 155.886656, "Ballalae Airport"},
+	{"BAT", "BR", -20.58449936, -48.59410095, "Chafei Amsei Airport"},
+	{"BAU", "BR", -22.34499931, -49.05379868, "Bauru Airport"},
+	{"BAV", "CN", 40.56000137, 109.9970016, "Baotou Airport"},
+	{"BAX", "RU", 53.36380005, 83.53849792, "Barnaul Airport"},
+	{"BAY", "RO", 47.65840149, 23.46999931, "Tautii Magheraus Airport"},
+	{"BAZ", "BR", -0.981292009, -62.91960144, "Barcelos Airport"},
+	{"BBA", "CL", -45.91609955, -71.6894989, "Balmaceda Airport"},
+	{"BBB", "US", 45.33190155, -95.65059662, "Benson Municipal Airport"},
+	{"BBC", "US", 28.97330093, -95.8635025, "Bay City Municipal Airport"},
+	{"BBD", "US", 31.17930031, -99.32389832, "Curtis Field"},
+	{"BBE", "AU", -27.32859993, 117.6729965, "Big Bell Airport"},
+	{"BBG", "KI", 3.085829973, 172.8110046, "Butaritari Atoll Airport"},
+	{"BBH", "DE", 54.33750153, 12.69970036, "Barth Airport"},
+	{"BBI", "IN", 20.24440002, 85.81780243, "Biju Patnaik Airport"},
+	{"BBJ", "DE", 49.94527817, 6.565000057, "Bitburg Airport"},
+	{"BBK", "BW", -17.83289909, 25.16239929, "Kasane Airport"},
+	{"BBL", "IR", 36.72000122, 52.65000153, "Babolsar Airport"},
+	{"BBM", "KH", 13.09560013, 103.223999, "Battambang Airport"},
+	{"BBN", "MY", 3.733890057, 115.4789963, "Bario Airport"},
+	{"BBO", "SO", 10.38920021, 44.94110107, "Berbera Airport"},
+	{"BBP", "GB", 50.67810059, -1.109439969, "Bembridge Airport"},
+	{"BBQ", "AG", 17.635799, -61.828602, "Codrington Airport"},
+	{"BBR", "GP", 16.01329994, -61.74219894, "Baillif Airport"},
+	{"BBS", "GB", 51.32389832, -0.847500026, "Blackbushe Airport"},
+	{"BBT", "CF", 4.221580029, 15.78639984, "Berbérati Airport"},
+	{"BBU", "RO", 44.50320053, 26.10210037, "Băneasa International Airport"},
+	{"BBV", "CI", 4.643413067, -6.923961639, "Nero-Mer Airport"},
+	{"BBW", "US", 41.43650055, -99.64219666, "Broken Bow Municipal Airport"},
+	{"BBX", "US", 40.13750076, -75.26509857, "Wings Field"},
+	{"BBY", "CF", 5.846940041, 20.64749908, "Bambari Airport"},
+	{"BBZ", "ZM", -13.53610039, 23.10849953, "Zambezi Airport"},
+	{"BCA", "CU", 20.36529922, -74.5062027, "Gustavo Rizo Airport"},
+	{"BCB", "US", 37.20759964, -80.40779877, "Virginia Tech Montgomery Executive Airport"},
+	{"BCC", "US", 63.57331604, -156.1494541, "Bear Creek 3 Airport"},
+	{"BCD", "PH", 10.77639961, 123.0149994, "Bacolod-Silay City International Airport"},
+	{"BCE", "US", 37.70640183, -112.1449966, "Bryce Canyon Airport"},
+	{"BCF", "CF", 6.517000198, 18.2670002, "Bouca Airport"},
+	{"BCG", "GY", 7.699999809, -59.16666031, "Bemichi Airport"},
+	{"BCH", "TL", -8.489029884, 126.401001, "Cakung Airport"},
+	{"BCI", "AU", -23.56529999, 145.3070068, "Barcaldine Airport"},
+	{"BCK", "AU", -17.38829994, 144.1690063, "Bolwarra Airport"},
+	{"BCL", "CR", 10.76869965, -83.58560181, "Barra del Colorado Airport"},
+	{"BCM", "RO", 46.52190018, 26.9102993, "Bacău Airport"},
+	{"BCN", "ES", 41.29710007, 2.078459978, "Barcelona International Airport"},
+	{"BCO", "ET", 5.782869816, 36.56200027, "Baco Airport"},
+	{"BCP", "PG", -5.863611111, 146.4925, "Bambu Airport"},
+	{"BCQ", "LY", 27.65299988, 14.27200031, "Brack Airport"},
+	{"BCR", "BR", -8.834560394, -67.31240082, "Novo Campo Airport"},
+	{"BCS", "US", 29.86610031, -90.02220154, "Southern Seaplane Airport"},
+	{"BCT", "US", 26.37849998, -80.10769653, "Boca Raton Airport"},
+	{"BCU", "NG", 10.29440022, 9.816670418, "Bauchi Airport"},
+	{"BCV", "BZ", 17.26959991, -88.77649689, "Belmopan Airport"},
+	{"BCW", "MZ", -21.85330009, 35.43830109, "Benguera Island Airport"},
+	{"BCX", "RU", 53.93809891, 58.34000015, "Beloretsk Airport"},
+	{"BCZ", "AU", -13.78083333, 136.2016667, "Milyakburra Airport"},
+	{"BDA", "BM", 32.36399841, -64.67870331, "L.F. Wade International International Airport"},
+	{"BDB", "AU", -24.90390015, 152.3190002, "Bundaberg Airport"},
+	{"BDC", "BR", -5.5025, -45.215833, "Barra do Corda Airport"},
+	{"BDD", "AU", -10.14999962, 142.1734, "Badu Island Airport"},
+	{"BDE", "US", 48.72840118, -94.61219788, "Baudette International Airport"},
+	{"BDF", "US", 41.23089981, -89.61569977, "Rinkenberger Restricted Landing Area"},
+	{"BDG", "US", 37.58330154, -109.4830017, "Blanding Municipal Airport"},
+	{"BDH", "IR", 26.53199959, 54.82479858, "Bandar Lengeh Airport"},
+	{"BDI", "SC", -3.72472, 55.205299, "Bird Island Airport"},
+	{"BDJ", "ID", -3.442359924, 114.7630005, "Syamsudin Noor Airport"},
+	{"BDK", "CI", 8.017219543, -2.761940002, "Soko Airport"},
+	{"BDL", "US", 41.93889999, -72.68319702, "Bradley International Airport"},
+	{"BDM", "TR", 40.31800079, 27.97769928, "Bandırma Airport"},
+	{"BDN", "PK", 24.84149933, 68.83840179, "Talhar Airport"},
+	{"BDO", "ID", -6.900629997, 107.5759964, "Husein Sastranegara International Airport"},
+	{"BDP", "NP", 26.57080078, 88.07959747, "Bhadrapur Airport"},
+	{"BDQ", "IN", 22.33620071, 73.2263031, "Vadodara Airport"},
+	{"BDR", "US", 41.16350174, -73.12619781, "Igor I Sikorsky Memorial Airport"},
+	{"BDS", "IT", 40.6576004, 17.9470005, "Brindisi – Salento Airport"},
+	{"BDT", "CD", 4.253210068, 20.97529984, "Gbadolite Airport"},
+	{"BDU", "NO", 69.05580139, 18.54039955, "Bardufoss Airport"},
+	{"BDV", "CD", -7.066999912, 29.78300095, "Moba Airport"},
+	{"BDW", "AU", -17.2866993, 127.4629974, "Bedford Downs Airport"},
+	{"BDX", "US", 45.47249985, -105.4540024, "Broadus Airport"},
+	{"BDY", "US", 43.08649826, -124.4079971, "Bandon State Airport"},
+	{"BDZ", "PG", -6.347222222, 146.9422222, "Baindoung Airport"},
+	{"BEB", "GB", 57.48109818, -7.362780094, "Benbecula Airport"},
+	{"BEC", "US", 37.69449997, -97.21499634, "Beech Factory Airport"},
+	{"BED", "US", 42.47000122, -71.28900146, "Laurence G Hanscom Field"},
+	{"BEE", "AU", -17.0165, 122.6464, "Beagle Bay Airport"},
+	{"BEF", "NI", 11.99100018, -83.77410126, "Bluefields Airport"},
+	{"BEG", "RS", 44.81840134, 20.3090992, "Belgrade Nikola Tesla Airport"},
+	{"BEH", "US", 42.12860107, -86.42849731, "Southwest Michigan Regional Airport"},
+	{"BEI", "ET", 9.386389732, 34.52190018, "Beica Airport"},
+	{"BEJ", "ID", 2.155499935, 117.4319992, "Barau(Kalimaru) Airport"},
+	{"BEK", "IN", 28.42210007, 79.45079803, "Bareilly Air Force Station"},
+	{"BEL", "BR", -1.37925005, -48.47629929, "Val de Cans/Júlio Cezar Ribeiro International Airport"},
+	{"BEM", "CF", 5.267000198, 17.63299942, "Bossembélé Airport"},
+	{"BEN", "LY", 32.09680176, 20.26950073, "Benina International Airport"},
+	{"BEO", "AU", -33.06669998, 151.647995, "Aeropelican Airport"},
+	{"BEP", "IN", 15.16279984, 76.88279724, "Bellary Airport"},
+	{"BEQ", "GB", 52.34260178, 0.772939026, "RAF Honington"},
+	{"BES", "FR", 48.44789886, -4.418540001, "Brest Bretagne Airport"},
+	{"BET", "US", 60.77980042, -161.8379974, "Bethel Airport"},
+	{"BEU", "AU", -24.34609985, 139.4600067, "Bedourie Airport"},
+	{"BEV", "IL", 31.28700066, 34.72299957, "Be\"er Sheva (Teyman) Airport"},
+	{"BEW", "MZ", -19.79640007, 34.9076004, "Beira Airport"},
+	{"BEX", "GB", 51.61640167, -1.095829964, "RAF Benson"},
+	{"BEY", "LB", 33.82089996, 35.48839951, "Beirut Rafic Hariri International Airport"},
+	{"BEZ", "KI", -1.354719996, 176.0070038, "Beru Airport"},
+	{"BFA", "PY", -20.22459984, -58.17919922, "Bahía Negra Airport"},
+	{"BFC", "AU", -15.87360001, 145.3300018, "Bloomfield River Airport"},
+	{"BFD", "US", 41.80310059, -78.64009857, "Bradford Regional Airport"},
+	{"BFE", "DE", 51.96472168, 8.544444084, "Bielefeld Airport"},
+	{"BFF", "US", 41.87400055, -103.5960007, "Western Neb. Rgnl/William B. Heilig Airport"},
+	{"BFG", "US", 37.54579926, -110.7129974, "Bullfrog Basin Airport"},
+	{"BFH", "BR", -25.40509987, -49.23199844, "Bacacheri Airport"},
+	{"BFI", "US", 47.52999878, -122.302002, "Boeing Field King County International Airport"},
+	{"BFJ", "FJ", -17.53505556, 177.6809167, "Ba Airport"},
+	{"BFK", "US", 39.7016983, -104.7519989, "Buckley Air Force Base"},
+	{"BFL", "US", 35.43360138, -119.0569992, "Meadows Field"},
+	{"BFM", "US", 30.62680054, -88.06809998, "Mobile Downtown Airport"},
+	{"BFN", "ZA", -29.09269905, 26.30240059, "Bram Fischer International Airport"},
+	{"BFO", "ZW", -21.00810051, 31.57859993, "Buffalo Range Airport"},
+	{"BFP", "US", 40.77249908, -80.3914032, "Beaver County Airport"},
+	{"BFQ", "PA", 7.583000183, -78.19999695, "Bahia Piña Airport"},
+	{"BFR", "US", 38.84000015, -86.44539642, "Virgil I Grissom Municipal Airport"},
+	{"BFS", "GB", 54.65750122, -6.215829849, "Belfast International Airport"},
+	{"BFT", "US", 32.41220093, -80.63439941, "Beaufort County Airport"},
+	{"BFU", "CN", 32.84773333, 117.3202444, "Bengbu Airport"},
+	{"BFV", "TH", 15.22949982, 103.2529984, "Buri Ram Airport"},
+	{"BFW", "DZ", 35.17179871, -0.593275011, "Sidi Bel Abbes Airport"},
+	{"BFX", "CM", 5.536920071, 10.35459995, "Bafoussam Airport"},
+	{"BGA", "CO", 7.1265, -73.1848, "Palonegro Airport"},
+	{"BGB", "GA", -0.100000001, 11.94999981, "Booue Airport"},
+	{"BGC", "PT", 41.85779953, -6.707129955, "Braganca Airport"},
+	{"BGD", "US", 35.70090103, -101.3939972, "Hutchinson County Airport"},
+	{"BGE", "US", 30.9715004, -84.63739777, "Decatur County Industrial Air Park"},
+	{"BGF", "CF", 4.398479939, 18.51880074, "Bangui M\"Poko International Airport"},
+	{"BGG", "TR", 38.85926056, 40.59596252, "Bingöl Çeltiksuyu Airport"},
+	{"BGH", "MR", 16.63331413, -14.20000076, "Abbaye Airport"},
+	{"BGI", "BB", 13.07460022, -59.49250031, "Sir Grantley Adams International Airport"},
+	{"BGJ", "IS", 65.5164032, -13.80500031, "Borgarfjörður eystri Airport"},
+	{"BGK", "BZ", 16.51936913, -88.40791321, "Big Creek Airport"},
+	{"BGL", "NP", 28.21279907, 83.66629791, "Baglung Airport"},
+	{"BGM", "US", 42.20869827, -75.97979736, "Greater Binghamton/Edwin A Link field"},
+	{"BGN", "DE", 51.1996994, 6.132080078, "Brüggen Air Base"},
+	{"BGO", "NO", 60.29339981, 5.218140125, "Bergen Airport, Flesland"},
+	{"BGP", "GA", -2.1713, 10.2088, "Bongo Airport"},
+	{"BGQ", "US", 61.53609848, -149.8139954, "Big Lake Airport"},
+	{"BGR", "US", 44.80739975, -68.82810211, "Bangor International Airport"},
+	{"BGS", "US", 32.21260071, -101.5220032, "Big Spring Mc Mahon-Wrinkle Airport"},
+	{"BGT", "US", 34.59590149, -113.1699982, "Bagdad Airport"},
+	{"BGU", "CF", 4.784999847, 22.78100014, "Bangassou Airport"},
+	{"BGV", "BR", -29.1483326, -51.5363884, "Aeroclube de Bento Gonçalves Airport"},
+	{"BGW", "IQ", 33.26250076, 44.23460007, "Baghdad International Airport"},
+	{"BGX", "BR", -31.39049911, -54.11220169, "Comandante Gustavo Kraemer Airport"},
+	{"BGY", "IT", 45.6739006, 9.704170227, "Il Caravaggio International Airport"},
+	{"BGZ", "PT", 41.58710098, -8.445139885, "Braga Municipal Aerodrome"},
+	{"BHA", "EC", -0.608111024, -80.40270233, "Los Perales Airport"},
+	{"BHB", "US", 44.45000076, -68.3615036, "Hancock County-Bar Harbor Airport"},
+	{"BHC", "PK", 33.96099854, 73.45200348, "Bhurban Heliport"},
+	{"BHD", "GB", 54.61809921, -5.872499943, "George Best Belfast City Airport"},
+	{"BHE", "NZ", -41.5182991, 173.8699951, "Woodbourne Airport"},
+	{"BHF", "CO", 6.55, -77.3263, "Bahia Cupica Airport"},
+	{"BHG", "HN", 15.76309967, -84.54360199, "Brus Laguna Airport"},
+	{"BHH", "SA", 19.9843998, 42.6208992, "Bisha Airport"},
+	{"BHI", "AR", -38.725, -62.1693, "Comandante Espora Airport"},
+	{"BHJ", "IN", 23.28779984, 69.67019653, "Bhuj Airport"},
+	{"BHK", "UZ", 39.77500153, 64.48329926, "Bukhara Airport"},
+	{"BHL", "MX", 28.97859955, -113.560997, "Bahía de los Ángeles Airport"},
+	{"BHM", "US", 33.56290054, -86.75350189, "Birmingham-Shuttlesworth International Airport"},
+	{"BHN", "YE", 14.78199959, 45.7201004, "Beihan Airport"},
+	{"BHO", "IN", 23.28750038, 77.33740234, "Raja Bhoj International Airport"},
+	{"BHP", "NP", 27.1473999, 87.05079651, "Bhojpur Airport"},
+	{"BHQ", "AU", -32.00139999, 141.4720001, "Broken Hill Airport"},
+	{"BHR", "NP", 27.67810059, 84.42939758, "Bharatpur Airport"},
+	{"BHS", "AU", -33.40940094, 149.6519928, "Bathurst Airport"},
+	{"BHT", "AU", -23.36388889, 141.5627778, "Brighton Downs Airport"},
+	{"BHU", "IN", 21.75219917, 72.18520355, "Bhavnagar Airport"},
+	{"BHV", "PK", 29.34810066, 71.71800232, "Bahawalpur Airport"},
+	{"BHW", "PK", 32.05609894, 72.9484024, "Bhagatanwala Airport"},
+	{"BHX", "GB", 52.45389938, -1.748029947, "Birmingham International Airport"},
+	{"BHY", "CN", 21.5394001, 109.2939987, "Beihai Airport"},
+	{"BIA", "FR", 42.55270004, 9.483730316, "Bastia-Poretta Airport"},
+	{"BIB", "SO", 3.102220058, 43.62860107, "Baidoa Airport"},
+	{"BID", "US", 41.16809845, -71.57779694, "Block Island State Airport"},
+	{"BIE", "US", 40.30130005, -96.75409698, "Beatrice Municipal Airport"},
+	{"BIF", "US", 31.84950066, -106.3799973, "Biggs Army Air Field (Fort Bliss)"},
+	{"BIG", "US", 63.99449921, -145.7220001, "Allen Army Airfield"},
+	{"BIH", "US", 37.37310028, -118.3639984, "Eastern Sierra Regional Airport"},
+	{"BII", "MH", 11.52250004, 165.5650024, "Enyu Airfield"},
+	{"BIK", "ID", -1.190019965, 136.1080017, "Frans Kaisiepo Airport"},
+	{"BIL", "US", 45.80770111, -108.5429993, "Billings Logan International Airport"},
+	{"BIM", "BS", 25.69989967, -79.26470184, "South Bimini Airport"},
+	{"BIN", "AF", 34.81700134, 67.81700134, "Bamiyan Airport"},
+	{"BIO", "ES", 43.30110168, -2.910609961, "Bilbao Airport"},
+	{"BIP", "AU", -16.88080025, 143.4790039, "Bulimba Airport"},
+	{"BIQ", "FR", 43.46839905, -1.52331996, "Biarritz-Anglet-Bayonne Airport"},
+	{"BIR", "NP", 26.48150063, 87.26399994, "Biratnagar Airport"},
+	{"BIS", "US", 46.77270126, -100.7460022, "Bismarck Municipal Airport"},
+	{"BIT", "NP", 29.46529961, 80.54920197, "Baitadi Airport"},
+	{"BIU", "IS", 65.64129639, -23.5461998, "Bildudalur Airport"},
+	{"BIV", "CF", 6.527780056, 21.98940086, "Bria Airport"},
+	{"BIW", "AU", -19.56669998, 127.6669998, "Billiluna Airport"},
+	{"BIX", "US", 30.41040039, -88.92440033, "Keesler Air Force Base"},
+	{"BIY", "ZA", -32.89709854, 27.27910042, "Bisho Airport"},
+	{"BJA", "DZ", 36.7120018, 5.069920063, "Soummam Airport"},
+	{"BJB", "IR", 37.49300003, 57.30820084, "Bojnord Airport"},
+	{"BJC", "US", 39.90879822, -105.1169968, "Rocky Mountain Metropolitan Airport"},
+	{"BJD", "IS", 66.02189636, -14.82439995, "Bakkafjörður Airport"},
+	{"BJE", "SD", 11.19944444, 28.52305556, "Baleela Airport"},
+	{"BJF", "NO", 70.60050201, 29.69140053, "Båtsfjord Airport"},
+	{"BJG", "ID", -0.97289598, 122.1449966, "Boalang Airport"},
+	{"BJH", "NP", 29.53899956, 81.18540192, "Bajhang Airport"},
+	{"BJI", "US", 47.50939941, -94.93370056, "Bemidji Regional Airport"},
+	{"BJJ", "US", 40.87480164, -81.88829803, "Wayne County Airport"},
+	{"BJK", "ID", -6.06619978, 134.2740021, "Nangasuri Airport"},
+	{"BJL", "GM", 13.3380003, -16.6522007, "Banjul International Airport"},
+	{"BJM", "BI", -3.324019909, 29.31850052, "Bujumbura International Airport"},
+	{"BJO", "BO", -22.77330017, -64.31289673, "Bermejo Airport"},
+	{"BJP", "BR", -22.979167, -46.5375, "Aeroporto Estadual Arthur Siqueira Airport"},
+	{"BJQ", "OM", 19.87305556, 56.06777778, "Bahja Airport"},
+	{"BJR", "ET", 11.60809994, 37.32160187, "Bahir Dar Airport"},
+	{"BJU", "NP", 29.50200081, 81.66899872, "Bajura Airport"},
+	{"BJV", "TR", 37.25059891, 27.66430092, "Milas Bodrum International Airport"},
+	{"BJW", "ID", -8.70743498, 121.0574269, "Bajawa Soa Airport"},
+	{"BJX", "MX", 20.99349976, -101.4810028, "Del Bajío International Airport"},
+	{"BJY", "RS", 44.935299, 20.2575, "Batajnica Air Base"},
+	{"BJZ", "ES", 38.8913002, -6.82133007, "Badajoz Airport"},
+	{"BKB", "IN", 28.07060051, 73.2071991, "Nal Airport"},
+	{"BKC", "US", 65.9815979, -161.1490021, "Buckland Airport"},
+	{"BKD", "US", 32.71900177, -98.89099884, "Stephens County Airport"},
+	{"BKE", "US", 44.83729935, -117.8089981, "Baker City Municipal Airport"},
+	{"BKG", "US", 36.532082, -93.200544, "Branson Airport"},
+	{"BKH", "US", 22.02280045, -159.7850037, "Barking Sands Airport"},
+	{"BKI", "MY", 5.937210083, 116.0510025, "Kota Kinabalu International Airport"},
+	{"BKJ", "GN", 10.96580029, -14.28110027, "Boke Airport"},
+	{"BKK", "TH", 13.68109989, 100.7470016, "Suvarnabhumi Airport"},
+	{"BKL", "US", 41.51750183, -81.6832962, "Burke Lakefront Airport"},
+	{"BKM", "MY", 3.973999977, 115.6179962, "Bakalalan Airport"},
+	{"BKO", "ML", 12.53349972, -7.949940205, "Senou Airport"},
+	{"BKP", "AU", -20.49583333, 138.4747222, "Barkly Downs Airport"},
+	{"BKQ", "AU", -24.42779922, 145.4290009, "Blackall Airport"},
+	{"BKR", "TD", 12.38300037, 17.06699944, "Bokoro Airport"},
+	{"BKS", "ID", -3.863699913, 102.3389969, "Padang Kemiling (Fatmawati Soekarno) Airport"},
+	{"BKT", "US", 37.07419968, -77.95749664, "Allen C Perkinson Blackstone Army Air Field"},
+	{"BKU", "MG", -23.7329998, 44.38899994, "Betioky Airport"},
+	{"BKW", "US", 37.78730011, -81.12419891, "Raleigh County Memorial Airport"},
+	{"BKX", "US", 44.30479813, -96.81690216, "Brookings Regional Airport"},
+	{"BKY", "CD", -2.308979988, 28.80879974, "Bukavu Kavumu Airport"},
+	{"BKZ", "TZ", -1.332, 31.8212, "Bukoba Airport"},
+	{"BLA", "VE", 10.10709953, -64.68920135, "General Jose Antonio Anzoategui International Airport"},
+	{"BLB", "PA", 8.914790154, -79.59960175, "Panama Pacific International Airport"},
+	{"BLC", "CM", 5.895277778, 10.03388889, "Bali Airport"},
+	{"BLD", "US", 35.94749832, -114.8610001, "Boulder City Municipal Airport"},
+	{"BLE", "SE", 60.42200089, 15.51519966, "Borlange Airport"},
+	{"BLF", "US", 37.29579926, -81.20770264, "Mercer County Airport"},
+	{"BLG", "MY", 2.650000095, 113.7669983, "Belaga Airport"},
+	{"BLH", "US", 33.61920166, -114.7170029, "Blythe Airport"},
+	{"BLI", "US", 48.7928009, -122.538002, "Bellingham International Airport"},
+	{"BLJ", "DZ", 35.7521019, 6.308589935, "Batna Airport"},
+	{"BLK", "GB", 53.77170181, -3.028609991, "Blackpool International Airport"},
+	{"BLL", "DK", 55.74029922, 9.151780128, "Billund Airport"},
+	{"BLM", "US", 40.18690109, -74.12490082, "Monmouth Executive Airport"},
+	{"BLN", "AU", -36.55189896, 146.0070038, "Benalla Airport"},
+	{"BLO", "IS", 65.64499664, -20.28750038, "Hjaltabakki Airport"},
+	{"BLP", "PE", -7.060560226, -76.5821991, "Huallaga Airport"},
+	{"BLQ", "IT", 44.53540039, 11.2887001, "Bologna Guglielmo Marconi Airport"},
+	{"BLR", "IN", 13.19789982, 77.70629883, "Bengaluru International Airport"},
+	{"BLS", "AU", -28.05830002, 147.4830017, "Bollon Airport"},
+	{"BLT", "AU", -23.60309982, 148.8070068, "Blackwater Airport"},
+	{"BLU", "US", 39.27500153, -120.7099991, "Blue Canyon Nyack Airport"},
+	{"BLV", "US", 38.54520035, -89.83519745, "Scott AFB/Midamerica Airport"},
+	{"BLX", "IT", 46.16749954, 12.24790001, "Belluno Airport"},
+	{"BLY", "IE", 54.22280121, -10.03079987, "Belmullet Aerodrome"},
+	{"BLZ", "MW", -15.67910004, 34.97399902, "Chileka International Airport"},
+	{"BMA", "SE", 59.35440063, 17.94169998, "Stockholm-Bromma Airport"},
+	{"BMB", "CD", 2.182780027, 22.4817009, "Bumbar Airport"},
+	{"BMC", "US", 41.55239868, -112.0619965, "Brigham City Airport"},
+	{"BMD", "MG", -19.68670082, 44.54190063, "Belo sur Tsiribihina Airport"},
+	{"BME", "AU", -17.94470024, 122.2320023, "Broome International Airport"},
+	{"BMF", "CF", 5.693999767, 22.8010006, "Bakouma Airport"},
+	{"BMG", "US", 39.14599991, -86.61669922, "Monroe County Airport"},
+	{"BMH", "PG", -6.373166667, 144.637, "Bomai Airport"},
+	{"BMI", "US", 40.47710037, -88.91590118, "Central Illinois Regional Airport at Bloomington-Normal"},
+	{"BMJ", "GY", 7.370120049, -60.48799896, "Baramita Airport"},
+	{"BMK", "DE", 53.59638977, 6.709167004, "Borkum Airport"},
+	{"BML", "US", 44.57540131, -71.17590332, "Berlin Regional Airport"},
+	{"BMM", "GA", 2.075639963, 11.4932003, "Bitam Airport"},
+	{"BMN", "IQ", 37.09880066, 43.26660156, "Bamarni Airport"},
+	{"BMO", "MM", 24.2689991, 97.24620056, "Banmaw Airport"},
+	{"BMP", "AU", -20.80330086, 149.2700043, "Brampton Island Airport"},
+	{"BMQ", "KE", -3.981909624, 39.7307682, "Bamburi Airport"},
+	{"BMR", "DE", 53.72472382, 7.373332977, "Baltrum Airport"},
+	{"BMS", "BR", -14.2553997, -41.81750107, "Sócrates Mariani Bittencourt Airport"},
+	{"BMT", "US", 30.07069969, -94.21579742, "Beaumont Municipal Airport"},
+	{"BMU", "ID", -8.539649963, 118.6869965, "Muhammad Salahuddin Airport"},
+	{"BMV", "VN", 12.66829968, 108.1200027, "Buon Ma Thuot Airport"},
+	{"BMW", "DZ", 21.375, 0.923888981, "Bordj Badji Mokhtar Airport"},
+	{"BMX", "US", 59.36119843, -155.2590027, "Big Mountain Airport"},
+	{"BMY", "NC", -19.72060013, 163.6609955, "Île Art - Waala Airport"},
+	{"BMZ", "PG", -7.8485, 143.2433, "Bamu Airport"},
+	{"BNA", "US", 36.12450027, -86.67819977, "Nashville International Airport"},
+	{"BNB", "CD", -0.216999993, 20.85000038, "Boende Airport"},
+	{"BNC", "CD", 0.574999988, 29.47389984, "Beni Airport"},
+	{"BND", "IR", 27.21829987, 56.37779999, "Bandar Abbas International Airport"},
+	{"BNE", "AU", -27.38419914, 153.1170044, "Brisbane International Airport"},
+	{"BNG", "US", 33.92309952, -116.8509979, "Banning Municipal Airport"},
+	{"BNI", "NG", 6.316979885, 5.599500179, "Benin Airport"},
+	{"BNJ", "DE", 50.76889038, 7.163332939, "Bonn-Hangelar Airport"},
+	{"BNK", "AU", -28.83390045, 153.5619965, "Ballina Byron Gateway Airport"},
+	{"BNL", "US", 33.25780106, -81.38829803, "Barnwell Regional Airport"},
+	{"BNM", "PG", -9.107777778, 147.6667222, "Bodinumu Airport"},
+	{"BNN", "NO", 65.46109772, 12.21749973, "Brønnøysund Airport"},
+	{"BNO", "US", 43.59189987, -118.9550018, "Burns Municipal Airport"},
+	{"BNP", "PK", 32.97290039, 70.5279007, "Bannu Airport"},
+	{"BNR", "BF", 10.68299961, -4.717000008, "Banfora Airport"},
+	{"BNS", "VE", 8.619569778, -70.22080231, "Barinas Airport"},
+	{"BNT", "PG", -5.742222222, 145.2275, "Bundi Airport"},
+	{"BNU", "BR", -26.83060074, -49.09030151, "Blumenau Airport"},
+	{"BNW", "US", 42.04959869, -93.84760284, "Boone Municipal Airport"},
+	{"BNX", "BA", 44.94139862, 17.29750061, "Banja Luka International Airport"},
+	{"BNY", "SB", -11.30222222, 159.7983333, "Bellona/Anua Airport"},
+	{"BOA", "CD", -5.854000092, 13.06400013, "Boma Airport"},
+	{"BOB", "PF", -16.44440079, -151.7510071, "Bora Bora Airport"},
+	{"BOC", "PA", 9.340849876, -82.25080109, "Bocas Del Toro International Airport"},
+	{"BOD", "FR", 44.82830048, -0.715556026, "Bordeaux-Mérignac Airport"},
+	{"BOE", "CG", -1.032999992, 15.38300037, "Boundji Airport"},
+	{"BOG", "CO", 4.70159, -74.1469, "El Dorado International Airport"},
+	{"BOH", "GB", 50.77999878, -1.842499971, "Bournemouth Airport"},
+	{"BOI", "US", 43.56439972, -116.2229996, "Boise Air Terminal/Gowen field"},
+	{"BOJ", "BG", 42.56959915, 27.51519966, "Burgas Airport"},
+	{"BOK", "US", 42.07460022, -124.2900009, "Brookings Airport"},
+	{"BOL", "GB", 55.06029892, -7.020279884, "Ballykelly Airport"},
+	{"BOM", "IN", 19.08869934, 72.86789703, "Chhatrapati Shivaji International Airport"},
+	{"BON", "BQ", 12.13099957, -68.26850128, "Flamingo International Airport"},
+	{"BOO", "NO", 67.26920319, 14.36530018, "Bodø Airport"},
+	{"BOP", "CF", 5.958000183, 15.63700008, "Bouar Airport"},
+	{"BOR", "FR", 47.6556015, 7.010829926, "Fontaine Airport"},
+	{"BOS", "US", 42.36429977, -71.00520325, "General Edward Lawrence Logan International Airport"},
+	{"BOT", "PG", -7.240833333, 141.0923333, "Bosset Airport"},
+	{"BOU", "FR", 47.05810165, 2.370280027, "Bourges Airport"},
+	{"BOV", "PG", -3.3834, 153.2812, "Boang Airport"},
+	{"BOW", "US", 27.94339943, -81.78340149, "Bartow Municipal Airport"},
+	{"BOX", "AU", -16.07530022, 136.302002, "Borroloola Airport"},
+	{"BOY", "BF", 11.16009998, -4.33096981, "Bobo Dioulasso Airport"},
+	{"BOZ", "CF", 6.344170094, 16.32189941, "Bozoum Airport"},
+	{"BPC", "CM", 6.039239883, 10.1225996, "Bamenda Airport"},
+	{"BPD", "PG", -7.743611111, 147.0205556, "Bapi Airstrip"},
+	{"BPF", "SB", -8.562027778, 158.1193056, "Batuna Aerodrome"},
+	{"BPG", "BR", -15.86130047, -52.38890076, "Barra do Garças Airport"},
+	{"BPH", "PH", 8.195949554, 126.3219986, "Bislig Airport"},
+	{"BPI", "US", 42.58509827, -110.1110001, "Miley Memorial Field"},
+	{"BPK", "PG", -5.526388889, 141.7445833, "Biangabip Airport"},
+	{"BPL", "CN", 44.895, 82.3, "Alashankou Bole (Bortala) airport"},
+	{"BPM", "IN", 17.4531002, 78.46759796, "Begumpet Airport"},
+	{"BPN", "ID", -1.268270016, 116.8939972, "Sultan Aji Muhamad Sulaiman Airport"},
+	{"BPS", "BR", -16.43860054, -39.08089828, "Porto Seguro Airport"},
+	{"BPT", "US", 29.95079994, -94.02069855, "Southeast Texas Regional Airport"},
+	{"BPX", "CN", 30.55360031, 97.10829926, "Qamdo Bangda Airport"},
+	{"BPY", "MG", -16.7445303, 44.48248386, "Besalampy Airport"},
+	{"BQA", "PH", 15.72980022, 121.5, "Dr.Juan C. Angara Airport"},
+	{"BQB", "AU", -33.68842316, 115.4015961, "Busselton Regional Airport"},
+	{"BQD", "IS", 65.07530212, -21.8003006, "Búðardalur Airport"},
+	{"BQE", "GW", 11.29735565, -15.83807945, "Bubaque Airport"},
+	{"BQG", "RU", 52.38, 140.448, "Bogorodskoye Airport"},
+	{"BQH", "GB", 51.3307991, 0.032499999, "London Biggin Hill Airport"},
+	{"BQI", "NA", -18.11809921, 21.62439919, "Bagani Airport"},
+	{"BQK", "US", 31.25880051, -81.46649933, "Brunswick Golden Isles Airport"},
+	{"BQL", "AU", -22.91329956, 139.8999939, "Boulia Airport"},
+	{"BQN", "PR", 18.49489975, -67.12940216, "Rafael Hernandez Airport"},
+	{"BQO", "CI", 9.277500153, -3.025279999, "Bouna Airport"},
+	{"BQQ", "BR", -11.08080006, -43.14749908, "Barra Airport"},
+	{"BQS", "RU", 50.42539978, 127.4120026, "Ignatyevo Airport"},
+	{"BQT", "BY", 52.10829926, 23.8980999, "Brest Airport"},
+	{"BQU", "VC", 12.98840046, -61.26200104, "J F Mitchell Airport"},
+	{"BQW", "AU", -20.14830017, 127.9729996, "Balgo Hill Airport"},
+	{"BRA", "BR", -12.07890034, -45.00899887, "Barreiras Airport"},
+	{"BRB", "BR", -2.755555556, -42.81, "Barreirinhas Airport"},
+	{"BRC", "AR", -41.15119934, -71.15750122, "San Carlos De Bariloche Airport"},
+	{"BRD", "US", 46.39830017, -94.13809967, "Brainerd Lakes Regional Airport"},
+	{"BRE", "DE", 53.04750061, 8.786669731, "Bremen Airport"},
+	{"BRI", "IT", 41.13890076, 16.76059914, "Bari Karol Wojtyła Airport"},
+	{"BRK", "AU", -30.03919983, 145.9519958, "Bourke Airport"},
+	{"BRL", "US", 40.78319931, -91.12550354, "Southeast Iowa Regional Airport"},
+	{"BRM", "VE", 10.04274654, -69.35861969, "Barquisimeto International Airport"},
+	{"BRN", "CH", 46.91410065, 7.497149944, "Bern Belp Airport"},
+	{"BRO", "US", 25.90679932, -97.42590332, "Brownsville South Padre Island International Airport"},
+	{"BRP", "PG", -7.6693, 146.7594, "Biaru Airport"},
+	{"BRQ", "CZ", 49.15129852, 16.69440079, "Brno-Tuřany Airport"},
+	{"BRR", "GB", 57.02280045, -7.443059921, "Barra Airport"},
+	{"BRS", "GB", 51.38270187, -2.719089985, "Bristol International Airport"},
+	{"BRT", "AU", -11.76920033, 130.6199951, "Bathurst Island Airport"},
+	{"BRU", "BE", 50.90140152, 4.48443985, "Brussels Airport"},
+	{"BRV", "DE", 53.50694275, 8.572777748, "Bremerhaven Airport"},
+	{"BRW", "US", 71.28540039, -156.7660065, "Wiley Post Will Rogers Memorial Airport"},
+	{"BRX", "DO", 18.25149918, -71.12039948, "Maria Montez International Airport"},
+	{"BRY", "US", 37.81430054, -85.49960327, "Samuels Field"},
+	{"BSA", "SO", 11.27530003, 49.1493988, "Bosaso Airport"},
+	{"BSB", "BR", -15.86916733, -47.92083359, "Presidente Juscelino Kubistschek International Airport"},
+	{"BSC", "CO", 6.20292, -77.3947, "José Celestino Mutis Airport"},
+	{"BSD", "CN", 25.05330086, 99.16829681, "Baoshan Yunduan Airport"},
+	{"BSE", "MY", 1.813609958, 109.7630005, "Sematan Airport"},
+	{"BSF", "US", 19.76009941, -155.5540009, "Bradshaw Army Airfield"},
+	{"BSG", "GQ", 1.905470014, 9.805680275, "Bata Airport"},
+	{"BSI", "US", 40.44472222, -79.28863889, "Blairsville"},
+	{"BSJ", "AU", -37.88750076, 147.5679932, "Bairnsdale Airport"},
+	{"BSK", "DZ", 34.79330063, 5.738230228, "Biskra Airport"},
+	{"BSL", "FR", 47.58959961, 7.529910088, "EuroAirport Basel-Mulhouse-Freiburg Airport"},
+	{"BSM", "IR", 36.65510178, 52.34960175, "Bishe Kola Air Base"},
+	{"BSN", "CF", 6.492000103, 17.42900085, "Bossangoa Airport"},
+	{"BSO", "PH", 20.45129967, 121.9800034, "Basco Airport"},
+	{"BSP", "PG", -8.858055556, 141.2594444, "Bensbach Airport"},
+	{"BSQ", "US", 31.36400032, -109.8830032, "Bisbee Municipal Airport"},
+	{"BSR", "IQ", 30.54910088, 47.66210175, "Basrah International Airport"},
+	{"BSS", "BR", -7.526030064, -46.05329895, "Balsas Airport"},
+	{"BST", "AF", 31.55970001, 64.36499786, "Bost Airport"},
+	{"BSU", "CD", 1.224720001, 19.78890038, "Basankusu Airport"},
+	{"BSV", "MG", -15.6725, 47.06166667, "Besakoa Airport"},
+	{"BSW", "US", 60.42309952, -146.1459961, "Boswell Bay Airport"},
+	{"BSX", "MM", 16.81520081, 94.7798996, "Pathein Airport"},
+	{"BSY", "SO", 2.336111, 42.307778, "Bardera Airport"},
+	{"BSZ", "US", 58.21620178, -157.352005, "Bartletts Airport"},
+	{"BTA", "CM", 4.54861021, 13.72609997, "Bertoua Airport"},
+	{"BTB", "CG", 3.049999952, 18.5, "Betou Airport"},
+	{"BTC", "LK", 7.705760002, 81.67880249, "Batticaloa Airport"},
+	{"BTD", "AU", -18.63999939, 135.9380035, "Brunette Downs Airport"},
+	{"BTE", "SL", 7.532420158, -12.51889992, "Sherbro International Airport"},
+	{"BTF", "US", 40.86940002, -111.927002, "Skypark Airport"},
+	{"BTG", "CF", 7.314109802, 18.30879974, "Batangafo Airport"},
+	{"BTH", "ID", 1.121029973, 104.1190033, "Hang Nadim International Airport"},
+	{"BTI", "US", 70.13400269, -143.5820007, "Barter Island LRRS Airport"},
+	{"BTJ", "ID", 5.522872024, 95.42063713, "Sultan Iskandar Muda International Airport"},
+	{"BTK", "RU", 56.37060165, 101.697998, "Bratsk Airport"},
+	{"BTL", "US", 42.30730057, -85.25150299, "W K Kellogg Airport"},
+	{"BTM", "US", 45.95479965, -112.4970016, "Bert Mooney Airport"},
+	{"BTN", "US", 34.62170029, -79.73439789, "Marlboro County Jetport H.E. Avent Field"},
+	{"BTO", "SR", 4.217511094, -55.44705391, "Botopasi Airport"},
+	{"BTP", "US", 40.77690125, -79.9496994, "Butler County-K W Scholter Field"},
+	{"BTQ", "RW", -2.595829964, 29.73670006, "Butare Airport"},
+	{"BTR", "US", 30.53319931, -91.14959717, "Baton Rouge Metropolitan, Ryan Field"},
+	{"BTS", "SK", 48.17020035, 17.21269989, "M. R. Štefánik Airport"},
+	{"BTT", "US", 66.91390228, -151.529007, "Bettles Airport"},
+	{"BTU", "MY", 3.123850107, 113.0199966, "Bintulu Airport"},
+	{"BTV", "US", 44.47190094, -73.15329742, "Burlington International Airport"},
+	{"BTW", "ID", -3.412410021, 115.9950027, "Batu Licin Airport"},
+	{"BTX", "AU", -25.64170074, 140.7830048, "Betoota Airport"},
+	{"BTY", "US", 36.86109924, -116.7870026, "Beatty Airport"},
+	{"BTZ", "TR", 40.23329926, 29.00919914, "Bursa Airport"},
+	{"BUA", "PG", -5.422319889, 154.6730042, "Buka Airport"},
+	{"BUB", "US", 41.77669907, -99.14969635, "Cram Field"},
+	{"BUC", "AU", -17.74860001, 139.5339966, "Burketown Airport"},
+	{"BUD", "HU", 47.43690109, 19.25559998, "Budapest Ferenc Liszt International Airport"},
+	{"BUF", "US", 42.94049835, -78.73220062, "Buffalo Niagara International Airport"},
+	{"BUG", "AO", -12.60900021, 13.40369987, "Benguela Airport"},
+	{"BUI", "ID", -3.6822, 138.6755, "Bokondini Airport"},
+	{"BUJ", "DZ", 35.33250046, 4.206389904, "Bou Saada Airport"},
+	{"BUK", "YE", 17.34670067, 44.62170029, "Al-Bough Airport"},
+	{"BUL", "PG", -7.216286671, 146.6495419, "Bulolo Airport"},
+	{"BUM", "US", 38.28979874, -94.34010315, "Butler Memorial Airport"},
+	{"BUN", "CO", 3.81963, -76.9898, "Gerardo Tobar López Airport"},
+	{"BUO", "SO", 9.5275, 45.5549, "Burao Airport"},
+	{"BUP", "IN", 30.27009964, 74.75579834, "Bhatinda Air Force Station"},
+	{"BUQ", "ZW", -20.01740074, 28.61790085, "Joshua Mqabuko Nkomo International Airport"},
+	{"BUR", "US", 34.20069885, -118.3590012, "Bob Hope Airport"},
+	{"BUS", "GE", 41.61029816, 41.59970093, "Batumi International Airport"},
+	{"BUV", "UY", -30.33333397, -57.08333206, "Bella Union Airport"},
+	{"BUW", "ID", -5.486879826, 122.5690002, "Betoambari Airport"},
+	{"BUX", "CD", 1.565719962, 30.2208004, "Bunia Airport"},
+	{"BUY", "AU", -33.37829971, 115.677002, "Bunbury Airport"},
+	{"BUZ", "IR", 28.94479942, 50.83459854, "Bushehr Airport"},
+	{"BVA", "FR", 49.45439911, 2.112780094, "Paris Beauvais Tillé Airport"},
+	{"BVB", "BR", 2.841388941, -60.6922226, "Atlas Brasil Cantanhede Airport"},
+	{"BVC", "CV", 16.1364994, -22.88890076, "Rabil Airport"},
+	{"BVE", "FR", 45.03972222, 1.485555556, "Brive Souillac Airport"},
+	{"BVG", "NO", 70.87139893, 29.03420067, "Berlevåg Airport"},
+	{"BVH", "BR", -12.69439983, -60.09830093, "Vilhena Airport"},
+	{"BVI", "AU", -25.89749908, 139.3480072, "Birdsville Airport"},
+	{"BVK", "BO", -13.55000019, -63.7478981, "Huacaraje Airport"},
+	{"BVL", "BO", -13.58333302, -63.58333206, "Baures Airport"},
+	{"BVM", "BR", -15.87170029, -38.87189865, "Belmonte Airport"},
+	{"BVO", "US", 36.76250076, -96.01119995, "Bartlesville Municipal Airport"},
+	{"BVR", "CV", 14.86430556, -24.746, "Esperadinha Airport"},
+	{"BVS", "BR", -1.636530042, -50.4435997, "Breves Airport"},
+	{"BVU", "US", 61.17219925, -151.0440063, "Beluga Airport"},
+	{"BVV", "RU", 44.91999817, 147.621994, "Burevestnik Airport"},
+	{"BVW", "AU", -12.65919971, 142.6750031, "Batavia Downs Airport"},
+	{"BVX", "US", 35.7262001, -91.64730072, "Batesville Regional Airport"},
+	{"BVY", "US", 42.58420181, -70.91649628, "Beverly Municipal Airport"},
+	{"BVZ", "AU", -16.73329926, 125.4329987, "Beverley Springs Airport"},
+	{"BWA", "NP", 27.50569916, 83.41629791, "Bhairahawa Airport"},
+	{"BWB", "AU", -20.86440086, 115.4059982, "Barrow Island Airport"},
+	{"BWC", "US", 32.99290085, -115.5169983, "Brawley Municipal Airport"},
+	{"BWD", "US", 31.79360008, -98.95649719, "Brownwood Regional Airport"},
+	{"BWE", "DE", 52.31919861, 10.55609989, "Braunschweig Wolfsburg Airport"},
+	{"BWF", "GB", 54.13119888, -3.263669968, "Barrow Walney Island Airport"},
+	{"BWG", "US", 36.96450043, -86.41970062, "Bowling Green Warren County Regional Airport"},
+	{"BWH", "MY", 5.465919971, 100.3909988, "Butterworth Airport"},
+	{"BWI", "US", 39.17539978, -76.66829681, "Baltimore/Washington International Thurgood Marshall Airport"},
+	{"BWJ", "PG", -6.396944444, 146.8816667, "Bawan Airport"},
+	{"BWK", "HR", 43.28570175, 16.67970085, "Bol Airport"},
+	{"BWL", "US", 36.79589844, -97.31700134, "Earl Henry Airport"},
+	{"BWM", "US", 46.18700027, -103.4280014, "Bowman Municipal Airport"},
+	{"BWN", "BN", 4.944200039, 114.9280014, "Brunei International Airport"},
+	{"BWO", "RU", 51.85829926, 47.74560165, "Balakovo Airport"},
+	{"BWP", "PG", -3.021666667, 141.1652778, "Bewani Airport"},
+	{"BWQ", "AU", -29.97389984, 146.8170013, "Brewarrina Airport"},
+	{"BWT", "AU", -40.99890137, 145.7310028, "Wynyard Airport"},
+	{"BWU", "AU", -33.92440033, 150.9880066, "Sydney Bankstown Airport"},
+	{"BWW", "CU", 22.62129974, -79.14720154, "Las Brujas Airport"},
+	{"BXA", "US", 30.81369972, -89.86499786, "George R Carr Memorial Air Field"},
+	{"BXB", "ID", -2.532239914, 133.4389954, "Babo Airport"},
+	{"BXD", "ID", -7.714630127, 139.5749969, "Bade Airport"},
+	{"BXE", "SN", 14.84729958, -12.46829987, "Bakel Airport"},
+	{"BXF", "AU", -17.545, 128.305, "Bellburn Airstrip"},
+	{"BXG", "AU", -36.73939896, 144.3300018, "Bendigo Airport"},
+	{"BXH", "KZ", 46.8932991, 75.00499725, "Balkhash Airport"},
+	{"BXI", "CI", 9.532999992, -6.467000008, "Boundiali Airport"},
+	{"BXJ", "KZ", 43.3526001, 76.88369751, "Boralday Airport"},
+	{"BXK", "US", 33.42039871, -112.685997, "Buckeye Municipal Airport"},
+	{"BXM", "ID", -4.166669846, 140.8500061, "Batom Airport"},
+	{"BXN", "TR", 37.14009857, 27.66970062, "Imsık Airport"},
+	{"BXO", "CH", 46.97444444, 8.396944444, "Buochs Airport"},
+	{"BXP", "PL", 52.00579834, 23.14360046, "Biała Podlaska Airport"},
+	{"BXR", "IR", 29.08419991, 58.45000076, "Bam Airport"},
+	{"BXS", "US", 33.25899887, -116.3209991, "Borrego Valley Airport"},
+	{"BXT", "ID", 0.119690999, 117.4749985, "Bontang Airport"},
+	{"BXU", "PH", 8.9515, 125.4788, "Bancasi Airport"},
+	{"BXV", "IS", 64.79000092, -14.02280045, "Breiðdalsvík Airport"},
+	{"BXX", "SO", 9.9463, 43.1495, "Boorama Airport"},
+	{"BXZ", "PG", -5.7243, 147.8667, "Bunsil Airport"},
+	{"BYA", "US", 64.07830048, -141.1130066, "Boundary Airport"},
+	{"BYB", "OM", 25.6142292, 56.24440002, "Dibba Airport"},
+	{"BYC", "BO", -21.96089935, -63.65169907, "Yacuiba Airport"},
+	{"BYF", "FR", 49.9715004, 2.697659969, "Albert-Bray Airport"},
+	{"BYG", "US", 44.3810997, -106.7220001, "Johnson County Airport"},
+	{"BYH", "US", 35.96429825, -89.94400024, "Arkansas International Airport"},
+	{"BYI", "US", 42.54259872, -113.7720032, "Burley Municipal Airport"},
+	{"BYJ", "PT", 38.07889938, -7.932400227, "Beja International Airport"},
+	{"BYK", "CI", 7.738800049, -5.07366991, "Bouaké Airport"},
+	{"BYL", "LR", 7.369166667, -9.993722222, "Bella Yella Airport"},
+	{"BYM", "CU", 20.39640045, -76.62139893, "Carlos Manuel de Cespedes Airport"},
+	{"BYN", "MN", 46.16329956, 100.7040024, "Bayankhongor Airport"},
+	{"BYO", "BR", -21.2473, -56.4525, "Bonito Airport"},
+	{"BYQ", "ID", 3.455719948, 117.8669968, "Bunyu Airport"},
+	{"BYR", "DK", 57.27719879, 11.00010014, "Læsø Airport"},
+	{"BYS", "US", 35.2804985, -116.6299973, "Bicycle Lake Army Air Field"},
+	{"BYT", "IE", 51.66859818, -9.48416996, "Bantry Aerodrome"},
+	{"BYU", "DE", 49.98500061, 11.64000034, "Bayreuth Airport"},
+	{"BYW", "US", 48.57899857, -122.8259964, "Blakely Island Airport"},
+	{"BYX", "AU", -13.19810009, 136.227005, "Baniyala Airport"},
+	{"BZA", "NI", 13.94999981, -84.59999847, "San Pedro Airport"},
+	{"BZB", "MZ", -21.54109955, 35.47290039, "Bazaruto Island Airport"},
+	{"BZC", "BR", -22.77099991, -41.96289825, "Umberto Modiano Airport"},
+	{"BZD", "AU", -34.62360001, 143.5780029, "Balranald Airport"},
+	{"BZE", "BZ", 17.53910065, -88.30819702, "Philip S. W. Goldson International Airport"},
+	{"BZF", "US", 40.57490158, -122.4079971, "Benton Field"},
+	{"BZG", "PL", 53.09680176, 17.97769928, "Bydgoszcz Ignacy Jan Paderewski Airport"},
+	{"BZH", "ZW", -16.81699944, 28.35000038, "Bumi Airport"},
+	{"BZI", "TR", 39.61930084, 27.9260006, "Balıkesir Merkez Airport"},
+	{"BZK", "RU", 53.21419907, 34.17639923, "Bryansk Airport"},
+	{"BZL", "BD", 22.8010006, 90.30120087, "Barisal Airport"},
+	{"BZM", "MG", -17.6925, 45.08883333, "Bemolanga Airport"},
+	{"BZN", "US", 45.77750015, -111.1529999, "Gallatin Field"},
+	{"BZO", "IT", 46.46020126, 11.3263998, "Bolzano Airport"},
+	{"BZP", "AU", -14.74027778, 144.1194444, "Bizant Airport"},
+	{"BZR", "FR", 43.32350159, 3.353899956, "Béziers-Vias Airport"},
+	{"BZU", "CD", 2.818350077, 24.79369926, "Buta Zega Airport"},
+	{"BZV", "CG", -4.251699924, 15.25300026, "Maya-Maya Airport"},
+	{"BZY", "MD", 47.83810043, 27.78149986, "Balti International Airport"},
+	{"BZZ", "GB", 51.75, -1.583619952, "RAF Brize Norton"},
+	{"CAA", "HN", 14.91699982, -85.90000153, "Catacamas Airport"},
+	{"CAB", "AO", -5.596990108, 12.18840027, "Cabinda Airport"},
+	{"CAC", "BR", -25.00029945, -53.50080109, "Cascavel Airport"},
+	{"CAD", "US", 44.27529907, -85.41889954, "Wexford County Airport"},
+	{"CAE", "US", 33.93880081, -81.11949921, "Columbia Metropolitan Airport"},
+	{"CAF", "BR", -4.871520042, -66.89749908, "Carauari Airport"},
+	{"CAG", "IT", 39.25149918, 9.054280281, "Cagliari Elmas Airport"},
+	{"CAH", "VN", 9.177666667, 105.1777778, "Cà Mau Airport"},
+	{"CAI", "EG", 30.12190056, 31.40559959, "Cairo International Airport"},
+	{"CAJ", "VE", 6.231988907, -62.85443115, "Canaima Airport"},
+	{"CAK", "US", 40.91609955, -81.44219971, "Akron Canton Regional Airport"},
+	{"CAL", "GB", 55.43719864, -5.686389923, "Campbeltown Airport"},
+	{"CAM", "BO", -20.00639915, -63.52780151, "Camiri Airport"},
+	{"CAN", "CN", 23.39240074, 113.2990036, "Guangzhou Baiyun International Airport"},
+	{"CAO", "US", 36.44620132, -103.1669998, "Clayton Municipal Airpark"},
+	{"CAP", "HT", 19.7329998, -72.19470215, "Cap Haitien International Airport"},
+	{"CAQ", "CO", 7.96847, -75.1985, "Juan H White Airport"},
+	{"CAR", "US", 46.87149811, -68.01789856, "Caribou Municipal Airport"},
+	{"CAT", "BS", 24.31529999, -75.45230103, "New Bight Airport"},
+	{"CAU", "BR", -8.282389641, -36.01350021, "Caruaru Airport"},
+	{"CAV", "AO", -11.89309978, 22.91640091, "Cazombo Airport"},
+	{"CAW", "BR", -21.69829941, -41.30170059, "Bartolomeu Lisandro Airport"},
+	{"CAX", "GB", 54.9375, -2.809170008, "Carlisle Airport"},
+	{"CAY", "GF", 4.819809914, -52.36040115, "Cayenne-Rochambeau Airport"},
+	{"CAZ", "AU", -31.53829956, 145.7940063, "Cobar Airport"},
+	{"CBB", "BO", -17.42110062, -66.17710114, "Jorge Wilsterman International Airport"},
+	{"CBC", "AU", -18.91777778, 125.5377778, "Cherrabun Airport"},
+	{"CBD", "IN", 9.152509689, 92.81960297, "Car Nicobar Air Force Station"},
+	{"CBE", "US", 39.61539841, -78.7609024, "Greater Cumberland Regional Airport"},
+	{"CBF", "US", 41.25920105, -95.76059723, "Council Bluffs Municipal Airport"},
+	{"CBG", "GB", 52.20500183, 0.174999997, "Cambridge Airport"},
+	{"CBH", "DZ", 31.64570045, -2.269860029, "Béchar Boudghene Ben Ali Lotfi Airport"},
+	{"CBI", "AU", -40.39170074, 148.0169983, "Cape Barren Island Airport"},
+	{"CBJ", "DO", 17.92900085, -71.64479828, "Cabo Rojo Airport"},
+	{"CBK", "US", 39.42750168, -101.0469971, "Shalz Field"},
+	{"CBL", "VE", 8.122160912, -63.53695679, "Aeropuerto \"General Tomas de Heres\". Ciudad Bolivar"},
+	{"CBM", "US", 33.64379883, -88.44380188, "Columbus Air Force Base"},
+	{"CBN", "ID", -6.756140232, 108.5400009, "Penggung Airport"},
+	{"CBO", "PH", 7.165239811, 124.2099991, "Awang Airport"},
+	{"CBP", "PT", 40.15719986, -8.470000267, "Coimbra Airport"},
+	{"CBQ", "NG", 4.976019859, 8.347200394, "Margaret Ekpo International Airport"},
+	{"CBR", "AU", -35.30690002, 149.1950073, "Canberra International Airport"},
+	{"CBS", "VE", 10.33069992, -71.32250214, "Oro Negro Airport"},
+	{"CBT", "AO", -12.47920036, 13.48690033, "Catumbela Airport"},
+	{"CBU", "DE", 51.88944244, 14.53194427, "Cottbus-Drewitz Airport"},
+	{"CBV", "GT", 15.46899986, -90.40670013, "Coban Airport"},
+	{"CBW", "BR", -24.00919914, -52.35680008, "Campo Mourão Airport"},
+	{"CBX", "AU", -33.06439972, 147.2089996, "Condobolin Airport"},
+	{"CBY", "AU", -19.47940063, 140.927002, "Canobie Airport"},
+	{"CCB", "US", 34.11159897, -117.6880035, "Cable Airport"},
+	{"CCC", "CU", 22.46100044, -78.32839966, "Jardines Del Rey Airport"},
+	{"CCF", "FR", 43.2159996, 2.306319952, "Carcassonne Airport"},
+	{"CCG", "US", 31.4151001, -102.362999, "Crane County Airport"},
+	{"CCH", "CL", -46.58330154, -71.68740082, "Chile Chico Airport"},
+	{"CCI", "BR", -27.18059921, -52.05270004, "Concórdia Airport"},
+	{"CCJ", "IN", 11.13679981, 75.95529938, "Calicut International Airport"},
+	{"CCK", "CC", -12.18830013, 96.83390045, "Cocos (Keeling) Islands Airport"},
+	{"CCL", "AU", -26.77499962, 150.6170044, "Chinchilla Airport"},
+	{"CCM", "BR", -28.72444344, -49.42139053, "Diomício Freitas Airport"},
+	{"CCN", "AF", 34.53300095, 65.26699829, "Chakcharan Airport"},
+	{"CCO", "CO", 4.56417, -71.3364, "Carimagua Airport"},
+	{"CCP", "CL", -36.77270126, -73.06310272, "Carriel Sur Airport"},
+	{"CCR", "US", 37.98970032, -122.0569992, "Buchanan Field"},
+	{"CCS", "VE", 10.60311699, -66.99058533, "Simón Bolívar International Airport"},
+	{"CCU", "IN", 22.65469933, 88.44670105, "Netaji Subhash Chandra Bose International Airport"},
+	{"CCV", "VU", -16.26499939, 167.923996, "Craig Cove Airport"},
+	{"CCW", "AU", -33.66669846, 136.8919983, "Cowell Airport"},
+	{"CCX", "BR", -16.04360008, -57.62990189, "Cáceres Airport"},
+	{"CCY", "US", 43.07260132, -92.6108017, "Northeast Iowa Regional Airport"},
+	{"CCZ", "BS", 25.41710091, -77.88089752, "Chub Cay Airport"},
+	{"CDA", "AU", -12.90330029, 132.5319977, "Cooinda Airport"},
+	{"CDB", "US", 55.20610046, -162.7250061, "Cold Bay Airport"},
+	{"CDC", "US", 37.70100021, -113.098999, "Cedar City Regional Airport"},
+	{"CDD", "HN", 15.3166666, -83.59166718, "Cauquira Airport"},
+	{"CDF", "IT", 46.57300186, 12.11800003, "Cortina Airport"},
+	{"CDG", "FR", 49.01279831, 2.549999952, "Charles de Gaulle International Airport"},
+	{"CDH", "US", 33.62279892, -92.76339722, "Harrell Field"},
+	{"CDI", "BR", -20.83429909, -41.18560028, "Cachoeiro do Itapemirim Airport"},
+	{"CDJ", "BR", -8.348349571, -49.30149841, "Conceição do Araguaia Airport"},
+	{"CDK", "US", 29.13525676, -83.04839015, "George T Lewis Airport"},
+	{"CDL", "US", 65.90769959, -161.9259949, "Candle 2 Airport"},
+	{"CDN", "US", 34.28359985, -80.56490326, "Woodward Field"},
+	{"CDO", "ZA", -32.15670013, 25.64559937, "Cradock Airport"},
+	{"CDP", "IN", 14.51000023, 78.77279663, "Cuddapah Airport"},
+	{"CDQ", "AU", -18.22500038, 142.2579956, "Croydon Airport"},
+	{"CDR", "US", 42.83760071, -103.0950012, "Chadron Municipal Airport"},
+	{"CDS", "US", 34.43379974, -100.288002, "Childress Municipal Airport"},
+	{"CDT", "ES", 39.99919891, 0.026110999, "Castellon De La Plana Airport"},
+	{"CDU", "AU", -34.04029846, 150.6869965, "Camden Airport"},
+	{"CDV", "US", 60.4917984, -145.4779968, "Merle K (Mudhole) Smith Airport"},
+	{"CDW", "US", 40.87519836, -74.28140259, "Essex County Airport"},
+	{"CDY", "PH", 7.013999939, 118.4960022, "Cagayan de Sulu Airport"},
+	{"CEA", "US", 37.64860153, -97.25060272, "Cessna Aircraft Field"},
+	{"CEB", "PH", 10.30749989, 123.9789963, "Mactan Cebu International Airport"},
+	{"CEC", "US", 41.78020096, -124.2369995, "Jack Mc Namara Field Airport"},
+	{"CED", "AU", -32.13059998, 133.7100067, "Ceduna Airport"},
+	{"CEE", "RU", 59.27360153, 38.01580048, "Cherepovets Airport"},
+	{"CEF", "US", 42.19400024, -72.53479767, "Westover ARB/Metropolitan Airport"},
+	{"CEG", "GB", 53.17810059, -2.977780104, "Hawarden Airport"},
+	{"CEH", "MW", -10.55000019, 33.79999924, "Chelinda Malawi Airport"},
+	{"CEI", "TH", 19.95229912, 99.88289642, "Chiang Rai International Airport"},
+	{"CEK", "RU", 55.30580139, 61.50329971, "Chelyabinsk Balandino Airport"},
+	{"CEM", "US", 65.57379913, -144.7830048, "Central Airport"},
+	{"CEN", "MX", 27.39259911, -109.8330002, "Ciudad Obregón International Airport"},
+	{"CEO", "AO", -11.42640018, 15.10140038, "Waco Kungo Airport"},
+	{"CEP", "BO", -16.13829994, -62.02859879, "Concepción Airport"},
+	{"CEQ", "FR", 43.54199982, 6.953479767, "Cannes-Mandelieu Airport"},
+	{"CER", "FR", 49.65010071, -1.470280051, "Cherbourg-Maupertus Airport"},
+	{"CES", "AU", -32.78749847, 151.3419952, "Cessnock Airport"},
+	{"CET", "FR", 47.08209991, -0.87706399, "Cholet Le Pontreau Airport"},
+	{"CEU", "US", 34.6719017, -82.8864975, "Oconee County Regional Airport"},
+	{"CEV", "US", 39.69850159, -85.12969971, "Mettel Field"},
+	{"CEW", "US", 30.77879906, -86.52210236, "Bob Sikes Airport"},
+	{"CEX", "US", 65.05180359, -146.0469971, "Chena Hot Springs Airport"},
+	{"CEY", "US", 36.66460037, -88.37280273, "Kyle Oakley Field"},
+	{"CEZ", "US", 37.3030014, -108.6279984, "Cortez Municipal Airport"},
+	{"CFB", "BR", -22.92169952, -42.07429886, "Cabo Frio Airport"},
+	{"CFC", "BR", -26.78840065, -50.93980026, "Caçador Airport"},
+	{"CFD", "US", 30.71570015, -96.33139801, "Coulter Field"},
+	{"CFE", "FR", 45.7867012, 3.169169903, "Clermont-Ferrand Auvergne Airport"},
+	{"CFF", "AO", -8.783610344, 17.98970032, "Cafunfo Airport"},
+	{"CFG", "CU", 22.14999962, -80.41419983, "Jaime Gonzalez Airport"},
+	{"CFH", "AU", -27.0182991, 138.8919983, "Clifton Hills Airport"},
+	{"CFI", "AU", -17.02169991, 131.3269958, "Camfield Airport"},
+	{"CFK", "DZ", 36.21269989, 1.331769943, "Ech Cheliff Airport"},
+	{"CFN", "IE", 55.0442009, -8.340999603, "Donegal Airport"},
+	{"CFO", "BR", -10.63440037, -51.56359863, "Confresa Airport"},
+	{"CFP", "AU", -18.7166996, 144.3170013, "Carpentaria Downs Airport"},
+	{"CFR", "FR", 49.1733017, -0.449999988, "Caen-Carpiquet Airport"},
+	{"CFS", "AU", -30.32060051, 153.1159973, "Coffs Harbour Airport"},
+	{"CFT", "US", 32.95280075, -109.2099991, "Greenlee County Airport"},
+	{"CFU", "GR", 39.60189819, 19.9116993, "Ioannis Kapodistrias International Airport"},
+	{"CFV", "US", 37.09400177, -95.57189941, "Coffeyville Municipal Airport"},
+	{"CGB", "BR", -15.65289974, -56.11669922, "Marechal Rondon Airport"},
+	{"CGC", "PG", -5.458965268, 148.4324169, "Cape Gloucester Airport"},
+	{"CGD", "CN", 28.91889954, 111.6399994, "Changde Airport"},
+	{"CGE", "US", 38.53929901, -76.03040314, "Cambridge Dorchester Airport"},
+	{"CGF", "US", 41.56510162, -81.48639679, "Cuyahoga County Airport"},
+	{"CGG", "PH", 16.1941, 122.0651, "Casiguran Airport"},
+	{"CGH", "BR", -23.62611008, -46.65638733, "Congonhas Airport"},
+	{"CGI", "US", 37.22529984, -89.57080078, "Cape Girardeau Regional Airport"},
+	{"CGJ", "ZM", -12.51669979, 27.88330078, "Chingola Airport"},
+	{"CGK", "ID", -6.12556982, 106.6559982, "Soekarno-Hatta International Airport"},
+	{"CGM", "PH", 9.253520012, 124.7070007, "Camiguin Airport"},
+	{"CGN", "DE", 50.86589813, 7.142739773, "Cologne Bonn Airport"},
+	{"CGO", "CN", 34.5196991, 113.8410034, "Zhengzhou Xinzheng International Airport"},
+	{"CGP", "BD", 22.24959946, 91.81330109, "Shah Amanat International Airport"},
+	{"CGQ", "CN", 43.99620056, 125.6849976, "Longjia Airport"},
+	{"CGR", "BR", -20.46870041, -54.67250061, "Campo Grande Airport"},
+	{"CGS", "US", 38.98059845, -76.92230225, "College Park Airport"},
+	{"CGT", "MR", 20.5055, -12.3978, "Chinguetti Airport"},
+	{"CGV", "AU", -32.26499939, 125.4929962, "Caiguna Airport"},
+	{"CGY", "PH", 8.612203, 124.456496, "Laguindingan Airport"},
+	{"CHA", "US", 35.03530121, -85.20379639, "Lovell Field"},
+	{"CHB", "PK", 35.42670059, 74.08170319, "Chilas Airport"},
+	{"CHC", "NZ", -43.48939896, 172.5319977, "Christchurch International Airport"},
+	{"CHE", "IE", 51.93333435, -10.23333263, "Reeroe Airport"},
+	{"CHF", "KR", 35.14120102, 128.6959991, "Jinhae Airport"},
+	{"CHG", "CN", 41.5381012, 120.4349976, "Chaoyang Airport"},
+	{"CHH", "PE", -6.201809883, -77.85610199, "Chachapoyas Airport"},
+	{"CHJ", "ZW", -20.20669937, 32.62829971, "Chipinge Airport"},
+	{"CHK", "US", 35.09740067, -97.96769714, "Chickasha Municipal Airport"},
+	{"CHL", "US", 44.52299881, -114.2180023, "Challis Airport"},
+	{"CHM", "PE", -9.149609566, -78.52380371, "Teniente FAP Jaime A De Montreuil Morales Airport"},
+	{"CHN", "KR", 35.8783989, 127.1200027, "Jeon Ju Airport"},
+	{"CHO", "US", 38.1385994, -78.45290375, "Charlottesville Albemarle Airport"},
+	{"CHP", "US", 65.48549652, -144.6109924, "Circle Hot Springs Airport"},
+	{"CHQ", "GR", 35.53170013, 24.14970016, "Chania International Airport"},
+	{"CHR", "FR", 46.86220169, 1.730669975, "Châteauroux-Déols \"Marcel Dassault\" Airport"},
+	{"CHS", "US", 32.89860153, -80.04049683, "Charleston Air Force Base-International Airport"},
+	{"CHT", "NZ", -43.81000137, -176.4570007, "Chatham Islands-Tuuta Airport"},
+	{"CHU", "US", 61.57910156, -159.2160034, "Chuathbaluk Airport"},
+	{"CHV", "PT", 41.72232437, -7.463150024, "Chaves Airport"},
+	{"CHX", "PA", 9.458640099, -82.51679993, "Cap Manuel Niño International Airport"},
+	{"CHY", "SB", -6.711944, 156.396111, "Choiseul Bay Airport"},
+	{"CHZ", "US", 42.57944049, -121.8790627, "Chiloquin State Airport"},
+	{"CIA", "IT", 41.79940033, 12.59490013, "Ciampino–G. B. Pastine International Airport"},
+	{"CIB", "US", 33.4048996, -118.4160004, "Catalina Airport"},
+	{"CIC", "US", 39.79539871, -121.8580017, "Chico Municipal Airport"},
+	{"CID", "US", 41.88470078, -91.71080017, "The Eastern Iowa Airport"},
+	{"CIE", "AU", -33.36669922, 116.2170029, "Collie Airport"},
+	{"CIF", "CN", 42.23500061, 118.9079971, "Chifeng Airport"},
+	{"CIG", "US", 40.49520111, -107.5220032, "Craig Moffat Airport"},
+	{"CIH", "CN", 36.24750137, 113.1259995, "Changzhi Airport"},
+	{"CII", "TR", 37.81499863, 27.89529991, "Çıldır Airport"},
+	{"CIJ", "BO", -11.04039955, -68.78299713, "Capitán Aníbal Arab Airport"},
+	{"CIK", "US", 66.64499664, -143.7400055, "Chalkyitsik Airport"},
+	{"CIL", "US", 64.89790344, -163.7030029, "Council Airport"},
+	{"CIM", "CO", 6.367, -73.967, "Cimitarra Airport"},
+	{"CIN", "US", 42.0461998, -94.78900146, "Arthur N Neu Airport"},
+	{"CIO", "PY", -23.44000053, -57.43000031, "Teniente Col Carmelo Peralta Airport"},
+	{"CIP", "ZM", -13.55830002, 32.58720016, "Chipata Airport"},
+	{"CIQ", "GT", 14.83092, -89.520938, "Chiquimula Airport"},
+	{"CIR", "US", 37.0644989, -89.21959686, "Cairo Regional Airport"},
+	{"CIS", "KI", -2.76812005, -171.7100067, "Canton Island Airport"},
+	{"CIT", "KZ", 42.36420059, 69.47889709, "Shymkent Airport"},
+	{"CIU", "US", 46.25080109, -84.47239685, "Chippewa County International Airport"},
+	{"CIW", "VC", 12.69900036, -61.3423996, "Canouan Airport"},
+	{"CIX", "PE", -6.787479877, -79.82810211, "Capitan FAP Jose A Quinones Gonzales International Airport"},
+	{"CIY", "IT", 36.99460102, 14.60718155, "Comiso Airport"},
+	{"CIZ", "BR", -4.134059906, -63.13259888, "Coari Airport"},
+	{"CJA", "PE", -7.139180183, -78.48940277, "Mayor General FAP Armando Revoredo Iglesias Airport"},
+	{"CJB", "IN", 11.02999973, 77.04340363, "Coimbatore International Airport"},
+	{"CJC", "CL", -22.49819946, -68.9036026, "El Loa Airport"},
+	{"CJH", "CA", 51.62609863, -124.1419983, "Chilko Lake (Tsylos Park Lodge) Airport"},
+	{"CJJ", "KR", 36.71659851, 127.4990005, "Cheongju International Airport"},
+	{"CJL", "PK", 35.88660049, 71.80059814, "Chitral Airport"},
+	{"CJM", "TH", 10.71119976, 99.36170197, "Chumphon Airport"},
+	{"CJS", "MX", 31.63610077, -106.4290009, "Abraham González International Airport"},
+	{"CJT", "MX", 16.17670059, -92.05059814, "Comitan Airport"},
+	{"CJU", "KR", 33.51129913, 126.4929962, "Jeju International Airport"},
+	{"CKA", "US", 36.7439003, -98.12310028, "Kegelman AF Aux Field"},
+	{"CKB", "US", 39.29660034, -80.22810364, "North Central West Virginia Airport"},
+	{"CKC", "UA", 49.41559982, 31.99530029, "Cherkasy International Airport"},
+	{"CKD", "US", 61.86790085, -158.1349945, "Crooked Creek Airport"},
+	{"CKG", "CN", 29.71920013, 106.6419983, "Chongqing Jiangbei International Airport"},
+	{"CKH", "RU", 70.62310028, 147.9019928, "Chokurdakh Airport"},
+	{"CKI", "AU", -11.16499996, 132.4830017, "Croker Island Airport"},
+	{"CKL", "RU", 55.87829971, 38.06169891, "Chkalovskiy Airport"},
+	{"CKM", "US", 34.29970169, -90.51229858, "Fletcher Field"},
+	{"CKN", "US", 47.84170151, -96.62159729, "Crookston Municipal Kirkwood Field"},
+	{"CKO", "BR", -23.15250015, -50.60250092, "Cornélio Procópio Airport"},
+	{"CKR", "US", 48.5978, -122.9979, "Crane Island Airstrip"},
+	{"CKS", "BR", -6.115277767, -50.00138855, "Carajás Airport"},
+	{"CKT", "IR", 36.50120163, 61.06489944, "Sarakhs Airport"},
+	{"CKU", "US", 60.54389954, -145.727005, "Cordova Municipal Airport"},
+	{"CKV", "US", 36.62189865, -87.41500092, "Clarksville–Montgomery County Regional Airport"},
+	{"CKX", "US", 64.07129669, -141.9519959, "Chicken Airport"},
+	{"CKY", "GN", 9.57689, -13.612, "Conakry Airport"},
+	{"CKZ", "TR", 40.13769913, 26.42679977, "Çanakkale Airport"},
+	{"CLA", "BD", 23.43720055, 91.18969727, "Comilla Airport"},
+	{"CLB", "IE", 53.84840012, -9.280369759, "Castlebar Airport"},
+	{"CLD", "US", 33.12829971, -117.2799988, "Mc Clellan-Palomar Airport"},
+	{"CLE", "US", 41.4117012, -81.84980011, "Cleveland Hopkins International Airport"},
+	{"CLG", "US", 36.1631012, -120.2939987, "New Coalinga Municipal Airport"},
+	{"CLH", "AU", -31.77330017, 149.6100006, "Coolah Airport"},
+	{"CLI", "US", 44.61380005, -88.73130035, "Clintonville Municipal Airport"},
+	{"CLJ", "RO", 46.78519821, 23.68619919, "Cluj-Napoca International Airport"},
+	{"CLK", "US", 35.53829956, -98.93270111, "Clinton Regional Airport"},
+	{"CLL", "US", 30.58860016, -96.36380005, "Easterwood Field"},
+	{"CLM", "US", 48.12020111, -123.5, "William R Fairchild International Airport"},
+	{"CLN", "BR", -7.320439816, -47.45869827, "Brig. Lysias Augusto Rodrigues Airport"},
+	{"CLO", "CO", 3.54322, -76.3816, "Alfonso Bonilla Aragon International Airport"},
+	{"CLP", "US", 58.83369827, -158.529007, "Clarks Point Airport"},
+	{"CLQ", "MX", 19.27700043, -103.5770035, "Licenciado Miguel de la Madrid Airport"},
+	{"CLR", "US", 33.13150024, -115.5210037, "Cliff Hatfield Memorial Airport"},
+	{"CLS", "US", 46.67699814, -122.9830017, "Chehalis Centralia Airport"},
+	{"CLT", "US", 35.2140007, -80.94309998, "Charlotte Douglas International Airport"},
+	{"CLU", "US", 39.26190186, -85.89630127, "Columbus Municipal Airport"},
+	{"CLV", "BR", -17.72529984, -48.60749817, "Caldas Novas Airport"},
+	{"CLW", "US", 27.97669983, -82.75869751, "Clearwater Air Park"},
+	{"CLX", "AR", -25.3036, -57.7344, "Clorinda Airport"},
+	{"CLY", "FR", 42.53079987, 8.793190002, "Calvi-Sainte-Catherine Airport"},
+	{"CLZ", "VE", 8.924655914, -67.41709137, "Calabozo Airport"},
+	{"CMA", "AU", -28.03000069, 145.621994, "Cunnamulla Airport"},
+	{"CMB", "LK", 7.180759907, 79.88410187, "Bandaranaike International Colombo Airport"},
+	{"CMC", "BR", -2.896179914, -40.85800171, "Camocim Airport"},
+	{"CMD", "AU", -34.62390137, 148.0279999, "Cootamundra Airport"},
+	{"CME", "MX", 18.65369987, -91.7990036, "Ciudad del Carmen International Airport"},
+	{"CMF", "FR", 45.63809967, 5.88022995, "Chambéry-Savoie Airport"},
+	{"CMG", "BR", -19.01194382, -57.67139053, "Corumbá International Airport"},
+	{"CMH", "US", 39.9980011, -82.89189911, "Port Columbus International Airport"},
+	{"CMI", "US", 40.03919983, -88.27809906, "University of Illinois Willard Airport"},
+	{"CMJ", "TW", 23.21310043, 119.4179993, "Chi Mei Airport"},
+	{"CMK", "MW", -14.30690002, 35.13249969, "Club Makokola Airport"},
+	{"CML", "AU", -19.9116993, 138.125, "Camooweal Airport"},
+	{"CMM", "GT", 17.46120071, -90.05370331, "Carmelita Airport"},
+	{"CMN", "MA", 33.36750031, -7.589970112, "Mohammed V International Airport"},
+	{"CMO", "SO", 5.366666794, 48.51666641, "Obbia Airport"},
+	{"CMP", "BR", -9.319970131, -50.32849884, "Santana do Araguaia Airport"},
+	{"CMQ", "AU", -22.7730999, 147.6210022, "Clermont Airport"},
+	{"CMR", "FR", 48.10990143, 7.35901022, "Colmar-Houssen Airport"},
+	{"CMS", "SO", 10.30000019, 50.23300171, "Scusciuban Airport"},
+	{"CMU", "PG", -6.024290085, 144.970993, "Chimbu Airport"},
+	{"CMV", "NZ", -36.79169846, 175.5090027, "Coromandel Aerodrome"},
+	{"CMW", "CU", 21.42029953, -77.84750366, "Ignacio Agramonte International Airport"},
+	{"CMX", "US", 47.16839981, -88.4890976, "Houghton County Memorial Airport"},
+	{"CMZ", "MZ", -17.8331, 35.3341, "Caia Airport"},
+	{"CNA", "MX", 31.06615015, -110.097878, "Cananea Airport"},
+	{"CNB", "AU", -30.98329926, 148.3760071, "Coonamble Airport"},
+	{"CNC", "AU", -10.05000019, 143.0700073, "Coconut Island Airport"},
+	{"CND", "RO", 44.36220169, 28.48830032, "Mihail Kogălniceanu International Airport"},
+	{"CNE", "US", 38.4280014, -105.1060028, "Fremont County Airport"},
+	{"CNF", "BR", -19.62444305, -43.9719429, "Tancredo Neves International Airport"},
+	{"CNG", "FR", 45.65829849, -0.317499995, "Cognac-Châteaubernard (BA 709) Air Base"},
+	{"CNH", "US", 43.37039948, -72.36869812, "Claremont Municipal Airport"},
+	{"CNI", "CN", 39.26666667, 122.6669444, "Changhai Airport"},
+	{"CNJ", "AU", -20.66860008, 140.5039978, "Cloncurry Airport"},
+	{"CNK", "US", 39.54930115, -97.65229797, "Blosser Municipal Airport"},
+	{"CNL", "DK", 57.50350189, 10.22939968, "Sindal Airport"},
+	{"CNM", "US", 32.33750153, -104.2630005, "Cavern City Air Terminal"},
+	{"CNN", "RU", 56.91389847, 124.9140015, "Chulman Airport"},
+	{"CNO", "US", 33.97470093, -117.637001, "Chino Airport"},
+	{"CNP", "GL", 70.74310303, -22.65049934, "Neerlerit Inaat Airport"},
+	{"CNQ", "AR", -27.4455, -58.7619, "Corrientes Airport"},
+	{"CNR", "CL", -26.33250046, -70.6072998, "Chañaral Airport"},
+	{"CNS", "AU", -16.88579941, 145.7550049, "Cairns International Airport"},
+	{"CNT", "AR", -27.2164, -61.2103, "Charata Airport"},
+	{"CNU", "US", 37.66880035, -95.48509979, "Chanute Martin Johnson Airport"},
+	{"CNV", "BR", -15.66699982, -38.95470047, "Canavieiras Airport"},
+	{"CNW", "US", 31.63780022, -97.07409668, "TSTC Waco Airport"},
+	{"CNX", "TH", 18.76679993, 98.96260071, "Chiang Mai International Airport"},
+	{"CNY", "US", 38.75500107, -109.7549973, "Canyonlands Field"},
+	{"CNZ", "AO", -13.7105999, 19.86109924, "Cangamba Airport"},
+	{"COA", "US", 38.03039932, -120.4150009, "Columbia Airport"},
+	{"COB", "AU", -15.54829979, 130.9620056, "Coolibah Airport"},
+	{"COC", "AR", -31.2969, -57.9966, "Comodoro Pierrestegui Airport"},
+	{"COD", "US", 44.52019882, -109.0240021, "Yellowstone Regional Airport"},
+	{"COE", "US", 47.77429962, -116.8199997, "Coeur D\"Alene - Pappy Boyington Field"},
+	{"COF", "US", 28.23489952, -80.61009979, "Patrick Air Force Base"},
+	{"COG", "CO", 5.08333, -76.7, "Mandinga Airport"},
+	{"COH", "IN", 26.33049965, 89.46720123, "Cooch Behar Airport"},
+	{"COI", "US", 28.34160042, -80.6855011, "Merritt Island Airport"},
+	{"COJ", "AU", -31.33250046, 149.2669983, "Coonabarabran Airport"},
+	{"COK", "IN", 10.15200043, 76.40190125, "Cochin International Airport"},
+	{"COL", "GB", 56.60189819, -6.617780209, "Coll Airport"},
+	{"COM", "US", 31.84110069, -99.4036026, "Coleman Municipal Airport"},
+	{"CON", "US", 43.20270157, -71.50229645, "Concord Municipal Airport"},
+	{"COO", "BJ", 6.357230186, 2.384350061, "Cadjehoun Airport"},
+	{"COP", "US", 42.62919998, -74.89099884, "Cooperstown-Westville Airport"},
+	{"COQ", "MN", 48.13570023, 114.6460037, "Choibalsan Airport"},
+	{"COR", "AR", -31.32360077, -64.20800018, "Ingeniero Ambrosio Taravella Airport"},
+	{"COS", "US", 38.80580139, -104.7009964, "City of Colorado Springs Municipal Airport"},
+	{"COT", "US", 28.45669937, -99.22029877, "Cotulla-La Salle County Airport"},
+	{"COU", "US", 38.81809998, -92.21959686, "Columbia Regional Airport"},
+	{"COV", "PT", 40.26480103, -7.479959965, "Covilha Airport"},
+	{"COW", "CL", -30.198889, -71.246944, "Tambillos Airport"},
+	{"COX", "BS", 24.15870094, -77.58979797, "Congo Town Airport"},
+	{"COY", "AU", -21.7946, 117.755, "Coolawanyah Airport"},
+	{"COZ", "DO", 18.90749931, -70.72190094, "Constanza Dom Re Airport"},
+	{"CPA", "LR", 4.379020214, -7.696949959, "Cape Palmas Airport"},
+	{"CPB", "CO", 8.63333, -77.35, "Capurganá Airport"},
+	{"CPC", "AR", -40.07540131, -71.13729858, "Aviador C. Campos Airport"},
+	{"CPD", "AU", -29.04000092, 134.720993, "Coober Pedy Airport"},
+	{"CPE", "MX", 19.81679916, -90.50029755, "Ingeniero Alberto Acuña Ongay International Airport"},
+	{"CPF", "ID", -7.194841667, 111.5481667, "Cepu Airport"},
+	{"CPG", "AR", -40.7781, -62.9803, "Carmen De Patagones Airport"},
+	{"CPH", "DK", 55.61790085, 12.65600014, "Copenhagen Kastrup Airport"},
+	{"CPI", "PG", -5.448333333, 152.0819444, "Cape Orford Airport"},
+	{"CPL", "CO", 3.717, -75.467, "Chaparral Airport"},
+	{"CPM", "US", 33.88999939, -118.2440033, "Compton Woodley Airport"},
+	{"CPN", "PG", -10.179823, 148.3790302, "Cape Rodney Airport"},
+	{"CPO", "CL", -27.26119995, -70.77919769, "Desierto de Atacama Airport"},
+	{"CPQ", "BR", -22.85919952, -47.10820007, "Amarais Airport"},
+	{"CPR", "US", 42.90800095, -106.4639969, "Casper-Natrona County International Airport"},
+	{"CPS", "US", 38.5707016, -90.15619659, "St Louis Downtown Airport"},
+	{"CPT", "ZA", -33.96480179, 18.60169983, "Cape Town International Airport"},
+	{"CPU", "BR", -1.821111, -44.866944, "Cururupu Airport"},
+	{"CPV", "BR", -7.269919872, -35.89640045, "Presidente João Suassuna Airport"},
+	{"CPX", "PR", 18.313289, -65.304324, "Benjamin Rivera Noriega Airport"},
+	{"CQA", "BR", -13.57444382, -52.2705574, "Canarana Airport"},
+	{"CQD", "IR", 32.29719925, 50.84220123, "Shahrekord Airport"},
+	{"CQF", "FR", 50.96210098, 1.954759955, "Calais-Dunkerque Airport"},
+	{"CQP", "AU", -14.970832, 145.311667, "Cape Flattery Airport"},
+	{"CQS", "BR", -12.42109966, -64.25160217, "Costa Marques Airport"},
+	{"CQT", "CO", 2.033333, -74.216667, "Caquetania Airport"},
+	{"CRA", "RO", 44.31809998, 23.8885994, "Craiova Airport"},
+	{"CRB", "AU", -29.52169991, 148.5820007, "Collarenebri Airport"},
+	{"CRC", "CO", 4.75818, -75.9557, "Santa Ana Airport"},
+	{"CRD", "AR", -45.7853, -67.4655, "General E. Mosconi Airport"},
+	{"CRE", "US", 33.81169891, -78.72389984, "Grand Strand Airport"},
+	{"CRF", "CF", 4.936999798, 15.89400005, "Carnot Airport"},
+	{"CRG", "US", 30.3362999, -81.51439667, "Jacksonville Executive at Craig Airport"},
+	{"CRH", "AU", -28.4301, 152.08895, "Cherrabah Airport"},
+	{"CRI", "BS", 22.74559975, -74.18240356, "Colonel Hill Airport"},
+	{"CRJ", "AU", -31.89439964, 132.2960052, "Coorabie Airport"},
+	{"CRK", "PH", 15.18599987, 120.5599976, "Clark International Airport"},
+	{"CRL", "BE", 50.45920181, 4.453820229, "Brussels South Charleroi Airport"},
+	{"CRM", "PH", 12.5024004, 124.6360016, "Catarman National Airport"},
+	{"CRO", "US", 36.10250092, -119.5950012, "Corcoran Airport"},
+	{"CRP", "US", 27.77039909, -97.50119781, "Corpus Christi International Airport"},
+	{"CRQ", "BR", -17.65229988, -39.25310135, "Caravelas Airport"},
+	{"CRR", "AR", -29.8631, -61.8728, "Ceres Airport"},
+	{"CRS", "US", 32.02809906, -96.40059662, "C David Campbell Field Corsicana Municipal Airport"},
+	{"CRT", "US", 33.17829895, -91.88020325, "Z M Jack Stell Field"},
+	{"CRU", "GD", 12.47609997, -61.47280121, "Lauriston Airport"},
+	{"CRV", "IT", 38.99720001, 17.0802002, "Crotone Airport"},
+	{"CRW", "US", 38.37310028, -81.59320068, "Yeager Airport"},
+	{"CRX", "US", 34.91500092, -88.60350037, "Roscoe Turner Airport"},
+	{"CRY", "AU", -15.50189972, 128.5339966, "Carlton Hill Airport"},
+	{"CRZ", "TM", 39.08330154, 63.61330032, "Turkmenabat Airport"},
+	{"CSA", "GB", 56.05749893, -6.243060112, "Colonsay Airstrip"},
+	{"CSB", "RO", 45.41999817, 22.25329971, "Caransebeş Airport"},
+	{"CSC", "CR", 10.4307003, -85.17459869, "Mojica Airport"},
+	{"CSD", "AU", -17.94799995, 135.9160004, "Cresswell Downs Airport"},
+	{"CSE", "US", 38.85189819, -106.93282, "Buckhorn Ranch Airport"},
+	{"CSF", "FR", 49.25350189, 2.519140005, "Creil Air Base"},
+	{"CSG", "US", 32.5163002, -84.93890381, "Columbus Metropolitan Airport"},
+	{"CSH", "RU", 65.02999878, 35.73333359, "Solovki Airport"},
+	{"CSI", "AU", -28.88279915, 153.0670013, "Casino Airport"},
+	{"CSK", "SN", 12.41020012, -16.74609947, "Cap Skirring Airport"},
+	{"CSM", "US", 35.33980179, -99.20050049, "Clinton Sherman Airport"},
+	{"CSN", "US", 39.19219971, -119.7340012, "Carson Airport"},
+	{"CSO", "DE", 51.85639954, 11.42029953, "Cochstedt Airport"},
+	{"CSQ", "US", 41.02140045, -94.36329651, "Creston Municipal Airport"},
+	{"CSR", "CO", 5.833333, -68.133333, "Casuarito Airport"},
+	{"CSS", "BR", -19.14638901, -51.68527985, "Cassilândia Airport"},
+	{"CSU", "BR", -29.6840992, -52.41220093, "Santa Cruz do Sul Airport"},
+	{"CSV", "US", 35.95130157, -85.08499908, "Crossville Memorial Whitson Field"},
+	{"CSX", "CN", 28.18919945, 113.2200012, "Changsha Huanghua International Airport"},
+	{"CSY", "RU", 56.09030151, 47.34730148, "Cheboksary Airport"},
+	{"CSZ", "AR", -37.44609833, -61.8893013, "Brigadier D.H.E. Ruiz Airport"},
+	{"CTA", "IT", 37.46680069, 15.06639957, "Catania-Fontanarossa Airport"},
+	{"CTB", "US", 48.60839844, -112.3759995, "Cut Bank International Airport"},
+	{"CTC", "AR", -28.59560013, -65.75170136, "Catamarca Airport"},
+	{"CTD", "PA", 7.987840176, -80.40969849, "Alonso Valderrama Airport"},
+	{"CTE", "PA", 9.313469887, -79.10160065, "Carti Airport"},
+	{"CTF", "GT", 14.69419956, -91.88249969, "Coatepeque Airport"},
+	{"CTG", "CO", 10.4424, -75.513, "Rafael Nuñez International Airport"},
+	{"CTH", "US", 39.97900009, -75.8655014, "Chester County G O Carlson Airport"},
+	{"CTI", "AO", -15.16030025, 19.15609932, "Cuito Cuanavale Airport"},
+	{"CTL", "AU", -26.41329956, 146.2619934, "Charleville Airport"},
+	{"CTM", "MX", 18.50469971, -88.32679749, "Chetumal International Airport"},
+	{"CTN", "AU", -15.44470024, 145.1840057, "Cooktown Airport"},
+	{"CTO", "US", 40.9151001, -72.79190063, "Calverton Executive Airpark"},
+	{"CTP", "BR", -1.250277996, -46.01722336, "Carutapera Airport"},
+	{"CTQ", "BR", -33.50222397, -53.3441658, "Santa Vitória do Palmar Airport"},
+	{"CTR", "AU", -17.60700035, 131.548996, "Cattle Creek Airport"},
+	{"CTS", "JP", 42.77519989, 141.6920013, "New Chitose Airport"},
+	{"CTT", "FR", 43.25249863, 5.785190105, "Le Castellet Airport"},
+	{"CTU", "CN", 30.57850075, 103.9469986, "Chengdu Shuangliu International Airport"},
+	{"CTY", "US", 29.63549995, -83.10479736, "Cross City Airport"},
+	{"CTZ", "US", 34.9756012, -78.36460114, "Sampson County Airport"},
+	{"CUA", "MX", 25.05380058, -111.6149979, "Ciudad Constitución Airport"},
+	{"CUB", "US", 33.97050095, -80.99520111, "Jim Hamilton L.B. Owens Airport"},
+	{"CUC", "CO", 7.92757, -72.5115, "Camilo Daza International Airport"},
+	{"CUD", "AU", -26.79999924, 153.1000061, "Caloundra Airport"},
+	{"CUE", "EC", -2.8894701, -78.98439789, "Mariscal Lamar Airport"},
+	{"CUF", "IT", 44.54700089, 7.623219967, "Cuneo International Airport"},
+	{"CUG", "AU", -33.27830124, 148.7630005, "Cudal Airport"},
+	{"CUH", "US", 35.94990158, -96.77310181, "Cushing Municipal Airport"},
+	{"CUI", "CO", 4.666667, -72.0, "Currillo Airport"},
+	{"CUK", "BZ", 17.73469925, -88.03250122, "Caye Caulker Airport"},
+	{"CUL", "MX", 24.76449966, -107.4749985, "Bachigualato Federal International Airport"},
+	{"CUM", "VE", 10.45033264, -64.13047028, "Cumaná (Antonio José de Sucre) Airport"},
+	{"CUN", "MX", 21.03650093, -86.87709808, "Cancún International Airport"},
+	{"CUO", "CO", 1.0136, -71.2961, "Carurú Airport"},
+	{"CUP", "VE", 10.66001415, -63.2616806, "General Francisco Bermúdez Airport"},
+	{"CUQ", "AU", -13.76080036, 143.1139984, "Coen Airport"},
+	{"CUR", "CW", 12.18889999, -68.95980072, "Hato International Airport"},
+	{"CUT", "AR", -38.93970108, -69.26460266, "Cutral-Co Airport"},
+	{"CUU", "MX", 28.70289993, -105.9649963, "General Roberto Fierro Villalobos International Airport"},
+	{"CUV", "VE", 8.75813961, -72.53630066, "Casigua El Cubo Airport"},
+	{"CUY", "AU", -27.44669914, 117.9179993, "Cue Airport"},
+	{"CUZ", "PE", -13.53569984, -71.938797, "Alejandro Velasco Astete International Airport"},
+	{"CVB", "PG", -4.806620121, 144.7149963, "Chungribu Airport"},
+	{"CVC", "AU", -33.70970154, 136.5050049, "Cleve Airport"},
+	{"CVE", "CO", 9.40092, -75.6913, "Coveñas Airport"},
+	{"CVF", "FR", 45.39670181, 6.634719849, "Courchevel Airport"},
+	{"CVG", "US", 39.04880142, -84.6678009, "Cincinnati Northern Kentucky International Airport"},
+	{"CVH", "AR", -37.85139847, -71.00920105, "Caviahue Airport"},
+	{"CVI", "AR", -46.43180084, -67.51860046, "Caleta Olivia Airport"},
+	{"CVJ", "MX", 18.83480072, -99.26129913, "General Mariano Matamoros Airport"},
+	{"CVL", "PG", -9.67, 150.0197222, "Cape Vogel Airport"},
+	{"CVM", "MX", 23.70330048, -98.95649719, "General Pedro Jose Mendez International Airport"},
+	{"CVN", "US", 34.42509842, -103.0790024, "Clovis Municipal Airport"},
+	{"CVO", "US", 44.49720001, -123.2900009, "Corvallis Municipal Airport"},
+	{"CVQ", "AU", -24.88059998, 113.6719971, "Carnarvon Airport"},
+	{"CVS", "US", 34.38280106, -103.3219986, "Cannon Air Force Base"},
+	{"CVT", "GB", 52.36970139, -1.479719996, "Coventry Airport"},
+	{"CVU", "PT", 39.67150116, -31.11359978, "Corvo Airport"},
+	{"CWA", "US", 44.77759933, -89.66680145, "Central Wisconsin Airport"},
+	{"CWB", "BR", -25.5284996, -49.17580032, "Afonso Pena Airport"},
+	{"CWC", "UA", 48.25930023, 25.98080063, "Chernivtsi International Airport"},
+	{"CWE", "EG", 30.11639977, 30.91539955, "Cairo West Airport"},
+	{"CWF", "US", 30.2105999, -93.14320374, "Chennault International Airport"},
+	{"CWI", "US", 41.83110046, -90.32910156, "Clinton Municipal Airport"},
+	{"CWL", "GB", 51.39670181, -3.343329906, "Cardiff International Airport"},
+	{"CWP", "PK", 33.76670074, 72.36689758, "Campbellpore Airport"},
+	{"CWR", "AU", -27.71170044, 138.3280029, "Cowarie Airport"},
+	{"CWS", "US", 48.49010086, -122.8320007, "Center Island Airport"},
+	{"CWT", "AU", -33.84469986, 148.6490021, "Cowra Airport"},
+	{"CWW", "AU", -35.99470139, 146.3569946, "Corowa Airport"},
+	{"CWX", "US", 32.24539948, -109.8949966, "Cochise County Airport"},
+	{"CXA", "VE", 7.625510216, -66.16280365, "Caicara del Orinoco Airport"},
+	{"CXB", "BD", 21.45219994, 91.96389771, "Cox\"s Bazar Airport"},
+	{"CXC", "US", 61.582901, -144.427002, "Chitina Airport"},
+	{"CXF", "US", 67.25219727, -150.2039948, "Coldfoot Airport"},
+	{"CXI", "KI", 1.98616004, -157.3500061, "Cassidy International Airport"},
+	{"CXJ", "BR", -29.19709969, -51.1875, "Hugo Cantergiani Regional Airport"},
+	{"CXL", "US", 32.66949844, -115.5130005, "Calexico International Airport"},
+	{"CXM", "AO", -8.373609543, 18.92359924, "Camaxilo Airport"},
+	{"CXN", "SO", 11.5, 49.91699982, "Candala Airport"},
+	{"CXO", "US", 30.35180092, -95.41449738, "Lone Star Executive Airport"},
+	{"CXP", "ID", -7.645060062, 109.0339966, "Tunggul Wulung Airport"},
+	{"CXR", "VN", 11.99820042, 109.2190018, "Cam Ranh Airport"},
+	{"CXT", "AU", -20.04310036, 146.272995, "Charters Towers Airport"},
+	{"CXY", "BS", 25.60000038, -79.26699829, "Cat Cay Airport"},
+	{"CYA", "HT", 18.27109909, -73.78829956, "Les Cayes Airport"},
+	{"CYB", "KY", 19.68700027, -79.88279724, "Gerrard Smith International Airport"},
+	{"CYC", "BZ", 17.70079994, -88.04109955, "Caye Chapel Airport"},
+	{"CYF", "US", 60.14920044, -164.2859955, "Chefornak Airport"},
+	{"CYG", "AU", -36.18280029, 147.8880005, "Corryong Airport"},
+	{"CYI", "TW", 23.46179962, 120.3929977, "Chiayi Airport"},
+	{"CYL", "HN", 15.44555569, -86.67527771, "Coyoles Airport"},
+	{"CYO", "CU", 21.61650085, -81.54599762, "Vilo Acuña International Airport"},
+	{"CYP", "PH", 12.07269955, 124.5449982, "Calbayog Airport"},
+	{"CYR", "UY", -34.45640182, -57.77059937, "Laguna de Los Patos International Airport"},
+	{"CYS", "US", 41.15570068, -104.8119965, "Cheyenne Regional Jerry Olson Field"},
+	{"CYT", "US", 60.08200073, -142.4929962, "Yakataga Airport"},
+	{"CYU", "PH", 10.85809994, 121.0690002, "Cuyo Airport"},
+	{"CYW", "MX", 20.54599953, -100.887001, "Captain Rogelio Castillo National Airport"},
+	{"CYX", "RU", 68.74060059, 161.3379974, "Cherskiy Airport"},
+	{"CYZ", "PH", 16.92989922, 121.7529984, "Cauayan Airport"},
+	{"CZA", "MX", 20.6413002, -88.44619751, "Chichen Itza International Airport"},
+	{"CZC", "US", 61.94120026, -145.2940063, "Copper Center 2 Airport"},
+	{"CZE", "VE", 11.4149437, -69.68090057, "José Leonardo Chirinos Airport"},
+	{"CZF", "US", 61.78030014, -166.0390015, "Cape Romanzof LRRS Airport"},
+	{"CZH", "BZ", 18.38220024, -88.41190338, "Corozal Municipal Airport"},
+	{"CZJ", "PA", 9.4446, -78.5888, "Corazón de Jesús Airport"},
+	{"CZL", "DZ", 36.27600098, 6.620389938, "Mohamed Boudiaf International Airport"},
+	{"CZM", "MX", 20.5223999, -86.92559814, "Cozumel International Airport"},
+	{"CZN", "US", 62.07120132, -142.0480042, "Chisana Airport"},
+	{"CZS", "BR", -7.599909782, -72.76950073, "Cruzeiro do Sul Airport"},
+	{"CZU", "CO", 9.33274, -75.2856, "Las Brujas Airport"},
+	{"CZW", "PL", 50.88499832, 19.20470047, "Częstochowa-Rudniki"},
+	{"CZX", "CN", 31.91970062, 119.7789993, "Changzhou Airport"},
+	{"CZY", "AU", -24.51670074, 139.6170044, "Cluny Airport"},
+	{"DAA", "US", 38.71500015, -77.18099976, "Davison Army Air Field"},
+	{"DAB", "US", 29.17989922, -81.05809784, "Daytona Beach International Airport"},
+	{"DAC", "BD", 23.843347, 90.397783, "Dhaka / Hazrat Shahjalal International Airport"},
+	{"DAD", "VN", 16.04389954, 108.1989975, "Da Nang International Airport"},
+	{"DAF", "PG", -4.7403, 145.9515, "Daup Airport"},
+	{"DAG", "US", 34.85369873, -116.7870026, "Barstow Daggett Airport"},
+	{"DAK", "EG", 25.41160011, 29.00309944, "Dakhla Airport"},
+	{"DAL", "US", 32.8470993, -96.85179901, "Dallas Love Field"},
+	{"DAM", "SY", 33.41149902, 36.5155983, "Damascus International Airport"},
+	{"DAN", "US", 36.57289886, -79.33609772, "Danville Regional Airport"},
+	{"DAO", "PG", -8.431944444, 147.8430556, "Dabo Airport"},
+	{"DAP", "NP", 29.6692009, 80.54840088, "Darchula Airport"},
+	{"DAR", "TZ", -6.878109932, 39.20259857, "Julius Nyerere International Airport"},
+	{"DAS", "CA", 66.70310211, -119.7070007, "Great Bear Lake Airport"},
+	{"DAT", "CN", 40.06029892, 113.4820023, "Datong Airport"},
+	{"DAU", "PG", -9.086759567, 143.2079926, "Daru Airport"},
+	{"DAV", "PA", 8.390999794, -82.43499756, "Enrique Malek International Airport"},
+	{"DAX", "CN", 31.1302, 107.4295, "Dachuan Airport"},
+	{"DAY", "US", 39.90240097, -84.2193985, "James M Cox Dayton International Airport"},
+	{"DAZ", "AF", 38.46110153, 70.88249969, "Darwaz Airport"},
+	{"DBA", "PK", 28.87829971, 64.39980316, "Dalbandin Airport"},
+	{"DBB", "EG", 30.92449951, 28.46139908, "El Alamein International Airport"},
+	{"DBD", "IN", 23.83399963, 86.4253006, "Dhanbad Airport"},
+	{"DBM", "ET", 10.35000038, 37.71699905, "Debra Marcos Airport"},
+	{"DBN", "US", 32.56439972, -82.98529816, "W H \"Bud\" Barron Airport"},
+	{"DBO", "AU", -32.21670151, 148.5749969, "Dubbo City Regional Airport"},
+	{"DBP", "PG", -6.308611111, 141.9055556, "Debepare Airport"},
+	{"DBQ", "US", 42.40200043, -90.70950317, "Dubuque Regional Airport"},
+	{"DBS", "US", 44.16659927, -112.2259979, "Dubois Municipal Airport"},
+	{"DBT", "ET", 11.96700001, 38.0, "Debre Tabor Airport"},
+	{"DBV", "HR", 42.56140137, 18.26819992, "Dubrovnik Airport"},
+	{"DBY", "AU", -27.15530014, 151.2669983, "Dalby Airport"},
+	{"DCA", "US", 38.85210037, -77.03769684, "Ronald Reagan Washington National Airport"},
+	{"DCF", "DM", 15.33670044, -61.39220047, "Canefield Airport"},
+	{"DCI", "IT", 39.35419846, 8.97247982, "Decimomannu Airport"},
+	{"DCK", "US", 66.94329834, -156.9049988, "Dahl Creek Airport"},
+	{"DCM", "FR", 43.55630112, 2.28918004, "Castres-Mazamet Airport"},
+	{"DCN", "AU", -17.58139992, 123.8280029, "RAAF Base Curtin"},
+	{"DCR", "US", 40.837548, -84.862464, "Decatur HI-Way Airfield"},
+	{"DCT", "BS", 22.18180084, -75.72949982, "Duncan Town Airport"},
+	{"DCU", "US", 34.65269852, -86.94539642, "Pryor Field Regional Airport"},
+	{"DDC", "US", 37.76340103, -99.96559906, "Dodge City Regional Airport"},
+	{"DDG", "CN", 40.02470016, 124.2860031, "Dandong Airport"},
+	{"DDM", "PG", -8.177361111, 147.8094444, "Dodoima Airport"},
+	{"DDN", "AU", -16.99169922, 141.3170013, "Delta Downs Airport"},
+	{"DDU", "PK", 26.74080086, 67.66660309, "Dadu West Airport"},
+	{"DEA", "PK", 29.96100044, 70.48590088, "Dera Ghazi Khan Airport"},
+	{"DEB", "HU", 47.48889923, 21.61529922, "Debrecen International Airport"},
+	{"DEC", "US", 39.83459854, -88.86569977, "Decatur Airport"},
+	{"DED", "IN", 30.18969917, 78.18029785, "Dehradun Airport"},
+	{"DEE", "RU", 43.95840073, 145.6829987, "Mendeleyevo Airport"},
+	{"DEF", "IR", 32.43439865, 48.39759827, "Dezful Airport"},
+	{"DEH", "US", 43.27550125, -91.73940277, "Decorah Municipal Airport"},
+	{"DEI", "SC", -3.80222, 55.666901, "Denis Island Airport"},
+	{"DEL", "IN", 28.56649971, 77.10310364, "Indira Gandhi International Airport"},
+	{"DEM", "ET", 8.553999901, 34.85800171, "Dembidollo Airport"},
+	{"DEN", "US", 39.86169815, -104.6729965, "Denver International Airport"},
+	{"DEP", "IN", 27.98550034, 94.22280121, "Daporijo Airport"},
+	{"DER", "PG", -6.144722222, 147.1072222, "Derim Airport"},
+	{"DES", "SC", -5.6967, 53.6558, "Desroches Airport"},
+	{"DET", "US", 42.40919876, -83.00990295, "Coleman A. Young Municipal Airport"},
+	{"DEZ", "SY", 35.28540039, 40.17599869, "Deir ez-Zor Airport"},
+	{"DFI", "US", 41.33750153, -84.42880249, "Defiance Memorial Airport"},
+	{"DFP", "AU", -16.0529995, 143.0119934, "Drumduff Airport"},
+	{"DFW", "US", 32.89680099, -97.03800201, "Dallas Fort Worth International Airport"},
+	{"DGA", "BZ", 16.98250961, -88.23098755, "Dangriga Airport"},
+	{"DGD", "AU", -27.83027778, 117.3163889, "Dalgaranga Gold Mine Airport"},
+	{"DGE", "AU", -32.5625, 149.6109924, "Mudgee Airport"},
+	{"DGF", "CA", 50.16546005, -120.1712734, "Douglas Lake Airport"},
+	{"DGL", "US", 31.34259987, -109.5059967, "Douglas Municipal Airport"},
+	{"DGN", "US", 38.33250046, -77.03720093, "Dahlgren Naval Surface Warfare Center Airport"},
+	{"DGO", "MX", 24.12420082, -104.5279999, "General Guadalupe Victoria International Airport"},
+	{"DGP", "LV", 55.94472122, 26.66500092, "Daugavpils Intrenational Airport"},
+	{"DGR", "NZ", -35.93970108, 173.8939972, "Dargaville Aerodrome"},
+	{"DGT", "PH", 9.333709717, 123.3000031, "Sibulan Airport"},
+	{"DGU", "BF", 12.45899963, -3.49000001, "Dedougou Airport"},
+	{"DGW", "US", 42.79719925, -105.3860016, "Converse County Airport"},
+	{"DGX", "GB", 51.40480042, -3.435750008, "MOD St. Athan"},
+	{"DHA", "SA", 26.26539993, 50.15200043, "King Abdulaziz Air Base"},
+	{"DHD", "AU", -27.07500076, 141.8999939, "Durham Downs Airport"},
+	{"DHF", "AE", 24.24819946, 54.54769897, "Al Dhafra Air Base"},
+	{"DHI", "NP", 28.75329971, 80.58190155, "Dhangarhi Airport"},
+	{"DHM", "IN", 32.1651001, 76.26339722, "Kangra Airport"},
+	{"DHN", "US", 31.32130051, -85.44960022, "Dothan Regional Airport"},
+	{"DHR", "NL", 52.92340088, 4.780620098, "De Kooy Airport"},
+	{"DHT", "US", 36.02259827, -102.5469971, "Dalhart Municipal Airport"},
+	{"DIB", "IN", 27.48390007, 95.01689911, "Dibrugarh Airport"},
+	{"DIE", "MG", -12.34939957, 49.29169846, "Arrachart Airport"},
+	{"DIG", "CN", 27.79360008, 99.67720032, "Diqing Airport"},
+	{"DIJ", "FR", 47.26890183, 5.090000153, "Dijon-Bourgogne Airport"},
+	{"DIK", "US", 46.79740143, -102.802002, "Dickinson Theodore Roosevelt Regional Airport"},
+	{"DIL", "TL", -8.54640007, 125.526001, "Presidente Nicolau Lobato International Airport"},
+	{"DIM", "CI", 6.651669979, -4.64056015, "Dimbokro Airport"},
+	{"DIN", "VN", 21.39749908, 103.0080032, "Dien Bien Phu Airport"},
+	{"DIP", "BF", 12.05000019, 1.782999992, "Diapaga Airport"},
+	{"DIQ", "BR", -20.1807003, -44.8708992, "Divinópolis Airport"},
+	{"DIR", "ET", 9.624699593, 41.85419846, "Aba Tenna Dejazmach Yilma International Airport"},
+	{"DIS", "CG", -4.20635, 12.6599, "Ngot Nzoungou Airport"},
+	{"DIU", "IN", 20.71310043, 70.9210968, "Diu Airport"},
+	{"DIY", "TR", 37.89390183, 40.20100021, "Diyarbakir Airport"},
+	{"DJA", "BJ", 9.692083333, 1.637777778, "Djougou Airport"},
+	{"DJB", "ID", -1.638020039, 103.6439972, "Sultan Thaha Airport"},
+	{"DJE", "TN", 33.875, 10.7755003, "Djerba Zarzis International Airport"},
+	{"DJG", "DZ", 24.2928009, 9.452440262, "Djanet Inedbirene Airport"},
+	{"DJJ", "ID", -2.576950073, 140.5160065, "Sentani Airport"},
+	{"DJM", "CG", -2.532999992, 14.75, "Djambala Airport"},
+	{"DJN", "US", 64.05039978, -145.7169952, "Delta Junction Airport"},
+	{"DJO", "CI", 6.792809963, -6.473189831, "Daloa Airport"},
+	{"DJR", "AU", -21.70829964, 139.5330048, "Dajarra Airport"},
+	{"DJU", "IS", 64.64420319, -14.28279972, "Djúpivogur Airport"},
+	{"DKA", "NG", 13.0078001, 7.660449982, "Katsina Airport"},
+	{"DKI", "AU", -17.94169998, 146.1399994, "Dunk Island Airport"},
+	{"DKK", "US", 42.49330139, -79.27200317, "Chautauqua County-Dunkirk Airport"},
+	{"DKR", "SN", 14.73970032, -17.49020004, "Léopold Sédar Senghor International Airport"},
+	{"DKS", "RU", 73.51780701, 80.37966919, "Dikson Airport"},
+	{"DKV", "AU", -24.86000061, 129.0700073, "Docker River Airport"},
+	{"DLA", "CM", 4.006080151, 9.719479561, "Douala International Airport"},
+	{"DLC", "CN", 38.96569824, 121.5390015, "Zhoushuizi Airport"},
+	{"DLD", "NO", 60.41730118, 8.518349648, "Geilo Airport Dagali"},
+	{"DLE", "FR", 47.03900146, 5.427249908, "Dole-Tavaux Airport"},
+	{"DLF", "US", 29.35950089, -100.7779999, "Laughlin Air Force Base"},
+	{"DLG", "US", 59.04470062, -158.5050049, "Dillingham Airport"},
+	{"DLH", "US", 46.84209824, -92.19360352, "Duluth International Airport"},
+	{"DLI", "VN", 11.75, 108.3669968, "Lien Khuong Airport"},
+	{"DLK", "AU", -29.01329994, 138.4810028, "Dulkaninna Airport"},
+	{"DLL", "US", 34.44910049, -79.36859894, "Dillon County Airport"},
+	{"DLM", "TR", 36.71310043, 28.79249954, "Dalaman International Airport"},
+	{"DLN", "US", 45.25540161, -112.5530014, "Dillon Airport"},
+	{"DLS", "US", 45.61849976, -121.1669998, "Columbia Gorge Regional the Dalles Municipal Airport"},
+	{"DLU", "CN", 25.64940071, 100.3190002, "Dali Airport"},
+	{"DLV", "AU", -12.55000019, 130.6849976, "Delissaville Airport"},
+	{"DLX", "KZ", 50.35129929, 80.23439789, "Semipalatinsk Airport"},
+	{"DLY", "VU", -18.76939964, 169.0010071, "Dillon\"s Bay Airport"},
+	{"DLZ", "MN", 43.59170151, 104.4300003, "Dalanzadgad Airport"},
+	{"DMA", "US", 32.16650009, -110.8830032, "Davis Monthan Air Force Base"},
+	{"DMB", "KZ", 42.85359955, 71.3035965, "Taraz Airport"},
+	{"DMD", "AU", -17.94029999, 138.8220062, "Doomadgee Airport"},
+	{"DME", "RU", 55.40879822, 37.90629959, "Domodedovo International Airport"},
+	{"DMK", "TH", 13.91259956, 100.6070023, "Don Mueang International Airport"},
+	{"DMM", "SA", 26.47120094, 49.79790115, "King Fahd International Airport"},
+	{"DMN", "US", 32.26229858, -107.7210007, "Deming Municipal Airport"},
+	{"DMO", "US", 38.70740128, -93.17590332, "Sedalia Memorial Airport"},
+	{"DMT", "BR", -14.37689972, -56.40039825, "Diamantino Airport"},
+	{"DMU", "IN", 25.88389969, 93.77110291, "Dimapur Airport"},
+	{"DNA", "JP", 26.35560036, 127.7679977, "Kadena Air Base"},
+	{"DNB", "AU", -16.04999924, 142.3999939, "Dunbar Airport"},
+	{"DND", "GB", 56.45249939, -3.02583003, "Dundee Airport"},
+	{"DNF", "LY", 32.54199982, 22.74500084, "Martubah Airport"},
+	{"DNH", "CN", 40.16109848, 94.80919647, "Dunhuang Airport"},
+	{"DNK", "UA", 48.35720062, 35.1006012, "Dnipropetrovsk International Airport"},
+	{"DNL", "US", 33.46649933, -82.03939819, "Daniel Field"},
+	{"DNM", "AU", -25.89389992, 113.5770035, "Shark Bay Airport"},
+	{"DNN", "US", 34.72290039, -84.87020111, "Dalton Municipal Airport"},
+	{"DNO", "BR", -11.59539986, -46.84669876, "Dianópolis Airport"},
+	{"DNP", "NP", 28.11109924, 82.29419708, "Tulsipur Airport"},
+	{"DNQ", "AU", -35.55939865, 144.9459991, "Deniliquin Airport"},
+	{"DNR", "FR", 48.58769989, -2.079960108, "Dinard-Pleurtuit-Saint-Malo Airport"},
+	{"DNS", "US", 41.9864006, -95.38069916, "Denison Municipal Airport"},
+	{"DNU", "PG", -6.1546, 146.6772, "Dinangat Airport"},
+	{"DNV", "US", 40.19919968, -87.59590149, "Vermilion Regional Airport"},
+	{"DNX", "SD", 12.53299999, 35.06700134, "Galegu Airport"},
+	{"DNZ", "TR", 37.78559875, 29.70129967, "Çardak Airport"},
+	{"DOA", "MG", -14.36810017, 49.51079941, "Doany Airport"},
+	{"DOB", "ID", -5.772220135, 134.2120056, "Rar Gwamar Airport"},
+	{"DOC", "GB", 57.86899948, -4.022999763, "Dornoch Airport"},
+	{"DOD", "TZ", -6.170440197, 35.75260162, "Dodoma Airport"},
+	{"DOE", "SR", 4.005712611, -55.48164368, "Djumu-Djomoe Airport"},
+	{"DOG", "SD", 19.15390015, 30.43009949, "Dongola Airport"},
+	{"DOH", "QA", 25.273056, 51.608056, "Hamad International Airport"},
+	{"DOI", "PG", -10.7009, 150.7218, "Doini Airport"},
+	{"DOK", "UA", 48.07360077, 37.73970032, "Donetsk International Airport"},
+	{"DOL", "FR", 49.36529922, 0.154305995, "Deauville-Saint-Gatien Airport"},
+	{"DOM", "DM", 15.54699993, -61.29999924, "Melville Hall Airport"},
+	{"DON", "GT", 17.61240005, -89.68840027, "Dos Lagunas Airport"},
+	{"DOO", "PG", -9.461855, 147.920907, "Dorobisoro Airport"},
+	{"DOP", "NP", 28.98570061, 82.81909943, "Dolpa Airport"},
+	{"DOR", "BF", 14.03299999, -0.033, "Dori Airport"},
+	{"DOU", "BR", -22.20190048, -54.92660141, "Dourados Airport"},
+	{"DOV", "US", 39.12950134, -75.46600342, "Dover Air Force Base"},
+	{"DOX", "AU", -29.29999924, 114.9329987, "Dongara Airport"},
+	{"DOY", "CN", 37.50859833, 118.788002, "Dongying Shengli Airport"},
+	{"DPA", "US", 41.90779877, -88.24859619, "Dupage Airport"},
+	{"DPE", "FR", 49.88249969, 1.085279942, "St Aubin Airport"},
+	{"DPG", "US", 40.19940186, -112.9369965, "Michael AAF (Dugway Proving Ground) Airport"},
+	{"DPL", "PH", 8.601983499, 123.3418751, "Dipolog Airport"},
+	{"DPO", "AU", -41.16970062, 146.4299927, "Devonport Airport"},
+	{"DPS", "ID", -8.748169899, 115.1669998, "Ngurah Rai (Bali) International Airport"},
+	{"DQA", "CN", 46.74638889, 125.1405556, "Saertu Airport"},
+	{"DQH", "US", 70.34429932, -150.9450073, "Alpine Airstrip"},
+	{"DRB", "AU", -17.37000084, 123.6610031, "Derby Airport"},
+	{"DRC", "AO", -17.98192406, 20.76806068, "Dirico Airport"},
+	{"DRD", "AU", -16.5537, 141.8238, "Dorunda Airport"},
+	{"DRE", "US", 46.00930023, -83.74389648, "Drummond Island Airport"},
+	{"DRF", "US", 60.58890152, -152.1620026, "Drift River Airport"},
+	{"DRG", "US", 66.06960297, -162.7660065, "Deering Airport"},
+	{"DRH", "ID", -3.2705, 138.6132, "Dabra Airport"},
+	{"DRI", "US", 30.83169937, -93.33989716, "Beauregard Regional Airport"},
+	{"DRJ", "SR", 4.116666794, -54.66667175, "Drietabbetje Airport"},
+	{"DRK", "CR", 8.71889019, -83.64170074, "Drake Bay Airport"},
+	{"DRN", "AU", -28.5916996, 148.2169952, "Dirranbandi Airport"},
+	{"DRO", "US", 37.1515007, -107.7539978, "Durango La Plata County Airport"},
+	{"DRR", "AU", -25.68499947, 140.2279968, "Durrie Airport"},
+	{"DRS", "DE", 51.13280106, 13.76720047, "Dresden Airport"},
+	{"DRT", "US", 29.37420082, -100.927002, "Del Rio International Airport"},
+	{"DRU", "US", 46.62080002, -113.2050018, "Drummond Airport"},
+	{"DRW", "AU", -12.41469955, 130.8769989, "Darwin International Airport"},
+	{"DRY", "AU", -15.7135704, 126.3810968, "Drysdale River Airport"},
+	{"DSA", "GB", 53.48053781, -1.010656357, "Robin Hood Doncaster Sheffield Airport"},
+	{"DSC", "CM", 5.449999809, 10.06700039, "Dschang Airport"},
+	{"DSD", "GP", 16.2968998, -61.08440018, "La Désirade Airport"},
+	{"DSE", "ET", 11.08250046, 39.71139908, "Combolcha Airport"},
+	{"DSG", "PH", 16.44583333, 122.2068056, "Dilasag Airport"},
+	{"DSI", "US", 30.40010071, -86.47149658, "Destin Fort Walton Beach Airport"},
+	{"DSK", "PK", 31.90940094, 70.89659882, "Dera Ismael Khan Airport"},
+	{"DSM", "US", 41.5340004, -93.6631012, "Des Moines International Airport"},
+	{"DSN", "CN", 39.49, 109.8613889, "Ordos Ejin Horo Airport"},
+	{"DSO", "KP", 39.74520111, 127.473999, "Sondok Airport"},
+	{"DSV", "US", 42.57089996, -77.71309662, "Dansville Municipal Airport"},
+	{"DTA", "US", 39.38059998, -112.5080032, "Delta Municipal Airport"},
+	{"DTD", "ID", 0.8106, 114.5306, "Datadawai Airport"},
+	{"DTE", "PH", 14.12919998, 122.9800034, "Daet Airport"},
+	{"DTI", "BR", -18.23200035, -43.65039825, "Diamantina Airport"},
+	{"DTL", "US", 46.82519913, -95.88569641, "Detroit Lakes Airport - Wething Field"},
+	{"DTM", "DE", 51.5182991, 7.612239838, "Dortmund Airport"},
+	{"DTN", "US", 32.54019928, -93.74500275, "Shreveport Downtown Airport"},
+	{"DTR", "US", 48.49980164, -122.814003, "Decatur Shores Airport"},
+	{"DTW", "US", 42.21239853, -83.35340118, "Detroit Metropolitan Wayne County Airport"},
+	{"DUA", "US", 33.94229889, -96.39450073, "Eaker Field"},
+	{"DUB", "IE", 53.42129898, -6.270070076, "Dublin Airport"},
+	{"DUC", "US", 34.47090149, -97.9598999, "Halliburton Field"},
+	{"DUD", "NZ", -45.92810059, 170.197998, "Dunedin Airport"},
+	{"DUE", "AO", -7.400889874, 20.81850052, "Dundo Airport"},
+	{"DUF", "US", 36.25350189, -75.78849792, "Pine Island Airport"},
+	{"DUG", "US", 31.46899986, -109.6039963, "Bisbee Douglas International Airport"},
+	{"DUJ", "US", 41.17829895, -78.8986969, "DuBois Regional Airport"},
+	{"DUK", "ZA", -28.36840057, 32.24810028, "Mubatuba Airport"},
+	{"DUM", "ID", 1.609189987, 101.4339981, "Pinang Kampai Airport"},
+	{"DUQ", "CA", 48.75453362, -123.7097025, "Duncan Airport"},
+	{"DUR", "ZA", -29.61444444, 31.11972222, "King Shaka International Airport"},
+	{"DUS", "DE", 51.28950119, 6.7667799, "Düsseldorf International Airport"},
+	{"DUT", "US", 53.90010071, -166.5440063, "Unalaska Airport"},
+	{"DVK", "CA", 64.51139832, -110.2890015, "Diavik Airport"},
+	{"DVL", "US", 48.11420059, -98.90879822, "Devils Lake Regional Airport"},
+	{"DVN", "US", 41.61029816, -90.58830261, "Davenport Municipal Airport"},
+	{"DVO", "PH", 7.125520229, 125.6460037, "Francisco Bangoy International Airport"},
+	{"DVP", "AU", -24.14999962, 141.1080017, "Davenport Downs Airport"},
+	{"DVR", "AU", -13.7498064, 130.6938782, "Daly River Airport"},
+	{"DVT", "US", 33.68830109, -112.0830002, "Phoenix Deer Valley Airport"},
+	{"DWA", "MW", -12.51830006, 34.13190079, "Dwangwa Airport"},
+	{"DWB", "MG", -16.10169042, 45.35883665, "Soalala Airport"},
+	{"DWC", "AE", 24.89666667, 55.16138889, "Al Maktoum International Airport"},
+	{"DWD", "SA", 24.5, 44.40000153, "Dawadmi Domestic Airport"},
+	{"DWH", "US", 30.0618, -95.55280304, "David Wayne Hooks Memorial Airport"},
+	{"DWN", "US", 35.44919968, -97.53309631, "Downtown Airpark"},
+	{"DWR", "AF", 31.0, 64.0, "Dywer Airbase"},
+	{"DXB", "AE", 25.25279999, 55.36439896, "Dubai International Airport"},
+	{"DXD", "AU", -15.11749443, 143.3160496, "Dixie Airport"},
+	{"DXR", "US", 41.37149811, -73.48220062, "Danbury Municipal Airport"},
+	{"DYA", "AU", -22.62220001, 148.3639984, "Dysart Airport"},
+	{"DYG", "CN", 29.10280037, 110.4430008, "Dayong Airport"},
+	{"DYL", "US", 40.33300018, -75.12229919, "Doylestown Airport"},
+	{"DYM", "AU", -23.76169968, 141.1450043, "Diamantina Lakes Airport"},
+	{"DYR", "RU", 64.73490143, 177.7409973, "Ugolny Airport"},
+	{"DYS", "US", 32.42079926, -99.854599, "Dyess Air Force Base"},
+	{"DYU", "TJ", 38.54330063, 68.82499695, "Dushanbe Airport"},
+	{"DYW", "AU", -16.26469507, 133.383379, "Daly Waters Airport"},
+	{"DZA", "YT", -12.8046999, 45.28110123, "Dzaoudzi Pamandzi International Airport"},
+	{"DZI", "CO", 9.6, -73.45, "Codazzi Airport"},
+	{"DZN", "KZ", 47.77080154, 67.67340088, "Dzhezkazgan Airport"},
+	{"DZO", "UY", -33.35889816, -56.49919891, "Santa Bernardina International Airport"},
+	{"DZU", "CN", 29.636227, 105.773621, "Dazu Air Base"},
+	{"EAA", "US", 64.77639771, -141.151001, "Eagle Airport"},
+	{"EAB", "YE", 15.30000019, 43.96699905, "Abbse Airport"},
+	{"EAE", "VU", -17.09029961, 168.3430023, "Siwo Airport"},
+	{"EAL", "MH", 9.319305556, 166.8477778, "Elenak Airport"},
+	{"EAM", "SA", 17.6114006, 44.4192009, "Nejran Airport"},
+	{"EAR", "US", 40.72700119, -99.00679779, "Kearney Regional Airport"},
+	{"EAS", "ES", 43.35649872, -1.790609956, "San Sebastian Airport"},
+	{"EAT", "US", 47.39889908, -120.2070007, "Pangborn Memorial Airport"},
+	{"EAU", "US", 44.86579895, -91.48429871, "Chippewa Valley Regional Airport"},
+	{"EBA", "IT", 42.76029968, 10.23939991, "Marina Di Campo Airport"},
+	{"EBB", "UG", 0.042385999, 32.44350052, "Entebbe International Airport"},
+	{"EBD", "SD", 13.15320015, 30.23270035, "El Obeid Airport"},
+	{"EBG", "CO", 7.59647, -74.8089, "El Bagre Airport"},
+	{"EBH", "DZ", 33.72166667, 1.0925, "El Bayadh Airport"},
+	{"EBJ", "DK", 55.52590179, 8.55340004, "Esbjerg Airport"},
+	{"EBL", "IQ", 36.23759842, 43.96319962, "Erbil International Airport"},
+	{"EBM", "TN", 31.70429993, 9.254619598, "El Borma Airport"},
+	{"EBN", "MH", 9.330559731, 166.8200073, "Ebadon Airport"},
+	{"EBO", "MH", 4.5982, 168.752, "Ebon Airport"},
+	{"EBS", "US", 42.43659973, -93.86889648, "Webster City Municipal Airport"},
+	{"EBU", "FR", 45.54059982, 4.296390057, "Saint-Étienne-Bouthéon Airport"},
+	{"EBW", "CM", 2.875999928, 11.18500042, "Ebolowa Airport"},
+	{"ECG", "US", 36.26060104, -76.17459869, "Elizabeth City Regional Airport & Coast Guard Air Station"},
+	{"ECH", "AU", -36.15719986, 144.7619934, "Echuca Airport"},
+	{"ECN", "CY", 35.15470123, 33.49610138, "Ercan International Airport"},
+	{"ECO", "CO", -1.753333333, -73.20472222, "El Encanto Airport"},
+	{"ECP", "US", 30.3417, -85.7973, "Northwest Florida Beaches International Airport"},
+	{"ECR", "CO", 2.449444444, -78.09416667, "El Charco Airport"},
+	{"ECV", "ES", 40.37070084, -3.785140038, "Cuatro Vientos Airport"},
+	{"EDB", "SD", 18.0146, 30.9595, "El Debba Airport"},
+	{"EDD", "AU", -25.2057991, 133.2539978, "Erldunda Airport"},
+	{"EDE", "US", 36.02769852, -76.56710052, "Northeastern Regional Airport"},
+	{"EDF", "US", 61.25099945, -149.8070068, "Elmendorf Air Force Base"},
+	{"EDI", "GB", 55.95000076, -3.372499943, "Edinburgh Airport"},
+	{"EDK", "US", 37.77410126, -96.81759644, "Captain Jack Thomas El Dorado Airport"},
+	{"EDL", "KE", 0.404457986, 35.23889923, "Eldoret International Airport"},
+	{"EDM", "FR", 46.70190048, -1.378630042, "La Roche-sur-Yon Airport"},
+	{"EDO", "TR", 39.55459976, 27.01379967, "Balıkesir Körfez Airport"},
+	{"EDR", "AU", -14.89669991, 141.6089935, "Pormpuraaw Airport"},
+	{"EED", "US", 34.7663002, -114.6230011, "Needles Airport"},
+	{"EEK", "US", 60.21367264, -162.0438843, "Eek Airport"},
+	{"EEN", "US", 42.89839935, -72.27079773, "Dillant Hopkins Airport"},
+	{"EFD", "US", 29.6072998, -95.15879822, "Ellington Airport"},
+	{"EFG", "PG", -9.153805556, 147.6598056, "Efogi Airport"},
+	{"EFK", "US", 44.88880157, -72.22920227, "Newport State Airport"},
+	{"EFL", "GR", 38.12009811, 20.50049973, "Kefallinia Airport"},
+	{"EFW", "US", 42.0102005, -94.34259796, "Jefferson Municipal Airport"},
+	{"EGA", "PG", -6.9179, 146.1053, "Engati Airstrip"},
+	{"EGC", "FR", 44.82529831, 0.518611014, "Bergerac-Roumanière Airport"},
+	{"EGE", "US", 39.64260101, -106.9179993, "Eagle County Regional Airport"},
+	{"EGH", "EG", 31.06900024, 34.12919998, "El Gora Airport"},
+	{"EGI", "US", 30.65040016, -86.52290344, "Duke Field"},
+	{"EGL", "ET", 5.2897, 39.7023, "Negele Airport"},
+	{"EGM", "SB", -8.578889847, 157.8760071, "Sege Airport"},
+	{"EGN", "SD", 13.48169994, 22.46719933, "Geneina Airport"},
+	{"EGO", "RU", 50.64379883, 36.59009933, "Belgorod International Airport"},
+	{"EGP", "US", 28.85720062, -100.512001, "Maverick County Memorial International Airport"},
+	{"EGS", "IS", 65.28330231, -14.40139961, "Egilsstaðir Airport"},
+	{"EGV", "US", 45.93230057, -89.26830292, "Eagle River Union Airport"},
+	{"EGX", "US", 58.1855011, -157.375, "Egegik Airport"},
+	{"EHL", "AR", -41.94319916, -71.53230286, "El Bolson Airport"},
+	{"EHM", "US", 58.64640045, -162.0630035, "Cape Newenham LRRS Airport"},
+	{"EIB", "DE", 50.99277878, 10.47277832, "Eisenach-Kindel Airport"},
+	{"EIE", "RU", 58.4742012, 92.11250305, "Yeniseysk Airport"},
+	{"EIH", "AU", -18.50329971, 144.0939941, "Einasleigh Airport"},
+	{"EIK", "RU", 46.68, 38.21, "Yeysk Airport"},
+	{"EIL", "US", 64.66570282, -147.102005, "Eielson Air Force Base"},
+	{"EIN", "NL", 51.45009995, 5.374529839, "Eindhoven Airport"},
+	{"EIS", "VG", 18.44479942, -64.54299927, "Terrance B. Lettsome International Airport"},
+	{"EIY", "IL", 30.62170029, 35.20330048, "Ein Yahav Airfield"},
+	{"EJA", "CO", 7.02433, -73.8068, "Yariguíes Airport"},
+	{"EJH", "SA", 26.19860077, 36.47639847, "Al Wajh Domestic Airport"},
+	{"EKA", "US", 40.80339813, -124.112999, "Murray Field"},
+	{"EKB", "KZ", 51.5909996, 75.21499634, "Ekibastuz Airport"},
+	{"EKD", "AU", -21.17250061, 135.4440002, "Elkedra Airport"},
+	{"EKI", "US", 41.7193985, -86.00319672, "Elkhart Municipal Airport"},
+	{"EKN", "US", 38.88940048, -79.85710144, "Elkins-Randolph Co-Jennings Randolph Field"},
+	{"EKO", "US", 40.82490158, -115.7919998, "Elko Regional Airport"},
+	{"EKS", "RU", 49.19029999, 142.0829926, "Shakhtyorsk Airport"},
+	{"EKT", "SE", 59.35110092, 16.70840073, "Eskilstuna Airport"},
+	{"EKX", "US", 37.68600082, -85.92500305, "Addington Field"},
+	{"ELA", "US", 29.60059929, -96.32189941, "Eagle Lake Airport"},
+	{"ELB", "CO", 9.04554, -73.9749, "Las Flores Airport"},
+	{"ELC", "AU", -12.01939964, 135.5709991, "Elcho Island Airport"},
+	{"ELD", "US", 33.22100067, -92.81330109, "South Arkansas Regional At Goodwin Field"},
+	{"ELE", "PA", 8.133000374, -77.69999695, "EL Real Airport"},
+	{"ELF", "SD", 13.61489964, 25.32460022, "El Fasher Airport"},
+	{"ELG", "DZ", 30.57130051, 2.859590054, "El Golea Airport"},
+	{"ELH", "BS", 25.47489929, -76.6835022, "North Eleuthera Airport"},
+	{"ELI", "US", 64.61470032, -162.2720032, "Elim Airport"},
+	{"ELJ", "CO", 2.0, -74.133333, "El Recreo Airport"},
+	{"ELK", "US", 35.43080139, -99.39430237, "Elk City Regional Business Airport"},
+	{"ELL", "ZA", -23.72669983, 27.68829918, "Ellisras Matimba Airport"},
+	{"ELM", "US", 42.15990067, -76.89160156, "Elmira Corning Regional Airport"},
+	{"ELN", "US", 47.03300095, -120.5309982, "Bowers Field"},
+	{"ELO", "AR", -26.39749908, -54.5746994, "El Dorado Airport"},
+	{"ELP", "US", 31.80719948, -106.3779984, "El Paso International Airport"},
+	{"ELQ", "SA", 26.30279922, 43.7743988, "Gassim Airport"},
+	{"ELR", "ID", -3.7826, 139.3861, "Elelim Airport"},
+	{"ELS", "ZA", -33.03559875, 27.82589912, "Ben Schoeman Airport"},
+	{"ELT", "EG", 28.20899963, 33.64550018, "El Tor Airport"},
+	{"ELU", "DZ", 33.51139832, 6.776790142, "Guemar Airport"},
+	{"ELY", "US", 39.29970169, -114.8420029, "Ely Airport Yelland Field"},
+	{"ELZ", "US", 42.10950089, -77.98999786, "Wellsville Municipal Arpt,Tarantine Field"},
+	{"EMA", "GB", 52.83110046, -1.328060031, "East Midlands Airport"},
+	{"EMD", "AU", -23.56749916, 148.1790009, "Emerald Airport"},
+	{"EME", "DE", 53.39110947, 7.227499962, "Emden Airport"},
+	{"EMG", "ZA", -28.71999931, 31.88999939, "Empangeni Airport"},
+	{"EMI", "PG", -1.653949991, 149.9757667, "Emirau Airport"},
+	{"EMK", "US", 62.78609848, -164.4909973, "Emmonak Airport"},
+	{"EMM", "US", 41.82410049, -110.5569992, "Kemmerer Municipal Airport"},
+	{"EMN", "MR", 16.62199974, -7.316599846, "Néma Airport"},
+	{"EMO", "PG", -9.1234, 148.0415, "Emo River Airstrip"},
+	{"EMP", "US", 38.33209991, -96.19120026, "Emporia Municipal Airport"},
+	{"EMS", "PG", -9.447, 148.7628, "Embessa Airport"},
+	{"EMT", "US", 34.08610153, -118.0350037, "El Monte Airport"},
+	{"EMX", "AR", -42.02920151, -71.17250061, "El Maiten Airport"},
+	{"EMY", "EG", 28.10129929, 30.7303009, "El Minya Airport"},
+	{"ENA", "US", 60.57310104, -151.2449951, "Kenai Municipal Airport"},
+	{"ENB", "AU", -29.83250046, 115.2460022, "Eneabba Airport"},
+	{"ENC", "FR", 48.69210052, 6.230460167, "Nancy-Essey Airport"},
+	{"END", "US", 36.33919907, -97.91649628, "Vance Air Force Base"},
+	{"ENE", "ID", -8.849289894, 121.6610031, "Ende (H Hasan Aroeboesman) Airport"},
+	{"ENF", "FI", 68.36260223, 23.42429924, "Enontekio Airport"},
+	{"ENH", "CN", 30.32029915, 109.4850006, "Enshi Airport"},
+	{"ENI", "PH", 11.20199966, 119.4169998, "El Nido Airport"},
+	{"ENJ", "GT", 17.35280037, -90.15139771, "El Naranjo Airport"},
+	{"ENK", "GB", 54.39889908, -7.651669979, "St Angelo Airport"},
+	{"ENL", "US", 38.51509857, -89.0911026, "Centralia Municipal Airport"},
+	{"ENN", "US", 64.54730225, -149.0740051, "Nenana Municipal Airport"},
+	{"ENO", "PY", -27.29999924, -55.90999985, "Encarnación Airport"},
+	{"ENS", "NL", 52.27000046, 6.874169827, "Twenthe Airport"},
+	{"ENT", "MH", 11.34070015, 162.3280029, "Eniwetok Airport"},
+	{"ENU", "NG", 6.474269867, 7.56196022, "Akanu Ibiam International Airport"},
+	{"ENV", "US", 40.71870041, -114.0309982, "Wendover Airport"},
+	{"ENW", "US", 42.59569931, -87.92780304, "Kenosha Regional Airport"},
+	{"ENY", "CN", 36.63690186, 109.5540009, "Yan\"an Airport"},
+	{"EOH", "CO", 6.220549, -75.590582, "Enrique Olaya Herrera Airport"},
+	{"EOI", "GB", 59.19060135, -2.772219896, "Eday Airport"},
+	{"EOK", "US", 40.4598999, -91.42849731, "Keokuk Municipal Airport"},
+	{"EOR", "VE", 6.733333111, -61.58333206, "El Dorado Airport"},
+	{"EOZ", "VE", 7.083333015, -69.53333282, "Elorza Airport"},
+	{"EPA", "AR", -34.6099, -58.6126, "El Palomar Airport"},
+	{"EPG", "US", 40.86750031, -96.11000061, "Browns Airport"},
+	{"EPH", "US", 47.30759811, -119.5159988, "Ephrata Municipal Airport"},
+	{"EPL", "FR", 48.32500076, 6.069980145, "Épinal-Mirecourt Airport"},
+	{"EPR", "AU", -33.68439865, 121.822998, "Esperance Airport"},
+	{"EPS", "DO", 19.32141504, -69.49591219, "Samana El Portillo Airport"},
+	{"EPT", "PG", -5.0412, 141.6779, "Eliptamin Airport"},
+	{"EPU", "EE", 58.41899872, 24.4727993, "Pärnu Airport"},
+	{"EQS", "AR", -42.90800095, -71.13950348, "Brigadier Antonio Parodi Airport"},
+	{"ERA", "SO", 10.64205055, 47.38798141, "Erigavo Airport"},
+	{"ERB", "AU", -26.26329994, 132.1820068, "Ernabella Airport"},
+	{"ERC", "TR", 39.71020126, 39.52700043, "Erzincan Airport"},
+	{"ERD", "UA", 46.81499863, 36.7580986, "Berdyansk Airport"},
+	{"ERE", "PG", -6.606463154, 143.9002132, "Erave Airport"},
+	{"ERF", "DE", 50.97980118, 10.95810032, "Erfurt Airport"},
+	{"ERG", "RU", 61.27500153, 108.0299988, "Yerbogachen Airport"},
+	{"ERH", "MA", 31.94750023, -4.398330212, "Moulay Ali Cherif Airport"},
+	{"ERI", "US", 42.08312701, -80.17386675, "Erie International Tom Ridge Field"},
+	{"ERL", "CN", 43.4225, 112.0966667, "Erenhot Saiwusu International Airport"},
+	{"ERM", "BR", -27.66189957, -52.2682991, "Erechim Airport"},
+	{"ERN", "BR", -6.639530182, -69.87979889, "Eirunepé Airport"},
+	{"ERR", "US", 44.79249954, -71.16419983, "Errol Airport"},
+	{"ERS", "NA", -22.61219978, 17.08040047, "Eros Airport"},
+	{"ERT", "MN", 48.98305556, 104.1505556, "Erdenet Airport"},
+	{"ERU", "PG", -8.2538, 146.9747, "Erume Airport"},
+	{"ERV", "US", 29.97669983, -99.08570099, "Kerrville Municipal Louis Schreiner Field"},
+	{"ERZ", "TR", 39.95650101, 41.17020035, "Erzurum International Airport"},
+	{"ESA", "PG", -9.874327023, 150.9542084, "Esa\"ala Airport"},
+	{"ESB", "TR", 40.12810135, 32.99509811, "Esenboğa International Airport"},
+	{"ESC", "US", 45.72269821, -87.09369659, "Delta County Airport"},
+	{"ESD", "US", 48.70819855, -122.9100037, "Orcas Island Airport"},
+	{"ESE", "MX", 31.79529953, -116.6029968, "Ensenada Airport"},
+	{"ESF", "US", 31.39489937, -92.29579926, "Esler Regional Airport"},
+	{"ESG", "PY", -22.04999924, -60.61999893, "Dr. Luis Maria Argaña International Airport"},
+	{"ESH", "GB", 50.83560181, -0.297221988, "Shoreham Airport"},
+	{"ESI", "BR", -14.93369444, -42.81, "Espinosa Airport"},
+	{"ESK", "TR", 39.78409958, 30.58209991, "Eskişehir Air Base"},
+	{"ESL", "RU", 46.37390137, 44.33089828, "Elista Airport"},
+	{"ESM", "EC", 0.978519022, -79.62660217, "General Rivadeneira Airport"},
+	{"ESN", "US", 38.80419922, -76.06900024, "Easton Newnam Field"},
+	{"ESO", "US", 36.02999878, -106.0459976, "Ohkay Owingeh Airport"},
+	{"ESR", "CL", -26.31110001, -69.76519775, "Ricardo García Posada Airport"},
+	{"ESS", "DE", 51.40230179, 6.937329769, "Essen Mulheim Airport"},
+	{"EST", "US", 43.40739822, -94.74639893, "Estherville Municipal Airport"},
+	{"ESU", "MA", 31.39749908, -9.681670189, "Mogador Airport"},
+	{"ESW", "US", 47.25419998, -121.185997, "Easton State Airport"},
+	{"ETB", "US", 43.42219925, -88.12789917, "West Bend Municipal Airport"},
+	{"ETD", "AU", -28.74080086, 138.5890045, "Etadunna Airport"},
+	{"ETE", "ET", 12.93299961, 36.16699982, "Metema Airport"},
+	{"ETH", "IL", 29.56130028, 34.96009827, "Eilat Airport"},
+	{"ETN", "US", 32.41350174, -98.80979919, "Eastland Municipal Airport"},
+	{"ETR", "EC", -3.435159922, -79.97779846, "Coronel Artilleria Victor Larrea Airport"},
+	{"ETS", "US", 31.29969978, -85.89990234, "Enterprise Municipal Airport"},
+	{"ETZ", "FR", 48.98210144, 6.251319885, "Metz-Nancy-Lorraine Airport"},
+	{"EUA", "TO", -21.37829971, -174.9579926, "Kaufana Airport"},
+	{"EUC", "AU", -31.70000076, 128.8829956, "Eucla Airport"},
+	{"EUE", "US", 39.60419846, -116.0049973, "Eureka Airport"},
+	{"EUF", "US", 31.95129967, -85.12889862, "Weedon Field"},
+	{"EUG", "US", 44.12459946, -123.211998, "Mahlon Sweet Field"},
+	{"EUM", "DE", 54.07944489, 9.941389084, "Neumünster Airport"},
+	{"EUN", "EH", 27.15169907, -13.21920013, "Hassan I Airport"},
+	{"EUO", "CO", 4.383333, -73.2, "Paratebueno Airport"},
+	{"EUQ", "PH", 10.76599979, 121.9329987, "Evelio Javier Airport"},
+	{"EUX", "BQ", 17.49650002, -62.97940063, "F. D. Roosevelt Airport"},
+	{"EVD", "AU", -18.00099945, 134.8630066, "Eva Downs Airport"},
+	{"EVE", "NO", 68.49130249, 16.67810059, "Harstad/Narvik Airport, Evenes"},
+	{"EVG", "SE", 62.04779816, 14.4229002, "Sveg Airport"},
+	{"EVH", "AU", -29.09329987, 153.4199982, "Evans Head Aerodrome"},
+	{"EVM", "US", 47.42509842, -92.49849701, "Eveleth Virginia Municipal Airport"},
+	{"EVN", "AM", 40.14730072, 44.39590073, "Zvartnots International Airport"},
+	{"EVV", "US", 38.03699875, -87.53240204, "Evansville Regional Airport"},
+	{"EVW", "US", 41.27479935, -111.0350037, "Evanston-Uinta County Airport-Burns Field"},
+	{"EVX", "FR", 49.02870178, 1.219859958, "Évreux-Fauville (BA 105) Air Base"},
+	{"EWB", "US", 41.67610168, -70.95690155, "New Bedford Regional Airport"},
+	{"EWE", "ID", -5.494, 138.083, "Ewer Airport"},
+	{"EWI", "ID", -3.925899982, 136.3769989, "Enarotali Airport"},
+	{"EWK", "US", 38.05820084, -97.27449799, "Newton City-County Airport"},
+	{"EWN", "US", 35.07300186, -77.04290009, "Coastal Carolina Regional Airport"},
+	{"EWO", "CG", -0.883000016, 14.80000019, "Ewo Airport"},
+	{"EWR", "US", 40.69250107, -74.16870117, "Newark Liberty International Airport"},
+	{"EXM", "AU", -22.0333004, 114.0999985, "Exmouth Airport"},
+	{"EXT", "GB", 50.7344017, -3.413889885, "Exeter International Airport"},
+	{"EYK", "RU", 63.68690109, 66.69860077, "Beloyarskiy Airport"},
+	{"EYL", "ML", 15.13300037, -10.56700039, "Yélimané Airport"},
+	{"EYP", "CO", 5.31911, -72.384, "El Yopal Airport"},
+	{"EYR", "US", 39.0041008, -119.1579971, "Yerington Municipal Airport"},
+	{"EYS", "KE", 3.216670036, 35.96670151, "Eliye Springs Airport"},
+	{"EYW", "US", 24.55610085, -81.75959778, "Key West International Airport"},
+	{"EZE", "AR", -34.8222, -58.5358, "Ministro Pistarini International Airport"},
+	{"EZS", "TR", 38.60689926, 39.29140091, "Elazığ Airport"},
+	{"EZV", "RU", 63.92100143, 65.03050232, "Berezovo Airport"},
+	{"FAA", "GN", 10.03549957, -10.76980019, "Faranah Airport"},
+	{"FAB", "GB", 51.2757988, -0.776332974, "Farnborough Airport"},
+	{"FAC", "PF", -16.68670082, -145.3289948, "Faaite Airport"},
+	{"FAE", "FO", 62.06359863, -7.277219772, "Vagar Airport"},
+	{"FAF", "US", 37.13249969, -76.6088028, "Felker Army Air Field"},
+	{"FAG", "IS", 63.87469864, -16.64109993, "Fagurhólsmýri Airport"},
+	{"FAH", "AF", 32.36700058, 62.18299866, "Farah Airport"},
+	{"FAI", "US", 64.81510162, -147.8560028, "Fairbanks International Airport"},
+	{"FAJ", "PR", 18.30890083, -65.66190338, "Diego Jimenez Torres Airport"},
+	{"FAM", "US", 37.76110077, -90.4285965, "Farmington Regional Airport"},
+	{"FAN", "NO", 58.09949875, 6.626049995, "Lista Airport"},
+	{"FAO", "PT", 37.01440048, -7.965909958, "Faro Airport"},
+	{"FAQ", "PG", -4.610468, 141.960138, "Frieda River Airport"},
+	{"FAR", "US", 46.92070007, -96.81580353, "Hector International Airport"},
+	{"FAS", "IS", 64.93170166, -14.06060028, "Fáskrúðsfjörður Airport"},
+	{"FAT", "US", 36.77619934, -119.7180023, "Fresno Yosemite International Airport"},
+	{"FAU", "OM", 22.35475932, 56.48414612, "Fahud Airport"},
+	{"FAV", "PF", -16.05410004, -145.6569977, "Fakarava Airport"},
+	{"FAY", "US", 34.99119949, -78.88030243, "Fayetteville Regional Grannis Field"},
+	{"FAZ", "IR", 28.89179993, 53.72330093, "Fasa Airport"},
+	{"FBA", "BR", -2.53260994, -66.08319855, "Fonte Boa Airport"},
+	{"FBD", "AF", 37.12110138, 70.51809692, "Faizabad Airport"},
+	{"FBE", "BR", -26.05920029, -53.06349945, "Francisco Beltrão Airport"},
+	{"FBG", "US", 35.13180161, -78.93669891, "Simmons Army Air Field"},
+	{"FBK", "US", 64.83750153, -147.6139984, "Ladd AAF Airfield"},
+	{"FBL", "US", 44.3246994, -93.31079865, "Faribault Municipal Airport"},
+	{"FBM", "CD", -11.59130001, 27.53089905, "Lubumbashi International Airport"},
+	{"FBR", "US", 41.39189911, -110.4069977, "Fort Bridger Airport"},
+	{"FBY", "US", 40.18299866, -97.16929626, "Fairbury Municipal Airport"},
+	{"FCA", "US", 48.3105011, -114.2559967, "Glacier Park International Airport"},
+	{"FCB", "ZA", -28.82309914, 27.90889931, "Ficksburg Sentraoes Airport"},
+	{"FCH", "US", 36.73239899, -119.8199997, "Fresno Chandler Executive Airport"},
+	{"FCM", "US", 44.82720184, -93.45709991, "Flying Cloud Airport"},
+	{"FCN", "DE", 53.7677002, 8.658499718, "Nordholz Naval Airbase"},
+	{"FCO", "IT", 41.80450058, 12.25080013, "Leonardo da Vinci–Fiumicino Airport"},
+	{"FCS", "US", 38.67839813, -104.7570038, "Butts AAF (Fort Carson) Air Field"},
+	{"FCY", "US", 34.94200134, -90.77500153, "Forrest City Municipal Airport"},
+	{"FDA", "CO", 10.5333, -74.2, "Fundación Airport"},
+	{"FDE", "NO", 61.39110184, 5.756939888, "Bringeland Airport"},
+	{"FDF", "MQ", 14.5909996, -61.00320053, "Martinique Aimé Césaire International Airport"},
+	{"FDH", "DE", 47.67129898, 9.511489868, "Friedrichshafen Airport"},
+	{"FDK", "US", 39.41759872, -77.3742981, "Frederick Municipal Airport"},
+	{"FDO", "AR", -34.4532, -58.5896, "San Fernando Airport"},
+	{"FDR", "US", 34.35200119, -98.98390198, "Frederick Regional Airport"},
+	{"FDU", "CD", -3.311320066, 17.38170052, "Bandundu Airport"},
+	{"FDY", "US", 41.01350021, -83.66870117, "Findlay Airport"},
+	{"FEA", "GB", 60.60333333, -0.872777778, "Fetlar Airport"},
+	{"FEB", "NP", 29.2329998, 81.21700287, "Sanfebagar Airport"},
+	{"FEC", "BR", -12.20030022, -38.90679932, "João Durval Carneiro Airport"},
+	{"FEG", "UZ", 40.35879898, 71.74500275, "Fergana International Airport"},
+	{"FEJ", "BR", -8.165579796, -70.35299683, "Feijó Airport"},
+	{"FEK", "CI", 9.6, -5.1833333, "Ferkessedougou Airport"},
+	{"FEL", "DE", 48.20555496, 11.26694393, "Fürstenfeldbruck Airport"},
+	{"FEN", "BR", -3.854929924, -32.4233017, "Fernando de Noronha Airport"},
+	{"FEP", "US", 42.24620056, -89.58200073, "Albertus Airport"},
+	{"FET", "US", 41.44910049, -96.52020264, "Fremont Municipal Airport"},
+	{"FEZ", "MA", 33.9272995, -4.97796011, "Saïss Airport"},
+	{"FFA", "US", 36.01819992, -75.6713028, "First Flight Airport"},
+	{"FFD", "GB", 51.68220139, -1.790030003, "RAF Fairford"},
+	{"FFL", "US", 41.05329895, -91.97889709, "Fairfield Municipal Airport"},
+	{"FFM", "US", 46.28440094, -96.15670013, "Fergus Falls Municipal Airport - Einar Mickelson Field"},
+	{"FFO", "US", 39.8260994, -84.0483017, "Wright-Patterson Air Force Base"},
+	{"FFT", "US", 38.18249893, -84.90470123, "Capital City Airport"},
+	{"FFU", "CL", -43.18920135, -71.85109711, "Futaleufú Airport"},
+	{"FGD", "MR", 22.66699982, -12.7329998, "Fderik Airport"},
+	{"FGI", "WS", -13.84869957, -171.7400055, "Fagali\"i Airport"},
+	{"FGU", "PF", -15.81989956, -140.8869934, "Fangatau Airport"},
+	{"FHB", "US", 30.61179924, -81.4611969, "Fernandina Beach Municipal Airport"},
+	{"FHU", "US", 31.58849907, -110.3440018, "Sierra Vista Municipal Libby Army Air Field"},
+	{"FHZ", "PF", -15.9921999, -140.1649933, "Fakahina Airport"},
+	{"FID", "US", 41.25130081, -72.03160095, "Elizabeth Field"},
+	{"FIE", "GB", 59.53580093, -1.628059983, "Fair Isle Airport"},
+	{"FIG", "GN", 10.35060024, -13.56919956, "Fria Airport"},
+	{"FIH", "CD", -4.385749817, 15.44460011, "Ndjili International Airport"},
+	{"FIK", "AU", -25.59469986, 134.5829926, "Finke Airport"},
+	{"FIL", "US", 38.95830154, -112.362999, "Fillmore Municipal Airport"},
+	{"FIN", "PG", -6.621750109, 147.85405, "Finschhafen Airport"},
+	{"FIZ", "AU", -18.18190002, 125.5589981, "Fitzroy Crossing Airport"},
+	{"FJR", "AE", 25.11219978, 56.32400131, "Fujairah International Airport"},
+	{"FKB", "DE", 48.77939987, 8.080499649, "Karlsruhe Baden-Baden Airport"},
+	{"FKI", "CD", 0.481638998, 25.33799934, "Bangoka International Airport"},
+	{"FKJ", "JP", 36.14279938, 136.223999, "Fukui Airport"},
+	{"FKL", "US", 41.37789917, -79.86039734, "Venango Regional Airport"},
+	{"FKN", "US", 36.69810104, -76.90380096, "Franklin Municipal-John Beverly Rose Airport"},
+	{"FKQ", "ID", -2.920190096, 132.2669983, "Fakfak Airport"},
+	{"FKS", "JP", 37.22740173, 140.4309998, "Fukushima Airport"},
+	{"FLA", "CO", 1.58919, -75.5644, "Gustavo Artunduaga Paredes Airport"},
+	{"FLB", "BR", -6.846389771, -43.07730103, "Cangapara Airport"},
+	{"FLD", "US", 43.77119827, -88.48840332, "Fond du Lac County Airport"},
+	{"FLF", "DE", 54.7733345, 9.378889084, "Flensburg-Schäferhaus Airport"},
+	{"FLG", "US", 35.13850021, -111.6709976, "Flagstaff Pulliam Airport"},
+	{"FLH", "GB", 58.82580185, -3.142780066, "Flotta Isle Airport"},
+	{"FLI", "IS", 66.01418043, -23.4416914, "Holt Airport"},
+	{"FLL", "US", 26.07259941, -80.15270233, "Fort Lauderdale Hollywood International Airport"},
+	{"FLM", "PY", -22.36000061, -60.04999924, "Filadelfia Airport"},
+	{"FLN", "BR", -27.67027855, -48.55250168, "Hercílio Luz International Airport"},
+	{"FLO", "US", 34.1853981, -79.72389984, "Florence Regional Airport"},
+	{"FLP", "US", 36.29090118, -92.59030151, "Marion County Regional Airport"},
+	{"FLR", "IT", 43.81000137, 11.20510006, "Peretola Airport"},
+	{"FLS", "AU", -40.09170151, 147.9929962, "Flinders Island Airport"},
+	{"FLT", "US", 62.45259857, -157.9889984, "Flat Airport"},
+	{"FLV", "US", 39.36830139, -94.91470337, "Sherman Army Air Field"},
+	{"FLW", "PT", 39.45529938, -31.13139915, "Flores Airport"},
+	{"FLX", "US", 39.49909973, -118.7490005, "Fallon Municipal Airport"},
+	{"FLY", "AU", -35.66669846, 145.5500031, "Finley Airport"},
+	{"FMA", "AR", -26.2127, -58.2281, "Formosa Airport"},
+	{"FMC", "US", 65.92700195, -149.8399963, "Five Mile Airport"},
+	{"FME", "US", 39.08539963, -76.75939941, "Tipton Airport"},
+	{"FMG", "CR", 10.41860008, -85.78269958, "Flamingo Airport"},
+	{"FMH", "US", 41.65840149, -70.52140045, "Cape Cod Coast Guard Air Station"},
+	{"FMI", "CD", -5.875559807, 29.25, "Kalemie Airport"},
+	{"FMM", "DE", 47.98880005, 10.23950005, "Memmingen Allgau Airport"},
+	{"FMN", "US", 36.74119949, -108.2300034, "Four Corners Regional Airport"},
+	{"FMO", "DE", 52.13460159, 7.684830189, "Münster Osnabrück Airport"},
+	{"FMS", "US", 40.65930176, -91.32679749, "Fort Madison Municipal Airport"},
+	{"FMU", "US", 43.98279953, -124.1110001, "Florence Municipal Airport"},
+	{"FMY", "US", 26.58659935, -81.86329651, "Page Field"},
+	{"FNA", "SL", 8.616439819, -13.19550037, "Lungi International Airport"},
+	{"FNB", "DE", 53.60219955, 13.30599976, "Neubrandenburg Airport"},
+	{"FNC", "PT", 32.69789886, -16.77449989, "Madeira Airport"},
+	{"FNE", "PG", -8.549277778, 147.0858333, "Fane Airport"},
+	{"FNG", "BF", 12.03299999, 0.349999994, "Fada N\"gourma Airport"},
+	{"FNH", "ET", 9.583000183, 37.34999847, "Fincha Airport"},
+	{"FNI", "FR", 43.75740051, 4.416349888, "Nîmes-Arles-Camargue Airport"},
+	{"FNJ", "KP", 39.22409821, 125.6699982, "Sunan International Airport"},
+	{"FNL", "US", 40.4518013, -105.0110016, "Fort Collins Loveland Municipal Airport"},
+	{"FNT", "US", 42.9654007, -83.74359894, "Bishop International Airport"},
+	{"FNU", "IT", 39.895308, 8.642661, "Oristano-Fenosu Airport"},
+	{"FOA", "GB", 60.12099838, -2.052000046, "Foula Airport"},
+	{"FOB", "US", 39.47430038, -123.7959976, "Fort Bragg Airport"},
+	{"FOC", "CN", 25.93510056, 119.663002, "Fuzhou Changle International Airport"},
+	{"FOD", "US", 42.55149841, -94.19259644, "Fort Dodge Regional Airport"},
+	{"FOE", "US", 38.95090103, -95.66359711, "Topeka Regional Airport - Forbes Field"},
+	{"FOG", "IT", 41.43289948, 15.53499985, "Foggia \"Gino Lisa\" Airport"},
+	{"FOK", "US", 40.84370041, -72.63179779, "Francis S Gabreski Airport"},
+	{"FOM", "CM", 5.636919975, 10.75080013, "Foumban Nkounja Airport"},
+	{"FON", "CR", 10.47799969, -84.6344986, "Arenal Airport"},
+	{"FOO", "ID", -0.936325014, 134.871994, "Kornasoren Airfield"},
+	{"FOR", "BR", -3.776279926, -38.5326004, "Pinto Martins International Airport"},
+	{"FOS", "AU", -30.83810043, 128.1150055, "Forrest Airport"},
+	{"FOT", "AU", -32.20420074, 152.4790039, "Forster (Wallis Is) Airport"},
+	{"FOU", "GA", -1.282999992, 10.61699963, "Fougamou Airport"},
+	{"FOY", "LR", 8.3513, -10.2269, "Foya Airport"},
+	{"FPO", "BS", 26.55870056, -78.69560242, "Grand Bahama International Airport"},
+	{"FPR", "US", 27.49510002, -80.36830139, "St Lucie County International Airport"},
+	{"FPY", "US", 30.0692997, -83.58059692, "Perry-Foley Airport"},
+	{"FRA", "DE", 50.02640152, 8.543129921, "Frankfurt am Main International Airport"},
+	{"FRB", "AU", -33.36360168, 147.9349976, "Forbes Airport"},
+	{"FRC", "BR", -20.59219933, -47.38290024, "Franca Airport"},
+	{"FRD", "US", 48.52199936, -123.0240021, "Friday Harbor Airport"},
+	{"FRE", "SB", -8.107500076, 159.5769958, "Fera/Maringe Airport"},
+	{"FRF", "DE", 52.03805542, 11.20555592, "Oschersleben Airport"},
+	{"FRG", "US", 40.72880173, -73.41339874, "Republic Airport"},
+	{"FRH", "US", 38.50619888, -86.63690186, "French Lick Municipal Airport"},
+	{"FRI", "US", 39.05530167, -96.76450348, "Marshall Army Air Field"},
+	{"FRK", "SC", -4.583, 55.950001, "Frégate Island Airport"},
+	{"FRL", "IT", 44.19480133, 12.07009983, "Forlì Airport"},
+	{"FRM", "US", 43.64390183, -94.41560364, "Fairmont Municipal Airport"},
+	{"FRO", "NO", 61.58359909, 5.024720192, "Florø Airport"},
+	{"FRQ", "PG", -5.208, 141.6988, "Feramin Airport"},
+	{"FRR", "US", 38.91749954, -78.25350189, "Front Royal Warren County Airport"},
+	{"FRS", "GT", 16.91379929, -89.86640167, "Mundo Maya International Airport"},
+	{"FRT", "CL", -41.11700058, -73.05000305, "El Avellano Airport"},
+	{"FRU", "KG", 43.06129837, 74.4776001, "Manas International Airport"},
+	{"FRW", "BW", -21.1595993, 27.47450066, "Francistown Airport"},
+	{"FRY", "US", 43.99110031, -70.94789886, "Eastern Slopes Regional Airport"},
+	{"FRZ", "DE", 51.1146, 9.286, "Fritzlar Airport"},
+	{"FSC", "FR", 41.50059891, 9.097780228, "Figari Sud-Corse Airport"},
+	{"FSD", "US", 43.58200073, -96.74189758, "Joe Foss Field Airport"},
+	{"FSI", "US", 34.64979935, -98.40219879, "Henry Post Army Air Field (Fort Sill)"},
+	{"FSK", "US", 37.79840088, -94.76940155, "Fort Scott Municipal Airport"},
+	{"FSL", "AU", -18.1321, 125.7873, "Fossil Downs Airport"},
+	{"FSM", "US", 35.33660126, -94.36740112, "Fort Smith Regional Airport"},
+	{"FSP", "PM", 46.76290131, -56.17309952, "St Pierre Airport"},
+	{"FSS", "GB", 57.6493988, -3.560640097, "RAF Kinloss"},
+	{"FST", "US", 30.91570091, -102.9160004, "Fort Stockton Pecos County Airport"},
+	{"FSU", "US", 34.48339844, -104.2170029, "Fort Sumner Municipal Airport"},
+	{"FSZ", "JP", 34.79604347, 138.1877518, "Mt. Fuji Shizuoka Airport"},
+	{"FTA", "VU", -19.51639938, 170.2319946, "Futuna Airport"},
+	{"FTE", "AR", -50.28030014, -72.05310059, "El Calafate Airport"},
+	{"FTI", "AS", -14.21720028, -169.4250031, "Fitiuta Airport"},
+	{"FTK", "US", 37.90710068, -85.9720993, "Godman Army Air Field"},
+	{"FTU", "MG", -25.03809929, 46.95610046, "Tôlanaro Airport"},
+	{"FTW", "US", 32.81980133, -97.36239624, "Fort Worth Meacham International Airport"},
+	{"FTX", "CG", -0.531350017, 15.95009995, "Owando Airport"},
+	{"FTY", "US", 33.77909851, -84.52140045, "Fulton County Airport Brown Field"},
+	{"FUB", "PG", -6.1518, 150.6264, "Fulleborn Airport"},
+	{"FUE", "ES", 28.45269966, -13.86380005, "Fuerteventura Airport"},
+	{"FUG", "CN", 32.882157, 115.734364, "Fuyang Xiguan Airport"},
+	{"FUJ", "JP", 32.66630173, 128.8329926, "Fukue Airport"},
+	{"FUK", "JP", 33.58589935, 130.451004, "Fukuoka Airport"},
+	{"FUL", "US", 33.87200165, -117.9800034, "Fullerton Municipal Airport"},
+	{"FUM", "PG", -6.3933, 142.4408, "Fuma Airport"},
+	{"FUN", "TV", -8.525, 179.195999, "Funafuti International Airport"},
+	{"FUO", "CN", 23.08329964, 113.0699997, "Foshan Shadi Airport"},
+	{"FUT", "WF", -14.31140041, -178.0659943, "Pointe Vele Airport"},
+	{"FVL", "AU", -18.2833004, 128.4170074, "Flora Valley Airport"},
+	{"FVM", "MV", -0.309722222, 73.435, "Fuvahmulah Airport"},
+	{"FVR", "AU", -15.1647, 127.8401, "Oombulgurri Airport"},
+	{"FWA", "US", 40.97850037, -85.19509888, "Fort Wayne International Airport"},
+	{"FWH", "US", 32.76919937, -97.4414978, "NAS Fort Worth JRB/Carswell Field"},
+	{"FWL", "US", 62.50659943, -153.8939972, "Farewell Airport"},
+	{"FXE", "US", 26.19729996, -80.17070007, "Fort Lauderdale Executive Airport"},
+	{"FXM", "US", 70.1895, -146.0209, "Flaxman Island Airstrip"},
+	{"FXO", "MZ", -14.81499958, 36.52999878, "Cuamba Airport"},
+	{"FXY", "US", 43.23469925, -93.62409973, "Forest City Municipal Airport"},
+	{"FYM", "US", 35.05970001, -86.56400299, "Fayetteville Municipal Airport"},
+	{"FYT", "TD", 17.91710091, 19.11109924, "Faya Largeau Airport"},
+	{"FYU", "US", 66.57150269, -145.25, "Fort Yukon Airport"},
+	{"FYV", "US", 36.00510025, -94.17009735, "Drake Field"},
+	{"FZB", "UY", -33.14300156, -58.29410172, "Fray Bentos Airport"},
+	{"FZO", "GB", 51.51940155, -2.590830088, "Bristol Filton Airport"},
+	{"GAB", "US", 38.92409897, -117.9589996, "Gabbs Airport"},
+	{"GAE", "TN", 33.87689972, 10.10330009, "Gabès Matmata International Airport"},
+	{"GAF", "TN", 34.42200089, 8.822500229, "Gafsa Ksar International Airport"},
+	{"GAG", "US", 36.29550171, -99.77639771, "Gage Airport"},
+	{"GAH", "AU", -25.61440086, 151.6190033, "Gayndah Airport"},
+	{"GAI", "US", 39.16830063, -77.16600037, "Montgomery County Airpark"},
+	{"GAJ", "JP", 38.41189957, 140.3710022, "Yamagata Airport"},
+	{"GAL", "US", 64.73619843, -156.9369965, "Edward G. Pitka Sr Airport"},
+	{"GAM", "US", 63.76679993, -171.7330017, "Gambell Airport"},
+	{"GAN", "MV", -0.69334197, 73.1556015, "Gan International Airport"},
+	{"GAO", "CU", 20.08530045, -75.15830231, "Mariana Grajales Airport"},
+	{"GAP", "PG", -6.053196, 145.959047, "Gusap Airport"},
+	{"GAQ", "ML", 16.24839973, -0.005456, "Gao Airport"},
+	{"GAR", "PG", -7.875625027, 147.1412659, "Garaina Airport"},
+	{"GAS", "KE", -0.46350801, 39.64830017, "Garissa Airport"},
+	{"GAT", "FR", 44.45500183, 6.037779808, "Gap - Tallard Airport"},
+	{"GAU", "IN", 26.10610008, 91.58589935, "Lokpriya Gopinath Bordoloi International Airport"},
+	{"GAV", "ID", -0.400555998, 129.8950043, "Gag Island Airport"},
+	{"GAW", "MM", 22.17469978, 94.13439941, "Gangaw Airport"},
+	{"GAX", "GA", -2.710160017, 9.960619926, "Gamba Airport"},
+	{"GAY", "IN", 24.74430084, 84.95120239, "Gaya Airport"},
+	{"GAZ", "PG", -9.225918, 152.944352, "Guasopa Airport"},
+	{"GBB", "AZ", 40.82666667, 47.7125, "Gabala International Airport"},
+	{"GBC", "PG", -6.1028, 141.7393, "Gasuke Airport"},
+	{"GBD", "US", 38.34429932, -98.85919952, "Great Bend Municipal Airport"},
+	{"GBE", "BW", -24.55520058, 25.91819954, "Sir Seretse Khama International Airport"},
+	{"GBF", "PG", -6.567778, 144.703058, "Negarbo(Negabo) Airport"},
+	{"GBG", "US", 40.93799973, -90.43109894, "Galesburg Municipal Airport"},
+	{"GBH", "US", 68.47969818, -149.4900055, "Galbraith Lake Airport"},
+	{"GBI", "BS", 26.63190079, -78.35919952, "Auxiliary Airfield"},
+	{"GBJ", "GP", 15.86870003, -61.27000046, "Les Bases Airport"},
+	{"GBK", "SL", 7.767000198, -12.38300037, "Gbangbatok Airport"},
+	{"GBL", "AU", -11.64999962, 133.3820038, "South Goulburn Is Airport"},
+	{"GBP", "AU", -16.54999924, 143.6670074, "Gamboola Airport"},
+	{"GBQ", "FR", 47.20660019, 6.083680153, "Besançon-La Vèze Airport"},
+	{"GBR", "US", 42.18420029, -73.40319824, "Walter J. Koladza Airport"},
+	{"GBT", "IR", 36.90940094, 54.40129852, "Gorgan Airport"},
+	{"GBU", "SD", 14.92500019, 35.87799835, "Khashm El Girba Airport"},
+	{"GBV", "AU", -16.42329979, 126.4449997, "Gibb River Airport"},
+	{"GBZ", "NZ", -36.24140167, 175.4720001, "Great Barrier Aerodrome"},
+	{"GCA", "CO", 2.283333, -74.95, "Guacamayas Airport"},
+	{"GCC", "US", 44.34889984, -105.5390015, "Gillette Campbell County Airport"},
+	{"GCD", "US", 47.92200089, -119.0830002, "Grand Coulee Dam Airport"},
+	{"GCH", "IR", 30.33760071, 50.82799912, "Gachsaran Airport"},
+	{"GCI", "GG", 49.43500137, -2.601969957, "Guernsey Airport"},
+	{"GCJ", "ZA", -25.98629951, 28.14010048, "Grand Central Airport"},
+	{"GCK", "US", 37.92750168, -100.723999, "Garden City Regional Airport"},
+	{"GCM", "KY", 19.2928009, -81.35769653, "Owen Roberts International Airport"},
+	{"GCN", "US", 35.95240021, -112.1470032, "Grand Canyon National Park Airport"},
+	{"GCV", "BR", -29.9494, -50.98505, "Gravatai Airport"},
+	{"GCW", "US", 35.99039841, -113.8160019, "Grand Canyon West Airport"},
+	{"GCY", "US", 36.19300079, -82.81510162, "Greeneville-Greene County Municipal Airport"},
+	{"GDC", "US", 34.75830078, -82.37640381, "Donaldson Center Airport"},
+	{"GDD", "AU", -18.67810059, 128.5919952, "Gordon Downs Airport"},
+	{"GDE", "ET", 5.935130119, 43.57860184, "Gode Airport"},
+	{"GDG", "RU", 53.47330093, 125.7949982, "Magdagachi Airport"},
+	{"GDI", "CF", 9.583000183, 21.7329998, "Gordil Airport"},
+	{"GDJ", "CD", -6.732999802, 23.95000076, "Gandajika Airport"},
+	{"GDL", "MX", 20.52179909, -103.310997, "Don Miguel Hidalgo Y Costilla International Airport"},
+	{"GDM", "US", 42.54999924, -72.01609802, "Gardner Municipal Airport"},
+	{"GDN", "PL", 54.37760162, 18.46619987, "Gdańsk Lech Wałęsa Airport"},
+	{"GDO", "VE", 7.233333111, -70.80000305, "Guasdalito Airport"},
+	{"GDP", "BR", -6.782219887, -43.5821991, "Guadalupe Airport"},
+	{"GDQ", "ET", 12.51990032, 37.43399811, "Gonder Airport"},
+	{"GDT", "TC", 21.44449997, -71.14230347, "JAGS McCartney International Airport"},
+	{"GDV", "US", 47.13869858, -104.8069992, "Dawson Community Airport"},
+	{"GDW", "US", 43.97060013, -84.47499847, "Gladwin Zettel Memorial Airport"},
+	{"GDX", "RU", 59.9109993, 150.7200012, "Sokol Airport"},
+	{"GDZ", "RU", 44.58209263, 38.01248074, "Gelendzhik Airport"},
+	{"GEA", "NC", -22.25830078, 166.4730072, "Nouméa Magenta Airport"},
+	{"GEB", "ID", -0.078888997, 129.4579926, "Gebe Airport"},
+	{"GEC", "CY", 35.23594666, 33.72435761, "Geçitkale Air Base"},
+	{"GED", "US", 38.68920135, -75.35890198, "Sussex County Airport"},
+	{"GEE", "AU", -41.08000183, 146.8399963, "Georgetown (Tas) Airport"},
+	{"GEF", "SB", -7.575829983, 156.5970001, "Geva Airport"},
+	{"GEG", "US", 47.61989975, -117.5339966, "Spokane International Airport"},
+	{"GEL", "BR", -28.28170013, -54.16910172, "Santo Ângelo Airport"},
+	{"GEO", "GY", 6.498549938, -58.2541008, "Cheddi Jagan International Airport"},
+	{"GER", "CU", 21.83469963, -82.78379822, "Rafael Cabrera Airport"},
+	{"GES", "PH", 6.058000088, 125.0960007, "General Santos International Airport"},
+	{"GET", "AU", -28.79610062, 114.7070007, "Geraldton Airport"},
+	{"GEV", "SE", 67.13240051, 20.81459999, "Gällivare Airport"},
+	{"GEW", "PG", -9.2263, 148.4949, "Gewoia Airport"},
+	{"GEX", "AU", -38.22499847, 144.3329926, "Geelong Airport"},
+	{"GEY", "US", 44.51679993, -108.0830002, "South Big Horn County Airport"},
+	{"GFD", "US", 39.79029846, -85.73609924, "Pope Field"},
+	{"GFE", "AU", -34.0, 148.1329956, "Grenfell Airport"},
+	{"GFF", "AU", -34.25080109, 146.0670013, "Griffith Airport"},
+	{"GFK", "US", 47.94929886, -97.17610168, "Grand Forks International Airport"},
+	{"GFL", "US", 43.34120178, -73.61029816, "Floyd Bennett Memorial Airport"},
+	{"GFN", "AU", -29.75939941, 153.0299988, "Grafton Airport"},
+	{"GFO", "GY", 6.374770164, -58.63809967, "Bartica A Airport"},
+	{"GFR", "FR", 48.8830986, -1.564170003, "Granville Airport"},
+	{"GFY", "NA", -19.60219955, 18.12269974, "Grootfontein Airport"},
+	{"GGC", "AO", -12.63987732, 22.5868206, "Lumbala Airport"},
+	{"GGD", "AU", -18.625, 139.2330017, "Gregory Downs Airport"},
+	{"GGE", "US", 33.31169891, -79.31960297, "Georgetown County Airport"},
+	{"GGG", "US", 32.38399887, -94.71150208, "East Texas Regional Airport"},
+	{"GGL", "CO", 8.333333, -77.083333, "Gilgal Airport"},
+	{"GGM", "KE", 0.271342009, 34.78730011, "Kakamega Airport"},
+	{"GGN", "CI", 6.132999897, -5.949999809, "Gagnoa Airport"},
+	{"GGO", "CI", 6.524990082, -7.478610039, "Guiglo Airport"},
+	{"GGS", "AR", -48.78310013, -70.15000153, "Gobernador Gregores Airport"},
+	{"GGT", "BS", 23.56259918, -75.87799835, "Exuma International Airport"},
+	{"GGW", "US", 48.21250153, -106.6149979, "Wokal Field Glasgow International Airport"},
+	{"GHA", "DZ", 32.38410187, 3.79411006, "Noumérat - Moufdi Zakaria Airport"},
+	{"GHB", "BS", 25.28470039, -76.33100128, "Governor\"s Harbour Airport"},
+	{"GHC", "BS", 25.73830032, -77.84010315, "Great Harbour Cay Airport"},
+	{"GHE", "PA", 8.0644, -78.3673, "Garachiné Airport"},
+	{"GHF", "DE", 49.64805603, 9.966388702, "Giebelstadt Airport"},
+	{"GHM", "US", 35.83739853, -87.44539642, "Centerville Municipal Airport"},
+	{"GHN", "CN", 30.9485, 104.3296, "Guanghan Airport"},
+	{"GHT", "LY", 25.14559937, 10.14260006, "Ghat Airport"},
+	{"GHU", "AR", -33.0103, -58.6131, "Gualeguaychu Airport"},
+	{"GIB", "GI", 36.15119934, -5.34965992, "Gibraltar Airport"},
+	{"GIC", "AU", -9.232780457, 142.2180023, "Boigu Airport"},
+	{"GID", "BI", -3.417209387, 29.91130829, "Gitega Airport"},
+	{"GIF", "US", 28.06290054, -81.75330353, "Winter Haven Municipal Airport - Gilbert Field"},
+	{"GIG", "BR", -22.80999947, -43.25055695, "RIOgaleão – Tom Jobim International Airport"},
+	{"GII", "GN", 11.43299961, -9.166999817, "Siguiri Airport"},
+	{"GIL", "PK", 35.91880035, 74.33360291, "Gilgit Airport"},
+	{"GIR", "CO", 4.27624, -74.7967, "Santiago Vila Airport"},
+	{"GIS", "NZ", -38.66329956, 177.9779968, "Gisborne Airport"},
+	{"GIT", "TZ", -2.871360064, 32.17169952, "Geita Airport"},
+	{"GIU", "LK", 7.956669807, 80.72850037, "Sigiriya Air Force Base"},
+	{"GIY", "ZA", -23.2833004, 30.64999962, "Giyani Airport"},
+	{"GIZ", "SA", 16.90110016, 42.58580017, "Jizan Regional Airport"},
+	{"GJA", "HN", 16.44540024, -85.90660095, "La Laguna Airport"},
+	{"GJL", "DZ", 36.79510117, 5.87361002, "Jijel Ferhat Abbas Airport"},
+	{"GJM", "BR", -10.78639984, -65.28479767, "Guajará-Mirim Airport"},
+	{"GJR", "IS", 65.99530029, -21.32690048, "Gjögur Airport"},
+	{"GJT", "US", 39.12239838, -108.5270004, "Grand Junction Regional Airport"},
+	{"GKA", "PG", -6.081689835, 145.3919983, "Goroka Airport"},
+	{"GKD", "TR", 40.20449829, 25.88330078, "Imroz Airport"},
+	{"GKE", "DE", 50.96080017, 6.04241991, "Geilenkirchen Airport"},
+	{"GKH", "NP", 28.04999924, 84.48300171, "Gorkha Airport"},
+	{"GKK", "MV", 0.7324, 73.4336, "Kooddoo Airport"},
+	{"GKL", "AU", -23.18330002, 150.9420013, "Great Keppel Is Airport"},
+	{"GKN", "US", 62.1548996, -145.4570007, "Gulkana Airport"},
+	{"GKT", "US", 35.85779953, -83.52870178, "Gatlinburg-Pigeon Forge Airport"},
+	{"GLA", "GB", 55.87189865, -4.433060169, "Glasgow International Airport"},
+	{"GLB", "US", 33.35309982, -110.6669998, "San Carlos Apache Airport"},
+	{"GLC", "ET", 6.98443985, 46.42139816, "Geladi Airport"},
+	{"GLD", "US", 39.37060165, -101.6989975, "Renner Field-Goodland Municipal Airport"},
+	{"GLE", "US", 33.65140152, -97.1969986, "Gainesville Municipal Airport"},
+	{"GLF", "CR", 8.654009819, -83.18219757, "Golfito Airport"},
+	{"GLG", "AU", -24.80830002, 139.6000061, "Glengyle Airport"},
+	{"GLH", "US", 33.48289871, -90.98560333, "Mid Delta Regional Airport"},
+	{"GLI", "AU", -29.67499924, 151.6889954, "Glen Innes Airport"},
+	{"GLJ", "CO", 2.1464, -75.6944, "Garzón La Jagua Airport"},
+	{"GLK", "SO", 6.780829906, 47.45470047, "Galcaio Airport"},
+	{"GLL", "NO", 60.79140091, 9.050559998, "Gol Airport"},
+	{"GLM", "AU", -22.88829994, 138.8249969, "Glenormiston Airport"},
+	{"GLN", "MA", 29.02669907, -10.05029964, "Goulimime Airport"},
+	{"GLO", "GB", 51.89419937, -2.167220116, "Gloucestershire Airport"},
+	{"GLP", "PG", -5.2808, 141.5411, "Gulgubip Airport"},
+	{"GLR", "US", 45.01350021, -84.70359802, "Gaylord Regional Airport"},
+	{"GLS", "US", 29.26530075, -94.86039734, "Scholes International At Galveston Airport"},
+	{"GLT", "AU", -23.86969948, 151.2230072, "Gladstone Airport"},
+	{"GLU", "BT", 26.88456, 90.46412, "Gelephu Airport"},
+	{"GLV", "US", 64.55049896, -163.0070038, "Golovin Airport"},
+	{"GLW", "US", 37.03179932, -85.9536972, "Glasgow Municipal Airport"},
+	{"GLX", "ID", 1.83833003, 127.7860031, "Gamarmalamo Airport"},
+	{"GLZ", "NL", 51.56740189, 4.931829929, "Gilze Rijen Air Base"},
+	{"GMA", "CD", 3.235369921, 19.77129936, "Gemena Airport"},
+	{"GMB", "ET", 8.128760338, 34.56309891, "Gambella Airport"},
+	{"GMD", "MA", 33.65539932, -7.221449852, "Ben Slimane Airport"},
+	{"GME", "BY", 52.52700043, 31.01670074, "Gomel Airport"},
+	{"GMI", "PG", -6.271110058, 150.3309937, "Gasmata Island Airport"},
+	{"GML", "UA", 50.60350037, 30.19190025, "Gostomel Airport"},
+	{"GMM", "CG", -1.829403, 15.885237, "Gamboma Airport"},
+	{"GMN", "NZ", -42.46170044, 171.1900024, "Greymouth Airport"},
+	{"GMO", "NG", 10.29833333, 10.89638889, "Gombe Lawanti International Airport"},
+	{"GMP", "KR", 37.55830002, 126.7910004, "Gimpo International Airport"},
+	{"GMR", "PF", -23.07990074, -134.8899994, "Totegegie Airport"},
+	{"GMS", "BR", -2.100276, -44.617221, "Guimarães Airport"},
+	{"GMT", "US", 65.40209961, -161.2810059, "Granite Mountain Air Station"},
+	{"GMU", "US", 34.84790039, -82.34999847, "Greenville Downtown Airport"},
+	{"GMV", "US", 37.01670074, -110.2009964, "Monument Valley Airport"},
+	{"GMZ", "ES", 28.02960014, -17.21459961, "La Gomera Airport"},
+	{"GNA", "BY", 53.60200119, 24.05380058, "Hrodna Airport"},
+	{"GNB", "FR", 45.36289978, 5.329370022, "Grenoble-Isère Airport"},
+	{"GND", "GD", 12.00419998, -61.78620148, "Point Salines International Airport"},
+	{"GNF", "US", 39.94390106, -120.9449997, "Gansner Field"},
+	{"GNG", "US", 42.91719818, -114.7649994, "Gooding Municipal Airport"},
+	{"GNI", "TW", 22.6739006, 121.4660034, "Lyudao Airport"},
+	{"GNM", "BR", -14.20820045, -42.74610138, "Guanambi Airport"},
+	{"GNN", "ET", 7.150000095, 40.71699905, "Ghinnir Airport"},
+	{"GNR", "AR", -39.0007019, -67.62049866, "Dr. Arturo H. Illia Airport"},
+	{"GNS", "ID", 1.166380048, 97.70469666, "Binaka Airport"},
+	{"GNT", "US", 35.16730118, -107.9020004, "Grants-Milan Municipal Airport"},
+	{"GNU", "US", 59.11740112, -161.5769959, "Goodnews Airport"},
+	{"GNV", "US", 29.69009972, -82.27179718, "Gainesville Regional Airport"},
+	{"GNY", "TR", 37.44566345, 38.89559174, "Şanlıurfa GAP Airport"},
+	{"GNZ", "BW", -21.69249916, 21.65810013, "Ghanzi Airport"},
+	{"GOA", "IT", 44.41329956, 8.837499619, "Genoa Cristoforo Colombo Airport"},
+	{"GOB", "ET", 7.1160634, 40.0463033, "Robe Airport"},
+	{"GOC", "PG", -9.0021, 148.2364, "Gora Airstrip"},
+	{"GOE", "PG", -5.527716614, 151.5733333, "Gonaili Airport"},
+	{"GOG", "NA", -22.50440025, 18.97310066, "Gobabis Airport"},
+	{"GOH", "GL", 64.19090271, -51.67810059, "Godthaab / Nuuk Airport"},
+	{"GOI", "IN", 15.38080025, 73.83139801, "Dabolim Airport"},
+	{"GOJ", "RU", 56.23009872, 43.7840004, "Nizhny Novgorod International Airport"},
+	{"GOK", "US", 35.84980011, -97.41560364, "Guthrie-Edmond Regional Airport"},
+	{"GOL", "US", 42.41339874, -124.4240036, "Gold Beach Municipal Airport"},
+	{"GOM", "CD", -1.670809984, 29.2385006, "Goma International Airport"},
+	{"GON", "US", 41.33010101, -72.04509735, "Groton New London Airport"},
+	{"GOO", "AU", -28.52140045, 150.3200073, "Goondiwindi Airport"},
+	{"GOP", "IN", 26.73970032, 83.4496994, "Gorakhpur Airport"},
+	{"GOQ", "CN", 36.40060043, 94.78610229, "Golmud Airport"},
+	{"GOR", "ET", 8.1614, 35.5529, "Gore Airport"},
+	{"GOT", "SE", 57.66279984, 12.27980042, "Gothenburg-Landvetter Airport"},
+	{"GOU", "CM", 9.335889816, 13.37010002, "Garoua International Airport"},
+	{"GOV", "AU", -12.26939964, 136.8179932, "Gove Airport"},
+	{"GOZ", "BG", 43.15140152, 25.71290016, "Gorna Oryahovitsa Airport"},
+	{"GPA", "GR", 38.15110016, 21.42560005, "Araxos Airport"},
+	{"GPB", "BR", -25.38750076, -51.52019882, "Tancredo Thomas de Faria Airport"},
+	{"GPD", "AU", -19.77265, 139.40425, "Mount Gordon Airport"},
+	{"GPI", "CO", 2.57013, -77.8986, "Juan Casiano Airport"},
+	{"GPL", "CR", 10.21720028, -83.79699707, "Guapiles Airport"},
+	{"GPN", "AU", -11.40250015, 130.4219971, "Garden Point Airport"},
+	{"GPO", "AR", -35.69620132, -63.75830078, "General Pico Airport"},
+	{"GPS", "EC", -0.453758001, -90.26589966, "Seymour Airport"},
+	{"GPT", "US", 30.40730095, -89.07009888, "Gulfport Biloxi International Airport"},
+	{"GPZ", "US", 47.21110153, -93.50980377, "Grand Rapids Itasca Co-Gordon Newstrom field"},
+	{"GQQ", "US", 40.7533989, -82.72380066, "Galion Municipal Airport"},
+	{"GRB", "US", 44.48509979, -88.12960052, "Austin Straubel International Airport"},
+	{"GRC", "LR", 4.571, -8.2076, "Grand Cess Airport"},
+	{"GRD", "US", 34.24869919, -82.15910339, "Greenwood County Airport"},
+	{"GRE", "US", 38.83620071, -89.37840271, "Greenville Airport"},
+	{"GRF", "US", 47.07920074, -122.5810013, "Gray Army Air Field"},
+	{"GRG", "AF", 33.63150024, 69.23940277, "Gardez Airport"},
+	{"GRI", "US", 40.96749878, -98.30960083, "Central Nebraska Regional Airport"},
+	{"GRJ", "ZA", -34.00559998, 22.37890053, "George Airport"},
+	{"GRK", "US", 31.06719971, -97.8289032, "Robert Gray  Army Air Field Airport"},
+	{"GRL", "PG", -7.980713, 147.213461, "Garasa Airport"},
+	{"GRM", "US", 47.8382988, -90.38289642, "Grand Marais Cook County Airport"},
+	{"GRN", "US", 42.80599976, -102.1750031, "Gordon Municipal Airport"},
+	{"GRO", "ES", 41.90100098, 2.760550022, "Girona Airport"},
+	{"GRP", "BR", -11.73960018, -49.13219833, "Gurupi Airport"},
+	{"GRQ", "NL", 53.11970139, 6.579440117, "Eelde Airport"},
+	{"GRR", "US", 42.88079834, -85.52279663, "Gerald R. Ford International Airport"},
+	{"GRS", "IT", 42.75970078, 11.07190037, "Grosseto Airport"},
+	{"GRT", "PK", 32.60309982, 74.07710266, "Gujrat Airport"},
+	{"GRU", "BR", -23.43555641, -46.47305679, "Guarulhos - Governador André Franco Montoro International Airport"},
+	{"GRV", "RU", 43.38830185, 45.69860077, "Grozny North Airport"},
+	{"GRW", "PT", 39.09220123, -28.02980042, "Graciosa Airport"},
+	{"GRX", "ES", 37.18870163, -3.777359962, "Federico Garcia Lorca Airport"},
+	{"GRY", "IS", 66.5458, -18.0173, "Grímsey Airport"},
+	{"GRZ", "AT", 46.99110031, 15.43959999, "Graz Airport"},
+	{"GSA", "MY", 4.400000095, 115.7170029, "Long Pasia Airport"},
+	{"GSB", "US", 35.33940125, -77.96060181, "Seymour Johnson Air Force Base"},
+	{"GSC", "AU", -25.0546, 115.2026, "Gascoyne Junction Airport"},
+	{"GSE", "SE", 57.77470016, 11.87040043, "Gothenburg City Airport"},
+	{"GSH", "US", 41.52640152, -85.79290009, "Goshen Municipal Airport"},
+	{"GSJ", "GT", 13.93620014, -90.83580017, "San José Airport"},
+	{"GSL", "CA", 62.59809875, -111.5429993, "Taltheilei Narrows Airport"},
+	{"GSM", "IR", 26.94869995, 56.26879883, "Gheshm Airport"},
+	{"GSN", "AU", -31.45969963, 137.173996, "Mount Gunson Airport"},
+	{"GSO", "US", 36.09780121, -79.93730164, "Piedmont Triad International Airport"},
+	{"GSP", "US", 34.89569855, -82.21890259, "Greenville Spartanburg International Airport"},
+	{"GSQ", "EG", 22.58569908, 28.71660042, "Shark El Oweinat International Airport"},
+	{"GSR", "SO", 9.517000198, 49.08300018, "Gardo Airport"},
+	{"GSS", "ZA", -24.94737543, 31.44884777, "Sabi Sabi Airport"},
+	{"GST", "US", 58.4253006, -135.7070007, "Gustavus Airport"},
+	{"GSU", "SD", 14.13300037, 35.31700134, "Azaza Airport"},
+	{"GTA", "SB", -8.739167, 158.203056, "Gatokae Airport"},
+	{"GTE", "AU", -13.97500038, 136.4600067, "Groote Eylandt Airport"},
+	{"GTF", "US", 47.48199844, -111.3710022, "Great Falls International Airport"},
+	{"GTG", "US", 45.79809952, -92.66439819, "Grantsburg Municipal Airport"},
+	{"GTI", "DE", 54.3833313, 13.3255558, "Rügen Airport"},
+	{"GTK", "MY", 2.599999905, 102.9166641, "Sungei Tekai Airport"},
+	{"GTN", "NZ", -43.90670013, 170.128006, "Glentanner Airport"},
+	{"GTO", "ID", 0.637118995, 122.8499985, "Jalaluddin Airport"},
+	{"GTP", "US", 42.51010132, -123.3880005, "Grants Pass Airport"},
+	{"GTR", "US", 33.45029831, -88.59140015, "Golden Triangle Regional Airport"},
+	{"GTS", "AU", -26.94829941, 133.6069946, "Granite Downs Airport"},
+	{"GTT", "AU", -18.30500031, 143.5299988, "Georgetown Airport"},
+	{"GTW", "CZ", 49.31439972, 17.56889915, "Holešov Airport"},
+	{"GTY", "US", 39.84090042, -77.27420044, "Gettysburg Regional Airport"},
+	{"GTZ", "TZ", -2.160833333, 34.22555556, "Kirawira B Aerodrome"},
+	{"GUA", "GT", 14.58329964, -90.52749634, "La Aurora Airport"},
+	{"GUB", "MX", 28.02610016, -114.0240021, "Guerrero Negro Airport"},
+	{"GUC", "US", 38.53390121, -106.9329987, "Gunnison Crested Butte Regional Airport"},
+	{"GUD", "ML", 16.3614006, -3.599720001, "Goundam Airport"},
+	{"GUE", "PG", -3.583167, 141.5895, "Guriaso (Keraso) Airport"},
+	{"GUF", "US", 30.29050064, -87.67179871, "Jack Edwards Airport"},
+	{"GUG", "PG", -8.1286, 146.8722, "Guari Airport"},
+	{"GUH", "AU", -30.96109962, 150.2510071, "Gunnedah Airport"},
+	{"GUI", "VE", 10.57407761, -62.31266785, "Guiria Airport"},
+	{"GUJ", "BR", -22.79159927, -45.20479965, "Guaratinguetá Airport"},
+	{"GUL", "AU", -34.81029892, 149.7259979, "Goulburn Airport"},
+	{"GUM", "GU", 13.48340034, 144.7960052, "Antonio B. Won Pat International Airport"},
+	{"GUP", "US", 35.51110077, -108.7890015, "Gallup Municipal Airport"},
+	{"GUQ", "VE", 9.02694416, -69.75514984, "Guanare Airport"},
+	{"GUR", "PG", -10.3114996, 150.3339996, "Gurney Airport"},
+	{"GUS", "US", 40.64810181, -86.15209961, "Grissom Air Reserve Base"},
+	{"GUT", "DE", 51.92279816, 8.306329727, "Gütersloh Airport"},
+	{"GUU", "IS", 64.99140167, -23.22470093, "Grundarfjörður Airport"},
+	{"GUV", "PG", -6.280859, 142.420775, "Mougulu Airport"},
+	{"GUW", "KZ", 47.12189865, 51.82139969, "Atyrau Airport"},
+	{"GUX", "IN", 24.65469933, 77.34729767, "Guna Airport"},
+	{"GUY", "US", 36.68510056, -101.5080032, "Guymon Municipal Airport"},
+	{"GUZ", "BR", -20.64649963, -40.4919014, "Guarapari Airport"},
+	{"GVA", "CH", 46.23809814, 6.108950138, "Geneva Cointrin International Airport"},
+	{"GVE", "US", 38.15599823, -78.165802, "Gordonsville Municipal Airport"},
+	{"GVI", "PG", -3.902242899, 141.170517, "Green River Airport"},
+	{"GVL", "US", 34.27259827, -83.8302002, "Lee Gilmer Memorial Airport"},
+	{"GVN", "RU", 48.92699814, 140.0339966, "Maygatka Airport."},
+	{"GVP", "AU", -18.98329926, 145.1170044, "Greenvale Airport"},
+	{"GVR", "BR", -18.89520073, -41.98220062, "Governador Valadares Airport"},
+	{"GVT", "US", 33.06779861, -96.06529999, "Majors Airport"},
+	{"GVX", "SE", 60.59329987, 16.95140076, "Gävle Sandviken Airport"},
+	{"GWA", "MM", 17.60000038, 94.58329773, "Gwa Airport"},
+	{"GWD", "PK", 25.23329926, 62.32949829, "Gwadar International Airport"},
+	{"GWE", "ZW", -19.43639946, 29.86190033, "Thornhill Air Base"},
+	{"GWL", "IN", 26.29330063, 78.22779846, "Gwalior Airport"},
+	{"GWO", "US", 33.49430084, -90.08470154, "Greenwood–Leflore Airport"},
+	{"GWS", "US", 39.50830078, -107.310997, "Glenwood Springs Municipal Airport"},
+	{"GWT", "DE", 54.91320038, 8.340470314, "Westerland Sylt Airport"},
+	{"GWV", "US", 39.94869995, -80.7594986, "Glendale Fokker Field"},
+	{"GWY", "IE", 53.30020142, -8.941590309, "Galway Airport"},
+	{"GXF", "YE", 15.96609974, 48.78829956, "Sayun International Airport"},
+	{"GXG", "AO", -7.754509926, 15.2876997, "Negage Airport"},
+	{"GXH", "CN", 34.8105, 102.6447, "Gannan Xiahe Airport"},
+	{"GXQ", "CL", -45.59420013, -72.10610199, "Teniente Vidal Airport"},
+	{"GXX", "CM", 10.35610008, 15.23719978, "Yagoua Airport"},
+	{"GXY", "US", 40.43740082, -104.6330032, "Greeley–Weld County Airport"},
+	{"GYA", "BO", -10.82059956, -65.34559631, "Capitán de Av. Emilio Beltrán Airport"},
+	{"GYD", "AZ", 40.46749878, 50.04669952, "Heydar Aliyev International Airport"},
+	{"GYE", "EC", -2.15741992, -79.88359833, "José Joaquín de Olmedo International Airport"},
+	{"GYI", "RW", -1.67719996, 29.25889969, "Gisenyi Airport"},
+	{"GYL", "AU", -16.63689995, 128.451004, "Argyle Airport"},
+	{"GYM", "MX", 27.96899986, -110.9250031, "General José María Yáñez International Airport"},
+	{"GYN", "BR", -16.63199997, -49.22069931, "Santa Genoveva Airport"},
+	{"GYP", "AU", -26.28280067, 152.7019958, "Gympie Airport"},
+	{"GYR", "US", 33.42250061, -112.3759995, "Phoenix Goodyear Airport"},
+	{"GYS", "CN", 32.39110184, 105.7020035, "Guangyuan Airport"},
+	{"GYU", "CN", 36.07888889, 106.2169444, "Guyuan Liupanshan Airport"},
+	{"GYY", "US", 41.61629868, -87.41280365, "Gary Chicago International Airport"},
+	{"GZI", "AF", 33.53120041, 68.41290283, "Ghazni Airport"},
+	{"GZO", "SB", -8.097780228, 156.8639984, "Nusatupe Airport"},
+	{"GZP", "TR", 36.29921722, 32.30059814, "Gazipaşa Airport"},
+	{"GZT", "TR", 36.94720078, 37.47869873, "Gaziantep International Airport"},
+	{"HAA", "NO", 70.48670197, 22.13969994, "Hasvik Airport"},
+	{"HAB", "US", 34.11759949, -87.99819946, "Marion County Rankin Fite Airport"},
+	{"HAC", "JP", 33.11500168, 139.7859955, "Hachijojima Airport"},
+	{"HAD", "SE", 56.69110107, 12.82019997, "Halmstad Airport"},
+	{"HAF", "US", 37.51340103, -122.5009995, "Half Moon Bay Airport"},
+	{"HAH", "KM", -11.53369999, 43.27190018, "Prince Said Ibrahim International Airport"},
+	{"HAI", "US", 41.95980072, -85.59339905, "Three Rivers Municipal Dr Haines Airport"},
+	{"HAJ", "DE", 52.46110153, 9.685079575, "Hannover Airport"},
+	{"HAK", "CN", 19.93490028, 110.4589996, "Haikou Meilan International Airport"},
+	{"HAL", "NA", -19.0285, 16.4585, "Halali Airport"},
+	{"HAM", "DE", 53.63040161, 9.988229752, "Hamburg Airport"},
+	{"HAN", "VN", 21.22120094, 105.8069992, "Noi Bai International Airport"},
+	{"HAO", "US", 39.36380005, -84.52200317, "Butler Co Regional Airport - Hogan Field"},
+	{"HAQ", "MV", 6.744229794, 73.17050171, "Hanimaadhoo Airport"},
+	{"HAR", "US", 40.21709824, -76.85150146, "Capital City Airport"},
+	{"HAS", "SA", 27.43790054, 41.68629837, "Hail Airport"},
+	{"HAT", "AU", -11.73690033, 142.5769959, "Heathlands Airport"},
+	{"HAU", "NO", 59.34529877, 5.208360195, "Haugesund Airport"},
+	{"HAV", "CU", 22.98920059, -82.40910339, "José Martí International Airport"},
+	{"HAW", "GB", 51.83309937, -4.961110115, "Haverfordwest Airport"},
+	{"HAY", "US", 65.2009964, -161.1569977, "Haycock Airport"},
+	{"HAZ", "PG", -4.4033, 145.2056, "Hatzfeldhaven Airport"},
+	{"HBA", "AU", -42.83610153, 147.5099945, "Hobart International Airport"},
+	{"HBD", "PG", -6.32, 142.4893, "Habi Airport"},
+	{"HBE", "EG", 30.91769981, 29.69639969, "Borg El Arab International Airport"},
+	{"HBK", "US", 34.94070053, -110.1380005, "Holbrook Municipal Airport"},
+	{"HBU", "MN", 46.1006012, 91.584198, "Bulgan Sum Airport"},
+	{"HBX", "IN", 15.36170006, 75.0848999, "Hubli Airport"},
+	{"HCC", "US", 42.29130173, -73.71029663, "Columbia County Airport"},
+	{"HCM", "SO", 7.916999817, 49.79999924, "Eil Airport"},
+	{"HCN", "TW", 22.04109955, 120.7300034, "Hengchun Airport"},
+	{"HCQ", "AU", -18.23390007, 127.6699982, "Halls Creek Airport"},
+	{"HCR", "US", 62.18830109, -159.7749939, "Holy Cross Airport"},
+	{"HCW", "US", 34.71289825, -79.95700073, "Cheraw Municipal Airport/Lynch Bellinger Field"},
+	{"HDD", "PK", 25.31809998, 68.3660965, "Hyderabad Airport"},
+	{"HDF", "DE", 53.87870026, 14.15229988, "Heringsdorf Airport"},
+	{"HDG", "CN", 36.52583333, 114.4255556, "Handan Airport"},
+	{"HDH", "US", 21.5795002, -158.1970062, "Dillingham Airfield"},
+	{"HDM", "IR", 34.86920166, 48.55250168, "Hamadan Airport"},
+	{"HDN", "US", 40.48120117, -107.2180023, "Yampa Valley Airport"},
+	{"HDR", "IR", 27.1583004, 56.17250061, "Havadarya Airport"},
+	{"HDS", "ZA", -24.36860085, 31.04870033, "Hoedspruit Air Force Base Airport"},
+	{"HDY", "TH", 6.933209896, 100.3929977, "Hat Yai International Airport"},
+	{"HEA", "AF", 34.20999908, 62.22829819, "Herat Airport"},
+	{"HEB", "MM", 17.63332939, 95.46669769, "Hinthada Airport"},
+	{"HED", "US", 55.80139923, -160.8990021, "Herendeen Bay Airport"},
+	{"HEG", "SD", 9.994933, 29.397718, "Heglig Airport"},
+	{"HEH", "MM", 20.74699974, 96.79199982, "Heho Airport"},
+	{"HEI", "DE", 54.15333176, 8.901666641, "Heide-Büsum Airport"},
+	{"HEK", "CN", 50.17162094, 127.3088837, "Heihe Airport"},
+	{"HEL", "FI", 60.31719971, 24.9633007, "Helsinki Vantaa Airport"},
+	{"HEM", "FI", 60.25460052, 25.0428009, "Helsinki Malmi Airport"},
+	{"HEO", "PG", -9.13658, 147.598434, "Haelogo Airport"},
+	{"HER", "GR", 35.33969879, 25.18029976, "Heraklion International Nikos Kazantzakis Airport"},
+	{"HET", "CN", 40.85139847, 111.8239975, "Baita International Airport"},
+	{"HEV", "ES", 37.36429977, -6.920939922, "Mafé - Gibraleón Airport"},
+	{"HEW", "GR", 37.8932991, 23.72610092, "Athen Helenikon Airport"},
+	{"HFA", "IL", 32.80939865, 35.04309845, "Haifa International Airport"},
+	{"HFE", "CN", 31.78000069, 117.2979965, "Hefei Luogang International Airport"},
+	{"HFN", "IS", 64.29560089, -15.22719955, "Hornafjordur Airport"},
+	{"HFS", "SE", 60.02009964, 13.57890034, "Hagfors Airport"},
+	{"HFT", "NO", 70.67970276, 23.66860008, "Hammerfest Airport"},
+	{"HGA", "SO", 9.518170357, 44.08879852, "Egal International Airport"},
+	{"HGD", "AU", -20.81500053, 144.2250061, "Hughenden Airport"},
+	{"HGH", "CN", 30.22949982, 120.4339981, "Hangzhou Xiaoshan International Airport"},
+	{"HGI", "SS", 10.52916667, 32.50055556, "Paloich Airport, Heliport"},
+	{"HGL", "DE", 54.18527985, 7.915832996, "Helgoland-Düne Airport"},
+	{"HGN", "TH", 19.30130005, 97.97579956, "Mae Hong Son Airport"},
+	{"HGO", "CI", 9.387180328, -5.556660175, "Korhogo Airport"},
+	{"HGR", "US", 39.707901, -77.72949982, "Hagerstown Regional Richard A Henson Field"},
+	{"HGS", "SL", 8.397130013, -13.12909985, "Hastings Airport"},
+	{"HGU", "PG", -5.826789856, 144.2960052, "Mount Hagen Kagamuga Airport"},
+	{"HGZ", "US", 66.2161026, -155.6690063, "Hog River Airport"},
+	{"HHE", "JP", 40.5564003, 141.4660034, "Hachinohe Airport"},
+	{"HHH", "US", 32.22439957, -80.69750214, "Hilton Head Airport"},
+	{"HHI", "US", 21.48349953, -158.0399933, "Wheeler Army Airfield"},
+	{"HHN", "DE", 49.94869995, 7.26388979, "Frankfurt-Hahn Airport"},
+	{"HHQ", "TH", 12.63619995, 99.95149994, "Hua Hin Airport"},
+	{"HHZ", "PF", -17.54470062, -142.6139984, "Hikueru Atoll Airport"},
+	{"HIA", "CN", 33.79083333, 119.125, "Lianshui Airport"},
+	{"HIB", "US", 47.38660049, -92.83899689, "Range Regional Airport"},
+	{"HID", "AU", -10.58640003, 142.2899933, "Horn Island Airport"},
+	{"HIG", "AU", -16.42440033, 143.1459961, "Highbury Airport"},
+	{"HIJ", "JP", 34.43610001, 132.9190063, "Hiroshima Airport"},
+	{"HIL", "ET", 6.083330154, 44.76670074, "Shilavo Airport"},
+	{"HIN", "KR", 35.08850098, 128.0700073, "Sacheon Air Base"},
+	{"HIP", "AU", -21.33329964, 138.2830048, "Headingly Airport"},
+	{"HIR", "SB", -9.42800045, 160.0549927, "Honiara International Airport"},
+	{"HIT", "PG", -6.9406, 143.059, "Haivaro Airport"},
+	{"HIW", "JP", 34.3669014, 132.4140015, "Hiroshimanishi Airport"},
+	{"HJJ", "CN", 27.44111111, 109.7, "Zhijiang Airport"},
+	{"HJR", "IN", 24.81719971, 79.91860199, "Khajuraho Airport"},
+	{"HJT", "MN", 46.92580032, 102.7730026, "Khujirt Airport"},
+	{"HKA", "US", 35.94039917, -89.83080292, "Blytheville Municipal Airport"},
+	{"HKB", "US", 63.9958, -144.6926, "Healy Lake Airport"},
+	{"HKD", "JP", 41.77000046, 140.8220062, "Hakodate Airport"},
+	{"HKG", "HK", 22.30890083, 113.9150009, "Chek Lap Kok International Airport"},
+	{"HKK", "NZ", -42.71360016, 170.9850006, "Hokitika Airfield"},
+	{"HKN", "PG", -5.462170124, 150.4049988, "Kimbe Airport"},
+	{"HKS", "US", 32.33449936, -90.22219849, "Hawkins Field"},
+	{"HKT", "TH", 8.113200188, 98.31690216, "Phuket International Airport"},
+	{"HKV", "BG", 41.87179947, 25.60479927, "Malevo Airport"},
+	{"HKY", "US", 35.74110031, -81.38950348, "Hickory Regional Airport"},
+	{"HLA", "ZA", -25.93849945, 27.92609978, "Lanseria Airport"},
+	{"HLB", "US", 39.3445015, -85.25830078, "Hillenbrand Industries Airport"},
+	{"HLC", "US", 39.37879944, -99.83149719, "Hill City Municipal Airport"},
+	{"HLD", "CN", 49.20500183, 119.8249969, "Dongshan Airport"},
+	{"HLF", "SE", 57.5257988, 15.82330036, "Hultsfred Airport"},
+	{"HLG", "US", 40.17499924, -80.64630127, "Wheeling Ohio County Airport"},
+	{"HLH", "CN", 46.195333, 122.008333, "Ulanhot Airport"},
+	{"HLI", "US", 36.8932991, -121.4100037, "Hollister Municipal Airport"},
+	{"HLJ", "LT", 56.0705986, 23.55809975, "Barysiai Airport"},
+	{"HLL", "AU", -21.7244, 119.3922, "Hillside Airport"},
+	{"HLM", "US", 42.79589844, -86.16200256, "Park Township Airport"},
+	{"HLN", "US", 46.60680008, -111.9830017, "Helena Regional Airport"},
+	{"HLO", "IS", 66.05000305, -23.69610023, "Ingjaldssanður Airport"},
+	{"HLP", "ID", -6.266610146, 106.8909988, "Halim Perdanakusuma International Airport"},
+	{"HLR", "US", 31.13870049, -97.71450043, "Hood Army Air Field"},
+	{"HLS", "AU", -41.33670044, 148.2819977, "St Helens Airport"},
+	{"HLT", "AU", -37.64889908, 142.0650024, "Hamilton Airport"},
+	{"HLU", "NC", -21.25639915, 165.6170044, "Nesson Airport"},
+	{"HLV", "AU", -15.6857996, 145.2149963, "Helenvale Airport"},
+	{"HLW", "ZA", -28.01660499, 32.27517128, "Hluhluwe Airport"},
+	{"HLZ", "NZ", -37.86669922, 175.3320007, "Hamilton International Airport"},
+	{"HMA", "RU", 61.0284996, 69.08609772, "Khanty Mansiysk Airport"},
+	{"HMB", "EG", 26.34277778, 31.74277778, "Sohag International Airport"},
+	{"HME", "DZ", 31.67300034, 6.140439987, "Oued Irara Airport"},
+	{"HMG", "AU", -23.93000031, 132.8049927, "Hermannsburg Airport"},
+	{"HMI", "CN", 42.84140015, 93.66919708, "Hami Airport"},
+	{"HMJ", "UA", 49.35969925, 26.9333992, "Khmelnytskyi Airport"},
+	{"HMN", "US", 32.85250092, -106.1070023, "Holloman Air Force Base"},
+	{"HMO", "MX", 29.09589958, -111.0479965, "General Ignacio P. Garcia International Airport"},
+	{"HMR", "NO", 60.81809998, 11.06799984, "Stafsberg Airport"},
+	{"HMT", "US", 33.73400116, -117.0230026, "Hemet Ryan Airport"},
+	{"HMV", "SE", 65.80609894, 15.08279991, "Hemavan Airport"},
+	{"HNA", "JP", 39.42860031, 141.1349945, "Hanamaki Airport"},
+	{"HNB", "US", 38.24900055, -86.9536972, "Huntingburg Airport"},
+	{"HNC", "US", 35.23279953, -75.61779785, "Billy Mitchell Airport"},
+	{"HND", "JP", 35.5522995, 139.7799988, "Tokyo International Airport"},
+	{"HNE", "US", 61.90140152, -147.3040009, "Tahneta Pass Airport"},
+	{"HNH", "US", 58.09609985, -135.4100037, "Hoonah Airport"},
+	{"HNI", "PG", -7.4223, 146.4276, "Heiweni Airport"},
+	{"HNL", "US", 21.31870079, -157.9219971, "Honolulu International Airport"},
+	{"HNM", "US", 20.79560089, -156.0140076, "Hana Airport"},
+	{"HNN", "PG", -16.2457, 142.1771, "Honinabi Airport"},
+	{"HNS", "US", 59.24380112, -135.5240021, "Haines Airport"},
+	{"HNY", "CN", 26.90530014, 112.6279984, "Hengyang Airport"},
+	{"HOA", "KE", -1.521999955, 40.00400162, "Hola Airport"},
+	{"HOB", "US", 32.6875, -103.2170029, "Lea County Regional Airport"},
+	{"HOC", "PG", -7.3984, 145.8827, "Komako Airport"},
+	{"HOD", "YE", 14.75300026, 42.97629929, "Hodeidah International Airport"},
+	{"HOE", "LA", 20.25729942, 100.4369965, "Ban Huoeisay Airport"},
+	{"HOF", "SA", 25.2852993, 49.48519897, "Al Ahsa Airport"},
+	{"HOG", "CU", 20.78560066, -76.31510162, "Frank Pais International Airport"},
+	{"HOH", "AT", 47.38499832, 9.699999809, "Hohenems-Dornbirn Airport"},
+	{"HOI", "PF", -18.07480049, -140.9459991, "Hao Airport"},
+	{"HOK", "AU", -18.33670044, 130.6380005, "Hooker Creek Airport"},
+	{"HOM", "US", 59.64559937, -151.477005, "Homer Airport"},
+	{"HON", "US", 44.3852005, -98.22850037, "Huron Regional Airport"},
+	{"HOO", "VN", 11.9787, 107.5638, "Nhon Co Airfield"},
+	{"HOP", "US", 36.66859818, -87.49620056, "Campbell AAF (Fort Campbell) Air Field"},
+	{"HOQ", "DE", 50.28861237, 11.85638905, "Hof-Plauen Airport"},
+	{"HOR", "PT", 38.51990128, -28.71590042, "Horta Airport"},
+	{"HOS", "AR", -37.44469833, -70.22250366, "Chos Malal Airport"},
+	{"HOT", "US", 34.47800064, -93.09619904, "Memorial Field"},
+	{"HOU", "US", 29.64539909, -95.27890015, "William P Hobby Airport"},
+	{"HOV", "NO", 62.18000031, 6.074100018, "Ørsta-Volda Airport, Hovden"},
+	{"HOX", "MM", 24.89959908, 94.91400146, "Hommalinn Airport"},
+	{"HOY", "GB", 58.79177893, -3.216075897, "Hoy/Longhope Airfield"},
+	{"HPA", "TO", -19.77700043, -174.3410034, "Lifuka Island Airport"},
+	{"HPB", "US", 61.52389908, -166.1470032, "Hooper Bay Airport"},
+	{"HPE", "AU", -15.2923, 145.1035, "Hope Vale Airport"},
+	{"HPH", "VN", 20.81940079, 106.7249985, "Cat Bi International Airport"},
+	{"HPN", "US", 41.06700134, -73.70760345, "Westchester County Airport"},
+	{"HPT", "US", 42.72370148, -93.2263031, "Hampton Municipal Airport"},
+	{"HPV", "US", 22.20919991, -159.4459991, "Princeville Airport"},
+	{"HPY", "US", 29.78610039, -94.95269775, "Baytown Airport"},
+	{"HQM", "US", 46.97119904, -123.9369965, "Bowerman Airport"},
+	{"HRA", "PK", 34.33332062, 73.20020294, "Mansehra Airport"},
+	{"HRB", "CN", 45.62340164, 126.25, "Taiping Airport"},
+	{"HRC", "KZ", 48.3974, 70.1915, "Sary Su Airport"},
+	{"HRE", "ZW", -17.93180084, 31.09280014, "Harare International Airport"},
+	{"HRG", "EG", 27.17830086, 33.79940033, "Hurghada International Airport"},
+	{"HRI", "LK", 6.284467, 81.124128, "Mattala Rajapaksa International Airport"},
+	{"HRK", "UA", 49.92480087, 36.29000092, "Kharkiv International Airport"},
+	{"HRL", "US", 26.22850037, -97.65440369, "Valley International Airport"},
+	{"HRM", "DZ", 32.93040085, 3.311539888, "Hassi R\"Mel Airport"},
+	{"HRO", "US", 36.26150131, -93.15470123, "Boone County Airport"},
+	{"HRR", "CO", 3.216667, -75.85, "Herrera Airport"},
+	{"HRS", "ZA", -28.23509979, 29.10619926, "Harrismith Airport"},
+	{"HRT", "GB", 54.0489006, -1.252750039, "RAF Linton-On-Ouse"},
+	{"HRY", "AU", -24.584, 133.236, "Henbury Airport"},
+	{"HRZ", "BR", -27.63829994, -54.33909988, "Walter Bündchen Airport"},
+	{"HSB", "US", 37.81129837, -88.5503006, "Harrisburg-Raleigh Airport"},
+	{"HSC", "CN", 24.97859955, 113.4209976, "Shaoguan Guitou Airport"},
+	{"HSG", "JP", 33.14970016, 130.302002, "Saga Airport"},
+	{"HSH", "US", 35.97280121, -115.1340027, "Henderson Executive Airport"},
+	{"HSI", "US", 40.6053009, -98.42790222, "Hastings Municipal Airport"},
+	{"HSK", "ES", 42.0760994, -0.316666991, "Huesca/Pirineos Airport"},
+	{"HSL", "US", 65.69789886, -156.3509979, "Huslia Airport"},
+	{"HSM", "AU", -36.66970062, 142.1730042, "Horsham Airport"},
+	{"HSN", "CN", 29.93420029, 122.3619995, "Zhoushan Airport"},
+	{"HSP", "US", 37.95140076, -79.83390045, "Ingalls Field"},
+	{"HSS", "IN", 29.17939949, 75.75530243, "Hissar Airport"},
+	{"HST", "US", 25.48859978, -80.38359833, "Homestead ARB Airport"},
+	{"HSV", "US", 34.6371994, -86.77510071, "Huntsville International Carl T Jones Field"},
+	{"HSZ", "TW", 24.81800079, 120.939003, "Hsinchu Air Base"},
+	{"HTA", "RU", 52.02629852, 113.3059998, "Chita-Kadala Airport"},
+	{"HTG", "RU", 71.97810364, 102.4909973, "Khatanga Airport"},
+	{"HTI", "AU", -20.35810089, 148.9519959, "Hamilton Island Airport"},
+	{"HTM", "MN", 50.435988, 100.139532, "Khatgal Airport"},
+	{"HTN", "CN", 37.03850174, 79.86489868, "Hotan Airport"},
+	{"HTO", "US", 40.95959854, -72.25180054, "East Hampton Airport"},
+	{"HTR", "JP", 24.05890083, 123.8059998, "Hateruma Airport"},
+	{"HTS", "US", 38.36669922, -82.55799866, "Tri-State/Milton J. Ferguson Field"},
+	{"HTU", "AU", -35.71530151, 142.3600006, "Hopetoun Airport"},
+	{"HTV", "US", 30.74690056, -95.58719635, "Huntsville Regional Airport"},
+	{"HTY", "TR", 36.36277771, 36.28222275, "Hatay Airport"},
+	{"HTZ", "CO", 6.15, -71.75, "Hato Corozal Airport"},
+	{"HUA", "US", 34.67869949, -86.68479919, "Redstone Army Air Field"},
+	{"HUB", "AU", -16.4896698, 130.6302795, "Humbert River Airport"},
+	{"HUC", "PR", 18.13809967, -65.80130005, "Humacao Airport"},
+	{"HUD", "US", 42.73609924, -94.24520111, "Humboldt Municipal Airport"},
+	{"HUE", "ET", 14.25, 36.58300018, "Humera Airport"},
+	{"HUF", "US", 39.45149994, -87.30760193, "Terre Haute International Hulman Field"},
+	{"HUG", "GT", 15.32740021, -91.46240234, "Huehuetenango Airport"},
+	{"HUH", "PF", -16.68720055, -151.0220032, "Huahine-Fare Airport"},
+	{"HUI", "VN", 16.4015007, 107.7030029, "Phu Bai Airport"},
+	{"HUJ", "US", 34.03480148, -95.54190063, "Stan Stamper Municipal Airport"},
+	{"HUK", "BW", -23.98970032, 21.75810051, "Hukuntsi Airport"},
+	{"HUL", "US", 46.12310028, -67.792099, "Houlton International Airport"},
+	{"HUM", "US", 29.56649971, -90.66040039, "Houma Terrebonne Airport"},
+	{"HUN", "TW", 24.0230999, 121.6179962, "Hualien Airport"},
+	{"HUQ", "LY", 29.11009979, 15.96560001, "Hon Airport"},
+	{"HUS", "US", 66.04109955, -154.2630005, "Hughes Airport"},
+	{"HUT", "US", 38.06549835, -97.86060333, "Hutchinson Municipal Airport"},
+	{"HUU", "PE", -9.878809929, -76.20480347, "Alferez Fap David Figueroa Fernandini Airport"},
+	{"HUW", "BR", -7.532120228, -63.07210159, "Humaitá Airport"},
+	{"HUX", "MX", 15.77530003, -96.26260376, "Bahías de Huatulco International Airport"},
+	{"HUY", "GB", 53.57440186, -0.350832999, "Humberside Airport"},
+	{"HUZ", "CN", 23.04999924, 114.5999985, "Huizhou Airport"},
+	{"HVA", "MG", -14.62969971, 47.76380157, "Analalava Airport"},
+	{"HVB", "AU", -25.31889915, 152.8800049, "Hervey Bay Airport"},
+	{"HVD", "MN", 47.95410156, 91.62819672, "Khovd Airport"},
+	{"HVE", "US", 38.41799927, -110.7040024, "Hanksville Airport"},
+	{"HVG", "NO", 71.00969696, 25.98360062, "Valan Airport"},
+	{"HVK", "IS", 65.70469666, -21.69639969, "Hólmavík Airport"},
+	{"HVM", "IS", 65.2664032, -20.84690094, "Krókstaðarmelar Airport"},
+	{"HVN", "US", 41.26369858, -72.88680267, "Tweed New Haven Airport"},
+	{"HVR", "US", 48.54299927, -109.762001, "Havre City County Airport"},
+	{"HVS", "US", 34.40309906, -80.11920166, "Hartsville Regional Airport"},
+	{"HWA", "PG", -7.392994, 146.003487, "Hawabango Airport"},
+	{"HWD", "US", 37.65919876, -122.1220016, "Hayward Executive Airport"},
+	{"HWK", "AU", -31.85590744, 138.4680786, "Wilpena Pound Airport"},
+	{"HWN", "ZW", -18.62989998, 27.02099991, "Hwange National Park Airport"},
+	{"HWO", "US", 26.00119972, -80.24069977, "North Perry Airport"},
+	{"HXX", "AU", -34.53139877, 144.8300018, "Hay Airport"},
+	{"HYA", "US", 41.66930008, -70.28040314, "Barnstable Municipal Boardman Polando Field"},
+	{"HYC", "GB", 51.61169815, -0.80833298, "Wycombe Air Park"},
+	{"HYD", "IN", 17.23131752, 78.42985535, "Rajiv Gandhi International Airport"},
+	{"HYF", "PG", -3.69838, 143.057902, "Hayfields Airport"},
+	{"HYN", "CN", 28.56220055, 121.4290009, "Huangyan Luqiao Airport"},
+	{"HYR", "US", 46.02519989, -91.44429779, "Sawyer County Airport"},
+	{"HYS", "US", 38.84220123, -99.27320099, "Hays Regional Airport"},
+	{"HYV", "FI", 60.65439987, 24.8810997, "Hyvinkää Airfield"},
+	{"HZB", "FR", 50.61840057, 2.642240047, "Merville-Calonne Airport"},
+	{"HZG", "CN", 33.06359863, 107.0080032, "Hanzhong Airport"},
+	{"HZH", "CN", 26.32217, 109.1499, "Liping Airport"},
+	{"HZK", "IS", 65.95230103, -17.4260006, "Husavik Airport"},
+	{"HZL", "US", 40.98680115, -75.99490356, "Hazleton Municipal Airport"},
+	{"HZP", "CA", 57.38169861, -111.7009964, "Fort Mackay / Horizon Airport"},
+	{"HZV", "ZA", -25.05010033, 31.13190079, "Hazyview Airport"},
+	{"IAA", "RU", 67.43720245, 86.62190247, "Igarka Airport"},
+	{"IAB", "US", 37.62189865, -97.26820374, "Mc Connell Air Force Base"},
+	{"IAD", "US", 38.94449997, -77.45580292, "Washington Dulles International Airport"},
+	{"IAG", "US", 43.1072998, -78.94619751, "Niagara Falls International Airport"},
+	{"IAH", "US", 29.9843998, -95.34140015, "George Bush Intercontinental Houston Airport"},
+	{"IAL", "PG", -6.2782, 143.9944, "Ialibu Airport"},
+	{"IAM", "DZ", 28.05150032, 9.642910004, "In Aménas Airport"},
+	{"IAN", "US", 66.97599792, -160.4369965, "Bob Baker Memorial Airport"},
+	{"IAO", "PH", 9.859100342, 126.0139999, "Siargao Airport"},
+	{"IAR", "RU", 57.56069946, 40.15739822, "Tunoshna Airport"},
+	{"IAS", "RO", 47.17850113, 27.62059975, "Iaşi Airport"},
+	{"IBA", "NG", 7.362460136, 3.978329897, "Ibadan Airport"},
+	{"IBB", "EC", -0.942628026, -90.95300293, "General Villamil Airport"},
+	{"IBE", "CO", 4.42161, -75.1333, "Perales Airport"},
+	{"IBI", "PG", -5.5536, 149.19, "Iboki Airport"},
+	{"IBL", "MZ", -21.70800018, 35.45280075, "Indigo Bay Lodge Airport"},
+	{"IBP", "PE", -11.41160011, -69.48870087, "Iberia Airport"},
+	{"IBR", "JP", 36.18109894, 140.4149933, "Hyakuri Airport"},
+	{"IBZ", "ES", 38.8728981, 1.37311995, "Ibiza Airport"},
+	{"ICA", "VE", 4.336319923, -61.73960114, "Icabarú Airport"},
+	{"ICI", "FJ", -17.74329948, -179.3419952, "Cicia Airport"},
+	{"ICK", "SR", 5.955560207, -57.03939819, "Nieuw Nickerie Airport"},
+	{"ICL", "US", 40.72180176, -95.02639771, "Schenck Field"},
+	{"ICN", "KR", 37.46910095, 126.4509964, "Incheon International Airport"},
+	{"ICO", "PH", 11.4595, 123.2506, "Sicogon Airstrip"},
+	{"ICR", "CU", 20.68860054, -75.53150177, "Nicaro Airport"},
+	{"ICS", "US", 44.49380112, -116.0159988, "Cascade Airport"},
+	{"ICT", "US", 37.64989853, -97.43309784, "Wichita Mid Continent Airport"},
+	{"ICY", "US", 59.96900177, -141.6620026, "Icy Bay Airport"},
+	{"IDA", "US", 43.51459885, -112.0709991, "Idaho Falls Regional Airport"},
+	{"IDB", "SE", 61.86970139, 12.68939972, "Idre Airport"},
+	{"IDF", "CD", -5.0, 19.60000038, "Idiofa Airport"},
+	{"IDH", "US", 45.94260025, -116.1230011, "Idaho County Airport"},
+	{"IDI", "US", 40.63219833, -79.10549927, "Indiana County/Jimmy Stewart Fld/ Airport"},
+	{"IDK", "AU", -26.9666996, 133.3249969, "Indulkana Airport"},
+	{"IDN", "PG", -6.22663, 147.244, "Indagen Airport"},
+	{"IDO", "BR", -11.57229996, -50.66619873, "Santa Izabel do Morro Airport"},
+	{"IDP", "US", 37.15840149, -95.77839661, "Independence Municipal Airport"},
+	{"IDR", "IN", 22.72179985, 75.80110168, "Devi Ahilyabai Holkar Airport"},
+	{"IDY", "FR", 46.71860123, -2.391109943, "Île d\"Yeu Airport"},
+	{"IEG", "PL", 52.13850021, 15.7986002, "Zielona Góra-Babimost Airport"},
+	{"IEJ", "JP", 26.72200012, 127.7850037, "Ie Jima Airport"},
+	{"IES", "DE", 51.29360962, 13.35611057, "Riesa-Göhlis Airport"},
+	{"IEV", "UA", 50.40169907, 30.4496994, "Kiev Zhuliany International Airport"},
+	{"IFA", "US", 42.47079849, -93.26999664, "Iowa Falls Municipal Airport"},
+	{"IFF", "AU", -18.89999962, 141.2169952, "Iffley Airport"},
+	{"IFH", "IR", 32.92890167, 51.56110001, "Hesa Airport"},
+	{"IFJ", "IS", 66.05809784, -23.13529968, "Ísafjörður Airport"},
+	{"IFL", "AU", -17.55940056, 146.0119934, "Innisfail Airport"},
+	{"IFN", "IR", 32.75080109, 51.86130142, "Esfahan Shahid Beheshti International Airport"},
+	{"IFO", "UA", 48.88420105, 24.68610001, "Ivano-Frankivsk International Airport"},
+	{"IFP", "US", 35.15739822, -114.5599976, "Laughlin Bullhead International Airport"},
+	{"IGA", "BS", 20.97500038, -73.66690063, "Inagua Airport"},
+	{"IGB", "AR", -41.32089996, -69.57489777, "Cabo F.A.A. H. R. Bordón Airport"},
+	{"IGD", "TR", 39.97662735, 43.87664795, "Iğdır Airport"},
+	{"IGE", "GA", -1.9223, 9.3092, "Tchongorove Airport"},
+	{"IGG", "US", 59.32400131, -155.9019928, "Igiugig Airport"},
+	{"IGH", "AU", -18.66060066, 146.1519928, "Ingham Airport"},
+	{"IGL", "TR", 38.51300049, 27.01009941, "Çiğli Airport"},
+	{"IGM", "US", 35.2594986, -113.9380035, "Kingman Airport"},
+	{"IGN", "PH", 8.130490303, 124.2149963, "Iligan Airport"},
+	{"IGO", "CO", 7.68038, -76.6865, "Chigorodó Airport"},
+	{"IGR", "AR", -25.73730087, -54.47340012, "Cataratas Del Iguazú International Airport"},
+	{"IGS", "DE", 48.71569824, 11.5340004, "Ingolstadt Manching Airport"},
+	{"IGT", "RU", 43.32229996, 45.01259995, "Magas Airport"},
+	{"IGU", "BR", -25.60027885, -54.48500061, "Cataratas International Airport"},
+	{"IHC", "MZ", -25.9971447, 32.92935181, "Inhaca Airport"},
+	{"IHN", "YE", 15.41699982, 51.68299866, "Qishn Airport"},
+	{"IHO", "MG", -22.4047202, 46.16493702, "Ihosy Airport"},
+	{"IHR", "IR", 27.23609924, 60.72000122, "Iran Shahr Airport"},
+	{"IHU", "PG", -7.89756012, 145.3959961, "Ihu Airport"},
+	{"IIA", "IE", 53.0929985, -9.568059921, "Inishmaan Aerodrome"},
+	{"IIL", "IR", 33.58660126, 46.40480042, "Ilam Airport"},
+	{"IIS", "PG", -4.499720097, 154.2259979, "Nissan Island Airport"},
+	{"IJK", "RU", 56.8280983, 53.45750046, "Izhevsk Airport"},
+	{"IJU", "BR", -28.36870003, -53.84659958, "Ijuí Airport"},
+	{"IKA", "IR", 35.41609955, 51.15219879, "Imam Khomeini International Airport"},
+	{"IKB", "US", 36.22280121, -81.09829712, "Wilkes County Airport"},
+	{"IKI", "JP", 33.74900055, 129.7850037, "Iki Airport"},
+	{"IKK", "US", 41.07139969, -87.84629822, "Greater Kankakee Airport"},
+	{"IKL", "CD", -1.200000048, 23.28300095, "Ikela Airport"},
+	{"IKO", "US", 52.9416008, -168.848999, "Nikolski Air Station"},
+	{"IKP", "AU", -16.27499962, 141.4420013, "Inkerman Airport"},
+	{"IKS", "RU", 71.6977005, 128.9029999, "Tiksi Airport"},
+	{"IKT", "RU", 52.26800156, 104.3889999, "Irkutsk Airport"},
+	{"ILA", "ID", -3.97648, 137.6225, "Illaga Airport"},
+	{"ILD", "ES", 41.728185, 0.535023, "Lleida-Alguaire Airport"},
+	{"ILE", "US", 31.08580017, -97.68650055, "Skylark Field"},
+	{"ILF", "CA", 56.06140137, -95.61389923, "Ilford Airport"},
+	{"ILG", "US", 39.67869949, -75.60649872, "New Castle Airport"},
+	{"ILI", "US", 59.75439835, -154.9109955, "Iliamna Airport"},
+	{"ILK", "MG", -19.58300018, 48.8030014, "Atsinanana Airport"},
+	{"ILL", "US", 45.11769867, -95.13040161, "Willmar Municipal -John L Rice Field"},
+	{"ILM", "US", 34.27059937, -77.90260315, "Wilmington International Airport"},
+	{"ILN", "US", 39.42789841, -83.792099, "Wilmington Airpark"},
+	{"ILO", "PH", 10.833017, 122.493358, "Iloilo International Airport"},
+	{"ILP", "NC", -22.58889961, 167.4559937, "Île des Pins Airport"},
+	{"ILQ", "PE", -17.69499969, -71.34400177, "Ilo Airport"},
+	{"ILR", "NG", 8.440210342, 4.493919849, "Ilorin International Airport"},
+	{"ILU", "KE", -2.910609961, 38.06520081, "Kilaguni Airport"},
+	{"ILY", "GB", 55.68190002, -6.256669998, "Islay Airport"},
+	{"ILZ", "SK", 49.23149872, 18.6135006, "Žilina Airport"},
+	{"IMA", "PG", -9.5107, 150.5246, "Iamalele Airport"},
+	{"IMB", "GY", 5.708109856, -60.2942009, "Imbaimadai Airport"},
+	{"IMD", "PG", -3.328, 141.1573, "Imonda Airport"},
+	{"IMF", "IN", 24.76000023, 93.896698, "Imphal Airport"},
+	{"IMG", "MZ", -18.41, 35.0045, "Inhaminga Airport"},
+	{"IMK", "NP", 29.97109985, 81.81890106, "Simikot Airport"},
+	{"IML", "US", 40.50930023, -101.6210022, "Imperial Municipal Airport"},
+	{"IMM", "US", 26.43320084, -81.40100098, "Immokalee Regional Airport"},
+	{"IMN", "PG", -6.7524, 146.1072, "Imane Airport"},
+	{"IMO", "CF", 5.050000191, 25.14999962, "Zemio Airport"},
+	{"IMP", "BR", -5.531290054, -47.45999908, "Prefeito Renato Moreira Airport"},
+	{"IMT", "US", 45.81840134, -88.11450195, "Ford Airport"},
+	{"INA", "RU", 66.05483246, 60.11032104, "Inta Airport"},
+	{"INB", "BZ", 16.53450012, -88.44129944, "Independence Airport"},
+	{"INC", "CN", 38.48189926, 106.0090027, "Yinchuan Airport"},
+	{"IND", "US", 39.71730042, -86.29440308, "Indianapolis International Airport"},
+	{"INE", "MZ", -18.59, 36.4489, "Chinde Airport"},
+	{"INF", "DZ", 19.56699944, 5.75, "In Guezzam Airport"},
+	{"ING", "AR", -50.33610153, -72.24859619, "Lago Argentino Airport"},
+	{"INH", "MZ", -23.87639999, 35.40850067, "Inhambane Airport"},
+	{"INI", "RS", 43.337299, 21.853701, "Nis Airport"},
+	{"INJ", "AU", -25.85000038, 148.5330048, "Injune Airport"},
+	{"INK", "US", 31.77960014, -103.2009964, "Winkler County Airport"},
+	{"INL", "US", 48.56620026, -93.40309906, "Falls International Airport"},
+	{"INM", "AU", -27.70000076, 140.7330017, "Innamincka Airport"},
+	{"INN", "AT", 47.2602005, 11.34399986, "Innsbruck Airport"},
+	{"INO", "CD", -1.947219968, 18.28580093, "Inongo Airport"},
+	{"INQ", "IE", 53.06470108, -9.510899544, "Inisheer Aerodrome"},
+	{"INS", "US", 36.58720016, -115.6729965, "Creech Air Force Base"},
+	{"INT", "US", 36.13370132, -80.22200012, "Smith Reynolds Airport"},
+	{"INU", "NR", -0.547458, 166.919006, "Nauru International Airport"},
+	{"INV", "GB", 57.54249954, -4.047500134, "Inverness Airport"},
+	{"INW", "US", 35.02190018, -110.7229996, "Winslow Lindbergh Regional Airport"},
+	{"INX", "ID", -2.128099918, 132.1609955, "Inanwatan Airport"},
+	{"INY", "ZA", -24.77770042, 31.38549995, "Inyati Airport"},
+	{"INZ", "DZ", 27.25099945, 2.512020111, "In Salah Airport"},
+	{"IOA", "GR", 39.69639969, 20.82250023, "Ioannina Airport"},
+	{"IOK", "PG", -8.401, 146.277, "Iokea Airport"},
+	{"IOM", "IM", 54.08330154, -4.623889923, "Isle of Man Airport"},
+	{"ION", "CG", 1.616999984, 18.06699944, "Impfondo Airport"},
+	{"IOP", "PG", -8.3614, 147.84, "Ioma Airport"},
+	{"IOR", "IE", 53.1067009, -9.653610229, "Inishmore Aerodrome"},
+	{"IOS", "BR", -14.81599998, -39.03319931, "Bahia - Jorge Amado Airport"},
+	{"IOU", "NC", -22.45999908, 166.7830048, "Edmond Cané Airport"},
+	{"IOW", "US", 41.6391983, -91.54650116, "Iowa City Municipal Airport"},
+	{"IPA", "VU", -18.856389, 169.283333, "Ipota Airport"},
+	{"IPC", "CL", -27.16480064, -109.4219971, "Mataveri Airport"},
+	{"IPE", "PH", 7.785560131, 122.6019974, "Ipil Airport"},
+	{"IPG", "BR", -2.939069986, -69.69400024, "Ipiranga Airport"},
+	{"IPH", "MY", 4.567969799, 101.0920029, "Sultan Azlan Shah Airport"},
+	{"IPI", "CO", 0.861925, -77.6718, "San Luis Airport"},
+	{"IPL", "US", 32.83420181, -115.5790024, "Imperial County Airport"},
+	{"IPN", "BR", -19.47069931, -42.48759842, "Usiminas Airport"},
+	{"IPT", "US", 41.2417984, -76.9210968, "Williamsport Regional Airport"},
+	{"IPU", "BR", -14.1338892, -39.73389053, "Ipiaú Airport"},
+	{"IQA", "IQ", 33.78559875, 42.44120026, "Al Asad Air Base"},
+	{"IQM", "CN", 38.1493988, 85.53279877, "Qiemo Airport"},
+	{"IQN", "CN", 35.79970169, 107.6029968, "Qingyang Airport"},
+	{"IQQ", "CL", -20.53520012, -70.1812973, "Diego Aracena Airport"},
+	{"IQT", "PE", -3.784739971, -73.30879974, "Coronel FAP Francisco Secada Vignetta International Airport"},
+	{"IRA", "SB", -10.44970036, 161.897995, "Ngorangora Airport"},
+	{"IRB", "US", 30.90570068, -101.8919983, "Iraan Municipal Airport"},
+	{"IRC", "US", 65.83049774, -144.076004, "Circle City /New/ Airport"},
+	{"IRD", "BD", 24.15250015, 89.04940033, "Ishurdi Airport"},
+	{"IRE", "BR", -11.33990002, -41.84700012, "Irecê Airport"},
+	{"IRG", "AU", -12.78689957, 143.3049927, "Lockhart River Airport"},
+	{"IRI", "TZ", -7.668630123, 35.7521019, "Iringa Airport"},
+	{"IRJ", "AR", -29.38159943, -66.79579926, "Capitan V A Almonacid Airport"},
+	{"IRK", "US", 40.09349823, -92.54489899, "Kirksville Regional Airport"},
+	{"IRM", "RU", 63.19879913, 64.43930054, "Igrim Airport"},
+	{"IRN", "HN", 15.93916702, -85.13722229, "Iriona Airport"},
+	{"IRO", "CF", 10.23639965, 22.71689987, "Birao Airport"},
+	{"IRP", "CD", 2.827610016, 27.5883007, "Matari Airport"},
+	{"IRS", "US", 41.81330109, -85.43900299, "Kirsch Municipal Airport"},
+	{"IRZ", "BR", -0.3786, -64.9923, "Tapuruquara Airport"},
+	{"ISA", "AU", -20.66390038, 139.4889984, "Mount Isa Airport"},
+	{"ISB", "PK", 33.61669922, 73.09919739, "Benazir Bhutto International Airport"},
+	{"ISC", "GB", 49.91329956, -6.291669846, "St. Mary\"s Airport"},
+	{"ISE", "TR", 37.85540009, 30.36840057, "Süleyman Demirel International Airport"},
+	{"ISG", "JP", 24.34449959, 124.1869965, "Ishigaki Airport"},
+	{"ISI", "AU", -24.25830078, 144.4250031, "Isisford Airport"},
+	{"ISJ", "MX", 21.24500084, -86.73999786, "Isla Mujeres Airport"},
+	{"ISK", "IN", 19.96369934, 73.80760193, "Gandhinagar Airport"},
+	{"isl", "VE", 10.93666744, -65.22333527, "La Tortuga Punta Delgada Airport"},
+	{"ISM", "US", 28.28980064, -81.43710327, "Kissimmee Gateway Airport"},
+	{"ISN", "US", 48.17789841, -103.6419983, "Sloulin Field International Airport"},
+	{"ISO", "US", 35.33140183, -77.6088028, "Kinston Regional Jetport At Stallings Field"},
+	{"ISP", "US", 40.79520035, -73.10019684, "Long Island Mac Arthur Airport"},
+	{"ISQ", "US", 45.97460175, -86.17179871, "Schoolcraft County Airport"},
+	{"ISS", "US", 43.96139908, -69.71260071, "Wiscasset Airport"},
+	{"IST", "TR", 40.97689819, 28.81459999, "Atatürk International Airport"},
+	{"ISU", "IQ", 35.5617485, 45.31673813, "Sulaymaniyah International Airport"},
+	{"ISW", "US", 44.36029816, -89.83899689, "Alexander Field South Wood County Airport"},
+	{"ITA", "BR", -3.12725997, -58.48120117, "Itacoatiara Airport"},
+	{"ITB", "BR", -4.242340088, -56.0007019, "Itaituba Airport"},
+	{"ITE", "BR", -13.7322216, -39.14166641, "Ituberá Airport"},
+	{"ITH", "US", 42.49100113, -76.45839691, "Ithaca Tompkins Regional Airport"},
+	{"ITI", "BR", -8.699999809, -51.17416763, "Agropecuária Castanhais Airport"},
+	{"ITK", "PG", -9.201527, 148.264261, "Itokama Airport"},
+	{"ITM", "JP", 34.78549957, 135.4380035, "Osaka International Airport"},
+	{"ITO", "US", 19.72139931, -155.0480042, "Hilo International Airport"},
+	{"ITP", "BR", -21.21929932, -41.87590027, "Itaperuna Airport"},
+	{"ITQ", "BR", -29.17309952, -56.5367012, "Itaqui Airport"},
+	{"ITR", "BR", -18.44470024, -49.21340179, "Hidroelétrica Airport"},
+	{"IUE", "NU", -19.07903099, -169.9255981, "Niue International Airport"},
+	{"IUL", "ID", -3.7051, 138.2002, "Ilu Airport"},
+	{"IVA", "MG", -13.64570531, 48.45942736, "Ambanja Airport"},
+	{"IVC", "NZ", -46.41239929, 168.3130035, "Invercargill Airport"},
+	{"IVG", "ME", 42.8390007, 19.86199951, "Berane Airport"},
+	{"IVL", "FI", 68.6072998, 27.40530014, "Ivalo Airport"},
+	{"IVR", "AU", -29.88829994, 151.1439972, "Inverell Airport"},
+	{"IVW", "AU", -17.84110069, 129.6430054, "Inverway Airport"},
+	{"IWA", "RU", 56.93939972, 40.94079971, "Ivanovo South Airport"},
+	{"IWD", "US", 46.52750015, -90.13140106, "Gogebic Iron County Airport"},
+	{"IWJ", "JP", 34.67639923, 131.7899933, "Iwami Airport"},
+	{"IWO", "JP", 24.7840004, 141.322998, "Iwo Jima Airport"},
+	{"IWS", "US", 29.81819916, -95.67259979, "West Houston Airport"},
+	{"IXA", "IN", 23.88699913, 91.24040222, "Agartala Airport"},
+	{"IXB", "IN", 26.68120003, 88.32859802, "Bagdogra Airport"},
+	{"IXC", "IN", 30.67350006, 76.78849792, "Chandigarh Airport"},
+	{"IXD", "IN", 25.44009972, 81.73390198, "Allahabad Airport"},
+	{"IXE", "IN", 12.9612999, 74.89009857, "Mangalore International Airport"},
+	{"IXG", "IN", 15.85929966, 74.61830139, "Belgaum Airport"},
+	{"IXH", "IN", 24.30820084, 92.00720215, "Kailashahar Airport"},
+	{"IXI", "IN", 27.2954998, 94.09760284, "North Lakhimpur Airport"},
+	{"IXJ", "IN", 32.68909836, 74.83740234, "Jammu Airport"},
+	{"IXK", "IN", 21.31710052, 70.270401, "Keshod Airport"},
+	{"IXL", "IN", 34.13589859, 77.54650116, "Leh Kushok Bakula Rimpochee Airport"},
+	{"IXM", "IN", 9.83450985, 78.09339905, "Madurai Airport"},
+	{"IXN", "IN", 24.06189919, 91.60389709, "Khowai Airport"},
+	{"IXP", "IN", 32.23379898, 75.63459778, "Pathankot Air Force Station"},
+	{"IXQ", "IN", 24.13170052, 91.81420135, "Kamalpur Airport"},
+	{"IXR", "IN", 23.31430054, 85.32170105, "Birsa Munda Airport"},
+	{"IXS", "IN", 24.91290092, 92.97869873, "Silchar Airport"},
+	{"IXT", "IN", 28.06609917, 95.33560181, "Pasighat Airport"},
+	{"IXU", "IN", 19.86269951, 75.39810181, "Aurangabad Airport"},
+	{"IXV", "IN", 28.1753006, 94.80200195, "Along Airport"},
+	{"IXW", "IN", 22.8132, 86.16880035, "Jamshedpur Airport"},
+	{"IXY", "IN", 23.11269951, 70.10030365, "Kandla Airport"},
+	{"IXZ", "IN", 11.64120007, 92.72969818, "Vir Savarkar International Airport"},
+	{"IYK", "US", 35.65879822, -117.8300018, "Inyokern Airport"},
+	{"IZA", "BR", -21.5130558, -43.17305756, "Zona da Mata Regional Airport"},
+	{"IZO", "JP", 35.41360092, 132.8899994, "Izumo Airport"},
+	{"IZT", "MX", 16.44930077, -95.09369659, "Ixtepec Airport"},
+	{"JAA", "AF", 34.39979935, 70.49859619, "Jalalabad Airport"},
+	{"JAB", "AU", -12.6583004, 132.8930054, "Jabiru Airport"},
+	{"JAC", "US", 43.6072998, -110.737999, "Jackson Hole Airport"},
+	{"JAD", "AU", -32.09749985, 115.8809967, "Perth Jandakot Airport"},
+	{"JAF", "LK", 9.792329788, 80.07009888, "Kankesanturai Airport"},
+	{"JAG", "PK", 28.28420067, 68.4496994, "Shahbaz Air Base"},
+	{"JAI", "IN", 26.82419968, 75.81220245, "Jaipur International Airport"},
+	{"JAK", "HT", 18.24110031, -72.51850128, "Jacmel Airport"},
+	{"JAL", "MX", 19.47509956, -96.79750061, "El Lencero Airport"},
+	{"JAM", "BG", 42.45489883, 26.35219955, "Bezmer Air Base"},
+	{"JAN", "US", 32.31119919, -90.07589722, "Jackson-Medgar Wiley Evers International Airport"},
+	{"JAP", "CR", 9.981410027, -84.77269745, "Chacarita Airport"},
+	{"JAQ", "PG", -5.652500153, 151.5070038, "Jacquinot Bay Airport"},
+	{"JAR", "IR", 28.58670044, 53.57910156, "Jahrom Airport"},
+	{"JAS", "US", 30.88570023, -94.03489685, "Jasper County Airport-Bell Field"},
+	{"JAT", "MH", 7.749774756, 168.9778376, "Jabot Airport"},
+	{"JAU", "PE", -11.78310013, -75.4733963, "Francisco Carle Airport"},
+	{"JAV", "GL", 69.24320221, -51.05709839, "Ilulissat Airport"},
+	{"JAX", "US", 30.49410057, -81.68789673, "Jacksonville International Airport"},
+	{"JBQ", "DO", 18.57250023, -69.98560333, "La Isabela International Airport"},
+	{"JBR", "US", 35.83169937, -90.64640045, "Jonesboro Municipal Airport"},
+	{"JCB", "BR", -27.17140007, -51.55329895, "Santa Terezinha Airport"},
+	{"JCI", "US", 38.83089828, -94.89029694, "New Century Aircenter Airport"},
+	{"JCK", "AU", -20.66830063, 141.7230072, "Julia Creek Airport"},
+	{"JCM", "BR", -11.16320038, -40.55310059, "Jacobina Airport"},
+	{"JCR", "BR", -6.233160019, -57.77690125, "Jacareacanga Airport"},
+	{"JCT", "US", 30.51129913, -99.7634964, "Kimble County Airport"},
+	{"JCY", "US", 30.25180054, -98.62249756, "LBJ Ranch Airport"},
+	{"JDA", "US", 44.40420151, -118.9629974, "Grant Co Regional/Ogilvie Field"},
+	{"JDF", "BR", -21.79150009, -43.38679886, "Francisco de Assis Airport"},
+	{"JDG", "KR", 33.39960098, 126.711998, "Jeongseok Airport"},
+	{"JDH", "IN", 26.25110054, 73.04889679, "Jodhpur Airport"},
+	{"JDN", "US", 47.3288002, -106.9530029, "Jordan Airport"},
+	{"JDO", "BR", -7.218959808, -39.27009964, "Orlando Bezerra de Menezes Airport"},
+	{"JDR", "BR", -21.08499908, -44.22472382, "Prefeito Octávio de Almeida Neves Airport"},
+	{"JDZ", "CN", 29.33860016, 117.1760025, "Jingdezhen Airport"},
+	{"JED", "SA", 21.67959976, 39.15650177, "King Abdulaziz International Airport"},
+	{"JEE", "HT", 18.66309929, -74.17030334, "Jérémie Airport"},
+	{"JEF", "US", 38.59120178, -92.15609741, "Jefferson City Memorial Airport"},
+	{"JEG", "GL", 68.72180176, -52.78469849, "Aasiaat Airport"},
+	{"JEJ", "MH", 7.565350056, 168.9620056, "Jeh Airport"},
+	{"JEQ", "BR", -13.87769985, -40.07160187, "Jequié Airport"},
+	{"JER", "JE", 49.207901, -2.195509911, "Jersey Airport"},
+	{"JFK", "US", 40.63980103, -73.77890015, "John F Kennedy International Airport"},
+	{"JFN", "US", 41.77799988, -80.69550323, "Northeast Ohio Regional Airport"},
+	{"JFR", "GL", 62.01473618, -49.67093658, "Paamiut Airport"},
+	{"JGA", "IN", 22.46549988, 70.01260376, "Jamnagar Airport"},
+	{"JGB", "IN", 19.07430077, 82.03679657, "Jagdalpur Airport"},
+	{"JGD", "CN", 50.375, 124.1166667, "Jiagedaqi Airport"},
+	{"JGN", "CN", 39.85689926, 98.34140015, "Jiayuguan Airport"},
+	{"JGS", "CN", 26.85689926, 114.7369995, "Jinggangshan Airport"},
+	{"JHB", "MY", 1.641309977, 103.6699982, "Senai International Airport"},
+	{"JHG", "CN", 21.97389984, 100.7600021, "Xishuangbanna Gasa Airport"},
+	{"JHL", "CA", 57.22389984, -111.4189987, "Fort MacKay/Albian Aerodrome"},
+	{"JHM", "US", 20.96290016, -156.6730042, "Kapalua Airport"},
+	{"JHQ", "AU", -20.277221, 148.755556, "Shute Harbour Airport"},
+	{"JHS", "GL", 66.95130157, -53.72930145, "Sisimiut Airport"},
+	{"JHW", "US", 42.15340042, -79.25800323, "Chautauqua County-Jamestown Airport"},
+	{"JIA", "BR", -11.41944408, -58.70166779, "Juína Airport"},
+	{"JIB", "DJ", 11.54730034, 43.15950012, "Djibouti-Ambouli Airport"},
+	{"JIC", "CN", 38.54222222, 102.3483333, "Jinchuan Airport"},
+	{"JIJ", "ET", 9.3325, 42.9121, "Wilwal International Airport"},
+	{"JIK", "GR", 37.68270111, 26.3470993, "Ikaria Airport"},
+	{"JIL", "CN", 44.00220108, 126.3960037, "Jilin Airport"},
+	{"JIM", "ET", 7.666090012, 36.8166008, "Jimma Airport"},
+	{"JIN", "UG", 0.449999988, 33.20000076, "Jinja Airport"},
+	{"JIP", "EC", -1.0, -80.66666412, "Jipijapa Airport"},
+	{"JIQ", "CN", 29.51333333, 108.8311111, "Qianjiang Wulingshan Airport"},
+	{"JIR", "NP", 27.61700058, 86.21700287, "Jiri Airport"},
+	{"JIU", "CN", 29.476944, 115.801111, "Jiujiang Lushan Airport"},
+	{"JIW", "PK", 25.06780052, 61.80540085, "Jiwani Airport"},
+	{"JJI", "PE", -7.169099808, -76.72859955, "Juanjui Airport"},
+	{"JJM", "KE", 0.165083006, 38.19514084, "Mulika Lodge Airport"},
+	{"JJN", "CN", 24.79640007, 118.5899963, "Quanzhou Airport"},
+	{"JKG", "SE", 57.75759888, 14.06869984, "Jönköping Airport"},
+	{"JKH", "GR", 38.34320068, 26.1406002, "Chios Island National Airport"},
+	{"JKL", "GR", 36.9632988, 26.94059944, "Kalymnos Airport"},
+	{"JKR", "NP", 26.70879936, 85.92240143, "Janakpur Airport"},
+	{"JKV", "US", 31.86930084, -95.2173996, "Cherokee County Airport"},
+	{"JLA", "US", 60.48270035, -149.7189941, "Quartz Creek Airport"},
+	{"JLN", "US", 37.15179825, -94.49829865, "Joplin Regional Airport"},
+	{"JLR", "IN", 23.17779922, 80.05200195, "Jabalpur Airport"},
+	{"JLS", "BR", -20.29299927, -50.54639816, "Jales Airport"},
+	{"JMB", "AO", -14.6981926, 16.07014847, "Jamba Airport"},
+	{"JMK", "GR", 37.43510056, 25.34810066, "Mikonos Airport"},
+	{"JMO", "NP", 28.780426, 83.723, "Jomsom Airport"},
+	{"JMS", "US", 46.92969894, -98.67819977, "Jamestown Regional Airport"},
+	{"JMU", "CN", 46.84339905, 130.4649963, "Jiamusi Airport"},
+	{"JNA", "BR", -15.47379971, -44.38550186, "Januária Airport"},
+	{"JNB", "ZA", -26.13920021, 28.24600029, "OR Tambo International Airport"},
+	{"JNG", "CN", 35.29277778, 116.3466667, "Jining Qufu Airport"},
+	{"JNI", "AR", -34.5459, -60.9306, "Junin Airport"},
+	{"JNJ", "OM", 19.50222222, 57.63444444, "Duqm Jaaluni Airport"},
+	{"JNU", "US", 58.35499954, -134.576004, "Juneau International Airport"},
+	{"JNX", "GR", 37.08110046, 25.36809921, "Naxos Airport"},
+	{"JNZ", "CN", 41.10139847, 121.0619965, "Jinzhou Airport"},
+	{"JOE", "FI", 62.66289902, 29.60750008, "Joensuu Airport"},
+	{"JOG", "ID", -7.788179874, 110.4319992, "Adi Sutjipto International Airport"},
+	{"JOH", "ZA", -31.60589981, 29.51980019, "Port St Johns Airport"},
+	{"JOI", "BR", -26.22450066, -48.79740143, "Lauro Carneiro de Loyola Airport"},
+	{"JOJ", "CA", 68.12527466, -106.5852814, "Doris Lake"},
+	{"JOK", "RU", 56.70059967, 47.90470123, "Yoshkar-Ola Airport"},
+	{"JOL", "PH", 6.05366993, 121.0110016, "Jolo Airport"},
+	{"JOM", "TZ", -9.350000381, 34.79999924, "Njombe Airport"},
+	{"JOP", "PG", -4.747083333, 145.0070833, "Josephstaal Airport"},
+	{"JOS", "NG", 9.639829636, 8.869050026, "Yakubu Gowon Airport"},
+	{"JOT", "US", 41.51779938, -88.17549896, "Joliet Regional Airport"},
+	{"JPA", "BR", -7.145833015, -34.94861221, "Presidente Castro Pinto International Airport"},
+	{"JPR", "BR", -10.87080002, -61.8465004, "Ji-Paraná Airport"},
+	{"JQA", "GL", 70.73419952, -52.69620132, "Qaarsut Airport"},
+	{"JQE", "PA", 7.517779827, -78.15720367, "Jaqué Airport"},
+	{"JRF", "US", 21.30739975, -158.0700073, "Kalaeloa Airport"},
+	{"JRH", "IN", 26.73150063, 94.17549896, "Jorhat Airport"},
+	{"JRN", "BR", -10.30583286, -58.48944473, "Juruena Airport"},
+	{"JRO", "TZ", -3.429409981, 37.07450104, "Kilimanjaro International Airport"},
+	{"JSA", "IN", 26.88870049, 70.86499786, "Jaisalmer Airport"},
+	{"JSH", "GR", 35.21609879, 26.10129929, "Sitia Airport"},
+	{"JSI", "GR", 39.17710114, 23.50370026, "Skiathos Island National Airport"},
+	{"JSM", "AR", -44.04859924, -70.45890045, "Jose De San Martin Airport"},
+	{"JSR", "BD", 23.18379974, 89.16079712, "Jessore Airport"},
+	{"JSS", "GR", 37.34889984, 23.25399971, "Spetsai Airport"},
+	{"JST", "US", 40.31610107, -78.83390045, "John Murtha Johnstown Cambria County Airport"},
+	{"JSU", "GL", 65.41249847, -52.93939972, "Maniitsoq Airport"},
+	{"JSY", "GR", 37.42279816, 24.95089912, "Syros Airport"},
+	{"JTC", "BR", -22.16685914, -49.05028667, "Bauru - Arealva Airport"},
+	{"JTI", "BR", -17.82990074, -51.77299881, "Jataí Airport"},
+	{"JTR", "GR", 36.39920044, 25.47929955, "Santorini Airport"},
+	{"JTY", "GR", 36.57989883, 26.37579918, "Astypalaia Airport"},
+	{"JUA", "BR", -11.2966, -57.5495, "Inácio Luís do Nascimento Airport"},
+	{"JUB", "SS", 4.872010231, 31.60110092, "Juba International Airport"},
+	{"JUH", "CN", 30.7403, 117.6856, "Jiuhuashan Airport"},
+	{"JUI", "DE", 53.68111038, 7.055832863, "Juist Airport"},
+	{"JUJ", "AR", -24.39279938, -65.09780121, "Gobernador Horacio Guzman International Airport"},
+	{"JUL", "PE", -15.46710014, -70.15820313, "Inca Manco Capac International Airport"},
+	{"JUM", "NP", 29.27420044, 82.19329834, "Jumla Airport"},
+	{"JUN", "AU", -24.8416996, 143.0579987, "Jundah Airport"},
+	{"JUR", "AU", -30.29999924, 115.0329971, "Jurien Bay Airport"},
+	{"JUT", "HN", 14.65260029, -86.22029877, "Jutigalpa airport"},
+	{"JUV", "GL", 72.79019928, -56.13059998, "Upernavik Airport"},
+	{"JUZ", "CN", 28.96579933, 118.8990021, "Quzhou Airport"},
+	{"JVA", "MG", -18.80500952, 45.2734673, "Ankavandra Airport"},
+	{"JVI", "US", 40.5243988, -74.5983963, "Central Jersey Regional Airport"},
+	{"JVL", "US", 42.62030029, -89.04160309, "Southern Wisconsin Regional Airport"},
+	{"JWA", "BW", -24.60230064, 24.69099998, "Jwaneng Airport"},
+	{"JWN", "IR", 36.77370071, 48.3594017, "Zanjan Airport"},
+	{"JXA", "CN", 45.293, 131.193, "Jixi Xingkaihu Airport"},
+	{"JXN", "US", 42.25979996, -84.45939636, "Jackson County Reynolds Field"},
+	{"JYR", "IR", 28.7269001, 57.67029953, "Jiroft Airport"},
+	{"JYV", "FI", 62.3995018, 25.67830086, "Jyvaskyla Airport"},
+	{"JZH", "CN", 32.85333333, 103.6822222, "Jiuzhai Huanglong Airport"},
+	{"KAA", "ZM", -10.2166996, 31.13330078, "Kasama Airport"},
+	{"KAB", "ZW", -16.51980019, 28.88500023, "Kariba International Airport"},
+	{"KAC", "SY", 37.02059937, 41.19139862, "Kamishly Airport"},
+	{"KAD", "NG", 10.6960001, 7.320109844, "Kaduna Airport"},
+	{"KAF", "PG", -6.2655, 155.3052, "Karato Airport"},
+	{"KAG", "KR", 37.75360107, 128.9440002, "Gangneung Airport"},
+	{"KAI", "GY", 5.172754765, -59.49148178, "Kaieteur International Airport"},
+	{"KAJ", "FI", 64.28549957, 27.69239998, "Kajaani Airport"},
+	{"KAL", "US", 64.31909943, -158.7409973, "Kaltag Airport"},
+	{"KAM", "YE", 15.36330032, 42.60499954, "Kamaran Airport"},
+	{"KAN", "NG", 12.04759979, 8.524620056, "Mallam Aminu International Airport"},
+	{"KAO", "FI", 65.98760223, 29.23940086, "Kuusamo Airport"},
+	{"KAP", "CD", -8.350000381, 22.58300018, "Kapanga Airport"},
+	{"KAQ", "PG", -8.150694444, 146.834, "Kamulai Airport"},
+	{"KAR", "GY", 5.865340233, -60.61420059, "Kamarang Airport"},
+	{"KAS", "NA", -28.0297, 18.7385, "Karasburg Airport"},
+	{"KAT", "NZ", -35.06999969, 173.2850037, "Kaitaia Airport"},
+	{"KAU", "FI", 63.1271019, 23.05139923, "Kauhava Airport"},
+	{"KAV", "VE", 5.632999897, -61.78300095, "Kavanayen Airport"},
+	{"KAW", "MM", 10.04930019, 98.53800201, "Kawthoung Airport"},
+	{"KAX", "AU", -27.69000053, 114.262001, "Kalbarri Airport"},
+	{"KAY", "FJ", -17.61700058, 179.0169983, "Wakaya Island Airport"},
+	{"KAZ", "ID", 1.185279965, 127.8960037, "Kao Airport"},
+	{"KBA", "SL", 9.638322913, -11.51556015, "Kabala Airport"},
+	{"KBB", "AU", -17.7791996, 129.2100067, "Kirkimbie Station Airport"},
+	{"KBC", "US", 66.27400208, -145.8240051, "Birch Creek Airport"},
+	{"KBD", "AU", -17.39780045, 124.3550034, "Kimberley Downs Airport"},
+	{"KBF", "ID", -3.684, 138.479, "Karubaga Airport"},
+	{"KBG", "UG", 2.326390028, 31.49780083, "Kabalega Falls Airport"},
+	{"KBH", "PK", 29.13333321, 66.51667023, "Kalat Airport"},
+	{"KBI", "CM", 2.873889923, 9.977780342, "Kribi Airport"},
+	{"KBJ", "AU", -24.26000023, 131.4900055, "Kings Canyon Airport"},
+	{"KBL", "AF", 34.5658989, 69.21230316, "Kabul International Airport"},
+	{"KBM", "PG", -6.155472222, 147.1914722, "Kabwum"},
+	{"KBN", "CD", -6.132999897, 24.4829998, "Tunta Airport"},
+	{"KBO", "CD", -6.083000183, 26.91699982, "Kabalo Airport"},
+	{"KBP", "UA", 50.34500122, 30.8946991, "Boryspil International Airport"},
+	{"KBQ", "MW", -13.0145998, 33.46860123, "Kasungu Airport"},
+	{"KBR", "MY", 6.16685009, 102.2929993, "Sultan Ismail Petra Airport"},
+	{"KBS", "SL", 7.944399834, -11.76099968, "Bo Airport"},
+	{"KBT", "MH", 8.900560379, 170.8439941, "Kaben Airport"},
+	{"KBU", "ID", -3.294719934, 116.1650009, "Stagen Airport"},
+	{"KBV", "TH", 8.09912014, 98.98619843, "Krabi Airport"},
+	{"KBX", "ID", -1.3169, 132.2857, "Kambuaya Airport"},
+	{"KBY", "AU", -32.83580017, 134.2929993, "Streaky Bay Airport"},
+	{"KBZ", "NZ", -42.42499924, 173.6049957, "Kaikoura Airport"},
+	{"KCA", "CN", 41.7181015, 82.98690033, "Kuqa Airport"},
+	{"KCB", "SR", 3.150000095, -55.71699905, "Tepoe Airstrip"},
+	{"KCD", "ID", -6.1851, 138.6372, "Kamur Airport"},
+	{"KCE", "AU", -20.59670067, 147.8600006, "Collinsville Airport"},
+	{"KCF", "PK", 27.16670036, 69.3167038, "Kadanwari Airport"},
+	{"KCH", "MY", 1.484699965, 110.3470001, "Kuching International Airport"},
+	{"KCI", "TL", -8.350000381, 127.0500031, "Kon Airport"},
+	{"KCJ", "PG", -7.2714, 143.5952, "Komaio Airport"},
+	{"KCK", "RU", 57.773, 108.064, "Kirensk Airport"},
+	{"KCL", "US", 56.31119919, -158.5359955, "Chignik Lagoon Airport"},
+	{"KCM", "TR", 37.53882599, 36.95352173, "Kahramanmaraş Airport"},
+	{"KCO", "TR", 40.73500061, 30.08329964, "Cengiz Topel Airport"},
+	{"KCQ", "US", 56.25500107, -158.7749939, "Chignik Lake Airport"},
+	{"KCR", "US", 63.56769943, -155.9889984, "Colorado Creek Airport"},
+	{"KCS", "AU", -24.42329979, 131.8350067, "Kings Creek Airport"},
+	{"KCT", "LK", 5.99368, 80.32029724, "Koggala Airport"},
+	{"KCU", "UG", 1.758059978, 31.73670006, "Masindi Airport"},
+	{"KCZ", "JP", 33.54610062, 133.6690063, "Kōchi Ryōma Airport"},
+	{"KDA", "SN", 12.89850044, -14.96809959, "Kolda North Airport"},
+	{"KDB", "AU", -31.1907, 121.5978, "Kambalda Airport"},
+	{"KDC", "BJ", 11.13300037, 2.933000088, "Kandi Airport"},
+	{"KDD", "PK", 27.79059982, 66.64730072, "Khuzdar Airport"},
+	{"KDE", "PG", -5.6952, 142.7441, "Koroba Airport"},
+	{"KDH", "AF", 31.50580025, 65.84780121, "Kandahar Airport"},
+	{"KDI", "ID", -4.081610203, 122.4179993, "Wolter Monginsidi Airport"},
+	{"KDJ", "GA", -0.182999998, 10.75, "Ville Airport"},
+	{"KDK", "US", 57.80590057, -152.3739929, "Kodiak Municipal Airport"},
+	{"KDL", "EE", 58.99079895, 22.83069992, "Kärdla Airport"},
+	{"KDM", "MV", 0.488130987, 72.99690247, "Kaadedhdhoo Airport"},
+	{"KDN", "GA", -2.400000095, 11.36699963, "Ndende Airport"},
+	{"KDO", "MV", 1.85916996, 73.52189636, "Kadhdhoo Airport"},
+	{"KDP", "PG", -5.840611111, 143.5072222, "Kandep Airport"},
+	{"KDQ", "PG", -3.600556, 141.051667, "Kamberatoro Airport"},
+	{"KDR", "PG", -6.19217, 149.54783, "Kandrian Airport"},
+	{"KDS", "AU", -24.3388, 139.2785, "Kamaran Downs Airport"},
+	{"KDT", "TH", 14.10200024, 99.91719818, "Kamphaeng Saen Airport"},
+	{"KDU", "PK", 35.33549881, 75.53600311, "Skardu Airport"},
+	{"KDV", "FJ", -19.05809975, 178.1569977, "Vunisea Airport"},
+	{"KDX", "SD", 11.13799953, 29.7010994, "Kadugli Airport"},
+	{"KDY", "RU", 62.78900146, 136.8549957, "Typliy Klyuch Airport"},
+	{"KEA", "ID", -7.666669846, 140.5, "Keisah Airport"},
+	{"KEB", "US", 59.35210037, -151.9250031, "Nanwalek Airport"},
+	{"KEC", "CD", -10.35000038, 28.63299942, "Kasenga Airport"},
+	{"KED", "MR", 16.15950012, -13.50759983, "Kaédi Airport"},
+	{"KEE", "CG", -0.082999997, 14.53299999, "Kelle Airport"},
+	{"KEF", "IS", 63.98500061, -22.60560036, "Keflavik International Airport"},
+	{"KEG", "PG", -5.832777778, 145.0972222, "Keglsugl Airport"},
+	{"KEI", "ID", -6.5418, 139.3318, "Kepi Airport"},
+	{"KEJ", "RU", 55.27009964, 86.10720062, "Kemerovo Airport"},
+	{"KEK", "US", 59.35680008, -157.470993, "Ekwok Airport"},
+	{"KEL", "DE", 54.37944412, 10.14527798, "Kiel-Holtenau Airport"},
+	{"KEM", "FI", 65.77870178, 24.58209991, "Kemi-Tornio Airport"},
+	{"KEN", "SL", 7.891290188, -11.17660046, "Kenema Airport"},
+	{"KEO", "CI", 9.5, -7.566999912, "Odienne Airport"},
+	{"KEP", "NP", 28.10359955, 81.66699982, "Nepalgunj Airport"},
+	{"KEQ", "ID", -0.637100995, 133.128006, "Kebar Airport"},
+	{"KER", "IR", 30.27440071, 56.9510994, "Kerman Airport"},
+	{"KES", "CA", 56.03749847, -96.50969696, "Kelsey Airport"},
+	{"KET", "MM", 21.3015995, 99.63600159, "Kengtung Airport"},
+	{"KEV", "FI", 61.85599899, 24.78660011, "Halli Airport"},
+	{"KEW", "CA", 52.99110031, -92.83640289, "Keewaywin Airport"},
+	{"KEX", "PG", -7.538888889, 145.905, "Kanabea Airport"},
+	{"KEY", "KE", -0.416999996, 35.25, "Kericho Airport"},
+	{"KFA", "MR", 16.59000015, -11.40620041, "Kiffa Airport"},
+	{"KFE", "AU", -22.28545556, 119.4291611, "Fortescue - Dave Forrest Aerodrome"},
+	{"KFG", "AU", -17.43190002, 130.8079987, "Kalkgurung Airport"},
+	{"KFP", "US", 54.84740067, -163.4100037, "False Pass Airport"},
+	{"KFS", "TR", 41.31420135, 33.79579926, "Kastamonu Airport"},
+	{"KGA", "CD", -5.900050163, 22.46920013, "Kananga Airport"},
+	{"KGB", "PG", -6.2239, 147.2152, "Konge Airport"},
+	{"KGC", "AU", -35.71390152, 137.5209961, "Kingscote Airport"},
+	{"KGD", "RU", 54.88999939, 20.59259987, "Khrabrovo Airport"},
+	{"KGE", "SB", -7.3305, 157.585, "Kaghau Airport"},
+	{"KGF", "KZ", 49.67079926, 73.33439636, "Sary-Arka Airport"},
+	{"KGG", "SN", 12.57229996, -12.22029972, "Kédougou Airport"},
+	{"KGH", "PG", -8.5289, 147.4603, "Yongai Airport"},
+	{"KGI", "AU", -30.7894001, 121.461998, "Kalgoorlie Boulder Airport"},
+	{"KGJ", "MW", -9.953570366, 33.89300156, "Karonga Airport"},
+	{"KGK", "US", 59.72660065, -157.2590027, "Koliganek Airport"},
+	{"KGL", "RW", -1.968629956, 30.13949966, "Kigali International Airport"},
+	{"KGM", "PG", -5.671, 141.03, "Kungim Airport"},
+	{"KGO", "UA", 48.5428009, 32.28499985, "Kirovograd Airport"},
+	{"KGP", "RU", 62.19039917, 74.53379822, "Kogalym International Airport"},
+	{"KGR", "AU", -25.84280014, 133.2920074, "Kulgera Airport"},
+	{"KGS", "GR", 36.79330063, 27.0916996, "Kos Airport"},
+	{"KGU", "MY", 5.357490063, 116.1620026, "Keningau Airport"},
+	{"KGW", "PG", -9.135916667, 147.6694444, "Kagi Airport"},
+	{"KGX", "US", 62.89459991, -160.0650024, "Grayling Airport"},
+	{"KGY", "AU", -26.5807991, 151.8410034, "Kingaroy Airport"},
+	{"KGZ", "US", 61.45510101, -142.3809967, "Glacier Creek Airport"},
+	{"KHA", "IR", 36.73334122, 45.15000153, "Khaneh Airport"},
+	{"KHC", "UA", 45.37250137, 36.40140152, "Kerch Airport"},
+	{"KHD", "IR", 33.4353981, 48.28290176, "Khoram Abad Airport"},
+	{"KHE", "UA", 46.67580032, 32.50640106, "Chernobayevka Airport"},
+	{"KHG", "CN", 39.54290009, 76.01999664, "Kashgar Airport"},
+	{"KHH", "TW", 22.57710075, 120.3499985, "Kaohsiung International Airport"},
+	{"KHI", "PK", 24.90649986, 67.16079712, "Jinnah International Airport"},
+	{"KHJ", "FI", 62.46250153, 22.39310074, "Kauhajoki Airport"},
+	{"KHK", "IR", 29.26029968, 50.32389832, "Khark Island Airport"},
+	{"KHM", "MM", 25.98830032, 95.67440033, "Kanti Airport"},
+	{"KHN", "CN", 28.86499977, 115.9000015, "Nanchang Changbei International Airport"},
+	{"KHO", "ZA", -24.59300041, 31.4151001, "Khoka Moya Airport"},
+	{"KHR", "MN", 47.246667, 102.826111, "Kharkhorin Airport"},
+	{"KHS", "OM", 26.17099953, 56.24060059, "Khasab Air Base"},
+	{"KHT", "AF", 33.33340073, 69.95200348, "Khost Airport"},
+	{"KHU", "UA", 49.1342, 33.4766, "Kakhnovka Airfield"},
+	{"KHV", "RU", 48.52799988, 135.1880035, "Khabarovsk-Novy Airport"},
+	{"KHW", "BW", -19.14999962, 23.78300095, "Khwai River Lodge Airport"},
+	{"KHY", "IR", 38.42750168, 44.97359848, "Khoy Airport"},
+	{"KHZ", "PF", -15.78079987, -145.1239929, "Kauehi Airport"},
+	{"KIC", "US", 36.22800064, -121.1220016, "Mesa Del Rey Airport"},
+	{"KID", "SE", 55.92169952, 14.08549976, "Kristianstad Airport"},
+	{"KIF", "CA", 53.01250076, -89.8553009, "Kingfisher Lake Airport"},
+	{"KIG", "ZA", -30.18779945, 17.2791996, "Koingnaas Airport"},
+	{"KIH", "IR", 26.52619934, 53.98020172, "Kish International Airport"},
+	{"KII", "PG", -9.006528, 142.698056, "Kibuli Airstrip"},
+	{"KIJ", "JP", 37.95589828, 139.1210022, "Niigata Airport"},
+	{"KIK", "IQ", 35.4695015, 44.34889984, "Kirkuk Air Base"},
+	{"KIL", "CD", -9.2886, 28.3269, "Kilwa Airport"},
+	{"KIM", "ZA", -28.80279922, 24.76519966, "Kimberley Airport"},
+	{"KIN", "JM", 17.93569946, -76.78749847, "Norman Manley International Airport"},
+	{"KIO", "MH", 5.644515038, 169.1195068, "Kili Airport"},
+	{"KIP", "US", 33.85779953, -98.49040222, "Kickapoo Downtown Airport"},
+	{"KIQ", "PG", -8.065111111, 147.3320278, "Kira Airport"},
+	{"KIR", "IE", 52.18090057, -9.523779869, "Kerry Airport"},
+	{"KIS", "KE", -0.086139001, 34.72890091, "Kisumu Airport"},
+	{"KIT", "GR", 36.27429962, 23.0170002, "Kithira Airport"},
+	{"KIU", "KE", -1.743829012, 41.48434448, "Kiunga Airport"},
+	{"KIV", "MD", 46.92770004, 28.93099976, "Chişinău International Airport"},
+	{"KIW", "ZM", -12.9005003, 28.14990044, "Southdowns Airport"},
+	{"KIX", "JP", 34.4272995, 135.2440033, "Kansai International Airport"},
+	{"KIY", "TZ", -8.932999611, 39.51699829, "Kilwa Masoko Airport"},
+	{"KJA", "RU", 56.17290115, 92.49330139, "Yemelyanovo Airport"},
+	{"KJH", "CN", 26.972, 107.988, "Kaili Airport"},
+	{"KJI", "CN", 48.2223, 86.9959, "Kanas Airport"},
+	{"KJK", "BE", 50.81719971, 3.20472002, "Wevelgem Airport"},
+	{"KJP", "JP", 26.16830063, 127.2929993, "Kerama Airport"},
+	{"KKA", "US", 64.9394989, -161.154007, "Koyuk Alfred Adams Airport"},
+	{"KKC", "TH", 16.46660042, 102.7839966, "Khon Kaen Airport"},
+	{"KKD", "PG", -8.884678841, 147.730957, "Kokoda Airport"},
+	{"KKE", "NZ", -35.26279831, 173.9120026, "Kerikeri Airport"},
+	{"KKG", "GY", 5.2684, -58.995, "Konawaruk Airport"},
+	{"KKH", "US", 59.96080017, -162.8809967, "Kongiganak Airport"},
+	{"KKJ", "JP", 33.84590149, 131.0350037, "Kitakyūshū Airport"},
+	{"KKK", "US", 64.4166257, -156.8203926, "Kalakaket Creek AS Airport"},
+	{"KKM", "TH", 14.94939995, 100.6429977, "Sa Pran Nak Airport"},
+	{"KKN", "NO", 69.72579956, 29.8913002, "Kirkenes Airport, Høybuktmoen"},
+	{"KKO", "NZ", -35.4510994, 173.8170013, "Kaikohe Airport"},
+	{"KKP", "AU", -15.31890011, 143.9550018, "Koolburra Airport"},
+	{"KKQ", "RU", 65.717, 82.455, "Krasnoselkup Airport"},
+	{"KKR", "PF", -15.66329956, -146.8849945, "Kaukura Airport"},
+	{"KKT", "US", 40.75870132, -87.42819977, "Kentland Municipal Airport"},
+	{"KKU", "US", 58.81119919, -158.5590057, "Ekuk Airport"},
+	{"KKW", "CD", -5.035769939, 18.78560066, "Kikwit Airport"},
+	{"KKX", "JP", 28.32130051, 129.9279938, "Kikai Airport"},
+	{"KKY", "IE", 52.6507988, -7.296110153, "Kilkenny Airport"},
+	{"KKZ", "KH", 11.61339712, 102.9970837, "Kaoh Kong Airport"},
+	{"KLB", "ZM", -14.9982996, 22.64539909, "Kalabo Airport"},
+	{"KLC", "SN", 14.14690018, -16.05130005, "Kaolack Airport"},
+	{"KLD", "RU", 56.8246994, 35.75770187, "Migalovo Air Base"},
+	{"KLE", "CM", 10.09249973, 14.44559956, "Kaélé Airport"},
+	{"KLF", "RU", 54.54999924, 36.3666687, "Grabtsevo Airport"},
+	{"KLG", "US", 61.53630066, -160.3410034, "Kalskag Airport"},
+	{"KLH", "IN", 16.66469955, 74.28939819, "Kolhapur Airport"},
+	{"KLI", "CD", 4.15763998, 21.65089989, "Kotakoli Airport"},
+	{"KLJ", "LT", 55.7118988, 21.24279976, "Klaipėda Airport"},
+	{"KLK", "KE", 3.48300004, 35.83300018, "Kalokol Airport"},
+	{"KLL", "US", 59.12810135, -156.8589935, "Levelock Airport"},
+	{"KLM", "IR", 37.38330078, 55.45199966, "Kalaleh Airport"},
+	{"KLN", "US", 57.53509903, -153.9779968, "Larsen Bay Airport"},
+	{"KLO", "PH", 11.67940044, 122.3759995, "Kalibo International Airport"},
+	{"KLQ", "ID", -2.623529911, 103.9550018, "Keluang Airport"},
+	{"KLR", "SE", 56.6855011, 16.28759956, "Kalmar Airport"},
+	{"KLS", "US", 46.11800003, -122.8980026, "Southwest Washington Regional Airport"},
+	{"KLU", "AT", 46.64250183, 14.33769989, "Klagenfurt Airport"},
+	{"KLV", "CZ", 50.20299911, 12.91499996, "Karlovy Vary International Airport"},
+	{"KLW", "US", 55.57920074, -133.076004, "Klawock Airport"},
+	{"KLX", "GR", 37.06829834, 22.02549934, "Kalamata Airport"},
+	{"KLY", "CD", -2.578000069, 26.73399925, "Kinkungwa Airport"},
+	{"KLZ", "ZA", -29.68840027, 17.09399986, "Kleinsee Airport"},
+	{"KMA", "PG", -7.963610172, 145.7709961, "Kerema Airport"},
+	{"KMB", "PG", -5.4875, 144.6069444, "Koinambe Airport"},
+	{"KMC", "SA", 27.90089989, 45.52819824, "Hafar Al-Batin Domestic Airport"},
+	{"KME", "RW", -2.462239981, 28.90789986, "Kamembe Airport"},
+	{"KMF", "PG", -7.64925, 145.9569444, "Kamina Airport"},
+	{"KMG", "CN", 24.99239922, 102.7440033, "Kunming Wujiaba International Airport"},
+	{"KMH", "ZA", -27.45669937, 23.41139984, "Johan Pienaar Airport"},
+	{"KMI", "JP", 31.87719917, 131.4490051, "Miyazaki Airport"},
+	{"KMJ", "JP", 32.83729935, 130.8549957, "Kumamoto Airport"},
+	{"KMK", "CG", -3.48300004, 12.61699963, "Makabana Airport"},
+	{"KML", "AU", -19.375, 140.0570068, "Kamileroi Airport"},
+	{"KMM", "ID", -3.666670084, 136.1666718, "Kiman Airport"},
+	{"KMN", "CD", -8.642020226, 25.25289917, "Kamina Base Airport"},
+	{"KMO", "US", 58.99020004, -159.0500031, "Manokotak Airport"},
+	{"KMP", "NA", -26.53980064, 18.1114006, "Keetmanshoop Airport"},
+	{"KMQ", "JP", 36.39459991, 136.4069977, "Komatsu Airport"},
+	{"KMR", "PG", -6.4921, 144.823, "Karimui Airport"},
+	{"KMS", "GH", 6.714560032, -1.590819955, "Kumasi Airport"},
+	{"KMU", "SO", -0.377353013, 42.45920181, "Kisimayu Airport"},
+	{"KMV", "MM", 23.18880081, 94.05110168, "Kalay Airport"},
+	{"KMW", "RU", 57.7969017, 41.01940155, "Kostroma Sokerkino Airport"},
+	{"KMX", "SA", 18.29730034, 42.80350113, "King Khaled Air Base"},
+	{"KMZ", "ZM", -14.80000019, 24.78300095, "Kaoma Airport"},
+	{"KNA", "CL", -32.94960022, -71.47859955, "Viña del mar Airport"},
+	{"KND", "CD", -2.919179916, 25.91539955, "Kindu Airport"},
+	{"KNE", "PG", -5.2903, 144.7072, "Kanainj Airport"},
+	{"KNF", "GB", 52.64839935, 0.550692022, "RAF Marham"},
+	{"KNG", "ID", -3.644520044, 133.6959991, "Kaimana Airport"},
+	{"KNH", "TW", 24.42790031, 118.3590012, "Kinmen Airport"},
+	{"KNI", "AU", -33.71670151, 117.6330032, "Katanning Airport"},
+	{"KNJ", "CG", -3.950000048, 14.5170002, "Kindamba Airport"},
+	{"KNK", "US", 59.43320084, -154.8040009, "Kokhanok Airport"},
+	{"KNM", "CD", -7.583000183, 24.14999962, "Kaniama Airport"},
+	{"KNN", "GN", 10.39400005, -9.303799629, "Kankan Airport"},
+	{"KNO", "ID", 3.642222, 98.885278, "Medan/Kuala Namu International Airport"},
+	{"KNP", "AO", -9.769370079, 15.4553194, "Capanda Airport"},
+	{"KNQ", "NC", -21.05430031, 164.8370056, "Koné Airport"},
+	{"KNR", "IR", 27.82049942, 52.35219955, "Jam Airport"},
+	{"KNS", "AU", -39.87749863, 143.878006, "King Island Airport"},
+	{"KNT", "US", 36.22589874, -90.03659821, "Kennett Memorial Airport"},
+	{"KNU", "IN", 26.44140053, 80.36489868, "Kanpur Airport"},
+	{"KNW", "US", 59.44990158, -157.3280029, "New Stuyahok Airport"},
+	{"KNX", "AU", -15.77810001, 128.7079926, "Kununurra Airport"},
+	{"KNZ", "ML", 12.83300018, -11.25, "Kenieba Airport"},
+	{"KOA", "US", 19.73880005, -156.0460052, "Kona International At Keahole Airport"},
+	{"KOC", "NC", -20.54630089, 164.2559967, "Koumac Airport"},
+	{"KOD", "ID", -0.266669989, 116.5833359, "Kotabangun Airport"},
+	{"KOE", "ID", -10.17160034, 123.6709976, "El Tari Airport"},
+	{"KOF", "ZA", -25.44029999, 31.93000031, "Komatipoort Airport"},
+	{"KOH", "AU", -15.88860035, 142.4389954, "Koolatah Airport"},
+	{"KOI", "GB", 58.95780182, -2.904999971, "Kirkwall Airport"},
+	{"KOJ", "JP", 31.80340004, 130.7189941, "Kagoshima Airport"},
+	{"KOK", "FI", 63.72119904, 23.14310074, "Kruunupyy Airport"},
+	{"KOM", "PG", -6.0682, 142.8598, "Komo-Manda Airport"},
+	{"KON", "VN", 14.35000038, 108.0169983, "Kontum Airport"},
+	{"KOO", "CD", -5.394440174, 26.98999977, "Kongolo Airport"},
+	{"KOP", "TH", 17.38380051, 104.6429977, "Nakhon Phanom Airport"},
+	{"KOQ", "DE", 51.7211113, 11.95277786, "Köthen Airport"},
+	{"KOR", "PG", -7.834666667, 146.5335, "Kakoro(Koroko) Airstrip"},
+	{"KOS", "KH", 10.57970047, 103.637001, "Sihanoukville International Airport"},
+	{"KOT", "US", 63.0306015, -163.5330048, "Kotlik Airport"},
+	{"KOU", "GA", -1.184610009, 12.44130039, "Koulamoutou Airport"},
+	{"KOV", "KZ", 53.32910156, 69.59459686, "Kokshetau Airport"},
+	{"KOW", "CN", 25.853333, 114.778889, "Ganzhou Airport"},
+	{"KOX", "ID", -4.71075, 136.43515, "Kokonau Airport"},
+	{"KOZ", "US", 57.92290115, -152.5010071, "Ouzinkie Airport"},
+	{"KPA", "PG", -5.3883, 142.4977, "Kopiago Airport"},
+	{"KPC", "US", 65.25370026, -166.8589935, "Port Clarence Coast Guard Station"},
+	{"KPE", "PG", -4.628055556, 141.0941667, "Yapsiei Airport"},
+	{"KPF", "PG", -8.5336, 142.5049, "Kondobol Airport"},
+	{"KPI", "MY", 2.010523745, 112.9314682, "Kapit Airport"},
+	{"KPL", "PG", -8.630184, 142.823756, "Kapal Airport"},
+	{"KPM", "PG", -5.381666667, 143.9247222, "Kompiam Airport"},
+	{"KPN", "US", 59.93299866, -164.0310059, "Kipnuk Airport"},
+	{"KPO", "KR", 35.98789978, 129.4199982, "Pohang Airport"},
+	{"KPP", "AU", -14.89, 144.22, "Kalpowar Airport"},
+	{"KPS", "AU", -31.07439995, 152.7700043, "Kempsey Airport"},
+	{"KPT", "US", 41.97600174, -114.6579971, "Jackpot Airport/Hayden Field"},
+	{"KPV", "US", 55.90599823, -159.1629944, "Perryville Airport"},
+	{"KQL", "PG", -5.731166667, 144.846, "Kol Airport"},
+	{"KQT", "TJ", 37.86640167, 68.86470032, "Qurghonteppa International Airport"},
+	{"KRA", "AU", -35.75139999, 143.9389954, "Kerang Airport"},
+	{"KRB", "AU", -17.45669937, 140.8300018, "Karumba Airport"},
+	{"KRC", "ID", -2.093, 101.4683, "Departi Parbo Airport"},
+	{"KRD", "AU", -20.51, 134.6706, "Kurundi Airport"},
+	{"KRE", "BI", -2.544772, 30.094575, "Kirundo Airport"},
+	{"KRF", "SE", 63.04859924, 17.76889992, "Kramfors Sollefteå Airport"},
+	{"KRG", "GY", 4.033329964, -59.53329849, "Karasabai Airport"},
+	{"KRH", "GB", 51.21360016, -0.138611004, "Redhill Aerodrome"},
+	{"KRI", "PG", -7.424379826, 144.2500763, "Kikori Airport"},
+	{"KRJ", "PG", -4.596666667, 143.5225, "Karawari Airstrip"},
+	{"KRK", "PL", 50.07770157, 19.78479958, "John Paul II International Airport Kraków-Balice Airport"},
+	{"KRL", "CN", 41.69779968, 86.12889862, "Korla Airport"},
+	{"KRM", "GY", 3.751940012, -59.30970001, "Karanambo Airport"},
+	{"KRN", "SE", 67.8219986, 20.33679962, "Kiruna Airport"},
+	{"KRO", "RU", 55.47529984, 65.41560364, "Kurgan Airport"},
+	{"KRP", "DK", 56.29750061, 9.124629974, "Karup Airport"},
+	{"KRQ", "UA", 48.70560074, 37.62889862, "Kramatorsk Airport"},
+	{"KRR", "RU", 45.03469849, 39.17050171, "Krasnodar International Airport"},
+	{"KRS", "NO", 58.20420074, 8.085370064, "Kristiansand Airport"},
+	{"KRT", "SD", 15.58950043, 32.55319977, "Khartoum International Airport"},
+	{"KRU", "PG", -8.271612, 147.071899, "Kerau Airport"},
+	{"KRV", "KE", 0.319638014, 35.66255951, "Kerio Valley Airport"},
+	{"KRW", "TM", 40.06330109, 53.00719833, "Turkmenbashi Airport"},
+	{"KRX", "PG", -4.557, 145.9404, "Kar Kar Airport"},
+	{"KRY", "CN", 45.46655, 84.9527, "Karamay Airport"},
+	{"KRZ", "CD", -1.434999943, 19.02400017, "Basango Mboliasa Airport"},
+	{"KSA", "FM", 5.356979847, 162.9579926, "Kosrae International Airport"},
+	{"KSB", "PG", -6.3819, 146.9859, "Kasonombe Airport"},
+	{"KSC", "SK", 48.6631012, 21.24110031, "Košice Airport"},
+	{"KSD", "SE", 59.44469833, 13.33740044, "Karlstad Airport"},
+	{"KSE", "UG", 0.182999998, 30.10000038, "Kasese Airport"},
+	{"KSF", "DE", 51.40833282, 9.37749958, "Kassel-Calden Airport"},
+	{"KSH", "IR", 34.34590149, 47.15810013, "Shahid Ashrafi Esfahani Airport"},
+	{"KSI", "GN", 9.160559654, -10.12440014, "Kissidougou Airport"},
+	{"KSJ", "GR", 35.42139816, 26.90999985, "Kasos Airport"},
+	{"KSK", "SE", 59.34590149, 14.49590015, "Karlskoga Airport"},
+	{"KSL", "SD", 15.38749981, 36.3288002, "Kassala Airport"},
+	{"KSM", "US", 62.0605011, -163.302002, "St Mary\"s Airport"},
+	{"KSN", "KZ", 53.20690155, 63.5503006, "Kostanay West Airport"},
+	{"KSO", "GR", 40.44630051, 21.28219986, "Kastoria National Airport"},
+	{"KSP", "PG", -8.450716, 147.209195, "Kosipe Airport"},
+	{"KSQ", "UZ", 38.83359909, 65.92150116, "Karshi Khanabad Airport"},
+	{"KSS", "ML", 11.33300018, -5.699999809, "Sikasso Airport"},
+	{"KST", "SD", 13.18299961, 32.73300171, "Kosti Airport"},
+	{"KSU", "NO", 63.11180115, 7.824520111, "Kristiansund Airport, Kvernberget"},
+	{"KSV", "AU", -23.54999924, 140.6999969, "Springvale Airport"},
+	{"KSW", "IL", 33.21670151, 35.59999847, "Kiryat Shmona Airport"},
+	{"KSX", "PG", -6.6015, 146.1813, "Yasuru Airport"},
+	{"KSY", "TR", 40.56219864, 43.11500168, "Kars Airport"},
+	{"KSZ", "RU", 61.2358017, 46.69749832, "Kotlas Airport"},
+	{"KTA", "AU", -20.71220016, 116.7730026, "Karratha Airport"},
+	{"KTC", "CI", 8.1329, -5.0657, "Katiola Airport"},
+	{"KTD", "JP", 25.94470024, 131.3269959, "Kitadaito Airport"},
+	{"KTE", "MY", 4.537220001, 103.427002, "Kerteh Airport"},
+	{"KTF", "NZ", -40.81330109, 172.7749939, "Takaka Airport"},
+	{"KTG", "ID", -1.816640019, 109.9629974, "Ketapang(Rahadi Usman) Airport"},
+	{"KTI", "KH", 12.48799992, 106.0550003, "Kratie Airport"},
+	{"KTL", "KE", 0.971988976, 34.95859909, "Kitale Airport"},
+	{"KTM", "NP", 27.69659996, 85.35910034, "Tribhuvan International Airport"},
+	{"KTN", "US", 55.35559845, -131.7140045, "Ketchikan International Airport"},
+	{"KTO", "GY", 4.649159908, -59.8321991, "Kato Airport"},
+	{"KTP", "JM", 17.98859978, -76.82379913, "Tinson Pen Airport"},
+	{"KTQ", "FI", 62.16609955, 30.07360077, "Kitee Airport"},
+	{"KTR", "AU", -14.52110004, 132.378006, "Tindal Airport"},
+	{"KTS", "US", 65.33129883, -166.4660034, "Brevig Mission Airport"},
+	{"KTT", "FI", 67.7009964, 24.84679985, "Kittila Airport"},
+	{"KTU", "IN", 25.16020012, 75.84559631, "Kota Airport"},
+	{"KTV", "VE", 5.75, -62.41600037, "Kamarata Airport"},
+	{"KTW", "PL", 50.47430038, 19.07999992, "Katowice International Airport"},
+	{"KTX", "ML", 12.38300037, -5.467000008, "Koutiala Airport"},
+	{"KTY", "LK", 6.552120209, 79.97750092, "Katukurunda Air Force Base"},
+	{"KUA", "MY", 3.77538991, 103.2089996, "Kuantan Airport"},
+	{"KUC", "KI", 0.218611002, 173.4420013, "Kuria Airport"},
+	{"KUD", "MY", 6.922500134, 116.8359985, "Kudat Airport"},
+	{"KUE", "SB", -8.0262, 156.94783, "Kukudu Airport"},
+	{"KUF", "RU", 53.50490189, 50.16429901, "Kurumoch International Airport"},
+	{"KUG", "AU", -10.22500038, 142.2180023, "Kubin Airport"},
+	{"KUH", "JP", 43.04100037, 144.1929932, "Kushiro Airport"},
+	{"KUK", "US", 60.87440109, -162.5240021, "Kasigluk Airport"},
+	{"KUL", "MY", 2.745579958, 101.7099991, "Kuala Lumpur International Airport"},
+	{"KUM", "JP", 30.38559914, 130.6589966, "Yakushima Airport"},
+	{"KUN", "LT", 54.96390152, 24.08480072, "Kaunas International Airport"},
+	{"KUO", "FI", 63.00709915, 27.79780006, "Kuopio Airport"},
+	{"KUP", "PG", -10.0736, 148.218, "Kupiano Airport"},
+	{"KUQ", "PG", -7.135083, 143.276111, "Kuri Airport"},
+	{"KUS", "GL", 65.57360077, -37.12360001, "Kulusuk Airport"},
+	{"KUT", "GE", 42.17670059, 42.48260117, "Kopitnari Airport"},
+	{"KUU", "IN", 31.87669945, 77.15440369, "Kullu Manali Airport"},
+	{"KUV", "KR", 35.90380096, 126.6159973, "Kunsan Air Base"},
+	{"KUX", "PG", -5.372361111, 141.6238889, "Kuyol Airport"},
+	{"KUY", "PG", -7.420347, 143.121811, "Kamusi Airport"},
+	{"KVA", "GR", 40.91329956, 24.61919975, "Alexander the Great International Airport"},
+	{"KVB", "SE", 58.45640182, 13.97270012, "Skövde Airport"},
+	{"KVC", "US", 55.11629868, -162.2660065, "King Cove Airport"},
+	{"KVD", "AZ", 40.73770142, 46.31760025, "Ganja Airport"},
+	{"KVE", "PG", -8.6285, 151.327, "Kitava Airport"},
+	{"KVG", "PG", -2.579400063, 150.8079987, "Kavieng Airport"},
+	{"KVK", "RU", 67.46330261, 33.5882988, "Kirovsk-Apatity Airport"},
+	{"KVL", "US", 67.73619843, -164.5630035, "Kivalina Airport"},
+	{"KVR", "RU", 44.2726, 135.029, "Kavalerovo Airport"},
+	{"KVX", "RU", 58.50329971, 49.34830093, "Pobedilovo Airport"},
+	{"KWA", "MH", 8.72012043, 167.7319946, "Bucholz Army Air Field"},
+	{"KWB", "ID", -5.80091, 110.47838, "Dewadaru Airport"},
+	{"KWE", "CN", 26.53849983, 106.8010025, "Longdongbao Airport"},
+	{"KWG", "UA", 48.04330063, 33.20999908, "Kryvyi Rih International Airport"},
+	{"KWH", "AF", 37.88299942, 70.21700287, "Khwahan Airport"},
+	{"KWI", "KW", 29.22660065, 47.96889877, "Kuwait International Airport"},
+	{"KWJ", "KR", 35.12639999, 126.8089981, "Gwangju Airport"},
+	{"KWL", "CN", 25.21809959, 110.0390015, "Guilin Liangjiang International Airport"},
+	{"KWM", "AU", -15.48560047, 141.7510071, "Kowanyama Airport"},
+	{"KWN", "US", 59.75510025, -161.8450012, "Quinhagak Airport"},
+	{"KWO", "PG", -7.977006912, 142.8235779, "Kawito Airport"},
+	{"KWS", "SB", -8.360508, 160.775127, "Kwailabesi Airport"},
+	{"KWT", "US", 60.79029846, -161.4440002, "Kwethluk Airport"},
+	{"KWY", "KE", -1.960559964, 41.29750061, "Kiwayu Airport"},
+	{"KWZ", "CD", -10.76589966, 25.50569916, "Kolwezi Airport"},
+	{"KXE", "ZA", -26.87109947, 26.71800041, "P C Pelser Airport"},
+	{"KXF", "FJ", -17.3458004, 179.4219971, "Koro Island Airport"},
+	{"KXK", "RU", 50.4090004, 136.9340057, "Komsomolsk-on-Amur Airport"},
+	{"KXU", "PF", -16.33939934, -144.4029999, "Katiu Airport"},
+	{"KYA", "TR", 37.97900009, 32.56190109, "Konya Airport"},
+	{"KYD", "TW", 22.02700043, 121.5350037, "Lanyu Airport"},
+	{"KYE", "LB", 34.58929825, 36.01129913, "Rene Mouawad Air Base"},
+	{"KYF", "AU", -27.27706004, 120.0956726, "Yeelirrie Airport"},
+	{"KYI", "AU", -31.47060013, 131.8249969, "Yalata Mission Airport"},
+	{"KYK", "US", 57.56710052, -154.4499969, "Karluk Airport"},
+	{"KYO", "US", 28.22130013, -82.37449646, "Tampa North Aero Park Airport"},
+	{"KYP", "MM", 19.42639923, 93.53479767, "Kyaukpyu Airport"},
+	{"KYS", "ML", 14.48120022, -11.40439987, "Kayes Dag Dag Airport"},
+	{"KYT", "MM", 21.39999962, 94.13330078, "Kyauktu Airport"},
+	{"KYU", "US", 64.87609863, -157.727005, "Koyukuk Airport"},
+	{"KYX", "PG", -6.09, 147.0117, "Yalumet Airport"},
+	{"KYZ", "RU", 51.66939926, 94.40059662, "Kyzyl Airport"},
+	{"KZC", "KH", 12.25520039, 104.564003, "Kampong Chhnang Airport"},
+	{"KZD", "KH", 12.5385, 104.1486, "Krakor Airport"},
+	{"KZF", "PG", -7.50025, 146.0338333, "Kaintiba Airport"},
+	{"KZI", "GR", 40.28609848, 21.84079933, "Filippos Airport"},
+	{"KZN", "RU", 55.60620117, 49.27870178, "Kazan International Airport"},
+	{"KZO", "KZ", 44.70690155, 65.59249878, "Kzyl-Orda Southwest Airport"},
+	{"KZS", "GR", 36.14170074, 29.57640076, "Kastelorizo Airport"},
+	{"LAA", "US", 38.06969833, -102.6880035, "Lamar Municipal Airport"},
+	{"LAB", "PG", -5.7204, 148.0566, "Lab Lab Airport"},
+	{"LAC", "CN", 7.37157011, 113.8440018, "Swallow Reef Airstrip"},
+	{"LAD", "AO", -8.858369827, 13.23120022, "Quatro de Fevereiro Airport"},
+	{"LAE", "PG", -6.569829941, 146.7259979, "Lae Nadzab Airport"},
+	{"LAF", "US", 40.41230011, -86.93689728, "Purdue University Airport"},
+	{"LAG", "VE", 10.53332996, -67.03333282, "La Guaira Airport"},
+	{"LAH", "ID", -0.635258973, 127.5019989, "Oesman Sadik Airport, Labuha"},
+	{"LAI", "FR", 48.75439835, -3.471659899, "Lannion-Côte de Granit Airport"},
+	{"LAJ", "BR", -27.78210068, -50.28150177, "Lages Airport"},
+	{"LAK", "CA", 68.22329712, -135.0059967, "Aklavik Airport"},
+	{"LAL", "US", 27.98889923, -82.01860046, "Lakeland Linder Regional Airport"},
+	{"LAM", "US", 35.87979889, -106.2689972, "Los Alamos Airport"},
+	{"LAN", "US", 42.77870178, -84.58740234, "Capital City Airport"},
+	{"LAO", "PH", 18.17810059, 120.5319977, "Laoag International Airport"},
+	{"LAP", "MX", 24.0727005, -110.3619995, "Manuel Márquez de León International Airport"},
+	{"LAQ", "LY", 32.7887001, 21.96430016, "La Abraq Airport"},
+	{"LAR", "US", 41.31209946, -105.6750031, "Laramie Regional Airport"},
+	{"LAS", "US", 36.08010101, -115.1520004, "McCarran International Airport"},
+	{"LAU", "KE", -2.252419949, 40.9131012, "Manda Airstrip"},
+	{"LAW", "US", 34.56769943, -98.41660309, "Lawton Fort Sill Regional Airport"},
+	{"LAX", "US", 33.94250107, -118.4079971, "Los Angeles International Airport"},
+	{"LAY", "ZA", -28.58169937, 29.74970055, "Ladysmith Airport"},
+	{"LAZ", "BR", -13.26210022, -43.40810013, "Bom Jesus da Lapa Airport"},
+	{"LBA", "GB", 53.86589813, -1.660570025, "Leeds Bradford Airport"},
+	{"LBB", "US", 33.66360092, -101.822998, "Lubbock Preston Smith International Airport"},
+	{"LBC", "DE", 53.80540085, 10.71920013, "Lübeck Blankensee Airport"},
+	{"LBD", "TJ", 40.2154007, 69.69470215, "Khudzhand Airport"},
+	{"LBE", "US", 40.27590179, -79.40480042, "Arnold Palmer Regional Airport"},
+	{"LBF", "US", 41.12620163, -100.6839981, "North Platte Regional Airport Lee Bird Field"},
+	{"LBG", "FR", 48.9693985, 2.441390038, "Paris-Le Bourget Airport"},
+	{"LBI", "FR", 43.91389847, 2.113059998, "Albi-Le Séquestre Airport"},
+	{"LBJ", "ID", -8.486660004, 119.8889999, "Komodo (Mutiara II) Airport"},
+	{"LBK", "KE", 0.348333001, 40.88169861, "Liboi Airport"},
+	{"LBL", "US", 37.0442009, -100.9599991, "Liberal Mid-America Regional Airport"},
+	{"LBM", "MZ", -18.4139, 36.1068, "Luabo Airport"},
+	{"LBN", "KE", 0.666103005, 36.10419083, "Lake Baringo Airport"},
+	{"LBO", "CD", -4.961669922, 23.37829971, "Lusambo Airport"},
+	{"LBP", "MY", 3.202, 115.4018, "Long Banga Airport"},
+	{"LBQ", "GA", -0.704388976, 10.24569988, "Lambarene Airport"},
+	{"LBR", "BR", -7.278969765, -64.76950073, "Lábrea Airport"},
+	{"LBS", "FJ", -16.4666996, 179.3399963, "Labasa Airport"},
+	{"LBT", "US", 34.60990143, -79.05940247, "Lumberton Regional Airport"},
+	{"LBU", "MY", 5.300680161, 115.25, "Labuan Airport"},
+	{"LBV", "GA", 0.458600014, 9.412280083, "Libreville Leon M\"ba International Airport"},
+	{"LBW", "ID", 3.9028, 115.6921, "Long Bawan Airport"},
+	{"LBX", "PH", 13.85540009, 120.1050034, "Lubang Airport"},
+	{"LBY", "FR", 47.28939819, -2.346390009, "La Baule-Escoublac Airport"},
+	{"LBZ", "AO", -8.445727348, 20.73208618, "Lucapa Airport"},
+	{"LCA", "CY", 34.87509918, 33.62490082, "Larnaca International Airport"},
+	{"LCB", "BR", -15.1934, -59.3848, "Pontes e Lacerda Airport"},
+	{"LCC", "IT", 40.23920059, 18.13330078, "Lecce Galatina Air Base"},
+	{"LCD", "ZA", -23.06189919, 29.86470032, "Louis Trichardt Airport"},
+	{"LCE", "HN", 15.74250031, -86.85299683, "Goloson International Airport"},
+	{"LCF", "GT", 15.66889954, -88.957901, "Las Vegas Airport"},
+	{"LCG", "ES", 43.30210114, -8.377260208, "A Coruña Airport"},
+	{"LCH", "US", 30.12610054, -93.22329712, "Lake Charles Regional Airport"},
+	{"LCI", "US", 43.5727005, -71.41889954, "Laconia Municipal Airport"},
+	{"LCJ", "PL", 51.72190094, 19.3980999, "Łódź Władysław Reymont Airport"},
+	{"LCK", "US", 39.81380081, -82.92780304, "Rickenbacker International Airport"},
+	{"LCL", "CU", 22.33609962, -83.64189911, "La Coloma Airport"},
+	{"LCM", "AR", -31.00580025, -64.5318985, "La Cumbre Airport"},
+	{"LCN", "AU", -30.53499985, 139.3370056, "Balcanoona Airport"},
+	{"LCO", "CG", -2.450000048, 14.53299999, "Lague Airport"},
+	{"LCP", "AR", -38.08190155, -70.64389801, "Loncopue Airport"},
+	{"LCQ", "US", 30.18199921, -82.57689667, "Lake City Gateway Airport"},
+	{"LCR", "CO", -0.733333, -73.01667, "La Chorrera Airport"},
+	{"LCV", "IT", 43.82820129, 10.5781002, "Lucca-Tassignano Airport"},
+	{"LCX", "CN", 25.67469978, 116.7470016, "Longyan Guanzhishan Airport"},
+	{"LCY", "GB", 51.50529861, 0.055277999, "London City Airport"},
+	{"LDA", "IN", 25.03300095, 88.13300323, "Malda Airport"},
+	{"LDB", "BR", -23.33359909, -51.13010025, "Governador José Richa Airport"},
+	{"LDC", "AU", -20.45359993, 149.0399933, "Lindeman Island Airport"},
+	{"LDE", "FR", 43.17869949, -0.006439, "Tarbes-Lourdes-Pyrénées Airport"},
+	{"LDG", "RU", 64.89600372, 45.72299957, "Leshukonskoye Airport"},
+	{"LDH", "AU", -31.53829956, 159.0769959, "Lord Howe Island Airport"},
+	{"LDI", "TZ", -9.851110458, 39.75780106, "Kikwetu Airport"},
+	{"LDJ", "US", 40.61740112, -74.24459839, "Linden Airport"},
+	{"LDK", "SE", 58.46549988, 13.17440033, "Lidköping-Hovby Airport"},
+	{"LDM", "US", 43.96250153, -86.40789795, "Mason County Airport"},
+	{"LDN", "NP", 27.25309944, 86.66999817, "Lamidanda Airport"},
+	{"LDO", "SR", 4.376108283, -55.40705681, "Ladouanie Airport"},
+	{"LDS", "CN", 47.75205556, 129.019125, "Lindu Airport"},
+	{"LDU", "MY", 5.032249928, 118.3239975, "Lahad Datu Airport"},
+	{"LDV", "FR", 48.53030014, -4.151639938, "Landivisiau Air Base"},
+	{"LDW", "AU", -17.6128, 126.743, "Lansdowne Airport"},
+	{"LDX", "GF", 5.483059883, -54.03440094, "Saint-Laurent-du-Maroni Airport"},
+	{"LDY", "GB", 55.0428009, -7.161109924, "City of Derry Airport"},
+	{"LDZ", "ZA", -24.75020027, 31.47570038, "Londolozi Airport"},
+	{"LEA", "AU", -22.23559952, 114.0889969, "Learmonth Airport"},
+	{"LEB", "US", 43.62609863, -72.30419922, "Lebanon Municipal Airport"},
+	{"LEC", "BR", -12.4822998, -41.27700043, "Chapada Diamantina Airport"},
+	{"LED", "RU", 59.8003006, 30.26250076, "Pulkovo Airport"},
+	{"LEE", "US", 28.82309914, -81.80870056, "Leesburg International Airport"},
+	{"LEF", "LS", -29.89080048, 28.65559959, "Lebakeng Airport"},
+	{"LEH", "FR", 49.53390121, 0.088055998, "Le Havre Octeville Airport"},
+	{"LEI", "ES", 36.84389877, -2.370100021, "Almería International Airport"},
+	{"LEJ", "DE", 51.43239975, 12.24160004, "Leipzig Halle Airport"},
+	{"LEK", "GN", 11.32610035, -12.28680038, "Labe Airport"},
+	{"LEL", "AU", -12.49890041, 135.8059998, "Lake Evella Airport"},
+	{"LEM", "US", 45.91870117, -102.1060028, "Lemmon Municipal Airport"},
+	{"LEN", "ES", 42.5890007, -5.655560017, "Leon Airport"},
+	{"LEO", "GA", -1.5724, 14.2878, "Lekoni Airport"},
+	{"LEP", "BR", -21.46610069, -42.72700119, "Leopoldina Airport"},
+	{"LEQ", "GB", 50.10279846, -5.670559883, "Land\"s End Airport"},
+	{"LER", "AU", -27.84329987, 120.7030029, "Leinster Airport"},
+	{"LES", "LS", -29.78289986, 28.31669998, "Lesobeng Airport"},
+	{"LET", "CO", -4.19355, -69.9432, "Alfredo Vásquez Cobo International Airport"},
+	{"LEU", "ES", 42.33860016, 1.409170032, "Pirineus - la Seu d\"Urgel Airport"},
+	{"LEV", "FJ", -17.71109962, 178.7590027, "Levuka Airfield"},
+	{"LEW", "US", 44.04850006, -70.28350067, "Auburn Lewiston Municipal Airport"},
+	{"LEX", "US", 38.03649902, -84.60590363, "Blue Grass Airport"},
+	{"LEY", "NL", 52.46030045, 5.527219772, "Lelystad Airport"},
+	{"LEZ", "HN", 14.29111099, -88.17500305, "La Esperanza Airport"},
+	{"LFB", "MZ", -15.03310013, 40.67169952, "Lumbo Airport"},
+	{"LFI", "US", 37.082901, -76.36049652, "Langley Air Force Base"},
+	{"LFK", "US", 31.23399925, -94.75, "Angelina County Airport"},
+	{"LFM", "IR", 27.37269974, 53.18880081, "Lamerd Airport"},
+	{"LFN", "US", 36.02330017, -78.33029938, "Triangle North Executive Airport"},
+	{"LFO", "ET", 5.657000065, 44.34999847, "Kelafo East Airport"},
+	{"LFP", "AU", -14.9207, 144.203, "Lakefield Airport"},
+	{"LFR", "VE", 8.239167213, -72.27102661, "La Fria Airport"},
+	{"LFT", "US", 30.20529938, -91.98760223, "Lafayette Regional Airport"},
+	{"LFW", "TG", 6.165609837, 1.254510045, "Lomé-Tokoin Airport"},
+	{"LGA", "US", 40.77719879, -73.87259674, "La Guardia Airport"},
+	{"LGB", "US", 33.81769943, -118.1520004, "Long Beach /Daugherty Field/ Airport"},
+	{"LGC", "US", 33.00889969, -85.07260132, "Lagrange Callaway Airport"},
+	{"LGD", "US", 45.29019928, -118.0070038, "La Grande/Union County Airport"},
+	{"LGE", "AU", -20.1089, 127.619, "Mulan Airport"},
+	{"LGF", "US", 32.86000061, -114.3970032, "Laguna Army Airfield"},
+	{"LGG", "BE", 50.63740158, 5.443220139, "Liège Airport"},
+	{"LGH", "AU", -30.59830093, 138.4259949, "Leigh Creek Airport"},
+	{"LGI", "BS", 23.17900085, -75.09359741, "Deadman\"s Cay Airport"},
+	{"LGK", "MY", 6.329730034, 99.72869873, "Langkawi International Airport"},
+	{"LGL", "MY", 3.421000004, 115.1539993, "Long Lellang Airport"},
+	{"LGN", "PG", -5.531944444, 149.7341667, "Linga Linga Airport"},
+	{"LGO", "DE", 53.74250031, 7.497777939, "Langeoog Airport"},
+	{"LGP", "PH", 13.1575, 123.735, "Legazpi City International Airport"},
+	{"LGQ", "EC", 0.093056001, -76.86750031, "Nueva Loja Airport"},
+	{"LGR", "CL", -47.24380112, -72.58840179, "Cochrane Airport"},
+	{"LGS", "AR", -35.49359894, -69.57430267, "Comodoro D.R. Salomón Airport"},
+	{"LGT", "CO", 4.549722, -70.925, "La Gaviota Airport"},
+	{"LGU", "US", 41.79119873, -111.8519974, "Logan-Cache Airport"},
+	{"LGW", "GB", 51.14810181, -0.190277994, "London Gatwick Airport"},
+	{"LGX", "SO", 3.8124, 42.5459, "Lugh Ganane Airport"},
+	{"LGY", "VE", 10.1239996, -71.23799896, "Lagunillas Airport"},
+	{"LHA", "DE", 48.36930084, 7.827720165, "Lahr Airport"},
+	{"LHE", "PK", 31.52160072, 74.4036026, "Alama Iqbal International Airport"},
+	{"LHG", "AU", -29.45669937, 147.9839935, "Lightning Ridge Airport"},
+	{"LHI", "ID", -3.0795, 139.952, "Lereh Airport"},
+	{"LHK", "CN", 32.38940048, 111.6949997, "Guangzhou MR Air Base"},
+	{"LHR", "GB", 51.47060013, -0.461941004, "London Heathrow Airport"},
+	{"LHS", "AR", -46.53829956, -68.96530151, "Las Heras Airport"},
+	{"LHU", "NA", -18.11669922, 23.3932991, "Lianshulu Airport"},
+	{"LHV", "US", 41.13560104, -77.42230225, "William T. Piper Memorial Airport"},
+	{"LHW", "CN", 36.51520157, 103.6200027, "Lanzhou Zhongchuan Airport"},
+	{"LIA", "CN", 30.67939949, 107.7860031, "Liangping Airport"},
+	{"LIB", "AU", -17.23559952, 129.8820038, "Limbunya Airport"},
+	{"LIC", "US", 39.27479935, -103.6660004, "Limon Municipal Airport"},
+	{"LIE", "CD", 3.632999897, 18.63299942, "Libenge Airport"},
+	{"LIF", "NC", -20.77479935, 167.2400055, "Lifou Airport"},
+	{"LIG", "FR", 45.8628006, 1.179440022, "Limoges Airport"},
+	{"LIH", "US", 21.97599983, -159.3390045, "Lihue Airport"},
+	{"LII", "ID", -3.7018, 137.957, "Mulia Airport"},
+	{"LIK", "MH", 9.823160172, 169.3079987, "Likiep Airport"},
+	{"LIL", "FR", 50.56190109, 3.089440107, "Lille-Lesquin Airport"},
+	{"LIM", "PE", -12.02190018, -77.11430359, "Jorge Chávez International Airport"},
+	{"LIN", "IT", 45.44509888, 9.276740074, "Linate Airport"},
+	{"LIO", "CR", 9.957960129, -83.02200317, "Limon International Airport"},
+	{"LIP", "BR", -21.66399956, -49.73049927, "Lins Airport"},
+	{"LIQ", "CD", 2.170660019, 21.49690056, "Lisala Airport"},
+	{"LIR", "CR", 10.59329987, -85.54440308, "Daniel Oduber Quiros International Airport"},
+	{"LIS", "PT", 38.78129959, -9.135919571, "Lisbon Portela Airport"},
+	{"LIT", "US", 34.72940063, -92.22429657, "Bill & Hillary Clinton National Airport/Adams Field"},
+	{"LIV", "US", 65.467, -148.6534, "Livengood Camp Airport"},
+	{"LIW", "MM", 19.69149971, 97.21479797, "Loikaw Airport"},
+	{"LIX", "MW", -12.08300018, 34.73300171, "Likoma Island Airport"},
+	{"LIY", "US", 31.88909912, -81.56230164, "Wright Aaf (Fort Stewart)/Midcoast Regional Airport"},
+	{"LIZ", "US", 46.95040131, -67.8859024, "Loring International Airport"},
+	{"LJA", "CD", -3.417000055, 23.45000076, "Lodja Airport"},
+	{"LJG", "CN", 26.68000031, 100.2460022, "Lijiang Airport"},
+	{"LJN", "US", 29.10860062, -95.46209717, "Texas Gulf Coast Regional Airport"},
+	{"LJU", "SI", 46.22370148, 14.45759964, "Ljubljana Jože Pučnik Airport"},
+	{"LKA", "ID", -8.274424, 123.002, "Gewayentana Airport"},
+	{"LKB", "FJ", -18.19919968, -178.8170013, "Lakeba Island Airport"},
+	{"LKC", "CG", -2.313, 14.606, "Lekana Airport"},
+	{"LKD", "AU", -15.83329964, 144.8500061, "Lakeland Airport"},
+	{"LKG", "KE", 4.204120159, 34.34820175, "Lokichoggio Airport"},
+	{"LKH", "MY", 3.299999952, 114.7829971, "Long Akah Airport"},
+	{"LKK", "US", 58.98210144, -155.1210022, "Kulik Lake Airport"},
+	{"LKL", "NO", 70.06880188, 24.9734993, "Banak Airport"},
+	{"LKN", "NO", 68.15249634, 13.6093998, "Leknes Airport"},
+	{"LKO", "IN", 26.76059914, 80.88929749, "Chaudhary Charan Singh International Airport"},
+	{"LKP", "US", 44.26449966, -73.9618988, "Lake Placid Airport"},
+	{"LKU", "KE", 3.416666985, 35.8833313, "Lake Rudolf Airport"},
+	{"LKV", "US", 42.16109848, -120.3990021, "Lake County Airport"},
+	{"LKW", "OM", 22.80498468, 55.37336826, "Lekhwair Airport"},
+	{"LKY", "TZ", -3.37631011, 35.81829834, "Lake Manyara Airport"},
+	{"LKZ", "GB", 52.40930176, 0.56099999, "RAF Lakenheath"},
+	{"LLA", "SE", 65.54380035, 22.12199974, "Luleå Airport"},
+	{"LLE", "ZA", -25.43000031, 31.57670021, "Riverside Airport"},
+	{"LLF", "CN", 26.338661, 111.610043, "Lingling Airport"},
+	{"LLG", "AU", -17.14279938, 144.529007, "Chillagoe Airport"},
+	{"LLH", "HN", 15.4422, -87.8988, "Reginaldo Hammer Airport"},
+	{"LLI", "ET", 11.97500038, 38.97999954, "Lalibella Airport"},
+	{"LLJ", "BD", 25.88750076, 89.43309784, "Lalmonirhat Airport"},
+	{"LLK", "AZ", 38.74639893, 48.81800079, "Lankaran International Airport"},
+	{"LLL", "AU", -16.661, 128.594, "Lissadell Airport"},
+	{"LLM", "MY", 3.766669989, 114.4666672, "Long Lama Airport"},
+	{"LLN", "ID", -3.75, 138.6666718, "Kelila Airport"},
+	{"LLS", "AR", -24.72139931, -60.54880142, "Alferez Armando Rodriguez Airport"},
+	{"LLW", "MW", -13.7894001, 33.78099823, "Lilongwe International Airport"},
+	{"LLX", "US", 44.56909943, -72.01799774, "Caledonia County Airport"},
+	{"LLY", "US", 39.94290161, -74.84570313, "South Jersey Regional Airport"},
+	{"LMB", "MW", -13.7558927, 34.58418846, "Salima Airport"},
+	{"LMC", "CO", 2.17565, -73.78674, "La Macarena Airport"},
+	{"LMD", "AR", -40.81769943, -68.0746994, "Los Menucos Airport"},
+	{"LME", "FR", 47.94860077, 0.201666996, "Le Mans-Arnage Airport"},
+	{"LMI", "PG", -3.476839511, 142.0412922, "Lumi Airport"},
+	{"LML", "MH", 8.921110153, 166.2649994, "Lae Island Airport"},
+	{"LMM", "MX", 25.68519974, -109.0810013, "Valle del Fuerte International Airport"},
+	{"LMN", "MY", 4.808300018, 115.0100021, "Limbang Airport"},
+	{"LMO", "GB", 57.7052002, -3.339169979, "RAF Lossiemouth"},
+	{"LMP", "IT", 35.4978981, 12.61810017, "Lampedusa Airport"},
+	{"LMQ", "LY", 30.37809944, 19.57640076, "Marsa Brega Airport"},
+	{"LMR", "ZA", -28.36009979, 23.43910027, "Lime Acres Finsch Mine Airport"},
+	{"LMS", "US", 33.14619827, -89.0625, "Louisville Winston County Airport"},
+	{"LMT", "US", 42.15610123, -121.7330017, "Klamath Falls Airport"},
+	{"LMY", "PG", -7.00992012, 141.4940033, "Lake Murray Airport"},
+	{"LMZ", "MZ", -10.7506, 40.4702, "Palma Airport"},
+	{"LNA", "US", 26.59300041, -80.08509827, "Palm Beach County Park Airport"},
+	{"LNB", "VU", -16.58419991, 168.1589966, "Lamen Bay Airport"},
+	{"LNC", "PG", -6.384611111, 147.3686389, "Lengbati Airport"},
+	{"LND", "US", 42.81520081, -108.7300034, "Hunt Field"},
+	{"LNE", "VU", -15.86559963, 168.1719971, "Lonorore Airport"},
+	{"LNF", "PG", -4.855941, 141.220165, "Munbil Airport"},
+	{"LNH", "AU", -20.9807, 137.9178, "Lake Nash Airport"},
+	{"LNI", "US", 70.91069794, -153.2420044, "Lonely Air Station"},
+	{"LNJ", "CN", 23.73810005, 100.0250015, "Lintsang Airfield"},
+	{"LNK", "US", 40.85100174, -96.75920105, "Lincoln Airport"},
+	{"LNM", "PG", -7.223138889, 146.2270833, "Langimar Airport"},
+	{"LNN", "US", 41.68399811, -81.38970184, "Willoughby Lost Nation Municipal Airport"},
+	{"LNO", "AU", -28.87809944, 121.3150024, "Leonora Airport"},
+	{"LNP", "US", 36.98749924, -82.52999878, "Lonesome Pine Airport"},
+	{"LNR", "US", 43.21170044, -90.18160248, "Tri-County Regional Airport"},
+	{"LNS", "US", 40.12170029, -76.2960968, "Lancaster Airport"},
+	{"LNV", "PG", -3.043610096, 152.6289978, "Londolovit Airport"},
+	{"LNX", "RU", 54.745, 32.065, "Smolensk South Airport"},
+	{"LNY", "US", 20.78560066, -156.951004, "Lanai Airport"},
+	{"LNZ", "AT", 48.23320007, 14.1875, "Linz Airport"},
+	{"LOA", "AU", -18.99329948, 139.9069977, "Lorraine Airport"},
+	{"LOB", "CL", -32.81420135, -70.646698, "San Rafael Airport"},
+	{"LOC", "AU", -33.5442009, 135.6929932, "Lock Airport"},
+	{"LOD", "VU", -15.30669975, 167.9669952, "Longana Airport"},
+	{"LOE", "TH", 17.43910027, 101.7220001, "Loei Airport"},
+	{"LOH", "EC", -3.995889902, -79.37190247, "Camilo Ponce Enriquez Airport"},
+	{"LOI", "BR", -27.15999985, -49.54249954, "Helmuth Baungarten Airport"},
+	{"LOK", "KE", 3.121969938, 35.6086998, "Lodwar Airport"},
+	{"LOL", "US", 40.06639862, -118.5650024, "LOL"},
+	{"LOM", "MX", 21.2581, -101.9441, "Francisco Primo de Verdad y Ramos Airport"},
+	{"LOO", "DZ", 33.76440048, 2.928339958, "Laghouat Airport"},
+	{"LOP", "ID", -8.757322, 116.276675, "Bandara International Lombok Airport"},
+	{"LOS", "NG", 6.577370167, 3.321160078, "Murtala Muhammed International Airport"},
+	{"LOU", "US", 38.22800064, -85.66369629, "Bowman Field"},
+	{"LOV", "MX", 26.95569992, -101.4700012, "Monclova International Airport"},
+	{"LOY", "KE", 2.75, 36.71699905, "Loyengalani Airport"},
+	{"LPA", "ES", 27.93190002, -15.38659954, "Gran Canaria Airport"},
+	{"LPB", "BO", -16.51329994, -68.19229889, "El Alto International Airport"},
+	{"LPD", "CO", -1.32861, -69.5797, "La Pedrera Airport"},
+	{"LPG", "AR", -34.9722, -57.8947, "La Plata Airport"},
+	{"LPI", "SE", 58.40620041, 15.68050003, "Linköping City Airport"},
+	{"LPJ", "VE", 6.578050137, -66.81690216, "Armando Schwarck Airport"},
+	{"LPK", "RU", 52.70280075, 39.53779984, "Lipetsk Airport"},
+	{"LPL", "GB", 53.33359909, -2.849720001, "Liverpool John Lennon Airport"},
+	{"LPM", "VU", -16.45400047, 167.822998, "Lamap Airport"},
+	{"LPP", "FI", 61.04460144, 28.14439964, "Lappeenranta Airport"},
+	{"LPQ", "LA", 19.89730072, 102.1610031, "Luang Phabang International Airport"},
+	{"LPS", "US", 48.48389816, -122.9380035, "Lopez Island Airport"},
+	{"LPT", "TH", 18.27090073, 99.5042038, "Lampang Airport"},
+	{"LPU", "ID", 1.704486, 114.970297, "Long Apung Airport"},
+	{"LPX", "LV", 56.51750183, 21.09690094, "Liepāja International Airport"},
+	{"LPY", "FR", 45.08069992, 3.7628901, "Le Puy-Loudes Airport"},
+	{"LQM", "CO", -0.182278, -74.7708, "Caucaya Airport"},
+	{"LQN", "AF", 34.98500061, 63.11780167, "Qala-I-Naw Airport"},
+	{"LRA", "GR", 39.650253, 22.4655, "Larisa Airport"},
+	{"LRB", "LS", -28.85560036, 28.05279922, "Leribe Airport"},
+	{"LRD", "US", 27.54380035, -99.46160126, "Laredo International Airport"},
+	{"LRE", "AU", -23.43420029, 144.2799988, "Longreach Airport"},
+	{"LRG", "PK", 30.35549927, 68.6135025, "Loralai Airport"},
+	{"LRH", "FR", 46.17919922, -1.195279956, "La Rochelle-Île de Ré Airport"},
+	{"LRL", "TG", 9.76733017, 1.091249943, "Niamtougou International Airport"},
+	{"LRM", "DO", 18.45070076, -68.91179657, "Casa De Campo International Airport"},
+	{"LRR", "IR", 27.67469978, 54.38330078, "Lar Airport"},
+	{"LRS", "GR", 37.18489838, 26.8003006, "Leros Airport"},
+	{"LRT", "FR", 47.76060104, -3.440000057, "Lorient South Brittany (Bretagne Sud) Airport"},
+	{"LRU", "US", 32.28939819, -106.9219971, "Las Cruces International Airport"},
+	{"LRV", "VE", 11.94999981, -66.66999817, "Gran Roque Airport"},
+	{"LSA", "PG", -8.505820274, 151.0809937, "Losuia Airport"},
+	{"LSC", "CL", -29.91620064, -71.19950104, "La Florida Airport"},
+	{"LSE", "US", 43.87900162, -91.25669861, "La Crosse Municipal Airport"},
+	{"LSH", "MM", 22.97789955, 97.75219727, "Lashio Airport"},
+	{"LSI", "GB", 59.87889862, -1.295560002, "Sumburgh Airport"},
+	{"LSL", "CR", 11.03530025, -84.70610046, "Los Chiles Airport"},
+	{"LSM", "MY", 4.217000008, 115.5999985, "Long Semado Airport"},
+	{"LSO", "FR", 46.47689819, -1.722779989, "Les Sables-d\"Olonne Talmont Airport"},
+	{"LSP", "VE", 11.78077507, -70.15149689, "Josefa Camejo International Airport"},
+	{"LSQ", "CL", -37.40169907, -72.42539978, "María Dolores Airport"},
+	{"LSS", "GP", 15.86439991, -61.58060074, "Terre-de-Haut Airport"},
+	{"LST", "AU", -41.54529953, 147.2140045, "Launceston Airport"},
+	{"LSU", "MY", 4.552219868, 115.4940033, "Long Sukang Airport"},
+	{"LSW", "ID", 5.226679802, 96.95030212, "Malikus Saleh Airport"},
+	{"LSX", "ID", 5.069509983, 97.25920105, "Lhok Sukon Airport"},
+	{"LSY", "AU", -28.83029938, 153.2599945, "Lismore Airport"},
+	{"LSZ", "HR", 44.56579971, 14.39309978, "Lošinj Island Airport"},
+	{"LTA", "ZA", -23.82439995, 30.32929993, "Tzaneen Airport"},
+	{"LTC", "TD", 9.3979, 16.3125, "Lai Airport"},
+	{"LTD", "LY", 30.15169907, 9.715310097, "Ghadames East Airport"},
+	{"LTF", "PG", -2.8337, 141.6257, "Leitre Airport"},
+	{"LTG", "NP", 28.20000076, 85.58300018, "Langtang Airport"},
+	{"LTI", "MN", 46.37639999, 96.22109985, "Altai Airport"},
+	{"LTK", "SY", 35.40110016, 35.94869995, "Bassel Al-Assad International Airport"},
+	{"LTL", "GA", -0.826667011, 12.74860001, "Lastourville Airport"},
+	{"LTM", "GY", 3.372760057, -59.78939819, "Lethem Airport"},
+	{"LTN", "GB", 51.87469864, -0.368333012, "London Luton Airport"},
+	{"LTO", "MX", 25.98920059, -111.3479996, "Loreto International Airport"},
+	{"LTP", "AU", -19.19580078, 144.3710022, "Lyndhurst Airport"},
+	{"LTQ", "FR", 50.51739883, 1.620589972, "Le Touquet-Côte d\"Opale Airport"},
+	{"LTR", "IE", 54.95130157, -7.672830105, "Letterkenny Airport"},
+	{"LTS", "US", 34.667099, -99.26670074, "Altus Air Force Base"},
+	{"LTT", "FR", 43.20539856, 6.481999874, "La Môle Airport"},
+	{"LTU", "IN", 18.41150093, 76.46469879, "Murod Kond Airport"},
+	{"LTV", "AU", -17.04829979, 141.3760071, "Lotus Vale Airport"},
+	{"LTW", "US", 38.31539917, -76.55010223, "St. Mary\"s County Regional Airport"},
+	{"LTX", "EC", -0.906832993, -78.61579895, "Cotopaxi International Airport"},
+	{"LUA", "NP", 27.68689919, 86.72969818, "Lukla Airport"},
+	{"LUB", "GY", 2.393929958, -59.44100189, "Lumid Pau Airport"},
+	{"LUC", "FJ", -16.74810028, -179.6670074, "Laucala Island Airport"},
+	{"LUD", "NA", -26.68740082, 15.24289989, "Luderitz Airport"},
+	{"LUE", "SK", 48.33940125, 19.73579979, "Lučenec Airport"},
+	{"LUF", "US", 33.53499985, -112.3830032, "Luke Air Force Base"},
+	{"LUG", "CH", 46.00429916, 8.910579681, "Lugano Airport"},
+	{"LUH", "IN", 30.85470009, 75.95259857, "Ludhiana Airport"},
+	{"LUJ", "ZA", -31.36669922, 29.58329964, "Lusikisiki Airport"},
+	{"LUK", "US", 39.10329819, -84.41860199, "Cincinnati Municipal Airport Lunken Field"},
+	{"LUL", "US", 31.67259979, -89.17220306, "Hesler Noble Field"},
+	{"LUM", "CN", 24.40110016, 98.53170013, "Mangshi Airport"},
+	{"LUN", "ZM", -15.33080006, 28.45260048, "Lusaka International Airport"},
+	{"LUO", "AO", -11.76809978, 19.89769936, "Luena Airport"},
+	{"LUP", "US", 21.21100044, -156.973999, "Kalaupapa Airport"},
+	{"LUQ", "AR", -33.27320099, -66.35639954, "Brigadier Mayor D Cesar Raul Ojeda Airport"},
+	{"LUR", "US", 68.87509918, -166.1100006, "Cape Lisburne LRRS Airport"},
+	{"LUS", "CD", -4.800000191, 18.71699905, "Lusanga Airport"},
+	{"LUT", "AU", -15.18330002, 144.3670044, "New Laura Airport"},
+	{"LUU", "AU", -15.55000019, 144.4499969, "Laura Airport"},
+	{"LUV", "ID", -5.66162014, 132.7310028, "Dumatumbun Airport"},
+	{"LUW", "ID", -1.038920045, 122.7720032, "Bubung Airport"},
+	{"LUX", "LU", 49.62659836, 6.211520195, "Luxembourg-Findel International Airport"},
+	{"LUY", "TZ", -4.783259869, 38.30419922, "Lushoto Airport"},
+	{"LUZ", "PL", 51.23194444, 22.69027778, "Lublin Airport"},
+	{"LVA", "FR", 48.03139877, -0.742986023, "Laval-Entrammes Airport"},
+	{"LVD", "US", 61.35910034, -155.4400024, "Lime Village Airport"},
+	{"LVI", "ZM", -17.82180023, 25.8227005, "Livingstone Airport"},
+	{"LVK", "US", 37.69340134, -121.8199997, "Livermore Municipal Airport"},
+	{"LVL", "US", 36.77280045, -77.79429626, "Lawrenceville Brunswick Municipal Airport"},
+	{"LVM", "US", 45.69940186, -110.447998, "Mission Field"},
+	{"LVO", "AU", -28.61359978, 122.4240036, "Laverton Airport"},
+	{"LVP", "IR", 26.81030083, 53.35630035, "Lavan Island Airport"},
+	{"LVR", "BR", -13.03786111, -55.95025, "Municipal Bom Futuro Airport"},
+	{"LVS", "US", 35.65420151, -105.1419983, "Las Vegas Municipal Airport"},
+	{"LWA", "PH", 6.6739, 124.0581, "Lebak Rural Airport"},
+	{"LWB", "US", 37.85829926, -80.39949799, "Greenbrier Valley Airport"},
+	{"LWC", "US", 39.01119995, -95.21659851, "Lawrence Municipal Airport"},
+	{"LWE", "ID", -8.3629, 123.438, "Lewoleba Airport"},
+	{"LWH", "AU", -18.56830025, 138.6349945, "Lawn Hill Airport"},
+	{"LWI", "PG", -6.3344, 146.6458, "Lowai Airport"},
+	{"LWK", "GB", 60.19219971, -1.243610024, "Lerwick / Tingwall Airport"},
+	{"LWL", "US", 41.11709976, -114.9219971, "Wells Municipal Airport/Harriet Field"},
+	{"LWM", "US", 42.71720123, -71.12339783, "Lawrence Municipal Airport"},
+	{"LWN", "AM", 40.75040054, 43.85929871, "Gyumri Shirak Airport"},
+	{"LWO", "UA", 49.8125, 23.95610046, "Lviv International Airport"},
+	{"LWR", "NL", 53.22859955, 5.760560036, "Leeuwarden Air Base"},
+	{"LWS", "US", 46.37450027, -117.0149994, "Lewiston Nez Perce County Airport"},
+	{"LWT", "US", 47.04930115, -109.4670029, "Lewistown Municipal Airport"},
+	{"LWV", "US", 38.7643013, -87.60549927, "Lawrenceville Vincennes International Airport"},
+	{"LWY", "MY", 4.849170208, 115.4079971, "Lawas Airport"},
+	{"LXA", "CN", 29.29780006, 90.91190338, "Lhasa Gonggar Airport"},
+	{"LXG", "LA", 20.96699905, 101.4000015, "Luang Namtha Airport"},
+	{"LXN", "US", 40.79100037, -99.77729797, "Jim Kelly Field"},
+	{"LXR", "EG", 25.67099953, 32.70660019, "Luxor International Airport"},
+	{"LXS", "GR", 39.917099, 25.23629951, "Limnos Airport"},
+	{"LXU", "ZM", -14.37479973, 23.24950027, "Lukulu Airport"},
+	{"LXV", "US", 39.22029877, -106.3170013, "Lake County Airport"},
+	{"LYA", "CN", 34.74110031, 112.3880005, "Luoyang Airport"},
+	{"LYB", "KY", 19.66699982, -80.09999847, "Edward Bodden Airfield"},
+	{"LYC", "SE", 64.5483017, 18.71619987, "Lycksele Airport"},
+	{"LYE", "GB", 51.50510025, -1.993430018, "RAF Lyneham"},
+	{"LYG", "CN", 34.571667, 118.873611, "Lianyungang Airport"},
+	{"LYH", "US", 37.3266983, -79.20040131, "Lynchburg Regional Preston Glenn Field"},
+	{"LYI", "CN", 35.04610062, 118.4120026, "Shubuling Airport"},
+	{"LYK", "ID", -8.9889, 117.2158, "Lunyuk Airport"},
+	{"LYN", "FR", 45.72719955, 4.944270134, "Lyon-Bron Airport"},
+	{"LYO", "US", 38.34280014, -98.22689819, "Lyons-Rice County Municipal Airport"},
+	{"LYP", "PK", 31.36499977, 72.99479675, "Faisalabad International Airport"},
+	{"LYR", "NO", 78.24610138, 15.46560001, "Svalbard Airport, Longyear"},
+	{"LYS", "FR", 45.72639847, 5.090829849, "Lyon Saint-Exupéry Airport"},
+	{"LYT", "AU", -24.1129, 152.7156, "Lady Elliot Island Airstrip"},
+	{"LYU", "US", 47.82450104, -91.83070374, "Ely Municipal Airport"},
+	{"LYX", "GB", 50.95610046, 0.939167023, "Lydd Airport"},
+	{"LZA", "CD", -7.183000088, 22.39999962, "Luiza Airport"},
+	{"LZC", "MX", 18.00169945, -102.2210007, "Lázaro Cárdenas Airport"},
+	{"LZH", "CN", 24.20750046, 109.3909988, "Bailian Airport"},
+	{"LZI", "CD", -4.949999809, 14.13300037, "Luozi Airport"},
+	{"LZM", "AO", -9.115960121, 18.04929924, "Luzamba Airport"},
+	{"LZN", "TW", 26.15979958, 119.9580002, "Matsu Nangan Airport"},
+	{"LZO", "CN", 28.85219955, 105.3929977, "Luzhou Airport"},
+	{"LZR", "AU", -14.673273, 145.454571, "Lizard Island Airport"},
+	{"LZU", "US", 33.97809982, -83.96240234, "Gwinnett County Briscoe Field"},
+	{"LZY", "CN", 29.30330086, 94.33529663, "Nyingchi Airport"},
+	{"MAA", "IN", 12.99000549, 80.16929626, "Chennai International Airport"},
+	{"MAB", "BR", -5.368589878, -49.13800049, "João Correa da Rocha Airport"},
+	{"MAC", "US", 32.82210159, -83.56199646, "Macon Downtown Airport"},
+	{"MAD", "ES", 40.4936, -3.56676, "Adolfo Suárez Madrid–Barajas Airport"},
+	{"MAE", "US", 36.98860168, -120.1119995, "Madera Municipal Airport"},
+	{"MAF", "US", 31.94249916, -102.2020035, "Midland International Airport"},
+	{"MAG", "PG", -5.207079887, 145.7890015, "Madang Airport"},
+	{"MAH", "ES", 39.86259842, 4.218649864, "Menorca Airport"},
+	{"MAI", "MW", -14.4829998, 35.26699829, "Mangochi Airport"},
+	{"MAJ", "MH", 7.064760208, 171.2720032, "Marshall Islands International Airport"},
+	{"MAK", "SS", 9.558970451, 31.6522007, "Malakal Airport"},
+	{"MAL", "ID", -1.87579, 125.8300018, "Mangole Airport, Falabisahaya"},
+	{"MAM", "MX", 25.76989937, -97.52529907, "General Servando Canales International Airport"},
+	{"MAN", "GB", 53.35369873, -2.274950027, "Manchester Airport"},
+	{"MAO", "BR", -3.038609982, -60.04970169, "Eduardo Gomes International Airport"},
+	{"MAP", "PG", -10.29083333, 149.5191667, "Mamai Airport"},
+	{"MAQ", "TH", 16.69989967, 98.54509735, "Mae Sot Airport"},
+	{"MAR", "VE", 10.55820847, -71.7278595, "La Chinita International Airport"},
+	{"MAS", "PG", -2.061889887, 147.423996, "Momote Airport"},
+	{"MAT", "CD", -5.799610138, 13.44040012, "Tshimpi Airport"},
+	{"MAU", "PF", -16.42650032, -152.2440033, "Maupiti Airport"},
+	{"MAV", "MH", 8.704440117, 171.2299957, "Maloelap Island Airport"},
+	{"MAW", "US", 36.6006012, -89.99220276, "Malden Regional Airport"},
+	{"MAX", "SN", 15.59360027, -13.32279968, "Ouro Sogui Airport"},
+	{"MAY", "BS", 24.28770065, -77.68460083, "Clarence A. Bain Airport"},
+	{"MAZ", "PR", 18.25569916, -67.14849854, "Eugenio Maria De Hostos Airport"},
+	{"MBA", "KE", -4.034830093, 39.59420013, "Mombasa Moi International Airport"},
+	{"MBB", "AU", -21.16329956, 119.8330002, "Marble Bar Airport"},
+	{"MBC", "GA", -1.883000016, 11.93299961, "M\"Bigou Airport"},
+	{"MBD", "ZA", -25.79840088, 25.54800034, "Mmabatho International Airport"},
+	{"MBE", "JP", 44.30390167, 143.404007, "Monbetsu Airport"},
+	{"MBH", "AU", -25.51329994, 152.7149963, "Maryborough Airport"},
+	{"MBI", "TZ", -8.916999817, 33.46699905, "Mbeya Airport"},
+	{"MBJ", "JM", 18.50370026, -77.91339874, "Sangster International Airport"},
+	{"MBK", "BR", -10.1702776, -54.95277786, "Regional Orlando Villas Boas Airport"},
+	{"MBL", "US", 44.2723999, -86.24690247, "Manistee Co Blacker Airport"},
+	{"MBM", "ZA", -31.2833004, 29.9666996, "Mkambati Airport"},
+	{"MBO", "PH", 13.20810032, 120.6050034, "Mamburao Airport"},
+	{"MBP", "PE", -6.01888895, -76.98832703, "Moyobamba Airport"},
+	{"MBQ", "UG", -0.555278003, 30.59939957, "Mbarara Airport"},
+	{"MBS", "US", 43.53290176, -84.07959747, "MBS International Airport"},
+	{"MBT", "PH", 12.36940002, 123.6289978, "Moises R. Espinosa Airport"},
+	{"MBU", "SB", -9.74750042, 159.8390045, "Babanakira Airport"},
+	{"MBW", "AU", -37.97579956, 145.102005, "Melbourne Moorabbin Airport"},
+	{"MBX", "SI", 46.47990036, 15.68610001, "Maribor Airport"},
+	{"MBZ", "BR", -3.372169971, -57.72480011, "Maués Airport"},
+	{"MCA", "GN", 8.481857022, -9.525070847, "Macenta Airport"},
+	{"MCE", "US", 37.28469849, -120.5139999, "Merced Regional Macready Field"},
+	{"MCG", "US", 62.95289993, -155.6060028, "McGrath Airport"},
+	{"MCH", "EC", -3.268899918, -79.96160126, "General Manuel Serrano Airport"},
+	{"MCI", "US", 39.29759979, -94.71389771, "Kansas City International Airport"},
+	{"MCJ", "CO", 11.2325, -72.4901, "Jorge Isaac Airport"},
+	{"MCK", "US", 40.20629883, -100.5920029, "Mc Cook Ben Nelson Regional Airport"},
+	{"MCL", "US", 63.73260117, -148.9109955, "McKinley National Park Airport"},
+	{"MCN", "US", 32.69279861, -83.64920044, "Middle Georgia Regional Airport"},
+	{"MCO", "US", 28.42939949, -81.30899811, "Orlando International Airport"},
+	{"MCP", "BR", 0.050664, -51.07220078, "Alberto Alcolumbre Airport"},
+	{"MCQ", "HU", 48.13690186, 20.79140091, "Miskolc Airport"},
+	{"MCR", "GT", 17.06860924, -89.15222168, "Melchor de Mencos Airport"},
+	{"MCS", "AR", -30.2719, -57.6402, "Monte Caseros Airport"},
+	{"MCT", "OM", 23.59329987, 58.28440094, "Muscat International Airport"},
+	{"MCU", "FR", 46.22259903, 2.363960028, "Montluçon-Guéret Airport"},
+	{"MCV", "AU", -16.44249916, 136.0839996, "McArthur River Mine Airport"},
+	{"MCW", "US", 43.15779877, -93.33129883, "Mason City Municipal Airport"},
+	{"MCX", "RU", 42.81679916, 47.65230179, "Uytash Airport"},
+	{"MCY", "AU", -26.60330009, 153.0910034, "Sunshine Coast Airport"},
+	{"MCZ", "BR", -9.510809898, -35.79169846, "Zumbi dos Palmares Airport"},
+	{"MDB", "BZ", 17.00429916, -88.30419922, "Melinda Airport"},
+	{"MDC", "ID", 1.54926002, 124.9260025, "Sam Ratulangi Airport"},
+	{"MDE", "CO", 6.16454, -75.4231, "Jose Maria Córdova International Airport"},
+	{"MDG", "CN", 44.52410126, 129.5690002, "Mudanjiang Hailang International Airport"},
+	{"MDH", "US", 37.77809906, -89.2519989, "Southern Illinois Airport"},
+	{"MDI", "NG", 7.703879833, 8.613940239, "Makurdi Airport"},
+	{"MDJ", "US", 44.67020035, -121.1549988, "Madras Municipal Airport"},
+	{"MDK", "CD", 0.022600001, 18.2887001, "Mbandaka Airport"},
+	{"MDL", "MM", 21.70219994, 95.97789764, "Mandalay International Airport"},
+	{"MDO", "US", 59.44990158, -146.3070068, "Middleton Island Airport"},
+	{"MDP", "ID", -5.75, 140.3670044, "Mindiptana Airport"},
+	{"MDQ", "AR", -37.9342, -57.5733, "Ástor Piazzola International Airport"},
+	{"MDR", "US", 63.10601982, -154.719429, "Medfra Airport"},
+	{"MDS", "TC", 21.82602, -71.8025, "Middle Caicos Airport"},
+	{"MDT", "US", 40.19350052, -76.76339722, "Harrisburg International Airport"},
+	{"MDU", "PG", -6.147739887, 143.6569977, "Mendi Airport"},
+	{"MDW", "US", 41.7859993, -87.75240326, "Chicago Midway International Airport"},
+	{"MDX", "AR", -29.22139931, -58.08779907, "Mercedes Airport"},
+	{"MDY", "UM", 28.20170021, -177.3809967, "Henderson Field"},
+	{"MDZ", "AR", -32.83169937, -68.79290009, "El Plumerillo Airport"},
+	{"MEA", "BR", -22.34300041, -41.76599884, "Macaé Airport"},
+	{"MEB", "AU", -37.72809982, 144.9019928, "Melbourne Essendon Airport"},
+	{"MEC", "EC", -0.946078002, -80.67880249, "Eloy Alfaro International Airport"},
+	{"MED", "SA", 24.55340004, 39.70510101, "Prince Mohammad Bin Abdulaziz Airport"},
+	{"MEE", "NC", -21.4817009, 168.0379944, "Maré Airport"},
+	{"MEG", "AO", -9.525090218, 16.31240082, "Malanje Airport"},
+	{"MEH", "NO", 71.02970123, 27.82670021, "Mehamn Airport"},
+	{"MEI", "US", 32.33259964, -88.75189972, "Key Field"},
+	{"MEJ", "US", 41.62649918, -80.21469879, "Port Meadville Airport"},
+	{"MEK", "MA", 33.8791008, -5.515120029, "Bassatine Airport"},
+	{"MEL", "AU", -37.6733017, 144.8430023, "Melbourne International Airport"},
+	{"MEM", "US", 35.04240036, -89.97669983, "Memphis International Airport"},
+	{"MEN", "FR", 44.5021019, 3.532819986, "Mende-Brenoux Airport"},
+	{"MEP", "MY", 2.382999897, 103.8669968, "Mersing Airport"},
+	{"MEQ", "ID", 4.25, 96.21700287, "Seunagan Airport"},
+	{"MER", "UY", -33.25, -58.06700134, "Ricardo de Tomasi International Airport"},
+	{"MES", "ID", 3.559167, 98.671111, "Medan/Polonia International Airport"},
+	{"MET", "AU", -12.44419956, 142.6380005, "Moreton Airport"},
+	{"MEU", "BR", -0.889838994, -52.60219955, "Monte Dourado Airport"},
+	{"MEW", "CD", -4.849999905, 21.54999924, "Mweka Airport"},
+	{"MEX", "MX", 19.43630028, -99.07209778, "Licenciado Benito Juarez International Airport"},
+	{"MEY", "NP", 27.5774, 84.22875, "Meghauli Airport"},
+	{"MEZ", "ZA", -22.3560009, 29.98620033, "Musina(Messina) Airport"},
+	{"MFA", "TZ", -7.916999817, 39.66699982, "Mafia Island Airport"},
+	{"MFC", "LS", -29.80109978, 27.24360085, "Mafeteng Airport"},
+	{"MFE", "US", 26.17580032, -98.23860168, "Mc Allen Miller International Airport"},
+	{"MFF", "GA", -1.532999992, 13.2670002, "Moanda Airport"},
+	{"MFG", "PK", 34.3390007, 73.50859833, "Muzaffarabad Airport"},
+	{"MFJ", "FJ", -18.56669998, 179.951004, "Moala Airport"},
+	{"MFK", "TW", 26.2241993, 120.0029984, "Matsu Beigan Airport"},
+	{"MFM", "MO", 22.14959908, 113.5920029, "Macau International Airport"},
+	{"MFN", "NZ", -44.6733017, 167.9230042, "Milford Sound Airport"},
+	{"MFO", "PG", -5.577778, 151.792333, "Manguna Airport"},
+	{"MFP", "AU", -22.10000038, 137.9830017, "Manners Creek Airport"},
+	{"MFQ", "NE", 13.50249958, 7.126749992, "Maradi Airport"},
+	{"MFR", "US", 42.37419891, -122.8730011, "Rogue Valley International Medford Airport"},
+	{"MFS", "CO", 1.35, -71.9444, "Miraflores Airport"},
+	{"MFT", "PE", -13.11666679, -72.56667328, "Machu Pichu Airport"},
+	{"MFU", "ZM", -13.25889969, 31.93659973, "Mfuwe Airport"},
+	{"MFX", "FR", 45.4068985, 6.580560207, "Méribel Airport"},
+	{"MGA", "NI", 12.14150047, -86.16819763, "Augusto C. Sandino (Managua) International Airport"},
+	{"MGB", "AU", -37.74560165, 140.7850037, "Mount Gambier Airport"},
+	{"MGD", "BO", -13.26074838, -64.06076431, "Magdalena Airport"},
+	{"MGF", "BR", -23.4794445, -52.01222229, "Regional de Maringá - Sílvio Nane Junior Airport"},
+	{"MGH", "ZA", -30.85740089, 30.34300041, "Margate Airport"},
+	{"MGK", "MM", 20.29669952, 98.89890289, "Mong Tong Airport"},
+	{"MGL", "DE", 51.23027802, 6.504444122, "Mönchengladbach Airport"},
+	{"MGM", "US", 32.30059814, -86.39399719, "Montgomery Regional (Dannelly Field) Airport"},
+	{"MGN", "CO", 9.28474, -74.8461, "Baracoa Airport"},
+	{"MGQ", "SO", 2.01444006, 45.30469894, "Aden Adde International Airport"},
+	{"MGS", "CK", -21.89598656, -157.906662, "Mangaia Island Airport"},
+	{"MGT", "AU", -12.09440041, 134.8939972, "Milingimbi Airport"},
+	{"MGU", "MM", 18.8458004, 93.68890381, "Manaung Airport"},
+	{"MGV", "AU", -18.62170029, 126.8830032, "Margaret River (Station) Airport"},
+	{"MGW", "US", 39.64289856, -79.91629791, "Morgantown Municipal Walter L. Bill Hart Field"},
+	{"MGX", "GA", -2.433000088, 11.0, "Moabi Airport"},
+	{"MGZ", "MM", 12.43980026, 98.62149811, "Myeik Airport"},
+	{"MHA", "GY", 5.277490139, -59.15110016, "Mahdia Airport"},
+	{"MHC", "CL", -42.340388, -73.715693, "Mocopulli Airport"},
+	{"MHD", "IR", 36.23519897, 59.64099884, "Mashhad International Airport"},
+	{"MHG", "DE", 49.47305679, 8.514166832, "Mannheim-City Airport"},
+	{"MHH", "BS", 26.51140022, -77.08350372, "Marsh Harbour International Airport"},
+	{"MHI", "DJ", 11.71666718, 43.20000076, "Moucha Airport"},
+	{"MHK", "US", 39.14099884, -96.67079926, "Manhattan Regional Airport"},
+	{"MHO", "AU", -17.05500031, 125.7099991, "Mount House Airport"},
+	{"MHP", "BY", 53.86449814, 27.53969955, "Minsk 1 Airport"},
+	{"MHQ", "FI", 60.12220001, 19.89819908, "Mariehamn Airport"},
+	{"MHT", "US", 42.93259811, -71.43569946, "Manchester Airport"},
+	{"MHU", "AU", -37.04750061, 147.3339996, "Mount Hotham Airport"},
+	{"MHV", "US", 35.05939865, -118.1520004, "Mojave Airport"},
+	{"MHW", "BO", -19.82699966, -63.96099854, "Monteagudo Airport"},
+	{"MHX", "CK", -10.3767004, -161.0019989, "Manihiki Island Airport"},
+	{"MHY", "PG", -8.714111111, 141.6444444, "Morehead Airport"},
+	{"MHZ", "GB", 52.36190033, 0.486405998, "RAF Mildenhall"},
+	{"MIA", "US", 25.79319954, -80.29060364, "Miami International Airport"},
+	{"MID", "MX", 20.93700027, -89.65769959, "Licenciado Manuel Crescencio Rejon Int Airport"},
+	{"MIE", "US", 40.24229813, -85.39589691, "Delaware County Johnson Field"},
+	{"MIG", "CN", 31.42810059, 104.7409973, "Mianyang Airport"},
+	{"MIH", "AU", -14.79139996, 125.8239975, "Mitchell Plateau Airport"},
+	{"MII", "BR", -22.19689941, -49.92639923, "Marília Airport"},
+	{"MIJ", "MH", 6.083330154, 171.7330017, "Mili Island Airport"},
+	{"MIK", "FI", 61.68659973, 27.20179939, "Mikkeli Airport"},
+	{"MIM", "AU", -36.90859985, 149.901001, "Merimbula Airport"},
+	{"MIN", "AU", -32.84329987, 135.1450043, "Minnipa Airport"},
+	{"MIP", "IL", 30.77610016, 34.66669846, "Ramon Air Base"},
+	{"MIR", "TN", 35.7580986, 10.75469971, "Monastir Habib Bourguiba International Airport"},
+	{"MIS", "PG", -10.6892004, 152.8379974, "Misima Island Airport"},
+	{"MIU", "NG", 11.85529995, 13.08090019, "Maiduguri International Airport"},
+	{"MIY", "AU", -18.8093, 137.0815, "Mittebah Airport"},
+	{"MIZ", "AU", -14.0533, 134.0942, "Mainoru Airstrip"},
+	{"MJA", "MG", -21.42610525, 44.31650877, "Manja Airport"},
+	{"MJB", "MH", 10.2833, 170.869, "Mejit Atoll Airport"},
+	{"MJC", "CI", 7.272069931, -7.587359905, "Man Airport"},
+	{"MJD", "PK", 27.33519936, 68.14309692, "Moenjodaro Airport"},
+	{"MJE", "MH", 7.76291, 168.26576, "Majkin Airport"},
+	{"MJF", "NO", 65.78399658, 13.21490002, "Mosjøen Airport, Kjærstad"},
+	{"MJG", "CU", 22.23080063, -79.06220245, "Mayajigua Airport"},
+	{"MJI", "LY", 32.89410019, 13.27600002, "Mitiga Airport"},
+	{"MJL", "GA", -1.84513998, 11.05669975, "Mouilla Ville Airport"},
+	{"MJM", "CD", -6.121240139, 23.56900024, "Mbuji Mayi Airport"},
+	{"MJN", "MG", -15.66684174, 46.35123253, "Amborovy Airport"},
+	{"MJP", "AU", -34.26530075, 116.1399994, "Manjimup Airport"},
+	{"MJR", "AR", -38.2271, -57.8697, "Miramar Airport"},
+	{"MJT", "GR", 39.05670166, 26.59830093, "Mytilene International Airport"},
+	{"MJU", "ID", -2.583333, 119.033333, "Tampa Padang Airport"},
+	{"MJV", "ES", 37.77500153, -0.812389016, "San Javier Airport"},
+	{"MJY", "ID", -2.733330011, 103.5670013, "Mangunjaya Airport"},
+	{"MJZ", "RU", 62.53469849, 114.0390015, "Mirny Airport"},
+	{"MKA", "CZ", 49.92279816, 12.72469997, "Mariánské Lázně Airport"},
+	{"MKB", "GA", 1.01699996, 13.93299961, "Mekambo Airport"},
+	{"MKC", "US", 39.12319946, -94.59279633, "Charles B. Wheeler Downtown Airport"},
+	{"MKE", "US", 42.94720078, -87.89659882, "General Mitchell International Airport"},
+	{"MKG", "US", 43.16949844, -86.23819733, "Muskegon County Airport"},
+	{"MKH", "LS", -29.28179932, 29.07279968, "Mokhotlong Airport"},
+	{"MKI", "CF", 5.333010197, 25.93190002, "M\"Boki Airport"},
+	{"MKJ", "CG", -0.017000001, 15.58300018, "Makoua Airport"},
+	{"MKK", "US", 21.1529007, -157.095993, "Molokai Airport"},
+	{"MKL", "US", 35.59989929, -88.91560364, "Mc Kellar Sipes Regional Airport"},
+	{"MKM", "MY", 2.906389952, 112.0800018, "Mukah Airport"},
+	{"MKN", "PG", -4.023430556, 153.6572778, "Malekolon Airport"},
+	{"MKP", "PF", -16.58390045, -143.6580048, "Makemo Airport"},
+	{"MKQ", "ID", -8.520290375, 140.4179993, "Mopah Airport"},
+	{"MKR", "AU", -26.61170006, 118.5479965, "Meekatharra Airport"},
+	{"MKU", "GA", 0.579210997, 12.89089966, "Makokou Airport"},
+	{"MKW", "ID", -0.891833007, 134.048996, "Rendani Airport"},
+	{"MKY", "AU", -21.17169952, 149.1799927, "Mackay Airport"},
+	{"MKZ", "MY", 2.263360024, 102.2519989, "Malacca Airport"},
+	{"MLA", "MT", 35.857498, 14.4775, "Malta International Airport"},
+	{"MLB", "US", 28.10280037, -80.64530182, "Melbourne International Airport"},
+	{"MLE", "MV", 4.191830158, 73.52909851, "Malé International Airport"},
+	{"MLG", "ID", -7.926559925, 112.7149963, "Abdul Rachman Saleh Airport"},
+	{"MLI", "US", 41.44850159, -90.50749969, "Quad City International Airport"},
+	{"MLM", "MX", 19.84989929, -101.0250015, "General Francisco J. Mujica International Airport"},
+	{"MLN", "ES", 35.27980042, -2.956259966, "Melilla Airport"},
+	{"MLO", "GR", 36.69689941, 24.4769001, "Milos Airport"},
+	{"MLP", "PH", 7.617219925, 124.0589981, "Malabang Airport"},
+	{"MLQ", "PG", -8.071388889, 146.1554722, "Malalaua Airport"},
+	{"MLR", "AU", -37.58359909, 140.3659973, "Millicent Airport"},
+	{"MLS", "US", 46.4280014, -105.8860016, "Frank Wiley Field"},
+	{"MLU", "US", 32.51089859, -92.03769684, "Monroe Regional Airport"},
+	{"MLV", "AU", -13.0649, 142.4536, "Merluna Airport"},
+	{"MLW", "LR", 6.289060116, -10.75870037, "Spriggs Payne Airport"},
+	{"MLX", "TR", 38.43529892, 38.0909996, "Malatya Erhaç Airport"},
+	{"MLY", "US", 64.99759674, -150.6439972, "Manley Hot Springs Airport"},
+	{"MLZ", "UY", -32.33789825, -54.21670151, "Cerro Largo International Airport"},
+	{"MMB", "JP", 43.88059998, 144.1640015, "Memanbetsu Airport"},
+	{"MMC", "MX", 22.74317741, -99.01737213, "Ciudad Mante National Airport"},
+	{"MMD", "JP", 25.8465004, 131.2630005, "Minami-Daito Airport"},
+	{"MME", "GB", 54.50920105, -1.429409981, "Durham Tees Valley Airport"},
+	{"MMF", "CM", 5.704170227, 9.306389809, "Mamfe Airport"},
+	{"MMG", "AU", -28.11610031, 117.8420029, "Mount Magnet Airport"},
+	{"MMH", "US", 37.62409973, -118.8379974, "Mammoth Yosemite Airport"},
+	{"MMJ", "JP", 36.16680145, 137.9230042, "Matsumoto Airport"},
+	{"MMK", "RU", 68.78170013, 32.75080109, "Murmansk Airport"},
+	{"MMM", "AU", -22.80249977, 148.7050018, "Middlemount Airport"},
+	{"MMO", "CV", 15.1559, -23.21369934, "Maio Airport"},
+	{"MMP", "CO", 9.26878, -74.6523, "Cicuco Airport"},
+	{"MMQ", "ZM", -8.85916996, 31.33639908, "Mbala Airport"},
+	{"MMU", "US", 40.79940033, -74.41490173, "Morristown Municipal Airport"},
+	{"MMV", "PG", -1.394444444, 144.1711111, "Mal Airport"},
+	{"MMX", "SE", 55.53630536, 13.37619781, "Malmö Sturup Airport"},
+	{"MMY", "JP", 24.78280067, 125.2949982, "Miyako Airport"},
+	{"MMZ", "AF", 35.93080139, 64.7609024, "Maimana Airport"},
+	{"MNA", "ID", 4.006939888, 126.6729965, "Melangguane Airport"},
+	{"MNB", "CD", -5.930860043, 12.35179996, "Muanda Airport"},
+	{"MNC", "MZ", -14.48820019, 40.71220016, "Nacala Airport"},
+	{"MNE", "AU", -28.00919914, 138.6569977, "Mungeranie Airport"},
+	{"MNF", "FJ", -17.67309952, 177.0980072, "Mana Island Airport"},
+	{"MNG", "AU", -12.05609989, 134.2339935, "Maningrida Airport"},
+	{"MNH", "LK", 8.04981041, 80.98139954, "Hingurakgoda Air Force Base"},
+	{"MNI", "MS", 16.79140091, -62.19329834, "John A. Osborne Airport"},
+	{"MNJ", "MG", -21.20179939, 48.35829926, "Mananjary Airport"},
+	{"MNK", "KI", 1.003610015, 173.0310059, "Maiana Airport"},
+	{"MNL", "PH", 14.50860024, 121.0199966, "Ninoy Aquino International Airport"},
+	{"MNO", "CD", -7.288889885, 27.39439964, "Manono Airport"},
+	{"MNQ", "AU", -24.88579941, 151.1000061, "Monto Airport"},
+	{"MNR", "ZM", -15.25450039, 23.16230011, "Mongu Airport"},
+	{"MNS", "ZM", -11.13700008, 28.87260056, "Mansa Airport"},
+	{"MNU", "MM", 16.44470024, 97.66069794, "Mawlamyine Airport"},
+	{"MNW", "AU", -22.44400024, 135.1990051, "Macdonald Downs Airport"},
+	{"MNX", "BR", -5.81137991, -61.27830124, "Manicoré Airport"},
+	{"MNY", "SB", -7.416940212, 155.5650024, "Mono Airport"},
+	{"MOA", "CU", 20.65390015, -74.92220306, "Orestes Acosta Airport"},
+	{"MOB", "US", 30.69120026, -88.24279785, "Mobile Regional Airport"},
+	{"MOC", "BR", -16.70689964, -43.81890106, "Mário Ribeiro Airport"},
+	{"MOD", "US", 37.62580109, -120.9540024, "Modesto City Co-Harry Sham Field"},
+	{"MOE", "MM", 23.09250069, 96.64530182, "Momeik Airport"},
+	{"MOF", "ID", -8.640649796, 122.2369995, "Maumere(Wai Oti) Airport"},
+	{"MOG", "MM", 20.51679993, 99.25679779, "Mong Hsat Airport"},
+	{"MOI", "CK", -19.84250069, -157.7030029, "Mitiaro Island Airport"},
+	{"MOJ", "SR", 5.6076, -54.4003, "Moengo Airstrip"},
+	{"MOL", "NO", 62.74470139, 7.262499809, "Molde Airport"},
+	{"MOM", "MR", 17.75, -12.5, "Letfotar Airport"},
+	{"MON", "NZ", -43.76499939, 170.1329956, "Mount Cook Airport"},
+	{"MOO", "AU", -28.09939957, 140.1970062, "Moomba Airport"},
+	{"MOQ", "MG", -20.28470039, 44.31760025, "Morondava Airport"},
+	{"MOT", "US", 48.25939941, -101.2799988, "Minot International Airport"},
+	{"MOU", "US", 62.09540176, -163.6820068, "Mountain Village Airport"},
+	{"MOV", "AU", -22.05780029, 148.0769959, "Moranbah Airport"},
+	{"MOZ", "PF", -17.48999977, -149.7619934, "Moorea Airport"},
+	{"MPA", "NA", -17.63439941, 24.17670059, "Katima Mulilo Airport"},
+	{"MPC", "ID", -2.541860104, 101.0879974, "Muko Muko Airport"},
+	{"MPD", "PK", 25.68250084, 69.07279968, "Mirpur Khas Air Base"},
+	{"MPF", "PG", -7.979, 143.1694, "Mapoda Airport"},
+	{"MPG", "PG", -6.532222222, 147.6511667, "Makini Airport"},
+	{"MPH", "PH", 11.92450047, 121.9540024, "Godofredo P. Ramos Airport"},
+	{"MPL", "FR", 43.57619858, 3.963010073, "Montpellier-Méditerranée Airport"},
+	{"MPM", "MZ", -25.92079926, 32.57260132, "Maputo Airport"},
+	{"MPN", "FK", -51.82279968, -58.44720078, "Mount Pleasant Airport"},
+	{"MPT", "ID", -8.167739868, 125.0090027, "Maliana airport"},
+	{"MPU", "PG", -2.811388889, 151.9911111, "Mapua(Mabua) Airport"},
+	{"MPV", "US", 44.20349884, -72.56230164, "Edward F Knapp State Airport"},
+	{"MPW", "UA", 47.0760994, 37.44960022, "Mariupol International Airport"},
+	{"MPX", "PG", -4.903055556, 141.6208333, "Miyanmin Airport"},
+	{"MPY", "GF", 3.657500029, -54.03720093, "Maripasoula Airport"},
+	{"MQA", "AU", -19.73830032, 120.8379974, "Mandora Airport"},
+	{"MQC", "PM", 47.09550095, -56.38029861, "Miquelon Airport"},
+	{"MQD", "AR", -41.24309921, -68.70780182, "Maquinchao Airport"},
+	{"MQE", "AU", -22.80579948, 137.2510071, "Marqua Airport"},
+	{"MQF", "RU", 53.39310074, 58.75569916, "Magnitogorsk International Airport"},
+	{"MQH", "BR", -13.54909992, -48.19530106, "Minaçu Airport"},
+	{"MQK", "BO", -16.33919907, -58.40190125, "San Matías Airport"},
+	{"MQL", "AU", -34.22919846, 142.0859985, "Mildura Airport"},
+	{"MQM", "TR", 37.22330093, 40.63169861, "Mardin Airport"},
+	{"MQN", "NO", 66.36389923, 14.30140018, "Mo i Rana Airport, Røssvoll"},
+	{"MQO", "PG", -8.709166667, 142.6497222, "Malam Airport"},
+	{"MQP", "ZA", -25.38319969, 31.10560036, "Kruger Mpumalanga International Airport"},
+	{"MQQ", "TD", 8.624409676, 16.07139969, "Moundou Airport"},
+	{"MQS", "VC", 12.88790035, -61.18019867, "Mustique Airport"},
+	{"MQT", "US", 46.35359955, -87.395401, "Sawyer International Airport"},
+	{"MQU", "CO", 5.21256, -74.8836, "Jose Celestino Mutis Airport"},
+	{"MQV", "DZ", 35.90879822, 0.149382994, "Mostaganem Airport"},
+	{"MQX", "ET", 13.4673996, 39.53350067, "Mekele Airport"},
+	{"MQZ", "AU", -33.93059921, 115.0999985, "Margaret River Airport"},
+	{"MRA", "LY", 32.32500076, 15.06099987, "Misratah Airport"},
+	{"MRD", "VE", 8.58207798, -71.16104126, "Alberto Carnevalli Airport"},
+	{"MRE", "KE", -1.406111002, 35.00805664, "Mara Serena Lodge Airstrip"},
+	{"MRG", "AU", -17.06920052, 145.4190063, "Mareeba Airport"},
+	{"MRH", "PG", -4.3615, 141.785, "May River Airstrip"},
+	{"MRI", "US", 61.21350098, -149.8439941, "Merrill Field"},
+	{"MRO", "NZ", -40.97330093, 175.6340027, "Hood Airport"},
+	{"MRP", "AU", -27.33329964, 133.6269989, "Marla Airport"},
+	{"MRQ", "PH", 13.36100006, 121.8259964, "Marinduque Airport"},
+	{"MRR", "EC", -4.378230095, -79.94100189, "Jose Maria Velasco Ibarra Airport"},
+	{"MRS", "FR", 43.43927192, 5.221424103, "Marseille Provence Airport"},
+	{"MRU", "MU", -20.43020058, 57.68360138, "Sir Seewoosagur Ramgoolam International Airport"},
+	{"MRV", "RU", 44.22510147, 43.08190155, "Mineralnyye Vody Airport"},
+	{"MRW", "DK", 54.69929886, 11.44009972, "Lolland Falster Maribo Airport"},
+	{"MRX", "IR", 30.55620003, 49.15190125, "Mahshahr Airport"},
+	{"MRY", "US", 36.5870018, -121.8430023, "Monterey Peninsula Airport"},
+	{"MRZ", "AU", -29.49889946, 149.8450012, "Moree Airport"},
+	{"MSA", "CA", 53.44139862, -91.76280212, "Muskrat Dam Airport"},
+	{"MSC", "US", 33.46080017, -111.7279968, "Falcon Field"},
+	{"MSE", "GB", 51.34220123, 1.346109986, "Kent International Airport"},
+	{"MSH", "OM", 20.67539978, 58.89049911, "Masirah Air Base"},
+	{"MSI", "ID", -5.583330154, 114.4329987, "Masalembo Airport"},
+	{"MSJ", "JP", 40.70320129, 141.3679962, "Misawa Air Base"},
+	{"MSL", "US", 34.74530029, -87.61019897, "Northwest Alabama Regional Airport"},
+	{"MSM", "CD", -4.782999992, 17.85000038, "Masi Manimba Airport"},
+	{"MSN", "US", 43.13990021, -89.33750153, "Dane County Regional Truax Field"},
+	{"MSO", "US", 46.91630173, -114.0910034, "Missoula International Airport"},
+	{"MSP", "US", 44.88199997, -93.22180176, "Minneapolis-St Paul International/Wold-Chamberlain Airport"},
+	{"MSQ", "BY", 53.88249969, 28.03070068, "Minsk International Airport"},
+	{"MSR", "TR", 38.74779892, 41.66120148, "Muş Airport"},
+	{"MSS", "US", 44.93579865, -74.84559631, "Massena International Richards Field"},
+	{"MST", "NL", 50.9117012, 5.770140171, "Maastricht Aachen Airport"},
+	{"MSU", "LS", -29.46229935, 27.55249977, "Moshoeshoe I International Airport"},
+	{"MSW", "ER", 15.67000008, 39.37009811, "Massawa International Airport"},
+	{"MSX", "CG", -2.950000048, 12.69999981, "Mossendjo Airport"},
+	{"MSY", "US", 29.99340057, -90.25800323, "Louis Armstrong New Orleans International Airport"},
+	{"MSZ", "AO", -15.26119995, 12.14680004, "Namibe Airport"},
+	{"MTA", "NZ", -37.7344017, 175.7420044, "Matamata Glider Airport"},
+	{"MTB", "CO", 7.97174, -75.4325, "Montelibano Airport"},
+	{"MTD", "AU", -16.97830009, 130.5549927, "Mount Sanford Station Airport"},
+	{"MTE", "BR", -1.995800018, -54.07419968, "Monte Alegre Airport"},
+	{"MTF", "ET", 6.9571, 35.5547, "Mizan Teferi Airport"},
+	{"MTI", "CV", 15.04500008, -24.33919907, "Mosteiros Airport"},
+	{"MTJ", "US", 38.50979996, -107.8939972, "Montrose Regional Airport"},
+	{"MTK", "KI", 3.374439955, 172.9920044, "Makin Island Airport"},
+	{"MTL", "AU", -32.70330048, 151.4880066, "Maitland Airport"},
+	{"MTQ", "AU", -26.48329926, 147.9369965, "Mitchell Airport"},
+	{"MTR", "CO", 8.82374, -75.8258, "Los Garzones Airport"},
+	{"MTS", "SZ", -26.52899933, 31.30750084, "Matsapha Airport"},
+	{"MTT", "MX", 18.10339928, -94.58070374, "Minatitlán/Coatzacoalcos National Airport"},
+	{"MTU", "MZ", -13.1218996, 39.05279922, "Montepuez Airport"},
+	{"MTV", "VU", -13.66600037, 167.7120056, "Mota Lava Airport"},
+	{"MTX", "US", 64.80680084, -147.7619934, "Metro Field"},
+	{"MTY", "MX", 25.7784996, -100.1070023, "General Mariano Escobedo International Airport"},
+	{"MTZ", "IL", 31.32819939, 35.3885994, "Bar Yehuda Airfield"},
+	{"MUA", "SB", -8.327969551, 157.2630005, "Munda Airport"},
+	{"MUB", "BW", -19.97260094, 23.43110085, "Maun Airport"},
+	{"MUC", "DE", 48.35380173, 11.78610039, "Munich International Airport"},
+	{"MUD", "MZ", -11.6729002, 39.56309891, "Mueda Airport"},
+	{"MUE", "US", 20.00130081, -155.6679993, "Waimea Kohala Airport"},
+	{"MUF", "ID", -7.3147, 140.5668, "Muting Airport"},
+	{"MUG", "MX", 26.89731026, -111.95755, "Mulege Airport"},
+	{"MUH", "EG", 31.3253994, 27.22170067, "Mersa Matruh Airport"},
+	{"MUJ", "ET", 5.8646, 35.7485, "Mui River Airport"},
+	{"MUK", "CK", -20.13610077, -157.3450012, "Mauke Airport"},
+	{"MUM", "KE", 0.333330005, 34.48333359, "Mumias Airport"},
+	{"MUN", "VE", 9.754529953, -63.1473999, "Maturín Airport"},
+	{"MUP", "AU", -25.86000061, 131.6499939, "Mulga Park Airport"},
+	{"MUQ", "AU", -20.65889931, 120.0670013, "Muccan Station Airport"},
+	{"MUR", "MY", 4.178979874, 114.3290024, "Marudi Airport"},
+	{"MUS", "JP", 24.28969955, 153.9790039, "Minami Torishima Airport"},
+	{"MUW", "DZ", 35.20769882, 0.147141993, "Ghriss Airport"},
+	{"MUX", "PK", 30.20319939, 71.4190979, "Multan International Airport"},
+	{"MUY", "CG", -4.014873981, 13.96611214, "Mouyondzi Airport"},
+	{"MUZ", "TZ", -1.503, 33.8021, "Musoma Airport"},
+	{"MVA", "IS", 65.65579987, -16.91810036, "Reykjahlíð Airport"},
+	{"MVB", "GA", -1.656159997, 13.43799973, "M\"Vengue El Hadj Omar Bongo Ondimba International Airport"},
+	{"MVC", "US", 31.45800018, -87.35099792, "Monroe County Airport"},
+	{"MVD", "UY", -34.83840179, -56.03079987, "Carrasco International /General C L Berisso Airport"},
+	{"MVF", "BR", -5.201920033, -37.36429977, "Dix-Sept Rosado Airport"},
+	{"MVK", "AU", -28.3477993, 138.6499939, "Mulka Airport"},
+	{"MVL", "US", 44.5345993, -72.61399841, "Morrisville Stowe State Airport"},
+	{"MVO", "TD", 12.16699982, 18.67499924, "Mongo Airport"},
+	{"MVP", "CO", 1.25366, -70.2339, "Fabio Alberto Leon Bentley Airport"},
+	{"MVQ", "BY", 53.95489883, 30.0951004, "Mogilev Airport"},
+	{"MVR", "CM", 10.4513998, 14.25739956, "Salak Airport"},
+	{"MVS", "BR", -18.0489006, -39.86420059, "Mucuri Airport"},
+	{"MVT", "PF", -14.86810017, -148.7169952, "Mataiva Airport"},
+	{"MVV", "FR", 45.82080078, 6.652219772, "Megève Airport"},
+	{"MVX", "GA", 2.150000095, 12.13300037, "Minvoul Airport"},
+	{"MVY", "US", 41.39310074, -70.61430359, "Martha\"s Vineyard Airport"},
+	{"MVZ", "ZW", -20.05529976, 30.85910034, "Masvingo International Airport"},
+	{"MWA", "US", 37.75500107, -89.01110077, "Williamson County Regional Airport"},
+	{"MWB", "AU", -29.20170021, 116.0220032, "Morawa Airport"},
+	{"MWD", "PK", 32.56309891, 71.5707016, "Mianwali Air Base"},
+	{"MWE", "SD", 18.45000076, 31.83300018, "Merowe Airport"},
+	{"MWF", "VU", -15.0, 168.0829926, "Maewo-Naone Airport"},
+	{"MWG", "PG", -6.9736, 145.8849, "Marawaka Airport"},
+	{"MWI", "PG", -5.13, 143.4829, "Maramuni Airport"},
+	{"MWJ", "GY", 7.488112, -60.184777, "Matthews Ridge Airport"},
+	{"MWK", "ID", 3.348119974, 106.2580032, "Tarempa Airport"},
+	{"MWL", "US", 32.78160095, -98.06020355, "Mineral Wells Airport"},
+	{"MWN", "TZ", -3.5, 33.61700058, "Mwadui Airport"},
+	{"MWP", "NP", 28.0, 85.33300018, "Mountain Airport"},
+	{"MWQ", "MM", 20.16559982, 94.94139862, "Magway Airport"},
+	{"MWX", "KR", 34.991406, 126.382814, "Muan International Airport"},
+	{"MWY", "AU", -17.32889938, 141.8860016, "Miranda Downs Airport"},
+	{"MWZ", "TZ", -2.444489956, 32.93270111, "Mwanza Airport"},
+	{"MXB", "ID", -2.558039904, 120.3239975, "Andi Jemma Airport"},
+	{"MXD", "AU", -23.36669922, 139.6670074, "Marion Downs Airport"},
+	{"MXH", "PG", -6.363329887, 143.2380066, "Moro Airport"},
+	{"MXI", "PH", 6.949369907, 126.2730026, "Mati National Airport"},
+	{"MXJ", "NG", 9.652170181, 6.462259769, "Minna Airport"},
+	{"MXK", "PG", -6.471666667, 147.4411389, "Mindik Airport"},
+	{"MXL", "MX", 32.63059998, -115.2419968, "General Rodolfo Sánchez Taboada International Airport"},
+	{"MXM", "MG", -21.75390053, 43.37549973, "Morombe Airport"},
+	{"MXN", "FR", 48.60319901, -3.815779924, "Morlaix-Ploujean Airport"},
+	{"MXP", "IT", 45.63059998, 8.728110313, "Malpensa International Airport"},
+	{"MXS", "WS", -13.74230003, -172.2579956, "Maota Airport"},
+	{"MXT", "MG", -18.04999924, 44.03300095, "Maintirano Airport"},
+	{"MXU", "AU", -28.47500038, 115.5169983, "Mullewa Airport"},
+	{"MXV", "MN", 49.66329956, 100.098999, "Mörön Airport"},
+	{"MXW", "MN", 45.73809814, 106.2689972, "Mandalgobi Airport"},
+	{"MXX", "SE", 60.957901, 14.51140022, "Mora Airport"},
+	{"MXZ", "CN", 24.35000038, 116.1330032, "Meixian Airport"},
+	{"MYA", "AU", -35.89780045, 150.1439972, "Moruya Airport"},
+	{"MYB", "GA", -3.4584198, 10.67407608, "Mayumba Airport"},
+	{"MYC", "VE", 10.24997807, -67.64942169, "Escuela Mariscal Sucre Airport"},
+	{"MYD", "KE", -3.229310036, 40.10169983, "Malindi Airport"},
+	{"MYE", "JP", 34.07360077, 139.5599976, "Miyakejima Airport"},
+	{"MYG", "BS", 22.37949944, -73.0134964, "Mayaguana Airport"},
+	{"MYI", "AU", -9.916669846, 144.0549927, "Murray Island Airport"},
+	{"MYJ", "JP", 33.82720184, 132.6999969, "Matsuyama Airport"},
+	{"MYM", "GY", 4.483329773, -59.68330002, "Monkey Mountain Airport"},
+	{"MYN", "YE", 15.46920013, 45.32690048, "Mareb Airport"},
+	{"MYO", "AU", -18.12470055, 124.2720032, "Camballin Airport"},
+	{"MYP", "TM", 37.61940002, 61.89670181, "Mary Airport"},
+	{"MYQ", "IN", 12.30720043, 76.64969635, "Mysore Airport"},
+	{"MYR", "US", 33.67969894, -78.92829895, "Myrtle Beach International Airport"},
+	{"MYT", "MM", 25.38360023, 97.35189819, "Myitkyina Airport"},
+	{"MYU", "US", 60.37139893, -166.2709961, "Mekoryuk Airport"},
+	{"MYW", "TZ", -10.33909988, 40.18180084, "Mtwara Airport"},
+	{"MYX", "PG", -7.211666667, 146.0193611, "Menyamya Airport"},
+	{"MYY", "MY", 4.32201004, 113.9869995, "Miri Airport"},
+	{"MYZ", "MW", -14.08360004, 34.91970062, "Monkey Bay Airport"},
+	{"MZB", "MZ", -11.36180019, 40.35490036, "Mocímboa da Praia Airport"},
+	{"MZC", "GA", 0.782999992, 11.55000019, "Mitzic Airport"},
+	{"MZD", "EC", -2.733330011, -78.3167038, "Méndez Airport"},
+	{"MZE", "BZ", 17.27846, -89.0238, "Manatee Airport"},
+	{"MZG", "TW", 23.56870079, 119.6279984, "Makung Airport"},
+	{"MZH", "TR", 40.82939911, 35.52199936, "Amasya Merzifon Airport"},
+	{"MZI", "ML", 14.51280022, -4.079559803, "Mopti Airport"},
+	{"MZJ", "US", 32.51060104, -111.3280029, "Pinal Airpark"},
+	{"MZK", "KI", 2.058609962, 173.2709961, "Marakei Airport"},
+	{"MZL", "CO", 5.0296, -75.4647, "La Nubia Airport"},
+	{"MZM", "FR", 49.07170105, 6.131669998, "Metz-Frescaty (BA 128) Air Base"},
+	{"MZO", "CU", 20.28809929, -77.08920288, "Sierra Maestra Airport"},
+	{"MZP", "NZ", -41.12329865, 172.9889984, "Motueka Airport"},
+	{"MZQ", "ZA", -27.62610054, 32.04430008, "Mkuze Airport"},
+	{"MZR", "AF", 36.70690155, 67.20970154, "Mazar I Sharif Airport"},
+	{"MZS", "MY", 4.616666794, 118.1500015, "Mostyn Airport"},
+	{"MZT", "MX", 23.16139984, -106.2659988, "General Rafael Buelna International Airport"},
+	{"MZU", "IN", 26.11910057, 85.31369781, "Muzaffarpur Airport"},
+	{"MZV", "MY", 4.04832983, 114.8050003, "Mulu Airport"},
+	{"MZW", "DZ", 33.53590012, -0.242353007, "Mecheria Airport"},
+	{"MZY", "ZA", -34.15829849, 22.05859947, "Mossel Bay Airport"},
+	{"MZZ", "US", 40.48989868, -85.67970276, "Marion Municipal Airport"},
+	{"NAA", "AU", -30.31920052, 149.8269959, "Narrabri Airport"},
+	{"NAC", "AU", -36.98529816, 140.7250061, "Naracoorte Airport"},
+	{"NAD", "CO", 2.566667, -67.583333, "Macanal Airport"},
+	{"NAE", "BJ", 10.31700039, 1.383000016, "Natitingou Airport"},
+	{"NAF", "ID", 2.723050117, 117.1259995, "Banaina Airport"},
+	{"NAG", "IN", 21.09219933, 79.04720306, "Dr. Babasaheb Ambedkar International Airport"},
+	{"NAH", "ID", 3.683209896, 125.5279999, "Naha Airport"},
+	{"NAI", "GY", 3.959439993, -59.12419891, "Annai Airport"},
+	{"NAJ", "AZ", 39.18880081, 45.45840073, "Nakhchivan Airport"},
+	{"NAK", "TH", 14.94950008, 102.3130035, "Nakhon Ratchasima Airport"},
+	{"NAL", "RU", 43.51290131, 43.63660049, "Nalchik Airport"},
+	{"NAM", "ID", -3.235569954, 127.0999985, "Namlea Airport"},
+	{"NAN", "FJ", -17.7553997, 177.4429932, "Nadi International Airport"},
+	{"NAO", "CN", 30.79545, 106.1626, "Nanchong Airport"},
+	{"NAP", "IT", 40.88600159, 14.29080009, "Naples International Airport"},
+	{"NAQ", "GL", 77.48860168, -69.38870239, "Qaanaaq Airport"},
+	{"NAR", "CO", 6.21002, -74.5906, "Puerto Nare Airport"},
+	{"NAS", "BS", 25.03899956, -77.46620178, "Lynden Pindling International Airport"},
+	{"NAT", "BR", -5.768056, -35.376111, "Governador Aluízio Alves International Airport"},
+	{"NAU", "PF", -14.17679977, -141.2669983, "Napuka Island Airport"},
+	{"NAV", "TR", 38.77190018, 34.53450012, "Nevşehir Kapadokya International Airport"},
+	{"NAW", "TH", 6.519919872, 101.7429962, "Narathiwat Airport"},
+	{"NAY", "CN", 39.78279877, 116.3880005, "Beijing Nanyuan Airport"},
+	{"NAZ", "SB", -10.6758, 162.2049, "Nana Airport"},
+	{"NBA", "PG", -6.2412, 145.2424, "Nambaiyufa Airport"},
+	{"NBB", "CO", 3.483333, -69.8, "Barranco Minas Airport"},
+	{"NBC", "RU", 55.56470108, 52.09249878, "Begishevo Airport"},
+	{"NBE", "TN", 36.075833, 10.438611, "Enfidha - Hammamet International Airport"},
+	{"NBG", "US", 29.82530022, -90.03500366, "New Orleans NAS JRB/Alvin Callender Field"},
+	{"NBH", "AU", -30.64999962, 153.0, "Nambucca Heads Airport"},
+	{"NBL", "PA", 9.44960022, -78.97949982, "San Blas Airport"},
+	{"NBO", "KE", -1.319239974, 36.92779922, "Jomo Kenyatta International Airport"},
+	{"NBS", "CN", 42.06694444, 127.6022222, "Changbaishan Airport"},
+	{"NBW", "CU", 19.90649986, -75.20709991, "Leeward Point Field"},
+	{"NBX", "ID", -3.368180037, 135.4960022, "Nabire Airport"},
+	{"NCA", "TC", 21.91749954, -71.93959808, "North Caicos Airport"},
+	{"NCE", "FR", 43.65840149, 7.215869904, "Nice-Côte d\"Azur Airport"},
+	{"NCG", "MX", 30.3973999, -107.875, "Nuevo Casas Grandes Airport"},
+	{"NCH", "TZ", -10.35750008, 38.77920151, "Nachingwea Airport"},
+	{"NCI", "CO", 8.45, -76.7833, "Necocli Airport"},
+	{"NCJ", "AR", -30.9575, -61.5283, "Sunchales Aeroclub Airport"},
+	{"NCL", "GB", 55.03749847, -1.691669941, "Newcastle Airport"},
+	{"NCN", "US", 60.07730103, -147.9920044, "Chenega Bay Airport"},
+	{"NCR", "NI", 11.13339996, -84.76999664, "San Carlos"},
+	{"NCS", "ZA", -27.77059937, 29.9769001, "Newcastle Airport"},
+	{"NCT", "CR", 10.13939953, -85.44580078, "Guanacaste Airport"},
+	{"NCU", "UZ", 42.48839951, 59.62329865, "Nukus Airport"},
+	{"NCY", "FR", 45.92919922, 6.098760128, "Annecy-Haute-Savoie-Mont Blanc Airport"},
+	{"NDA", "ID", -4.533329964, 129.8999939, "Bandanaira Airport"},
+	{"NDB", "MR", 20.93309975, -17.03000069, "Nouadhibou International Airport"},
+	{"NDC", "IN", 19.18330002, 77.3167038, "Nanded Airport"},
+	{"NDD", "AO", -11.16790009, 13.84749985, "Sumbe Airport"},
+	{"NDE", "KE", 3.933000088, 41.84999847, "Mandera Airport"},
+	{"NDF", "AO", -9.275188446, 14.97724247, "Ndalatandos Airport"},
+	{"NDG", "CN", 47.23960114, 123.9179993, "Qiqihar Sanjiazi Airport"},
+	{"NDI", "PG", -9.47375, 148.3294167, "Namudi Airport"},
+	{"NDJ", "TD", 12.13370037, 15.0340004, "N\"Djamena International Airport"},
+	{"NDK", "MH", 5.631669998, 168.125, "Namorik Atoll Airport"},
+	{"NDL", "CF", 8.427206039, 20.63515663, "N\"Délé Airport"},
+	{"NDM", "ET", 9.767000198, 35.09999847, "Mendi Airport"},
+	{"NDN", "PG", -9.143555556, 147.68425, "Nadunumu Airport"},
+	{"NDR", "MA", 34.98880005, -3.028209925, "Nador International Airport"},
+	{"NDS", "AU", -27.97999954, 119.2969971, "Sandstone Airport"},
+	{"NDU", "NA", -17.9564991, 19.71940041, "Rundu Airport"},
+	{"NDY", "GB", 59.25030136, -2.576669931, "Sanday Airport"},
+	{"NDZ", "DE", 53.76722336, 8.643610954, "Nordholz-Spieka Airport"},
+	{"NEC", "AR", -38.4831, -58.8172, "Necochea Airport"},
+	{"NEF", "RU", 56.10689926, 54.34719849, "Neftekamsk Airport"},
+	{"NEG", "JM", 18.34280014, -78.33209991, "Negril Airport"},
+	{"NEJ", "ET", 9.550000191, 35.46699905, "Nejjo Airport"},
+	{"NEK", "ET", 9.050000191, 36.59999847, "Nekemte Airport"},
+	{"NEL", "US", 40.03329849, -74.353302, "Lakehurst Maxfield Field Airport"},
+	{"NEU", "LA", 20.41839981, 104.0670013, "Sam Neua Airport"},
+	{"NEV", "KN", 17.20569992, -62.58990097, "Vance W. Amory International Airport"},
+	{"NFG", "RU", 61.10829926, 72.65000153, "Nefteyugansk Airport"},
+	{"NFO", "TO", -15.57079983, -175.6329956, "Mata\"aho Airport"},
+	{"NGA", "AU", -34.25559998, 148.2480011, "Young Airport"},
+	{"NGB", "CN", 29.82670021, 121.461998, "Ningbo Lishe International Airport"},
+	{"NGD", "VG", 18.72719955, -64.32969666, "Captain Auguste George Airport"},
+	{"NGE", "CM", 7.357009888, 13.55920029, "N\"Gaoundéré Airport"},
+	{"NGI", "FJ", -18.11560059, 179.3399963, "Ngau Airport"},
+	{"NGK", "RU", 51.78010178, 143.1390076, "Nogliki Airport"},
+	{"NGL", "ZA", -24.38780022, 31.32579994, "Ngala Airport"},
+	{"NGO", "JP", 34.85839844, 136.8049927, "Chubu Centrair International Airport"},
+	{"NGQ", "CN", 32.1, 80.05305556, "Ngari Gunsa Airport"},
+	{"NGS", "JP", 32.91690063, 129.9140015, "Nagasaki Airport"},
+	{"NGX", "NP", 28.64139938, 84.08920288, "Manang Airport"},
+	{"NHA", "VN", 12.22749996, 109.1920013, "Nha Trang Air Base"},
+	{"NHD", "AE", 25.02680016, 55.36619949, "Al Minhad Air Base"},
+	{"NHF", "SD", 15.35560036, 35.72779846, "New Halfa Airport"},
+	{"NHS", "PK", 29.53899956, 66.02330017, "Nushki Airport"},
+	{"NHT", "GB", 51.5530014, -0.418166995, "RAF Northolt"},
+	{"NHV", "PF", -8.795599937, -140.2290039, "Nuku Hiva Airport"},
+	{"NIA", "LR", 7.5, -8.600000381, "Nimba Airport"},
+	{"NIF", "AU", -21.67169952, 121.586998, "Camp Nifty Airport"},
+	{"NIG", "KI", -1.314440012, 176.4100037, "Nikunau Airport"},
+	{"NIM", "NE", 13.48149967, 2.183609962, "Diori Hamani International Airport"},
+	{"NIO", "CD", -2.717499971, 17.68470001, "Nioki Airport"},
+	{"NIS", "PG", -2.662222222, 151.9977778, "Simberi Airport"},
+	{"NIT", "FR", 46.31129837, -0.401502997, "Niort-Souché Airport"},
+	{"NIU", "PF", -16.1191, -146.3683, "Naiu Airport"},
+	{"NIX", "ML", 15.23810005, -9.576109886, "Nioro du Sahel Airport"},
+	{"NJA", "JP", 35.45460129, 139.4499969, "Atsugi Naval Air Facility"},
+	{"NJC", "RU", 60.94929886, 76.4835968, "Nizhnevartovsk Airport"},
+	{"NJF", "IQ", 31.989853, 44.404317, "Al Najaf International Airport"},
+	{"NKB", "AU", -18.49469948, 124.8519974, "Noonkanbah Airport"},
+	{"NKC", "MR", 18.09819984, -15.94849968, "Nouakchott International Airport"},
+	{"NKD", "ID", -3.822, 137.8406, "Sinak Airport"},
+	{"NKG", "CN", 31.74200058, 118.8619995, "Nanjing Lukou Airport"},
+	{"NKL", "CD", -5.449999809, 14.83300018, "Nkolo Fuma Airport"},
+	{"NKM", "JP", 35.25500107, 136.923996, "Nagoya Airport"},
+	{"NKS", "CM", 4.949999809, 9.932999611, "Nkongsamba Airport"},
+	{"NKU", "LS", -30.02169991, 28.19689941, "Nkaus Airport"},
+	{"NKW", "IO", -7.313270092, 72.41110229, "Diego Garcia Naval Support Facility"},
+	{"NKY", "CG", -4.217000008, 13.28299999, "Yokangassi Airport"},
+	{"NLA", "ZM", -12.99810028, 28.66489983, "Ndola Airport"},
+	{"NLD", "MX", 27.44389915, -99.57050323, "Quetzalcóatl International Airport"},
+	{"NLF", "AU", -9.583330154, 143.7669983, "Darnley Island Airport"},
+	{"NLK", "NF", -29.04159927, 167.9389954, "Norfolk Island International Airport"},
+	{"NLL", "AU", -21.91329956, 120.197998, "Nullagine Airport"},
+	{"NLO", "CD", -4.326660156, 15.32750034, "Ndolo Airport"},
+	{"NLP", "ZA", -25.5, 30.91379929, "Nelspruit Airport"},
+	{"NLS", "AU", -18.04999924, 128.8999939, "Nicholson Airport"},
+	{"NLU", "MX", 19.75530052, -99.0164032, "Santa Lucia Air Force Base"},
+	{"NLV", "UA", 47.05789948, 31.9197998, "Mykolaiv International Airport"},
+	{"NMA", "UZ", 40.98460007, 71.55670166, "Namangan Airport"},
+	{"NMB", "IN", 20.43440056, 72.84320068, "Daman Airport"},
+	{"NMC", "BS", 24.59429932, -76.82019806, "Normans Cay Airport"},
+	{"NML", "CA", 57.05559921, -111.5739975, "Fort McMurray / Mildred Lake Airport"},
+	{"NMN", "PG", -6.32183, 145.070667, "Nomane Airport"},
+	{"NMR", "AU", -27.55830002, 141.1329956, "Nappa Merrie Airport"},
+	{"NMS", "MM", 20.89049911, 97.73590088, "Namsang Airport"},
+	{"NMT", "MM", 23.08300018, 97.38300323, "Namtu Airport"},
+	{"NNA", "MA", 34.2989006, -6.595880032, "Kenitra Airport"},
+	{"NNB", "SB", -10.847994, 162.454108, "Santa Ana Airport"},
+	{"NNG", "CN", 22.60829926, 108.1719971, "Nanning Wuxu Airport"},
+	{"NNI", "NA", -18.8064003, 16.92720032, "Namutoni Airport"},
+	{"NNM", "RU", 67.63999939, 53.12189865, "Naryan Mar Airport"},
+	{"NNR", "IE", 53.2303009, -9.467780113, "Connemara Regional Airport"},
+	{"NNT", "TH", 18.80789948, 100.7829971, "Nan Airport"},
+	{"NNU", "BR", -17.82329941, -40.32989883, "Nanuque Airport"},
+	{"NNX", "ID", 4.133333333, 117.6666667, "Nunukan Airport"},
+	{"NNY", "CN", 32.98080063, 112.6149979, "Nanyang Airport"},
+	{"NOA", "AU", -34.94889832, 150.5370026, "Nowra Airport"},
+	{"NOB", "CR", 9.976490021, -85.65299988, "Nosara Airport"},
+	{"NOC", "IE", 53.91030121, -8.818490028, "Ireland West Knock Airport"},
+	{"NOD", "DE", 53.63305664, 7.190278053, "Norden-Norddeich Airport"},
+	{"NOG", "MX", 31.22610092, -110.9759979, "Nogales International Airport"},
+	{"NOJ", "RU", 63.18330002, 75.26999664, "Noyabrsk Airport"},
+	{"NOK", "BR", -14.69830036, -52.34640121, "Xavantina Airport"},
+	{"NOM", "PG", -6.294, 142.2341667, "Nomad River Airport"},
+	{"NON", "KI", -0.63972199, 174.4279938, "Nonouti Airport"},
+	{"NOO", "PG", -9.254455, 147.621352, "Naoro Airport"},
+	{"NOR", "IS", 65.13189697, -13.74639988, "Norðfjörður Airport"},
+	{"NOS", "MG", -13.31210041, 48.31480026, "Fascene Airport"},
+	{"NOU", "NC", -22.01460075, 166.2129974, "La Tontouta International Airport"},
+	{"NOV", "AO", -12.80889988, 15.76049995, "Nova Lisboa Airport"},
+	{"NOZ", "RU", 53.81140137, 86.87719727, "Spichenkovo Airport"},
+	{"NPE", "NZ", -39.46580124, 176.8699951, "Napier Airport"},
+	{"NPG", "PG", -6.1454, 143.4531, "Nipa Airport"},
+	{"NPL", "NZ", -39.00859833, 174.1790009, "New Plymouth Airport"},
+	{"NPO", "ID", -0.348868996, 111.7480011, "Nanga Pinoh I Airport"},
+	{"NPT", "US", 41.53239822, -71.28150177, "Newport State Airport"},
+	{"NQL", "BR", -14.43490028, -48.49150085, "Niquelândia Airport"},
+	{"NQN", "AR", -38.94900131, -68.15570068, "Presidente Peron Airport"},
+	{"NQT", "GB", 52.91999817, -1.079169989, "Nottingham Airport"},
+	{"NQU", "CO", 5.6964, -77.2806, "Reyes Murillo Airport"},
+	{"NQY", "GB", 50.44060135, -4.995409966, "Newquay Cornwall Airport"},
+	{"NRA", "AU", -34.70220184, 146.5119934, "Narrandera Airport"},
+	{"NRD", "DE", 53.70694351, 7.230000019, "Norderney Airport"},
+	{"NRE", "ID", -3.8548, 126.7012, "Namrole Airport"},
+	{"NRK", "SE", 58.5862999, 16.25060081, "Norrköping Airport"},
+	{"NRL", "GB", 59.36750031, -2.434439898, "North Ronaldsay Airport"},
+	{"NRM", "ML", 15.21700001, -7.267000198, "Nara Airport"},
+	{"NRN", "DE", 51.60240173, 6.142169952, "Niederrhein Airport"},
+	{"NRT", "JP", 35.76470184, 140.3860016, "Narita International Airport"},
+	{"NSA", "BR", -5.911419868, -35.24769974, "Augusto Severo Airport"},
+	{"NSH", "IR", 36.66329956, 51.46469879, "Noshahr Airport"},
+	{"NSI", "CM", 3.722559929, 11.5532999, "Yaoundé Nsimalen International Airport"},
+	{"NSK", "RU", 69.31109619, 87.3321991, "Norilsk-Alykel Airport"},
+	{"NSM", "AU", -32.20999908, 121.7549973, "Norseman Airport"},
+	{"NSN", "NZ", -41.2983017, 173.220993, "Nelson Airport"},
+	{"NSO", "AU", -32.03720093, 150.8320007, "Scone Airport"},
+	{"NSP", "PH", 14.49540043, 120.9039993, "Sangley Point Air Base"},
+	{"NST", "TH", 8.539620399, 99.94470215, "Nakhon Si Thammarat Airport"},
+	{"NSV", "AU", -26.42329979, 153.0630035, "Noosa Airport"},
+	{"NSY", "IT", 37.40169907, 14.92240047, "Sigonella Airport"},
+	{"NTB", "NO", 59.56570053, 9.212220192, "Notodden Airport"},
+	{"NTE", "FR", 47.15319824, -1.610730052, "Nantes Atlantique Airport"},
+	{"NTG", "CN", 32.07080078, 120.9759979, "Nantong Airport"},
+	{"NTI", "ID", -2.1033, 133.5164, "Stenkol Airport"},
+	{"NTL", "AU", -32.79499817, 151.8339996, "Newcastle Airport"},
+	{"NTN", "AU", -17.68359947, 141.0700073, "Normanton Airport"},
+	{"NTO", "CV", 17.20280075, -25.09059906, "Agostinho Neto Airport"},
+	{"NTQ", "JP", 37.29309845, 136.9620056, "Noto Airport"},
+	{"NTR", "MX", 25.86560059, -100.2369995, "Del Norte International Airport"},
+	{"NTT", "TO", -15.97733765, -173.7910295, "Kuini Lavenia Airport"},
+	{"NTX", "ID", 3.908710003, 108.3880005, "Ranai Airport"},
+	{"NTY", "ZA", -25.33379936, 27.17340088, "Pilanesberg International Airport"},
+	{"NUB", "AU", -14.27169991, 135.7169952, "Numbulwar Airport"},
+	{"NUD", "SD", 12.66699982, 28.33300018, "En Nahud Airport"},
+	{"NUE", "DE", 49.49869919, 11.06690025, "Nuremberg Airport"},
+	{"NUG", "PG", -3.4075, 154.7383, "Nuguria Airstrip"},
+	{"NUI", "US", 70.20999908, -151.0059967, "Nuiqsut Airport"},
+	{"NUK", "PF", -19.28499985, -138.7720032, "Nukutavake Airport"},
+	{"NUP", "US", 60.90579987, -162.4389954, "Nunapitchuk Airport"},
+	{"NUR", "AU", -31.44169998, 130.9019928, "Nullabor Motel Airport"},
+	{"NUS", "VU", -16.07970047, 167.401001, "Norsup Airport"},
+	{"NUU", "KE", -0.298067003, 36.15930176, "Nakuru Airport"},
+	{"NUX", "RU", 66.06939697, 76.52030182, "Novy Urengoy Airport"},
+	{"NVA", "CO", 2.95015, -75.294, "Benito Salas Airport"},
+	{"NVG", "NI", 11.66666698, -84.44999695, "Nueva Guinea Airport"},
+	{"NVI", "UZ", 40.11719894, 65.17079926, "Navoi Airport"},
+	{"NVJ", "MX", 26.99220085, -109.4160004, "Navojoa Airport"},
+	{"NVK", "NO", 68.43689728, 17.38669968, "Narvik Framnes Airport"},
+	{"NVN", "US", 39.81850052, -120.3529968, "Nervino Airport"},
+	{"NVP", "BR", -5.118030071, -60.36489868, "Novo Aripuanã Airport"},
+	{"NVS", "FR", 47.00260162, 3.113329887, "Nevers-Fourchambault Airport"},
+	{"NVT", "BR", -26.87999916, -48.65140152, "Ministro Victor Konder International Airport"},
+	{"NVY", "IN", 11.61295605, 79.5273819, "Neyveli Airport"},
+	{"NWA", "KM", -12.29810047, 43.76639938, "Mohéli Bandar Es Eslam Airport"},
+	{"NWI", "GB", 52.67580032, 1.282780051, "Norwich International Airport"},
+	{"NWT", "PG", -9.984166667, 149.7291667, "Nowata Airport"},
+	{"NYA", "RU", 62.11000061, 65.61499786, "Nyagan Airport"},
+	{"NYE", "KE", -0.364414006, 36.97848511, "Nyeri Airport"},
+	{"NYI", "GH", 7.361830235, -2.328759909, "Sunyani Airport"},
+	{"NYK", "KE", -0.062398899, 37.041008, "Nanyuki Airport"},
+	{"NYM", "RU", 65.48090363, 72.69889832, "Nadym Airport"},
+	{"NYN", "AU", -31.55109978, 147.2030029, "Nyngan Airport"},
+	{"NYO", "SE", 58.78860092, 16.91220093, "Stockholm Skavsta Airport"},
+	{"NYT", "MM", 19.62350082, 96.2009964, "Naypyidaw Airport"},
+	{"NYU", "MM", 21.17880058, 94.93019867, "Bagan Airport"},
+	{"NZA", "AO", -7.716939926, 21.35820007, "Nzagi Airport"},
+	{"NZC", "PE", -14.85400009, -74.96150208, "Maria Reiche Neuman Airport"},
+	{"NZE", "GN", 7.806019306, -8.701797485, "Nzerekore Airport"},
+	{"NZY", "US", 32.69919968, -117.2149963, "North Island Naval Air Station-Halsey Field"},
+	{"OAA", "AF", 33.9225, 69.07722222, "Shank Air Base"},
+	{"OAG", "AU", -33.38169861, 149.1329956, "Orange Airport"},
+	{"OAH", "AF", 33.3913002, 62.26100159, "Shindand Airport"},
+	{"OAI", "AF", 34.94609833, 69.26499939, "Bagram Air Base"},
+	{"OAJ", "US", 34.82920074, -77.61209869, "Albert J Ellis Airport"},
+	{"OAK", "US", 37.72129822, -122.2210007, "Metropolitan Oakland International Airport"},
+	{"OAL", "BR", -11.496, -61.4508, "Cacoal Airport"},
+	{"OAM", "NZ", -44.97000122, 171.0820007, "Oamaru Airport"},
+	{"OAR", "US", 36.68190002, -121.762001, "Marina Municipal Airport"},
+	{"OAS", "AF", 33.12575, 68.838517, "Sharana Airstrip"},
+	{"OAX", "MX", 16.99990082, -96.72660065, "Xoxocotlán International Airport"},
+	{"OAZ", "AF", 31.86380005, 64.22460175, "Camp Bastion Airport"},
+	{"OBC", "DJ", 11.96700001, 43.26699829, "Obock Airport"},
+	{"OBD", "ID", -3.9106, 136.2306, "Obano Airport"},
+	{"OBE", "US", 27.26280022, -80.84980011, "Okeechobee County Airport"},
+	{"OBF", "DE", 48.08140182, 11.28310013, "Oberpfaffenhofen Airport"},
+	{"OBI", "BR", 2.223469973, -55.94609833, "Tiriós Airport"},
+	{"OBL", "BE", 51.26470184, 4.753330231, "Oostmalle Air Base"},
+	{"OBM", "PG", -7.74, 147.59, "Morobe Airport"},
+	{"OBN", "GB", 56.46350098, -5.399670124, "Oban Airport"},
+	{"OBO", "JP", 42.73329926, 143.2169952, "Tokachi-Obihiro Airport"},
+	{"OBS", "FR", 44.5442009, 4.372189999, "Aubenas-Ardèche Méridional Airport"},
+	{"OBU", "US", 66.91230011, -156.8970032, "Kobuk Airport"},
+	{"OBX", "PG", -7.590622421, 141.3242626, "Obo Airport"},
+	{"OCC", "EC", -0.462886006, -76.98680115, "Francisco De Orellana Airport"},
+	{"OCJ", "JM", 18.4041996, -76.96900177, "Boscobel Aerodrome"},
+	{"OCV", "CO", 8.31506, -73.3583, "Aguas Claras Airport"},
+	{"ODA", "CF", 8.010560036, 22.39859962, "Ouadda Airport"},
+	{"ODB", "ES", 37.84199905, -4.848879814, "Córdoba Airport"},
+	{"ODC", "US", 37.75630188, -120.8000031, "Oakdale Airport"},
+	{"ODD", "AU", -27.56170082, 135.4470062, "Oodnadatta Airport"},
+	{"ODE", "DK", 55.47669983, 10.33090019, "Odense Airport"},
+	{"ODH", "GB", 51.23410034, -0.942825019, "RAF Odiham"},
+	{"ODJ", "CF", 8.899999619, 22.78300095, "Ouanda Djallé Airport"},
+	{"ODL", "AU", -26.74530029, 140.6380005, "Cordillo Downs Airport"},
+	{"ODN", "MY", 3.967000008, 115.0500031, "Long Seridan Airport"},
+	{"ODO", "RU", 57.86610031, 114.2429962, "Bodaybo Airport"},
+	{"ODR", "AU", -17.34079933, 128.9120026, "Ord River Airport"},
+	{"ODS", "UA", 46.42679977, 30.67650032, "Odessa International Airport"},
+	{"ODW", "US", 48.25149918, -122.6740036, "AJ Eisenberg Airport"},
+	{"ODY", "LA", 20.6826992, 101.9940033, "Oudomsay Airport"},
+	{"OEC", "TL", -9.198060036, 124.3430023, "Oecussi Airport"},
+	{"OEL", "RU", 52.93470001, 36.00220108, "Oryol Yuzhny Airport"},
+	{"OEM", "SR", 3.345279932, -55.44250107, "Vincent Fayks Airport"},
+	{"OER", "SE", 63.40829849, 18.98999977, "Örnsköldsvik Airport"},
+	{"OES", "AR", -40.7512, -65.0343, "Antoine De St Exupery Airport"},
+	{"OFI", "CI", 9.600000381, -4.050000191, "Ouango Fitini Airport"},
+	{"OFJ", "IS", 66.08329773, -18.66670036, "Ólafsfjörður Airport"},
+	{"OFU", "AS", -14.1843996, -169.6699982, "Ofu Village Airport"},
+	{"OGE", "PG", -6.46675, 147.3641667, "Ogeranang Airport"},
+	{"OGG", "US", 20.89859962, -156.4299927, "Kahului Airport"},
+	{"OGL", "GY", 6.806280136, -58.10589981, "Ogle Airport"},
+	{"OGN", "JP", 24.46689987, 122.9779968, "Yonaguni Airport"},
+	{"OGO", "CI", 6.715559959, -3.470279932, "Abengourou Airport"},
+	{"OGR", "TD", 10.28810024, 15.3810997, "Bongor Airport"},
+	{"OGS", "US", 44.68190002, -75.46549988, "Ogdensburg International Airport"},
+	{"OGX", "DZ", 31.91720009, 5.412779808, "Ain el Beida Airport"},
+	{"OGZ", "RU", 43.20510101, 44.60660172, "Beslan Airport"},
+	{"OHA", "NZ", -40.20600128, 175.3880005, "RNZAF Base Ohakea"},
+	{"OHD", "MK", 41.18000031, 20.74230003, "Ohrid St. Paul the Apostle Airport"},
+	{"OHE", "CN", 52.91277778, 122.43, "[Duplicate] Gu-Lian Airport"},
+	{"OHH", "RU", 53.52000046, 142.8899994, "Okha Airport"},
+	{"OHO", "RU", 59.4100647, 143.0565033, "Okhotsk Airport"},
+	{"OHR", "DE", 54.68444443, 8.52833271, "Wyk auf Föhr Airport"},
+	{"OHT", "PK", 33.56999969, 71.44000244, "Kohat Airport"},
+	{"OIA", "BR", -6.763100147, -51.04990005, "Ourilândia do Norte Airport"},
+	{"OIM", "JP", 34.7820015, 139.3600006, "Oshima Airport"},
+	{"OIR", "JP", 42.07170105, 139.4329987, "Okushiri Airport"},
+	{"OIT", "JP", 33.47940063, 131.7369995, "Oita Airport"},
+	{"OKA", "JP", 26.19580078, 127.6460037, "Naha Airport"},
+	{"OKB", "AU", -24.95940018, 153.3150024, "Orchid Beach Airport"},
+	{"OKC", "US", 35.39310074, -97.60070038, "Will Rogers World Airport"},
+	{"OKD", "JP", 43.11610031, 141.3800049, "Okadama Airport"},
+	{"OKE", "JP", 27.42550087, 128.701004, "Okierabu Airport"},
+	{"OKF", "NA", -19.14920044, 15.91189957, "Okaukuejo Airport"},
+	{"OKG", "CG", -1.448330045, 15.07330036, "Okoyo Airport"},
+	{"OKH", "GB", 52.7356987, -0.648769021, "RAF Cottesmore"},
+	{"OKI", "JP", 36.18109894, 133.3249969, "Oki Airport"},
+	{"OKJ", "JP", 34.75690079, 133.8549957, "Okayama Airport"},
+	{"OKL", "ID", -5.091060162, 140.6100006, "Oksibil Airport"},
+	{"OKN", "GA", -0.665214002, 13.67310047, "Okondja Airport"},
+	{"OKO", "JP", 35.74850082, 139.3480072, "Yokota Air Base"},
+	{"OKP", "PG", -5.2261, 142.2259, "Oksapmin Airport"},
+	{"OKQ", "ID", -8.0946, 139.7233, "Okaba Airport"},
+	{"OKR", "AU", -9.757029533, 143.4109955, "Yorke Island Airport"},
+	{"OKT", "RU", 54.43999863, 53.38830185, "Oktyabrskiy Airport"},
+	{"OKU", "NA", -18.81279945, 17.05940056, "Mokuti Lodge Airport"},
+	{"OKV", "PG", -5.556666667, 141.0327778, "Okao Airport"},
+	{"OKY", "AU", -27.41139984, 151.7350006, "Oakey Airport"},
+	{"OLA", "NO", 63.69889832, 9.604000092, "Ørland Airport"},
+	{"OLB", "IT", 40.89870071, 9.517629623, "Olbia Costa Smeralda Airport"},
+	{"OLC", "BR", -3.467929508, -68.92041206, "Senadora Eunice Micheles Airport"},
+	{"OLD", "US", 44.95280075, -68.67430115, "Dewitt Field,Old Town Municipal Airport"},
+	{"OLF", "US", 48.0945015, -105.5749969, "L M Clayton Airport"},
+	{"OLI", "IS", 64.91139984, -23.82309914, "Rif Airport"},
+	{"OLJ", "VU", -14.88169956, 166.5579987, "North West Santo Airport"},
+	{"OLK", "PY", -21.04520035, -57.88249969, "Fuerte Olimpo Airport"},
+	{"OLM", "US", 46.9693985, -122.9029999, "Olympia Regional Airport"},
+	{"OLN", "AR", -45.582371, -68.999841, "Colonia Sarmiento Airport"},
+	{"OLP", "AU", -30.48500061, 136.8769989, "Olympic Dam Airport"},
+	{"OLQ", "PG", -5.389722222, 141.5152778, "Olsobip Airport"},
+	{"OLR", "AF", 33.36380005, 69.95610046, "Salerno Landing Zone Airport"},
+	{"OLV", "US", 34.97869873, -89.78690338, "Olive Branch Airport"},
+	{"OMA", "US", 41.30319977, -95.89409637, "Eppley Airfield"},
+	{"OMB", "GA", -1.574730039, 9.26268959, "Omboue Hopital Airport"},
+	{"OMC", "PH", 11.05799961, 124.5650024, "Ormoc Airport"},
+	{"OMD", "NA", -28.58469963, 16.44669914, "Oranjemund Airport"},
+	{"OME", "US", 64.5121994, -165.4450073, "Nome Airport"},
+	{"OMF", "JO", 32.35639954, 36.25920105, "King Hussein Air College"},
+	{"OMG", "NA", -18.03030014, 22.18969917, "Omega Airport"},
+	{"OMH", "IR", 37.66809845, 45.06869888, "Urmia Airport"},
+	{"OMI", "IR", 30.83519936, 49.53490067, "Omidiyeh Airport"},
+	{"OMM", "OM", 18.13599968, 55.18209839, "Marmul Airport"},
+	{"OMO", "BA", 43.28290176, 17.84589958, "Mostar International Airport"},
+	{"OMR", "RO", 47.02529907, 21.90250015, "Oradea International Airport"},
+	{"OMS", "RU", 54.96699905, 73.3105011, "Omsk Central Airport"},
+	{"OMY", "KH", 13.7597, 104.97173, "Preah Vinhear Airport"},
+	{"ONB", "PG", -8.674361111, 147.2624167, "Ononge Airport"},
+	{"OND", "NA", -17.87820053, 15.95259953, "Ondangwa Airport"},
+	{"ONG", "AU", -16.66250038, 139.1779938, "Mornington Island Airport"},
+	{"ONI", "ID", -3.983402014, 136.0832977, "Moanamani Airport"},
+	{"ONJ", "JP", 40.19189835, 140.3710022, "Odate Noshiro Airport"},
+	{"ONQ", "TR", 41.50640106, 32.08860016, "Zonguldak Airport"},
+	{"ONR", "AU", -24.81669998, 140.5330048, "Monkira Airport"},
+	{"ONT", "US", 34.05599976, -117.6009979, "Ontario International Airport"},
+	{"ONU", "FJ", -20.64999962, -178.6999969, "Ono-I-Lau Airport"},
+	{"ONX", "PA", 9.356639862, -79.86740112, "Enrique Adolfo Jimenez Airport"},
+	{"OOK", "US", 60.54140091, -165.0870056, "Toksook Bay Airport"},
+	{"OOL", "AU", -28.1644001, 153.5050049, "Gold Coast Airport"},
+	{"OOM", "AU", -36.30059814, 148.973999, "Cooma Snowy Mountains Airport"},
+	{"OOR", "AU", -25.25, 140.9830017, "Mooraberree Airport"},
+	{"OOT", "KI", -1.796110034, 175.526001, "Onotoa Airport"},
+	{"OOX", "UA", 46.88000107, 35.30500031, "Melitopol Air Base"},
+	{"OPA", "IS", 66.31079865, -16.4666996, "Kópasker Airport"},
+	{"OPI", "AU", -12.32499981, 133.0059967, "Oenpelli Airport"},
+	{"OPO", "PT", 41.24810028, -8.681389809, "Francisco de Sá Carneiro Airport"},
+	{"OPS", "BR", -11.88500023, -55.58610916, "Presidente João Batista Figueiredo Airport"},
+	{"OPU", "PG", -8.050000191, 142.9329987, "Balimo Airport"},
+	{"ORA", "AR", -23.15279961, -64.32920074, "Orán Airport"},
+	{"ORB", "SE", 59.22370148, 15.03800011, "Örebro Airport"},
+	{"ORC", "CO", 4.79222, -71.3564, "Orocue Airport"},
+	{"ORD", "US", 41.97859955, -87.90480042, "Chicago O\"Hare International Airport"},
+	{"ORE", "FR", 47.9878006, 1.760560036, "Orléans-Bricy (BA 123) Air Base"},
+	{"ORF", "US", 36.89459991, -76.20120239, "Norfolk International Airport"},
+	{"ORG", "SR", 5.811079979, -55.19070053, "Zorg en Hoop Airport"},
+	{"ORH", "US", 42.26729965, -71.8757019, "Worcester Regional Airport"},
+	{"ORJ", "GY", 4.725269794, -60.03499985, "Orinduik Airport"},
+	{"ORK", "IE", 51.84130096, -8.491109848, "Cork Airport"},
+	{"ORM", "GB", 52.30530167, -0.793056011, "Sywell Aerodrome"},
+	{"ORN", "DZ", 35.62390137, -0.621182978, "Es Senia Airport"},
+	{"ORP", "BW", -21.26670074, 25.31669998, "Orapa Airport"},
+	{"ORR", "AU", -35.0, 137.6170044, "Yorketown Airport"},
+	{"ORT", "US", 62.9612999, -141.9290009, "Northway Airport"},
+	{"ORU", "BO", -17.96260071, -67.07620239, "Juan Mendoza Airport"},
+	{"ORV", "US", 66.81790161, -161.0189972, "Robert (Bob) Curtis Memorial Airport"},
+	{"ORW", "PK", 25.27470016, 64.58599854, "Ormara Airport"},
+	{"ORX", "BR", -1.714079976, -55.83620071, "Oriximiná Airport"},
+	{"ORY", "FR", 48.72529984, 2.359440088, "Paris-Orly Airport"},
+	{"ORZ", "BZ", 18.04676628, -88.58386993, "Orange Walk Airport"},
+	{"OSB", "IQ", 36.30580139, 43.1473999, "Mosul International Airport"},
+	{"OSD", "SE", 63.19440079, 14.50030041, "Östersund Airport"},
+	{"OSE", "PG", -7.827777778, 147.0806389, "Omora Airport"},
+	{"OSG", "PG", -2.915555556, 141.2973056, "Ossima Airport"},
+	{"OSH", "US", 43.9844017, -88.55699921, "Wittman Regional Airport"},
+	{"OSI", "HR", 45.46269989, 18.81019974, "Osijek Airport"},
+	{"OSK", "SE", 57.3504982, 16.49799919, "Oskarshamn Airport"},
+	{"OSL", "NO", 60.19390106, 11.10039997, "Oslo Gardermoen Airport"},
+	{"OSN", "KR", 37.09059906, 127.0299988, "Osan Air Base"},
+	{"OSO", "AU", -22.08169937, 140.5549927, "Osborne Mine Airport"},
+	{"OSR", "CZ", 49.69630051, 18.11109924, "Ostrava Leos Janáček Airport"},
+	{"OSS", "KG", 40.60900116, 72.79329681, "Osh Airport"},
+	{"OST", "BE", 51.19889832, 2.862220049, "Ostend-Bruges International Airport"},
+	{"OSW", "RU", 51.07249832, 58.59560013, "Orsk Airport"},
+	{"OSY", "NO", 64.47219849, 11.57859993, "Namsos Høknesøra Airport"},
+	{"OSZ", "PL", 54.04249954, 16.2656002, "Koszalin Zegrze Airport"},
+	{"OTC", "TD", 13.44330025, 14.73939991, "Bol Airport"},
+	{"OTD", "PA", 8.628760338, -79.03469849, "Contadora Airport"},
+	{"OTH", "US", 43.417099, -124.2460022, "Southwest Oregon Regional Airport"},
+	{"OTI", "ID", 2.04598999, 128.3249969, "Pitu Airport"},
+	{"OTK", "US", 45.41820145, -123.814003, "Tillamook Airport"},
+	{"OTL", "MR", 17.53300095, -14.68299961, "Boutilimit Airport"},
+	{"OTM", "US", 41.10660172, -92.44789886, "Ottumwa Regional Airport"},
+	{"OTP", "RO", 44.57220078, 26.10219955, "Henri Coandă International Airport"},
+	{"OTR", "CR", 8.601559639, -82.96859741, "Coto 47 Airport"},
+	{"OTT", "BR", -9.898611111, -58.58194444, "Andre Maggi Airport"},
+	{"OTZ", "US", 66.88469696, -162.598999, "Ralph Wien Memorial Airport"},
+	{"OUA", "BF", 12.35319996, -1.512420058, "Ouagadougou Airport"},
+	{"OUD", "MA", 34.78720093, -1.923990011, "Angads Airport"},
+	{"OUE", "CG", 1.615990043, 16.03790092, "Ouesso Airport"},
+	{"OUG", "BF", 13.56700039, -2.417000055, "Ouahigouya Airport"},
+	{"OUH", "ZA", -33.60699844, 22.18899918, "Oudtshoorn Airport"},
+	{"OUK", "GB", 60.41699982, -0.75, "Outer Skerries Airport"},
+	{"OUL", "FI", 64.93009949, 25.35460091, "Oulu Airport"},
+	{"OUR", "CM", 4.474999905, 14.36250019, "Batouri Airport"},
+	{"OUS", "BR", -22.96649933, -49.91329956, "Ourinhos Airport"},
+	{"OUT", "TD", 10.4829998, 16.71699905, "Bousso Airport"},
+	{"OUZ", "MR", 22.75639915, -12.48359966, "Tazadit Airport"},
+	{"OVA", "MG", -24.23569475, 45.30452728, "Bekily Airport"},
+	{"OVB", "RU", 55.01259995, 82.65070343, "Tolmachevo Airport"},
+	{"OVD", "ES", 43.56359863, -6.034619808, "Asturias Airport"},
+	{"OVG", "ZA", -34.55490112, 20.2507, "Overberg Airport"},
+	{"OVL", "CL", -30.55920029, -71.17559814, "El Tuqui Airport"},
+	{"OVR", "AR", -36.88999939, -60.21659851, "Olavarria Airport"},
+	{"OVS", "RU", 61.32662201, 63.60191345, "Sovetskiy Airport"},
+	{"OWB", "US", 37.74010086, -87.16680145, "Owensboro Daviess County Airport"},
+	{"OWD", "US", 42.19049835, -71.17289734, "Norwood Memorial Airport"},
+	{"OXB", "GW", 11.89480019, -15.65369987, "Osvaldo Vieira International Airport"},
+	{"OXF", "GB", 51.8368988, -1.320000052, "Oxford (Kidlington) Airport"},
+	{"OXP", "GF", 3.897599936, -51.80410004, "Saint-Georges-de-l\"Oyapock Airport"},
+	{"OXY", "AU", -25.35829926, 141.4329987, "Morney Airport"},
+	{"OYA", "AR", -29.1058, -59.2189, "Goya Airport"},
+	{"OYE", "GA", 1.543110013, 11.58139992, "Oyem Airport"},
+	{"OYG", "UG", 3.64440012, 31.76276207, "Moyo Airport"},
+	{"OYK", "BR", 3.855489969, -51.7969017, "Oiapoque Airport"},
+	{"OYL", "KE", 3.469719887, 39.10139847, "Moyale Airport"},
+	{"OYN", "AU", -35.08901243, 142.3544884, "Ouyen Airport"},
+	{"OYO", "AR", -38.3869, -60.3297, "Tres Arroyos Airport"},
+	{"OZC", "PH", 8.178509712, 123.8420029, "Labo Airport"},
+	{"OZG", "MA", 30.32029915, -5.866670132, "Zagora Airport"},
+	{"OZH", "UA", 47.86700058, 35.31570053, "Zaporizhzhia International Airport"},
+	{"OZP", "ES", 37.17490005, -5.615940094, "Moron Air Base"},
+	{"OZZ", "MA", 30.93910027, -6.909430027, "Ouarzazate Airport"},
+	{"PAA", "MM", 16.89369965, 97.67459869, "Hpa-N Airport"},
+	{"PAB", "IN", 21.98839951, 82.11100006, "Bilaspur Airport"},
+	{"PAC", "PA", 8.973340034, -79.55560303, "Marcos A. Gelabert International Airport"},
+	{"PAD", "DE", 51.61410141, 8.616319656, "Paderborn Lippstadt Airport"},
+	{"PAE", "US", 47.90629959, -122.2819977, "Snohomish County (Paine Field) Airport"},
+	{"PAF", "UG", 2.202222, 31.554444, "Pakuba Airport"},
+	{"PAG", "PH", 7.830731448, 123.4611797, "Pagadian Airport"},
+	{"PAH", "US", 37.06079865, -88.77380371, "Barkley Regional Airport"},
+	{"PAJ", "PK", 33.90209961, 70.07160187, "Parachinar Airport"},
+	{"PAK", "US", 21.89690018, -159.6029968, "Port Allen Airport"},
+	{"PAL", "CO", 5.48361, -74.6574, "German Olano Air Base"},
+	{"PAM", "US", 30.06959915, -85.57540131, "Tyndall Air Force Base"},
+	{"PAN", "TH", 6.785459995, 101.1539993, "Pattani Airport"},
+	{"PAO", "US", 37.46110153, -122.1149979, "Palo Alto Airport of Santa Clara County"},
+	{"PAP", "HT", 18.57999992, -72.29250336, "Toussaint Louverture International Airport"},
+	{"PAQ", "US", 61.59489822, -149.0890045, "Palmer Municipal Airport"},
+	{"PAS", "GR", 37.01029968, 25.12809944, "Paros Airport"},
+	{"PAT", "IN", 25.59129906, 85.08799744, "Lok Nayak Jayaprakash Airport"},
+	{"PAU", "MM", 21.44919968, 94.48690033, "Pauk Airport"},
+	{"PAV", "BR", -9.40087986, -38.25059891, "Paulo Afonso Airport"},
+	{"PAX", "HT", 19.93359947, -72.84860229, "Port-de-Paix Airport"},
+	{"PAY", "MY", 5.999300003, 117.3999176, "Pamol Airport"},
+	{"PAZ", "MX", 20.60269928, -97.46080017, "El Tajín National Airport"},
+	{"PBB", "BR", -19.65119934, -51.19940186, "Paranaíba Airport"},
+	{"PBC", "MX", 19.15810013, -98.37139893, "Hermanos Serdán International Airport"},
+	{"PBD", "IN", 21.64870071, 69.65720367, "Porbandar Airport"},
+	{"PBE", "CO", 6.46034, -74.4105, "Puerto Berrio Airport"},
+	{"PBF", "US", 34.17309952, -91.93560028, "Grider Field"},
+	{"PBG", "US", 44.65090179, -73.4681015, "Plattsburgh International Airport"},
+	{"PBH", "BT", 27.40320015, 89.42459869, "Paro Airport"},
+	{"PBI", "US", 26.68320084, -80.09559631, "Palm Beach International Airport"},
+	{"PBJ", "VU", -16.43899918, 168.2570038, "Tavie Airport"},
+	{"PBL", "VE", 10.48050022, -68.07299805, "General Bartolome Salom International Airport"},
+	{"PBM", "SR", 5.452829838, -55.18780136, "Johan Adolf Pengel International Airport"},
+	{"PBN", "AO", -10.72200012, 13.76550007, "Porto Amboim Airport"},
+	{"PBO", "AU", -23.17110062, 117.7450027, "Paraburdoo Airport"},
+	{"PBP", "CR", 9.856109619, -85.3707962, "Islita Airport"},
+	{"PBQ", "BR", -11.64159966, -61.17910004, "Pimenta Bueno Airport"},
+	{"PBR", "GT", 15.73089981, -88.58380127, "Puerto Barrios Airport"},
+	{"PBT", "PY", -20.90460014, -57.92710114, "Puerto Leda Airport"},
+	{"PBU", "MM", 27.32990074, 97.42630005, "Putao Airport"},
+	{"PBZ", "ZA", -34.08816017, 23.32872343, "Plettenberg Bay Airport"},
+	{"PCA", "US", 58.90650177, -157.7140045, "Portage Creek Airport"},
+	{"PCB", "ID", -6.336959839, 106.7649994, "Pondok Cabe Air Base"},
+	{"PCF", "ZA", -26.67099953, 27.08189964, "Potchefstroom Airport"},
+	{"PCG", "GT", 17.26390076, -90.25630188, "Paso Caballos Airport"},
+	{"PCJ", "PY", -22.29520035, -57.86410141, "Puerto La Victoria Airport"},
+	{"PCL", "PE", -8.377940178, -74.57430267, "Cap FAP David Abenzur Rengifo International Airport"},
+	{"PCM", "MX", 20.62249947, -87.0821991, "Playa del Carmen Airport"},
+	{"PCN", "NZ", -41.34609985, 173.9559937, "Picton Aerodrome"},
+	{"PCO", "MX", 23.575011, -109.535826, "Punta Colorada Airport"},
+	{"PCP", "ST", 1.662940025, 7.411739826, "Principe Airport"},
+	{"PCQ", "LA", 21.6475, 101.9, "Bounneau Airport"},
+	{"PCR", "CO", 6.18472, -67.4932, "German Olano Airport"},
+	{"PCS", "BR", -7.062059879, -41.52370071, "Picos Airport"},
+	{"PCV", "MX", 27.06920052, -111.961998, "Punta Chivato Airport"},
+	{"PDA", "CO", 3.85353, -67.9062, "Obando Airport"},
+	{"PDB", "US", 59.78960037, -154.1239929, "Pedro Bay Airport"},
+	{"PDC", "NC", -21.31640053, 164.9989929, "Mueo Airport"},
+	{"PDE", "AU", -26.11669922, 139.3999939, "Pandie Pandie Airport"},
+	{"PDF", "BR", -17.29669952, -39.27119827, "Prado Airport"},
+	{"PDG", "ID", -0.874988973, 100.3519974, "Tabing Airport"},
+	{"PDI", "PG", -6.445138889, 147.5158333, "Pindiu Airport"},
+	{"PDK", "US", 33.87559891, -84.30200195, "DeKalb Peachtree Airport"},
+	{"PDL", "PT", 37.74119949, -25.69790077, "João Paulo II Airport"},
+	{"PDO", "ID", -3.286070108, 103.8799973, "Pendopo Airport"},
+	{"PDP", "UY", -34.85509872, -55.09429932, "Capitan Corbeta CA Curbelo International Airport"},
+	{"PDS", "MX", 28.62739944, -100.5350037, "Piedras Negras International Airport"},
+	{"PDT", "US", 45.69509888, -118.8410034, "Eastern Oregon Regional At Pendleton Airport"},
+	{"PDU", "UY", -32.36330032, -58.06190109, "Tydeo Larre Borges Airport"},
+	{"PDV", "BG", 42.06779861, 24.85079956, "Plovdiv International Airport"},
+	{"PDX", "US", 45.58869934, -122.5979996, "Portland International Airport"},
+	{"PDZ", "VE", 9.979240417, -62.22859955, "Pedernales Airport"},
+	{"PEA", "AU", -35.75584629, 137.9628754, "Penneshaw Airport"},
+	{"PED", "CZ", 50.01340103, 15.73859978, "Pardubice Airport"},
+	{"PEE", "RU", 57.91450119, 56.02119827, "Bolshoye Savino Airport"},
+	{"PEF", "DE", 54.15777969, 13.77444363, "Peenemünde Airport"},
+	{"PEG", "IT", 43.09590149, 12.51319981, "Perugia San Francesco d\"Assisi – Umbria International Airport"},
+	{"PEH", "AR", -35.8446, -61.8576, "Comodoro Pedro Zanni Airport"},
+	{"PEI", "CO", 4.81267, -75.7395, "Matecaña International Airport"},
+	{"PEK", "CN", 40.08010101, 116.5849991, "Beijing Capital International Airport"},
+	{"PEL", "LS", -29.12059975, 28.50530052, "Pelaneng Airport"},
+	{"PEM", "PE", -12.61359978, -69.22859955, "Padre Aldamiz International Airport"},
+	{"PEN", "MY", 5.297140121, 100.2770004, "Penang International Airport"},
+	{"PEP", "AU", -14.14420033, 130.0910034, "Peppimenarti Airport"},
+	{"PER", "AU", -31.94029999, 115.9670029, "Perth International Airport"},
+	{"PES", "RU", 61.8852005, 34.15470123, "Petrozavodsk Airport"},
+	{"PET", "BR", -31.71839905, -52.32770157, "Pelotas Airport"},
+	{"PEU", "HN", 15.26220036, -83.78119659, "Puerto Lempira Airport"},
+	{"PEV", "HU", 45.99089813, 18.24099922, "Pécs-Pogány Airport"},
+	{"PEW", "PK", 33.9939003, 71.51460266, "Peshawar International Airport"},
+	{"PEX", "RU", 65.12110138, 57.13079834, "Pechora Airport"},
+	{"PEZ", "RU", 53.11059952, 45.02109909, "Penza Airport"},
+	{"PFB", "BR", -28.24399948, -52.32659912, "Lauro Kurtz Airport"},
+	{"PFC", "US", 45.19979858, -123.961998, "Pacific City State Airport"},
+	{"PFJ", "IS", 65.55580139, -23.96500015, "Patreksfjordur Airport"},
+	{"PFN", "US", 30.21209908, -85.68280029, "Panama City-Bay Co International Airport"},
+	{"PFO", "CY", 34.7179985, 32.4856987, "Paphos International Airport"},
+	{"PFQ", "IR", 39.60359955, 47.88150024, "Parsabade Moghan Airport"},
+	{"PFR", "CD", -4.333000183, 20.58300018, "Ilebo Airport"},
+	{"PGA", "US", 36.92610168, -111.447998, "Page Municipal Airport"},
+	{"PGB", "PG", -7.0226, 141.5605, "Pangoa Airport"},
+	{"PGE", "PG", -7.134444444, 146.1563889, "Yegepa Airport"},
+	{"PGF", "FR", 42.74039841, 2.87067008, "Perpignan-Rivesaltes (Llabanère) Airport"},
+	{"PGH", "IN", 29.03339958, 79.47370148, "Pantnagar Airport"},
+	{"PGI", "AO", -7.358890057, 20.80470085, "Chitato Airport"},
+	{"PGK", "ID", -2.162199974, 106.1389999, "Pangkal Pinang (Depati Amir) Airport"},
+	{"PGO", "US", 37.28630066, -107.0559998, "Stevens Field"},
+	{"PGV", "US", 35.6352005, -77.38529968, "Pitt Greenville Airport"},
+	{"PGX", "FR", 45.19810104, 0.81555599, "Périgueux-Bassillac Airport"},
+	{"PGZ", "BR", -25.18470001, -50.14410019, "Ponta Grossa Airport"},
+	{"PHA", "VN", 11.6335001, 108.9520035, "Phan Rang Airport"},
+	{"PHB", "BR", -2.893749952, -41.73199844, "Prefeito Doutor João Silva Filho Airport"},
+	{"PHC", "NG", 5.015490055, 6.949590206, "Port Harcourt International Airport"},
+	{"PHE", "AU", -20.37779999, 118.6259995, "Port Hedland International Airport"},
+	{"PHF", "US", 37.13190079, -76.49299622, "Newport News Williamsburg International Airport"},
+	{"PHG", "NG", 4.846111111, 7.021388889, "Port Harcourt City Airport"},
+	{"PHH", "VN", 10.90639973, 108.0690002, "Phan Thiet Airport"},
+	{"PHI", "BR", -2.483611107, -45.0672226, "Pinheiro Airport"},
+	{"PHL", "US", 39.87189865, -75.2410965, "Philadelphia International Airport"},
+	{"PHO", "US", 68.34880066, -166.798996, "Point Hope Airport"},
+	{"PHS", "TH", 16.78289986, 100.2789993, "Phitsanulok Airport"},
+	{"PHT", "US", 36.33819962, -88.38289642, "Henry County Airport"},
+	{"PHW", "ZA", -23.93720055, 31.15539932, "Hendrik Van Eck Airport"},
+	{"PHX", "US", 33.43429947, -112.012001, "Phoenix Sky Harbor International Airport"},
+	{"PHY", "TH", 16.6760006, 101.1949997, "Phetchabun Airport"},
+	{"PIA", "US", 40.66419983, -89.69329834, "General Wayne A. Downing Peoria International Airport"},
+	{"PIB", "US", 31.46710014, -89.33709717, "Hattiesburg Laurel Regional Airport"},
+	{"PIC", "TC", 21.89999962, -72.09999847, "Pine Cay Airport"},
+	{"PID", "BS", 25.08300018, -77.30000305, "Nassau Paradise Island Airport"},
+	{"PIE", "US", 27.91020012, -82.68740082, "St Petersburg Clearwater International Airport"},
+	{"PIF", "TW", 22.70019913, 120.4820023, "Pingtung North Airport"},
+	{"PIH", "US", 42.90980148, -112.5960007, "Pocatello Regional Airport"},
+	{"PIK", "GB", 55.50939941, -4.586669922, "Glasgow Prestwick Airport"},
+	{"PIL", "PY", -26.87999916, -58.31999969, "Carlos Miguel Gimenez Airport"},
+	{"PIN", "BR", -2.673019886, -56.77719879, "Parintins Airport"},
+	{"PIO", "PE", -13.74489975, -76.22029877, "Capitán FAP Renán Elías Olivera International Airport"},
+	{"PIP", "US", 57.58039856, -157.5720062, "Pilot Point Airport"},
+	{"PIR", "US", 44.38270187, -100.2860031, "Pierre Regional Airport"},
+	{"PIS", "FR", 46.58769989, 0.306665987, "Poitiers-Biard Airport"},
+	{"PIT", "US", 40.49150085, -80.23290253, "Pittsburgh International Airport"},
+	{"PIU", "PE", -5.205749989, -80.61640167, "Capitán FAP Guillermo Concha Iberico International Airport"},
+	{"PIV", "BR", -17.31690025, -44.86029816, "Pirapora Airport"},
+	{"PIW", "CA", 55.58890152, -97.16419983, "Pikwitonei Airport"},
+	{"PIX", "PT", 38.5542984, -28.44129944, "Pico Airport"},
+	{"PIZ", "US", 69.73290253, -163.0050049, "Point Lay LRRS Airport"},
+	{"PJA", "SE", 67.24559784, 23.06889915, "Pajala Airport"},
+	{"PJC", "PY", -22.63999939, -55.83000183, "Dr Augusto Roberto Fuster International Airport"},
+	{"PJG", "PK", 26.9545002, 64.13249969, "Panjgur Airport"},
+	{"PJM", "CR", 8.533329964, -83.30000305, "Puerto Jimenez Airport"},
+	{"PKA", "US", 60.70289993, -161.7779999, "Napaskiak Airport"},
+	{"PKB", "US", 39.3451004, -81.43920135, "Mid Ohio Valley Regional Airport"},
+	{"PKC", "RU", 53.16790009, 158.4539948, "Yelizovo Airport"},
+	{"PKE", "AU", -33.13140106, 148.2389984, "Parkes Airport"},
+	{"PKG", "MY", 4.244719982, 100.5530014, "Pulau Pangkor Airport"},
+	{"PKH", "GR", 37.29750061, 23.14780045, "Porto Cheli Airport"},
+	{"PKJ", "GT", 15.99750042, -90.74169922, "Playa Grande Airport"},
+	{"PKK", "MM", 21.4043, 95.11125, "Pakhokku Airport"},
+	{"PKN", "ID", -2.705199957, 111.6729965, "Iskandar Airport"},
+	{"PKO", "BJ", 9.357689857, 2.609679937, "Parakou Airport"},
+	{"PKP", "PF", -14.80949974, -138.8130035, "Puka Puka Airport"},
+	{"PKR", "NP", 28.20089912, 83.98210144, "Pokhara Airport"},
+	{"PKT", "AU", -14.25, 129.529007, "Port Keats Airport"},
+	{"PKU", "ID", 0.460786015, 101.4449997, "Sultan Syarif Kasim Ii (Simpang Tiga) Airport"},
+	{"PKV", "RU", 57.78390121, 28.39559937, "Pskov Airport"},
+	{"PKW", "BW", -22.05830002, 27.8288002, "Selebi Phikwe Airport"},
+	{"PKY", "ID", -2.225130081, 113.9430008, "Tjilik Riwut Airport"},
+	{"PKZ", "LA", 15.13210011, 105.7809982, "Pakse International Airport"},
+	{"PLD", "CR", 10.25, -85.41699982, "Playa Samara Airport"},
+	{"PLE", "PG", -5.3725, 142.9761111, "Paiela Airport"},
+	{"PLF", "TD", 9.378060341, 14.92500019, "Pala Airport"},
+	{"PLH", "GB", 50.42279816, -4.105830193, "Plymouth City Airport"},
+	{"PLJ", "BZ", 16.53695679, -88.36151123, "Placencia Airport"},
+	{"PLL", "BR", -3.146039963, -59.98630142, "Ponta Pelada Airport"},
+	{"PLM", "ID", -2.898250103, 104.6999969, "Sultan Mahmud Badaruddin Ii Airport"},
+	{"PLN", "US", 45.57089996, -84.79669952, "Pellston Regional Airport of Emmet County Airport"},
+	{"PLO", "AU", -34.6053009, 135.8800049, "Port Lincoln Airport"},
+	{"PLP", "PA", 8.406669617, -78.14170074, "Captain Ramon Xatruch Airport"},
+	{"PLQ", "LT", 55.97320175, 21.09390068, "Palanga International Airport"},
+	{"PLS", "TC", 21.77359962, -72.26589966, "Providenciales Airport"},
+	{"PLT", "CO", 9.8, -74.7833, "Plato Airport"},
+	{"PLU", "BR", -19.8512001, -43.95059967, "Pampulha - Carlos Drummond de Andrade Airport"},
+	{"PLV", "UA", 49.5685997, 34.39720154, "Suprunovka Airport"},
+	{"PLW", "ID", -0.918542027, 119.9100037, "Mutiara Airport"},
+	{"PLZ", "ZA", -33.98490143, 25.61730003, "Port Elizabeth Airport"},
+	{"PMA", "TZ", -5.257259846, 39.81140137, "Pemba Airport"},
+	{"PMC", "CL", -41.43889999, -73.09400177, "El Tepual Airport"},
+	{"PMD", "US", 34.62939835, -118.0849991, "Palmdale Regional/USAF Plant 42 Airport"},
+	{"PMF", "IT", 44.82450104, 10.29640007, "Parma Airport"},
+	{"PMG", "BR", -22.5496006, -55.70259857, "Ponta Porã Airport"},
+	{"PMI", "ES", 39.55170059, 2.738810062, "Palma De Mallorca Airport"},
+	{"PMK", "AU", -18.75530052, 146.5809937, "Palm Island Airport"},
+	{"PML", "US", 56.00600052, -160.5610046, "Port Moller Airport"},
+	{"PMN", "PG", -9.7469, 149.4766, "Pumani Airport"},
+	{"PMO", "IT", 38.17599869, 13.0909996, "Falcone–Borsellino Airport"},
+	{"PMP", "PG", -6.499166667, 143.5102778, "Pimaga Airport"},
+	{"PMQ", "AR", -46.53789902, -70.97869873, "Perito Moreno Airport"},
+	{"PMR", "NZ", -40.3205986, 175.6170044, "Palmerston North Airport"},
+	{"PMS", "SY", 34.55739975, 38.31689835, "Palmyra Airport"},
+	{"PMV", "VE", 10.91260338, -63.96659851, "Del Caribe Santiago Mariño International Airport"},
+	{"PMW", "BR", -10.29150009, -48.35699844, "Brigadeiro Lysias Rodrigues Airport"},
+	{"PMX", "US", 42.22330093, -72.31140137, "Metropolitan Airport"},
+	{"PMY", "AR", -42.7592, -65.1027, "El Tehuelche Airport"},
+	{"PMZ", "CR", 8.951029778, -83.46859741, "Palmar Sur Airport"},
+	{"PNA", "ES", 42.77000046, -1.646329999, "Pamplona Airport"},
+	{"PNB", "BR", -10.71940041, -48.39970016, "Porto Nacional Airport"},
+	{"PND", "BZ", 16.10239983, -88.8082962, "Punta Gorda Airport"},
+	{"PNG", "BR", -25.5401001, -48.53120041, "Paranaguá Airport"},
+	{"PNH", "KH", 11.54660034, 104.8440018, "Phnom Penh International Airport"},
+	{"PNI", "FM", 6.985099792, 158.2089996, "Pohnpei International Airport"},
+	{"PNK", "ID", -0.150711, 109.4039993, "Supadio Airport"},
+	{"PNL", "IT", 36.81650162, 11.96889973, "Pantelleria Airport"},
+	{"PNO", "MX", 16.35000038, -98.06199646, "Pinotepa Nacional Airport"},
+	{"PNP", "PG", -8.80453968, 148.3090057, "Girua Airport"},
+	{"PNQ", "IN", 18.58209991, 73.91970062, "Pune Airport"},
+	{"PNR", "CG", -4.816030025, 11.88659954, "Pointe Noire Airport"},
+	{"PNS", "US", 30.47340012, -87.18659973, "Pensacola Regional Airport"},
+	{"PNT", "CL", -51.67150116, -72.52839661, "Tte. Julio Gallardo Airport"},
+	{"PNV", "LT", 55.72940063, 24.46080017, "Panevėžys Air Base"},
+	{"PNY", "IN", 11.96870041, 79.81009674, "Pondicherry Airport"},
+	{"PNZ", "BR", -9.362409592, -40.56909943, "Senador Nilo Coelho Airport"},
+	{"POA", "BR", -29.99440002, -51.17139816, "Salgado Filho Airport"},
+	{"POD", "SN", 16.68300056, -14.96700001, "Podor Airport"},
+	{"POG", "GA", -0.711739004, 8.754380226, "Port Gentil Airport"},
+	{"POI", "BO", -19.54310036, -65.72370148, "Capitan Nicolas Rojas Airport"},
+	{"POJ", "BR", -18.67280006, -46.49119949, "Patos de Minas Airport"},
+	{"POL", "MZ", -12.99176216, 40.52401352, "Pemba Airport"},
+	{"POM", "PG", -9.443380356, 147.2200012, "Port Moresby Jacksons International Airport"},
+	{"PON", "GT", 16.32579994, -89.41609955, "Poptún Airport"},
+	{"POO", "BR", -21.84300041, -46.56790161, "Poços de Caldas Airport"},
+	{"POP", "DO", 19.75790024, -70.56999969, "Gregorio Luperon International Airport"},
+	{"POR", "FI", 61.46170044, 21.79999924, "Pori Airport"},
+	{"POS", "TT", 10.59539986, -61.33720016, "Piarco International Airport"},
+	{"POT", "JM", 18.19879913, -76.53450012, "Ken Jones Airport"},
+	{"POU", "US", 41.62659836, -73.88420105, "Dutchess County Airport"},
+	{"POV", "SK", 49.02970123, 21.31559944, "Prešov Air Base"},
+	{"POW", "SI", 45.47340012, 13.61499977, "Portoroz Airport"},
+	{"POX", "FR", 49.09659958, 2.040829897, "Pontoise - Cormeilles-en-Vexin Airport"},
+	{"POZ", "PL", 52.42100143, 16.82629967, "Poznań-Ławica Airport"},
+	{"PPB", "BR", -22.17510033, -51.42459869, "Presidente Prudente Airport"},
+	{"PPD", "PR", 18.08620071, -65.79650116, "Palmas del Mar Airstrip"},
+	{"PPE", "MX", 31.3562023, -113.5256767, "Puerto Peñasco International Airport"},
+	{"PPG", "AS", -14.33100033, -170.7100067, "Pago Pago International Airport"},
+	{"PPH", "VE", 4.56666708, -61.48333359, "Perai Tepuy Airport"},
+	{"PPI", "AU", -33.23889923, 137.9949951, "Port Pirie Airport"},
+	{"PPJ", "ID", -5.644444, 106.5625, "Pulau Panjang Airport"},
+	{"PPK", "KZ", 54.77470016, 69.18389893, "Petropavlosk South Airport"},
+	{"PPL", "NP", 27.51778688, 86.58445358, "Phaplu Airport"},
+	{"PPN", "CO", 2.4544, -76.6093, "Guillermo León Valencia Airport"},
+	{"PPP", "AU", -20.49500084, 148.552002, "Proserpine Whitsunday Coast Airport"},
+	{"PPQ", "NZ", -40.90470123, 174.9889984, "Paraparaumu Airport"},
+	{"PPR", "ID", 0.84543103, 100.3700027, "Pasir Pangaraan Airport"},
+	{"PPS", "PH", 9.742119789, 118.7590027, "Puerto Princesa Airport"},
+	{"PPT", "PF", -17.55369949, -149.6069946, "Faa\"a International Airport"},
+	{"PPU", "MM", 18.06669998, 97.44979858, "Hpapun Airport"},
+	{"PPW", "GB", 59.35169983, -2.900279999, "Papa Westray Airport"},
+	{"PPY", "BR", -22.28919983, -45.91910172, "Pouso Alegre Airport"},
+	{"PQC", "VN", 10.22700024, 103.9670029, "Phu Quoc Airport"},
+	{"PQI", "US", 46.68899918, -68.0447998, "Northern Maine Regional Airport at Presque Isle"},
+	{"PQM", "MX", 17.53339958, -91.98449707, "Palenque International Airport"},
+	{"PQQ", "AU", -31.43580055, 152.8630066, "Port Macquarie Airport"},
+	{"PRA", "AR", -31.7948, -60.4804, "General Urquiza Airport"},
+	{"PRC", "US", 34.65449905, -112.4199982, "Ernest A. Love Field"},
+	{"PRD", "AU", -20.11750031, 119.5899963, "Pardoo Airport"},
+	{"PRG", "CZ", 50.10079956, 14.26000023, "Ruzyně International Airport"},
+	{"PRH", "TH", 18.13220024, 100.1650009, "Phrae Airport"},
+	{"PRI", "SC", -4.319290161, 55.69139862, "Praslin Airport"},
+	{"PRK", "ZA", -29.68359947, 22.77059937, "Prieska Airport"},
+	{"PRM", "PT", 37.14929962, -8.583959579, "Portimao Airport"},
+	{"PRN", "KS", 42.57279968, 21.03580093, "Priština International Airport"},
+	{"PRP", "FR", 41.66059875, 8.889749527, "Propriano Airport"},
+	{"PRQ", "AR", -26.75361061, -60.49222183, "Termal Airport"},
+	{"PRR", "GY", 5.815450191, -61.05540085, "Paruma Airport"},
+	{"PRS", "SB", -9.641670227, 161.4250031, "Parasi Airport"},
+	{"PRU", "MM", 18.82449913, 95.26599884, "Pyay Airport"},
+	{"PRV", "CZ", 49.42580032, 17.40469933, "Přerov Air Base"},
+	{"PRY", "ZA", -25.65390015, 28.2241993, "Wonderboom Airport"},
+	{"PSA", "IT", 43.68389893, 10.3927002, "Pisa International Airport"},
+	{"PSC", "US", 46.26470184, -119.1190033, "Tri Cities Airport"},
+	{"PSD", "EG", 31.27939987, 32.24000168, "Port Said Airport"},
+	{"PSE", "PR", 18.00830078, -66.56300354, "Mercedita Airport"},
+	{"PSG", "US", 56.80170059, -132.9450073, "Petersburg James A Johnson Airport"},
+	{"PSH", "DE", 54.30888748, 8.686944008, "St. Peter-Ording Airport"},
+	{"PSI", "PK", 25.29050064, 63.3451004, "Pasni Airport"},
+	{"PSJ", "ID", -1.416749954, 120.6579971, "Kasiguncu Airport"},
+	{"PSL", "GB", 56.43920135, -3.372220039, "Perth/Scone Airport"},
+	{"PSM", "US", 43.07789993, -70.82330322, "Portsmouth International at Pease Airport"},
+	{"PSO", "CO", 1.39625, -77.2915, "Antonio Narino Airport"},
+	{"PSP", "US", 33.82970047, -116.5070038, "Palm Springs International Airport"},
+	{"PSR", "IT", 42.43170166, 14.18109989, "Pescara International Airport"},
+	{"PSS", "AR", -27.3858, -55.9707, "Libertador Gral D Jose De San Martin Airport"},
+	{"PST", "CU", 20.73500061, -75.65830231, "Preston Airport"},
+	{"PSU", "ID", 0.835578024, 112.9369965, "Pangsuma Airport"},
+	{"PSV", "GB", 60.32170105, -1.69306004, "Papa Stour Airport"},
+	{"PSW", "BR", -20.73220062, -46.66180038, "Municipal José Figueiredo Airport"},
+	{"PSY", "FK", -51.68569946, -57.77759933, "Stanley Airport"},
+	{"PSZ", "BO", -18.97529984, -57.8205986, "Capitán Av. Salvador Ogaya G. airport"},
+	{"PTA", "US", 60.20429993, -154.3190002, "Port Alsworth Airport"},
+	{"PTF", "FJ", -17.7779007, 177.1970062, "Malolo Lailai Island Airport"},
+	{"PTG", "ZA", -23.84530067, 29.45859909, "Polokwane International Airport"},
+	{"PTH", "US", 56.95909882, -158.6329956, "Port Heiden Airport"},
+	{"PTJ", "AU", -38.31809998, 141.470993, "Portland Airport"},
+	{"PTM", "VE", 7.566669941, -70.1832962, "Palmarito Airport"},
+	{"PTO", "BR", -26.21780014, -52.69430161, "Pato Branco Airport"},
+	{"PTP", "GP", 16.26530075, -61.53179932, "Pointe-à-Pitre Le Raizet"},
+	{"PTQ", "BR", -1.741449952, -52.23609924, "Porto de Moz Airport"},
+	{"PTU", "US", 59.01139832, -161.8200073, "Platinum Airport"},
+	{"PTX", "CO", 1.85777, -76.0857, "Pitalito Airport"},
+	{"PTY", "PA", 9.071359634, -79.38349915, "Tocumen International Airport"},
+	{"PTZ", "EC", -1.505239964, -78.06269836, "Rio Amazonas Airport"},
+	{"PUA", "PG", -2.395, 150.2361, "Puas Airport"},
+	{"PUB", "US", 38.28910065, -104.4970016, "Pueblo Memorial Airport"},
+	{"PUD", "AR", -47.7353, -65.9041, "Puerto Deseado Airport"},
+	{"PUF", "FR", 43.38000107, -0.41861099, "Pau Pyrénées Airport"},
+	{"PUG", "AU", -32.50690079, 137.7169952, "Port Augusta Airport"},
+	{"PUJ", "DO", 18.56739998, -68.36340332, "Punta Cana International Airport"},
+	{"PUK", "PF", -18.29560089, -137.0169983, "Pukarua Airport"},
+	{"PUM", "ID", -4.181089878, 121.6179962, "Pomala Airport"},
+	{"PUN", "CD", -1.366999984, 26.33300018, "Punia Airport"},
+	{"PUP", "BF", 11.14999962, -1.149999976, "Po Airport"},
+	{"PUQ", "CL", -53.00260162, -70.854599, "Pdte. carlos Ibañez del Campo Airport"},
+	{"PUR", "BO", -11.10766315, -67.55115509, "Puerto Rico Airport"},
+	{"PUS", "KR", 35.17950058, 128.9380035, "Gimhae International Airport"},
+	{"PUT", "IN", 14.14929962, 77.79109955, "Sri Sathya Sai Airport"},
+	{"PUU", "CO", 0.505228, -76.5008, "Tres De Mayo Airport"},
+	{"PUV", "NC", -20.28919983, 164.098999, "Poum Airport"},
+	{"PUW", "US", 46.7439003, -117.1100006, "Pullman Moscow Regional Airport"},
+	{"PUX", "CL", -41.34939957, -72.94670105, "El Mirador Airport"},
+	{"PUY", "HR", 44.89350128, 13.9222002, "Pula Airport"},
+	{"PUZ", "NI", 14.0472002, -83.38670349, "Puerto Cabezas Airport"},
+	{"PVA", "CO", 13.3569, -81.3583, "El Embrujo Airport"},
+	{"PVC", "US", 42.07189941, -70.2213974, "Provincetown Municipal Airport"},
+	{"PVD", "US", 41.73260117, -71.42040253, "Theodore Francis Green State Airport"},
+	{"PVG", "CN", 31.14340019, 121.8050003, "Shanghai Pudong International Airport"},
+	{"PVH", "BR", -8.709289551, -63.90230179, "Governador Jorge Teixeira de Oliveira Airport"},
+	{"PVI", "BR", -23.08989906, -52.48849869, "Paranavaí Airport"},
+	{"PVK", "GR", 38.92549896, 20.76530075, "Aktion National Airport"},
+	{"PVN", "BG", 43.45140076, 24.50279999, "Dolna Mitropoliya Air Base"},
+	{"PVO", "EC", -1.041650057, -80.47219849, "Reales Tamarindos Airport"},
+	{"PVR", "MX", 20.68009949, -105.2539978, "Licenciado Gustavo Díaz Ordaz International Airport"},
+	{"PVS", "RU", 64.37809753, -173.2429962, "Provideniya Bay Airport"},
+	{"PWE", "RU", 69.78330231, 170.5970001, "Pevek Airport"},
+	{"PWL", "ID", -7.461669922, 109.4169998, "Purwokerto Airport"},
+	{"PWM", "US", 43.64619827, -70.30930328, "Portland International Jetport Airport"},
+	{"PWN", "BS", 22.82970047, -74.34609985, "Pitts Town Airport"},
+	{"PWO", "CD", -8.467000008, 28.88299942, "Pweto Airport"},
+	{"PWQ", "KZ", 52.19499969, 77.07389832, "Pavlodar Airport"},
+	{"PWT", "US", 47.49020004, -122.7649994, "Bremerton National Airport"},
+	{"PXL", "US", 35.79169846, -110.4229965, "Polacca Airport"},
+	{"PXM", "MX", 15.87689972, -97.0891037, "Puerto Escondido International Airport"},
+	{"PXO", "PT", 33.07339859, -16.35000038, "Porto Santo Airport"},
+	{"PXU", "VN", 14.00450039, 108.0169983, "Pleiku Airport"},
+	{"PYB", "IN", 18.87999916, 82.55200195, "Jeypore Airport"},
+	{"PYC", "PA", 9.305184161, -78.23587418, "Playón Chico Airport"},
+	{"PYE", "CK", -9.014369965, -158.0324097, "Tongareva Airport"},
+	{"PYH", "VE", 5.619989872, -67.60610199, "Cacique Aramare Airport"},
+	{"PYJ", "RU", 66.40039825, 112.0299988, "Polyarny Airport"},
+	{"PYO", "EC", 0.083333001, -75.90000153, "Putumayo Airport"},
+	{"PYR", "GR", 37.92070007, 21.29260063, "Andravida Airport"},
+	{"PYY", "TH", 19.37199974, 98.43699646, "Mae Hong Son Airport"},
+	{"PZA", "CO", 5.87615, -71.8866, "Paz De Ariporo Airport"},
+	{"PZB", "ZA", -29.64900017, 30.39870071, "Pietermaritzburg Airport"},
+	{"PZH", "PK", 31.35840034, 69.46360016, "Zhob Airport"},
+	{"PZI", "CN", 26.54, 101.79852, "Bao\"anying Airport"},
+	{"PZL", "ZA", -27.84939957, 32.30970001, "Zulu Inyala Airport"},
+	{"PZO", "VE", 8.28853035, -62.76039886, "General Manuel Carlos Piar International Airport"},
+	{"PZU", "SD", 19.43359947, 37.23410034, "Port Sudan New International Airport"},
+	{"PZY", "SK", 48.62519836, 17.82839966, "Piešťany Airport"},
+	{"QAC", "BR", -24.80750084, -49.96030045, "Castro Airport"},
+	{"QAK", "BR", -21.26720047, -43.76110077, "Major Brigadeiro Doorgal Borges Airport"},
+	{"QAM", "FR", 49.8730011, 2.387069941, "Amiens-Glisy Airport"},
+	{"QAQ", "IT", 42.37990189, 13.30920029, "L\"Aquila–Preturo Airport"},
+	{"QAR", "NL", 52.06060028, 5.873060226, "Deelen Air Base"},
+	{"QBC", "CA", 52.38750076, -126.5960007, "Bella Coola Airport"},
+	{"QBG", "RS", 44.9, 20.641, "Pančevo Airfield"},
+	{"QBX", "BR", -3.67888999, -40.33679962, "Sobral Airport"},
+	{"QCH", "BR", -19.48699951, -40.57939911, "Colatina Airport"},
+	{"QCJ", "BR", -22.93950081, -48.4679985, "Botucatu Airport"},
+	{"QCN", "BR", -29.3701992, -50.83200073, "Canela Airport"},
+	{"QCO", "CU", 22.71109962, -80.92279816, "Colón Airport"},
+	{"QCP", "BR", -6.280832767, -36.54027939, "Currais Novos Airport"},
+	{"QCR", "BR", -27.28249931, -50.6114006, "Curitibanos Airport"},
+	{"QCY", "GB", 53.0929985, -0.166014001, "RAF Coningsby"},
+	{"QDB", "BR", -30.00189972, -52.94079971, "Cachoeira do Sul Airport"},
+	{"QDC", "BR", -21.46050072, -51.60689926, "Dracena Airport"},
+	{"QDF", "BR", -20.73859978, -43.79740143, "Conselheiro Lafaiete Airport"},
+	{"QDJ", "DZ", 34.6657, 3.351, "Tsletsi Airport"},
+	{"QEF", "DE", 49.95999908, 8.645833015, "Frankfurt-Egelsbach Airport"},
+	{"QEO", "PL", 49.93999863, 19.02199936, "Bielsko-Bialo Kaniow Airfield"},
+	{"QFD", "DZ", 36.54579926, 2.876110077, "Boufarik Airport"},
+	{"QFO", "GB", 52.09080124, 0.131944001, "Duxford Airport"},
+	{"QFR", "IT", 41.64640045, 13.29979992, "Frosinone Military Airport"},
+	{"QGA", "BR", -24.08110046, -54.19169998, "Guaíra Airport"},
+	{"QGB", "BR", -22.603889, -47.411944, "Limeira Airport"},
+	{"QGC", "BR", -22.57839966, -48.77460098, "Lençóis Paulista Airport"},
+	{"QGF", "BR", -29.71940041, -51.48939896, "Montenegro Airport"},
+	{"QGP", "BR", -8.834280014, -36.47159958, "Garanhuns Airport"},
+	{"QGS", "BR", -12.17510033, -38.38019943, "Alagoinhas Airport"},
+	{"QGU", "JP", 35.39410019, 136.8699951, "Gifu Airport"},
+	{"QGY", "HU", 47.62440109, 17.81360054, "Győr-Pér International Airport"},
+	{"QHA", "BE", 50.97000122, 5.375070095, "Kiewit Airfield Hasselt"},
+	{"QHB", "BR", -22.71150017, -47.61819839, "Piracicaba Airport"},
+	{"QHN", "BR", -12.43388939, -46.40055466, "Taguatinga Airport"},
+	{"QHP", "BR", -23.0401001, -45.51599884, "Base de Aviação de Taubaté Airport"},
+	{"QHR", "ET", 8.7163, 39.0059, "Harar Meda Airport"},
+	{"QHU", "DE", 54.50999832, 9.138333321, "Husum-Schwesing Airport"},
+	{"QHV", "BR", -29.69610023, -51.08169937, "Novo Hamburgo Airport"},
+	{"QID", "BR", -21.79059982, -45.26919937, "Mélio Viana Airport"},
+	{"QIE", "FR", 43.52270126, 4.923840046, "Istres Le Tubé/Istres Air Base (BA 125) Airport"},
+	{"QIG", "BR", -6.34664011, -39.29380035, "Iguatu Airport"},
+	{"QIQ", "BR", -22.430104, -47.562113, "Rio Claro Airport"},
+	{"QIT", "BR", -15.24450016, -40.27719879, "Itapetinga Airport"},
+	{"QIZ", "TN", 37.24539948, 9.791449547, "Sidi Ahmed Air Base"},
+	{"QJB", "SA", 27.03899956, 49.40510178, "Jubail Airport"},
+	{"QJD", "AU", -36.42670059, 148.602005, "Jindabyne Airport"},
+	{"QKX", "NO", 69.04029846, 23.0340004, "Kautokeino Air Base"},
+	{"QLA", "GB", 51.18719864, -1.033499956, "Lasham Airport"},
+	{"QLC", "PL", 50.26940155, 18.67280006, "Gliwice Glider Airport"},
+	{"QLD", "DZ", 36.50360107, 2.814169884, "Blida Airport"},
+	{"QLF", "FI", 61.14419937, 25.69350052, "Lahti Vesivehmaa Airport"},
+	{"QLP", "IT", 44.08800125, 9.987950325, "Sarzana-Luni Airport"},
+	{"QLS", "CH", 46.54529953, 6.616670132, "Lausanne-Blécherette Airport"},
+	{"QLT", "IT", 41.54240036, 12.9090004, "Latina Airport"},
+	{"QLU", "PL", 51.22190094, 22.3946991, "Lublin Radwiec Airport"},
+	{"QMF", "BR", -26.15889931, -49.8321991, "Mafra Airport"},
+	{"QMH", "DZ", 35.87969971, 7.270800114, "Oum el Bouaghi airport"},
+	{"QMM", "IT", 43.98600006, 10.14280033, "Massa Cinquale Airport"},
+	{"QMZ", "DE", 49.96749878, 8.147221565, "Mainz-Finthen Airport"},
+	{"QNC", "CH", 46.95750046, 6.864719868, "Neuchatel Airport"},
+	{"QND", "RS", 45.385799, 19.839199, "Cenej Airport"},
+	{"QNJ", "FR", 46.19200134, 6.268390179, "Annemasse Airport"},
+	{"QNM", "BE", 50.48799896, 4.768919945, "Suarlée Airport"},
+	{"QNS", "BR", -29.94589996, -51.14440155, "Canoas Airport"},
+	{"QNV", "BR", -22.74530029, -43.46030045, "Aeroclube Airport"},
+	{"QNX", "FR", 46.29510117, 4.795770168, "Mâcon-Charnay Airport"},
+	{"QOA", "BR", -21.4878006, -47.03440094, "Mococa Airport"},
+	{"QOB", "DE", 49.36111069, 10.66944408, "Ansbach-Petersdorf Airport"},
+	{"QOE", "DE", 50.83119965, 6.658170223, "Norvenich Airport"},
+	{"QOJ", "BR", -28.6548996, -56.0345993, "São Borja Airport"},
+	{"QON", "BE", 49.66279984, 5.886940002, "Arlon-Sterpenich ULM"},
+	{"QOW", "NG", 5.427060127, 7.206029892, "Sam Mbakwe International Airport"},
+	{"QPA", "IT", 45.39580154, 11.84790039, "Padova Airport"},
+	{"QPD", "CU", 22.42140007, -83.67839813, "Pinar Del Rio Airport"},
+	{"QPG", "SG", 1.360419989, 103.9100037, "Paya Lebar Air Base"},
+	{"QPH", "BW", -22.56699944, 27.14999962, "Palapye Airport"},
+	{"QPM", "PL", 50.63330078, 17.78170013, "Opole-Polska Nowa Wieś Airport"},
+	{"QPS", "BR", -21.98460007, -47.33480072, "Campo Fontenelle Airport"},
+	{"QPZ", "IT", 44.9131012, 9.723329544, "Piacenza Airport"},
+	{"QRA", "ZA", -26.24250031, 28.15119934, "Rand Airport"},
+	{"QRC", "CL", -34.17369843, -70.77570343, "De La Independencia Airport"},
+	{"QRF", "AR", -35.144, -60.4803, "Bragado Airport"},
+	{"QRM", "AU", -32.21469879, 148.2250061, "Narromine Airport"},
+	{"QRO", "MX", 20.61730003, -100.185997, "Querétaro Intercontinental Airport"},
+	{"QRR", "AU", -31.73329926, 147.8029938, "Warren Airport"},
+	{"QRT", "IT", 42.42720032, 12.85169983, "Rieti Airport"},
+	{"QRW", "NG", 5.596109867, 5.817780018, "Warri Airport"},
+	{"QRZ", "BR", -22.47850037, -44.4803009, "Resende Airport"},
+	{"QSA", "ES", 41.52090073, 2.105079889, "Sabadell Airport"},
+	{"QSC", "BR", -21.87540054, -47.90370178, "São Carlos Airport"},
+	{"QSF", "DZ", 36.17810059, 5.32449007, "Ain Arnat Airport"},
+	{"QSI", "TZ", -3.363329887, 37.32690048, "Moshi Airport"},
+	{"QSN", "CU", 22.7560997, -81.92089844, "San Nicolas De Bari Airport"},
+	{"QSR", "IT", 40.62039948, 14.91129971, "Salerno Costa d\"Amalfi Airport"},
+	{"QSX", "GY", 6.244324, -57.474172, "New Amsterdam Airport"},
+	{"QTJ", "FR", 48.45890045, 1.523890018, "Chartres – Métropole Airport"},
+	{"QUB", "LY", 26.56749916, 12.82310009, "Ubari Airport"},
+	{"QUG", "GB", 50.8594017, -0.759167016, "Chichester/Goodwood Airport"},
+	{"QUN", "KR", 37.88380051, 127.7180023, "A-306 Airport"},
+	{"QUO", "NG", 4.8725, 8.093, "Akwa Ibom International Airport"},
+	{"QUS", "NG", 12.17169952, 6.696109772, "Gusau Airport"},
+	{"QUT", "JP", 36.51449966, 139.8710022, "Utsunomiya Airport"},
+	{"QUY", "GB", 52.35720062, -0.107832998, "RAF Wyton"},
+	{"QVA", "IT", 45.74219894, 8.888230324, "Varese-Venegono Airport"},
+	{"QVB", "BR", -26.23340034, -51.06779861, "União da Vitória Airport"},
+	{"QVE", "FI", 60.80189896, 23.64559937, "Forssa Airport"},
+	{"QVP", "BR", -23.09250069, -48.98740005, "Avaré-Arandu Airport"},
+	{"QWG", "US", 35.21379852, -80.67009735, "Wilgrove Air Park"},
+	{"QWS", "PL", 49.46279907, 20.0503006, "Nowy Targ Airport"},
+	{"QWV", "RS", 44.29781667, 20.02191111, "Divci Airport"},
+	{"QXC", "BR", -9.375277519, -35.54166794, "Fazenda São Braz Airport"},
+	{"QXH", "DE", 52.20360947, 13.15638924, "Schönhagen Airport"},
+	{"QYD", "PL", 54.57970047, 18.51720047, "Oksywie Military Air Base"},
+	{"QYR", "FR", 48.32210159, 4.016699791, "Troyes-Barberey Airport"},
+	{"QYY", "PL", 53.10139847, 23.17060089, "Białystok-Krywlany Airport"},
+	{"QZD", "HU", 46.24689865, 20.09079933, "Szeged Glider Airport"},
+	{"QZN", "DZ", 35.75220108, 0.626272023, "Relizane Airport"},
+	{"QZO", "IT", 43.45589828, 11.84700012, "Arezzo Airport"},
+	{"RAA", "PG", -4.2096, 152.4385, "Rakanda Airport"},
+	{"RAB", "PG", -4.340459824, 152.3800049, "Tokua Airport"},
+	{"RAE", "SA", 30.90660095, 41.13819885, "Arar Domestic Airport"},
+	{"RAG", "NZ", -37.80469894, 174.8600006, "Raglan Airfield"},
+	{"RAH", "SA", 29.62639999, 43.49060059, "Rafha Domestic Airport"},
+	{"RAI", "CV", 14.92450047, -23.49349976, "Praia International Airport"},
+	{"RAJ", "IN", 22.30920029, 70.77950287, "Rajkot Airport"},
+	{"RAK", "MA", 31.60689926, -8.036299706, "Menara Airport"},
+	{"RAL", "US", 33.95190048, -117.4449997, "Riverside Municipal Airport"},
+	{"RAM", "AU", -12.35639954, 134.897995, "Ramingining Airport"},
+	{"RAN", "IT", 44.36429977, 12.22490025, "Ravenna Airport"},
+	{"RAO", "BR", -21.13638878, -47.77666855, "Leite Lopes Airport"},
+	{"RAP", "US", 44.04529953, -103.0569992, "Rapid City Regional Airport"},
+	{"RAQ", "ID", -4.860070229, 122.7200012, "Sugimanuru Airport"},
+	{"RAR", "CK", -21.20269966, -159.8059998, "Rarotonga International Airport"},
+	{"RAS", "IR", 37.323333, 49.617778, "Sardar-e-Jangal Airport"},
+	{"RAV", "CO", 6.31684, -70.2107, "Cravo Norte Airport"},
+	{"RAW", "PG", -6.220555556, 155.5713889, "Arawa Airport"},
+	{"RAX", "PG", -9.632916667, 148.0480556, "Oram Airport"},
+	{"RAZ", "PK", 33.84970093, 73.79810333, "Rawalakot Airport"},
+	{"RBA", "MA", 34.05149841, -6.751520157, "Rabat-Salé Airport"},
+	{"RBB", "BR", -4.406340122, -59.60240173, "Borba Airport"},
+	{"RBC", "AU", -34.65000153, 142.7830048, "Robinvale Airport"},
+	{"RBD", "US", 32.68090057, -96.86820221, "Dallas Executive Airport"},
+	{"RBE", "KH", 13.72999954, 106.9869995, "Ratanakiri Airport"},
+	{"RBF", "US", 34.26380157, -116.8560028, "Big Bear City Airport"},
+	{"RBG", "US", 43.23880005, -123.3560028, "Roseburg Regional Airport"},
+	{"RBI", "FJ", -16.5337, 179.9757, "Rabi Island Airport"},
+	{"RBJ", "JP", 45.45500183, 141.0390015, "Rebun Airport"},
+	{"RBK", "US", 33.57419968, -117.1279984, "French Valley Airport"},
+	{"RBM", "DE", 48.90083313, 12.51666737, "Straubing Airport"},
+	{"RBO", "BO", -18.32920074, -59.76499939, "Roboré Airport"},
+	{"RBP", "PG", -9.971111111, 149.8322222, "Raba Raba Airport"},
+	{"RBQ", "BO", -14.42790031, -67.49680328, "Rurenabaque Airport"},
+	{"RBR", "BR", -9.868888855, -67.89805603, "Plácido de Castro Airport"},
+	{"RBS", "AU", -37.79000092, 148.6100006, "Orbost Airport"},
+	{"RBT", "KE", 2.349999905, 37.98300171, "Segel Airport"},
+	{"RBU", "AU", -20.76169968, 117.1569977, "Roebourne Airport"},
+	{"RBV", "SB", -8.168060303, 157.6430054, "Ramata Airport"},
+	{"RBW", "US", 32.92100143, -80.64060211, "Lowcountry Regional Airport"},
+	{"RBX", "SS", 6.824999809, 29.66900063, "Rumbek Airport"},
+	{"RBY", "US", 64.72720337, -155.4700012, "Ruby Airport"},
+	{"RCB", "ZA", -28.74099922, 32.09209824, "Richards Bay Airport"},
+	{"RCH", "CO", 11.5262, -72.926, "Almirante Padilla Airport"},
+	{"RCL", "VU", -15.47200012, 167.8350067, "Redcliffe Airport"},
+	{"RCM", "AU", -20.70190048, 143.1150055, "Richmond Airport"},
+	{"RCO", "FR", 45.88779831, -0.983056009, "Rochefort-Saint-Agnant (BA 721) Airport"},
+	{"RCQ", "AR", -29.2103, -59.68, "Reconquista Airport"},
+	{"RCR", "US", 41.06560135, -86.18170166, "Fulton County Airport"},
+	{"RCS", "GB", 51.35189819, 0.503332973, "Rochester Airport"},
+	{"RCT", "US", 43.90000153, -85.51670074, "Nartron Field"},
+	{"RCU", "AR", -33.08509827, -64.26129913, "Area De Material Airport"},
+	{"RCY", "BS", 23.68440056, -74.8361969, "Rum Cay Airport"},
+	{"RDA", "AU", -18.95330048, 135.201004, "Rockhampton Downs Airport"},
+	{"RDB", "US", 68.03209686, -162.8990021, "Red Dog Airport"},
+	{"RDC", "BR", -8.033289909, -49.97990036, "Redenção Airport"},
+	{"RDD", "US", 40.50899887, -122.2929993, "Redding Municipal Airport"},
+	{"RDE", "ID", -1.583330035, 133.3329926, "Merdei Airport"},
+	{"RDG", "US", 40.37850189, -75.96520233, "Reading Regional Carl A Spaatz Field"},
+	{"RDM", "US", 44.2541008, -121.1500015, "Roberts Field"},
+	{"RDN", "MY", 5.76527977, 103.0070038, "LTS Pulau Redang Airport"},
+	{"RDR", "US", 47.96110153, -97.40119934, "Grand Forks Air Force Base"},
+	{"RDS", "AR", -37.3905983, -68.90419769, "Rincon De Los Sauces Airport"},
+	{"RDT", "SN", 16.43300056, -15.64999962, "Richard Toll Airport"},
+	{"RDU", "US", 35.87760162, -78.78749847, "Raleigh Durham International Airport"},
+	{"RDV", "US", 61.7881012, -157.3500061, "Red Devil Airport"},
+	{"RDZ", "FR", 44.40790176, 2.482670069, "Rodez-Marcillac Airport"},
+	{"REA", "PF", -18.46590042, -136.4400024, "Reao Airport"},
+	{"REB", "DE", 53.30638886, 12.75222206, "Rechlin-Lärz Airport"},
+	{"REC", "BR", -8.126489639, -34.92359924, "Guararapes - Gilberto Freyre International Airport"},
+	{"REG", "IT", 38.07120132, 15.65159988, "Reggio Calabria Airport"},
+	{"REI", "GF", 4.314720154, -52.13169861, "Regina Airport"},
+	{"REL", "AR", -43.2105, -65.2703, "Almirante Marco Andres Zar Airport"},
+	{"REN", "RU", 51.79579926, 55.45669937, "Orenburg Central Airport"},
+	{"REP", "KH", 13.41069984, 103.8130035, "Siem Reap International Airport"},
+	{"REQ", "PK", 28.9708004, 62.43330002, "Reko Diq Airport"},
+	{"RER", "GT", 14.52099991, -91.69730377, "Retalhuleu Airport"},
+	{"RES", "AR", -27.45, -59.0561, "Resistencia International Airport"},
+	{"RET", "NO", 67.52780151, 12.10330009, "Røst Airport"},
+	{"REU", "ES", 41.1473999, 1.167170048, "Reus Air Base"},
+	{"REX", "MX", 26.00889969, -98.22850037, "General Lucio Blanco International Airport"},
+	{"REY", "BO", -14.30440044, -67.35340118, "Reyes Airport"},
+	{"RFA", "CF", 4.988609791, 23.92779922, "Rafaï Airport"},
+	{"RFD", "US", 42.19540024, -89.09719849, "Chicago Rockford International Airport"},
+	{"RFN", "IS", 66.40640259, -15.91829967, "Raufarhöfn Airport"},
+	{"RFP", "PF", -16.72290039, -151.4660034, "Raiatea Airport"},
+	{"RFR", "CR", 10.32740021, -83.88760376, "Rio Frio / Progreso Airport"},
+	{"RFS", "NI", 13.88969994, -84.4088974, "Rosita Airport"},
+	{"RGA", "AR", -53.7777, -67.7494, "Hermes Quijada International Airport"},
+	{"RGH", "IN", 25.26169968, 88.79560089, "Balurghat Airport"},
+	{"RGI", "PF", -14.95429993, -147.6609955, "Rangiroa Airport"},
+	{"RGK", "RU", 51.96670151, 85.83329773, "Gorno-Altaysk Airport"},
+	{"RGL", "AR", -51.6089, -69.3126, "Piloto Civil N. Fernández Airport"},
+	{"RGN", "MM", 16.90730095, 96.1332016, "Yangon International Airport"},
+	{"RGO", "KP", 41.42810059, 129.6470032, "Orang Airport"},
+	{"RGS", "ES", 42.35760117, -3.620759964, "Burgos Airport"},
+	{"RGT", "ID", -0.352807999, 102.3349991, "Japura Airport"},
+	{"RHA", "IS", 65.45262909, -22.20611572, "Reykhólar Airport"},
+	{"RHD", "AR", -27.47369957, -64.90550232, "Las Termas Airport"},
+	{"RHE", "FR", 49.31000137, 4.050000191, "Reims-Champagne (BA 112) Airport"},
+	{"RHG", "RW", -1.5, 29.63299942, "Ruhengeri Airport"},
+	{"RHI", "US", 45.63119888, -89.46749878, "Rhinelander Oneida County Airport"},
+	{"RHL", "AU", -22.62581589, 119.9590302, "Roy Hill Station Airport"},
+	{"RHO", "GR", 36.40539932, 28.08620071, "Diagoras Airport"},
+	{"RHP", "NP", 27.3939991, 86.06140137, "Ramechhap Airport"},
+	{"RIA", "BR", -29.71139908, -53.6882019, "Santa Maria Airport"},
+	{"RIB", "BO", -11.0, -66.0, "Capitán Av. Selin Zeitun Lopez Airport"},
+	{"RIC", "US", 37.50519943, -77.31970215, "Richmond International Airport"},
+	{"RIG", "BR", -32.081699, -52.163299, "Rio Grande Airport"},
+	{"RIJ", "PE", -6.067860126, -77.16000366, "Juan Simons Vela Airport"},
+	{"RIK", "CR", 9.870510101, -85.48139954, "Carrillo Airport"},
+	{"RIM", "PE", -6.392310143, -77.50119781, "San Nicolas Airport"},
+	{"RIN", "SB", -8.126390457, 157.1430054, "Ringi Cove Airport"},
+	{"RIS", "JP", 45.24200058, 141.1860046, "Rishiri Airport"},
+	{"RIW", "US", 43.06420136, -108.4599991, "Riverton Regional Airport"},
+	{"RIX", "LV", 56.92359924, 23.97109985, "Riga International Airport"},
+	{"RIY", "YE", 14.66259956, 49.375, "Mukalla International Airport"},
+	{"RJA", "IN", 17.11039925, 81.81819916, "Rajahmundry Airport"},
+	{"RJB", "NP", 26.5170002, 86.75, "Rajbiraj Airport"},
+	{"RJH", "BD", 24.43720055, 88.61650085, "Shah Mokhdum Airport"},
+	{"RJI", "IN", 33.37789917, 74.31520081, "Rajouri Airport"},
+	{"RJK", "HR", 45.21689987, 14.5703001, "Rijeka Airport"},
+	{"RJL", "ES", 42.46095349, -2.322235107, "Logroño-Agoncillo Airport"},
+	{"RJN", "IR", 30.29770088, 56.05110168, "Rafsanjan Airport"},
+	{"RKA", "PF", -15.48530006, -145.4700012, "Aratika Nord Airport"},
+	{"RKD", "US", 44.06010056, -69.09919739, "Knox County Regional Airport"},
+	{"RKE", "DK", 55.58560181, 12.13140011, "Copenhagen Roskilde Airport"},
+	{"RKI", "ID", -0.949999988, 100.75, "Rokot Airport"},
+	{"RKO", "ID", -2.099119902, 99.70069885, "Rokot Airport"},
+	{"RKS", "US", 41.59420013, -109.0650024, "Rock Springs Sweetwater County Airport"},
+	{"RKT", "AE", 25.6135006, 55.93880081, "Ras Al Khaimah International Airport"},
+	{"RKU", "PG", -8.817000054, 146.5243833, "Kairuku Airport"},
+	{"RKV", "IS", 64.12999725, -21.94059944, "Reykjavik Airport"},
+	{"RLA", "US", 37.93569946, -91.81349945, "Rolla Downtown Airport"},
+	{"RLG", "DE", 53.91820145, 12.27830029, "Rostock-Laage Airport"},
+	{"RLO", "AR", -32.38470078, -65.18650055, "Valle Del Conlara International Airport"},
+	{"RMA", "AU", -26.54500008, 148.7749939, "Roma Airport"},
+	{"RMB", "OM", 24.2329998, 55.78300095, "Buraimi Airport"},
+	{"RMF", "EG", 25.5571003, 34.58369827, "Marsa Alam International Airport"},
+	{"RMI", "IT", 44.02030182, 12.61170006, "Federico Fellini International Airport"},
+	{"RMK", "AU", -34.19639969, 140.673996, "Renmark Airport"},
+	{"RML", "LK", 6.821990013, 79.88619995, "Colombo Ratmalana Airport"},
+	{"RMN", "PG", -5.897222222, 141.2716667, "Rumginae Airport"},
+	{"RMQ", "TW", 24.26469994, 120.6210022, "Taichung Ching Chuang Kang Airport"},
+	{"RMS", "DE", 49.43690109, 7.600279808, "Ramstein Air Base"},
+	{"RMT", "PF", -22.63725, -152.8059, "Rimatara Airport"},
+	{"RMU", "ES", 37.803, -1.125, "Corvera/Murcia International Airport"},
+	{"RMY", "US", 37.51089859, -120.0400009, "Mariposa Yosemite Airport"},
+	{"RNA", "SB", -9.860543583, 161.9795465, "Ulawa Airport"},
+	{"RNB", "SE", 56.26670074, 15.26500034, "Ronneby Airport"},
+	{"RNE", "FR", 46.05830002, 4.00138998, "Roanne-Renaison Airport"},
+	{"RNI", "NI", 12.16289997, -83.063797, "Corn Island"},
+	{"RNJ", "JP", 27.04400063, 128.4019928, "Yoron Airport"},
+	{"RNL", "SB", -11.53390026, 160.0630035, "Rennell/Tingoa Airport"},
+	{"RNM", "OM", 21.38299942, 57.04999924, "Qarn Alam Airport"},
+	{"RNN", "DK", 55.06330109, 14.75959969, "Bornholm Airport"},
+	{"RNO", "US", 39.49909973, -119.7679977, "Reno Tahoe International Airport"},
+	{"RNP", "MH", 11.15719986, 166.8869934, "Rongelap Island Airport"},
+	{"RNS", "FR", 48.06949997, -1.734789968, "Rennes-Saint-Jacques Airport"},
+	{"RNU", "MY", 5.949999809, 116.6669998, "Ranau Airport"},
+	{"ROA", "US", 37.32550049, -79.97540283, "Roanoke–Blacksburg Regional Airport"},
+	{"ROB", "LR", 6.233789921, -10.36229992, "Roberts International Airport"},
+	{"ROC", "US", 43.1189003, -77.67240143, "Greater Rochester International Airport"},
+	{"ROD", "ZA", -33.81219864, 19.90279961, "Robertson Airport"},
+	{"ROF", "US", 41.73040009, -122.5459976, "Montague-Yreka Rohrer Field"},
+	{"ROH", "AU", -18.84499931, 143.7100067, "Robinhood Airport"},
+	{"ROI", "TH", 16.11680031, 103.7740021, "Roi Et Airport"},
+	{"ROK", "AU", -23.38190079, 150.4750061, "Rockhampton Airport"},
+	{"ROO", "BR", -16.58600044, -54.72480011, "Rondonópolis Airport"},
+	{"ROP", "MP", 14.17430019, 145.2429962, "Rota International Airport"},
+	{"ROR", "PW", 7.367650032, 134.5440063, "Babelthuap Airport"},
+	{"ROS", "AR", -32.9036, -60.785, "Islas Malvinas Airport"},
+	{"ROT", "NZ", -38.10919952, 176.3170013, "Rotorua Regional Airport"},
+	{"ROV", "RU", 47.2582016, 39.81809998, "Rostov-na-Donu Airport"},
+	{"ROW", "US", 33.30160141, -104.5309982, "Roswell International Air Center Airport"},
+	{"ROY", "AR", -45.70389938, -70.24559784, "Rio Mayo Airport"},
+	{"ROZ", "ES", 36.64519882, -6.349460125, "Rota Naval Station Airport"},
+	{"RPA", "NP", 28.2670002, 82.76699829, "Rolpa Airport"},
+	{"RPB", "AU", -14.734814, 134.525485, "Roper Bar Airport"},
+	{"RPM", "AU", -14.72280025, 134.746994, "Ngukurr Airport"},
+	{"RPN", "IL", 32.98099899, 35.57189941, "Ben Ya\"akov Airport"},
+	{"RPR", "IN", 21.18040085, 81.73880005, "Raipur Airport"},
+	{"RPX", "US", 46.475095, -108.541497, "Roundup Airport"},
+	{"RQO", "US", 35.47269821, -98.00579834, "El Reno Regional Airport"},
+	{"RQW", "IQ", 35.76720047, 43.12509918, "Qayyarah West Airport"},
+	{"RRE", "AU", -29.66329956, 138.0650024, "Marree Airport"},
+	{"RRG", "MU", -19.75769997, 63.36100006, "Sir Charles Gaetan Duval Airport"},
+	{"RRK", "IN", 22.25670052, 84.81459808, "Rourkela Airport"},
+	{"RRR", "PF", -16.045, -142.476944, "Raroia Airport"},
+	{"RRS", "NO", 62.57839966, 11.34230042, "Røros Airport"},
+	{"RSA", "AR", -36.5882988, -64.27570343, "Santa Rosa Airport"},
+	{"RSB", "AU", -25.83329964, 139.6499939, "Roseberth Airport"},
+	{"RSD", "BS", 24.89507873, -76.17688179, "Rock Sound Airport"},
+	{"RSK", "ID", -1.508450031, 134.1869965, "Abresso Airport"},
+	{"RSS", "SD", 11.78590012, 34.33670044, "Damazin Airport"},
+	{"RST", "US", 43.90829849, -92.5, "Rochester International Airport"},
+	{"RSU", "KR", 34.84230042, 127.6169968, "Yeosu Airport"},
+	{"RSW", "US", 26.53619957, -81.75520325, "Southwest Florida International Airport"},
+	{"RTA", "FJ", -12.48250008, 177.0709991, "Rotuma Airport"},
+	{"RTB", "HN", 16.31679916, -86.52300262, "Juan Manuel Galvez International Airport"},
+	{"RTC", "IN", 17.0135994, 73.32779694, "Ratnagiri Airport"},
+	{"RTG", "ID", -8.597009659, 120.4769974, "Satar Tacik Airport"},
+	{"RTI", "ID", -10.48429966, 123.3740005, "Roti Airport"},
+	{"RTM", "NL", 51.95690155, 4.437220097, "Rotterdam Airport"},
+	{"RTP", "AU", -15.64330006, 141.8430023, "Rutland Plains Airport"},
+	{"RTS", "AU", -32.00669861, 115.5400009, "Rottnest Island Airport"},
+	{"RTW", "RU", 51.56499863, 46.04669952, "Saratov Central Airport"},
+	{"RTY", "AU", -28.58329964, 140.3170013, "Merty Merty Airport"},
+	{"RUA", "UG", 3.049999952, 30.91699982, "Arua Airport"},
+	{"RUD", "IR", 36.4253006, 55.10419846, "Shahroud Airport"},
+	{"RUE", "CD", 0.117142, 29.312992, "Rughenda Airfield"},
+	{"RUF", "ID", -3.633330107, 140.9329987, "Yuruf Airport"},
+	{"RUG", "CN", 32.25788498, 120.5016556, "Rugao Air Base"},
+	{"RUH", "SA", 24.95759964, 46.69879913, "King Khaled International Airport"},
+	{"RUK", "NP", 28.62700081, 82.19499969, "Rukumkot Airport"},
+	{"RUM", "NP", 27.30349922, 86.55039978, "Rumjatar Airport"},
+	{"RUN", "RE", -20.88710022, 55.51029968, "Roland Garros Airport"},
+	{"RUP", "IN", 26.13969994, 89.91000366, "Rupsi India Airport"},
+	{"RUR", "PF", -22.4340992, -151.3609924, "Rurutu Airport"},
+	{"RUS", "SB", -9.86166954, 160.8249969, "Marau Airport"},
+	{"RUT", "US", 43.52939987, -72.94960022, "Rutland - Southern Vermont Regional Airport"},
+	{"RUU", "PG", -5.334444444, 144.2566667, "Ruti Airport"},
+	{"RUV", "GT", 15.99199963, -90.44529724, "Rubelsanto Airport"},
+	{"RUY", "HN", 14.84029961, -89.14399719, "Copan Ruinas Airport"},
+	{"RVA", "MG", -22.80529976, 47.8205986, "Farafangana Airport"},
+	{"RVC", "LR", 5.471326, -9.585373, "River Cess Airport/Heliport"},
+	{"RVD", "BR", -17.83472252, -50.95611191, "General Leite de Castro Airport"},
+	{"RVK", "NO", 64.83830261, 11.14610004, "Rørvik Airport, Ryum"},
+	{"RVN", "FI", 66.56479645, 25.83040047, "Rovaniemi Airport"},
+	{"RVO", "ZA", -27.54719925, 24.17250061, "Reivilo Airport"},
+	{"RVR", "US", 38.96139908, -110.2269974, "Green River Municipal Airport"},
+	{"RVT", "AU", -33.79719925, 120.2080002, "Ravensthorpe Airport"},
+	{"RVV", "PF", -23.8852005, -147.6620026, "Raivavae Airport"},
+	{"RVY", "UY", -30.97459984, -55.4762001, "Presidente General Don Oscar D. Gestido International Airport"},
+	{"RWI", "US", 35.85630035, -77.89189911, "Rocky Mount Wilson Regional Airport"},
+	{"RWN", "UA", 50.60710144, 26.14159966, "Rivne International Airport"},
+	{"RXE", "US", 43.83390045, -111.8050003, "Rexburg Madison County Airport"},
+	{"RXS", "PH", 11.59770012, 122.7519989, "Roxas Airport"},
+	{"RYB", "RU", 58.10419846, 38.9294014, "Staroselye Airport"},
+	{"RYG", "NO", 59.37889862, 10.78559971, "Moss Airport, Rygge"},
+	{"RYK", "PK", 28.38389969, 70.27960205, "Shaikh Zaid Airport"},
+	{"RYN", "FR", 45.62810135, -0.972500026, "Royan-Médis Airport"},
+	{"RYO", "AR", -51.60919952, -72.22170258, "28 De Noviembre Airport"},
+	{"RZA", "AR", -50.0165, -68.5792, "Santa Cruz Airport"},
+	{"RZE", "PL", 50.11000061, 22.0189991, "Rzeszów-Jasionka Airport"},
+	{"RZN", "RU", 54.55590057, 39.85520172, "Turlatovo Airport"},
+	{"RZP", "PH", 10.81874, 119.507697, "Cesar Lim Rodriguez Airport"},
+	{"RZR", "IR", 36.90990067, 50.67959976, "Ramsar Airport"},
+	{"RZS", "ID", -8.132780075, 115.1729965, "Sawan Airport"},
+	{"SAB", "BQ", 17.64500046, -63.22000122, "Juancho E. Yrausquin Airport"},
+	{"SAC", "US", 38.51250076, -121.4929962, "Sacramento Executive Airport"},
+	{"SAD", "US", 32.85480118, -109.6350021, "Safford Regional Airport"},
+	{"SAE", "ID", -8.366669655, 118.3333588, "Sangir Airport"},
+	{"SAF", "US", 35.61709976, -106.0889969, "Santa Fe Municipal Airport"},
+	{"SAH", "YE", 15.47630024, 44.21969986, "Sana\"a International Airport"},
+	{"SAK", "IS", 65.73169708, -19.57279968, "Sauðárkrókur Airport"},
+	{"SAL", "SV", 13.44089985, -89.05570221, "El Salvador International Airport"},
+	{"SAM", "PG", -9.6701, 150.7903, "Salamo Airport"},
+	{"SAN", "US", 32.73360062, -117.1900024, "San Diego International Airport"},
+	{"SAP", "HN", 15.45259953, -87.92359924, "Ramón Villeda Morales International Airport"},
+	{"SAQ", "BS", 25.05380058, -78.0490036, "San Andros Airport"},
+	{"SAR", "US", 38.14889908, -89.69869995, "Sparta Community Hunter Field"},
+	{"SAS", "US", 33.24140167, -115.9520035, "Salton Sea Airport"},
+	{"SAT", "US", 29.53370094, -98.46980286, "San Antonio International Airport"},
+	{"SAU", "ID", -10.4924, 121.8482, "Sabu-Tardanu Airport"},
+	{"SAV", "US", 32.12760162, -81.20210266, "Savannah Hilton Head International Airport"},
+	{"SAW", "TR", 40.89860153, 29.30920029, "Sabiha Gökçen International Airport"},
+	{"SAX", "PA", 8.017000198, -78.19999695, "Sambu Airport"},
+	{"SAY", "IT", 43.25630188, 11.25500011, "Siena-Ampugnano Airport"},
+	{"SAZ", "LR", 4.666666985, -8.433333397, "Sasstown Airport"},
+	{"SBA", "US", 34.42620087, -119.8399963, "Santa Barbara Municipal Airport"},
+	{"SBB", "VE", 7.803514004, -71.16571808, "Santa Bárbara de Barinas Airport"},
+	{"SBC", "PG", -5.315, 141.752, "Selbang Airport"},
+	{"SBD", "US", 34.09540176, -117.2350006, "San Bernardino International Airport"},
+	{"SBE", "PG", -6.104444444, 142.2783333, "Suabi Airport"},
+	{"SBF", "AF", 33.3207016, 68.6364975, "Sardeh Band Airport"},
+	{"SBG", "ID", 5.874129772, 95.33969879, "Maimun Saleh Airport"},
+	{"SBH", "BL", 17.90439987, -62.84360123, "Gustaf III Airport"},
+	{"SBI", "GN", 12.57269955, -13.35849953, "Sambailo Airport"},
+	{"SBJ", "BR", -18.72130013, -39.83369827, "São Mateus Airport"},
+	{"SBK", "FR", 48.53779984, -2.854439974, "Saint-Brieuc-Armor Airport"},
+	{"SBL", "BO", -13.76220036, -65.43520355, "Santa Ana Del Yacuma Airport"},
+	{"SBM", "US", 43.76959991, -87.85140228, "Sheboygan County Memorial Airport"},
+	{"SBN", "US", 41.70869827, -86.31729889, "South Bend Regional Airport"},
+	{"SBO", "US", 39.02909851, -111.8379974, "Salina Gunnison Airport"},
+	{"SBP", "US", 35.23680115, -120.6419983, "San Luis County Regional Airport"},
+	{"SBQ", "PK", 29.57119942, 67.84790039, "Sibi Airport"},
+	{"SBR", "AU", -9.378330231, 142.625, "Saibai Island Airport"},
+	{"SBS", "US", 40.5163002, -106.8659973, "Steamboat Springs Bob Adams Field"},
+	{"SBU", "ZA", -29.68930054, 17.93959999, "Springbok Airport"},
+	{"SBV", "PG", -5.5765, 155.0489, "Sabah Airport"},
+	{"SBW", "MY", 2.261600018, 111.9850006, "Sibu Airport"},
+	{"SBX", "US", 48.54069901, -111.8710022, "Shelby Airport"},
+	{"SBY", "US", 38.34049988, -75.51029968, "Salisbury Ocean City Wicomico Regional Airport"},
+	{"SBZ", "RO", 45.78559875, 24.09129906, "Sibiu International Airport"},
+	{"SCC", "US", 70.19470215, -148.4649963, "Deadhorse Airport"},
+	{"SCD", "HN", 14.90719986, -87.26339722, "Sulaco Airport"},
+	{"SCE", "US", 40.84930038, -77.84870148, "University Park Airport"},
+	{"SCG", "AU", -18.63330078, 144.5670013, "Spring Creek Airport"},
+	{"SCI", "VE", 7.801320076, -72.20290375, "Paramillo Airport"},
+	{"SCL", "CL", -33.39300156, -70.78579712, "Comodoro Arturo Merino Benítez International Airport"},
+	{"SCM", "US", 61.84529877, -165.5709991, "Scammon Bay Airport"},
+	{"SCN", "DE", 49.21459961, 7.109509945, "Saarbrücken Airport"},
+	{"SCO", "KZ", 43.86009979, 51.09199905, "Aktau Airport"},
+	{"SCP", "FR", 44.7016983, 6.600279808, "Mont-Dauphin - St-Crépin Airport"},
+	{"SCQ", "ES", 42.89630127, -8.415140152, "Santiago de Compostela Airport"},
+	{"SCS", "GB", 60.43280029, -1.296110034, "Scatsta Airport"},
+	{"SCT", "YE", 12.63070011, 53.90579987, "Socotra International Airport"},
+	{"SCU", "CU", 19.96980095, -75.83540344, "Antonio Maceo International Airport"},
+	{"SCV", "RO", 47.6875, 26.35409927, "Suceava Stefan cel Mare Airport"},
+	{"SCW", "RU", 61.64699936, 50.8451004, "Syktyvkar Airport"},
+	{"SCX", "MX", 16.21260071, -95.20159912, "Salina Cruz Naval Air Station"},
+	{"SCY", "EC", -0.91020602, -89.61740112, "San Cristóbal Airport"},
+	{"SCZ", "SB", -10.72029972, 165.7949982, "Santa Cruz/Graciosa Bay/Luova Airport"},
+	{"SDB", "ZA", -32.96889877, 18.1602993, "Langebaanweg Airport"},
+	{"SDD", "AO", -14.92469978, 13.57499981, "Lubango Airport"},
+	{"SDE", "AR", -27.76555634, -64.30999756, "Vicecomodoro Angel D. La Paz Aragonés Airport"},
+	{"SDF", "US", 38.17440033, -85.73600006, "Louisville International Standiford Field"},
+	{"SDG", "IR", 35.2458992, 47.00920105, "Sanandaj Airport"},
+	{"SDH", "HN", 14.8149004, -88.75150299, "Santa Rosa Copán Airport"},
+	{"SDI", "PG", -5.627138889, 146.4627778, "Saidor Airport"},
+	{"SDJ", "JP", 38.13970184, 140.9170074, "Sendai Airport"},
+	{"SDK", "MY", 5.900899887, 118.0589981, "Sandakan Airport"},
+	{"SDL", "SE", 62.52809906, 17.44389915, "Sundsvall-Härnösand Airport"},
+	{"SDN", "NO", 61.83000183, 6.105830193, "Sandane Airport, Anda"},
+	{"SDP", "US", 55.31499863, -160.522995, "Sand Point Airport"},
+	{"SDQ", "DO", 18.42970085, -69.66889954, "Las Américas International Airport"},
+	{"SDR", "ES", 43.42710114, -3.820009947, "Santander Airport"},
+	{"SDS", "JP", 38.06019974, 138.4140015, "Sado Airport"},
+	{"SDT", "PK", 34.81359863, 72.35279846, "Saidu Sharif Airport"},
+	{"SDU", "BR", -22.91049957, -43.1631012, "Santos Dumont Airport"},
+	{"SDV", "IL", 32.11470032, 34.78219986, "Sde Dov Airport"},
+	{"SDY", "US", 47.70690155, -104.1930008, "Sidney Richland Municipal Airport"},
+	{"SEA", "US", 47.44900131, -122.3089981, "Seattle Tacoma International Airport"},
+	{"SEB", "LY", 26.98699951, 14.47249985, "Sabha Airport"},
+	{"SEH", "ID", -3.433330059, 140.8170013, "Senggeh Airport"},
+	{"SEN", "GB", 51.57139969, 0.695555985, "Southend Airport"},
+	{"SEO", "CI", 7.968329906, -6.710830212, "Seguela Airport"},
+	{"SEQ", "ID", 1.370000005, 102.1399994, "Sungai Pakning Bengkalis Airport"},
+	{"SEU", "TZ", -2.458060026, 34.82249832, "Seronera Airport"},
+	{"SEV", "UA", 48.9003301, 38.54166985, "Sievierodonetsk Airport"},
+	{"SEW", "EG", 29.34550095, 25.50670052, "Siwa Oasis North Airport"},
+	{"SEY", "MR", 15.1796999, -12.20730019, "Sélibaby Airport"},
+	{"SEZ", "SC", -4.674339771, 55.52180099, "Seychelles International Airport"},
+	{"SFA", "TN", 34.7179985, 10.69099998, "Sfax Thyna International Airport"},
+	{"SFB", "US", 28.77759933, -81.23750305, "Orlando Sanford International Airport"},
+	{"SFC", "GP", 16.25779915, -61.26250076, "St-François Airport"},
+	{"SFD", "VE", 7.883319855, -67.44400024, "San Fernando De Apure Airport"},
+	{"SFE", "PH", 16.59560013, 120.3030014, "San Fernando Airport"},
+	{"SFG", "MF", 18.09989929, -63.04719925, "L\"Espérance Airport"},
+	{"SFH", "MX", 30.93020058, -114.8089981, "San Felipe International Airport"},
+	{"SFI", "MA", 32.28329849, -9.233329773, "Safi Airport"},
+	{"SFJ", "GL", 67.0122219, -50.71160316, "Kangerlussuaq Airport"},
+	{"SFK", "BR", -0.699431002, -48.52099991, "Soure Airport"},
+	{"SFL", "CV", 14.88500023, -24.47999954, "São Filipe Airport"},
+	{"SFN", "AR", -31.7117, -60.8117, "Sauce Viejo Airport"},
+	{"SFO", "US", 37.61899948, -122.375, "San Francisco International Airport"},
+	{"SFQ", "TR", 37.09429932, 38.8470993, "Şanlıurfa Airport"},
+	{"SFS", "PH", 14.79440022, 120.2710037, "Subic Bay International Airport"},
+	{"SFT", "SE", 64.62480164, 21.07690048, "Skellefteå Airport"},
+	{"SFU", "PG", -9.593055556, 148.6361111, "Safia Airport"},
+	{"SFX", "VE", 8.350000381, -62.63333511, "San Felix Airport"},
+	{"SFZ", "US", 41.92079926, -71.49140167, "North Central State Airport"},
+	{"SGA", "AF", 37.56700134, 71.5, "Sheghnan Airport"},
+	{"SGC", "RU", 61.34370041, 73.40180206, "Surgut Airport"},
+	{"SGD", "DK", 54.96440125, 9.791729927, "Sønderborg Airport"},
+	{"SGE", "DE", 50.70769882, 8.082969666, "Siegerland Airport"},
+	{"SGF", "US", 37.24570084, -93.38860321, "Springfield Branson National Airport"},
+	{"SGG", "MY", 1.217000008, 111.4499969, "Simanggang Airport"},
+	{"SGI", "PK", 32.04859924, 72.66500092, "Mushaf Air Base"},
+	{"SGK", "PG", -5.125833333, 144.3236111, "Sengapi Airstrip"},
+	{"SGM", "MX", 27.29660034, -112.9380035, "San Ignacio Airport"},
+	{"SGN", "VN", 10.81879997, 106.6520004, "Tan Son Nhat International Airport"},
+	{"SGO", "AU", -28.04969978, 148.5950012, "St George Airport"},
+	{"SGP", "AU", -20.42469978, 120.1409988, "Shay Gap Airport"},
+	{"SGQ", "ID", 0.3847, 117.543, "Sanggata/Sangkimah Airport"},
+	{"SGS", "PH", 5.046989918, 119.7429962, "Sanga Sanga Airport"},
+	{"SGU", "US", 37.03638889, -113.5103056, "St George Municipal Airport"},
+	{"SGV", "AR", -41.59170151, -65.33940125, "Sierra Grande Airport"},
+	{"SGX", "TZ", -10.68299961, 35.58300018, "Songea Airport"},
+	{"SGY", "US", 59.46009827, -135.3159943, "Skagway Airport"},
+	{"SGZ", "TH", 7.186560154, 100.6080017, "Songkhla Airport"},
+	{"SHA", "CN", 31.19790077, 121.3359985, "Shanghai Hongqiao International Airport"},
+	{"SHB", "JP", 43.57749939, 144.9600067, "Nakashibetsu Airport"},
+	{"SHC", "ET", 14.0781002, 38.27249908, "Shire Inda Selassie Airport"},
+	{"SHD", "US", 38.26380157, -78.89640045, "Shenandoah Valley Regional Airport"},
+	{"SHE", "CN", 41.63980103, 123.4830017, "Taoxian Airport"},
+	{"SHH", "US", 66.24960327, -166.0890045, "Shishmaref Airport"},
+	{"SHI", "JP", 24.82670021, 125.1449966, "Shimojishima Airport"},
+	{"SHJ", "AE", 25.32859993, 55.51720047, "Sharjah International Airport"},
+	{"SHK", "LS", -29.73089981, 28.76889992, "Sehonghong Airport"},
+	{"SHL", "IN", 25.70359993, 91.97869873, "Shillong Airport"},
+	{"SHM", "JP", 33.66220093, 135.3639984, "Nanki Shirahama Airport"},
+	{"SHO", "KR", 38.14260101, 128.598999, "Sokcho Airport"},
+	{"SHP", "CN", 39.9681015, 119.7310028, "Shanhaiguan Airport"},
+	{"SHQ", "AU", -27.91500092, 153.3730011, "Southport Airport"},
+	{"SHR", "US", 44.76919937, -106.9800034, "Sheridan County Airport"},
+	{"SHS", "CN", 30.32439995, 112.2809982, "Shashi Airport"},
+	{"SHT", "AU", -36.42890167, 145.3930054, "Shepparton Airport"},
+	{"SHU", "AU", -11.14999962, 132.1499939, "Smith Point Airport"},
+	{"SHV", "US", 32.44660187, -93.82559967, "Shreveport Regional Airport"},
+	{"SHW", "SA", 17.46689987, 47.12139893, "Sharurah Airport"},
+	{"SHX", "US", 62.69229889, -159.5690002, "Shageluk Airport"},
+	{"SHY", "TZ", -3.6093, 33.5035, "Shinyanga Airport"},
+	{"SHZ", "LS", -29.26759911, 28.5522995, "Seshutes Airport"},
+	{"SIA", "CN", 34.37670135, 109.1200027, "Xiguan Airport"},
+	{"SIB", "CG", -3.683000088, 13.35000038, "Sibiti Airport"},
+	{"SIC", "TR", 42.01580048, 35.06639862, "Sinop Airport"},
+	{"SID", "CV", 16.74139977, -22.94939995, "Amílcar Cabral International Airport"},
+	{"SIE", "PT", 37.94169235, -8.817300797, "Sines Airport"},
+	{"SIF", "NP", 27.15950012, 84.98010254, "Simara Airport"},
+	{"SIG", "PR", 18.45680046, -66.09809875, "Fernando Luis Ribas Dominicci Airport"},
+	{"SIH", "NP", 29.26309967, 80.93599701, "Silgadi Doti Airport"},
+	{"SII", "MA", 29.36669922, -10.18780041, "Sidi Ifni Xx Airport"},
+	{"SIJ", "IS", 66.13330078, -18.91670036, "Siglufjordur Airport"},
+	{"SIL", "PG", -9.073555556, 148.38925, "Sila Airport"},
+	{"SIM", "PG", -5.278611111, 144.5447222, "Simbai Airport"},
+	{"SIN", "SG", 1.350190043, 103.9940033, "Singapore Changi International Airport"},
+	{"SIO", "AU", -40.83499908, 145.0839996, "Smithton Airport"},
+	{"SIP", "UA", 45.05220032, 33.97510147, "Simferopol International Airport"},
+	{"SIQ", "ID", -0.479189008, 104.5790024, "Dabo Airport"},
+	{"SIR", "CH", 46.21960068, 7.326759815, "Sion Airport"},
+	{"SIS", "ZA", -27.64859962, 22.9993, "Sishen Airport"},
+	{"SIT", "US", 57.04710007, -135.3619995, "Sitka Rocky Gutierrez Airport"},
+	{"SIU", "NI", 13.72722244, -84.77777863, "Siuna"},
+	{"SIX", "AU", -32.600832, 151.193056, "Singleton Airport"},
+	{"SIZ", "PG", -2.999444444, 142.0445, "Sissano Airport"},
+	{"SJA", "PE", -15.35249996, -75.1371994, "San Juan de Marcona Airport"},
+	{"SJB", "BO", -13.05280018, -64.66169739, "San Joaquín Airport"},
+	{"SJC", "US", 37.36259842, -121.9290009, "Norman Y. Mineta San Jose International Airport"},
+	{"SJD", "MX", 23.15180016, -109.7210007, "Los Cabos International Airport"},
+	{"SJE", "CO", 2.57969, -72.6394, "Jorge E. Gonzalez Torres Airport"},
+	{"SJH", "CO", 10.766667, -73.016667, "San Juan Del César Airport"},
+	{"SJI", "PH", 12.36149979, 121.0469971, "San Jose Airport"},
+	{"SJJ", "BA", 43.82460022, 18.3314991, "Sarajevo International Airport"},
+	{"SJK", "BR", -23.22920036, -45.86149979, "Professor Urbano Ernesto Stumpf Airport"},
+	{"SJL", "BR", -0.14835, -66.9855, "São Gabriel da Cachoeira Airport"},
+	{"SJN", "US", 34.51860046, -109.3789978, "St Johns Industrial Air Park"},
+	{"SJO", "CR", 9.993860245, -84.20880127, "Juan Santamaria International Airport"},
+	{"SJP", "BR", -20.8166008, -49.40650177, "Prof. Eribelto Manoel Reino State Airport"},
+	{"SJQ", "ZM", -17.47629929, 24.30470085, "Sesheke Airport"},
+	{"SJR", "CO", 8.766667, -76.533333, "San Juan De Uraba Airport"},
+	{"SJS", "BO", -17.8307991, -60.74309921, "San José De Chiquitos Airport"},
+	{"SJT", "US", 31.35770035, -100.4960022, "San Angelo Regional Mathis Field"},
+	{"SJU", "PR", 18.43939972, -66.00180054, "Luis Munoz Marin International Airport"},
+	{"SJV", "BO", -16.27079964, -62.47029877, "San Javier Airport"},
+	{"SJW", "CN", 38.28070068, 114.6969986, "Shijiazhuang Daguocun International Airport"},
+	{"SJX", "BZ", 18.35610008, -88.13079834, "Sartaneja Airport"},
+	{"SJY", "FI", 62.69210052, 22.83230019, "Seinäjoki Airport"},
+	{"SJZ", "PT", 38.66550064, -28.17580032, "São Jorge Airport"},
+	{"SKA", "US", 47.61510086, -117.6559982, "Fairchild Air Force Base"},
+	{"SKB", "KN", 17.31119919, -62.71870041, "Robert L. Bradshaw International Airport"},
+	{"SKC", "PG", -8.0466, 141.7222, "Suki Airport"},
+	{"SKD", "UZ", 39.70050049, 66.9838028, "Samarkand Airport"},
+	{"SKE", "NO", 59.18500137, 9.566940308, "Skien Airport"},
+	{"SKF", "US", 29.38419914, -98.58110046, "Lackland Air Force Base"},
+	{"SKG", "GR", 40.5196991, 22.97089958, "Thessaloniki Macedonia International Airport"},
+	{"SKH", "NP", 28.58600044, 81.63600159, "Surkhet Airport"},
+	{"SKK", "US", 64.37110138, -161.223999, "Shaktoolik Airport"},
+	{"SKL", "GB", 57.2533989, -5.827899933, "Skye Bridge Ashaig Airport"},
+	{"SKM", "GY", 5.8599, -57.14894, "Skeldon Airport"},
+	{"SKN", "NO", 68.5788269, 15.03341675, "Stokmarknes Skagen Airport"},
+	{"SKO", "NG", 12.91629982, 5.207190037, "Sadiq Abubakar III International Airport"},
+	{"SKP", "MK", 41.96160126, 21.62140083, "Skopje Alexander the Great Airport"},
+	{"SKQ", "LS", -30.03890038, 28.37030029, "Sekakes Airport"},
+	{"SKS", "DK", 55.2256012, 9.263930321, "Vojens Skrydstrup Airport"},
+	{"SKT", "PK", 32.53555679, 74.3638916, "Sialkot Airport"},
+	{"SKU", "GR", 38.96760178, 24.48719978, "Skiros Airport"},
+	{"SKV", "EG", 28.68530083, 34.0625, "St Catherine International Airport"},
+	{"SKW", "US", 61.96530151, -151.1909943, "Skwentna Airport"},
+	{"SKX", "RU", 54.1251297, 45.21225739, "Saransk Airport"},
+	{"SKY", "US", 41.4333992, -82.65229797, "Griffing Sandusky Airport"},
+	{"SKZ", "PK", 27.72200012, 68.79170227, "Sukkur Airport"},
+	{"SLA", "AR", -24.8560009, -65.48619843, "Martin Miguel De Guemes International Airport"},
+	{"SLB", "US", 42.59730148, -95.24069977, "Storm Lake Municipal Airport"},
+	{"SLC", "US", 40.78839874, -111.9779968, "Salt Lake City International Airport"},
+	{"SLD", "SK", 48.63779831, 19.13409996, "Sliač Airport"},
+	{"SLE", "US", 44.90950012, -123.0029984, "Salem Municipal Airport/McNary Field"},
+	{"SLF", "SA", 20.4647007, 45.61959839, "Sulayel Airport"},
+	{"SLG", "US", 36.19189835, -94.48999786, "Smith Field"},
+	{"SLH", "VU", -13.85169983, 167.5370026, "Sola Airport"},
+	{"SLI", "ZM", -12.17370033, 26.36510086, "Solwesi Airport"},
+	{"SLJ", "AU", -22.2554, 117.7627, "Solomon Aerodrome"},
+	{"SLK", "US", 44.38529968, -74.20619965, "Adirondack Regional Airport"},
+	{"SLL", "OM", 17.0387001, 54.09130096, "Salalah Airport"},
+	{"SLM", "ES", 40.95209885, -5.501989841, "Salamanca Airport"},
+	{"SLN", "US", 38.79100037, -97.65219879, "Salina Municipal Airport"},
+	{"SLP", "MX", 22.25429916, -100.9309998, "Ponciano Arriaga International Airport"},
+	{"SLS", "BG", 44.05519867, 27.17880058, "Silistra Polkovnik Lambrinovo Airfield"},
+	{"SLU", "LC", 14.0202, -60.992901, "George F. L. Charles Airport"},
+	{"SLV", "IN", 31.08180046, 77.06800079, "Shimla Airport"},
+	{"SLW", "MX", 25.54949951, -100.9290009, "Plan De Guadalupe International Airport"},
+	{"SLX", "TC", 21.33300018, -71.19999695, "Salt Cay Airport"},
+	{"SLY", "RU", 66.59079742, 66.61100006, "Salekhard Airport"},
+	{"SLZ", "BR", -2.58536005, -44.23410034, "Marechal Cunha Machado International Airport"},
+	{"SMA", "PT", 36.97140121, -25.17060089, "Santa Maria Airport"},
+	{"SMB", "CL", -52.73669815, -69.33360291, "Franco Bianco Airport"},
+	{"SMF", "US", 38.69540024, -121.5910034, "Sacramento International Airport"},
+	{"SMG", "PE", -11.98333359, -77.0, "Santa Maria Airport"},
+	{"SMH", "PG", -6.075277778, 146.8111111, "Sapmanga Airport"},
+	{"SMI", "GR", 37.68999863, 26.9116993, "Samos Airport"},
+	{"SMJ", "PG", -7.75, 146.9273, "Sim Airport"},
+	{"SML", "BS", 23.5823168, -75.26862144, "Stella Maris Airport"},
+	{"SMM", "MY", 4.449999809, 118.5830002, "Semporna Airport"},
+	{"SMQ", "ID", -2.499190092, 112.9749985, "Sampit(Hasan) Airport"},
+	{"SMR", "CO", 11.1196, -74.2306, "Simón Bolívar International Airport"},
+	{"SMS", "MG", -17.09390068, 49.81579971, "Sainte Marie Airport"},
+	{"SMU", "US", 61.81200027, -147.5070038, "Sheep Mountain Airport"},
+	{"SMV", "CH", 46.53409958, 9.884110451, "Samedan Airport"},
+	{"SMW", "EH", 26.73180008, -11.68470001, "Smara Airport"},
+	{"SMX", "US", 34.89889908, -120.4570007, "Santa Maria Pub/Capt G Allan Hancock Field"},
+	{"SMY", "SN", 13.04679966, -13.29539967, "Simenti Airport"},
+	{"SMZ", "SR", 4.349999905, -54.41666794, "Stoelmanseiland Airport"},
+	{"SNA", "US", 33.67570114, -117.8679962, "John Wayne Airport-Orange County Airport"},
+	{"SNB", "AU", -11.42280006, 130.654007, "Snake Bay Airport"},
+	{"SNC", "EC", -2.20498991, -80.98889923, "General Ulpiano Paez Airport"},
+	{"SNE", "CV", 16.58839989, -24.28470039, "Preguiça Airport"},
+	{"SNF", "VE", 10.27869987, -68.75520325, "Sub Teniente Nestor Arias Airport"},
+	{"SNG", "BO", -16.38360023, -60.96279907, "Capitán Av. Juan Cochamanidis S. Airport"},
+	{"SNH", "AU", -28.62030029, 151.9909973, "Stanthorpe Airport"},
+	{"SNI", "LR", 5.034309864, -9.066800117, "Greenville Sinoe Airport"},
+	{"SNJ", "CU", 22.09530067, -84.15200043, "San Julian Air Base"},
+	{"SNM", "BO", -14.0, -65.6339035, "San Ignacio de Moxos Airport"},
+	{"SNN", "IE", 52.70199966, -8.924819946, "Shannon Airport"},
+	{"SNO", "TH", 17.19510078, 104.1190033, "Sakon Nakhon Airport"},
+	{"SNP", "US", 57.16730118, -170.2200012, "St Paul Island Airport"},
+	{"SNQ", "MX", 30.5288, -115.9462, "San Quintín Military Airstrip"},
+	{"SNR", "FR", 47.31219864, -2.149179935, "Saint-Nazaire-Montoir Airport"},
+	{"SNT", "CO", 7.38322, -73.505402, "Las Cruces Airport"},
+	{"SNU", "CU", 22.49220085, -79.94360352, "Abel Santamaria Airport"},
+	{"SNV", "VE", 4.554999828, -61.15000153, "Santa Elena de Uairen Airport"},
+	{"SNW", "MM", 18.46069908, 94.30010223, "Thandwe Airport"},
+	{"SNX", "DO", 19.06189919, -69.3852005, "Sabana de Mar Airport"},
+	{"SNZ", "BR", -22.93239975, -43.71910095, "Santa Cruz Airport"},
+	{"SOB", "HU", 46.68640137, 17.15909958, "Sármellék International Airport"},
+	{"SOC", "ID", -7.516089916, 110.7570038, "Adi Sumarmo Wiryokusumo Airport"},
+	{"SOD", "BR", -23.47800064, -47.49000168, "Sorocaba Airport"},
+	{"SOE", "CG", 2.066999912, 14.13300037, "Souanke Airport"},
+	{"SOF", "BG", 42.69669342, 23.41143608, "Sofia Airport"},
+	{"SOG", "NO", 61.15610123, 7.13778019, "Sogndal Airport"},
+	{"SOJ", "NO", 69.78679657, 20.95940018, "Sørkjosen Airport"},
+	{"SOK", "LS", -29.83860016, 28.05999947, "Semonkong Airport"},
+	{"SOM", "VE", 8.945146561, -64.1510849, "San Tome Airport"},
+	{"SON", "VU", -15.50500011, 167.2200012, "Santo Pekoa International Airport"},
+	{"SOO", "SE", 61.26150131, 17.09910011, "Söderhamn Airport"},
+	{"SOQ", "ID", -0.926357985, 131.1210022, "Sorong (Jefman) Airport"},
+	{"SOT", "FI", 67.39499664, 26.61910057, "Sodankyla Airport"},
+	{"SOU", "GB", 50.95029831, -1.35679996, "Southampton Airport"},
+	{"SOW", "US", 34.26549912, -110.0059967, "Show Low Regional Airport"},
+	{"SOX", "CO", 5.67732, -72.9703, "Alberto Lleras Camargo Airport"},
+	{"SOY", "GB", 59.15530014, -2.641390085, "Stronsay Airport"},
+	{"SOZ", "FR", 41.92440033, 9.406000137, "Solenzara (BA 126) Air Base"},
+	{"SPC", "ES", 28.62649918, -17.75559998, "La Palma Airport"},
+	{"SPD", "BD", 25.75919914, 88.9088974, "Saidpur Airport"},
+	{"SPE", "MY", 4.732999802, 116.4670029, "Sepulot Airport"},
+	{"SPH", "PG", -8.3038, 147.1659, "Sopu Airport"},
+	{"SPI", "US", 39.84410095, -89.67790222, "Abraham Lincoln Capital Airport"},
+	{"SPJ", "GR", 36.97389984, 22.52630043, "Sparti Airport"},
+	{"SPM", "DE", 49.97269821, 6.692500114, "Spangdahlem Air Base"},
+	{"SPN", "MP", 15.11900043, 145.7290039, "Saipan International Airport"},
+	{"SPP", "AO", -14.6576004, 17.71980095, "Menongue Airport"},
+	{"SPR", "BZ", 17.91390038, -87.97109985, "San Pedro Airport"},
+	{"SPS", "US", 33.98880005, -98.49189758, "Sheppard Air Force Base-Wichita Falls Municipal Airport"},
+	{"SPT", "MY", 5.083333015, 115.5500031, "Sipitang Airport"},
+	{"SPU", "HR", 43.53889847, 16.29800034, "Split Airport"},
+	{"SPY", "CI", 4.746719837, -6.660820007, "San Pedro Airport"},
+	{"SPZ", "US", 36.17639923, -94.11930084, "Springdale Municipal Airport"},
+	{"SQA", "US", 34.60680008, -120.0759964, "Santa Ynez Airport"},
+	{"SQB", "CO", 4.408333, -75.021667, "Santa Ana Airport"},
+	{"SQC", "AU", -31.23999977, 119.3600006, "Southern Cross Airport"},
+	{"SQD", "PE", -6.616680145, -77.76670074, "San Francisco Airport"},
+	{"SQG", "ID", 0.063619003, 111.4729996, "Sintang(Susilo) Airport"},
+	{"SQH", "VN", 21.21699905, 104.0329971, "Na-San Airport"},
+	{"SQL", "US", 61.70050049, -157.1660004, "Sleetmute Airport"},
+	{"SQM", "BR", -13.33129978, -50.19760132, "São Miguel do Araguaia Airport"},
+	{"SQN", "ID", -2.080509901, 125.9670029, "Emalamo Sanana Airport"},
+	{"SQO", "SE", 64.96089935, 17.69659996, "Storuman Airport"},
+	{"SQQ", "LT", 55.89390182, 23.39500046, "Šiauliai International Airport"},
+	{"SQR", "ID", -2.531199932, 121.3580017, "Soroako Airport"},
+	{"SQS", "BZ", 17.1859, -89.0098, "Matthew Spain Airport"},
+	{"SQT", "PG", -10.56277778, 150.6906944, "China Strait Airstrip"},
+	{"SQU", "PE", -6.960030079, -76.7684021, "Saposoa Airport"},
+	{"SQW", "DK", 56.55020142, 9.172980309, "Skive Airport"},
+	{"SQX", "BR", -26.78160095, -53.50350189, "São Miguel do Oeste Airport"},
+	{"SQY", "BR", -31.38330078, -52.03279877, "São Lourenço do Sul Airport"},
+	{"SQZ", "GB", 53.30780029, -0.550832987, "RAF Scampton"},
+	{"SRA", "BR", -27.90670013, -54.520401, "Santa Rosa Airport"},
+	{"SRB", "BO", -14.06620026, -66.78679657, "Santa Rosa De Yacuma Airport"},
+	{"SRD", "BO", -13.2638998, -64.60389709, "San Ramón Airport"},
+	{"SRE", "BO", -19.00709915, -65.28869629, "Juana Azurduy De Padilla Airport"},
+	{"SRG", "ID", -6.97273016, 110.375, "Achmad Yani Airport"},
+	{"SRH", "TD", 9.144439697, 18.37439919, "Sarh Airport"},
+	{"SRI", "ID", -0.484530985, 117.1569977, "Temindung Airport"},
+	{"SRJ", "BO", -14.85919952, -66.73750305, "Capitán Av. German Quiroga G. Airport"},
+	{"SRN", "AU", -42.15499878, 145.2920074, "Strahan Airport"},
+	{"SRP", "NO", 59.79190063, 5.340849876, "Stord Airport"},
+	{"SRQ", "US", 27.39539909, -82.55439758, "Sarasota Bradenton International Airport"},
+	{"SRT", "UG", 1.727689981, 33.62279892, "Soroti Airport"},
+	{"SRV", "US", 61.78969955, -156.5890045, "Stony River 2 Airport"},
+	{"SRX", "LY", 31.06349945, 16.59499931, "Gardabya Airport"},
+	{"SRY", "IR", 36.63579941, 53.1935997, "Dasht-e Naz Airport"},
+	{"SRZ", "BO", -17.81159973, -63.17150116, "El Trompillo Airport"},
+	{"SSA", "BR", -12.9086113, -38.32249832, "Deputado Luiz Eduardo Magalhães International Airport"},
+	{"SSD", "CL", -32.74580002, -70.70500183, "Víctor Lafón Airport"},
+	{"SSE", "IN", 17.62800026, 75.93479919, "Solapur Airport"},
+	{"SSG", "GQ", 3.755270004, 8.708720207, "Malabo Airport"},
+	{"SSH", "EG", 27.97730064, 34.39500046, "Sharm El Sheikh International Airport"},
+	{"SSJ", "NO", 65.95680237, 12.46889973, "Sandnessjøen Airport, Stokka"},
+	{"SSK", "AU", -19.16640091, 128.173996, "Sturt Creek Airport"},
+	{"SSN", "KR", 37.44580078, 127.1139984, "Seoul Air Base"},
+	{"SSO", "BR", -22.09090042, -45.04449844, "São Lourenço Airport"},
+	{"SSR", "VU", -15.4708004, 168.1519928, "Sara Airport"},
+	{"SST", "AR", -36.5423, -56.7218, "Santa Teresita Airport"},
+	{"SSX", "TR", 41.27864456, 36.3051796, "Samsun Samair Airport"},
+	{"SSY", "AO", -6.269899845, 14.24699974, "Mbanza Congo Airport"},
+	{"SSZ", "BR", -23.92805672, -46.29972076, "Base Aérea de Santos Airport"},
+	{"STA", "DK", 55.99010086, 8.353910446, "Stauning Airport"},
+	{"STB", "VE", 8.974550247, -71.94325256, "Santa Bárbara del Zulia Airport"},
+	{"STC", "US", 45.54660034, -94.05989838, "St Cloud Regional Airport"},
+	{"STD", "VE", 7.565380096, -72.03510284, "Mayor Buenaventura Vivas International Airport"},
+	{"STG", "US", 56.57830048, -169.6620026, "St George Airport"},
+	{"STH", "AU", -17.85000038, 142.5670013, "Strathmore Airport"},
+	{"STI", "DO", 19.46990013, -70.70059967, "Santiago Municipal Airport"},
+	{"STL", "US", 38.74869919, -90.37000275, "Lambert St Louis International Airport"},
+	{"STM", "BR", -2.424721956, -54.78583145, "Maestro Wilson Fonseca Airport"},
+	{"STN", "GB", 51.88499832, 0.234999999, "London Stansted Airport"},
+	{"STP", "US", 44.93450165, -93.05999756, "St Paul Downtown Holman Field"},
+	{"STR", "DE", 48.68989944, 9.221960068, "Stuttgart Airport"},
+	{"STS", "US", 38.50899887, -122.8130035, "Charles M. Schulz Sonoma County Airport"},
+	{"STT", "VI", 18.33729935, -64.9733963, "Cyril E. King Airport"},
+	{"STU", "BZ", 18.27210045, -88.45629883, "Santa Cruz Airport"},
+	{"STV", "IN", 21.1140995, 72.7417984, "Surat Airport"},
+	{"STW", "RU", 45.10919952, 42.1128006, "Stavropol Shpakovskoye Airport"},
+	{"STX", "VI", 17.70190048, -64.79859924, "Henry E Rohlsen Airport"},
+	{"STY", "UY", -31.43849945, -57.98529816, "Nueva Hesperides International Airport"},
+	{"STZ", "BR", -10.46472168, -50.51861191, "Santa Terezinha Airport"},
+	{"SUB", "ID", -7.379829884, 112.7870026, "Juanda International Airport"},
+	{"SUF", "IT", 38.90539932, 16.24230003, "Lamezia Terme Airport"},
+	{"SUG", "PH", 9.755838326, 125.4809475, "Surigao Airport"},
+	{"SUH", "OM", 22.53300095, 59.48300171, "Sur Airport"},
+	{"SUI", "GE", 42.85820007, 41.12810135, "Sukhumi Dranda Airport"},
+	{"SUJ", "RO", 47.70330048, 22.88570023, "Satu Mare Airport"},
+	{"SUL", "PK", 28.64509964, 69.17690277, "Sui Airport"},
+	{"SUN", "US", 43.50439835, -114.2959976, "Friedman Memorial Airport"},
+	{"SUO", "US", 43.87630081, -121.4530029, "Sunriver Airport"},
+	{"SUP", "ID", -7.0242, 113.89023, "Trunojoyo Airport"},
+	{"SUQ", "EC", -2.48300004, -78.16699982, "Sucua Airport"},
+	{"SUR", "CA", 52.70859909, -88.54190063, "Summer Beaver Airport"},
+	{"SUT", "TZ", -7.967000008, 31.66699982, "Sumbawanga Airport"},
+	{"SUV", "FJ", -18.04330063, 178.5590057, "Nausori International Airport"},
+	{"SUX", "US", 42.40259933, -96.38439941, "Sioux Gateway Col. Bud Day Field"},
+	{"SVA", "US", 63.68640137, -170.4929962, "Savoonga Airport"},
+	{"SVB", "MG", -14.27859974, 50.17470169, "Sambava Airport"},
+	{"SVC", "US", 32.63650131, -108.1559982, "Grant County Airport"},
+	{"SVD", "VC", 13.14430046, -61.21089935, "E. T. Joshua Airport"},
+	{"SVF", "BJ", 8.018170357, 2.464580059, "Savé Airport"},
+	{"SVG", "NO", 58.87670135, 5.63778019, "Stavanger Airport, Sola"},
+	{"SVI", "CO", 2.15217, -74.7663, "Eduardo Falla Solano Airport"},
+	{"SVJ", "NO", 68.24330139, 14.66919994, "Svolvær Helle Airport"},
+	{"SVK", "BZ", 16.72529984, -88.33999634, "Silver Creek Airport"},
+	{"SVL", "FI", 61.94309998, 28.94510078, "Savonlinna Airport"},
+	{"SVO", "RU", 55.97259903, 37.41460037, "Sheremetyevo International Airport"},
+	{"SVP", "AO", -12.40460014, 16.94739914, "Kuito Airport"},
+	{"SVQ", "ES", 37.41799927, -5.893109798, "Sevilla Airport"},
+	{"SVU", "FJ", -16.80279922, 179.3410034, "Savusavu Airport"},
+	{"SVV", "VE", 7.0, -62.0, "San Salvador de Paul Airport"},
+	{"SVW", "US", 61.09740067, -155.5740051, "Sparrevohn LRRS Airport"},
+	{"SVX", "RU", 56.74309921, 60.80270004, "Koltsovo Airport"},
+	{"SVZ", "VE", 7.840829849, -72.43969727, "San Antonio Del Tachira Airport"},
+	{"SWA", "CN", 23.42690086, 116.762001, "Shantou Waisha Airport"},
+	{"SWB", "AU", -21.51029968, 119.3619995, "Shaw River Airport"},
+	{"SWC", "AU", -37.07170105, 142.7409973, "Stawell Airport"},
+	{"SWD", "US", 60.12689972, -149.4190063, "Seward Airport"},
+	{"SWE", "PG", -6.284639, 147.580833, "Siwea Airport"},
+	{"SWF", "US", 41.5041008, -74.10479736, "Stewart International Airport"},
+	{"SWG", "PG", -6.139555556, 147.2791667, "Satwag Airport"},
+	{"SWH", "AU", -35.37580109, 143.5330048, "Swan Hill Airport"},
+	{"SWJ", "VU", -16.4864, 167.4472, "Southwest Bay Airport"},
+	{"SWN", "PK", 31.88944435, 72.39167023, "Sahiwal Airport"},
+	{"SWP", "NA", -22.66189957, 14.56809998, "Swakopmund Airport"},
+	{"SWQ", "ID", -8.489040375, 117.4120026, "Sumbawa Besar Airport"},
+	{"SWR", "PG", -4.529888889, 153.0544444, "Silur Airport"},
+	{"SWS", "GB", 51.6053009, -4.067830086, "Swansea Airport"},
+	{"SWT", "RU", 60.70940018, 77.66000366, "Strezhevoy Airport"},
+	{"SWU", "KR", 37.23939896, 127.0070038, "Suwon Airport"},
+	{"SWX", "BW", -18.37389946, 21.83259964, "Shakawe Airport"},
+	{"SWY", "MY", 4.216388226, 100.6986084, "Sitiawan Airport"},
+	{"SXA", "PG", -6.0908, 147.5955, "Sialum Airport"},
+	{"SXB", "FR", 48.53829956, 7.628230095, "Strasbourg Airport"},
+	{"SXE", "AU", -38.090827, 146.965335, "West Sale Airport"},
+	{"SXF", "DE", 52.38000107, 13.52250004, "Berlin-Schönefeld International Airport"},
+	{"SXG", "ZM", -16.10000038, 23.2670002, "Senanga Airport"},
+	{"SXH", "PG", -9.964527778, 151.1618611, "Sehulea Airport"},
+	{"SXI", "IR", 25.90889931, 54.53939819, "Sirri Island Airport"},
+	{"SXJ", "CN", 42.9117012, 90.24749756, "Shanshan Airport"},
+	{"SXK", "ID", -7.988609791, 131.3059998, "Saumlaki/Olilit Airport"},
+	{"SXL", "IE", 54.28020096, -8.599209785, "Sligo Airport"},
+	{"SXM", "SX", 18.04100037, -63.10889816, "Princess Juliana International Airport"},
+	{"SXN", "BW", -20.55340004, 26.11580086, "Sua Pan Airport"},
+	{"SXO", "BR", -11.63239956, -50.6896019, "São Félix do Araguaia Airport"},
+	{"SXQ", "US", 60.47570038, -151.0339966, "Soldotna Airport"},
+	{"SXR", "IN", 33.98709869, 74.77420044, "Sheikh ul Alam Airport"},
+	{"SXS", "MY", 5.087779999, 119.0940018, "Sahabat [Sahabat 16] Airport"},
+	{"SXT", "MY", 4.33027792, 102.3949966, "Sungai Tiang Airport"},
+	{"SXU", "ET", 6.816999912, 37.75, "Soddu Airport"},
+	{"SXV", "IN", 11.7833004, 78.06559753, "Salem Airport"},
+	{"SXX", "BR", -6.6413, -51.9523, "São Félix do Xingu Airport"},
+	{"SXZ", "TR", 37.97890091, 41.8404007, "Siirt Airport"},
+	{"SYA", "US", 52.71229935, 174.1139984, "Eareckson Air Station"},
+	{"SYB", "US", 58.3733, -152.2018, "Seal Bay Seaplane Base"},
+	{"SYC", "PE", -11.89999962, -69.16699982, "Shiringayoc/Hacienda Hda Mejia Airport"},
+	{"SYD", "AU", -33.94609833, 151.177002, "Sydney Kingsford Smith International Airport"},
+	{"SYE", "YE", 16.96699905, 43.73300171, "Sadah Airport"},
+	{"SYJ", "IR", 29.55089951, 55.67269897, "Sirjan Airport"},
+	{"SYK", "IS", 65.05809784, -22.7942009, "Stykkishólmur Airport"},
+	{"SYM", "CN", 22.79330063, 100.9589996, "Simao Airport"},
+	{"SYN", "US", 44.4754982, -93.01629639, "Stanton Airfield"},
+	{"SYO", "JP", 38.81219864, 139.7870026, "Shonai Airport"},
+	{"SYP", "PA", 8.085599899, -80.94529724, "Ruben Cantu Airport"},
+	{"SYQ", "CR", 9.957050323, -84.13980103, "Tobias Bolanos International Airport"},
+	{"SYR", "US", 43.11119843, -76.10630035, "Syracuse Hancock International Airport"},
+	{"SYT", "FR", 46.41249847, 4.013259888, "Saint-Yan Airport"},
+	{"SYU", "AU", -10.20829964, 142.8249969, "Warraber Island Airport"},
+	{"SYW", "PK", 26.47310066, 67.71720123, "Sehwan Sharif Airport"},
+	{"SYX", "CN", 18.30290031, 109.4120026, "Sanya Phoenix International Airport"},
+	{"SYY", "GB", 58.21559906, -6.331110001, "Stornoway Airport"},
+	{"SYZ", "IR", 29.53919983, 52.58980179, "Shiraz Shahid Dastghaib International Airport"},
+	{"SZA", "AO", -6.141089916, 12.37180042, "Soyo Airport"},
+	{"SZB", "MY", 3.130579948, 101.5490036, "Sultan Abdul Aziz Shah International Airport"},
+	{"SZF", "TR", 41.25450134, 36.56710052, "Samsun Çarşamba Airport"},
+	{"SZG", "AT", 47.79330063, 13.00430012, "Salzburg Airport"},
+	{"SZJ", "CU", 21.64249992, -82.95510101, "Siguanea Airport"},
+	{"SZK", "ZA", -24.96089935, 31.58869934, "Skukuza Airport"},
+	{"SZN", "US", 34.06060028, -119.9150009, "Santa Cruz Island Airport"},
+	{"SZP", "US", 34.34719849, -119.060997, "Santa Paula Airport"},
+	{"SZR", "BG", 42.37670136, 25.65519905, "Stara Zagora Airport"},
+	{"SZS", "NZ", -46.89970016, 168.1009979, "Ryans Creek Aerodrome"},
+	{"SZT", "MX", 16.69029999, -92.53009796, "San Cristobal De Las Casas Airport"},
+	{"SZV", "CN", 31.26309967, 120.401001, "Guangfu Airport"},
+	{"SZW", "DE", 53.42699814, 11.78339958, "Schwerin Parchim Airport"},
+	{"SZX", "CN", 22.63929939, 113.810997, "Shenzhen Bao\"an International Airport"},
+	{"SZZ", "PL", 53.58470154, 14.90219975, "Szczecin-Goleniów \"Solidarność\" Airport"},
+	{"TAB", "TT", 11.14970016, -60.8321991, "Tobago-Crown Point Airport"},
+	{"TAC", "PH", 11.2276001, 125.0279999, "Daniel Z. Romualdez Airport"},
+	{"TAD", "US", 37.25939941, -104.3410034, "Perry Stokes Airport"},
+	{"TAE", "KR", 35.89410019, 128.6589966, "Daegu Airport"},
+	{"TAF", "DZ", 35.54240036, -0.532278001, "Tafaraoui Airport"},
+	{"TAG", "PH", 9.664079666, 123.8529968, "Tagbilaran Airport"},
+	{"TAH", "VU", -19.45509911, 169.223999, "Tanna Airport"},
+	{"TAI", "YE", 13.68599987, 44.13909912, "Ta\"izz International Airport"},
+	{"TAJ", "PG", -3.198191804, 142.4309635, "Tadji Airport"},
+	{"TAK", "JP", 34.21419907, 134.0160065, "Takamatsu Airport"},
+	{"TAL", "US", 65.17440033, -152.1089935, "Ralph M Calhoun Memorial Airport"},
+	{"TAM", "MX", 22.29640007, -97.86589813, "General Francisco Javier Mina International Airport"},
+	{"TAN", "AU", -27.12999916, 153.3630066, "Tangalooma Airport"},
+	{"TAO", "CN", 36.26610184, 120.3740005, "Liuting Airport"},
+	{"TAP", "MX", 14.79430008, -92.37000275, "Tapachula International Airport"},
+	{"TAQ", "AU", -30.70330048, 134.5839996, "Tarcoola Airport"},
+	{"TAR", "IT", 40.51750183, 17.40320015, "Taranto-Grottaglie \"Marcello Arlotta\" Airport"},
+	{"TAS", "UZ", 41.25790024, 69.28119659, "Tashkent International Airport"},
+	{"TAT", "SK", 49.07360077, 20.24110031, "Poprad-Tatry Airport"},
+	{"TAU", "CO", 5.01281, -72.7424, "Tauramena Airport"},
+	{"TAV", "AS", -14.22920036, -169.5110016, "Tau Airport"},
+	{"TAW", "UY", -31.74900055, -55.92580032, "Tacuarembo Airport"},
+	{"TAX", "ID", -1.642629981, 124.5589981, "Taliabu Island Airport"},
+	{"TAY", "EE", 58.30749893, 26.69039917, "Tartu Airport"},
+	{"TAZ", "TM", 41.76110077, 59.8266983, "Daşoguz Airport"},
+	{"TBB", "VN", 13.04959965, 109.3339996, "Dong Tac Airport"},
+	{"TBC", "US", 36.09280014, -111.3830032, "Tuba City Airport"},
+	{"TBE", "PG", -4.196633, 143.519222, "Timbunke Airport"},
+	{"TBF", "KI", -1.224470019, 174.776001, "Tabiteuea North Airport"},
+	{"TBG", "PG", -5.278610229, 141.2259979, "Tabubil Airport"},
+	{"TBH", "PH", 12.31099987, 122.0849991, "Romblon Airport"},
+	{"TBJ", "TN", 36.97999954, 8.876939774, "Tabarka 7 Novembre Airport"},
+	{"TBK", "AU", -15.61999989, 130.4450073, "Timber Creek Airport"},
+	{"TBL", "AU", -17.2833004, 126.9000015, "Tableland Homestead Airport"},
+	{"TBM", "ID", -1.4694, 113.0833, "Tumbang Samba Airport"},
+	{"TBN", "US", 37.74160004, -92.14070129, "Waynesville-St. Robert Regional Forney field"},
+	{"TBO", "TZ", -5.07638979, 32.83330154, "Tabora Airport"},
+	{"TBP", "PE", -3.55253005, -80.38140106, "Capitan FAP Pedro Canga Rodriguez Airport"},
+	{"TBQ", "PG", -6.466666667, 145.5322222, "Tarabo Airport"},
+	{"TBR", "US", 32.48270035, -81.73690033, "Statesboro Bulloch County Airport"},
+	{"TBS", "GE", 41.6692009, 44.95470047, "Tbilisi International Airport"},
+	{"TBT", "BR", -4.255670071, -69.93579865, "Tabatinga Airport"},
+	{"TBU", "TO", -21.24119949, -175.1499939, "Fua\"amotu International Airport"},
+	{"TBV", "MH", 8.3027, 171.1615, "Tabal Airstrip"},
+	{"TBW", "RU", 52.80609894, 41.48279953, "Donskoye Airport"},
+	{"TBY", "BW", -26.0333004, 22.39999962, "Tshabong Airport"},
+	{"TBZ", "IR", 38.13389969, 46.23500061, "Tabriz International Airport"},
+	{"TCA", "AU", -19.63439941, 134.1829987, "Tennant Creek Airport"},
+	{"TCB", "BS", 26.74530029, -77.39129639, "Treasure Cay Airport"},
+	{"TCC", "US", 35.18280029, -103.6029968, "Tucumcari Municipal Airport"},
+	{"TCD", "CO", -2.867, -69.733002, "Tarapacá Airport"},
+	{"TCE", "RO", 45.0625, 28.71430016, "Tulcea Airport"},
+	{"TCG", "CN", 46.67250061, 83.34079742, "Tacheng Airport"},
+	{"TCH", "GA", -2.849999905, 11.0170002, "Tchibanga Airport"},
+	{"TCJ", "PG", -4.0165, 143.1329, "Torembi Airport"},
+	{"TCK", "PG", -4.0949, 143.3838, "Tinboli Airport"},
+	{"TCL", "US", 33.22060013, -87.61139679, "Tuscaloosa Regional Airport"},
+	{"TCM", "US", 47.13769913, -122.4759979, "McChord Air Force Base"},
+	{"TCN", "MX", 18.49720001, -97.41989899, "Tehuacan Airport"},
+	{"TCO", "CO", 1.81442, -78.7492, "La Florida Airport"},
+	{"TCP", "EG", 29.58779907, 34.77809906, "Taba International Airport"},
+	{"TCQ", "PE", -18.05330086, -70.27580261, "Coronel FAP Carlos Ciriani Santa Rosa International Airport"},
+	{"TCS", "US", 33.23690033, -107.2720032, "Truth Or Consequences Municipal Airport"},
+	{"TCT", "US", 62.99269867, -156.0679932, "Takotna Airport"},
+	{"TCU", "ZA", -29.31780052, 26.82279968, "Thaba Nchu Tar Airport"},
+	{"TCW", "AU", -35.81169891, 145.6080017, "Tocumwal Airport"},
+	{"TCX", "IR", 33.6678009, 56.8927002, "Tabas Airport"},
+	{"TCY", "NA", -19.9713, 13.0249, "Terrace Bay Airport"},
+	{"TCZ", "CN", 24.93805556, 98.48583333, "Tengchong Tuofeng Airport"},
+	{"TDA", "CO", 5.43278, -71.6625, "Trinidad Airport"},
+	{"TDB", "PG", -9.1586, 148.0686, "Tetebedi Airport"},
+	{"TDD", "BO", -14.81869984, -64.91799927, "Teniente Av. Jorge Henrich Arauz Airport"},
+	{"TDG", "PH", 9.072110176, 126.1709976, "Tandag Airport"},
+	{"TDJ", "DJ", 11.78299999, 42.91699982, "Tadjoura Airport"},
+	{"TDK", "KZ", 45.12620163, 78.4469986, "Taldykorgan Airport"},
+	{"TDL", "AR", -37.23740005, -59.22790146, "Héroes De Malvinas Airport"},
+	{"TDN", "AU", -14.78810024, 126.4960022, "Theda Station Airport"},
+	{"TDO", "US", 46.47719955, -122.8059998, "Ed Carlson Memorial Field South Lewis County Airport"},
+	{"TDP", "PE", -3.806010008, -75.03929901, "Trompeteros Airport"},
+	{"TDR", "AU", -24.99329948, 150.0930023, "Theodore Airport"},
+	{"TDS", "PG", -7.6217, 142.868, "Sasereme Airport"},
+	{"TDT", "ZA", -24.53359985, 31.29999924, "Tanda Tula Airport"},
+	{"TDV", "MG", -21.70000076, 43.73300171, "Samangoky Airport"},
+	{"TDW", "US", 35.16989899, -101.8259964, "Tradewind Airport"},
+	{"TDX", "TH", 12.27460003, 102.3190002, "Trat Airport"},
+	{"TDZ", "US", 41.56489944, -83.4822998, "Toledo Executive Airport"},
+	{"TEA", "HN", 15.77589989, -87.47579956, "Tela Airport"},
+	{"TEB", "US", 40.85010147, -74.06079865, "Teterboro Airport"},
+	{"TEC", "BR", -24.31780052, -50.65159988, "Telêmaco Borba Airport"},
+	{"TED", "DK", 57.06880188, 8.705220222, "Thisted Airport"},
+	{"TEE", "DZ", 35.43159866, 8.12071991, "Cheikh Larbi Tébessi Airport"},
+	{"TEF", "AU", -21.71500015, 122.2289963, "Telfer Airport"},
+	{"TEG", "BF", 11.80000019, -0.367000014, "Tenkodogo Airport"},
+	{"TEI", "IN", 27.94120026, 96.13439941, "Tezu Airport"},
+	{"TEK", "US", 60.8689003, -146.6860046, "Tatitlek Airport"},
+	{"TEL", "MY", 5.628610134, 117.1259995, "Telupid Airport"},
+	{"TEM", "AU", -34.42139816, 147.5119934, "Temora Airport"},
+	{"TEN", "CN", 27.883333, 109.308889, "Tongren Fenghuang Airport"},
+	{"TEO", "PG", -8.169722222, 146.1944444, "Terapo Airport"},
+	{"TEP", "PG", -5.9553, 146.5595, "Tep Tep Airport"},
+	{"TEQ", "TR", 41.13819885, 27.91909981, "Tekirdağ Çorlu Airport"},
+	{"TER", "PT", 38.76179886, -27.09079933, "Lajes Field"},
+	{"TES", "ER", 15.11699963, 36.68299866, "Tessenei Airport"},
+	{"TET", "MZ", -16.10479927, 33.64020157, "Chingozi Airport"},
+	{"TEU", "NZ", -45.53310013, 167.6499939, "Manapouri Airport"},
+	{"TEX", "US", 37.9538002, -107.9079971, "Telluride Regional Airport"},
+	{"TEY", "IS", 65.87030029, -23.55999947, "Þingeyri Airport"},
+	{"TEZ", "IN", 26.70910072, 92.78469849, "Tezpur Airport"},
+	{"TFB", "PG", -5.1173, 141.419, "Tifalmin Airport"},
+	{"TFF", "BR", -3.382940054, -64.72409821, "Tefé Airport"},
+	{"TFI", "PG", -9.075954437, 149.3198395, "Tufi Airport"},
+	{"TFL", "BR", -17.89229965, -41.5135994, "Juscelino Kubitscheck Airport"},
+	{"TFM", "PG", -5.126080464, 141.641922, "Telefomin Airport"},
+	{"TFN", "ES", 28.48270035, -16.34149933, "Tenerife Norte Airport"},
+	{"TFS", "ES", 28.04450035, -16.57250023, "Tenerife South Airport"},
+	{"TFT", "PK", 28.96419907, 61.59540176, "Taftan Airport"},
+	{"TFY", "MA", 27.9487, -12.9166, "Tarfaya Airport"},
+	{"TGA", "SG", 1.38725996, 103.7089996, "Tengah Air Base"},
+	{"TGC", "MY", 2.177839994, 111.2020035, "Tanjung Manis Airport"},
+	{"TGD", "ME", 42.3594017, 19.25189972, "Podgorica Airport"},
+	{"TGE", "US", 32.4919014, -85.77559662, "Sharpe Field"},
+	{"TGG", "MY", 5.382639885, 103.1029968, "Sultan Mahmud Airport"},
+	{"TGH", "VU", -16.89109993, 168.5509949, "Tongoa Airport"},
+	{"TGI", "PE", -9.133000374, -75.94999695, "Tingo Maria Airport"},
+	{"TGJ", "NC", -21.09609985, 167.8040009, "Tiga Airport"},
+	{"TGK", "RU", 47.20000076, 38.84999847, "Taganrog Yuzhny Airport"},
+	{"TGL", "PG", -11.33111111, 153.2029167, "Tagula Airport"},
+	{"TGM", "RO", 46.46770096, 24.41250038, "Transilvania Târgu Mureş International Airport"},
+	{"TGN", "AU", -38.2071991, 146.4700012, "Latrobe Valley Airport"},
+	{"TGO", "CN", 43.55670166, 122.1999969, "Tongliao Airport"},
+	{"TGQ", "BR", -14.6619997, -57.44350052, "Tangará da Serra Airport"},
+	{"TGR", "DZ", 33.06779861, 6.088669777, "Touggourt Sidi Madhi Airport"},
+	{"TGS", "MZ", -24.52059937, 32.96530151, "Chokwé Airport"},
+	{"TGT", "TZ", -5.09236002, 39.07120132, "Tanga Airport"},
+	{"TGU", "HN", 14.06089973, -87.21720123, "Toncontín International Airport"},
+	{"TGV", "BG", 43.30659866, 26.70089912, "Bukhovtsi Airfield"},
+	{"TGZ", "MX", 16.56360054, -93.02249908, "Angel Albino Corzo International Airport"},
+	{"THA", "US", 35.38010025, -86.24639893, "Tullahoma Regional Arpt/Wm Northern Field"},
+	{"THB", "LS", -29.52280045, 28.61580086, "Thaba-Tseka Airport"},
+	{"THC", "LR", 6.045650482, -8.138723373, "Tchien Airport"},
+	{"THE", "BR", -5.059939861, -42.82350159, "Senador Petrônio Portela Airport"},
+	{"THG", "AU", -24.4939003, 150.576004, "Thangool Airport"},
+	{"THH", "NZ", -38.18109894, 174.7079926, "Taharoa Aerodrome"},
+	{"THI", "MR", 18.45000076, -9.517000198, "Tichitt Airport"},
+	{"THK", "LA", 17.39999962, 104.8000031, "Thakhek Airport"},
+	{"THL", "MM", 20.48380089, 99.93540192, "Tachileik Airport"},
+	{"THN", "SE", 58.31809998, 12.34500027, "Trollhättan-Vänersborg Airport"},
+	{"THO", "IS", 66.21849823, -15.3355999, "Thorshofn Airport"},
+	{"THQ", "CN", 34.55939865, 105.8600006, "Tianshui Maijishan Airport"},
+	{"THR", "IR", 35.68920135, 51.31340027, "Mehrabad International Airport"},
+	{"THS", "TH", 17.23800087, 99.81819916, "Sukhothai Airport"},
+	{"THT", "MR", 17.2329998, -10.81700039, "Tamchakett Airport"},
+	{"THU", "GL", 76.53119659, -68.70320129, "Thule Air Base"},
+	{"THX", "RU", 65.79720306, 87.93530273, "Turukhansk Airport"},
+	{"THY", "ZA", -23.07690048, 30.38360023, "Thohoyandou Airport"},
+	{"THZ", "NE", 14.8757, 5.265359879, "Tahoua Airport"},
+	{"TIA", "AL", 41.41469955, 19.72060013, "Tirana International Airport Mother Teresa"},
+	{"TIB", "CO", 8.63152, -72.7304, "Tibú Airport"},
+	{"TIC", "MH", 7.133329868, 171.9170074, "Tinak Airport"},
+	{"TID", "DZ", 35.34109879, 1.463150024, "Bou Chekif Airport"},
+	{"TIE", "ET", 7.2024, 35.415, "Tippi Airport"},
+	{"TIF", "SA", 21.48340034, 40.54430008, "Taif Airport"},
+	{"TIH", "PF", -15.1196003, -148.2310028, "Tikehau Airport"},
+	{"TII", "AF", 32.60419846, 65.86579895, "Tarin Kowt Airport"},
+	{"TIJ", "MX", 32.54109955, -116.9700012, "General Abelardo L. Rodríguez International Airport"},
+	{"TIM", "ID", -4.528279781, 136.8869934, "Moses Kilangin Airport"},
+	{"TIN", "DZ", 27.7003994, -8.167099953, "Tindouf Airport"},
+	{"TIO", "MM", 21.70000076, 94.09999847, "Tilin Airport"},
+	{"TIP", "LY", 32.66350174, 13.1590004, "Tripoli International Airport"},
+	{"TIQ", "MP", 14.99919987, 145.6190033, "Tinian International Airport"},
+	{"TIR", "IN", 13.63249969, 79.54329681, "Tirupati Airport"},
+	{"TIU", "NZ", -44.30279922, 171.2250061, "Timaru Airport"},
+	{"TIV", "ME", 42.40470123, 18.72330093, "Tivat Airport"},
+	{"TIY", "MR", 18.57010078, -11.42350006, "Tidjikja Airport"},
+	{"TIZ", "PG", -5.84499979, 142.947998, "Tari Airport"},
+	{"TJA", "BO", -21.5557003, -64.70130157, "Capitan Oriel Lea Plaza Airport"},
+	{"TJB", "ID", 1.0527, 103.3931, "Sei Bati Airport"},
+	{"TJG", "ID", -2.216559887, 115.435997, "Warukin Airport"},
+	{"TJH", "JP", 35.51279831, 134.7870026, "Tajima Airport"},
+	{"TJI", "HN", 15.92679977, -85.9382019, "Trujillo Airport"},
+	{"TJK", "TR", 40.30743027, 36.36740875, "Tokat Airport"},
+	{"TJM", "RU", 57.1896019, 65.32430267, "Roshchino International Airport"},
+	{"TJN", "PF", -15.85470009, -142.2680054, "Takume Airport"},
+	{"TJQ", "ID", -2.74571991, 107.7549973, "Buluh Tumbang (H A S Hanandjoeddin) Airport"},
+	{"TJS", "ID", 2.835833333, 117.3736111, "Tanjung Harapan Airport"},
+	{"TJU", "TJ", 37.98809814, 69.80500031, "Kulob Airport"},
+	{"TJV", "IN", 10.72239971, 79.10160065, "Tanjore Air Force Base"},
+	{"TKB", "PG", -7.6808, 146.5503, "Tekadu Airport"},
+	{"TKC", "CM", 4.089190006, 9.3605299, "Tiko Airport"},
+	{"TKD", "GH", 4.89605999, -1.774760008, "Takoradi Airport"},
+	{"TKF", "US", 39.31999969, -120.1399994, "Truckee Tahoe Airport"},
+	{"TKG", "ID", -5.240556, 105.175556, "Radin Inten II (Branti) Airport"},
+	{"TKH", "TH", 15.27729988, 100.2959976, "Takhli Airport"},
+	{"TKJ", "US", 63.303333, -143.001111, "Tok Airport"},
+	{"TKK", "FM", 7.461870193, 151.8430023, "Chuuk International Airport"},
+	{"TKM", "GT", 17.22638893, -89.60500336, "El Petén Airport"},
+	{"TKN", "JP", 27.83639908, 128.8809967, "Tokunoshima Airport"},
+	{"TKO", "LS", -29.2329998, 28.88299942, "Tlokoeng Airport"},
+	{"TKP", "PF", -14.70950031, -145.2460022, "Takapoto Airport"},
+	{"TKQ", "TZ", -4.8862, 29.6709, "Kigoma Airport"},
+	{"TKR", "BD", 26.01639938, 88.4036026, "Thakurgaon Airport"},
+	{"TKS", "JP", 34.13280106, 134.6069946, "Tokushima Airport"},
+	{"TKT", "TH", 16.89599991, 99.25330353, "Tak Airport"},
+	{"TKU", "FI", 60.51409912, 22.26280022, "Turku Airport"},
+	{"TKV", "PF", -17.3553009, -138.4450073, "Tatakoto Airport"},
+	{"TKW", "PG", -5.243666667, 142.1651944, "Tekin Airport"},
+	{"TKX", "PF", -14.45580006, -145.0249939, "Takaroa Airport"},
+	{"TKY", "AU", -17.04080009, 128.2059937, "Turkey Creek Airport"},
+	{"TKZ", "NZ", -38.23669815, 175.8919983, "Tokoroa Airfield"},
+	{"TLA", "US", 65.24040222, -166.3390045, "Teller Airport"},
+	{"TLB", "PK", 33.98609924, 72.61139679, "Tarbela Dam Airport"},
+	{"TLC", "MX", 19.33709908, -99.56600189, "Licenciado Adolfo Lopez Mateos International Airport"},
+	{"TLD", "BW", -22.18919945, 29.12689972, "Limpopo Valley Airport"},
+	{"TLE", "MG", -23.38339996, 43.72850037, "Toliara Airport"},
+	{"TLH", "US", 30.39649963, -84.35030365, "Tallahassee Regional Airport"},
+	{"TLI", "ID", -1.029770017, 120.8170013, "Toli Toli Airport"},
+	{"TLJ", "US", 62.89440155, -155.977005, "Tatalina LRRS Airport"},
+	{"TLL", "EE", 59.41329956, 24.83279991, "Tallinn Airport"},
+	{"TLM", "DZ", 35.01670074, -1.450000048, "Zenata – Messali El Hadj Airport"},
+	{"TLN", "FR", 43.09730148, 6.146029949, "Toulon-Hyères Airport"},
+	{"TLO", "PG", -4.980830193, 152.0099945, "Tol Airport"},
+	{"TLP", "PG", -4.7748, 141.0133, "Tumolbil Airport"},
+	{"TLS", "FR", 43.6291008, 1.363819957, "Toulouse-Blagnac Airport"},
+	{"TLT", "US", 61.09680176, -160.9689941, "Tuluksak Airport"},
+	{"TLU", "CO", 9.50945, -75.5854, "Tolú Airport"},
+	{"TLV", "IL", 32.01139832, 34.88669968, "Ben Gurion International Airport"},
+	{"TLX", "CL", -35.37779999, -71.60169983, "Panguilemo Airport"},
+	{"TLZ", "BR", -18.21680069, -47.89970016, "Catalão Airport"},
+	{"TMA", "US", 31.42900085, -83.4885025, "Henry Tift Myers Airport"},
+	{"TMB", "US", 25.64789963, -80.43280029, "Kendall-Tamiami Executive Airport"},
+	{"TMC", "ID", -9.409720421, 119.2440033, "Tambolaka Airport"},
+	{"TMD", "MR", 16.2329998, -8.166999817, "Timbedra Airport"},
+	{"TME", "CO", 6.45108, -71.7603, "Gustavo Vargas Airport"},
+	{"TMG", "MY", 5.400000095, 118.6500015, "Tomanggong Airport"},
+	{"TMH", "ID", -6.099219799, 140.2980042, "Tanah Merah Airport"},
+	{"TMI", "NP", 27.31500053, 87.19329834, "Tumling Tar Airport"},
+	{"TMJ", "UZ", 37.2867012, 67.30999756, "Termez Airport"},
+	{"TML", "GH", 9.557189941, -0.863214016, "Tamale Airport"},
+	{"TMM", "MG", -18.10950089, 49.39250183, "Toamasina Airport"},
+	{"TMN", "KI", -2.485830069, 175.9700012, "Tamana Island Airport"},
+	{"TMO", "VE", 7.24938, -61.52893, "Tumeremo Airport"},
+	{"TMP", "FI", 61.41410065, 23.60440063, "Tampere-Pirkkala Airport"},
+	{"TMQ", "BF", 14.80000019, 0.050000001, "Tambao Airport"},
+	{"TMR", "DZ", 22.81150055, 5.451079845, "Aguenar – Hadj Bey Akhamok Airport"},
+	{"TMS", "ST", 0.37817499, 6.712150097, "São Tomé International Airport"},
+	{"TMT", "BR", -1.489599943, -56.39680099, "Trombetas Airport"},
+	{"TMU", "CR", 9.738519669, -85.01380157, "Tambor Airport"},
+	{"TMW", "AU", -31.08390045, 150.8470001, "Tamworth Airport"},
+	{"TMX", "DZ", 29.2371006, 0.276033014, "Timimoun Airport"},
+	{"TMY", "ID", -3.9256, 138.4555, "Tiom Airport"},
+	{"TMZ", "NZ", -37.15670013, 175.5500031, "Thames Aerodrome"},
+	{"TNA", "CN", 36.85720062, 117.2160034, "Yaoqiang Airport"},
+	{"TNB", "ID", -1.910130024, 116.2020035, "Tanah Grogot Airport"},
+	{"TNC", "US", 65.56310272, -167.9219971, "Tin City Long Range Radar Station Airport"},
+	{"TND", "CU", 21.78829956, -79.99720001, "Alberto Delgado Airport"},
+	{"TNE", "JP", 30.60510063, 130.9909973, "New Tanegashima Airport"},
+	{"TNF", "FR", 48.75189972, 2.106189966, "Toussus-le-Noble Airport"},
+	{"TNG", "MA", 35.72689819, -5.916890144, "Ibn Batouta Airport"},
+	{"TNH", "CN", 42.25388889, 125.7033333, "Tonghua Sanyuanpu Airport"},
+	{"TNI", "IN", 24.56229973, 80.85489655, "Satna Airport"},
+	{"TNJ", "ID", 0.922683001, 104.5319977, "Raja Haji Fisabilillah International Airport"},
+	{"TNL", "UA", 49.52420044, 25.70009995, "Ternopil International Airport"},
+	{"TNM", "AQ", -62.19079971, -58.98669815, "Teniente Rodolfo Marsh Martin Base"},
+	{"TNN", "TW", 22.9503994, 120.2060013, "Tainan Airport"},
+	{"TNO", "CR", 10.35569954, -85.85289764, "Cabo Velas Airport"},
+	{"TNR", "MG", -18.7968998, 47.47880173, "Ivato Airport"},
+	{"TNV", "KI", 3.89944005, -159.3890076, "Tabuaeran Island Airport"},
+	{"TNW", "EC", -1.0626, -77.5736, "Jumandy Airport"},
+	{"TNX", "KH", 13.53190041, 106.0149994, "Stung Treng Airport"},
+	{"TNZ", "MN", 48.73887, 98.2941, "Tosontsengel Airport"},
+	{"TOB", "LY", 31.86100006, 23.90699959, "Gamal Abdel Nasser Airport"},
+	{"TOD", "MY", 2.818180084, 104.1600037, "Pulau Tioman Airport"},
+	{"TOE", "TN", 33.93970108, 8.110560417, "Tozeur Nefta International Airport"},
+	{"TOF", "RU", 56.38029861, 85.20829773, "Bogashevo Airport"},
+	{"TOG", "US", 59.05279922, -160.3970032, "Togiak Airport"},
+	{"TOH", "VU", -13.32800007, 166.6380005, "Torres Airstrip"},
+	{"TOJ", "ES", 40.49670029, -3.445869923, "Torrejón Airport"},
+	{"TOL", "US", 41.58679962, -83.80780029, "Toledo Express Airport"},
+	{"TOM", "ML", 16.73049927, -3.007580042, "Timbuktu Airport"},
+	{"TON", "PG", -6.6552, 155.4326, "Tonu Airport"},
+	{"TOO", "CR", 8.826109886, -82.95890045, "San Vito De Java Airport"},
+	{"TOQ", "CL", -22.14109993, -70.06289673, "Barriles Airport"},
+	{"TOS", "NO", 69.6832962, 18.91889954, "Tromsø Airport"},
+	{"TOT", "SR", 5.865829945, -56.32749939, "Totness Airport"},
+	{"TOU", "NC", -20.79000092, 165.2590027, "Touho Airport"},
+	{"TOW", "BR", -24.68630028, -53.69749832, "Toledo Airport"},
+	{"TOX", "RU", 58.13579941, 68.23190308, "Tobolsk Airport"},
+	{"TOY", "JP", 36.64830017, 137.1880035, "Toyama Airport"},
+	{"TPA", "US", 27.97550011, -82.53320313, "Tampa International Airport"},
+	{"TPC", "EC", -0.122956, -76.33779907, "Tarapoa Airport"},
+	{"TPE", "TW", 25.07769966, 121.2330017, "Taiwan Taoyuan International Airport"},
+	{"TPG", "MY", 4.867000103, 100.7170029, "Taiping (Tekah) Airport"},
+	{"TPI", "PG", -8.356666667, 146.9891667, "Tapini Airport"},
+	{"TPJ", "NP", 27.35000038, 84.66699982, "Taplejung Airport"},
+	{"TPK", "ID", 3.1707, 97.2869, "Teuku Cut Ali Airport"},
+	{"TPL", "US", 31.15250015, -97.40779877, "Draughon Miller Central Texas Regional Airport"},
+	{"TPN", "EC", -0.776111007, -75.52639771, "Tiputini Airport"},
+	{"TPP", "PE", -6.508739948, -76.37319946, "Cadete FAP Guillermo Del Castillo Paredes Airport"},
+	{"TPQ", "MX", 21.41950035, -104.8430023, "Amado Nervo National Airport"},
+	{"TPR", "AU", -22.74600029, 117.8690033, "Tom Price Airport"},
+	{"TPS", "IT", 37.91139984, 12.48799992, "Vincenzo Florio Airport Trapani-Birgi"},
+	{"TPX", "PF", -16.2423, -151.8338, "Tupai Airport"},
+	{"TQL", "RU", 64.93080139, 77.81809998, "Tarko-Sale Airport"},
+	{"TQN", "AF", 36.77069855, 69.53199768, "Talolqan Airport"},
+	{"TQS", "CO", 0.7459, -75.234, "Tres Esquinas Air Base"},
+	{"TRA", "JP", 24.65390015, 124.6750031, "Tarama Airport"},
+	{"TRB", "CO", 8.07453, -76.7415, "Gonzalo Mejía Airport"},
+	{"TRC", "MX", 25.56830025, -103.4110031, "Francisco Sarabia International Airport"},
+	{"TRD", "NO", 63.45780182, 10.92399979, "Trondheim Airport, Værnes"},
+	{"TRE", "GB", 56.49919891, -6.869170189, "Tiree Airport"},
+	{"TRF", "NO", 59.18669891, 10.25860024, "Sandefjord Airport, Torp"},
+	{"TRG", "NZ", -37.6719017, 176.1959991, "Tauranga Airport"},
+	{"TRI", "US", 36.47520065, -82.40740204, "Tri Cities Regional Tn Va Airport"},
+	{"TRJ", "PG", -5.614, 141.0421, "Tarakbits Airport"},
+	{"TRK", "ID", 3.326666667, 117.5694444, "Juwata Airport"},
+	{"TRN", "IT", 45.20080185, 7.64963007, "Turin Airport"},
+	{"TRO", "AU", -31.8885994, 152.5140076, "Taree Airport"},
+	{"TRQ", "BR", -8.155260086, -70.78330231, "Tarauacá Airport"},
+	{"TRR", "LK", 8.538510323, 81.18190002, "China Bay Airport"},
+	{"TRS", "IT", 45.82749939, 13.47220039, "Trieste–Friuli Venezia Giulia Airport"},
+	{"TRU", "PE", -8.081410408, -79.1088028, "Capitan FAP Carlos Martinez De Pinillos International Airport"},
+	{"TRV", "IN", 8.48211956, 76.92009735, "Trivandrum International Airport"},
+	{"TRW", "KI", 1.381639957, 173.1470032, "Bonriki International Airport"},
+	{"TRY", "UG", 0.683000028, 34.16699982, "Tororo Airport"},
+	{"TRZ", "IN", 10.76539993, 78.70970154, "Tiruchirapally Civil Airport Airport"},
+	{"TSA", "TW", 25.06940079, 121.552002, "Taipei Songshan Airport"},
+	{"TSB", "NA", -19.26189995, 17.73250008, "Tsumeb Airport"},
+	{"TSC", "EC", -2.381669998, -77.50279999, "Taisha Airport"},
+	{"TSD", "ZA", -22.61930084, 30.17560005, "Tshipise Airport"},
+	{"TSE", "KZ", 51.02220154, 71.46690369, "Astana International Airport"},
+	{"TSF", "IT", 45.64839935, 12.19439983, "Treviso-Sant\"Angelo Airport"},
+	{"TSG", "US", 63.37440109, -143.3359985, "Tanacross Airport"},
+	{"TSH", "CD", -6.438330173, 20.79470062, "Tshikapa Airport"},
+	{"TSJ", "JP", 34.28490067, 129.3309937, "Tsushima Airport"},
+	{"TSL", "MX", 22.03829956, -98.8065033, "Tamuin Airport"},
+	{"TSM", "US", 36.45819855, -105.6719971, "Taos Regional Airport"},
+	{"TSN", "CN", 39.12440109, 117.3460007, "Tianjin Binhai International Airport"},
+	{"TSR", "RO", 45.80989838, 21.33790016, "Timişoara Traian Vuia Airport"},
+	{"TST", "TH", 7.508739948, 99.61660004, "Trang Airport"},
+	{"TSU", "KI", -1.474439979, 175.0639954, "Tabiteuea South Airport"},
+	{"TSV", "AU", -19.25250053, 146.7649994, "Townsville Airport"},
+	{"TSW", "PG", -7.0695, 146.1272, "Tsewi Airport"},
+	{"TSX", "ID", -0.092973001, 117.4530029, "Tanjung Santan Airport"},
+	{"TSY", "ID", -7.346600056, 108.2460022, "Cibeureum Airport"},
+	{"TSZ", "MN", 47.46544, 101.47755, "Tselserleg Airport"},
+	{"TTA", "MA", 28.44820023, -11.16129971, "Tan Tan Airport"},
+	{"TTB", "IT", 39.91880035, 9.682979584, "Tortolì Airport"},
+	{"TTC", "CL", -25.56430054, -70.37590027, "Las Breas Airport"},
+	{"TTE", "ID", 0.831413984, 127.3809967, "Sultan Khairun Babullah Airport"},
+	{"TTG", "AR", -22.6196003, -63.79370117, "General Enrique Mosconi Airport"},
+	{"TTH", "OM", 17.66600037, 54.02460098, "Thumrait Air Base"},
+	{"TTI", "PF", -17.01329994, -149.5870056, "Tetiaroa Airport"},
+	{"TTJ", "JP", 35.53010178, 134.1670074, "Tottori Airport"},
+	{"TTN", "US", 40.27669907, -74.81349945, "Trenton Mercer Airport"},
+	{"TTQ", "CR", 10.56900024, -83.51480103, "Aerotortuguero Airport"},
+	{"TTR", "ID", -3.044739962, 119.8219986, "Pongtiku Airport"},
+	{"TTS", "MG", -16.7510644, 47.61901617, "Tsaratanana Airport"},
+	{"TTT", "TW", 22.75499916, 121.1019974, "Taitung Airport"},
+	{"TTU", "MA", 35.59429932, -5.320020199, "Saniat Rmel Airport"},
+	{"TUA", "EC", 0.809505999, -77.70809937, "Teniente Coronel Luis a Mantilla Airport"},
+	{"TUB", "PF", -23.36540031, -149.5240021, "Tubuai Airport"},
+	{"TUC", "AR", -26.84090042, -65.10489655, "Teniente Benjamin Matienzo Airport"},
+	{"TUD", "SN", 13.73680019, -13.65310001, "Tambacounda Airport"},
+	{"TUF", "FR", 47.43220139, 0.727605999, "Tours-Val-de-Loire Airport"},
+	{"TUG", "PH", 17.64336768, 121.7331505, "Tuguegarao Airport"},
+	{"TUI", "SA", 31.69269943, 38.73120117, "Turaif Domestic Airport"},
+	{"TUK", "PK", 25.9864006, 63.03020096, "Turbat International Airport"},
+	{"TUL", "US", 36.19839859, -95.88809967, "Tulsa International Airport"},
+	{"TUM", "AU", -35.26279831, 148.2409973, "Tumut Airport"},
+	{"TUN", "TN", 36.85100174, 10.22719955, "Tunis Carthage International Airport"},
+	{"TUO", "NZ", -38.73970032, 176.0839996, "Taupo Airport"},
+	{"TUP", "US", 34.26810074, -88.76989746, "Tupelo Regional Airport"},
+	{"TUQ", "BF", 13.06700039, -3.066999912, "Tougan Airport"},
+	{"TUR", "BR", -3.786010027, -49.72029877, "Tucuruí Airport"},
+	{"TUS", "US", 32.11610031, -110.9410019, "Tucson International Airport"},
+	{"TUU", "SA", 28.36540031, 36.6189003, "Tabuk Airport"},
+	{"TUV", "VE", 9.088994026, -62.09417343, "Tucupita Airport"},
+	{"TUY", "MX", 20.22730064, -87.4382019, "Tulum Naval Air Station"},
+	{"TVA", "MG", -17.85008346, 44.92046714, "Morafenobe Airport"},
+	{"TVC", "US", 44.74140167, -85.5821991, "Cherry Capital Airport"},
+	{"TVF", "US", 48.06570053, -96.18499756, "Thief River Falls Regional Airport"},
+	{"TVL", "US", 38.89390182, -119.9950027, "Lake Tahoe Airport"},
+	{"TVS", "CN", 39.71780014, 118.0026245, "Tangshan Sannühe Airport"},
+	{"TVU", "FJ", -16.69059944, -179.8769989, "Matei Airport"},
+	{"TVY", "MM", 14.10389996, 98.20359802, "Dawei Airport"},
+	{"TWB", "AU", -27.5428009, 151.9160004, "Toowoomba Airport"},
+	{"TWD", "US", 48.05379868, -122.810997, "Jefferson County International Airport"},
+	{"TWE", "US", 65.6792984, -164.798996, "Taylor Airport"},
+	{"TWF", "US", 42.48180008, -114.487999, "Joslin Field Magic Valley Regional Airport"},
+	{"TWP", "AU", -17.36330032, 143.75, "Torwood Airport"},
+	{"TWU", "MY", 4.320159912, 118.1279984, "Tawau Airport"},
+	{"TWY", "PG", -7.4463, 146.1067, "Tawa Airport"},
+	{"TWZ", "NZ", -44.23500061, 170.1179962, "Pukaki Airport"},
+	{"TXG", "TW", 24.18630028, 120.6539993, "Taichung Airport"},
+	{"TXK", "US", 33.45370102, -93.99099731, "Texarkana Regional Webb Field"},
+	{"TXL", "DE", 52.55970001, 13.2876997, "Berlin-Tegel International Airport"},
+	{"TXM", "ID", -1.44472003, 132.0209961, "Teminabuan Airport"},
+	{"TXN", "CN", 29.73329926, 118.2559967, "Tunxi International Airport"},
+	{"TXU", "CI", 4.437809467, -7.362728119, "Tabou Airport"},
+	{"TYA", "RU", 54.239, 37.6, "Klokovo Airfield"},
+	{"TYB", "AU", -29.4510994, 142.0579987, "Tibooburra Airport"},
+	{"TYD", "RU", 55.28419876, 124.7789993, "Tynda Airport"},
+	{"TYF", "SE", 60.1576004, 12.99129963, "Torsby Airport"},
+	{"TYG", "AU", -26.08329964, 143.4669952, "Thylungra Airport"},
+	{"TYL", "PE", -4.576640129, -81.25409698, "Capitan Montes Airport"},
+	{"TYM", "BS", 24.16909981, -76.43910217, "Staniel Cay Airport"},
+	{"TYN", "CN", 37.74689865, 112.6279984, "Taiyuan Wusu Airport"},
+	{"TYP", "AU", -22.25580025, 137.9530029, "Tobermorey Airport"},
+	{"TYR", "US", 32.35409927, -95.40239716, "Tyler Pounds Regional Airport"},
+	{"TYS", "US", 35.81100082, -83.9940033, "McGhee Tyson Airport"},
+	{"TYT", "UY", -33.20000076, -54.34999847, "Treinta y Tres Airport"},
+	{"TYZ", "US", 34.45280075, -110.1149979, "Taylor Airport"},
+	{"TZA", "BZ", 17.51638985, -88.19444275, "Belize City Municipal Airport"},
+	{"TZL", "BA", 44.45869827, 18.72480011, "Tuzla International Airport"},
+	{"TZR", "HU", 46.39310074, 17.91749954, "Taszár Air Base"},
+	{"TZX", "TR", 40.99509811, 39.78969955, "Trabzon International Airport"},
+	{"UAB", "TR", 37.0021019, 35.42589951, "İncirlik Air Base"},
+	{"UAC", "MX", 32.44530106, -114.7979965, "San Luis Río Colorado Airport"},
+	{"UAE", "PG", -6.234166667, 144.6647222, "Mount Aue Airport"},
+	{"UAH", "PF", -8.936109543, -139.552002, "Ua Huka Airport"},
+	{"UAI", "TL", -9.303310394, 125.2870026, "Suai Airport"},
+	{"UAK", "GL", 61.16049957, -45.42599869, "Narsarsuaq Airport"},
+	{"UAL", "AO", -10.71580029, 22.23110008, "Luau Airport"},
+	{"UAM", "GU", 13.58399963, 144.9299927, "Andersen Air Force Base"},
+	{"UAP", "PF", -9.351670265, -140.0780029, "Ua Pou Airport"},
+	{"UAQ", "AR", -31.57150078, -68.41819763, "Domingo Faustino Sarmiento Airport"},
+	{"UAR", "MA", 32.51430556, -1.983055556, "Bouarfa Airport"},
+	{"UAS", "KE", 0.530583024, 37.53419495, "Samburu South Airport"},
+	{"UAX", "GT", 17.39388847, -89.63274384, "Uaxactun Airport"},
+	{"UBA", "BR", -19.76472282, -47.96611023, "Mário de Almeida Franco Airport"},
+	{"UBB", "AU", -9.949999809, 142.1829987, "Mabuiag Island Airport"},
+	{"UBI", "PG", -6.729166667, 155.6833333, "Buin Airport"},
+	{"UBJ", "JP", 33.93000031, 131.279007, "Yamaguchi Ube Airport"},
+	{"UBP", "TH", 15.25129986, 104.8700027, "Ubon Ratchathani Airport"},
+	{"UBR", "ID", -3.67565, 140.8838, "Ubrub Airport"},
+	{"UBS", "US", 33.4654007, -88.38030243, "Columbus Lowndes County Airport"},
+	{"UBT", "BR", -23.44109917, -45.07559967, "Ubatuba Airport"},
+	{"UBU", "AU", -14.28829956, 126.6320038, "Kalumburu Airport"},
+	{"UCK", "UA", 50.67840411, 25.48716545, "Lutsk Airport"},
+	{"UCN", "LR", 5.167650223, -9.283470154, "Buchanan Airport"},
+	{"UCT", "RU", 63.56689835, 53.80469894, "Ukhta Airport"},
+	{"UCZ", "PE", -8.467000008, -76.34999847, "Uchiza Airport"},
+	{"UDA", "AU", -18.20000076, 144.6000061, "Undara Airport"},
+	{"UDE", "NL", 51.65639877, 5.708610058, "Volkel Air Base"},
+	{"UDI", "BR", -18.88361168, -48.22527695, "Ten. Cel. Aviador César Bombonato Airport"},
+	{"UDJ", "UA", 48.63430023, 22.26339912, "Uzhhorod International Airport"},
+	{"UDN", "IT", 46.03219986, 13.1868, "Udine-Campoformido Air Base"},
+	{"UDR", "IN", 24.61770058, 73.89610291, "Maharana Pratap Airport"},
+	{"UEE", "AU", -42.07500076, 145.5319977, "Queenstown Airport"},
+	{"UEL", "MZ", -17.85549927, 36.86909866, "Quelimane Airport"},
+	{"UEN", "RU", 65.95999908, 78.43699646, "Urengoy Airport"},
+	{"UEO", "JP", 26.3635006, 126.7139969, "Kumejima Airport"},
+	{"UET", "PK", 30.25139999, 66.93779755, "Quetta International Airport"},
+	{"UFA", "RU", 54.55749893, 55.87440109, "Ufa International Airport"},
+	{"UGA", "MN", 48.85499954, 103.4759979, "Bulgan Airport"},
+	{"UGB", "US", 57.42539978, -157.7400055, "Ugashik Bay Airport"},
+	{"UGC", "UZ", 41.58430099, 60.64170074, "Urgench Airport"},
+	{"UGO", "AO", -7.603069782, 15.02779961, "Uige Airport"},
+	{"UGT", "MN", 43.74930418, 104.1149855, "Bulagtai Resort Airport"},
+	{"UGU", "ID", -3.739559889, 137.0319977, "Bilogai-Sugapa Airport"},
+	{"UHE", "CZ", 49.02939987, 17.43969917, "Kunovice Airport"},
+	{"UIB", "CO", 5.69076, -76.6412, "El Caraño Airport"},
+	{"UIH", "VN", 13.95499992, 109.0419998, "Phu Cat Airport"},
+	{"UII", "HN", 16.11310005, -86.88030243, "Utila Airport"},
+	{"UIK", "RU", 58.13610077, 102.5650024, "Ust-Ilimsk Airport"},
+	{"UIN", "US", 39.94269943, -91.19460297, "Quincy Regional Baldwin Field"},
+	{"UIO", "EC", -0.129166667, -78.3575, "Mariscal Sucre International Airport"},
+	{"UIP", "FR", 47.97499847, -4.167789936, "Quimper-Cornouaille Airport"},
+	{"UIQ", "VU", -17.54000092, 168.4420013, "Quion Hill Airport"},
+	{"UIR", "AU", -31.49060059, 150.5140076, "Quirindi Airport"},
+	{"UIT", "MH", 5.909239769, 169.6369934, "Jaluit Airport"},
+	{"UJE", "MH", 8.928059578, 165.7619934, "Ujae Atoll Airport"},
+	{"UJN", "KR", 36.77666667, 129.4619444, "Uljin Airport"},
+	{"UKA", "KE", -4.293330193, 39.57109833, "Ukunda Airstrip"},
+	{"UKB", "JP", 34.63280106, 135.223999, "Kobe Airport"},
+	{"UKH", "OM", 19.38638889, 56.40138889, "Mukhaizna Airport"},
+	{"UKK", "KZ", 50.03659821, 82.49420166, "Ust-Kamennogorsk Airport"},
+	{"UKS", "UA", 44.68899918, 33.57099915, "Belbek Airport"},
+	{"UKU", "PG", -3.676651, 142.484334, "Nuku Airport"},
+	{"UKX", "RU", 56.8567009, 105.7300034, "Ust-Kut Airport"},
+	{"ULA", "AR", -49.3068, -67.8026, "Capitan D Daniel Vazquez Airport"},
+	{"ULB", "VU", -16.3297, 168.3011, "Uléi Airport"},
+	{"ULD", "ZA", -28.32060051, 31.41650009, "Prince Mangosuthu Buthelezi Airport"},
+	{"ULE", "PG", -4.974720001, 151.2969971, "Sule Airport"},
+	{"ULG", "MN", 48.96699905, 89.96700287, "Ölgii Airport"},
+	{"ULH", "SA", 26.48, 38.12888889, "Majeed Bin Abdulaziz Airport"},
+	{"ULI", "FM", 10.01980019, 139.7899933, "Falalop Island Airport"},
+	{"ULK", "RU", 60.72060013, 114.8259964, "Lensk Airport"},
+	{"ULL", "GB", 56.51750183, -5.914169788, "Glenforsa Airfield"},
+	{"ULN", "MN", 47.8431015, 106.7669983, "Chinggis Khaan International Airport"},
+	{"ULO", "MN", 49.973333, 92.079722, "Ulaangom Airport"},
+	{"ULP", "AU", -26.61219978, 144.253006, "Quilpie Airport"},
+	{"ULQ", "CO", 4.08836, -76.2351, "Farfan Airport"},
+	{"ULU", "UG", 2.805560112, 32.27180099, "Gulu Airport"},
+	{"ULV", "RU", 54.2682991, 48.22669983, "Ulyanovsk Baratayevka Airport"},
+	{"ULX", "ZA", -24.78540039, 31.35490036, "Ulusaba Airport"},
+	{"ULY", "RU", 54.40100098, 48.80270004, "Ulyanovsk East Airport"},
+	{"ULZ", "MN", 47.7093, 96.5258, "Donoi Airport"},
+	{"UMA", "CU", 20.25060081, -74.15049744, "Punta de Maisi Airport"},
+	{"UMC", "PG", -7.0214, 145.966, "Umba Airport"},
+	{"UME", "SE", 63.79180145, 20.28280067, "Umeå Airport"},
+	{"UMI", "PE", -13.23330021, -70.75330353, "Quince Air Base"},
+	{"UMM", "US", 63.33150101, -149.1269989, "Summit Airport"},
+	{"UMR", "AU", -31.14419937, 136.8170013, "Woomera Airfield"},
+	{"UMT", "US", 69.37110138, -152.1360016, "Umiat Airport"},
+	{"UMU", "BR", -23.79870033, -53.31380081, "Umuarama Airport"},
+	{"UMY", "UA", 50.85829926, 34.76250076, "Sumy Airport"},
+	{"UNA", "BR", -15.35519981, -38.99900055, "Hotel Transamérica Airport"},
+	{"UND", "AF", 36.6651001, 68.91079712, "Konduz Airport"},
+	{"UNE", "LS", -30.11170006, 28.6718998, "Qacha\"s Nek Airport"},
+	{"UNG", "PG", -6.125710011, 141.2819977, "Kiunga Airport"},
+	{"UNI", "VC", 12.60013485, -61.41194534, "Union Island International Airport"},
+	{"UNK", "US", 63.88840103, -160.798996, "Unalakleet Airport"},
+	{"UNN", "TH", 9.777620316, 98.58550262, "Ranong Airport"},
+	{"UNR", "MN", 47.30486, 110.6092, "Öndörkhaan Airport"},
+	{"UNT", "GB", 60.74720001, -0.853850007, "Unst Airport"},
+	{"UOL", "ID", 1.1027, 121.4141, "Buol Airport"},
+	{"UPB", "CU", 23.03280067, -82.57939911, "Playa Baracoa Airport"},
+	{"UPG", "ID", -5.061629772, 119.5540009, "Hasanuddin International Airport"},
+	{"UPL", "CR", 10.89220047, -85.0161972, "Upala Airport"},
+	{"UPN", "MX", 19.39669991, -102.0390015, "Licenciado y General Ignacio Lopez Rayon Airport"},
+	{"UPP", "US", 20.26530075, -155.8600006, "Upolu Airport"},
+	{"UPR", "PG", -8.5411, 142.6503, "Upiara Airport"},
+	{"UPV", "GB", 51.28620148, -1.782019973, "Upavon Aerodrome"},
+	{"URA", "KZ", 51.1507988, 51.54309845, "Uralsk Airport"},
+	{"URC", "CN", 43.90710068, 87.47419739, "Ürümqi Diwopu International Airport"},
+	{"URE", "EE", 58.22990036, 22.5095005, "Kuressaare Airport"},
+	{"URG", "BR", -29.78219986, -57.03820038, "Rubem Berta Airport"},
+	{"URJ", "RU", 60.10329819, 64.8266983, "Uray Airport"},
+	{"URM", "VE", 5.333333015, -62.76666641, "Uriman Airport"},
+	{"URN", "AF", 32.93180084, 69.15630341, "Urgun Airport"},
+	{"URO", "FR", 49.38420105, 1.174800038, "Rouen Airport"},
+	{"URR", "CO", 6.32883, -76.1425, "Urrao Airport"},
+	{"URS", "RU", 51.75059891, 36.29560089, "Kursk East Airport"},
+	{"URT", "TH", 9.132599831, 99.13559723, "Surat Thani Airport"},
+	{"URU", "PG", -9.5162, 148.5591, "Uroubi Airport"},
+	{"URY", "SA", 31.41189957, 37.27949905, "Guriat Domestic Airport"},
+	{"URZ", "AF", 32.90299988, 66.63089752, "Orūzgān Airport"},
+	{"USH", "AR", -54.8433, -68.2958, "Malvinas Argentinas Airport"},
+	{"USI", "GY", 8.199999809, -59.78329849, "Mabaruma Airport"},
+	{"USK", "RU", 66.00469971, 57.36719894, "Usinsk Airport"},
+	{"USL", "AU", -26.16670036, 113.4000015, "Useless Loop Airport"},
+	{"USM", "TH", 9.547789574, 100.0619965, "Samui Airport"},
+	{"USN", "KR", 35.59349823, 129.352005, "Ulsan Airport"},
+	{"USQ", "TR", 38.68149948, 29.47170067, "Uşak Airport"},
+	{"USS", "CU", 21.97039986, -79.44270325, "Sancti Spiritus Airport"},
+	{"USU", "PH", 12.12150002, 120.0999985, "Francisco B. Reyes Airport"},
+	{"UTA", "ZW", -18.99749947, 32.62720108, "Mutare Airport"},
+	{"UTB", "AU", -22.58329964, 144.5330048, "Muttaburra Airport"},
+	{"UTE", "ZA", -28.273369, 26.135835, "Bultfontein Airport"},
+	{"UTG", "LS", -30.40749931, 27.69330025, "Quthing Airport"},
+	{"UTH", "TH", 17.38640022, 102.788002, "Udon Thani Airport"},
+	{"UTI", "FI", 60.89640045, 26.93840027, "Utti Air Base"},
+	{"UTK", "MH", 11.22200012, 169.852005, "Utirik Airport"},
+	{"UTN", "ZA", -28.39909935, 21.2602005, "Pierre Van Ryneveld Airport"},
+	{"UTO", "US", 65.99279785, -153.7039948, "Indian Mountain LRRS Airport"},
+	{"UTP", "TH", 12.67990017, 101.0049973, "U-Tapao International Airport"},
+	{"UTR", "TH", 17.61700058, 100.0999985, "Uttaradit Airport"},
+	{"UTS", "RU", 65.43729401, 52.20033646, "Ust-Tsylma Airport"},
+	{"UTT", "ZA", -31.54636318, 28.6733551, "K. D. Matanzima Airport"},
+	{"UTW", "ZA", -31.92020035, 26.88220024, "Queenstown Airport"},
+	{"UUA", "RU", 54.63999939, 52.80170059, "Bugulma Airport"},
+	{"UUD", "RU", 51.80780029, 107.4380035, "Ulan-Ude Airport (Mukhino)"},
+	{"UUK", "US", 70.33080292, -149.5980072, "Ugnu-Kuparuk Airport"},
+	{"UUN", "MN", 46.66030121, 113.2850037, "Baruun Urt Airport"},
+	{"UUS", "RU", 46.88869858, 142.7180023, "Yuzhno-Sakhalinsk Airport"},
+	{"UVA", "US", 29.2112999, -99.74359894, "Garner Field"},
+	{"UVE", "NC", -20.6406002, 166.572998, "Ouvéa Airport"},
+	{"UVF", "LC", 13.7332, -60.952599, "Hewanorra International Airport"},
+	{"UVL", "EG", 25.47360039, 30.59070015, "El Kharga Airport"},
+	{"UVO", "PG", -6.0178, 150.9557, "Uvol Airport"},
+	{"UYL", "SD", 12.05350018, 24.95619965, "Nyala Airport"},
+	{"UYN", "CN", 38.26919937, 109.7310028, "Yulin Airport"},
+	{"UYU", "BO", -20.44630051, -66.8483963, "Uyuni Airport"},
+	{"UZC", "RS", 43.898899, 19.697701, "Ponikve Airport"},
+	{"UZM", "CA", 68.156, -106.618, "Hope Bay Aerodrome"},
+	{"UZR", "KZ", 47.09115, 81.66521, "Urzhar Airport"},
+	{"UZU", "AR", -29.7706, -57.9789, "Curuzu Cuatia Airport"},
+	{"VAA", "FI", 63.05070114, 21.7621994, "Vaasa Airport"},
+	{"VAC", "DE", 52.90833282, 8.040555954, "Varrelbusch Airport"},
+	{"VAD", "US", 30.96780014, -83.19300079, "Moody Air Force Base"},
+	{"VAF", "FR", 44.92160034, 4.969900131, "Valence-Chabeuil Airport"},
+	{"VAG", "BR", -21.59009933, -45.47330093, "Major Brigadeiro Trompowsky Airport"},
+	{"VAH", "BO", -18.48250008, -64.09940338, "Capitán Av. Vidal Villagomez Toledo Airport"},
+	{"VAI", "PG", -2.697170019, 141.302002, "Vanimo Airport"},
+	{"VAK", "US", 61.5409, -165.6005, "Chevak Airport"},
+	{"VAL", "BR", -13.29650021, -38.99240112, "Valença Airport"},
+	{"VAM", "MV", 3.470555556, 72.83583333, "Villa Airport"},
+	{"VAN", "TR", 38.46820068, 43.33229828, "Van Ferit Melen Airport"},
+	{"VAO", "SB", -7.585559845, 158.7310028, "Suavanao Airport"},
+	{"VAP", "CL", -33.06809998, -71.55750275, "Rodelillo Airport"},
+	{"VAR", "BG", 43.23210144, 27.82509995, "Varna Airport"},
+	{"VAS", "TR", 39.81380081, 36.9034996, "Sivas Airport"},
+	{"VAT", "MG", -19.383333, 48.95, "Vatomandry Airport"},
+	{"VAU", "FJ", -17.5, 177.8419952, "Vatukoula Airport"},
+	{"VAV", "TO", -18.58530045, -173.9620056, "Vava\"u International Airport"},
+	{"VAW", "NO", 70.35540009, 31.04490089, "Vardø Airport, Svartnes"},
+	{"VBG", "US", 34.73730087, -120.5839996, "Vandenberg Air Force Base"},
+	{"VBS", "IT", 45.42890167, 10.33059978, "Brescia Airport"},
+	{"VBV", "FJ", -17.2689991, -178.9759979, "Vanua Balavu Airport"},
+	{"VBY", "SE", 57.66279984, 18.34620094, "Visby Airport"},
+	{"VCA", "VN", 10.08510017, 105.711998, "Can Tho International Airport"},
+	{"VCD", "AU", -16.4021244, 131.0049744, "Victoria River Downs Airport"},
+	{"VCE", "IT", 45.50529861, 12.3519001, "Venice Marco Polo Airport"},
+	{"VCF", "AR", -40.70000076, -66.15000153, "Valcheta Airport"},
+	{"VCH", "UY", -31.7670002, -54.61700058, "Vichadero Airport"},
+	{"VCL", "VN", 15.40330029, 108.7060013, "Chu Lai International Airport"},
+	{"VCP", "BR", -23.00740051, -47.1344986, "Viracopos International Airport"},
+	{"VCR", "VE", 10.17560291, -70.06521606, "Carora Airport"},
+	{"VCS", "VN", 8.731829643, 106.6330032, "Co Ong Airport"},
+	{"VCT", "US", 28.8526001, -96.91850281, "Victoria Regional Airport"},
+	{"VCV", "US", 34.59749985, -117.3830032, "Southern California Logistics Airport"},
+	{"VDA", "IL", 29.94029999, 34.93579865, "Ovda International Airport"},
+	{"VDB", "NO", 61.0155983, 9.288060188, "Leirin Airport"},
+	{"VDC", "BR", -14.86279964, -40.86309814, "Vitória da Conquista Airport"},
+	{"VDE", "ES", 27.81480026, -17.88710022, "Hierro Airport"},
+	{"VDH", "VN", 17.515, 106.590556, "Dong Hoi Airport"},
+	{"VDM", "AR", -40.8692, -63.0004, "Gobernador Castello Airport"},
+	{"VDP", "VE", 9.222027779, -65.99358368, "Valle de La Pascua Airport"},
+	{"VDR", "AR", -31.94519997, -65.14630127, "Villa Dolores Airport"},
+	{"VDS", "NO", 70.06529999, 29.84469986, "Vadsø Airport"},
+	{"VDY", "IN", 15.17496667, 76.63494722, "Vijayanagar Aerodrome (JSW)"},
+	{"VDZ", "US", 61.13389969, -146.2480011, "Valdez Pioneer Field"},
+	{"VEE", "US", 67.00869751, -146.3659973, "Venetie Airport"},
+	{"VEL", "US", 40.4408989, -109.5100021, "Vernal Regional Airport"},
+	{"VER", "MX", 19.14590073, -96.18730164, "General Heriberto Jara International Airport"},
+	{"VEV", "SB", -7.912779808, 156.7059937, "Barakoma Airport"},
+	{"VEY", "IS", 63.42430115, -20.27890015, "Vestmannaeyjar Airport"},
+	{"VFA", "ZW", -18.09589958, 25.8390007, "Victoria Falls International Airport"},
+	{"VGA", "IN", 16.53039932, 80.79679871, "Vijayawada Airport"},
+	{"VGD", "RU", 59.28250122, 39.94440079, "Vologda Airport"},
+	{"VGO", "ES", 42.23180008, -8.62677002, "Vigo Airport"},
+	{"VGS", "AR", -35.0, -63.0, "General Villegas Airport"},
+	{"VHC", "AO", -9.689069748, 20.43190002, "Saurimo Airport"},
+	{"VHM", "SE", 64.57910156, 16.83359909, "Vilhelmina Airport"},
+	{"VHY", "FR", 46.16970062, 3.403739929, "Vichy-Charmeil Airport"},
+	{"VHZ", "PF", -18.78000069, -138.8529968, "Vahitahi Airport"},
+	{"VIA", "BR", -26.99970055, -51.14189911, "Videira Airport"},
+	{"VIC", "IT", 45.57339859, 11.52950001, "Vicenza Airport"},
+	{"VID", "BG", 44.0223999, 22.81609917, "Vidin Smurdan Airfield"},
+	{"VIE", "AT", 48.11029816, 16.56970024, "Vienna International Airport"},
+	{"VIG", "VE", 8.624138832, -71.67266846, "Juan Pablo Pérez Alfonso Airport"},
+	{"VII", "VN", 18.73760033, 105.6709976, "Vinh Airport"},
+	{"VIJ", "VG", 18.44639969, -64.42749786, "Virgin Gorda Airport"},
+	{"VIL", "EH", 23.71829987, -15.93200016, "Dakhla Airport"},
+	{"VIN", "UA", 49.242531, 28.613778, "Vinnytsia/Gavyryshivka Airport"},
+	{"VIQ", "TL", -8.883799553, 126.3730011, "Viqueque Airport"},
+	{"VIR", "ZA", -29.77059937, 31.0583992, "Virginia Airport"},
+	{"VIS", "US", 36.31869888, -119.3929977, "Visalia Municipal Airport"},
+	{"VIT", "ES", 42.88280106, -2.7244699, "Vitoria/Foronda Airport"},
+	{"VIU", "SB", -8.5085, 157.69, "Viru Harbour Airstrip"},
+	{"VIV", "PG", -9.303333333, 150.3188889, "Vivigani Airfield"},
+	{"VIX", "BR", -20.25805664, -40.2863884, "Eurico de Aguiar Salles Airport"},
+	{"VIY", "FR", 48.7743988, 2.201539993, "Villacoublay-Vélizy (BA 107) Air Base"},
+	{"VJB", "MZ", -25.03779984, 33.62739944, "Xai-Xai Airport"},
+	{"VKG", "VN", 9.958029972, 105.1323795, "Rach Gia Airport"},
+	{"VKO", "RU", 55.59149933, 37.26150131, "Vnukovo International Airport"},
+	{"VKT", "RU", 67.48860168, 63.99309921, "Vorkuta Airport"},
+	{"VKX", "US", 38.74760056, -76.9571991, "Potomac Airfield"},
+	{"VLC", "ES", 39.48929977, -0.481624991, "Valencia Airport"},
+	{"VLD", "US", 30.78249931, -83.27670288, "Valdosta Regional Airport"},
+	{"VLG", "AR", -37.2354, -57.0292, "Villa Gesell Airport"},
+	{"VLI", "VU", -17.69930077, 168.3200073, "Port Vila Bauerfield Airport"},
+	{"VLK", "RU", 47.68209839, 42.07279968, "Volgodonsk Airport"},
+	{"VLL", "ES", 41.70610046, -4.851940155, "Valladolid Airport"},
+	{"VLM", "BO", -21.25519943, -63.4056015, "Teniente Coronel Rafael Pabón Airport"},
+	{"VLN", "VE", 10.14973259, -67.92839813, "Arturo Michelena International Airport"},
+	{"VLP", "BR", -9.97944355, -51.14222336, "Vila Rica Airport"},
+	{"VLR", "CL", -28.59639931, -70.7559967, "Vallenar Airport"},
+	{"VLS", "VU", -16.79610062, 168.177002, "Valesdir Airport"},
+	{"VLU", "RU", 56.3810997, 30.60810089, "Velikiye Luki Airport"},
+	{"VLV", "VE", 9.340477943, -70.58406067, "Dr. Antonio Nicolás Briceño Airport"},
+	{"VLY", "GB", 53.24810028, -4.535339832, "Anglesey Airport"},
+	{"VME", "AR", -33.63499832, -65.41870117, "Villa Mercedes Airport"},
+	{"VMI", "PY", -22.15910896, -57.94258118, "Dr Juan Plate Airport"},
+	{"VMU", "PG", -7.496860027, 144.8199005, "Baimuru Airport"},
+	{"VNA", "LA", 15.70943921, 106.4106989, "Saravane Airport"},
+	{"VND", "MG", -23.35076659, 47.58170128, "Vangaindrano Airport"},
+	{"VNE", "FR", 47.72330093, -2.71855998, "Vannes-Meucon Airport"},
+	{"VNO", "LT", 54.63410187, 25.28580093, "Vilnius International Airport"},
+	{"VNR", "AU", -16.9633007, 141.9499969, "Vanrook Station Airport"},
+	{"VNS", "IN", 25.45240021, 82.85929871, "Lal Bahadur Shastri Airport"},
+	{"VNT", "LV", 57.35779953, 21.5442009, "Ventspils International Airport"},
+	{"VNX", "MZ", -22.01840019, 35.31330109, "Vilankulo Airport"},
+	{"VOD", "CZ", 50.21659851, 14.39579964, "Vodochody Airport"},
+	{"VOG", "RU", 48.78250122, 44.34550095, "Volgograd International Airport"},
+	{"VOH", "MG", -13.37580013, 50.00279999, "Vohimarina Airport"},
+	{"VOI", "LR", 8.399999619, -9.767000198, "Voinjama Airport"},
+	{"VOL", "GR", 39.21960068, 22.79430008, "Nea Anchialos Airport"},
+	{"VOT", "BR", -20.46319962, -50.00450134, "Votuporanga Airport"},
+	{"VOZ", "RU", 51.81420135, 39.229599, "Voronezh International Airport"},
+	{"VPE", "AO", -17.0435009, 15.68379974, "Ngjiva Pereira Airport"},
+	{"VPG", "KE", -3.806666667, 39.79738889, "Vipingo Estate Airport"},
+	{"VPN", "IS", 65.72059631, -14.85060024, "Vopnafjörður Airport"},
+	{"VPS", "US", 30.48320007, -86.52539825, "Eglin Air Force Base"},
+	{"VPY", "MZ", -19.15130043, 33.42900085, "Chimoio Airport"},
+	{"VQQ", "US", 30.21870041, -81.87670136, "Cecil Field"},
+	{"VQS", "PR", 18.13479996, -65.49359894, "Antonio Rivera Rodriguez Airport"},
+	{"VRA", "CU", 23.03440094, -81.43530273, "Juan Gualberto Gomez International Airport"},
+	{"VRB", "US", 27.65559959, -80.41790009, "Vero Beach Municipal Airport"},
+	{"VRC", "PH", 13.5763998, 124.2060013, "Virac Airport"},
+	{"VRE", "ZA", -31.64100075, 18.5447998, "Vredendal Airport"},
+	{"VRK", "FI", 62.17110062, 27.86860085, "Varkaus Airport"},
+	{"VRL", "PT", 41.27429962, -7.720469952, "Vila Real Airport"},
+	{"VRN", "IT", 45.39569855, 10.88850021, "Verona Villafranca Airport"},
+	{"VRO", "CU", 23.12400055, -81.3015976, "Kawama Airport"},
+	{"VRU", "ZA", -26.98240089, 24.72879982, "Vryburg Airport"},
+	{"VSA", "MX", 17.99699974, -92.81739807, "Carlos Rovirosa Pérez International Airport"},
+	{"VSE", "PT", 40.7254982, -7.888989925, "Viseu Airport"},
+	{"VSF", "US", 43.34360123, -72.51730347, "Hartness State (Springfield) Airport"},
+	{"VSG", "UA", 48.41740036, 39.37409973, "Luhansk International Airport"},
+	{"VSK", "US", 46.21860123, -119.2099991, "Vista Field"},
+	{"VST", "SE", 59.58940125, 16.63360023, "Stockholm Västerås Airport"},
+	{"VTB", "BY", 55.12649918, 30.34959984, "Vitebsk East Airport"},
+	{"VTE", "LA", 17.98830032, 102.5630035, "Wattay International Airport"},
+	{"VTF", "FJ", -18.51250076, 177.6390076, "Vatulele Airport"},
+	{"VTG", "VN", 10.37250042, 107.0950012, "Vung Tau Airport"},
+	{"VTL", "FR", 48.22389984, 5.935279846, "Vittel Champ De Course Airport"},
+	{"VTM", "IL", 31.20829964, 35.01229858, "Nevatim Air Base"},
+	{"VTU", "CU", 20.98760033, -76.93579865, "Hermanos Ameijeiras Airport"},
+	{"VTZ", "IN", 17.72120094, 83.22450256, "Vishakhapatnam Airport"},
+	{"VUP", "CO", 10.435, -73.2495, "Alfonso López Pumarejo Airport"},
+	{"VUS", "RU", 60.78829956, 46.25999832, "Velikiy Ustyug Airport"},
+	{"VUU", "MW", -14.83861111, 35.30138889, "Mvuu Camp Airport"},
+	{"VVB", "MG", -19.83300018, 48.79999924, "Mahanoro Airport"},
+	{"VVC", "CO", 4.16787, -73.6138, "Vanguardia Airport"},
+	{"VVI", "BO", -17.64480019, -63.13539886, "Viru Viru International Airport"},
+	{"VVK", "SE", 57.77999878, 16.52359962, "Västervik Airport"},
+	{"VVN", "PE", -11.85486111, -72.93933333, "Las Malvinas/Echarate Airport"},
+	{"VVO", "RU", 43.39899826, 132.147995, "Vladivostok International Airport"},
+	{"VVZ", "DZ", 26.7234993, 8.622650146, "Illizi Takhamalt Airport"},
+	{"VXC", "MZ", -13.27400017, 35.2663002, "Lichinga Airport"},
+	{"VXE", "CV", 16.83320045, -25.05529976, "São Pedro Airport"},
+	{"VXO", "SE", 56.92910004, 14.72799969, "Växjö Kronoberg Airport"},
+	{"VYD", "ZA", -27.78689957, 30.79640007, "Vryheid Airport"},
+	{"WAA", "US", 65.62259674, -168.0950012, "Wales Airport"},
+	{"WAC", "ET", 7.166999817, 37.16699982, "Waca Airport"},
+	{"WAE", "SA", 20.50429916, 45.19960022, "Wadi Al Dawasir Airport"},
+	{"WAF", "PK", 32.30469894, 69.57039642, "Wana Airport"},
+	{"WAG", "NZ", -39.96220016, 175.0249939, "Wanganui Airport"},
+	{"WAH", "US", 46.24409866, -96.60739899, "Harry Stern Airport"},
+	{"WAI", "MG", -14.8987999, 47.9939003, "Ambalabe Airport"},
+	{"WAK", "MG", -22.29644235, 44.5315361, "Ankazoabo Airport"},
+	{"WAL", "US", 37.94020081, -75.46640015, "Wallops Flight Facility Airport"},
+	{"WAM", "MG", -17.79537761, 48.44258308, "Ambatondrazaka Airport"},
+	{"WAO", "PG", -6.989444444, 145.0751111, "Wabo Airport"},
+	{"WAP", "CL", -43.61190033, -71.80609894, "Alto Palena Airport"},
+	{"WAQ", "MG", -18.70127324, 44.61492062, "Antsalova Airport"},
+	{"WAR", "ID", -3.222429991, 140.9790039, "Waris Airport"},
+	{"WAT", "IE", 52.18719864, -7.086959839, "Waterford Airport"},
+	{"WAV", "AU", -17.3932991, 131.1179962, "Wave Hill Airport"},
+	{"WAW", "PL", 52.16569901, 20.96710014, "Warsaw Chopin Airport"},
+	{"WAX", "LY", 32.95230103, 12.01550007, "Zwara Airport"},
+	{"WAY", "US", 39.90010071, -80.13310242, "Greene County Airport"},
+	{"WAZ", "AU", -28.14940071, 151.9429932, "Warwick Airport"},
+	{"WBA", "ID", -2.8114, 129.484, "Wahai,Seram Island"},
+	{"WBB", "US", 63.51599884, -162.2779999, "Stebbins Airport"},
+	{"WBC", "PG", -9.3376, 150.5093, "Wapolu Airport"},
+	{"WBD", "MG", -15.19999981, 48.48300171, "Avaratra Airport"},
+	{"WBG", "DE", 54.45930099, 9.516329765, "Schleswig Airport"},
+	{"WBM", "PG", -5.643300056, 143.8950043, "Wapenamanda Airport"},
+	{"WBO", "MG", -21.60698376, 45.13602018, "Antsoa Airport"},
+	{"WBQ", "US", 66.36219788, -147.4069977, "Beaver Airport"},
+	{"WBR", "US", 43.72259903, -85.50409698, "Roben Hood Airport"},
+	{"WBU", "US", 40.03939819, -105.2259979, "Boulder Municipal Airport"},
+	{"WBW", "US", 41.29719925, -75.85119629, "Wilkes Barre Wyoming Valley Airport"},
+	{"WCA", "CL", -42.49029922, -73.77279663, "Gamboa Airport"},
+	{"WCH", "CL", -42.93280029, -72.69909668, "Chaitén Airport"},
+	{"WCR", "US", 67.50450134, -148.4830017, "Chandalar Lake Airport"},
+	{"WDA", "YE", 14.86699963, 45.54999924, "Wadi Ain Airport"},
+	{"WDG", "US", 36.37919998, -97.79109955, "Enid Woodring Regional Airport"},
+	{"WDH", "NA", -22.47990036, 17.47089958, "Hosea Kutako International Airport"},
+	{"WDI", "AU", -26.2833004, 151.8580017, "Wondai Airport"},
+	{"WDN", "US", 48.71179962, -123.0179977, "Waldronaire Airport"},
+	{"WDR", "US", 33.98289871, -83.66739655, "Barrow County Airport"},
+	{"WEA", "US", 32.74629974, -97.68240356, "Parker County Airport"},
+	{"WEF", "CN", 36.64670181, 119.1190033, "Weifang Airport"},
+	{"WEH", "CN", 37.18709946, 122.2289963, "Weihai Airport"},
+	{"WEI", "AU", -12.67860031, 141.9250031, "Weipa Airport"},
+	{"WET", "ID", -4.044229984, 136.2779999, "Wagethe Airport"},
+	{"WEW", "AU", -30.25830078, 149.4080048, "Wee Waa Airport"},
+	{"WEX", "IE", 52.33333206, -6.466667175, "Castlebridge Airport"},
+	{"WFI", "MG", -21.4416008, 47.11169815, "Fianarantsoa Airport"},
+	{"WFK", "US", 47.28549957, -68.31279755, "Northern Aroostook Regional Airport"},
+	{"WGA", "AU", -35.16529846, 147.4660034, "Wagga Wagga City Airport"},
+	{"WGB", "PK", 29.94630051, 73.24909973, "Bahawalnagar Airport"},
+	{"WGC", "IN", 17.9144001, 79.60220337, "Warangal Airport"},
+	{"WGE", "AU", -30.03280067, 148.1260071, "Walgett Airport"},
+	{"WGO", "US", 39.14350128, -78.14440155, "Winchester Regional Airport"},
+	{"WGP", "ID", -9.669219971, 120.302002, "Waingapu Airport"},
+	{"WGT", "AU", -36.41579819, 146.3070068, "Wangaratta Airport"},
+	{"WHF", "SD", 21.80269814, 31.52157784, "Wadi Halfa Airport"},
+	{"WHK", "NZ", -37.92060089, 176.9140015, "Whakatane Airport"},
+	{"WHO", "NZ", -43.36309814, 170.1340027, "Franz Josef Aerodrome"},
+	{"WHP", "US", 34.25930023, -118.413002, "Whiteman Airport"},
+	{"WHS", "GB", 60.37749863, -0.925556004, "Whalsay Airport"},
+	{"WHT", "US", 29.25429916, -96.15440369, "Wharton Regional Airport"},
+	{"WHU", "CN", 31.3906002, 118.4089966, "Wuhu Air Base"},
+	{"WIB", "US", 34.22570038, -99.28379822, "Wilbarger County Airport"},
+	{"WIC", "GB", 58.45890045, -3.093060017, "Wick Airport"},
+	{"WIE", "DE", 50.04980087, 8.325400352, "Wiesbaden Army Airfield"},
+	{"WIK", "NZ", -36.80889893, 175.0859985, "Waiheke Reeve Airport"},
+	{"WIL", "KE", -1.321720004, 36.81480026, "Nairobi Wilson Airport"},
+	{"WIN", "AU", -22.36359978, 143.0859985, "Winton Airport"},
+	{"WIO", "AU", -31.52639961, 143.375, "Wilcannia Airport"},
+	{"WIR", "NZ", -39.00690079, 177.4069977, "Wairoa Airport"},
+	{"WIT", "AU", -22.21829987, 118.3479996, "Wittenoom Airport"},
+	{"WIX", "MX", 19.58390045, -103.3840027, "Tuxpan Airport"},
+	{"WJA", "MH", 7.450833333, 168.55, "Woja Airport"},
+	{"WJF", "US", 34.74110031, -118.2190018, "General WM J Fox Airfield"},
+	{"WJR", "KE", 1.733240008, 40.09159851, "Wajir Airport"},
+	{"WJU", "KR", 37.43809891, 127.9599991, "Wonju Airport"},
+	{"WKA", "NZ", -44.72219849, 169.2460022, "Wanaka Airport"},
+	{"WKB", "AU", -36.32109833, 142.4190063, "Warracknabeal Airport"},
+	{"WKF", "ZA", -25.82999992, 28.22249985, "Waterkloof Air Force Base"},
+	{"WKI", "ZW", -18.36249924, 26.51670074, "Hwange Airport"},
+	{"WKJ", "JP", 45.40420151, 141.8009949, "Wakkanai Airport"},
+	{"WKK", "US", 59.2826004, -158.6179962, "Aleknagik / New Airport"},
+	{"WKR", "BS", 27.26670074, -78.39969635, "Abaco I Walker C Airport"},
+	{"WLA", "AU", -19.77359962, 120.6490021, "Wallal Airport"},
+	{"WLC", "AU", -31.0, 151.5670013, "Walcha Airport"},
+	{"WLD", "US", 37.16859818, -97.03759766, "Strother Field"},
+	{"WLG", "NZ", -41.32720184, 174.8049927, "Wellington International Airport"},
+	{"WLH", "VU", -15.4119997, 167.6909943, "Walaha Airport"},
+	{"WLK", "US", 66.60009766, -159.9859924, "Selawik Airport"},
+	{"WLL", "AU", -17.2199, 137.93453, "Wollogorang Airport"},
+	{"WLO", "AU", -16.62999916, 129.3200073, "Waterloo Airport"},
+	{"WLS", "WF", -13.23830032, -176.1990051, "Hihifo Airport"},
+	{"WLW", "US", 39.51639938, -122.2180023, "Willows Glenn County Airport"},
+	{"WMA", "MG", -15.83304941, 48.83328438, "Mandritsara Airport"},
+	{"WMB", "AU", -38.29529953, 142.4470062, "Warrnambool Airport"},
+	{"WMC", "US", 40.89659882, -117.8059998, "Winnemucca Municipal Airport"},
+	{"WMD", "MG", -21.04630493, 44.94041204, "Mandabe Airport"},
+	{"WME", "AU", -27.28639984, 120.5550003, "Mount Keith Airport"},
+	{"WMH", "US", 36.3689003, -92.47049713, "Ozark Regional Airport"},
+	{"WMI", "PL", 52.4510994, 20.65180016, "Modlin Airport"},
+	{"WML", "MG", -20.35539029, 45.54337263, "Malaimbandy Airport"},
+	{"WMN", "MG", -15.43669987, 49.68830109, "Maroantsetra Airport"},
+	{"WMO", "US", 64.68920136, -163.4129944, "White Mountain Airport"},
+	{"WMP", "MG", -16.07226934, 47.64416456, "Mampikony Airport"},
+	{"WMR", "MG", -16.16390038, 49.7737999, "Mananara Nord Airport"},
+	{"WMX", "ID", -4.102509975, 138.9570007, "Wamena Airport"},
+	{"WNA", "US", 60.69029999, -161.9790039, "Napakiak Airport"},
+	{"WND", "AU", -28.47500038, 122.2419968, "Windarra Airport"},
+	{"WNN", "CA", 52.89390182, -89.28919983, "Wunnumin Lake Airport"},
+	{"WNP", "PH", 13.5848999, 123.2699966, "Naga Airport"},
+	{"WNR", "AU", -25.41309929, 142.6670074, "Windorah Airport"},
+	{"WNS", "PK", 26.21940041, 68.39009857, "Nawabshah Airport"},
+	{"WNU", "PG", -4.8961, 145.3213, "Wanuma Airport"},
+	{"WNZ", "CN", 27.91220093, 120.8519974, "Wenzhou Yongqiang Airport"},
+	{"WOA", "PG", -6.796861111, 145.8919444, "Wonenara Airport"},
+	{"WOE", "NL", 51.44910049, 4.342030048, "Woensdrecht Air Base"},
+	{"WOK", "VE", 5.25, -61.73333359, "Wonken Airport"},
+	{"WOL", "AU", -34.56110001, 150.7890015, "Wollongong Airport"},
+	{"WON", "AU", -18.57500076, 140.8919983, "Wondoola Airport"},
+	{"WOS", "KP", 39.16680145, 127.4860001, "Wonsan Airport"},
+	{"WOT", "TW", 23.36737251, 119.5027771, "Wang-an Airport"},
+	{"WOW", "US", 61.75419998, -150.052002, "Willow Airport"},
+	{"WPA", "CL", -45.39920044, -72.67030334, "Cabo 1° Juan Román Airport"},
+	{"WPB", "MG", -15.58428647, 47.62358665, "Port Bergé Airport"},
+	{"WPC", "CA", 49.52059937, -113.9970016, "Pincher Creek Airport"},
+	{"WPK", "AU", -16.6583004, 144.0019989, "Wrotham Park Airport"},
+	{"WPM", "PG", -8.788220406, 142.8820038, "Wipim Airport"},
+	{"WPO", "US", 38.83169937, -107.6460037, "North Fork Valley Airport"},
+	{"WPR", "CL", -53.25370026, -70.31919861, "Capitan Fuentes Martinez Airport Airport"},
+	{"WPU", "CL", -54.93109894, -67.626297, "Guardiamarina Zañartu Airport"},
+	{"WRA", "ET", 6.9724, 45.3334, "Warder Airport"},
+	{"WRB", "US", 32.64009857, -83.59190369, "Robins Air Force Base"},
+	{"WRE", "NZ", -35.7682991, 174.3650055, "Whangarei Airport"},
+	{"WRG", "US", 56.48429871, -132.3699951, "Wrangell Airport"},
+	{"WRI", "US", 40.0155983, -74.59169769, "Mc Guire Air Force Base"},
+	{"WRL", "US", 43.96569824, -107.9509964, "Worland Municipal Airport"},
+	{"WRO", "PL", 51.10269928, 16.88579941, "Copernicus Wrocław Airport"},
+	{"WRW", "AU", -20.84420013, 120.7020035, "Warrawagine Airport"},
+	{"WRY", "GB", 59.35029984, -2.950000048, "Westray Airport"},
+	{"WRZ", "LK", 6.254489899, 81.23519897, "Weerawila Airport"},
+	{"WSA", "PG", -8.2836, 142.8697, "Wasua Airport"},
+	{"WSD", "US", 32.34149933, -106.4029999, "Condron Army Air Field"},
+	{"WSG", "US", 40.13650131, -80.29019928, "Washington County Airport"},
+	{"WSH", "US", 40.82189941, -72.86940002, "Brookhaven Airport"},
+	{"WSM", "US", 67.40460205, -150.1230011, "Wiseman Airport"},
+	{"WSN", "US", 58.70339966, -157.0079956, "South Naknek Nr 2 Airport"},
+	{"WSO", "SR", 5.250150204, -57.17380142, "Washabo Airport"},
+	{"WSP", "NI", 14.73919964, -83.9693985, "Waspam Airport"},
+	{"WSR", "ID", -2.721, 134.5061, "Wasior Airport"},
+	{"WST", "US", 41.34960175, -71.80339813, "Westerly State Airport"},
+	{"WSU", "PG", -5.961709449, 147.1982253, "Wasu Airport"},
+	{"WSY", "AU", -20.27611, 148.755, "Whitsunday Island Airport"},
+	{"WSZ", "NZ", -41.73809814, 171.5809937, "Westport Airport"},
+	{"WTA", "MG", -17.47610092, 43.97280121, "Tambohorano Airport"},
+	{"WTD", "BS", 26.68530083, -78.97499847, "West End Airport"},
+	{"WTE", "MH", 9.458333, 170.238611, "Wotje Atoll Airport"},
+	{"WTK", "US", 67.56610107, -162.9750061, "Noatak Airport"},
+	{"WTL", "US", 60.33530045, -162.6670074, "Tuntutuliak Airport"},
+	{"WTN", "GB", 53.16619873, -0.523810983, "RAF Waddington"},
+	{"WTO", "MH", 10.17329979, 166.003006, "Wotho Island Airport"},
+	{"WTP", "PG", -8.545833333, 147.2525, "Woitape Airport"},
+	{"WTS", "MG", -18.75967656, 46.05406523, "Tsiroanomandidy Airport"},
+	{"WTT", "PG", -6.1325, 146.4677778, "Wantoat Airport"},
+	{"WTZ", "NZ", -36.83169937, 175.6790009, "Whitianga Airport"},
+	{"WUA", "CN", 39.7934, 106.7993, "Wuhai Airport"},
+	{"WUD", "AU", -33.04330063, 135.4470062, "Wudinna Airport"},
+	{"WUG", "PG", -7.345555556, 146.7186111, "Wau Airport"},
+	{"WUH", "CN", 30.78380013, 114.2080002, "Wuhan Tianhe International Airport"},
+	{"WUN", "AU", -26.62919998, 120.2210007, "Wiluna Airport"},
+	{"WUS", "CN", 27.70190048, 118.0009995, "Nanping Wuyishan Airport"},
+	{"WUU", "SS", 7.725830078, 27.97500038, "Wau Airport"},
+	{"WUV", "PG", -1.736111111, 142.8366667, "Wuvulu Island Airport"},
+	{"WUX", "CN", 31.49440002, 120.4290009, "Sunan Shuofang International Airport"},
+	{"WUZ", "CN", 23.45669937, 111.2480011, "Changzhoudao Airport"},
+	{"WVB", "NA", -22.97990036, 14.64529991, "Walvis Bay Airport"},
+	{"WVI", "US", 36.93569946, -121.7900009, "Watsonville Municipal Airport"},
+	{"WVK", "MG", -22.11969948, 48.02170181, "Manakara Airport"},
+	{"WVL", "US", 44.53319931, -69.67549896, "Waterville Robert Lafleur Airport"},
+	{"WVN", "DE", 53.50222015, 8.052222252, "Wilhelmshaven-Mariensiel Airport"},
+	{"WWA", "US", 61.57170105, -149.5399933, "Wasilla Airport"},
+	{"WWD", "US", 39.00849915, -74.90830231, "Cape May County Airport"},
+	{"WWI", "AU", -21.66279984, 121.2340012, "Woodie Woodie Airport"},
+	{"WWK", "PG", -3.583830118, 143.6690063, "Wewak International Airport"},
+	{"WWR", "US", 36.438, -99.5226667, "West Woodward Airport"},
+	{"WWY", "AU", -33.93719864, 147.1909943, "West Wyalong Airport"},
+	{"WXF", "GB", 51.97190094, 0.509443998, "Wethersfield Airport"},
+	{"WXN", "CN", 30.8017, 108.433, "Wanxian Airport"},
+	{"WYA", "AU", -33.05889893, 137.5140076, "Whyalla Airport"},
+	{"WYE", "SL", 8.610469818, -11.04539967, "Yengema Airport"},
+	{"WYN", "AU", -15.51140022, 128.1529999, "Wyndham Airport"},
+	{"WYS", "US", 44.68840027, -111.1179962, "Yellowstone Airport"},
+	{"XAB", "FR", 50.14350128, 1.831889987, "Abbeville-Buigny-Saint-Maclou Airport"},
+	{"XAC", "FR", 44.59640121, -1.110829949, "Arcachon-La Teste-de-Buch Airport"},
+	{"XAL", "MX", 27.03569984, -108.947998, "Álamos Airport"},
+	{"XAN", "FR", 48.44749832, 0.109167002, "Alençon Valframbert Airport"},
+	{"XAP", "BR", -27.13419914, -52.65660095, "Chapecó Airport"},
+	{"XAR", "BF", 14.21700001, -0.883000016, "Aribinda Airport"},
+	{"XAS", "FR", 44.06969833, 4.142119884, "Alès-Deaux Airport"},
+	{"XAU", "GF", 3.613610029, -53.20420074, "Saúl Airport"},
+	{"XAV", "FR", 45.62720108, 6.32972002, "Albertville Airport"},
+	{"XBE", "CA", 53.96559906, -91.02719879, "Bearskin Lake Airport"},
+	{"XBG", "BF", 12.9829998, -0.166999996, "Bogande Airport"},
+	{"XBJ", "IR", 32.89810181, 59.26610184, "Birjand Airport"},
+	{"XBN", "PG", -9.6425, 149.3038889, "Biniguni Airport"},
+	{"XBO", "BF", 12.64999962, -0.566999972, "Boulsa Airport"},
+	{"XBQ", "FR", 47.67850113, 1.208840013, "Blois-Le Breuil Airport"},
+	{"XBR", "CA", 44.63940048, -75.75029755, "Brockville - Thousand Islands Regional Tackaberry Airport"},
+	{"XBV", "FR", 47.00590134, 4.893420219, "Beaune-Challanges Airport"},
+	{"XBX", "FR", 49.10279846, 0.56666702, "Bernay – St Martin Airport"},
+	{"XCB", "FR", 50.22180176, 3.154239893, "Cambrai-Épinoy (BA 103) Air Base"},
+	{"XCH", "CX", -10.45059967, 105.6900024, "Christmas Island Airport"},
+	{"XCL", "CA", 58.39110184, -109.5159988, "Cluff Lake Airport"},
+	{"XCM", "CA", 42.3064003, -82.08190155, "Chatham Kent Airport"},
+	{"XCO", "AU", -38.2867012, 143.6799927, "Colac Airport"},
+	{"XCP", "FR", 49.43439865, 2.806109905, "Compiègne Margny Airport"},
+	{"XCR", "FR", 48.77610016, 4.184490204, "Châlons-Vatry Air Base"},
+	{"XCW", "FR", 48.0862999, 5.049019814, "Chaumont-Semoutiers Airport"},
+	{"XCX", "FR", 46.78139877, 0.551944017, "Châtellerault Airport"},
+	{"XCZ", "FR", 49.78390121, 4.647079945, "Charleville-Mézières Airport"},
+	{"XDA", "FR", 43.68920135, -1.068889976, "Dax Seyresse Airport"},
+	{"XDE", "BF", 10.94999981, -3.25, "Diebougou Airport"},
+	{"XDJ", "BF", 14.10000038, -1.633000016, "Djibo Airport"},
+	{"XDK", "FR", 51.04059982, 2.550280094, "Dunkerque les Moeres Airport"},
+	{"XEN", "CN", 40.58029938, 120.697998, "Xingcheng Air Base"},
+	{"XFN", "CN", 32.15060043, 112.2910004, "Xiangfan Airport"},
+	{"XFW", "DE", 53.53527832, 9.83555603, "Hamburg-Finkenwerder Airport"},
+	{"XGA", "BF", 10.29920006, -3.250829935, "Gaoua Airport"},
+	{"XGG", "BF", 14.44999981, -0.232999995, "Gorom-Gorom Airport"},
+	{"XGN", "AO", -16.7553997, 14.96529961, "Xangongo Airport"},
+	{"XGR", "CA", 58.71139908, -65.99279785, "Kangiqsualujjuaq (Georges River) Airport"},
+	{"XGT", "FR", 46.17919922, 1.958330035, "Guéret St Laurent Airport"},
+	{"XIC", "CN", 27.9890995, 102.1839981, "Xichang Qingshan Airport"},
+	{"XIJ", "KW", 28.93479919, 47.79190063, "Ahmed Al Jaber Air Base"},
+	{"XIL", "CN", 43.91559982, 115.9639969, "Xilinhot Airport"},
+	{"XIN", "CN", 24.14920044, 115.7580032, "Xingning Airport"},
+	{"XIY", "CN", 34.44710159, 108.7519989, "Xi\"an Xianyang International Airport"},
+	{"XJD", "QA", 25.11730003, 51.31499863, "Al Udeid Air Base"},
+	{"XJM", "PK", 33.05009842, 73.63839722, "Mangla Airport"},
+	{"XKA", "BF", 12.46700001, 1.5, "Kantchari Airport"},
+	{"XKH", "LA", 19.45000076, 103.1579971, "Xieng Khouang Airport"},
+	{"XKS", "CA", 53.52470016, -88.64279938, "Kasabonika Airport"},
+	{"XKY", "BF", 13.06700039, -1.100000024, "Kaya Airport"},
+	{"XLB", "CA", 58.61750031, -101.4690018, "Lac Brochet Airport"},
+	{"XLN", "FR", 49.59579849, 3.631669998, "Laon - Chambry Airport"},
+	{"XLR", "FR", 44.98249817, -0.134721994, "Libourne-Artigues-de-Lussac Airport"},
+	{"XLS", "SN", 16.05080032, -16.46319962, "Saint Louis Airport"},
+	{"XLU", "BF", 11.10000038, -2.099999905, "Leo Airport"},
+	{"XLW", "DE", 53.1446991, 8.624440193, "Lemwerder Airport"},
+	{"XMA", "PH", 7.7538, 125.0333, "Maramag Airport"},
+	{"XMC", "AU", -37.59830093, 149.7200012, "Mallacoota Airport"},
+	{"XMD", "US", 44.01599884, -97.08589935, "Madison Municipal Airport"},
+	{"XME", "FR", 50.3105011, 4.033120155, "Maubeuge-Élesmes Airport"},
+	{"XMF", "FR", 47.48699951, 6.790540218, "Montbéliard-Courcelles Airport"},
+	{"XMG", "NP", 28.96319962, 80.14790344, "Mahendranagar Airport"},
+	{"XMH", "PF", -14.4368, -146.0700073, "Manihi Airport"},
+	{"XMI", "TZ", -10.7329998, 38.76699829, "Masasi Airport"},
+	{"XMJ", "FR", 43.9117012, -0.507499993, "Mont-de-Marsan (BA 118) Air Base"},
+	{"XMK", "FR", 44.58359909, 4.740560055, "Montélimar - Ancône Airport"},
+	{"XML", "AU", -34.75, 137.5330048, "Minlaton Airport"},
+	{"XMN", "CN", 24.54400063, 118.1279984, "Xiamen Gaoqi International Airport"},
+	{"XMP", "CA", 63.18109894, -130.2019959, "Macmillan Pass Airport"},
+	{"XMS", "EC", -2.299170017, -78.1207962, "Coronel E Carvajal Airport"},
+	{"XMW", "FR", 44.02569962, 1.378039956, "Montauban Airport"},
+	{"XMY", "AU", -9.901109695, 142.776001, "Yam Island Airport"},
+	{"XNA", "US", 36.2818985, -94.30680084, "Northwest Arkansas Regional Airport"},
+	{"XNN", "CN", 36.52750015, 102.0429993, "Xining Caojiabu Airport"},
+	{"XNT", "CN", 36.8831, 114.4293, "Xingtai Dalian Airport"},
+	{"XNU", "BF", 12.75, -3.867000103, "Nouna Airport"},
+	{"XOG", "FR", 44.14049911, 4.8667202, "Orange-Caritat (BA 115) Air Base"},
+	{"XPA", "BF", 11.25, 0.699999988, "Pama Airport"},
+	{"XPK", "CA", 55.74919891, -101.2659988, "Pukatawagan Airport"},
+	{"XPL", "HN", 14.38239956, -87.62120056, "Coronel Enrique Soto Cano Air Base"},
+	{"XPP", "CA", 52.99652588, -97.27419376, "Poplar River Airport"},
+	{"XPR", "US", 43.02249908, -102.5110016, "Pine Ridge Airport"},
+	{"XQC", "IQ", 33.94020081, 44.36159897, "Joint Base Balad"},
+	{"XQP", "CR", 9.443160057, -84.12979889, "Quepos Managua Airport"},
+	{"XRH", "AU", -33.6006012, 150.7810059, "RAAF Base Richmond"},
+	{"XRR", "CA", 61.97060013, -132.4230042, "Ross River Airport"},
+	{"XRY", "ES", 36.74459839, -6.060110092, "Jerez Airport"},
+	{"XSC", "TC", 21.51569939, -71.52850342, "South Caicos Airport"},
+	{"XSD", "US", 37.79880142, -116.7809982, "Tonopah Test Range Airport"},
+	{"XSE", "BF", 13.44999981, 0.51700002, "Sebba Airport"},
+	{"XSI", "CA", 56.7928009, -98.90720367, "South Indian Lake Airport"},
+	{"XSJ", "FR", 49.86849976, 3.029580116, "Péronne-Saint-Quentin Airport"},
+	{"XSL", "FR", 44.79330063, 1.244719982, "Sarlat Domme Airport"},
+	{"XSO", "PH", 7.710481328, 122.1611881, "Siocon Airport"},
+	{"XSP", "SG", 1.416949987, 103.8679962, "Seletar Airport"},
+	{"XSU", "FR", 47.25680161, -0.115142003, "Saumur-Saint-Florent Airport"},
+	{"XTB", "FR", 43.21609879, 0.078611001, "Tarbes Laloubère Airport"},
+	{"XTG", "AU", -27.9864006, 143.8110046, "Thargomindah Airport"},
+	{"XTL", "CA", 58.70610046, -98.5121994, "Tadoule Lake Airport"},
+	{"XTO", "AU", -25.80170059, 149.8999939, "Taroom Airport"},
+	{"XTR", "AU", -27.15670013, 150.477005, "Tara Airport"},
+	{"XUZ", "CN", 34.059056, 117.555278, "Xuzhou Guanyin Airport"},
+	{"XVL", "VN", 10.2509, 105.9445, "Vinh Long Airfield"},
+	{"XVN", "FR", 49.12239838, 5.469049931, "Verdun-Le Rozelier Airport"},
+	{"XVO", "FR", 47.63759995, 6.203919888, "Vesoul-Frotey Airport"},
+	{"XVS", "FR", 50.32580185, 3.46126008, "Valenciennes-Denain Airport"},
+	{"XVZ", "FR", 47.19469833, 2.066669941, "Vierzon Méreau Airport"},
+	{"XWP", "SE", 56.13440699, 13.87564659, "Hässleholm Bokeberg Airport"},
+	{"XXN", "SA", 24.70980072, 46.72520065, "Riyadh Air Base"},
+	{"XYA", "SB", -9.092816, 159.21841, "Yandina Airport"},
+	{"XYE", "MM", 15.30000019, 97.86699677, "Ye Airport"},
+	{"XYR", "PG", -3.883986, 141.792234, "Edwaki Airport"},
+	{"XZA", "BF", 11.16699982, -0.616999984, "Zabré Airport"},
+	{"YAA", "CA", 52.45249939, -125.3030014, "Anahim Lake Airport"},
+	{"YAC", "CA", 51.72719955, -91.82440186, "Cat Lake Airport"},
+	{"YAG", "CA", 48.65420151, -93.43969727, "Fort Frances Municipal Airport"},
+	{"YAH", "CA", 53.75469971, -73.6753006, "La Grande-4 Airport"},
+	{"YAI", "CL", -36.58250046, -72.03140259, "Gral. Bernardo O´Higgins Airport"},
+	{"YAK", "US", 59.50329971, -139.6600037, "Yakutat Airport"},
+	{"YAL", "CA", 50.5821991, -126.9160004, "Alert Bay Airport"},
+	{"YAM", "CA", 46.48500061, -84.50939941, "Sault Ste Marie Airport"},
+	{"YAN", "CD", 0.782999992, 24.46699905, "Yangambi Airport"},
+	{"YAO", "CM", 3.83604002, 11.52350044, "Yaoundé Airport"},
+	{"YAP", "FM", 9.49890995, 138.0829926, "Yap International Airport"},
+	{"YAR", "CA", 53.57170105, -76.1964035, "La Grande-3 Airport"},
+	{"YAS", "FJ", -16.75889969, 177.5449982, "Yasawa Island Airport"},
+	{"YAT", "CA", 52.92750168, -82.43190002, "Attawapiskat Airport"},
+	{"YAU", "CA", 61.66220093, -73.3214035, "Donaldson Airport"},
+	{"YAX", "CA", 53.8492012, -89.57939911, "Wapekeka Airport"},
+	{"YAY", "CA", 51.39189911, -56.08309937, "St. Anthony Airport"},
+	{"YAZ", "CA", 49.07982555, -125.7756042, "Tofino / Long Beach Airport"},
+	{"YBA", "CA", 51.20734047, -115.5418611, "Banff Airport"},
+	{"YBB", "CA", 68.53440094, -89.80809784, "Kugaaruk Airport"},
+	{"YBC", "CA", 49.13249969, -68.20439911, "Baie Comeau Airport"},
+	{"YBE", "CA", 59.56140137, -108.4810028, "Uranium City Airport"},
+	{"YBG", "CA", 48.33060074, -70.99639893, "CFB Bagotville"},
+	{"YBI", "CA", 53.4693985, -55.78499985, "Black Tickle Airport"},
+	{"YBK", "CA", 64.29889679, -96.07779694, "Baker Lake Airport"},
+	{"YBL", "CA", 49.95080185, -125.2710037, "Campbell River Airport"},
+	{"YBO", "CA", 56.96670151, -130.25, "Bob Quinn Lake Airport"},
+	{"YBP", "CN", 28.80055556, 104.545, "Yibin Caiba Airport"},
+	{"YBR", "CA", 49.90999985, -99.95189667, "Brandon Municipal Airport"},
+	{"YBS", "CA", 52.6067009, -90.37689972, "Opapimiskan Lake Airport"},
+	{"YBT", "CA", 57.88940048, -101.6790009, "Brochet Airport"},
+	{"YBV", "CA", 52.35889816, -97.01830292, "Berens River Airport"},
+	{"YBX", "CA", 51.4435997, -57.18529892, "Lourdes de Blanc Sablon Airport"},
+	{"YBY", "CA", 54.30419922, -110.7440033, "Bonnyville Airport"},
+	{"YCB", "CA", 69.10810089, -105.1380005, "Cambridge Bay Airport"},
+	{"YCC", "CA", 45.09280014, -74.56330109, "Cornwall Regional Airport"},
+	{"YCD", "CA", 49.05497022, -123.8698626, "Nanaimo Airport"},
+	{"YCE", "CA", 43.28559875, -81.50830078, "James T. Field Memorial Aerodrome"},
+	{"YCG", "CA", 49.29639816, -117.6320038, "Castlegar/West Kootenay Regional Airport"},
+	{"YCH", "CA", 47.00780106, -65.44920349, "Miramichi Airport"},
+	{"YCK", "CA", 67.03330231, -126.0830002, "Colville Lake Airport"},
+	{"YCL", "CA", 47.99079895, -66.33029938, "Charlo Airport"},
+	{"YCM", "CA", 43.19169998, -79.17169952, "Niagara District Airport"},
+	{"YCN", "CA", 49.10559845, -81.01360321, "Cochrane Airport"},
+	{"YCO", "CA", 67.8167038, -115.1439972, "Kugluktuk Airport"},
+	{"YCQ", "CA", 55.68719864, -121.6269989, "Chetwynd Airport"},
+	{"YCR", "CA", 54.61059952, -97.76080322, "Cross Lake (Charlie Sinclair Memorial) Airport"},
+	{"YCS", "CA", 63.34690094, -90.73110199, "Chesterfield Inlet Airport"},
+	{"YCT", "CA", 52.07500076, -111.4449997, "Coronation Airport"},
+	{"YCU", "CN", 35.116391, 111.0313889, "Yuncheng Guangong Airport"},
+	{"YCW", "CA", 49.15280151, -121.939003, "Chilliwack Airport"},
+	{"YCY", "CA", 70.48609924, -68.51670074, "Clyde River Airport"},
+	{"YCZ", "CA", 50.33029938, -115.8730011, "Fairmont Hot Springs Airport"},
+	{"YDA", "CA", 64.04309845, -139.128006, "Dawson City Airport"},
+	{"YDB", "CA", 61.37110138, -139.0410004, "Burwash Airport"},
+	{"YDF", "CA", 49.21080017, -57.39139938, "Deer Lake Airport"},
+	{"YDG", "CA", 44.54584504, -65.78542471, "Digby / Annapolis Regional Airport"},
+	{"YDL", "CA", 58.42219925, -130.0319977, "Dease Lake Airport"},
+	{"YDN", "CA", 51.10079956, -100.052002, "Dauphin Barker Airport"},
+	{"YDO", "CA", 48.7784996, -72.375, "Dolbeau St Felicien Airport"},
+	{"YDP", "CA", 56.54919815, -61.68030167, "Nain Airport"},
+	{"YDQ", "CA", 55.74229813, -120.1829987, "Dawson Creek Airport"},
+	{"YDT", "CA", 49.07419968, -123.012001, "Boundary Bay Airport"},
+	{"YDV", "CA", 51.784568, -96.692305, "Bloodvein River Airport"},
+	{"YEB", "CA", 46.42029953, -84.09220123, "Bar River Airport"},
+	{"YEC", "KR", 36.63190079, 128.3549957, "Yecheon Airport"},
+	{"YEG", "CA", 53.30970001, -113.5800018, "Edmonton International Airport"},
+	{"YEH", "IR", 27.37960052, 52.73770142, "Persian Gulf International Airport"},
+	{"YEI", "TR", 40.25519943, 29.56259918, "Bursa Yenişehir Airport"},
+	{"YEK", "CA", 61.09420013, -94.07080078, "Arviat Airport"},
+	{"YEL", "CA", 46.35139847, -82.56140137, "Elliot Lake Municipal Airport"},
+	{"YEM", "CA", 45.84280014, -81.85810089, "Manitoulin East Municipal Airport"},
+	{"YEN", "CA", 49.21030045, -102.9660034, "Estevan Airport"},
+	{"YEO", "GB", 51.00939941, -2.638819933, "RNAS Yeovilton"},
+	{"YEQ", "PG", -5.109722222, 143.9176667, "Yenkis(Yankisa) Airport"},
+	{"YER", "CA", 56.01890182, -87.67610168, "Fort Severn Airport"},
+	{"YET", "CA", 53.57889938, -116.4649963, "Edson Airport"},
+	{"YEU", "CA", 79.99469757, -85.81420136, "Eureka Airport"},
+	{"YEV", "CA", 68.30419922, -133.4830017, "Inuvik Mike Zubko Airport"},
+	{"YEY", "CA", 48.56389999, -78.24970245, "Amos Magny Airport"},
+	{"YFA", "CA", 52.20140076, -81.69689941, "Fort Albany Airport"},
+	{"YFB", "CA", 63.75640106, -68.55580139, "Iqaluit Airport"},
+	{"YFC", "CA", 45.8689003, -66.53720093, "Fredericton Airport"},
+	{"YFE", "CA", 48.74610138, -69.09719849, "Forestville Airport"},
+	{"YFH", "CA", 51.56190109, -87.90779877, "Fort Hope Airport"},
+	{"YFI", "CA", 57.27583333, -110.9766667, "Fort Mackay / Firebag"},
+	{"YFO", "CA", 54.67810059, -101.6819992, "Flin Flon Airport"},
+	{"YFR", "CA", 61.18080139, -113.6900024, "Fort Resolution Airport"},
+	{"YFS", "CA", 61.7602005, -121.2369995, "Fort Simpson Airport"},
+	{"YFX", "CA", 52.37279892, -55.6739006, "St. Lewis (Fox Harbour) Airport"},
+	{"YGB", "CA", 49.69419861, -124.5179977, "Texada Gillies Bay Airport"},
+	{"YGH", "CA", 66.24079895, -128.651001, "Fort Good Hope Airport"},
+	{"YGJ", "JP", 35.49219894, 133.2359924, "Miho Yonago Airport"},
+	{"YGK", "CA", 44.22529984, -76.59690094, "Kingston Norman Rogers Airport"},
+	{"YGL", "CA", 53.62530136, -77.70420074, "La Grande Rivière Airport"},
+	{"YGM", "CA", 50.62810135, -97.04329681, "Gimli Industrial Park Airport"},
+	{"YGO", "CA", 54.55889893, -94.49140167, "Gods Lake Narrows Airport"},
+	{"YGP", "CA", 48.77529907, -64.47859955, "Gaspé (Michel-Pouliot) Airport"},
+	{"YGQ", "CA", 49.77830124, -86.93939972, "Geraldton Greenstone Regional Airport"},
+	{"YGR", "CA", 47.42470169, -61.77809906, "Îles-de-la-Madeleine Airport"},
+	{"YGT", "CA", 69.36470032, -81.81610107, "Igloolik Airport"},
+	{"YGV", "CA", 50.2818985, -63.6114006, "Havre St Pierre Airport"},
+	{"YGW", "CA", 55.2818985, -77.76529694, "Kuujjuarapik Airport"},
+	{"YGX", "CA", 56.35749817, -94.71060181, "Gillam Airport"},
+	{"YGZ", "CA", 76.42610168, -82.90920258, "Grise Fiord Airport"},
+	{"YHA", "CA", 52.52809906, -56.28609848, "Port Hope Simpson Airport"},
+	{"YHB", "CA", 52.81669998, -102.310997, "Hudson Bay Airport"},
+	{"YHD", "CA", 49.83169937, -92.74420166, "Dryden Regional Airport"},
+	{"YHE", "CA", 49.36830139, -121.4980011, "Hope Airport"},
+	{"YHF", "CA", 49.71419907, -83.68609619, "Hearst René Fontaine Municipal Airport"},
+	{"YHI", "CA", 70.76280212, -117.8059998, "Ulukhaktok Holman Airport"},
+	{"YHK", "CA", 68.63559723, -95.84970093, "Gjoa Haven Airport"},
+	{"YHM", "CA", 43.17359924, -79.93499756, "John C. Munro Hamilton International Airport"},
+	{"YHN", "CA", 49.19309998, -84.7589035, "Hornepayne Municipal Airport"},
+	{"YHO", "CA", 55.44829941, -60.22859955, "Hopedale Airport"},
+	{"YHP", "CA", 52.11330032, -94.25559998, "Poplar Hill Airport"},
+	{"YHR", "CA", 50.46889877, -59.63669968, "Chevery Airport"},
+	{"YHT", "CA", 60.78919983, -137.5460052, "Haines Junction Airport"},
+	{"YHU", "CA", 45.51750183, -73.41690063, "Montréal / Saint-Hubert Airport"},
+	{"YHY", "CA", 60.83969879, -115.7829971, "Hay River / Merlyn Carter Airport"},
+	{"YHZ", "CA", 44.88079834, -63.50859833, "Halifax / Stanfield International Airport"},
+	{"YIB", "CA", 48.77389908, -91.63860321, "Atikokan Municipal Airport"},
+	{"YIF", "CA", 51.21170044, -58.65829849, "St Augustin Airport"},
+	{"YIH", "CN", 30.55654972, 111.4799883, "Yichang Airport"},
+	{"YIK", "CA", 62.41730118, -77.9253006, "Ivujivik Airport"},
+	{"YIN", "CN", 43.9557991, 81.33029938, "Yining Airport"},
+	{"YIO", "CA", 72.6832962, -77.96669769, "Pond Inlet Airport"},
+	{"YIP", "US", 42.23789978, -83.53040314, "Willow Run Airport"},
+	{"YIV", "CA", 53.85720062, -94.6536026, "Island Lake Airport"},
+	{"YIW", "CN", 29.34469986, 120.0319977, "Yiwu Airport"},
+	{"YJF", "CA", 60.2358017, -123.4690018, "Fort Liard Airport"},
+	{"YJN", "CA", 45.29439926, -73.28109741, "St Jean Airport"},
+	{"YJS", "KP", 41.90589905, 128.4100037, "Samjiyŏn Airport"},
+	{"YJT", "CA", 48.5442009, -58.54999924, "Stephenville Airport"},
+	{"YKA", "CA", 50.70220184, -120.4440002, "Kamloops Airport"},
+	{"YKC", "CA", 58.23609924, -103.6780014, "Collins Bay Airport"},
+	{"YKD", "CA", 44.20140076, -81.60669708, "Kincardine Airport"},
+	{"YKF", "CA", 43.46080017, -80.37860107, "Waterloo Airport"},
+	{"YKG", "CA", 60.02719879, -69.99919891, "Kangirsuk Airport"},
+	{"YKJ", "CA", 57.2560997, -105.6179962, "Key Lake Airport"},
+	{"YKL", "CA", 54.80530167, -66.80529785, "Schefferville Airport"},
+	{"YKM", "US", 46.56819916, -120.5439987, "Yakima Air Terminal McAllister Field"},
+	{"YKQ", "CA", 51.47330093, -78.75830078, "Waskaganish Airport"},
+	{"YKS", "RU", 62.09329987, 129.7709961, "Yakutsk Airport"},
+	{"YKU", "CA", 53.80559921, -78.91690063, "Chisasibi Airport"},
+	{"YKX", "CA", 48.21030045, -79.98139954, "Kirkland Lake Airport"},
+	{"YKY", "CA", 51.51750183, -109.1809998, "Kindersley Airport"},
+	{"YKZ", "CA", 43.86220169, -79.37000275, "Buttonville Municipal Airport"},
+	{"YLB", "CA", 54.77030182, -112.0319977, "Lac La Biche Airport"},
+	{"YLC", "CA", 62.84999847, -69.88330078, "Kimmirut Airport"},
+	{"YLD", "CA", 47.81999969, -83.34670258, "Chapleau Airport"},
+	{"YLE", "CA", 63.13169861, -117.2460022, "Whatì Airport"},
+	{"YLG", "AU", -28.3553009, 116.6839981, "Yalgoo Airport"},
+	{"YLH", "CA", 52.1955986, -87.93419647, "Lansdowne House Airport"},
+	{"YLI", "FI", 64.0605011, 24.7159996, "Ylivieska Airport"},
+	{"YLJ", "CA", 54.12530136, -108.5230026, "Meadow Lake Airport"},
+	{"YLK", "CA", 44.48529816, -79.55560303, "Barrie-Orillia (Lake Simcoe Regional Airport)"},
+	{"YLL", "CA", 53.30920029, -110.072998, "Lloydminster Airport"},
+	{"YLP", "CA", 50.2869, -64.1528, "Mingan Airport"},
+	{"YLQ", "CA", 47.40969849, -72.78890228, "La Tuque Airport"},
+	{"YLR", "CA", 56.51330185, -99.98529816, "Leaf Rapids Airport"},
+	{"YLT", "CA", 82.51779938, -62.2806015, "Alert Airport"},
+	{"YLW", "CA", 49.95610046, -119.3779984, "Kelowna International Airport"},
+	{"YLY", "CA", 49.10079956, -122.6309967, "Langley Airport"},
+	{"YMA", "CA", 63.61640167, -135.8679962, "Mayo Airport"},
+	{"YME", "CA", 48.85689926, -67.45330048, "Matane Airport"},
+	{"YMG", "CA", 49.08390045, -85.86060333, "Manitouwadge Airport"},
+	{"YMH", "CA", 52.30279922, -55.84719849, "Mary\"s Harbour Airport"},
+	{"YMJ", "CA", 50.33029938, -105.5589981, "Moose Jaw Air Vice Marshal C. M. McEwen Airport"},
+	{"YMK", "RU", 68.48300171, 73.56700134, "Mys Kamenny Airport"},
+	{"YML", "CA", 47.59749985, -70.22389984, "Charlevoix Airport"},
+	{"YMM", "CA", 56.65330124, -111.2220001, "Fort McMurray Airport"},
+	{"YMN", "CA", 55.07690048, -59.18640137, "Makkovik Airport"},
+	{"YMO", "CA", 51.29109955, -80.60780334, "Moosonee Airport"},
+	{"YMS", "PE", -5.893770218, -76.11820221, "Moises Benzaquen Rengifo Airport"},
+	{"YMT", "CA", 49.77190018, -74.52809906, "Chapais Airport"},
+	{"YMV", "CA", 71.32416667, -79.35694444, "Mary River Aerodrome"},
+	{"YMW", "CA", 46.27280045, -75.99060059, "Maniwaki Airport"},
+	{"YMX", "CA", 45.67950058, -74.03869629, "Montreal International (Mirabel) Airport"},
+	{"YNA", "CA", 50.18999863, -61.78919983, "Natashquan Airport"},
+	{"YNB", "SA", 24.14419937, 38.06340027, "Yenbo Airport"},
+	{"YNC", "CA", 53.01060104, -78.83110046, "Wemindji Airport"},
+	{"YND", "CA", 45.52170181, -75.56359863, "Ottawa / Gatineau Airport"},
+	{"YNE", "CA", 53.95830154, -97.84420013, "Norway House Airport"},
+	{"YNG", "US", 41.26070023, -80.67910004, "Youngstown Warren Regional Airport"},
+	{"YNH", "CA", 56.03559875, -121.9759979, "Hudsons Hope Airport"},
+	{"YNJ", "CN", 42.88280106, 129.451004, "Yanji Chaoyangchuan Airport"},
+	{"YNL", "CA", 58.27669907, -104.0820007, "Points North Landing Airport"},
+	{"YNM", "CA", 49.76169968, -77.80280304, "Matagami Airport"},
+	{"YNO", "CA", 52.49000168, -92.97109985, "North Spirit Lake Airport"},
+	{"YNS", "CA", 51.69110107, -76.13559723, "Nemiscau Airport"},
+	{"YNT", "CN", 37.40169907, 121.3720016, "Yantai Laishan Airport"},
+	{"YNX", "CA", 63.59360123, -110.9059982, "Snap Lake Airport"},
+	{"YNY", "KR", 38.06129837, 128.6690063, "Yangyang International Airport"},
+	{"YNZ", "CN", 33.425833, 120.203056, "Yancheng Airport"},
+	{"YOA", "CA", 64.69889832, -110.6149979, "Ekati Airport"},
+	{"YOC", "CA", 67.57060242, -139.8390045, "Old Crow Airport"},
+	{"YOD", "CA", 54.40499878, -110.2789993, "CFB Cold Lake"},
+	{"YOG", "CA", 51.65859985, -85.90170288, "Ogoki Post Airport"},
+	{"YOH", "CA", 54.93330002, -95.27890015, "Oxford House Airport"},
+	{"YOI", "CA", 52.22194444, -76.61194444, "Opinaca Aerodrome"},
+	{"YOJ", "CA", 58.62139893, -117.1650009, "High Level Airport"},
+	{"YOL", "NG", 9.25755024, 12.43039989, "Yola Airport"},
+	{"YOO", "CA", 43.92279816, -78.89499664, "Oshawa Airport"},
+	{"YOP", "CA", 58.49140167, -119.4079971, "Rainbow Lake Airport"},
+	{"YOS", "CA", 44.59030151, -80.83750153, "Owen Sound / Billy Bishop Regional Airport"},
+	{"YOT", "IL", 29.90620041, 35.06669998, "Yotvata Airfield"},
+	{"YOW", "CA", 45.32249832, -75.66919708, "Ottawa Macdonald-Cartier International Airport"},
+	{"YPA", "CA", 53.21419907, -105.6729965, "Prince Albert Glass Field"},
+	{"YPC", "CA", 69.36083812, -124.07547, "Paulatuk (Nora Aliqatchialuk Ruben) Airport"},
+	{"YPE", "CA", 56.22689819, -117.4469986, "Peace River Airport"},
+	{"YPG", "CA", 49.90309906, -98.27390289, "Southport Airport"},
+	{"YPH", "CA", 58.47190094, -78.07689667, "Inukjuak Airport"},
+	{"YPJ", "CA", 59.29669952, -69.59970093, "Aupaluk Airport"},
+	{"YPL", "CA", 51.44639969, -90.21420288, "Pickle Lake Airport"},
+	{"YPM", "CA", 51.81969833, -93.97329712, "Pikangikum Airport"},
+	{"YPN", "CA", 49.83639908, -64.28859711, "Port Menier Airport"},
+	{"YPO", "CA", 54.98809814, -85.44329834, "Peawanuck Airport"},
+	{"YPQ", "CA", 44.22999954, -78.36329651, "Peterborough Airport"},
+	{"YPR", "CA", 54.28609848, -130.4450073, "Prince Rupert Airport"},
+	{"YPS", "CA", 45.65670013, -61.36809921, "Port Hawkesbury Airport"},
+	{"YPW", "CA", 49.83420181, -124.5, "Powell River Airport"},
+	{"YPX", "CA", 60.05059814, -77.28690338, "Puvirnituq Airport"},
+	{"YPY", "CA", 58.76720047, -111.1169968, "Fort Chipewyan Airport"},
+	{"YPZ", "CA", 54.37639999, -125.9509964, "Burns Lake Airport"},
+	{"YQA", "CA", 44.97470093, -79.30329895, "Muskoka Airport"},
+	{"YQB", "CA", 46.79109955, -71.39330292, "Quebec Jean Lesage International Airport"},
+	{"YQC", "CA", 61.04639816, -69.61779785, "Quaqtaq Airport"},
+	{"YQD", "CA", 53.97140121, -101.0910034, "The Pas Airport"},
+	{"YQF", "CA", 52.18220139, -113.8939972, "Red Deer Regional Airport"},
+	{"YQG", "CA", 42.27560043, -82.95559692, "Windsor Airport"},
+	{"YQH", "CA", 60.11640167, -128.8220062, "Watson Lake Airport"},
+	{"YQI", "CA", 43.82690048, -66.08809662, "Yarmouth Airport"},
+	{"YQK", "CA", 49.78829956, -94.36309814, "Kenora Airport"},
+	{"YQL", "CA", 49.63029861, -112.8000031, "Lethbridge County Airport"},
+	{"YQM", "CA", 46.11220169, -64.6785965, "Greater Moncton International Airport"},
+	{"YQN", "CA", 50.18280029, -86.6964035, "Nakina Airport"},
+	{"YQQ", "CA", 49.71080017, -124.887001, "Comox Airport"},
+	{"YQR", "CA", 50.43190002, -104.6660004, "Regina International Airport"},
+	{"YQS", "CA", 42.77000046, -81.1108017, "St Thomas Municipal Airport"},
+	{"YQT", "CA", 48.37189865, -89.32389832, "Thunder Bay Airport"},
+	{"YQU", "CA", 55.17969894, -118.8850021, "Grande Prairie Airport"},
+	{"YQV", "CA", 51.26470184, -102.461998, "Yorkton Municipal Airport"},
+	{"YQW", "CA", 52.76919937, -108.2440033, "North Battleford Airport"},
+	{"YQX", "CA", 48.93690109, -54.56809998, "Gander International Airport"},
+	{"YQY", "CA", 46.16139984, -60.04779816, "Sydney / J.A. Douglas McCurdy Airport"},
+	{"YQZ", "CA", 53.02610016, -122.5100021, "Quesnel Airport"},
+	{"YRA", "CA", 64.1160965, -117.3099976, "Rae Lakes Airport"},
+	{"YRB", "CA", 74.71690369, -94.9693985, "Resolute Bay Airport"},
+	{"YRF", "CA", 53.68280029, -57.04190063, "Cartwright Airport"},
+	{"YRG", "CA", 54.17969894, -58.45750046, "Rigolet Airport"},
+	{"YRI", "CA", 47.76440048, -69.58470154, "Rivière-du-Loup Airport"},
+	{"YRJ", "CA", 48.52000046, -72.26560211, "Roberval Airport"},
+	{"YRL", "CA", 51.06689835, -93.79309845, "Red Lake Airport"},
+	{"YRM", "CA", 52.42969894, -114.9039993, "Rocky Mountain House Airport"},
+	{"YRO", "CA", 45.46030045, -75.64610291, "Ottawa / Rockcliffe Airport"},
+	{"YRQ", "CA", 46.35279846, -72.67939758, "Trois-Rivières Airport"},
+	{"YRS", "CA", 54.16719818, -93.55719757, "Red Sucker Lake Airport"},
+	{"YRT", "CA", 62.81140137, -92.11579895, "Rankin Inlet Airport"},
+	{"YRV", "CA", 50.96670151, -118.1829987, "Revelstoke Airport"},
+	{"YSB", "CA", 46.625, -80.79889679, "Sudbury Airport"},
+	{"YSC", "CA", 45.43859863, -71.69139862, "Sherbrooke Airport"},
+	{"YSE", "CA", 49.78170013, -123.1620026, "Squamish Airport"},
+	{"YSF", "CA", 59.25030136, -105.8410034, "Stony Rapids Airport"},
+	{"YSG", "CA", 62.41830063, -110.6819992, "Lutselk\"e Airport"},
+	{"YSH", "CA", 44.94580078, -75.94059753, "Smiths Falls-Montague (Russ Beach) Airport"},
+	{"YSJ", "CA", 45.31610107, -65.89029694, "Saint John Airport"},
+	{"YSK", "CA", 56.53779984, -79.24669647, "Sanikiluaq Airport"},
+	{"YSL", "CA", 47.15750122, -67.83470154, "St Leonard Airport"},
+	{"YSM", "CA", 60.02030182, -111.961998, "Fort Smith Airport"},
+	{"YSN", "CA", 50.68280029, -119.2289963, "Salmon Arm Airport"},
+	{"YSP", "CA", 48.75529861, -86.3443985, "Marathon Airport"},
+	{"YST", "CA", 53.84560013, -94.85189819, "St. Theresa Point Airport"},
+	{"YSU", "CA", 46.44060135, -63.83359909, "Summerside Airport"},
+	{"YSY", "CA", 71.99389648, -125.2429962, "Sachs Harbour (David Nasogaluak Jr. Saaryuaq) Airport"},
+	{"YTA", "CA", 45.86439896, -77.25170135, "Pembroke Airport"},
+	{"YTD", "CA", 55.31890106, -97.70780182, "Thicket Portage Airport"},
+	{"YTE", "CA", 64.23000336, -76.52670288, "Cape Dorset Airport"},
+	{"YTF", "CA", 48.50889969, -71.64189911, "Alma Airport"},
+	{"YTH", "CA", 55.80110168, -97.86419678, "Thompson Airport"},
+	{"YTL", "CA", 53.81779861, -89.89689636, "Big Trout Lake Airport"},
+	{"YTM", "CA", 46.40940094, -74.77999878, "La Macaza / Mont-Tremblant International Inc Airport"},
+	{"YTQ", "CA", 58.6678009, -69.95580292, "Tasiujaq Airport"},
+	{"YTR", "CA", 44.1189003, -77.52809906, "CFB Trenton"},
+	{"YTS", "CA", 48.56969833, -81.37670136, "Timmins/Victor M. Power"},
+	{"YTZ", "CA", 43.62749863, -79.39620209, "Billy Bishop Toronto City Centre Airport"},
+	{"YUB", "CA", 69.4332962, -133.026001, "Tuktoyaktuk Airport"},
+	{"YUD", "CA", 56.53609848, -76.51830292, "Umiujaq Airport"},
+	{"YUE", "AU", -22.25419998, 131.7819977, "Yuendumu Airport"},
+	{"YUL", "CA", 45.47060013, -73.74079895, "Montreal / Pierre Elliott Trudeau International Airport"},
+	{"YUM", "US", 32.65660095, -114.6060028, "Yuma MCAS/Yuma International Airport"},
+	{"YUS", "CN", 32.83638889, 97.03638889, "Yushu Batang Airport"},
+	{"YUT", "CA", 66.52140045, -86.22470093, "Repulse Bay Airport"},
+	{"YUX", "CA", 68.77610016, -81.2425, "Hall Beach Airport"},
+	{"YUY", "CA", 48.20610046, -78.83560181, "Rouyn Noranda Airport"},
+	{"YVA", "KM", -11.71080017, 43.2439003, "Iconi Airport"},
+	{"YVB", "CA", 48.07109833, -65.46029663, "Bonaventure Airport"},
+	{"YVC", "CA", 55.15140152, -105.262001, "La Ronge Airport"},
+	{"YVD", "PG", -7.548, 146.188, "Yeva Airport"},
+	{"YVE", "CA", 50.24810028, -119.3310013, "Vernon Airport"},
+	{"YVG", "CA", 53.35580063, -110.8239975, "Vermilion Airport"},
+	{"YVM", "CA", 67.54579926, -64.03140259, "Qikiqtarjuaq Airport"},
+	{"YVO", "CA", 48.05329895, -77.78279877, "Val-d\"Or Airport"},
+	{"YVP", "CA", 58.09609985, -68.42690277, "Kuujjuaq Airport"},
+	{"YVQ", "CA", 65.28160095, -126.7979965, "Norman Wells Airport"},
+	{"YVR", "CA", 49.19390106, -123.1839981, "Vancouver International Airport"},
+	{"YVT", "CA", 55.84189987, -108.4179993, "Buffalo Narrows Airport"},
+	{"YVV", "CA", 44.74580002, -81.10720062, "Wiarton Airport"},
+	{"YVZ", "CA", 52.65579987, -94.06140137, "Deer Lake Airport"},
+	{"YWA", "CA", 45.95220184, -77.31919861, "Petawawa Airport"},
+	{"YWB", "CA", 61.58860016, -71.92939758, "Kangiqsujuaq (Wakeham Bay) Airport"},
+	{"YWG", "CA", 49.90999985, -97.23989868, "Winnipeg / James Armstrong Richardson International Airport"},
+	{"YWJ", "CA", 65.21109772, -123.435997, "Déline Airport"},
+	{"YWK", "CA", 52.9219017, -66.86440277, "Wabush Airport"},
+	{"YWL", "CA", 52.18310165, -122.0540009, "Williams Lake Airport"},
+	{"YWM", "CA", 52.56689835, -55.78469849, "Williams Harbour Airport"},
+	{"YWP", "CA", 52.9593934, -87.37486839, "Webequie Airport"},
+	{"YWY", "CA", 63.20940018, -123.4369965, "Wrigley Airport"},
+	{"YXC", "CA", 49.6108017, -115.7819977, "Cranbrook Airport"},
+	{"YXE", "CA", 52.17079926, -106.6999969, "Saskatoon John G. Diefenbaker International Airport"},
+	{"YXH", "CA", 50.01890182, -110.7210007, "Medicine Hat Airport"},
+	{"YXJ", "CA", 56.23809814, -120.7399979, "Fort St John Airport"},
+	{"YXK", "CA", 48.47809982, -68.49690247, "Rimouski Airport"},
+	{"YXL", "CA", 50.11389923, -91.90529633, "Sioux Lookout Airport"},
+	{"YXN", "CA", 62.24000168, -92.59809875, "Whale Cove Airport"},
+	{"YXP", "CA", 66.14499664, -65.71360016, "Pangnirtung Airport"},
+	{"YXQ", "CA", 62.41030121, -140.8670044, "Beaver Creek Airport"},
+	{"YXR", "CA", 47.69740065, -79.84734535, "Earlton (Timiskaming Regional) Airport"},
+	{"YXS", "CA", 53.88940048, -122.6790009, "Prince George Airport"},
+	{"YXT", "CA", 54.46849823, -128.576004, "Terrace Airport"},
+	{"YXU", "CA", 43.03559875, -81.15390015, "London Airport"},
+	{"YXX", "CA", 49.02529907, -122.3610001, "Abbotsford Airport"},
+	{"YXY", "CA", 60.70959854, -135.0670013, "Whitehorse / Erik Nielsen International Airport"},
+	{"YXZ", "CA", 47.96670151, -84.78669739, "Wawa Airport"},
+	{"YYB", "CA", 46.36360168, -79.42279816, "North Bay Airport"},
+	{"YYC", "CA", 51.11389923, -114.0199966, "Calgary International Airport"},
+	{"YYD", "CA", 54.8246994, -127.1829987, "Smithers Airport"},
+	{"YYE", "CA", 58.83639908, -122.5970001, "Fort Nelson Airport"},
+	{"YYF", "CA", 49.46310043, -119.6019974, "Penticton Airport"},
+	{"YYG", "CA", 46.29000092, -63.12110138, "Charlottetown Airport"},
+	{"YYH", "CA", 69.54669952, -93.5766983, "Taloyoak Airport"},
+	{"YYJ", "CA", 48.64690018, -123.4260025, "Victoria International Airport"},
+	{"YYL", "CA", 56.86389923, -101.0759964, "Lynn Lake Airport"},
+	{"YYM", "CA", 49.63639832, -114.0940018, "Cowley Airport"},
+	{"YYN", "CA", 50.29190063, -107.6910019, "Swift Current Airport"},
+	{"YYQ", "CA", 58.73920059, -94.06500244, "Churchill Airport"},
+	{"YYR", "CA", 53.31919861, -60.42580032, "Goose Bay Airport"},
+	{"YYT", "CA", 47.61859894, -52.75189972, "St. John\"s International Airport"},
+	{"YYU", "CA", 49.41389847, -82.46749878, "Kapuskasing Airport"},
+	{"YYW", "CA", 50.29029846, -88.90969849, "Armstrong Airport"},
+	{"YYY", "CA", 48.60860062, -68.20809937, "Mont Joli Airport"},
+	{"YYZ", "CA", 43.67720032, -79.63059998, "Lester B. Pearson International Airport"},
+	{"YZD", "CA", 43.74250031, -79.46559906, "Downsview Airport"},
+	{"YZE", "CA", 45.88529968, -82.56780243, "Gore Bay Manitoulin Airport"},
+	{"YZF", "CA", 62.46279907, -114.4400024, "Yellowknife Airport"},
+	{"YZG", "CA", 62.1794014, -75.66719818, "Salluit Airport"},
+	{"YZH", "CA", 55.29309845, -114.7770004, "Slave Lake Airport"},
+	{"YZP", "CA", 53.25429916, -131.8139954, "Sandspit Airport"},
+	{"YZR", "CA", 42.99940109, -82.30889893, "Chris Hadfield Airport"},
+	{"YZS", "CA", 64.19329834, -83.35939789, "Coral Harbour Airport"},
+	{"YZT", "CA", 50.68059921, -127.3669968, "Port Hardy Airport"},
+	{"YZU", "CA", 54.14390182, -115.7870026, "Whitecourt Airport"},
+	{"YZV", "CA", 50.22330093, -66.26560211, "Sept-Îles Airport"},
+	{"YZW", "CA", 60.17279816, -132.7429962, "Teslin Airport"},
+	{"YZX", "CA", 44.9844017, -64.91690063, "CFB Greenwood"},
+	{"YZY", "CN", 38.80189896, 100.6750031, "Zhangye Southeast Air Base"},
+	{"YZZ", "CA", 49.05559921, -117.6090012, "Trail Airport"},
+	{"ZAC", "CA", 56.08940125, -96.08920288, "York Landing Airport"},
+	{"ZAD", "HR", 44.10829926, 15.34669971, "Zemunik Airport"},
+	{"ZAG", "HR", 45.74290085, 16.06879997, "Zagreb Airport"},
+	{"ZAH", "IR", 29.47570038, 60.90620041, "Zahedan International Airport"},
+	{"ZAJ", "AF", 30.972222, 61.865833, "Zaranj Airport"},
+	{"ZAL", "CL", -39.65000153, -73.08609772, "Pichoy Airport"},
+	{"ZAM", "PH", 6.922420025, 122.0599976, "Zamboanga International Airport"},
+	{"ZAO", "FR", 44.35139847, 1.475280046, "Cahors-Lalbenque Airport"},
+	{"ZAR", "NG", 11.13020039, 7.685810089, "Zaria Airport"},
+	{"ZAT", "CN", 27.32559967, 103.7549973, "Zhaotong Airport"},
+	{"ZAZ", "ES", 41.66619873, -1.04155004, "Zaragoza Air Base"},
+	{"ZBE", "CZ", 49.92829895, 18.07830048, "Zabreh Ostrava Airport"},
+	{"ZBF", "CA", 47.62969971, -65.73889923, "Bathurst Airport"},
+	{"ZBK", "ME", 43.1166687, 19.23333359, "Žabljak Airport"},
+	{"ZBM", "CA", 45.29079819, -72.74140167, "Bromont (Roland Desourdy) Airport"},
+	{"ZBO", "AU", -20.0182991, 148.2149963, "Bowen Airport"},
+	{"ZBR", "IR", 25.44330025, 60.38209915, "Konarak Airport"},
+	{"ZBY", "LA", 19.2436, 101.7093, "Sayaboury Airport"},
+	{"ZCD", "DE", 49.92055511, 10.9141674, "Bamberg-Breitenau Airport"},
+	{"ZCL", "MX", 22.89710045, -102.6869965, "General Leobardo C. Ruiz International Airport"},
+	{"ZCN", "DE", 52.59120178, 10.02210045, "Celle Airport"},
+	{"ZCO", "CL", -38.76679993, -72.63710022, "Maquehue Airport"},
+	{"ZEC", "ZA", -26.52409935, 29.17009926, "Secunda Airport"},
+	{"ZEG", "ID", -5.6908, 139.3502, "Senggo Airport"},
+	{"ZEL", "CA", 52.13970184, -128.0639954, "Denny Island Airport"},
+	{"ZEM", "CA", 52.22639847, -78.52249908, "Eastmain River Airport"},
+	{"ZEN", "PG", -6.952222222, 146.61625, "Zenag Airport"},
+	{"ZER", "IN", 27.5883007, 93.82810211, "Zero Airport"},
+	{"ZFA", "CA", 62.20750046, -133.3760071, "Faro Airport"},
+	{"ZFD", "CA", 59.33440018, -107.1819992, "Fond-Du-Lac Airport"},
+	{"ZFM", "CA", 67.40750122, -134.8609924, "Fort Mcpherson Airport"},
+	{"ZFN", "CA", 64.90969849, -125.572998, "Tulita Airport"},
+	{"ZFW", "CA", 56.08140183, -118.4349976, "Fairview Airport"},
+	{"ZGF", "CA", 49.0155983, -118.4309998, "Grand Forks Airport"},
+	{"ZGI", "CA", 54.83969879, -94.07859802, "Gods River Airport"},
+	{"ZGL", "AU", -25.68330002, 142.1080017, "South Galway Airport"},
+	{"ZGM", "ZM", -15.96580029, 25.93330002, "Ngoma Airport"},
+	{"ZGQ", "BE", 50.52970123, 3.49454999, "Tournai/Maubray Airport"},
+	{"ZGR", "CA", 52.04560089, -95.46579742, "Little Grand Rapids Airport"},
+	{"ZGU", "VU", -14.21809959, 167.5870056, "Gaua Island Airport"},
+	{"ZHA", "CN", 21.21439934, 110.3580017, "Zhanjiang Airport"},
+	{"ZHI", "CH", 47.18159866, 7.417190075, "Grenchen Airport"},
+	{"ZHM", "BD", 24.41699982, 91.88300323, "Shamshernagar Airport"},
+	{"ZHP", "CA", 55.39360046, -116.4749985, "High Prairie Airport"},
+	{"ZHY", "CN", 37.573125, 105.154454, "Zhongwei Shapotou Airport"},
+	{"ZIA", "IT", 46.02140045, 11.12419987, "Trento-Mattarello Airport"},
+	{"ZIC", "CL", -38.24560165, -72.34860229, "Victoria Airport"},
+	{"ZIG", "SN", 12.55560017, -16.28179932, "Ziguinchor Airport"},
+	{"ZIH", "MX", 17.60160065, -101.4609985, "Ixtapa Zihuatanejo International Airport"},
+	{"ZIN", "CH", 46.67660141, 7.879079819, "Interlaken Air Base"},
+	{"ZJG", "CA", 54.51890182, -98.0460968, "Jenpeg Airport"},
+	{"ZJI", "CH", 46.16080093, 8.878609657, "Locarno Airport"},
+	{"ZJN", "CA", 52.12060165, -101.2360001, "Swan River Airport"},
+	{"ZKB", "ZM", -8.524999619, 30.66300011, "Kasaba Bay Airport"},
+	{"ZKE", "CA", 52.28250122, -81.67780304, "Kashechewan Airport"},
+	{"ZKG", "CA", 50.19580078, -61.26580048, "Kegaska Airport"},
+	{"ZKM", "GA", -2.532999992, 9.767000198, "Sette Cama Airport"},
+	{"ZKP", "ZM", -12.57279968, 27.89389992, "Kasompe Airport"},
+	{"ZLO", "MX", 19.14480019, -104.5589981, "Playa De Oro International Airport"},
+	{"ZLR", "CL", -35.86169815, -71.54859924, "Municipal de Linares Airport"},
+	{"ZLT", "CA", 50.8307991, -58.9756012, "La Tabatière Airport"},
+	{"ZMG", "DE", 52.07361221, 11.62638855, "Magdeburg Airport"},
+	{"ZMH", "CA", 51.73609924, -121.3330002, "South Cariboo Region / 108 Mile Airport"},
+	{"ZMM", "MX", 20.04500008, -102.276001, "Zamora Airport"},
+	{"ZMT", "CA", 54.02750015, -132.125, "Masset Airport"},
+	{"ZNC", "US", 60.98070145, -159.9940033, "Nyac Airport"},
+	{"ZND", "NE", 13.77900028, 8.98375988, "Zinder Airport"},
+	{"ZNE", "AU", -23.4178009, 119.8030014, "Newman Airport"},
+	{"ZNF", "DE", 50.1692009, 8.961589813, "Hanau Army Air Field"},
+	{"ZNZ", "TZ", -6.222020149, 39.22489929, "Abeid Amani Karume International Airport"},
+	{"ZOJ", "DE", 51.64720154, 7.163330078, "Marl-Loemühle Airport"},
+	{"ZOS", "CL", -40.61119843, -73.06099701, "Cañal Bajo Carlos - Hott Siebert Airport"},
+	{"ZOW", "DE", 52.45750046, 7.182221889, "Nordhorn-Lingen Airport"},
+	{"ZPB", "CA", 53.89110184, -92.1964035, "Sachigo Lake Airport"},
+	{"ZPC", "CL", -39.2928009, -71.91590118, "Pucón Airport"},
+	{"ZPH", "US", 28.22820091, -82.15589905, "Zephyrhills Municipal Airport"},
+	{"ZPO", "CA", 55.52809906, -106.5820007, "Pinehouse Lake Airport"},
+	{"ZPQ", "DE", 52.29119873, 7.387000084, "Rheine Bentlage Airport"},
+	{"ZQL", "DE", 47.97333145, 8.522221565, "Donaueschingen-Villingen Airport"},
+	{"ZQN", "NZ", -45.02109909, 168.7389984, "Queenstown International Airport"},
+	{"ZQW", "DE", 49.20940018, 7.400559902, "Zweibrücken Airport"},
+	{"ZQZ", "CN", 40.73860168, 114.9300003, "Zhangjiakou Ningyuan Airport"},
+	{"ZRE", "RS", 45.339756, 20.454078, "Zrenjanin Airport"},
+	{"ZRH", "CH", 47.46469879, 8.54916954, "Zürich Airport"},
+	{"ZRI", "ID", -1.875579953, 136.2409973, "Serui Airport"},
+	{"ZRJ", "CA", 52.9435997, -91.31279755, "Round Lake (Weagamow Lake) Airport"},
+	{"ZRM", "ID", -1.86954999, 138.75, "Sarmi Airport"},
+	{"ZSA", "BS", 24.06329918, -74.52400208, "San Salvador Airport"},
+	{"ZSE", "RE", -21.32089996, 55.42499924, "Pierrefonds Airport"},
+	{"ZSJ", "CA", 53.06420135, -93.3443985, "Sandy Lake Airport"},
+	{"ZSP", "CA", 53.99330139, -111.3799973, "St. Paul Airport"},
+	{"ZSS", "CI", 4.928329945, -6.132780075, "Sassandra Airport"},
+	{"ZST", "CA", 55.93541045, -129.9824345, "Stewart Airport"},
+	{"ZTA", "PF", -20.78969955, -138.5700073, "Tureia Airport"},
+	{"ZTB", "CA", 50.67440033, -59.38359833, "Tête-à-la-Baleine Airport"},
+	{"ZTH", "GR", 37.75090027, 20.88430023, "Dionysios Solomos Airport"},
+	{"ZTM", "CA", 55.86560059, -92.08139801, "Shamattawa Airport"},
+	{"ZTR", "UA", 50.270556, 28.738611, "Zhytomyr Airport"},
+	{"ZTU", "AZ", 41.56222153, 46.66722107, "Zaqatala International Airport"},
+	{"ZUC", "CA", 49.42969894, -91.71779633, "Ignace Municipal Airport"},
+	{"ZUD", "CL", -41.90390015, -73.79669952, "Pupelde Airport"},
+	{"ZUH", "CN", 22.00639915, 113.3759995, "Zhuhai Airport"},
+	{"ZUL", "SA", 26.35000038, 44.83300018, "Zilfi Airport"},
+	{"ZUM", "CA", 53.56190109, -64.10639954, "Churchill Falls Airport"},
+	{"ZVA", "MG", -19.56279945, 45.45080185, "Miandrivazo Airport"},
+	{"ZVG", "AU", -17.78689957, 127.6699982, "Springvale Airport"},
+	{"ZVK", "LA", 16.55660057, 104.7600021, "Savannakhet Airport"},
+	{"ZWA", "MG", -14.65170002, 49.62060165, "Andapa Airport"},
+	{"ZWL", "CA", 58.10689926, -103.1719971, "Wollaston Lake Airport"},
+	{"ZXT", "AZ", 40.49554222, 49.97680664, "Zabrat Airport"},
+	{"ZYI", "CN", 27.5895, 107.0007, "Zunyi Xinzhou Airport"},
+	{"ZYL", "BD", 24.96319962, 91.8667984, "Osmany International Airport"},
+	{"ZZO", "RU", 50.6692009, 142.7610016, "Zonalnoye Airport"},
+	{"ZZU", "MW", -11.44470024, 34.01179886, "Mzuzu Airport"},
+	{"ZZV", "US", 39.94440079, -81.89209747, "Zanesville Municipal Airport"},
+}
@@ -0,0 +1,192 @@
+package transitdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+)
+
+// A Notifier posts queued watch deliveries to their webhooks, retrying
+// failures with exponential backoff. Deliveries that fail MaxAttempts
+// times are marked dead and logged.
+//
+// Several notifiers may share a store; each claims deliveries for Lease
+// so they don't post the same one twice.
+type Notifier struct {
+	Store Store
+
+	// Client is used to post notifications. If nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+
+	// MaxAttempts is the number of tries before a delivery is given
+	// up on. If zero, 8 is used.
+	MaxAttempts int
+	// Backoff is the delay after the first failed attempt. It doubles
+	// after each later failure. If zero, one minute is used.
+	Backoff time.Duration
+	// MaxBackoff caps the delay between attempts. If zero, one day is
+	// used.
+	MaxBackoff time.Duration
+	// PollInterval is how often Run checks for due deliveries. If
+	// zero, ten seconds is used.
+	PollInterval time.Duration
+	// Lease is how long a claimed delivery is hidden from other
+	// notifiers. If zero, five minutes is used.
+	Lease time.Duration
+	// BatchSize is the most deliveries claimed at once. If zero, 100
+	// is used.
+	BatchSize int
+
+	// Now reports the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+func (n *Notifier) now() time.Time {
+	if n.Now != nil {
+		return n.Now()
+	}
+	return time.Now()
+}
+
+// Run delivers due notifications until ctx is done.
+func (n *Notifier) Run(ctx context.Context) error {
+	interval := n.PollInterval
+	if interval == 0 {
+		interval = 10 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := n.DeliverDue(ctx); err != nil {
+			fmt.Fprintln(os.Stderr, "[error]", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// retryDelay returns the wait after a delivery's attempts-th failure:
+// backoff doubled for each failure after the first, up to maxBackoff.
+func retryDelay(backoff, maxBackoff time.Duration, attempts int) time.Duration {
+	delay := backoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+// DeliverDue makes one attempt at each delivery that's due and returns
+// the number that succeeded.
+func (n *Notifier) DeliverDue(ctx context.Context) (int, error) {
+	var (
+		batchSize   = n.BatchSize
+		lease       = n.Lease
+		maxAttempts = n.MaxAttempts
+		backoff     = n.Backoff
+		maxBackoff  = n.MaxBackoff
+	)
+	if batchSize == 0 {
+		batchSize = 100
+	}
+	if lease == 0 {
+		lease = 5 * time.Minute
+	}
+	if maxAttempts == 0 {
+		maxAttempts = 8
+	}
+	if backoff == 0 {
+		backoff = time.Minute
+	}
+	if maxBackoff == 0 {
+		maxBackoff = 24 * time.Hour
+	}
+
+	var delivered int
+	for {
+		deliveries, err := n.Store.ClaimDeliveries(ctx, batchSize, lease)
+		if err != nil {
+			return delivered, err
+		}
+
+		for _, d := range deliveries {
+			d.Attempts++
+
+			err := n.post(ctx, d)
+			switch {
+			case err == nil:
+				d.Status = DeliveryDelivered
+				d.DeliveredAt = n.now()
+				d.LastError = ""
+				delivered++
+			case d.Attempts >= maxAttempts:
+				d.Status = DeliveryDead
+				d.LastError = err.Error()
+				fmt.Fprintf(os.Stderr, "[error] watch %d: giving up on delivery %d after %d attempts: %v\n",
+					d.WatchID, d.ID, d.Attempts, err)
+			default:
+				d.LastError = err.Error()
+				d.NextAttemptAt = n.now().Add(retryDelay(backoff, maxBackoff, d.Attempts))
+			}
+
+			if err := n.Store.UpdateDelivery(ctx, d); err != nil {
+				return delivered, err
+			}
+		}
+
+		if len(deliveries) < batchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// post sends d to its webhook. Any status other than 2xx is a failure.
+func (n *Notifier) post(ctx context.Context, d Delivery) error {
+	body, err := json.Marshal(WatchNotification{
+		WatchID:    d.WatchID,
+		DeliveryID: d.ID,
+		Offer:      d.Offer,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", d.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := n.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package transitdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/memstore"
+)
+
+func TestNotifierDeliverDue(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	tests := []struct {
+		name        string
+		status      int
+		maxAttempts int
+		attempts    int // deliveries already made
+
+		wantStatus    transitdb.DeliveryStatus
+		wantDelivered int
+		wantNext      time.Time // for pending deliveries
+	}{
+		{
+			name:          "accepted",
+			status:        http.StatusNoContent,
+			wantStatus:    transitdb.DeliveryDelivered,
+			wantDelivered: 1,
+		},
+		{
+			name:       "first failure backs off",
+			status:     http.StatusInternalServerError,
+			wantStatus: transitdb.DeliveryPending,
+			wantNext:   now.Add(time.Minute),
+		},
+		{
+			name:       "later failures back off exponentially",
+			status:     http.StatusBadGateway,
+			attempts:   2,
+			wantStatus: transitdb.DeliveryPending,
+			wantNext:   now.Add(4 * time.Minute),
+		},
+		{
+			name:        "backoff is capped",
+			status:      http.StatusInternalServerError,
+			maxAttempts: 100,
+			attempts:    70,
+			wantStatus:  transitdb.DeliveryPending,
+			wantNext:    now.Add(24 * time.Hour),
+		},
+		{
+			name:        "gives up after MaxAttempts",
+			status:      http.StatusInternalServerError,
+			maxAttempts: 3,
+			attempts:    2,
+			wantStatus:  transitdb.DeliveryDead,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var got []transitdb.WatchNotification
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var n transitdb.WatchNotification
+				if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+					t.Errorf("decoding notification: %v", err)
+				}
+				got = append(got, n)
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			store := memstore.New()
+			store.Now = clock
+			watch, err := store.CreateWatch(ctx, transitdb.Watch{
+				Destinations: []string{"NRT"},
+				StartDate:    transitdb.Date(now),
+				EndDate:      transitdb.Date(now.AddDate(0, 1, 0)),
+				MaxCost:      1000,
+				WebhookURL:   receiver.URL,
+			})
+			if err != nil {
+				t.Fatalf("CreateWatch: %v", err)
+			}
+			_, err = store.SaveOffer(ctx, transitdb.Offer{
+				OriginAirport:      "LGB",
+				DestinationAirport: "NRT",
+				Cost:               500,
+				Source:             "test",
+				AvailableFrom:      transitdb.Date(now.AddDate(0, 0, 7)),
+				OfferedAt:          now,
+				ExpiresAt:          now.Add(24 * time.Hour),
+			})
+			if err != nil {
+				t.Fatalf("SaveOffer: %v", err)
+			}
+
+			// Record earlier failed attempts.
+			if tt.attempts > 0 {
+				ds, err := store.ListDeliveries(ctx, transitdb.ListDeliveriesRequest{Limit: 10})
+				if err != nil || len(ds) != 1 {
+					t.Fatalf("ListDeliveries = %v, %v", ds, err)
+				}
+				ds[0].Attempts = tt.attempts
+				ds[0].NextAttemptAt = now
+				if err := store.UpdateDelivery(ctx, ds[0]); err != nil {
+					t.Fatalf("UpdateDelivery: %v", err)
+				}
+			}
+
+			n := &transitdb.Notifier{
+				Store:       store,
+				Client:      receiver.Client(),
+				MaxAttempts: tt.maxAttempts,
+				Now:         clock,
+			}
+			delivered, err := n.DeliverDue(ctx)
+			if err != nil {
+				t.Fatalf("DeliverDue: %v", err)
+			}
+			if delivered != tt.wantDelivered {
+				t.Errorf("DeliverDue = %d, want %d", delivered, tt.wantDelivered)
+			}
+
+			if len(got) != 1 || got[0].WatchID != watch.ID || got[0].Offer.Cost != 500 {
+				t.Errorf("receiver got %+v, want one notification for watch %d", got, watch.ID)
+			}
+
+			ds, err := store.ListDeliveries(ctx, transitdb.ListDeliveriesRequest{Limit: 10})
+			if err != nil || len(ds) != 1 {
+				t.Fatalf("ListDeliveries = %v, %v", ds, err)
+			}
+			d := ds[0]
+			if d.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", d.Status, tt.wantStatus)
+			}
+			if d.Attempts != tt.attempts+1 {
+				t.Errorf("attempts = %d, want %d", d.Attempts, tt.attempts+1)
+			}
+			if tt.wantStatus == transitdb.DeliveryPending && !d.NextAttemptAt.Equal(tt.wantNext) {
+				t.Errorf("next attempt at %v, want %v", d.NextAttemptAt, tt.wantNext)
+			}
+		})
+	}
+}
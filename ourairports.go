@@ -0,0 +1,196 @@
+package transitdb
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReadOurAirports reads places from an airports.csv file in the format
+// published by OurAirports (https://ourairports.com/data/). Airports
+// without an IATA code and closed airports are skipped.
+//
+// Rows that can't be read as a valid place are returned as *LineError
+// values in rowErrs rather than stopping the import, so that one bad row
+// in a large file doesn't hold up the rest. err is set if the file
+// itself can't be read.
+func ReadOurAirports(r io.Reader) (places []Place, rowErrs []error, err error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range []string{"type", "name", "latitude_deg", "longitude_deg", "iso_country", "iata_code"} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing %s column", name)
+		}
+	}
+
+	seen := make(map[string]int) // line each IATA code was first seen on
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			parseErr, ok := err.(*csv.ParseError)
+			if !ok {
+				return nil, nil, err
+			}
+			rowErrs = append(rowErrs, &LineError{Line: parseErr.StartLine, Err: &ValidationError{Msg: parseErr.Err.Error()}})
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+
+		code := strings.TrimSpace(record[columns["iata_code"]])
+		if code == "" || record[columns["type"]] == "closed" {
+			continue
+		}
+
+		p, err := ourAirportsPlace(record, columns)
+		if err == nil {
+			if first, ok := seen[p.IATACode]; ok {
+				err = fmt.Errorf("IATA code %q already used on line %d", p.IATACode, first)
+			}
+		}
+		if err != nil {
+			rowErrs = append(rowErrs, &LineError{Line: line, Err: invalid(err)})
+			continue
+		}
+		seen[p.IATACode] = line
+		places = append(places, p)
+	}
+
+	return places, rowErrs, nil
+}
+
+func ourAirportsPlace(record []string, columns map[string]int) (Place, error) {
+	p := Place{
+		IATACode: strings.TrimSpace(record[columns["iata_code"]]),
+		Name:     truncate(strings.TrimSpace(record[columns["name"]]), maxPlaceName),
+		Country:  record[columns["iso_country"]],
+		Active:   true,
+	}
+
+	var err error
+	p.Latitude, err = strconv.ParseFloat(record[columns["latitude_deg"]], 64)
+	if err != nil {
+		return p, fmt.Errorf("invalid latitude %q", record[columns["latitude_deg"]])
+	}
+	p.Longitude, err = strconv.ParseFloat(record[columns["longitude_deg"]], 64)
+	if err != nil {
+		return p, fmt.Errorf("invalid longitude %q", record[columns["longitude_deg"]])
+	}
+
+	// A latitude out of range that would make sense as a longitude, and
+	// vice versa, is almost certainly a transposed pair. Report it as
+	// such instead of guessing.
+	if math.Abs(p.Latitude) > 90 && math.Abs(p.Longitude) <= 90 {
+		return p, fmt.Errorf("latitude %v and longitude %v look swapped", p.Latitude, p.Longitude)
+	}
+
+	return p, p.Validate()
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8
+// sequence.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// A PlaceChangeKind says what an import will do to a place.
+type PlaceChangeKind string
+
+const (
+	PlaceInserted PlaceChangeKind = "insert"
+	PlaceUpdated  PlaceChangeKind = "update"
+	// PlaceSwapped is an update that swaps the place's latitude and
+	// longitude, fixing a place loaded in the wrong order. Other fields
+	// may change too.
+	PlaceSwapped PlaceChangeKind = "swap"
+)
+
+// A PlaceChange is one insert or update found by PlanPlaceImport.
+type PlaceChange struct {
+	Kind   PlaceChangeKind
+	Old    Place    // zero for inserts
+	New    Place    // the place to save, with Old's ID and status
+	Fields []string // JSON names of the fields that differ, for updates
+}
+
+// coordTolerance is how far apart, in degrees, two coordinates can be
+// and still be considered the same. It's about 10 cm, which hides
+// rounding in the source data.
+const coordTolerance = 1e-6
+
+// PlanPlaceImport compares incoming places with existing ones, matching
+// them by IATA code, and returns the changes needed to bring existing up
+// to date. Places that are already up to date, and existing places
+// missing from incoming, are left alone. Updates keep the existing
+// place's ID and active status, so deactivated places stay deactivated.
+func PlanPlaceImport(existing, incoming []Place) []PlaceChange {
+	byCode := make(map[string]Place)
+	for _, p := range existing {
+		if p.IATACode != "" {
+			byCode[p.IATACode] = p
+		}
+	}
+
+	var changes []PlaceChange
+	for _, p := range incoming {
+		old, ok := byCode[p.IATACode]
+		if !ok {
+			p.ID = 0
+			p.Active = true
+			changes = append(changes, PlaceChange{Kind: PlaceInserted, New: p})
+			continue
+		}
+		p.ID = old.ID
+		p.Active = old.Active
+
+		var fields []string
+		if p.Name != old.Name {
+			fields = append(fields, "name")
+		}
+		if p.Country != old.Country {
+			fields = append(fields, "country")
+		}
+		if !sameCoord(p.Latitude, old.Latitude) {
+			fields = append(fields, "latitude")
+		}
+		if !sameCoord(p.Longitude, old.Longitude) {
+			fields = append(fields, "longitude")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		kind := PlaceUpdated
+		if sameCoord(p.Latitude, old.Longitude) && sameCoord(p.Longitude, old.Latitude) {
+			kind = PlaceSwapped
+		}
+		changes = append(changes, PlaceChange{Kind: kind, Old: old, New: p, Fields: fields})
+	}
+
+	return changes
+}
+
+func sameCoord(a, b float64) bool {
+	return math.Abs(a-b) <= coordTolerance
+}
@@ -0,0 +1,145 @@
+package transitdb
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const ourAirportsHeader = `"id","ident","type","name","latitude_deg","longitude_deg","elevation_ft","continent","iso_country","iata_code"`
+
+func TestReadOurAirports(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      []string
+		want      []Place
+		wantLines []int // lines of the rows reported as errors
+	}{
+		{
+			name: "valid",
+			rows: []string{
+				`1,"KLGB","medium_airport","Long Beach Airport",33.8177,-118.152,60,"NA","US","LGB"`,
+			},
+			want: []Place{
+				{IATACode: "LGB", Name: "Long Beach Airport", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: true},
+			},
+		},
+		{
+			name: "skips closed and codeless airports",
+			rows: []string{
+				`1,"KLGB","medium_airport","Long Beach Airport",33.8177,-118.152,60,"NA","US","LGB"`,
+				`2,"XXXX","closed","Old Field",10,10,0,"NA","US","OLD"`,
+				`3,"YYYY","heliport","Rooftop",10,10,0,"NA","US",""`,
+			},
+			want: []Place{
+				{IATACode: "LGB", Name: "Long Beach Airport", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: true},
+			},
+		},
+		{
+			name: "reports bad rows and keeps going",
+			rows: []string{
+				`1,"AAAA","small_airport","Bad Latitude",north,10,0,"NA","US","AAA"`,
+				`2,"BBBB","small_airport","Swapped",-118.152,33.8177,0,"NA","US","BBB"`,
+				`3,"CCCC","small_airport","Bad Country",10,10,0,"NA","usa","CCC"`,
+				`4,"KLGB","medium_airport","Long Beach Airport",33.8177,-118.152,60,"NA","US","LGB"`,
+				`5,"KLGX","small_airport","Duplicate",33,-118,0,"NA","US","LGB"`,
+			},
+			want: []Place{
+				{IATACode: "LGB", Name: "Long Beach Airport", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: true},
+			},
+			wantLines: []int{2, 3, 4, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csv := ourAirportsHeader + "\n" + strings.Join(tt.rows, "\n") + "\n"
+			places, rowErrs, err := ReadOurAirports(strings.NewReader(csv))
+			if err != nil {
+				t.Fatalf("ReadOurAirports: %v", err)
+			}
+			if !reflect.DeepEqual(places, tt.want) {
+				t.Errorf("places = %+v, want %+v", places, tt.want)
+			}
+
+			var lines []int
+			for _, err := range rowErrs {
+				var lineErr *LineError
+				if !errors.As(err, &lineErr) {
+					t.Fatalf("row error %v is not a *LineError", err)
+				}
+				lines = append(lines, lineErr.Line)
+			}
+			if !reflect.DeepEqual(lines, tt.wantLines) {
+				t.Errorf("error lines = %v, want %v", lines, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestReadOurAirportsMissingColumn(t *testing.T) {
+	_, _, err := ReadOurAirports(strings.NewReader("id,name\n1,x\n"))
+	if err == nil {
+		t.Error("ReadOurAirports with missing columns succeeded")
+	}
+}
+
+func TestPlanPlaceImport(t *testing.T) {
+	lgb := Place{ID: 1, IATACode: "LGB", Name: "Long Beach", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: true}
+	swapped := lgb
+	swapped.Latitude, swapped.Longitude = lgb.Longitude, lgb.Latitude
+	inactive := lgb
+	inactive.Active = false
+
+	renamed := lgb
+	renamed.ID, renamed.Active = 0, false
+	renamed.Name = "Long Beach Airport"
+
+	tests := []struct {
+		name     string
+		existing []Place
+		incoming []Place
+		want     []PlaceChange
+	}{
+		{
+			name:     "insert",
+			incoming: []Place{{IATACode: "LGB", Name: "Long Beach", Country: "US", Latitude: 33.8177, Longitude: -118.152}},
+			want:     []PlaceChange{{Kind: PlaceInserted, New: Place{IATACode: "LGB", Name: "Long Beach", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: true}}},
+		},
+		{
+			name:     "unchanged within tolerance",
+			existing: []Place{lgb},
+			incoming: []Place{{IATACode: "LGB", Name: "Long Beach", Country: "US", Latitude: 33.8177001, Longitude: -118.152}},
+		},
+		{
+			name:     "update keeps ID and status",
+			existing: []Place{inactive},
+			incoming: []Place{renamed},
+			want: []PlaceChange{{
+				Kind:   PlaceUpdated,
+				Old:    inactive,
+				New:    Place{ID: 1, IATACode: "LGB", Name: "Long Beach Airport", Country: "US", Latitude: 33.8177, Longitude: -118.152, Active: false},
+				Fields: []string{"name"},
+			}},
+		},
+		{
+			name:     "swap",
+			existing: []Place{swapped},
+			incoming: []Place{{IATACode: "LGB", Name: "Long Beach", Country: "US", Latitude: 33.8177, Longitude: -118.152}},
+			want: []PlaceChange{{
+				Kind:   PlaceSwapped,
+				Old:    swapped,
+				New:    lgb,
+				Fields: []string{"latitude", "longitude"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanPlaceImport(tt.existing, tt.incoming)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanPlaceImport = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
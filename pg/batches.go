@@ -0,0 +1,169 @@
+package pg
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) CreateBatch(ctx context.Context, b transitdb.Batch) (transitdb.Batch, error) {
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO ingestion_batches (label)
+		VALUES ($1)
+		RETURNING batch_id, created_at`,
+		b.Label).Scan(&b.ID, &b.CreatedAt)
+	if err != nil {
+		return b, err
+	}
+
+	return b, nil
+}
+
+func (s *Store) UpdateBatch(ctx context.Context, b transitdb.Batch) error {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE ingestion_batches
+		   SET sources = COALESCE($2, '{}'),
+		       lines = $3,
+		       inserted = $4,
+		       updated = $5,
+		       unchanged = $6
+		 WHERE batch_id = $1`,
+		b.ID,
+		pq.Array(b.Sources),
+		b.Lines,
+		b.Inserted,
+		b.Updated,
+		b.Unchanged)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) ListBatches(ctx context.Context, q transitdb.ListBatchesRequest) ([]transitdb.Batch, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, listBatchesSQL, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.Batch
+	for rows.Next() {
+		var (
+			res       transitdb.Batch
+			deletedAt pq.NullTime
+		)
+
+		err = rows.Scan(
+			&res.ID,
+			&res.Label,
+			pq.Array(&res.Sources),
+			&res.Lines,
+			&res.Inserted,
+			&res.Updated,
+			&res.Unchanged,
+			&res.Offers,
+			&res.CreatedAt,
+			&deletedAt)
+		if err != nil {
+			return nil, err
+		}
+		res.DeletedAt = deletedAt.Time
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const listBatchesSQL = `
+WITH
+
+page AS (
+      SELECT *
+        FROM ingestion_batches
+    ORDER BY created_at DESC, batch_id DESC
+       LIMIT $1
+      OFFSET $2
+)
+
+SELECT page.batch_id,
+       page.label,
+       page.sources,
+       page.lines,
+       page.inserted,
+       page.updated,
+       page.unchanged,
+       COUNT(offers.offer_id),
+       page.created_at,
+       page.deleted_at
+FROM page
+     LEFT JOIN offers USING (batch_id)
+GROUP BY page.batch_id,
+         page.label,
+         page.sources,
+         page.lines,
+         page.inserted,
+         page.updated,
+         page.unchanged,
+         page.created_at,
+         page.deleted_at
+ORDER BY page.created_at DESC, page.batch_id DESC
+`
+
+// DeleteBatch deletes the batch's offers, along with their price
+// history. It returns transitdb.ErrNotFound if there's no such batch.
+func (s *Store) DeleteBatch(ctx context.Context, id int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE ingestion_batches
+		   SET deleted_at = COALESCE(deleted_at, NOW())
+		 WHERE batch_id = $1`,
+		id)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, transitdb.ErrNotFound
+	}
+
+	res, err = tx.ExecContext(ctx, `DELETE FROM offers WHERE batch_id = $1`, id)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
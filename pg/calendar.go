@@ -0,0 +1,151 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) PriceCalendar(ctx context.Context, q transitdb.PriceCalendarRequest) ([]transitdb.CalendarDay, error) {
+	currency := q.Currency
+	if currency == "" {
+		currency = transitdb.BaseCurrency
+	}
+	perUSD, err := s.exchangeRate(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, priceCalendarSQL,
+		q.StartDate, q.EndDate,
+		strings.Join(q.Origins, ","),
+		strings.Join(q.Destinations, ","),
+		perUSD)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.CalendarDay
+	for rows.Next() {
+		var (
+			res                           transitdb.CalendarDay
+			cost, offerID                 sql.NullInt64
+			origin, originIATA, originCC  sql.NullString
+			dest, destIATA, destCC        sql.NullString
+			source                        sql.NullString
+			endDate, offeredAt, expiresAt pq.NullTime
+		)
+
+		err = rows.Scan(
+			&res.Date,
+			&cost,
+			&origin,
+			&originIATA,
+			&originCC,
+			&dest,
+			&destIATA,
+			&destCC,
+			&offerID,
+			&source,
+			&endDate,
+			&offeredAt,
+			&expiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if cost.Valid {
+			res.Quote = &transitdb.Quote{
+				Cost:          int(cost.Int64),
+				Currency:      currency,
+				Origin:        origin.String,
+				OriginIATA:    originIATA.String,
+				OriginCountry: originCC.String,
+				Dest:          dest.String,
+				DestIATA:      destIATA.String,
+				DestCountry:   destCC.String,
+				Date:          res.Date,
+				OfferID:       int(offerID.Int64),
+				Source:        source.String,
+				EndDate:       transitdb.Date(endDate.Time),
+				OfferedAt:     offeredAt.Time,
+				ExpiresAt:     expiresAt.Time,
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const priceCalendarSQL = `
+WITH
+
+origin_ids AS (
+    SELECT place_id
+      FROM places
+     WHERE iata_code = ANY(expand_place_codes(string_to_array($3, ',')))
+),
+
+dest_ids AS (
+    SELECT place_id
+      FROM places
+     WHERE iata_code = ANY(expand_place_codes(string_to_array($4, ',')))
+),
+
+days AS (
+    SELECT generate_series($1::date, $2::date, '1 day')::date AS day
+)
+
+-- For each day, probe offer_cost_join_idx for the cheapest live offer
+-- on any of the requested routes, converted to the requested currency.
+-- Days without one come back NULL.
+--
+SELECT
+	days.day,
+	ROUND(cheapest.cost_usd * $5::numeric),
+	origin.name,
+	origin.iata_code,
+	origin.country,
+	dest.name,
+	dest.iata_code,
+	dest.country,
+	cheapest.offer_id,
+	cheapest.source,
+	cheapest.end_time,
+	cheapest.created_at,
+	cheapest.expires_at
+FROM days
+     LEFT JOIN LATERAL (
+          SELECT offer_id,
+                 origin_id,
+                 dest_id,
+                 cost_usd,
+                 source,
+                 end_time,
+                 created_at,
+                 expires_at
+            FROM offers
+                 JOIN offer_costs USING (offer_id)
+           WHERE origin_id IN (SELECT place_id FROM origin_ids)
+             AND dest_id IN (SELECT place_id FROM dest_ids)
+             AND start_time = days.day
+             AND expires_at > NOW()
+        ORDER BY cost_usd ASC, offer_id ASC
+           LIMIT 1
+     ) AS cheapest ON TRUE
+     LEFT JOIN places AS origin
+          ON origin.place_id = cheapest.origin_id
+     LEFT JOIN places AS dest
+          ON dest.place_id = cheapest.dest_id
+ORDER BY days.day ASC;
+`
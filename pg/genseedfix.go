@@ -0,0 +1,89 @@
+//go:build ignore
+// +build ignore
+
+// genseedfix reads the places.sql seed file and writes a Go source file
+// listing the coordinates of each place, for the migration that fixes
+// places loaded before places.sql named its columns correctly. Its
+// output is part of that migration, so it isn't regenerated when
+// places.sql changes:
+//
+//	go run genseedfix.go -o seedfix.go ../places.sql
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	columnsRe = regexp.MustCompile(`^\s*\(iata_code, country, longitude, latitude, name\)\s*$`)
+	rowRe     = regexp.MustCompile(`^\s*\('([^']+)', '[A-Z]{2}', (-?[0-9.]+), (-?[0-9.]+), `)
+)
+
+func main() {
+	out := flag.String("o", "seedfix.go", "output file")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("usage: genseedfix [-o seedfix.go] places.sql")
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var (
+		sawColumns bool
+		rows       []string
+	)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if !sawColumns {
+			sawColumns = columnsRe.MatchString(text)
+			continue
+		}
+		m := rowRe.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		rows = append(rows, fmt.Sprintf("('%s', %s, %s)", m[1], m[3], m[2]))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if !sawColumns {
+		log.Fatal("no (iata_code, country, longitude, latitude, name) column list found")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintln(buf, "// Code generated by genseedfix.go from places.sql. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package pg")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// seedCoordinatesSQL is a VALUES list of the (iata_code, latitude,")
+	fmt.Fprintln(buf, "// longitude) of each place in places.sql.")
+	fmt.Fprintln(buf, "const seedCoordinatesSQL = `")
+	fmt.Fprintln(buf, "VALUES")
+	fmt.Fprintf(buf, "    %s\n", strings.Join(rows, ",\n    "))
+	fmt.Fprintln(buf, "`")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,123 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) CreateAPIKey(ctx context.Context, k transitdb.APIKey) (transitdb.APIKey, error) {
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO api_keys
+		(name, key_hash, role, sources)
+		VALUES ($1, $2, $3, COALESCE($4, '{}'))
+		RETURNING key_id, created_at`,
+		k.Name,
+		k.Hash,
+		string(k.Role),
+		pq.Array(k.Sources)).Scan(&k.ID, &k.CreatedAt)
+	if err != nil {
+		return k, err
+	}
+
+	return k, nil
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context) ([]transitdb.APIKey, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		ORDER BY key_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.APIKey
+	for rows.Next() {
+		res, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (s *Store) LookupAPIKey(ctx context.Context, hash string) (transitdb.APIKey, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE key_hash = $1
+		  AND revoked_at IS NULL`,
+		hash)
+	k, err := scanAPIKey(row)
+	if err == sql.ErrNoRows {
+		return k, transitdb.ErrNotFound
+	}
+	return k, err
+}
+
+func (s *Store) RevokeAPIKey(ctx context.Context, id int) error {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE api_keys
+		   SET revoked_at = COALESCE(revoked_at, NOW())
+		 WHERE key_id = $1`,
+		id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+const apiKeyColumns = `
+	key_id,
+	name,
+	key_hash,
+	role,
+	sources,
+	created_at,
+	revoked_at`
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(row scanner) (transitdb.APIKey, error) {
+	var (
+		res       transitdb.APIKey
+		role      string
+		revokedAt pq.NullTime
+	)
+
+	err := row.Scan(
+		&res.ID,
+		&res.Name,
+		&res.Hash,
+		&role,
+		pq.Array(&res.Sources),
+		&res.CreatedAt,
+		&revokedAt)
+	if err != nil {
+		return res, err
+	}
+	res.Role = transitdb.Role(role)
+	res.RevokedAt = revokedAt.Time
+
+	return res, nil
+}
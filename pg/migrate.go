@@ -0,0 +1,629 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// A migration is one numbered step in the evolution of the schema.
+// Migrations are applied in version order and never edited once
+// released; changes to the schema are made by appending a new one.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create places and offers",
+
+		// Written with IF NOT EXISTS so that databases created before
+		// migrations existed pick up this step without changes.
+		up: `
+CREATE TABLE IF NOT EXISTS
+places (
+    place_id   SERIAL        PRIMARY KEY,
+    latitude   DECIMAL       NOT NULL,
+    longitude  DECIMAL       NOT NULL,
+    name       VARCHAR(100)  NOT NULL,
+    country    VARCHAR(2)    NOT NULL,
+    iata_code  VARCHAR(3)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS
+place_airport_idx ON places (iata_code);
+
+CREATE TABLE IF NOT EXISTS
+offers (
+    offer_id    SERIAL       PRIMARY KEY,
+    origin_id   INT          NOT NULL
+                             REFERENCES places(place_id),
+    dest_id     INT          NOT NULL
+                             REFERENCES places(place_id),
+    cost        DECIMAL      NOT NULL,
+    source      VARCHAR(20)  NOT NULL,
+    start_time  DATE         NOT NULL,
+    end_time    DATE,
+    created_at  TIMESTAMP    NOT NULL,
+    expires_at  TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS
+offer_cost_join_idx
+ON offers (origin_id, dest_id, start_time, expires_at, cost);
+
+CREATE INDEX IF NOT EXISTS
+offer_date_idx
+ON OFFERS (start_time);
+`,
+		down: `
+DROP TABLE offers;
+DROP TABLE places;
+`,
+	},
+	{
+		version: 2,
+		name:    "deduplicate offers",
+		up: `
+CREATE TABLE
+offer_price_history (
+    history_id   SERIAL     PRIMARY KEY,
+    offer_id     INT        NOT NULL
+                            REFERENCES offers(offer_id)
+                            ON DELETE CASCADE,
+    cost         DECIMAL    NOT NULL,
+    observed_at  TIMESTAMP  NOT NULL,
+    replaced_at  TIMESTAMP  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX
+offer_price_history_offer_idx
+ON offer_price_history (offer_id);
+
+-- Offers saved so far may share a key. Keep the oldest row for each
+-- key, record every price but the most recent one as its history,
+-- bring it up to date with the most recently offered row, and delete
+-- the others.
+CREATE TEMPORARY TABLE
+offer_duplicates ON COMMIT DROP AS
+SELECT offer_id,
+       FIRST_VALUE(offer_id) OVER (
+           PARTITION BY source, origin_id, dest_id, start_time, end_time
+           ORDER BY offer_id) AS keep_id,
+       ROW_NUMBER() OVER (
+           PARTITION BY source, origin_id, dest_id, start_time, end_time
+           ORDER BY created_at DESC, offer_id DESC) AS recency,
+       COUNT(*) OVER (
+           PARTITION BY source, origin_id, dest_id, start_time, end_time) AS copies
+FROM offers;
+
+INSERT INTO offer_price_history
+(offer_id, cost, observed_at)
+SELECT dup.keep_id,
+       offers.cost,
+       offers.created_at
+  FROM offer_duplicates AS dup
+       JOIN offers USING (offer_id)
+ WHERE dup.copies > 1
+   AND dup.recency > 1
+ ORDER BY dup.keep_id, offers.created_at, offers.offer_id;
+
+UPDATE offers
+   SET cost = latest.cost,
+       created_at = latest.created_at,
+       expires_at = latest.expires_at
+  FROM offer_duplicates AS dup
+       JOIN offers AS latest
+            ON latest.offer_id = dup.offer_id
+ WHERE offers.offer_id = dup.keep_id
+   AND dup.recency = 1
+   AND dup.offer_id <> dup.keep_id;
+
+DELETE FROM offers
+ USING offer_duplicates AS dup
+ WHERE offers.offer_id = dup.offer_id
+   AND dup.offer_id <> dup.keep_id;
+
+DROP TABLE offer_duplicates;
+
+-- The default offer key. Offers without an end_time share one.
+CREATE UNIQUE INDEX
+offer_natural_key_idx
+ON offers (source, origin_id, dest_id, start_time, (COALESCE(end_time, 'infinity'::date)));
+`,
+		down: `
+DROP TABLE offer_price_history;
+DROP INDEX offer_natural_key_idx;
+`,
+	},
+	{
+		version: 3,
+		name:    "add currencies",
+		up: `
+ALTER TABLE offers
+ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE offer_price_history
+ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+CREATE TABLE
+exchange_rates (
+    currency    VARCHAR(3)  PRIMARY KEY,
+    per_usd     DECIMAL     NOT NULL
+                            CHECK (per_usd > 0),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO exchange_rates (currency, per_usd) VALUES ('USD', 1);
+
+-- Offer costs in US dollars, for ranking offers in different
+-- currencies against each other. Offers in currencies without an
+-- exchange rate are left out.
+CREATE VIEW
+offer_costs AS
+SELECT offers.offer_id,
+       offers.cost / rates.per_usd AS cost_usd
+FROM offers
+     JOIN exchange_rates AS rates USING (currency);
+`,
+		down: `
+DROP VIEW offer_costs;
+DROP TABLE exchange_rates;
+ALTER TABLE offer_price_history DROP COLUMN currency;
+ALTER TABLE offers DROP COLUMN currency;
+`,
+	},
+	{
+		version: 4,
+		name:    "add watches",
+		up: `
+CREATE TABLE
+watches (
+    watch_id          SERIAL        PRIMARY KEY,
+    origins           VARCHAR(3)[]  NOT NULL DEFAULT '{}',
+    destinations      VARCHAR(3)[]  NOT NULL DEFAULT '{}',
+    origin_countries  VARCHAR(2)[]  NOT NULL DEFAULT '{}',
+    dest_countries    VARCHAR(2)[]  NOT NULL DEFAULT '{}',
+    start_date        DATE          NOT NULL,
+    end_date          DATE          NOT NULL,
+    max_cost          DECIMAL       NOT NULL,
+    currency          VARCHAR(3)    NOT NULL,
+    webhook_url       TEXT          NOT NULL,
+    created_at        TIMESTAMP     NOT NULL DEFAULT NOW()
+);
+
+-- Notifications waiting to be posted to a watch's webhook, and the
+-- ones that were. Each row keeps a copy of the offer as it was saved.
+CREATE TABLE
+watch_deliveries (
+    delivery_id      SERIAL       PRIMARY KEY,
+    watch_id         INT          NOT NULL
+                                  REFERENCES watches(watch_id)
+                                  ON DELETE CASCADE,
+    offer_id         INT          NOT NULL,
+    origin_iata      VARCHAR(3)   NOT NULL,
+    dest_iata        VARCHAR(3)   NOT NULL,
+    cost             DECIMAL      NOT NULL,
+    currency         VARCHAR(3)   NOT NULL,
+    source           VARCHAR(20)  NOT NULL,
+    start_time       DATE         NOT NULL,
+    end_time         DATE,
+    offered_at       TIMESTAMP    NOT NULL,
+    expires_at       TIMESTAMP,
+    status           VARCHAR(10)  NOT NULL DEFAULT 'pending',
+    attempts         INT          NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    last_error       TEXT         NOT NULL DEFAULT '',
+    created_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    delivered_at     TIMESTAMP
+);
+
+CREATE INDEX
+watch_deliveries_due_idx
+ON watch_deliveries (next_attempt_at)
+WHERE status = 'pending';
+
+CREATE INDEX
+watch_deliveries_watch_idx
+ON watch_deliveries (watch_id);
+`,
+		down: `
+DROP TABLE watch_deliveries;
+DROP TABLE watches;
+`,
+	},
+	{
+		version: 5,
+		name:    "notify on saved offers",
+		up: `
+-- Tell listeners on the offers_saved channel the ID of each offer that
+-- is inserted or changes price.
+CREATE FUNCTION
+notify_offer_saved() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('offers_saved', NEW.offer_id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER
+offer_inserted_notify
+AFTER INSERT ON offers
+FOR EACH ROW
+EXECUTE PROCEDURE notify_offer_saved();
+
+CREATE TRIGGER
+offer_repriced_notify
+AFTER UPDATE OF cost, currency ON offers
+FOR EACH ROW
+WHEN ((OLD.cost, OLD.currency) IS DISTINCT FROM (NEW.cost, NEW.currency))
+EXECUTE PROCEDURE notify_offer_saved();
+`,
+		down: `
+DROP TRIGGER offer_repriced_notify ON offers;
+DROP TRIGGER offer_inserted_notify ON offers;
+DROP FUNCTION notify_offer_saved();
+`,
+	},
+	{
+		version: 6,
+		name:    "add ingestion batches",
+		up: `
+CREATE TABLE
+ingestion_batches (
+    batch_id    SERIAL         PRIMARY KEY,
+    label       VARCHAR(100)   NOT NULL DEFAULT '',
+    sources     VARCHAR(20)[]  NOT NULL DEFAULT '{}',
+    lines       INT            NOT NULL DEFAULT 0,
+    inserted    INT            NOT NULL DEFAULT 0,
+    updated     INT            NOT NULL DEFAULT 0,
+    unchanged   INT            NOT NULL DEFAULT 0,
+    created_at  TIMESTAMP      NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMP
+);
+
+ALTER TABLE offers
+ADD COLUMN batch_id INT REFERENCES ingestion_batches(batch_id);
+
+CREATE INDEX
+offer_batch_idx
+ON offers (batch_id);
+`,
+		down: `
+ALTER TABLE offers DROP COLUMN batch_id;
+DROP TABLE ingestion_batches;
+`,
+	},
+	{
+		version: 7,
+		name:    "add api keys",
+		up: `
+CREATE TABLE
+api_keys (
+    key_id      SERIAL         PRIMARY KEY,
+    name        VARCHAR(100)   NOT NULL,
+    key_hash    CHAR(64)       NOT NULL UNIQUE,
+    role        VARCHAR(10)    NOT NULL,
+    sources     VARCHAR(20)[]  NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMP      NOT NULL DEFAULT NOW(),
+    revoked_at  TIMESTAMP
+);
+`,
+		down: `
+DROP TABLE api_keys;
+`,
+	},
+	{
+		version: 8,
+		name:    "add place status",
+		up: `
+ALTER TABLE places
+ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE;
+`,
+		down: `
+ALTER TABLE places DROP COLUMN active;
+`,
+	},
+	{
+		version: 9,
+		name:    "add place groups",
+		up: `
+CREATE TABLE
+place_groups (
+    code   VARCHAR(32)   PRIMARY KEY,
+    name   VARCHAR(100)  NOT NULL,
+    metro  BOOLEAN       NOT NULL DEFAULT FALSE
+);
+
+-- Members are IATA codes rather than place IDs, so groups can name
+-- airports that haven't been loaded yet.
+CREATE TABLE
+place_group_members (
+    group_code  VARCHAR(32)  NOT NULL
+                             REFERENCES place_groups(code)
+                             ON DELETE CASCADE,
+    iata_code   VARCHAR(3)   NOT NULL,
+    PRIMARY KEY (group_code, iata_code)
+);
+
+-- The codes in a filter plus the members of any groups among them.
+CREATE FUNCTION
+expand_place_codes(codes TEXT[]) RETURNS TEXT[] AS $$
+    SELECT codes || ARRAY(
+        SELECT iata_code::TEXT
+          FROM place_group_members
+         WHERE group_code = ANY(codes))
+$$ LANGUAGE SQL STABLE;
+
+-- Watches may name user-defined groups, which are longer than
+-- airport codes.
+ALTER TABLE watches
+ALTER COLUMN origins TYPE VARCHAR(32)[],
+ALTER COLUMN destinations TYPE VARCHAR(32)[];
+
+INSERT INTO place_groups (code, name, metro) VALUES
+    ('BER', 'Berlin', TRUE),
+    ('BJS', 'Beijing', TRUE),
+    ('BKK', 'Bangkok', TRUE),
+    ('BUE', 'Buenos Aires', TRUE),
+    ('CHI', 'Chicago', TRUE),
+    ('DXB', 'Dubai', TRUE),
+    ('IST', 'Istanbul', TRUE),
+    ('LON', 'London', TRUE),
+    ('MIL', 'Milan', TRUE),
+    ('MOW', 'Moscow', TRUE),
+    ('NYC', 'New York', TRUE),
+    ('OSA', 'Osaka', TRUE),
+    ('OSL', 'Oslo', TRUE),
+    ('PAR', 'Paris', TRUE),
+    ('RIO', 'Rio de Janeiro', TRUE),
+    ('ROM', 'Rome', TRUE),
+    ('SAO', 'Sao Paulo', TRUE),
+    ('SEL', 'Seoul', TRUE),
+    ('SHA', 'Shanghai', TRUE),
+    ('STO', 'Stockholm', TRUE),
+    ('TYO', 'Tokyo', TRUE),
+    ('WAS', 'Washington', TRUE),
+    ('YTO', 'Toronto', TRUE);
+
+INSERT INTO place_group_members (group_code, iata_code) VALUES
+    ('BER', 'BER'), ('BER', 'SXF'), ('BER', 'TXL'),
+    ('BJS', 'PEK'), ('BJS', 'PKX'),
+    ('BKK', 'BKK'), ('BKK', 'DMK'),
+    ('BUE', 'AEP'), ('BUE', 'EZE'),
+    ('CHI', 'MDW'), ('CHI', 'ORD'),
+    ('DXB', 'DWC'), ('DXB', 'DXB'),
+    ('IST', 'IST'), ('IST', 'SAW'),
+    ('LON', 'LCY'), ('LON', 'LGW'), ('LON', 'LHR'), ('LON', 'LTN'), ('LON', 'SEN'), ('LON', 'STN'),
+    ('MIL', 'BGY'), ('MIL', 'LIN'), ('MIL', 'MXP'),
+    ('MOW', 'DME'), ('MOW', 'SVO'), ('MOW', 'VKO'),
+    ('NYC', 'EWR'), ('NYC', 'JFK'), ('NYC', 'LGA'),
+    ('OSA', 'ITM'), ('OSA', 'KIX'), ('OSA', 'UKB'),
+    ('OSL', 'OSL'), ('OSL', 'TRF'),
+    ('PAR', 'BVA'), ('PAR', 'CDG'), ('PAR', 'ORY'),
+    ('RIO', 'GIG'), ('RIO', 'SDU'),
+    ('ROM', 'CIA'), ('ROM', 'FCO'),
+    ('SAO', 'CGH'), ('SAO', 'GRU'), ('SAO', 'VCP'),
+    ('SEL', 'GMP'), ('SEL', 'ICN'),
+    ('SHA', 'PVG'), ('SHA', 'SHA'),
+    ('STO', 'ARN'), ('STO', 'BMA'), ('STO', 'NYO'),
+    ('TYO', 'HND'), ('TYO', 'NRT'),
+    ('WAS', 'BWI'), ('WAS', 'DCA'), ('WAS', 'IAD'),
+    ('YTO', 'YTZ'), ('YTO', 'YYZ');
+`,
+		down: `
+ALTER TABLE watches
+ALTER COLUMN origins TYPE VARCHAR(3)[],
+ALTER COLUMN destinations TYPE VARCHAR(3)[];
+
+DROP FUNCTION expand_place_codes(TEXT[]);
+DROP TABLE place_group_members;
+DROP TABLE place_groups;
+`,
+	},
+	{
+		version: 10,
+		name:    "add great circle distance",
+		up: `
+-- Distance in kilometers between two points, by the haversine formula.
+CREATE FUNCTION
+great_circle_km(lat1 FLOAT8, lon1 FLOAT8, lat2 FLOAT8, lon2 FLOAT8) RETURNS FLOAT8 AS $$
+    SELECT 2 * 6371 * asin(least(1, sqrt(
+        sin(radians(lat2 - lat1) / 2) ^ 2 +
+        cos(radians(lat1)) * cos(radians(lat2)) * sin(radians(lon2 - lon1) / 2) ^ 2)))
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX
+place_location_idx
+ON places (latitude, longitude);
+`,
+		down: `
+DROP INDEX place_location_idx;
+DROP FUNCTION great_circle_km(FLOAT8, FLOAT8, FLOAT8, FLOAT8);
+`,
+	},
+	{
+		version: 11,
+		name:    "swap transposed seed coordinates",
+
+		// places.sql used to name its columns (latitude, longitude)
+		// while listing longitude first, so places loaded from it
+		// have the two swapped. Only places still holding exactly the
+		// transposed seed values are fixed; ones since corrected by
+		// reloading places.sql or importing airports are left alone.
+		// seedCoordinatesSQL is a snapshot of places.sql taken for
+		// this migration.
+		up: `
+UPDATE places
+   SET latitude = places.longitude,
+       longitude = places.latitude
+  FROM (` + seedCoordinatesSQL + `) AS seed (iata_code, latitude, longitude)
+ WHERE places.iata_code = seed.iata_code
+   AND places.latitude = seed.longitude
+   AND places.longitude = seed.latitude;
+`,
+		down: `
+UPDATE places
+   SET latitude = places.longitude,
+       longitude = places.latitude
+  FROM (` + seedCoordinatesSQL + `) AS seed (iata_code, latitude, longitude)
+ WHERE places.iata_code = seed.iata_code
+   AND places.latitude = seed.latitude
+   AND places.longitude = seed.longitude;
+`,
+	},
+}
+
+// migrationLockID is the key of the advisory lock held while migrating,
+// so that several servers starting at once don't race each other.
+const migrationLockID = 0x7472616e // "tran"
+
+const migrationsTableSQL = `
+CREATE TABLE IF NOT EXISTS
+schema_migrations (
+    version     INT          PRIMARY KEY,
+    applied_at  TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+`
+
+// MigrationStatus describes a known migration and whether it has been
+// applied to the database.
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time // zero if pending
+}
+
+// MigrateUp applies each pending migration in its own transaction,
+// together with the row recording it, so a failing migration leaves the
+// ones before it applied. The migration lock is held throughout.
+func (s *Store) MigrateUp(ctx context.Context) error {
+	return s.withMigrationLock(ctx, func(conn *sql.Conn, applied map[int]time.Time) error {
+		for _, m := range migrations {
+			if _, ok := applied[m.version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.up); err != nil {
+					return fmt.Errorf("migration %d up: %v", m.version, err)
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version) VALUES ($1)`,
+					m.version)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MigrateDown reverts the most recently applied migration.
+func (s *Store) MigrateDown(ctx context.Context) error {
+	return s.withMigrationLock(ctx, func(conn *sql.Conn, applied map[int]time.Time) error {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			if _, ok := applied[m.version]; !ok {
+				continue
+			}
+			return inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.down); err != nil {
+					return fmt.Errorf("migration %d down: %v", m.version, err)
+				}
+				_, err := tx.ExecContext(ctx,
+					`DELETE FROM schema_migrations WHERE version = $1`,
+					m.version)
+				return err
+			})
+		}
+		return fmt.Errorf("no migrations to revert")
+	})
+}
+
+// MigrationStatus lists all known migrations in version order.
+func (s *Store) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	var results []MigrationStatus
+
+	err := s.withMigrationLock(ctx, func(conn *sql.Conn, applied map[int]time.Time) error {
+		for _, m := range migrations {
+			results = append(results, MigrationStatus{
+				Version:   m.version,
+				Name:      m.name,
+				AppliedAt: applied[m.version],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// withMigrationLock runs fn on a connection holding the migration lock,
+// passing it the versions already applied. The lock is a session lock,
+// so it outlives the transactions fn runs on conn.
+func (s *Store) withMigrationLock(ctx context.Context, fn func(conn *sql.Conn, applied map[int]time.Time) error) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return err
+	}
+	// Unlock even if ctx is done, since conn goes back to the pool.
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID)
+
+	if _, err := conn.ExecContext(ctx, migrationsTableSQL); err != nil {
+		return err
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return err
+		}
+		applied[version] = appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	return fn(conn, applied)
+}
+
+// inTx runs fn in a transaction on conn, committing it if fn succeeds.
+func inTx(ctx context.Context, conn *sql.Conn, fn func(*sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,171 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) ListOffers(ctx context.Context, q transitdb.ListOffersRequest) ([]transitdb.Offer, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, listOffersSQL,
+		nullTime(q.StartDate), nullTime(q.EndDate),
+		q.IncludeExpired,
+		q.Limit,
+		q.Offset,
+		q.Source,
+		strings.Join(q.Origins, ","),
+		strings.Join(q.Destinations, ","),
+		nullTime(q.CreatedAfter), nullTime(q.CreatedBefore))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.Offer
+	for rows.Next() {
+		res, err := scanOffer(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const offerColumns = `
+       offer_id,
+       origin.iata_code,
+       dest.iata_code,
+       cost,
+       currency,
+       source,
+       start_time,
+       end_time,
+       created_at,
+       expires_at,
+       batch_id`
+
+const listOffersSQL = `
+SELECT ` + offerColumns + `
+FROM offers
+     JOIN places AS origin
+          ON origin.place_id = offers.origin_id
+     JOIN places AS dest
+          ON dest.place_id = offers.dest_id
+WHERE ($1::date IS NULL OR start_time >= $1)
+  AND ($2::date IS NULL OR start_time <= $2)
+  AND ($3 OR expires_at > NOW())
+  AND ($6 = '' OR source = $6)
+  AND ($7 = '' OR origin.iata_code = ANY(expand_place_codes(string_to_array($7, ','))))
+  AND ($8 = '' OR dest.iata_code = ANY(expand_place_codes(string_to_array($8, ','))))
+  AND ($9::timestamp IS NULL OR created_at >= $9)
+  AND ($10::timestamp IS NULL OR created_at <= $10)
+ORDER BY start_time, offer_id
+LIMIT NULLIF($4, 0)
+OFFSET $5;
+`
+
+func (s *Store) GetOffer(ctx context.Context, id int) (transitdb.Offer, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+offerColumns+`
+		FROM offers
+		     JOIN places AS origin
+		          ON origin.place_id = offers.origin_id
+		     JOIN places AS dest
+		          ON dest.place_id = offers.dest_id
+		WHERE offer_id = $1`,
+		id)
+	o, err := scanOffer(row)
+	if err == sql.ErrNoRows {
+		return o, transitdb.ErrNotFound
+	}
+	return o, err
+}
+
+// DeleteOffer deletes an offer. Its price history goes with it.
+func (s *Store) DeleteOffer(ctx context.Context, id int) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM offers WHERE offer_id = $1`, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) ExpireOffers(ctx context.Context, q transitdb.ExpireOffersRequest) (int, error) {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE offers
+		   SET expires_at = NOW()
+		  FROM places AS origin,
+		       places AS dest
+		 WHERE origin.place_id = offers.origin_id
+		   AND dest.place_id = offers.dest_id
+		   AND offers.source = $1
+		   AND origin.iata_code = $2
+		   AND dest.iata_code = $3
+		   AND offers.expires_at > NOW()`,
+		q.Source,
+		q.Origin,
+		q.Dest)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+func scanOffer(row scanner) (transitdb.Offer, error) {
+	var (
+		res                    transitdb.Offer
+		availableTo, expiresAt pq.NullTime
+		batchID                sql.NullInt64
+	)
+
+	err := row.Scan(
+		&res.ID,
+		&res.OriginAirport,
+		&res.DestinationAirport,
+		&res.Cost,
+		&res.Currency,
+		&res.Source,
+		&res.AvailableFrom,
+		&availableTo,
+		&res.OfferedAt,
+		&expiresAt,
+		&batchID)
+	if err != nil {
+		return res, err
+	}
+	res.AvailableTo = transitdb.Date(availableTo.Time)
+	res.ExpiresAt = expiresAt.Time
+	res.BatchID = int(batchID.Int64)
+
+	return res, nil
+}
+
+// nullTime passes the zero time to the database as NULL.
+func nullTime(t time.Time) pq.NullTime {
+	return pq.NullTime{Time: t, Valid: !t.IsZero()}
+}
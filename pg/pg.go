@@ -6,148 +6,112 @@ import (
 	"fmt"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/lib/pq"
 	"github.com/maxhawkins/transitdb"
 )
 
+// Open connects to the database at url and brings its schema up to date.
 func Open(url string) (*Store, error) {
-	db, err := sql.Open("postgres", url)
+	s, err := Connect(url)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = db.Exec(schema)
+	if err := s.MigrateUp(context.Background()); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Connect connects to the database at url without running migrations.
+func Connect(url string) (*Store, error) {
+	db, err := sql.Open("postgres", url)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Store{
-		db: db,
+		db:  db,
+		url: url,
 	}, nil
 }
 
-const schema = `
-CREATE TABLE IF NOT EXISTS
-places (
-    place_id   SERIAL        PRIMARY KEY,
-    latitude   DECIMAL       NOT NULL,
-    longitude  DECIMAL       NOT NULL,
-    name       VARCHAR(100)  NOT NULL,
-    country    VARCHAR(2)    NOT NULL,
-    iata_code  VARCHAR(3)
-);
-
-CREATE UNIQUE INDEX IF NOT EXISTS
-place_airport_idx ON places (iata_code);
-
-CREATE TABLE IF NOT EXISTS
-offers (
-    offer_id    SERIAL       PRIMARY KEY,
-    origin_id   INT          NOT NULL
-                             REFERENCES places(place_id),
-    dest_id     INT          NOT NULL
-                             REFERENCES places(place_id),
-    cost        DECIMAL      NOT NULL,
-    source      VARCHAR(20)  NOT NULL,
-    start_time  DATE         NOT NULL,
-    end_time    DATE,
-    created_at  TIMESTAMP    NOT NULL,
-    expires_at  TIMESTAMP
-);
-
-CREATE INDEX IF NOT EXISTS
-offer_cost_join_idx
-ON offers (origin_id, dest_id, start_time, expires_at, cost);
-
-CREATE INDEX IF NOT EXISTS
-offer_date_idx
-ON OFFERS (start_time);
-`
-
 type Store struct {
-	db *sql.DB
+	// OfferKey identifies offers that are re-reports of a stored offer.
+	// If nil, transitdb.DefaultOfferKey is used.
+	OfferKey transitdb.OfferKey
+
+	db  *sql.DB
+	url string
+
+	// keyIndexed is set once the unique index for OfferKey exists.
+	keyIndexMu sync.Mutex
+	keyIndexed bool
+
+	// The listener for offer notifications is started by the first
+	// call to SubscribeOffers and shared by all subscribers.
+	streamMu    sync.Mutex
+	listener    *pq.Listener
+	subscribers map[chan transitdb.OfferEvent]bool
 }
 
 func (s *Store) Close() error {
+	s.streamMu.Lock()
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	s.streamMu.Unlock()
+
 	return s.db.Close()
 }
 
-var (
-	airportIDCache   = make(map[string]int)
-	airportIDCacheMu sync.Mutex
-)
-
+// AirportIDByIATA looks the code up each time rather than caching it,
+// since places can be changed by other processes sharing the database.
+// Callers checking many offers remember the answers themselves.
 func (s *Store) AirportIDByIATA(ctx context.Context, iata string) (int, error) {
-	id, ok := airportIDCache[iata]
-	if ok {
-		return id, nil
-	}
-
+	var id int
 	row := s.db.QueryRowContext(ctx,
-		`SELECT place_id FROM places WHERE iata_code = $1`,
+		`SELECT place_id FROM places WHERE iata_code = $1 AND active`,
 		iata)
-	if err := row.Scan(&id); err != nil {
-		return id, err
+	err := row.Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, transitdb.ErrNotFound
+	}
+	if err != nil {
+		return 0, err
 	}
-
-	airportIDCacheMu.Lock()
-	airportIDCache[iata] = id
-	airportIDCacheMu.Unlock()
 
 	return id, nil
 }
 
-func (s *Store) SaveOffer(ctx context.Context, o transitdb.Offer) error {
-	availableFrom := pq.NullTime{
-		Time:  time.Time(o.AvailableFrom),
-		Valid: !time.Time(o.AvailableFrom).IsZero(),
-	}
-	availableTo := pq.NullTime{
-		Time:  time.Time(o.AvailableTo),
-		Valid: !time.Time(o.AvailableTo).IsZero(),
+func (s *Store) CheapestPerRoute(ctx context.Context, q transitdb.CheapestPerRouteRequest) ([]transitdb.Quote, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
 	}
 
-	expiresAt := pq.NullTime{Time: o.ExpiresAt, Valid: !o.ExpiresAt.IsZero()}
-
-	_, err := s.db.ExecContext(ctx, `
-			INSERT INTO offers
-			(origin_id, dest_id, cost, source, start_time, end_time, created_at, expires_at)
-			VALUES
-			(
-				(SELECT place_id FROM places WHERE iata_code = $1),
-				(SELECT place_id FROM places WHERE iata_code = $2),
-				$3, $4, $5, $6, $7, $8
-			)`,
-		o.OriginAirport,
-		o.DestinationAirport,
-		o.Cost,
-		o.Source,
-		availableFrom,
-		availableTo,
-		o.OfferedAt,
-		expiresAt)
-
-	if err, ok := err.(*pq.Error); ok {
-		isNullErr := err.Code.Name() == "not_null_violation"
-		if isNullErr && err.Column == "origin_id" {
-			return fmt.Errorf("unknown origin airport %q", o.OriginAirport)
-		}
-		if isNullErr && err.Column == "dest_id" {
-			return fmt.Errorf("unknown destination airport %q", o.DestinationAirport)
-		}
+	currency := q.Currency
+	if currency == "" {
+		currency = transitdb.BaseCurrency
 	}
-
+	perUSD, err := s.exchangeRate(ctx, currency)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	args := []interface{}{
+		q.StartDate, q.EndDate,
+		strings.Join(q.Origins, ","),
+		strings.Join(q.Destinations, ","),
+	}
+	args = append(args, regionArgs(
+		placeFilter{q.OriginCountries, q.ExcludeOriginCountries, q.OriginContinents, q.ExcludeOriginContinents},
+		placeFilter{q.DestCountries, q.ExcludeDestCountries, q.DestContinents, q.ExcludeDestContinents})...)
+	args = append(args, perUSD, q.Limit, q.Offset)
 
-func (s *Store) CheapestPerRoute(ctx context.Context, start, end time.Time) ([]transitdb.Quote, error) {
-	rows, err := s.db.QueryContext(ctx, cheapestPerRouteSQL, start, end)
+	rows, err := s.db.QueryContext(ctx, cheapestPerRouteSQL, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,18 +119,31 @@ func (s *Store) CheapestPerRoute(ctx context.Context, start, end time.Time) ([]t
 
 	var results []transitdb.Quote
 	for rows.Next() {
-		var res transitdb.Quote
+		var (
+			res                transitdb.Quote
+			endDate, expiresAt pq.NullTime
+		)
 
 		err = rows.Scan(
 			&res.Origin,
+			&res.OriginIATA,
 			&res.OriginCountry,
 			&res.Dest,
+			&res.DestIATA,
 			&res.DestCountry,
 			&res.Cost,
-			&res.Date)
+			&res.Date,
+			&res.OfferID,
+			&res.Source,
+			&endDate,
+			&res.OfferedAt,
+			&expiresAt)
 		if err != nil {
 			return nil, err
 		}
+		res.Currency = currency
+		res.EndDate = transitdb.Date(endDate.Time)
+		res.ExpiresAt = expiresAt.Time
 
 		results = append(results, res)
 	}
@@ -178,40 +155,87 @@ func (s *Store) CheapestPerRoute(ctx context.Context, start, end time.Time) ([]t
 	return results, nil
 }
 
-const cheapestPerRouteSQL = `
+var cheapestPerRouteSQL = `
 WITH
 
+-- Live offers in the time range matching the filters, like
+-- matching_offers in listQuotesSQL.
+--
+matching_offers AS (
+    SELECT offers.*,
+           offer_costs.cost_usd
+      FROM offers
+           JOIN offer_costs USING (offer_id)
+           JOIN places AS origin
+              ON origin.place_id = offers.origin_id
+           JOIN places AS dest
+              ON dest.place_id = offers.dest_id
+     WHERE (start_time BETWEEN $1 AND $2)
+       AND ($3 = '' OR origin.iata_code = ANY(expand_place_codes(string_to_array($3, ','))))
+       AND ($4 = '' OR dest.iata_code = ANY(expand_place_codes(string_to_array($4, ','))))
+       AND ` + regionsSQL(5) + `
+       AND expires_at > NOW()
+),
+
+-- The offer with the lowest price in dollars on each route, and the
+-- one happening soonest among those.
+--
 cheapest AS (
-    SELECT origin_id,
-		   dest_id,
-		   MIN(start_time) as start_time,
-           MIN(cost) AS cost
-    FROM offers
-    WHERE start_time BETWEEN $1 AND $2
-    GROUP BY (origin_id, dest_id)
+      SELECT DISTINCT ON (origin_id, dest_id) *
+        FROM matching_offers
+    ORDER BY origin_id, dest_id, cost_usd, start_time, offer_id
 )
 
-SELECT origin.iata_code,
+SELECT origin.name,
+       origin.iata_code,
        origin.country,
+	   dest.name,
 	   dest.iata_code,
 	   dest.country,
-	   cheapest.cost,
-	   cheapest.start_time
+	   ROUND(cheapest.cost_usd * $13::numeric),
+	   cheapest.start_time,
+	   cheapest.offer_id,
+	   cheapest.source,
+	   cheapest.end_time,
+	   cheapest.created_at,
+	   cheapest.expires_at
 FROM cheapest
 JOIN places AS origin
 	ON origin.place_id = cheapest.origin_id
 JOIN places AS dest
 	ON dest.place_id = cheapest.dest_id
-ORDER BY cheapest.cost ASC
+ORDER BY ROUND(cheapest.cost_usd * $13::numeric) ASC, origin.iata_code, dest.iata_code
+LIMIT NULLIF($14, 0)
+OFFSET $15
 `
 
 func (s *Store) ListQuotes(ctx context.Context, q transitdb.ListQuotesRequest) ([]transitdb.Quote, error) {
-	rows, err := s.db.QueryContext(ctx, listQuotesSQL,
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	currency := q.Currency
+	if currency == "" {
+		currency = transitdb.BaseCurrency
+	}
+	perUSD, err := s.exchangeRate(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{
 		q.StartDate, q.EndDate,
 		strings.Join(q.Origins, ","),
 		strings.Join(q.Destinations, ","),
-		q.Limit,
-		q.Offset)
+	}
+	args = append(args, radiusArgs(q.OriginNear)...)
+	args = append(args, radiusArgs(q.DestNear)...)
+	args = append(args, regionArgs(
+		placeFilter{q.OriginCountries, q.ExcludeOriginCountries, q.OriginContinents, q.ExcludeOriginContinents},
+		placeFilter{q.DestCountries, q.ExcludeDestCountries, q.DestContinents, q.ExcludeDestContinents})...)
+	args = append(args, perUSD, q.Limit, q.Offset)
+
+	rows, err := s.db.QueryContext(ctx, listQuotesSQL, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -219,18 +243,31 @@ func (s *Store) ListQuotes(ctx context.Context, q transitdb.ListQuotesRequest) (
 
 	var results []transitdb.Quote
 	for rows.Next() {
-		var res transitdb.Quote
+		var (
+			res                transitdb.Quote
+			endDate, expiresAt pq.NullTime
+		)
 
 		err = rows.Scan(
 			&res.Cost,
 			&res.Origin,
+			&res.OriginIATA,
 			&res.OriginCountry,
 			&res.Dest,
+			&res.DestIATA,
 			&res.DestCountry,
-			&res.Date)
+			&res.Date,
+			&res.OfferID,
+			&res.Source,
+			&endDate,
+			&res.OfferedAt,
+			&expiresAt)
 		if err != nil {
 			return nil, err
 		}
+		res.Currency = currency
+		res.EndDate = transitdb.Date(endDate.Time)
+		res.ExpiresAt = expiresAt.Time
 
 		results = append(results, res)
 	}
@@ -242,32 +279,97 @@ func (s *Store) ListQuotes(ctx context.Context, q transitdb.ListQuotesRequest) (
 	return results, nil
 }
 
-const listQuotesSQL = `
+// radiusArgs returns the query arguments for an optional radius: its
+// center, its length in kilometers and its bounding box. All are NULL
+// if r is nil.
+func radiusArgs(r *transitdb.Radius) []interface{} {
+	if r == nil {
+		return make([]interface{}, 7)
+	}
+	minLat, maxLat, minLon, maxLon := r.Bounds()
+	return []interface{}{
+		r.Latitude, r.Longitude, r.Km,
+		minLat, maxLat, minLon, maxLon,
+	}
+}
+
+// A placeFilter holds the countries and continents one end of a route
+// must be in, if any are listed, and must not be in.
+type placeFilter struct {
+	countries, excludeCountries   []string
+	continents, excludeContinents []string
+}
+
+// regionArgs returns the query arguments for regionsSQL. Continents are
+// passed as the countries on them, using the transitdb package's map.
+func regionArgs(origin, dest placeFilter) []interface{} {
+	var args []interface{}
+	for _, f := range []placeFilter{origin, dest} {
+		args = append(args,
+			strings.Join(f.countries, ","),
+			strings.Join(f.excludeCountries, ","),
+			strings.Join(transitdb.Countries(f.continents), ","),
+			strings.Join(transitdb.Countries(f.excludeContinents), ","))
+	}
+	return args
+}
+
+// regionsSQL returns the conditions that apply the placeFilters from
+// regionArgs to the places aliased origin and dest, whose arguments
+// start at $n.
+func regionsSQL(n int) string {
+	var conds []string
+	for _, place := range []string{"origin", "dest"} {
+		conds = append(conds, fmt.Sprintf(regionSQLTemplate, place, n, n+1, n+2, n+3))
+		n += 4
+	}
+	return strings.Join(conds, "\n       AND ")
+}
+
+const regionSQLTemplate = `($%[2]d = '' OR %[1]s.country = ANY(string_to_array($%[2]d, ',')))
+       AND ($%[3]d = '' OR %[1]s.country <> ALL(string_to_array($%[3]d, ',')))
+       AND ($%[4]d = '' OR %[1]s.country = ANY(string_to_array($%[4]d, ',')))
+       AND ($%[5]d = '' OR %[1]s.country <> ALL(string_to_array($%[5]d, ',')))`
+
+var listQuotesSQL = `
 WITH
 
 -- Get offers in our time range from the source airport, ignoring
 -- ones that don't match our conditions.
 -- 
 matching_offers AS (
-    SELECT offers.*
+    SELECT offers.*,
+           offer_costs.cost_usd
       FROM offers
+           JOIN offer_costs USING (offer_id)
            JOIN places AS origin
               ON origin.place_id = offers.origin_id
            JOIN places AS dest
               ON dest.place_id = offers.dest_id
      WHERE (start_time BETWEEN $1 AND $2)
-       AND ($3 = '' OR origin.iata_code = ANY(string_to_array($3, ',')))
-       AND ($4 = '' OR dest.iata_code = ANY(string_to_array($4, ',')))
+       AND ($3 = '' OR origin.iata_code = ANY(expand_place_codes(string_to_array($3, ','))))
+       AND ($4 = '' OR dest.iata_code = ANY(expand_place_codes(string_to_array($4, ','))))
+       -- The bounding box is compared as numeric, the type of the
+       -- place_location_idx columns, so that the index can be used.
+       AND ($5::float8 IS NULL
+            OR (origin.latitude BETWEEN $8::numeric AND $9::numeric
+                AND origin.longitude BETWEEN $10::numeric AND $11::numeric
+                AND great_circle_km(origin.latitude, origin.longitude, $5, $6::float8) <= $7::float8))
+       AND ($12::float8 IS NULL
+            OR (dest.latitude BETWEEN $15::numeric AND $16::numeric
+                AND dest.longitude BETWEEN $17::numeric AND $18::numeric
+                AND great_circle_km(dest.latitude, dest.longitude, $12, $13::float8) <= $14::float8))
+       AND ` + regionsSQL(19) + `
        AND expires_at > NOW()
 ),
 
 -- Set of offers for a given leg that have the lowest price during
--- the time window. There may be more than one.
+-- the time window, compared in dollars. There may be more than one.
 --
 min_offers AS (
       SELECT origin_id,
              dest_id,
-             MIN(cost) AS cost
+             MIN(cost_usd) AS cost_usd
         FROM matching_offers
     GROUP BY (origin_id, dest_id)
 ),
@@ -277,29 +379,111 @@ min_offers AS (
 next_min_offer AS (
       SELECT origin_id,
              dest_id,
-             cost,
+             cost_usd,
              MIN(start_time) AS start_time
         FROM min_offers
-             JOIN matching_offers USING (origin_id, dest_id, cost)
-    GROUP BY (origin_id, dest_id, cost)
+             JOIN matching_offers USING (origin_id, dest_id, cost_usd)
+    GROUP BY (origin_id, dest_id, cost_usd)
 )
 
--- Print them all, starting with the cheapest
+-- Print them all, starting with the cheapest, converted to the
+-- requested currency
 --
 SELECT
-	cost,
+	ROUND(cost_usd * $27::numeric),
 	origin.name,
+	origin.iata_code,
 	origin.country,
 	dest.name,
+	dest.iata_code,
 	dest.country,
-	start_time AS cheapest_date
+	start_time AS cheapest_date,
+	matching_offers.offer_id,
+	matching_offers.source,
+	matching_offers.end_time,
+	matching_offers.created_at,
+	matching_offers.expires_at
 FROM next_min_offer
-     JOIN offers USING (origin_id, dest_id, cost, start_time)
+     JOIN matching_offers USING (origin_id, dest_id, cost_usd, start_time)
      JOIN places AS dest
-          ON dest.place_id = offers.dest_id
+          ON dest.place_id = matching_offers.dest_id
      JOIN places AS origin
-          ON origin.place_id = offers.origin_id
-ORDER BY cost ASC
-LIMIT $5
-OFFSET $6;
+          ON origin.place_id = matching_offers.origin_id
+ORDER BY cost_usd ASC, matching_offers.offer_id
+LIMIT $28
+OFFSET $29;
+`
+
+func (s *Store) PriceHistory(ctx context.Context, q transitdb.PriceHistoryRequest) ([]transitdb.PricePoint, error) {
+	rows, err := s.db.QueryContext(ctx, priceHistorySQL,
+		q.Origin, q.Dest,
+		q.StartDate, q.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.PricePoint
+	for rows.Next() {
+		var res transitdb.PricePoint
+
+		err = rows.Scan(
+			&res.OfferID,
+			&res.Source,
+			&res.Cost,
+			&res.Currency,
+			&res.Date,
+			&res.ObservedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const priceHistorySQL = `
+WITH
+
+route_offers AS (
+    SELECT offers.*
+      FROM offers
+           JOIN places AS origin
+              ON origin.place_id = offers.origin_id
+           JOIN places AS dest
+              ON dest.place_id = offers.dest_id
+     WHERE origin.iata_code = $1
+       AND dest.iata_code = $2
+       AND (start_time BETWEEN $3 AND $4)
+)
+
+-- The current price of each offer, plus the prices it had before
+-- later reports replaced them.
+--
+SELECT offer_id,
+       source,
+       cost,
+       currency,
+       start_time,
+       created_at AS observed_at
+FROM route_offers
+
+UNION ALL
+
+SELECT history.offer_id,
+       route_offers.source,
+       history.cost,
+       history.currency,
+       route_offers.start_time,
+       history.observed_at
+FROM offer_price_history AS history
+     JOIN route_offers USING (offer_id)
+
+ORDER BY observed_at, start_time, offer_id
 `
@@ -0,0 +1,65 @@
+package pg
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/maxhawkins/transitdb"
+	"github.com/maxhawkins/transitdb/storetest"
+)
+
+// TestStore runs the conformance suite against the database named by
+// TRANSITDB_TEST_DB. Every subtest drops and recreates its public
+// schema, so point it at a scratch database.
+func TestStore(t *testing.T) {
+	url := os.Getenv("TRANSITDB_TEST_DB")
+	if url == "" {
+		t.Skip("TRANSITDB_TEST_DB not set")
+	}
+
+	places, err := ioutil.ReadFile("../places.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var last *Store
+	defer func() {
+		if last != nil {
+			last.Close()
+		}
+	}()
+
+	storetest.Run(t, func() transitdb.Store {
+		if last != nil {
+			last.Close()
+		}
+		last = freshStore(t, url, string(places))
+		return last
+	})
+}
+
+// freshStore returns a store for an empty database with the current
+// schema, seeded with places.
+func freshStore(t *testing.T, url, places string) *Store {
+	t.Helper()
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public;`); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Open(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.db.Exec(places); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
@@ -0,0 +1,129 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+const placeGroupColumns = `
+	code,
+	name,
+	metro,
+	ARRAY(SELECT iata_code
+	        FROM place_group_members
+	       WHERE group_code = code
+	    ORDER BY iata_code)`
+
+func (s *Store) ListPlaceGroups(ctx context.Context) ([]transitdb.PlaceGroup, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+placeGroupColumns+`
+		FROM place_groups
+		ORDER BY code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.PlaceGroup
+	for rows.Next() {
+		res, err := scanPlaceGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (s *Store) GetPlaceGroup(ctx context.Context, code string) (transitdb.PlaceGroup, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+placeGroupColumns+`
+		FROM place_groups
+		WHERE code = $1`,
+		code)
+	g, err := scanPlaceGroup(row)
+	if err == sql.ErrNoRows {
+		return g, transitdb.ErrNotFound
+	}
+	return g, err
+}
+
+func (s *Store) SavePlaceGroup(ctx context.Context, g transitdb.PlaceGroup) (transitdb.PlaceGroup, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return g, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO place_groups
+		(code, name, metro)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (code) DO UPDATE
+		SET name = EXCLUDED.name,
+		    metro = EXCLUDED.metro`,
+		g.Code,
+		g.Name,
+		g.Metro)
+	if err != nil {
+		return g, err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM place_group_members WHERE group_code = $1`, g.Code)
+	if err != nil {
+		return g, err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO place_group_members
+		(group_code, iata_code)
+		SELECT $1, unnest($2::text[])`,
+		g.Code,
+		pq.Array(g.Members))
+	if err != nil {
+		return g, err
+	}
+
+	res, err := scanPlaceGroup(tx.QueryRowContext(ctx, `
+		SELECT `+placeGroupColumns+`
+		FROM place_groups
+		WHERE code = $1`,
+		g.Code))
+	if err != nil {
+		return res, err
+	}
+
+	return res, tx.Commit()
+}
+
+func (s *Store) DeletePlaceGroup(ctx context.Context, code string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM place_groups WHERE code = $1`, code)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+func scanPlaceGroup(row scanner) (transitdb.PlaceGroup, error) {
+	var res transitdb.PlaceGroup
+	err := row.Scan(
+		&res.Code,
+		&res.Name,
+		&res.Metro,
+		pq.Array(&res.Members))
+	return res, err
+}
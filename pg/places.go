@@ -0,0 +1,164 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) ListPlaces(ctx context.Context, q transitdb.ListPlacesRequest) ([]transitdb.Place, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+placeColumns+`
+		FROM places
+		WHERE ($1 = '' OR country = $1)
+		  AND ($2 = ''
+		       OR strpos(lower(name), lower($2)) > 0
+		       OR iata_code = upper($2))
+		  AND ($3 OR active)
+		ORDER BY iata_code NULLS LAST, place_id
+		LIMIT $4
+		OFFSET $5`,
+		q.Country,
+		q.Query,
+		q.IncludeInactive,
+		q.Limit,
+		q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.Place
+	for rows.Next() {
+		res, err := scanPlace(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (s *Store) GetPlace(ctx context.Context, id int) (transitdb.Place, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+placeColumns+`
+		FROM places
+		WHERE place_id = $1`,
+		id)
+	p, err := scanPlace(row)
+	if err == sql.ErrNoRows {
+		return p, transitdb.ErrNotFound
+	}
+	return p, err
+}
+
+func (s *Store) CreatePlace(ctx context.Context, p transitdb.Place) (transitdb.Place, error) {
+	row := s.db.QueryRowContext(ctx, `
+		INSERT INTO places
+		(iata_code, country, latitude, longitude, name)
+		VALUES (NULLIF($1, ''), $2, $3, $4, $5)
+		RETURNING `+placeColumns,
+		p.IATACode,
+		p.Country,
+		p.Latitude,
+		p.Longitude,
+		p.Name)
+	res, err := scanPlace(row)
+	if err != nil {
+		return res, placeError(err, p)
+	}
+
+	return res, nil
+}
+
+func (s *Store) UpdatePlace(ctx context.Context, p transitdb.Place) (transitdb.Place, error) {
+	row := s.db.QueryRowContext(ctx, `
+		UPDATE places
+		   SET iata_code = NULLIF($2, ''),
+		       country = $3,
+		       latitude = $4,
+		       longitude = $5,
+		       name = $6,
+		       active = $7
+		 WHERE place_id = $1
+		RETURNING `+placeColumns,
+		p.ID,
+		p.IATACode,
+		p.Country,
+		p.Latitude,
+		p.Longitude,
+		p.Name,
+		p.Active)
+	res, err := scanPlace(row)
+	if err == sql.ErrNoRows {
+		return res, transitdb.ErrNotFound
+	}
+	if err != nil {
+		return res, placeError(err, p)
+	}
+
+	return res, nil
+}
+
+func (s *Store) DeactivatePlace(ctx context.Context, id int) error {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE places
+		   SET active = FALSE
+		 WHERE place_id = $1`,
+		id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+
+	return nil
+}
+
+// placeError reports a clash with another place's IATA code as
+// transitdb.ErrConflict.
+func placeError(err error, p transitdb.Place) error {
+	if e, ok := err.(*pq.Error); ok && e.Code.Name() == "unique_violation" {
+		return fmt.Errorf("%w: IATA code %q is taken", transitdb.ErrConflict, p.IATACode)
+	}
+	return err
+}
+
+const placeColumns = `
+	place_id,
+	COALESCE(iata_code, ''),
+	name,
+	country,
+	latitude,
+	longitude,
+	active`
+
+func scanPlace(row scanner) (transitdb.Place, error) {
+	var res transitdb.Place
+	err := row.Scan(
+		&res.ID,
+		&res.IATACode,
+		&res.Name,
+		&res.Country,
+		&res.Latitude,
+		&res.Longitude,
+		&res.Active)
+	return res, err
+}
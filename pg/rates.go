@@ -0,0 +1,75 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) SaveExchangeRates(ctx context.Context, rates []transitdb.ExchangeRate) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, r := range rates {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO exchange_rates
+			(currency, per_usd, updated_at)
+			VALUES ($1, $2, NOW())
+			ON CONFLICT (currency) DO
+			UPDATE SET
+				per_usd = excluded.per_usd,
+				updated_at = excluded.updated_at`,
+			r.Currency,
+			r.PerUSD)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) ListExchangeRates(ctx context.Context) ([]transitdb.ExchangeRate, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT currency, per_usd, updated_at
+		FROM exchange_rates
+		ORDER BY currency`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.ExchangeRate
+	for rows.Next() {
+		var res transitdb.ExchangeRate
+
+		if err := rows.Scan(&res.Currency, &res.PerUSD, &res.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// exchangeRate returns the number of units of currency per dollar.
+func (s *Store) exchangeRate(ctx context.Context, currency string) (float64, error) {
+	var perUSD float64
+	err := s.db.QueryRowContext(ctx,
+		`SELECT per_usd FROM exchange_rates WHERE currency = $1`,
+		currency).Scan(&perUSD)
+	if err == sql.ErrNoRows {
+		return 0, &transitdb.ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", currency)}
+	}
+	return perUSD, err
+}
@@ -0,0 +1,516 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) SaveOffer(ctx context.Context, o transitdb.Offer) (transitdb.SaveResult, error) {
+	results, err := s.SaveOffers(ctx, []transitdb.Offer{o})
+	if err != nil {
+		return 0, err
+	}
+	return results[0], nil
+}
+
+// SaveOffers saves a batch of offers in one transaction. The offers are
+// copied into a staging table and then resolved against places and
+// upserted in a single statement, which is much faster than saving them
+// one at a time. If any offer names an unknown airport, none are saved.
+//
+// Offers whose key matches a stored offer update it in place. When a
+// batch repeats a key, the last occurrence wins and the earlier ones are
+// reported as unchanged. Inserted and updated offers are matched against
+// watches in the same transaction.
+func (s *Store) SaveOffers(ctx context.Context, offers []transitdb.Offer) ([]transitdb.SaveResult, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results, err := s.saveOffers(ctx, tx, offers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// A Tx saves batches of offers in one database transaction. Concurrent
+// saves of the offers it has saved wait for it to end.
+type Tx struct {
+	s  *Store
+	tx *sql.Tx
+}
+
+func (s *Store) Begin(ctx context.Context) (transitdb.Tx, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{s: s, tx: tx}, nil
+}
+
+func (t *Tx) SaveOffers(ctx context.Context, offers []transitdb.Offer) ([]transitdb.SaveResult, error) {
+	return t.s.saveOffers(ctx, t.tx, offers)
+}
+
+func (t *Tx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *Tx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// saveOffers does the work of SaveOffers in tx.
+func (s *Store) saveOffers(ctx context.Context, tx *sql.Tx, offers []transitdb.Offer) ([]transitdb.SaveResult, error) {
+	upsertSQL, err := s.upsertSQL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, createStagingSQL); err != nil {
+		return nil, err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("offers_staging",
+		"seq", "origin_iata", "dest_iata", "cost", "currency", "source",
+		"start_time", "end_time", "created_at", "expires_at", "batch_id"))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, o := range offers {
+		availableFrom := pq.NullTime{
+			Time:  time.Time(o.AvailableFrom),
+			Valid: !time.Time(o.AvailableFrom).IsZero(),
+		}
+		availableTo := pq.NullTime{
+			Time:  time.Time(o.AvailableTo),
+			Valid: !time.Time(o.AvailableTo).IsZero(),
+		}
+		expiresAt := pq.NullTime{Time: o.ExpiresAt, Valid: !o.ExpiresAt.IsZero()}
+		batchID := sql.NullInt64{Int64: int64(o.BatchID), Valid: o.BatchID != 0}
+
+		currency := o.Currency
+		if currency == "" {
+			currency = transitdb.BaseCurrency
+		}
+
+		_, err := stmt.ExecContext(ctx,
+			i,
+			o.OriginAirport,
+			o.DestinationAirport,
+			o.Cost,
+			currency,
+			o.Source,
+			availableFrom,
+			availableTo,
+			o.OfferedAt,
+			expiresAt,
+			batchID)
+		if err != nil {
+			stmt.Close()
+			return nil, err
+		}
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		stmt.Close()
+		return nil, err
+	}
+	if err := stmt.Close(); err != nil {
+		return nil, err
+	}
+
+	var (
+		originIATA, destIATA string
+		originKnown          bool
+	)
+	err = tx.QueryRowContext(ctx, unknownStagedAirportSQL).Scan(&originIATA, &destIATA, &originKnown)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return nil, err
+	case !originKnown:
+		return nil, &transitdb.ErrUnknownAirport{Code: originIATA, Role: "origin"}
+	default:
+		return nil, &transitdb.ErrUnknownAirport{Code: destIATA, Role: "destination"}
+	}
+
+	// Offers without a rate would drop out of offer_costs, and so out
+	// of every quote.
+	var currency string
+	err = tx.QueryRowContext(ctx, unknownStagedCurrencySQL).Scan(&currency)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return nil, err
+	default:
+		return nil, &transitdb.ValidationError{Msg: fmt.Sprintf("no exchange rate for %q", currency)}
+	}
+
+	rows, err := tx.QueryContext(ctx, upsertSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]transitdb.SaveResult, len(offers))
+	for i := range results {
+		results[i] = transitdb.OfferUnchanged
+	}
+	var changed, changedIDs []int64
+	for rows.Next() {
+		var (
+			seq, offerID int
+			result       string
+		)
+		if err := rows.Scan(&seq, &offerID, &result); err != nil {
+			return nil, err
+		}
+		switch result {
+		case "inserted":
+			results[seq] = transitdb.OfferInserted
+		case "updated":
+			results[seq] = transitdb.OfferUpdated
+		default:
+			continue
+		}
+		changed = append(changed, int64(seq))
+		changedIDs = append(changedIDs, int64(offerID))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if len(changed) > 0 {
+		if _, err := tx.ExecContext(ctx, matchWatchesSQL, pq.Array(changed), pq.Array(changedIDs)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make way for the next batch in the same transaction.
+	if _, err := tx.ExecContext(ctx, `DROP TABLE offers_staging`); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const createStagingSQL = `
+CREATE TEMPORARY TABLE
+offers_staging (
+    seq          INT          NOT NULL,
+    origin_iata  VARCHAR(3)   NOT NULL,
+    dest_iata    VARCHAR(3)   NOT NULL,
+    cost         DECIMAL      NOT NULL,
+    currency     VARCHAR(3)   NOT NULL,
+    source       VARCHAR(20)  NOT NULL,
+    start_time   DATE         NOT NULL,
+    end_time     DATE,
+    created_at   TIMESTAMP    NOT NULL,
+    expires_at   TIMESTAMP,
+    batch_id     INT
+) ON COMMIT DROP
+`
+
+// The first staged offer that references an airport we don't know.
+const unknownStagedAirportSQL = `
+SELECT staged.origin_iata,
+       staged.dest_iata,
+       origin.place_id IS NOT NULL
+FROM offers_staging AS staged
+     LEFT JOIN places AS origin
+          ON origin.iata_code = staged.origin_iata
+         AND origin.active
+     LEFT JOIN places AS dest
+          ON dest.iata_code = staged.dest_iata
+         AND dest.active
+WHERE origin.place_id IS NULL
+   OR dest.place_id IS NULL
+ORDER BY staged.seq
+LIMIT 1
+`
+
+const unknownStagedCurrencySQL = `
+SELECT staged.currency
+FROM offers_staging AS staged
+     LEFT JOIN exchange_rates AS rates USING (currency)
+WHERE rates.currency IS NULL
+ORDER BY staged.seq
+LIMIT 1
+`
+
+// offerKeyColumns maps OfferKey fields to offers columns. end_time is
+// the only nullable one.
+var offerKeyColumns = map[string]string{
+	transitdb.KeySource:    "source",
+	transitdb.KeyOrigin:    "origin_id",
+	transitdb.KeyDest:      "dest_id",
+	transitdb.KeyStartTime: "start_time",
+	transitdb.KeyEndTime:   "end_time",
+}
+
+// upsertSQL builds the statement that merges offers_staging into offers
+// using the store's offer key.
+func (s *Store) upsertSQL(ctx context.Context) (string, error) {
+	key := s.OfferKey
+	if key == nil {
+		key = transitdb.DefaultOfferKey
+	}
+	if err := key.Validate(); err != nil {
+		return "", err
+	}
+	if err := s.ensureOfferKeyIndex(ctx, key); err != nil {
+		return "", err
+	}
+
+	var columns, matches, savedMatches []string
+	for _, field := range key {
+		col := offerKeyColumns[field]
+		columns = append(columns, col)
+
+		op := "="
+		if col == "end_time" {
+			op = "IS NOT DISTINCT FROM"
+		}
+		matches = append(matches, fmt.Sprintf("offers.%s %s latest.%s", col, op, col))
+		savedMatches = append(savedMatches, fmt.Sprintf("saved.%s %s latest.%s", col, op, col))
+	}
+
+	r := strings.NewReplacer(
+		"{{key}}", strings.Join(columns, ", "),
+		"{{conflict}}", offerKeyTarget(key),
+		"{{match}}", strings.Join(matches, "\n          AND "),
+		"{{savedMatch}}", strings.Join(savedMatches, "\n          AND "))
+	return r.Replace(upsertSQLTemplate), nil
+}
+
+// offerKeyTarget returns the columns of the unique index on key, as the
+// conflict target of an upsert. Offers without an end_time share one.
+func offerKeyTarget(key transitdb.OfferKey) string {
+	var cols []string
+	for _, field := range key {
+		col := offerKeyColumns[field]
+		if col == "end_time" {
+			col = "(COALESCE(end_time, 'infinity'::date))"
+		}
+		cols = append(cols, col)
+	}
+	return strings.Join(cols, ", ")
+}
+
+// ensureOfferKeyIndex creates the unique index that upserts on key need,
+// unless key has the same fields as transitdb.DefaultOfferKey, which the
+// migrations index. It returns an *ErrDuplicateOfferKey if stored
+// offers already share a key.
+func (s *Store) ensureOfferKeyIndex(ctx context.Context, key transitdb.OfferKey) error {
+	fields := append([]string(nil), key...)
+	sort.Strings(fields)
+	defaults := append([]string(nil), transitdb.DefaultOfferKey...)
+	sort.Strings(defaults)
+	if strings.Join(fields, ",") == strings.Join(defaults, ",") {
+		return nil
+	}
+
+	s.keyIndexMu.Lock()
+	defer s.keyIndexMu.Unlock()
+	if s.keyIndexed {
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+		CREATE UNIQUE INDEX IF NOT EXISTS
+		offer_key_%s_idx
+		ON offers (%s)`,
+		strings.Join(fields, "_"),
+		offerKeyTarget(key)))
+	if e, ok := err.(*pq.Error); ok && e.Code.Name() == "unique_violation" {
+		return s.duplicateOfferKey(ctx, key)
+	}
+	if err != nil {
+		return fmt.Errorf("indexing offer key %s: %v", strings.Join(key, ","), err)
+	}
+	s.keyIndexed = true
+
+	return nil
+}
+
+// ErrDuplicateOfferKey is returned when a Store's OfferKey can't be
+// indexed because stored offers already share a key. Those offers have
+// to be merged or deleted before saving with that key.
+type ErrDuplicateOfferKey struct {
+	Key    transitdb.OfferKey
+	Values []string // the shared values, in Key order
+}
+
+func (e *ErrDuplicateOfferKey) Error() string {
+	var pairs []string
+	for i, field := range e.Key {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", field, e.Values[i]))
+	}
+	return fmt.Sprintf("stored offers share the key %s", strings.Join(pairs, " "))
+}
+
+// offerKeyValues maps OfferKey fields to the text of their values, for
+// reporting duplicates.
+var offerKeyValues = map[string]string{
+	transitdb.KeySource:    "offers.source",
+	transitdb.KeyOrigin:    "origin.iata_code",
+	transitdb.KeyDest:      "dest.iata_code",
+	transitdb.KeyStartTime: "to_char(offers.start_time, 'YYYY-MM-DD')",
+	transitdb.KeyEndTime:   "COALESCE(to_char(offers.end_time, 'YYYY-MM-DD'), '')",
+}
+
+// duplicateOfferKey finds a key that stored offers share, returning it
+// as an *ErrDuplicateOfferKey.
+func (s *Store) duplicateOfferKey(ctx context.Context, key transitdb.OfferKey) error {
+	var exprs []string
+	for _, field := range key {
+		exprs = append(exprs, offerKeyValues[field])
+	}
+	query := fmt.Sprintf(`
+		SELECT %[1]s
+		  FROM offers
+		       JOIN places AS origin
+		            ON origin.place_id = offers.origin_id
+		       JOIN places AS dest
+		            ON dest.place_id = offers.dest_id
+		 GROUP BY %[1]s
+		HAVING COUNT(*) > 1
+		 LIMIT 1`,
+		strings.Join(exprs, ", "))
+
+	values := make([]string, len(key))
+	dest := make([]interface{}, len(key))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := s.db.QueryRowContext(ctx, query).Scan(dest...); err != nil {
+		return fmt.Errorf("indexing offer key %s: %v", strings.Join(key, ","), err)
+	}
+
+	return &ErrDuplicateOfferKey{Key: key, Values: values}
+}
+
+const upsertSQLTemplate = `
+WITH
+
+resolved AS (
+    SELECT staged.seq,
+           origin.place_id AS origin_id,
+           dest.place_id AS dest_id,
+           staged.cost,
+           staged.currency,
+           staged.source,
+           staged.start_time,
+           staged.end_time,
+           staged.created_at,
+           staged.expires_at,
+           staged.batch_id
+      FROM offers_staging AS staged
+           JOIN places AS origin
+                ON origin.iata_code = staged.origin_iata
+           JOIN places AS dest
+                ON dest.iata_code = staged.dest_iata
+),
+
+-- When the batch repeats a key, the last occurrence wins.
+--
+latest AS (
+      SELECT DISTINCT ON ({{key}}) *
+        FROM resolved
+    ORDER BY {{key}}, seq DESC
+),
+
+-- The stored offer each incoming offer replaces, if any, as it was
+-- before this statement.
+--
+existing AS (
+    SELECT latest.seq,
+           offers.offer_id,
+           offers.cost AS old_cost,
+           offers.currency AS old_currency,
+           offers.created_at AS old_created_at
+      FROM latest
+           JOIN offers
+             ON {{match}}
+),
+
+history AS (
+    INSERT INTO offer_price_history
+    (offer_id, cost, currency, observed_at)
+    SELECT existing.offer_id,
+           existing.old_cost,
+           existing.old_currency,
+           existing.old_created_at
+      FROM existing
+           JOIN latest USING (seq)
+     WHERE (existing.old_cost, existing.old_currency)
+           <> (latest.cost, latest.currency)
+),
+
+-- Insert new offers and update the rest in place. Offers belong to the
+-- batch that last set their price. A concurrent save of the same key
+-- waits for this one to commit and then updates the row it inserted.
+--
+saved AS (
+    INSERT INTO offers
+    (origin_id, dest_id, cost, currency, source, start_time, end_time, created_at, expires_at, batch_id)
+    SELECT origin_id,
+           dest_id,
+           cost,
+           currency,
+           source,
+           start_time,
+           end_time,
+           created_at,
+           expires_at,
+           batch_id
+      FROM latest
+  ORDER BY seq
+    ON CONFLICT ({{conflict}}) DO UPDATE
+       SET cost = EXCLUDED.cost,
+           currency = EXCLUDED.currency,
+           created_at = EXCLUDED.created_at,
+           expires_at = EXCLUDED.expires_at,
+           batch_id = CASE
+               WHEN (offers.cost, offers.currency)
+                    <> (EXCLUDED.cost, EXCLUDED.currency) THEN EXCLUDED.batch_id
+               ELSE offers.batch_id
+           END
+    RETURNING offers.*,
+              xmax = 0 AS inserted
+)
+
+-- An offer another transaction inserted after this statement began
+-- has no existing row and is reported as updated.
+--
+SELECT latest.seq,
+       saved.offer_id,
+       CASE
+           WHEN saved.inserted THEN 'inserted'
+           WHEN existing.seq IS NULL
+                OR (existing.old_cost, existing.old_currency)
+                   <> (latest.cost, latest.currency) THEN 'updated'
+           ELSE 'unchanged'
+       END
+FROM latest
+     JOIN saved
+       ON {{savedMatch}}
+     LEFT JOIN existing USING (seq)
+`
@@ -0,0 +1,7903 @@
+// Code generated by genseedfix.go from places.sql. DO NOT EDIT.
+
+package pg
+
+// seedCoordinatesSQL is a VALUES list of the (iata_code, latitude,
+// longitude) of each place in places.sql.
+const seedCoordinatesSQL = `
+VALUES
+    ('AAA', -17.3526001, -145.5099945),
+    ('AAB', -26.70000076, 141.0500031),
+    ('AAC', 31.07329941, 33.83580017),
+    ('AAE', 36.82220078, 7.809169769),
+    ('AAF', 29.72750092, -85.02749634),
+    ('AAG', -24.10390091, -49.78910065),
+    ('AAH', 50.82305527, 6.186388969),
+    ('AAI', -13.02388859, -46.88555527),
+    ('AAJ', 3.898681, -55.577907),
+    ('AAK', 0.185277998, 173.6369934),
+    ('AAL', 57.09275891, 9.849243164),
+    ('AAM', -24.81809998, 31.54459953),
+    ('AAN', 24.26169968, 55.60919952),
+    ('AAO', 9.430225372, -64.47072601),
+    ('AAQ', 45.0021019, 37.34730148),
+    ('AAR', 56.29999924, 10.61900043),
+    ('AAS', -3.8832, 139.3108),
+    ('AAT', 47.74988556, 88.0858078),
+    ('AAU', -13.50580025, -172.628006),
+    ('AAV', 6.366819859, 124.7509995),
+    ('AAW', 34.20000076, 73.25),
+    ('AAX', -19.5632, -46.96039963),
+    ('AAY', 16.19169998, 52.17499924),
+    ('AAZ', 14.86559963, -91.5019989),
+    ('ABA', 53.74000168, 91.38500214),
+    ('ABB', 6.203333333, 6.658888889),
+    ('ABC', 38.94850159, -1.863520026),
+    ('ABD', 30.37109947, 48.22829819),
+    ('ABE', 40.65209961, -75.44080353),
+    ('ABF', 1.798609972, 173.0410004),
+    ('ABG', -17.61669922, 143.1670074),
+    ('ABH', -23.64609909, 146.5839996),
+    ('ABI', 32.41130066, -99.68190002),
+    ('ABJ', 5.261390209, -3.926290035),
+    ('ABK', 6.734000206, 44.25299835),
+    ('ABL', 67.10630035, -157.8569946),
+    ('ABM', -10.95079994, 142.4589996),
+    ('ABN', 5.512720108, -54.05009842),
+    ('ABP', -6.065555556, 141.0952778),
+    ('ABQ', 35.04019928, -106.6090012),
+    ('ABR', 45.44910049, -98.42179871),
+    ('ABS', 22.37599945, 31.61170006),
+    ('ABT', 20.29610062, 41.63430023),
+    ('ABU', -9.073049545, 124.9049988),
+    ('ABV', 9.006790161, 7.263169765),
+    ('ABX', -36.06779861, 146.9579926),
+    ('ABY', 31.53549957, -84.19450378),
+    ('ABZ', 57.20190048, -2.197779894),
+    ('ACA', 16.75709915, -99.7539978),
+    ('ACB', 44.98860168, -85.1984024),
+    ('ACC', 5.6051898, -0.166786),
+    ('ACD', 8.51667, -77.3),
+    ('ACE', 28.94549942, -13.60519981),
+    ('ACF', -27.57029915, 153.0079956),
+    ('ACH', 47.48500061, 9.560770035),
+    ('ACI', 49.70610046, -2.214720011),
+    ('ACJ', 8.30148983, 80.42790222),
+    ('ACK', 41.25310135, -70.06020355),
+    ('ACL', 4.753611111, -73.00277778),
+    ('ACM', -2.145437336, -71.75806046),
+    ('ACN', 29.33289909, -101.098999),
+    ('ACP', 37.34799957, 46.12789917),
+    ('ACR', -0.5833, -72.4083),
+    ('ACS', 56.2682991, 90.57080078),
+    ('ACT', 31.61129951, -97.23049927),
+    ('ACU', 8.431659698, -77.96520233),
+    ('ACV', 40.97809982, -124.1090012),
+    ('ACX', 25.08638889, 104.9594444),
+    ('ACY', 39.45759964, -74.57720184),
+    ('ACZ', 31.09830093, 61.54389954),
+    ('ADA', 36.98220062, 35.28039932),
+    ('ADB', 38.29240036, 27.15699959),
+    ('ADC', -7.137222222, 145.7447222),
+    ('ADD', 8.977890015, 38.79930115),
+    ('ADE', 12.8295002, 45.02880096),
+    ('ADF', 37.73139954, 38.46889877),
+    ('ADG', 41.86769867, -84.07730103),
+    ('ADH', 58.60279846, 125.4089966),
+    ('ADI', -22.46220016, 14.97999954),
+    ('ADJ', 31.97270012, 35.99160004),
+    ('ADK', 51.87799835, -176.6459961),
+    ('ADL', -34.94499969, 138.5310059),
+    ('ADM', 34.30301, -97.0196342),
+    ('ADN', 5.66667, -75.8833),
+    ('ADO', -30.43829918, 137.1369934),
+    ('ADP', 7.33776, 81.62594),
+    ('ADQ', 57.75, -152.4940033),
+    ('ADR', 33.4516983, -79.52619934),
+    ('ADS', 32.96860123, -96.83640289),
+    ('ADT', 34.8042984, -96.6713028),
+    ('ADU', 38.32569885, 48.42440033),
+    ('ADV', 11.4023, 26.1186),
+    ('ADW', 38.81079865, -76.86699677),
+    ('ADX', 56.3728981, -2.868439913),
+    ('ADY', -22.67900085, 29.05550003),
+    ('ADZ', 12.5836, -81.7112),
+    ('AEA', 0.490833014, 173.8289948),
+    ('AEB', 23.72060013, 106.9599991),
+    ('AEE', 10.05361111, 32.95944444),
+    ('AEG', 1.400099993, 99.43049622),
+    ('AEH', 13.84700012, 20.84429932),
+    ('AEK', -7.350804856, 146.1938667),
+    ('AEL', 43.68149948, -93.36720276),
+    ('AEO', 16.7112999, -9.637880325),
+    ('AEP', -34.5592, -58.4156),
+    ('AER', 43.44990158, 39.95660019),
+    ('AES', 62.5625, 6.119699955),
+    ('AET', 66.55180359, -152.621994),
+    ('AEU', 25.8757, 55.03300095),
+    ('AEX', 31.32740021, -92.54979706),
+    ('AEY', 65.66000366, -18.0727005),
+    ('AFA', -34.5882988, -68.40390015),
+    ('AFD', -33.55419922, 26.87770081),
+    ('AFF', 38.96969986, -104.8130035),
+    ('AFI', 6.91667, -75.0667),
+    ('AFL', -9.866389275, -56.10499954),
+    ('AFN', 42.80509949, -72.00299835),
+    ('AFO', 42.71120071, -110.9420013),
+    ('AFR', -9.142222222, 148.3908333),
+    ('AFS', 41.61389923, 64.23320007),
+    ('AFT', -9.191388889, 160.9486111),
+    ('AFW', 32.98759842, -97.31880188),
+    ('AFY', 38.72639847, 30.60110092),
+    ('AFZ', 36.16809845, 57.59519958),
+    ('AGA', 30.32500076, -9.413069725),
+    ('AGB', 48.42527771, 10.93166733),
+    ('AGC', 40.35440063, -79.93019867),
+    ('AGD', -1.3858, 133.8737),
+    ('AGE', 53.78277969, 7.913888931),
+    ('AGF', 44.17470169, 0.590556026),
+    ('AGG', -4.055833333, 144.0738889),
+    ('AGH', 56.29610062, 12.84710026),
+    ('AGI', 5.766666889, -56.6333313),
+    ('AGJ', 26.59250069, 127.2409973),
+    ('AGK', -6.396388889, 143.8536111),
+    ('AGL', -9.3375, 149.1555556),
+    ('AGO', 33.22800064, -93.21700287),
+    ('AGP', 36.67490005, -4.499110222),
+    ('AGQ', 38.60200119, 21.3512001),
+    ('AGR', 27.15579987, 77.96089935),
+    ('AGS', 33.36989975, -81.96450043),
+    ('AGT', -25.45999908, -54.84000015),
+    ('AGU', 21.70560074, -102.3180008),
+    ('AGV', 9.553375244, -69.23786926),
+    ('AGW', -12.14560032, 142.1490021),
+    ('AGX', 10.82369995, 72.1760025),
+    ('AGZ', -29.28179932, 18.81389999),
+    ('AHB', 18.24040031, 42.65660095),
+    ('AHC', 40.26620102, -120.1529999),
+    ('AHD', 34.14699936, -97.12270355),
+    ('AHE', -14.42809963, -146.2570038),
+    ('AHF', 40.33950043, -99.90650177),
+    ('AHH', 45.28110123, -92.37539673),
+    ('AHI', -3.34800005, 128.9259949),
+    ('AHJ', 32.53154, 102.35224),
+    ('AHL', 2.486530066, -59.31340027),
+    ('AHM', 42.19029999, -122.6610031),
+    ('AHN', 33.94860077, -83.32630157),
+    ('AHO', 40.63209915, 8.290769577),
+    ('AHS', 15.47220039, -84.35220337),
+    ('AHU', 35.17710114, -3.839519978),
+    ('AHY', -20.01583333, 45.535),
+    ('AHZ', 45.0882988, 6.084720135),
+    ('AIA', 42.05319977, -102.8040009),
+    ('AID', 40.10860062, -85.61299896),
+    ('AIE', -5.145699978, 144.7307003),
+    ('AIF', -22.64, -50.453056),
+    ('AIG', 6.519999981, 23.26000023),
+    ('AIH', -7.342777778, 141.2675),
+    ('AII', 11.14688889, 42.72),
+    ('AIK', 33.6493988, -81.68499756),
+    ('AIL', 9.21366024, -78.02519989),
+    ('AIM', 10.21679974, 169.9830017),
+    ('AIN', 70.63800049, -159.9949951),
+    ('AIO', 41.40729904, -95.04689789),
+    ('AIP', 7.279421592, 168.8257),
+    ('AIR', -10.25027752, -59.38388824),
+    ('AIS', -2.616110086, 176.8029938),
+    ('AIT', -18.83090019, -159.7640076),
+    ('AIU', -19.96780014, -158.1190033),
+    ('AIV', 33.10649872, -88.19779968),
+    ('AIZ', 38.09600067, -92.54949951),
+    ('AJA', 41.92359924, 8.802920341),
+    ('AJF', 29.78510094, 40.09999847),
+    ('AJI', 39.65454102, 43.02597809),
+    ('AJJ', 19.73301697, -14.38324928),
+    ('AJK', 34.13809967, 49.84730148),
+    ('AJL', 23.84059906, 92.61969757),
+    ('AJN', -12.13169956, 44.43030167),
+    ('AJR', 65.59030151, 19.28190041),
+    ('AJS', 26.72719955, -113.5589981),
+    ('AJU', -10.98400021, -37.07030106),
+    ('AJY', 16.9659996, 8.000109673),
+    ('AKA', 32.70809937, 108.9309998),
+    ('AKB', 52.22029877, -174.2059937),
+    ('AKC', 41.03749847, -81.46690369),
+    ('AKD', 20.6989994, 77.05860138),
+    ('AKE', -1.139670014, 13.90359974),
+    ('AKF', 24.17869949, 23.31399918),
+    ('AKG', -3.592803001, 142.2545166),
+    ('AKH', 24.06270027, 47.58050156),
+    ('AKI', 60.9029007, -161.2310028),
+    ('AKJ', 43.67079926, 142.4470062),
+    ('AKK', 56.93870163, -154.1829987),
+    ('AKL', -37.0080986, 174.7920074),
+    ('AKM', 10.89027778, 19.81722222),
+    ('AKN', 58.67679977, -156.6490021),
+    ('AKO', 40.17559814, -103.2220001),
+    ('AKP', 68.13359833, -151.7429962),
+    ('AKQ', -4.611139774, 105.2320023),
+    ('AKR', 7.246739864, 5.301010132),
+    ('AKS', -8.702569962, 160.6820068),
+    ('AKT', 34.5904007, 32.98789978),
+    ('AKU', 41.26250076, 80.29170227),
+    ('AKV', 60.8185997, -78.14859772),
+    ('AKW', 30.74440002, 49.67720032),
+    ('AKX', 50.24580002, 57.20669937),
+    ('AKY', 20.13269997, 92.87259674),
+    ('ALA', 43.35210037, 77.04049683),
+    ('ALB', 42.74829865, -73.80169678),
+    ('ALC', 38.28219986, -0.558156013),
+    ('ALD', -11.68299961, -69.33300018),
+    ('ALE', 30.38419914, -103.6839981),
+    ('ALF', 69.97609711, 23.37170029),
+    ('ALG', 36.69100189, 3.215409994),
+    ('ALH', -34.94329834, 117.8089981),
+    ('ALI', 27.74090004, -98.02690125),
+    ('ALJ', -28.57500076, 16.5333004),
+    ('ALL', 44.05059814, 8.127429962),
+    ('ALM', 32.83990097, -105.9909973),
+    ('ALN', 38.89030075, -90.04599762),
+    ('ALO', 42.55709839, -92.40029907),
+    ('ALP', 36.18069839, 37.22439957),
+    ('ALQ', -29.81270027, -55.89339828),
+    ('ALR', -45.21170044, 169.3730011),
+    ('ALS', 37.43489838, -105.8669968),
+    ('ALT', -1.917, -54.7231),
+    ('ALU', 11.9582, 50.748),
+    ('ALW', 46.09489822, -118.288002),
+    ('ALX', 32.91469955, -85.96299744),
+    ('ALY', 31.18390083, 29.94890022),
+    ('AMA', 35.2193985, -101.7060013),
+    ('AMB', -13.18840027, 48.98799896),
+    ('AMC', 11.0340004, 20.27400017),
+    ('AMD', 23.07719994, 72.63469696),
+    ('AME', -15.61027778, 37.68138889),
+    ('AMF', -4.101111111, 141.67),
+    ('AMH', 6.039390087, 37.59049988),
+    ('AMI', -8.560709953, 116.0950012),
+    ('AMJ', -16.18389, -40.66722),
+    ('AMK', 37.20320129, -107.8690033),
+    ('AML', 8.268059731, -82.86489868),
+    ('AMM', 31.72260094, 35.99319839),
+    ('AMN', 43.32210159, -84.68800354),
+    ('AMO', 14.14560032, 15.31439972),
+    ('AMP', -24.6996994, 44.73419952),
+    ('AMQ', -3.710259914, 128.0890045),
+    ('AMS', 52.30860138, 4.76388979),
+    ('AMT', -26.10829926, 131.2070007),
+    ('AMU', -3.586, 141.2143333),
+    ('AMV', 69.76329803, 61.5564003),
+    ('AMW', 41.99200058, -93.62180328),
+    ('AMX', -21.73830032, 135.2420044),
+    ('AMY', -17.68666667, 45.62388889),
+    ('AMZ', -37.02970123, 174.9730072),
+    ('ANB', 33.58819962, -85.85810089),
+    ('ANC', 61.17440033, -149.9960022),
+    ('AND', 34.49459839, -82.70939636),
+    ('ANE', 47.56029892, -0.312222004),
+    ('ANF', -23.44449997, -70.44509888),
+    ('ANG', 45.72919846, 0.221456006),
+    ('ANI', 61.58160019, -159.5429993),
+    ('ANJ', -2.849999905, 13.81700039),
+    ('ANK', 39.94979858, 32.68859863),
+    ('ANL', -11.47229958, 16.71089935),
+    ('ANM', -14.99940014, 50.32020187),
+    ('ANN', 55.04240036, -131.5720062),
+    ('ANO', -16.18186951, 39.94521713),
+    ('ANP', 38.94290161, -76.56839752),
+    ('ANQ', 41.63970184, -85.08350372),
+    ('ANR', 51.18939972, 4.460279942),
+    ('ANS', -13.70639992, -73.35040283),
+    ('ANU', 17.1367, -61.792702),
+    ('ANV', 62.64670181, -160.1909943),
+    ('ANW', 42.57920074, -99.99299622),
+    ('ANX', 69.29250336, 16.14419937),
+    ('ANY', 37.15850067, -98.07959747),
+    ('ANZ', -25.0325, 132.2748),
+    ('AOB', -4.904166667, 144.6358333),
+    ('AOC', 50.98194504, 12.50638866),
+    ('AOD', 11.4773, 19.2874),
+    ('AOE', 39.80989838, 30.51939964),
+    ('AOG', 41.1053009, 122.8539963),
+    ('AOH', 40.70690155, -84.02670288),
+    ('AOI', 43.61629868, 13.36229992),
+    ('AOJ', 40.73469925, 140.6909943),
+    ('AOK', 35.42139816, 27.14599991),
+    ('AOL', -29.6894, -57.1521),
+    ('AOM', 22.49194444, 57.38388889),
+    ('AOO', 40.29639816, -78.31999969),
+    ('AOP', -2.796129942, -76.46659851),
+    ('AOR', 6.189670086, 100.3980026),
+    ('AOT', 45.73849869, 7.368720055),
+    ('AOU', 14.81471536, 106.8216991),
+    ('AOY', 27.48139954, 52.6155014),
+    ('APA', 39.57009888, -104.848999),
+    ('APB', -14.73560047, -68.41190338),
+    ('APC', 38.21319962, -122.2809982),
+    ('APE', -15.35389996, -75.167099),
+    ('APF', 26.15259933, -81.77529907),
+    ('APG', 39.46620178, -76.16880035),
+    ('APH', 38.06890106, -77.31829834),
+    ('API', 4.06889, -73.051903),
+    ('APK', -15.57359982, -146.4149933),
+    ('APL', -15.10560036, 39.28179932),
+    ('APN', 45.0780983, -83.56030273),
+    ('APO', 7.033, -77.199997),
+    ('APP', -8.979166667, 148.1036111),
+    ('APQ', -9.775360107, -36.62919998),
+    ('APR', -4.676666667, 142.5401389),
+    ('APS', -16.22920036, -48.96429825),
+    ('APT', 35.06069946, -85.58529663),
+    ('APU', -23.60950089, -51.3844986),
+    ('APV', 34.57529831, -117.185997),
+    ('APW', -13.82999992, -172.0079956),
+    ('APX', -23.35289955, -51.49169922),
+    ('APY', -9.083610535, -45.95055771),
+    ('APZ', -38.9754982, -70.11360168),
+    ('AQA', -21.81200027, -48.13299942),
+    ('AQB', 15.01220036, -91.15059662),
+    ('AQG', 30.5821991, 117.0500031),
+    ('AQI', 28.33519936, 46.12509918),
+    ('AQJ', 29.61160088, 35.01810074),
+    ('AQM', -10.17805576, -62.82555771),
+    ('AQP', -16.34110069, -71.58309937),
+    ('AQY', 60.96609879, -149.1260071),
+    ('ARA', 30.03779984, -91.8839035),
+    ('ARB', 42.22299957, -83.74559784),
+    ('ARC', 68.11470032, -145.5789948),
+    ('ARD', -8.132340431, 124.5970001),
+    ('ARE', 18.45000076, -66.6753006),
+    ('ARF', 0.533333, -70.133333),
+    ('ARG', 36.1246667, -90.9251111),
+    ('ARH', 64.60030365, 40.71670151),
+    ('ARI', -18.3484993, -70.33869934),
+    ('ARJ', -2.93333292, 140.7833405),
+    ('ARK', -3.367789984, 36.63330078),
+    ('ARL', 11.59700012, 1.48300004),
+    ('ARM', -30.52809906, 151.6170044),
+    ('ARN', 59.65190125, 17.91860008),
+    ('ARO', 8.85705, -76.4244),
+    ('ARP', -9.883333333, 149.4833333),
+    ('ARQ', 7.02106, -71.388901),
+    ('ARR', -45.0135994, -70.81220245),
+    ('ARS', -15.89939976, -52.24110031),
+    ('ART', 43.9919014, -76.021698),
+    ('ARU', -21.1413002, -50.42470169),
+    ('ARV', 45.92789841, -89.73090363),
+    ('ARW', 46.17660141, 21.26199913),
+    ('ARY', -37.30939865, 142.9889984),
+    ('ARZ', -7.259439945, 12.86310005),
+    ('ASA', 13.07180023, 42.64500046),
+    ('ASB', 37.98680115, 58.36100006),
+    ('ASC', -15.93029976, -63.15670013),
+    ('ASD', 24.69790077, -77.79560089),
+    ('ASE', 39.22320175, -106.8690033),
+    ('ASF', 46.28329849, 48.00630188),
+    ('ASG', -43.90330124, 171.7969971),
+    ('ASH', 42.78170013, -71.51480103),
+    ('ASI', -7.969600201, -14.39369965),
+    ('ASJ', 28.43059921, 129.7129974),
+    ('ASK', 6.903170109, -5.365580082),
+    ('ASL', 32.52050018, -94.30780029),
+    ('ASM', 15.29189968, 38.91070175),
+    ('ASN', 33.56990051, -86.05090332),
+    ('ASO', 10.01850033, 34.5862999),
+    ('ASP', -23.80669975, 133.9019928),
+    ('ASQ', 39.4679985, -117.1949997),
+    ('ASR', 38.770401, 35.49539948),
+    ('AST', 46.15800095, -123.8789978),
+    ('ASU', -25.23999977, -57.52000046),
+    ('ASV', -2.645050049, 37.25310135),
+    ('ASW', 23.96439934, 32.81999969),
+    ('ASX', 46.54850006, -90.91899872),
+    ('ASY', 46.02389908, -99.3526001),
+    ('ASZ', -6.009722222, 150.3686111),
+    ('ATA', -9.347439766, -77.5983963),
+    ('ATB', 17.71034431, 34.05701828),
+    ('ATC', 24.62940025, -75.67379761),
+    ('ATD', -8.873330116, 161.0110016),
+    ('ATE', 34.19260025, -95.64990234),
+    ('ATF', -1.212069988, -78.57460022),
+    ('ATG', 33.86909866, 72.40090179),
+    ('ATH', 37.93640137, 23.94449997),
+    ('ATI', -30.40069962, -56.50790024),
+    ('ATJ', -19.83922148, 47.06371307),
+    ('ATK', 70.46730042, -157.4360046),
+    ('ATL', 33.63669968, -84.42810059),
+    ('ATM', -3.253910065, -52.25400162),
+    ('ATN', -3.6695, 152.438),
+    ('ATO', 39.21099854, -82.23139954),
+    ('ATP', -3.143611111, 142.3468056),
+    ('ATQ', 31.70960045, 74.79730225),
+    ('ATR', 20.5067997, -13.04319954),
+    ('ATS', 32.85250092, -104.4680023),
+    ('ATT', 60.86669922, -162.272995),
+    ('ATV', 13.23890018, 18.31329918),
+    ('ATW', 44.2580986, -88.51909637),
+    ('ATX', 51.85169983, 68.36329651),
+    ('ATY', 44.91400146, -97.15470123),
+    ('ATZ', 27.04649925, 31.01199913),
+    ('AUA', 12.50139999, -70.01519775),
+    ('AUC', 7.06888, -70.7369),
+    ('AUD', -18.51499939, 139.878006),
+    ('AUE', 28.89900017, 33.20249939),
+    ('AUF', 47.85020065, 3.49710989),
+    ('AUG', 44.3205986, -69.79730225),
+    ('AUH', 24.43300056, 54.65110016),
+    ('AUI', -1.460555556, 143.0647222),
+    ('AUJ', -4.215666667, 142.8231667),
+    ('AUK', 62.68004227, -164.6599274),
+    ('AUL', 8.145279884, 171.1730042),
+    ('AUM', 43.66500092, -92.93340302),
+    ('AUN', 38.95479965, -121.0820007),
+    ('AUO', 32.61510086, -85.43399811),
+    ('AUP', -9.930833333, 149.3856667),
+    ('AUQ', -9.768790245, -139.0110016),
+    ('AUR', 44.89139938, 2.421940088),
+    ('AUS', 30.19449997, -97.66989899),
+    ('AUT', -8.210519791, 125.6159973),
+    ('AUU', -13.35389996, 141.720993),
+    ('AUV', -5.731111111, 148.4452778),
+    ('AUW', 44.92620087, -89.62660217),
+    ('AUX', -7.227869987, -48.2405014),
+    ('AUY', -20.24920082, 169.7709961),
+    ('AUZ', 41.77190018, -88.47570038),
+    ('AVA', 26.26055556, 105.8733333),
+    ('AVB', 46.0318985, 12.5965004),
+    ('AVG', -15.69999981, 130.0),
+    ('AVI', 22.02709961, -78.78959656),
+    ('AVK', 46.25030136, 102.802002),
+    ('AVL', 35.43619919, -82.54180145),
+    ('AVN', 43.90729904, 4.901830196),
+    ('AVO', 27.59119987, -81.52780151),
+    ('AVP', 41.33850098, -75.7233963),
+    ('AVU', -9.868333333, 160.4105556),
+    ('AVV', -38.03939819, 144.4689941),
+    ('AVW', 32.4095993, -111.2180023),
+    ('AWA', 7.066999912, 38.5),
+    ('AWB', -8.006110191, 142.7483521),
+    ('AWD', -19.2346, 169.6009),
+    ('AWE', -0.538055556, 9.450277778),
+    ('AWK', 19.28210068, 166.6360016),
+    ('AWM', 35.13510132, -90.23439789),
+    ('AWN', -26.5333004, 139.2669983),
+    ('AWP', -20.5, 137.75),
+    ('AWZ', 31.33740044, 48.76200104),
+    ('AXA', 18.20479965, -63.05509949),
+    ('AXC', -22.9666996, 145.2420044),
+    ('AXD', 40.85589981, 25.95630074),
+    ('AXE', -26.87555695, -52.3730545),
+    ('AXG', 43.07789993, -94.27200317),
+    ('AXK', 14.55130005, 46.82619858),
+    ('AXL', -19.06019974, 136.7100067),
+    ('AXM', 4.45278, -75.7664),
+    ('AXN', 45.86629868, -95.3946991),
+    ('AXP', 22.44179916, -73.97090149),
+    ('AXR', -15.2482996, -146.6170044),
+    ('AXS', 34.69639969, -99.34030151),
+    ('AXT', 39.61560059, 140.2189941),
+    ('AXU', 14.14680004, 38.77280045),
+    ('AXV', 40.49340057, -84.29889679),
+    ('AXX', 36.42200089, -105.2900009),
+    ('AYA', 8.3, -75.15),
+    ('AYC', 8.6, -73.616667),
+    ('AYD', -19.29080009, 136.0789948),
+    ('AYG', 1.54417, -73.9333),
+    ('AYI', -0.383333, -72.266667),
+    ('AYK', 50.3185997, 66.95279694),
+    ('AYL', -18.01807903, 135.5350685),
+    ('AYN', 36.13389969, 114.3440018),
+    ('AYO', -27.37000084, -56.84999847),
+    ('AYP', -13.15480042, -74.20439911),
+    ('AYQ', -25.18610001, 130.9759979),
+    ('AYR', -19.58440018, 147.3289948),
+    ('AYS', 31.24909973, -82.39550018),
+    ('AYT', 36.89870071, 30.80050087),
+    ('AYU', -6.338055556, 145.9041667),
+    ('AYW', -1.1593, 132.4633),
+    ('AZA', 33.30780029, -111.6549988),
+    ('AZB', -10.29916667, 149.3383333),
+    ('AZD', 31.9048996, 54.2765007),
+    ('AZG', 19.09339905, -102.3939972),
+    ('AZI', 24.42830086, 54.45809937),
+    ('AZL', -13.46552781, -58.86693478),
+    ('AZN', 40.72769928, 72.29399872),
+    ('AZO', 42.23490143, -85.55210114),
+    ('AZP', 19.57480049, -99.2888031),
+    ('AZR', 27.83760071, -0.186414003),
+    ('AZS', 19.2670002, -69.74199677),
+    ('AZT', 6.816667, -73.283333),
+    ('AZZ', -7.86221981, 13.11610031),
+    ('BAA', -5.330560207, 151.0079956),
+    ('BAB', 39.13610077, -121.4369965),
+    ('BAC', 4.583333, -72.966667),
+    ('BAD', 32.50180054, -93.66269684),
+    ('BAE', 44.38830185, 6.610280037),
+    ('BAF', 42.15779877, -72.71559906),
+    ('BAG', 16.37509918, 120.6200027),
+    ('BAH', 26.27079964, 50.63359833),
+    ('BAI', 9.163610458, -83.32990265),
+    ('BAJ', -4.883333333, 149.1333333),
+    ('BAL', 37.92900085, 41.11660004),
+    ('BAM', 40.59899902, -116.8740005),
+    ('BAN', -4.316999912, 20.43300056),
+    ('BAO', 17.38299942, 102.8000031),
+    ('BAQ', 10.8896, -74.7808),
+    ('BAS', -6.990745, 155.886656),
+    ('BAT', -20.58449936, -48.59410095),
+    ('BAU', -22.34499931, -49.05379868),
+    ('BAV', 40.56000137, 109.9970016),
+    ('BAX', 53.36380005, 83.53849792),
+    ('BAY', 47.65840149, 23.46999931),
+    ('BAZ', -0.981292009, -62.91960144),
+    ('BBA', -45.91609955, -71.6894989),
+    ('BBB', 45.33190155, -95.65059662),
+    ('BBC', 28.97330093, -95.8635025),
+    ('BBD', 31.17930031, -99.32389832),
+    ('BBE', -27.32859993, 117.6729965),
+    ('BBG', 3.085829973, 172.8110046),
+    ('BBH', 54.33750153, 12.69970036),
+    ('BBI', 20.24440002, 85.81780243),
+    ('BBJ', 49.94527817, 6.565000057),
+    ('BBK', -17.83289909, 25.16239929),
+    ('BBL', 36.72000122, 52.65000153),
+    ('BBM', 13.09560013, 103.223999),
+    ('BBN', 3.733890057, 115.4789963),
+    ('BBO', 10.38920021, 44.94110107),
+    ('BBP', 50.67810059, -1.109439969),
+    ('BBQ', 17.635799, -61.828602),
+    ('BBR', 16.01329994, -61.74219894),
+    ('BBS', 51.32389832, -0.847500026),
+    ('BBT', 4.221580029, 15.78639984),
+    ('BBU', 44.50320053, 26.10210037),
+    ('BBV', 4.643413067, -6.923961639),
+    ('BBW', 41.43650055, -99.64219666),
+    ('BBX', 40.13750076, -75.26509857),
+    ('BBY', 5.846940041, 20.64749908),
+    ('BBZ', -13.53610039, 23.10849953),
+    ('BCA', 20.36529922, -74.5062027),
+    ('BCB', 37.20759964, -80.40779877),
+    ('BCC', 63.57331604, -156.1494541),
+    ('BCD', 10.77639961, 123.0149994),
+    ('BCE', 37.70640183, -112.1449966),
+    ('BCF', 6.517000198, 18.2670002),
+    ('BCG', 7.699999809, -59.16666031),
+    ('BCH', -8.489029884, 126.401001),
+    ('BCI', -23.56529999, 145.3070068),
+    ('BCK', -17.38829994, 144.1690063),
+    ('BCL', 10.76869965, -83.58560181),
+    ('BCM', 46.52190018, 26.9102993),
+    ('BCN', 41.29710007, 2.078459978),
+    ('BCO', 5.782869816, 36.56200027),
+    ('BCP', -5.863611111, 146.4925),
+    ('BCQ', 27.65299988, 14.27200031),
+    ('BCR', -8.834560394, -67.31240082),
+    ('BCS', 29.86610031, -90.02220154),
+    ('BCT', 26.37849998, -80.10769653),
+    ('BCU', 10.29440022, 9.816670418),
+    ('BCV', 17.26959991, -88.77649689),
+    ('BCW', -21.85330009, 35.43830109),
+    ('BCX', 53.93809891, 58.34000015),
+    ('BCZ', -13.78083333, 136.2016667),
+    ('BDA', 32.36399841, -64.67870331),
+    ('BDB', -24.90390015, 152.3190002),
+    ('BDC', -5.5025, -45.215833),
+    ('BDD', -10.14999962, 142.1734),
+    ('BDE', 48.72840118, -94.61219788),
+    ('BDF', 41.23089981, -89.61569977),
+    ('BDG', 37.58330154, -109.4830017),
+    ('BDH', 26.53199959, 54.82479858),
+    ('BDI', -3.72472, 55.205299),
+    ('BDJ', -3.442359924, 114.7630005),
+    ('BDK', 8.017219543, -2.761940002),
+    ('BDL', 41.93889999, -72.68319702),
+    ('BDM', 40.31800079, 27.97769928),
+    ('BDN', 24.84149933, 68.83840179),
+    ('BDO', -6.900629997, 107.5759964),
+    ('BDP', 26.57080078, 88.07959747),
+    ('BDQ', 22.33620071, 73.2263031),
+    ('BDR', 41.16350174, -73.12619781),
+    ('BDS', 40.6576004, 17.9470005),
+    ('BDT', 4.253210068, 20.97529984),
+    ('BDU', 69.05580139, 18.54039955),
+    ('BDV', -7.066999912, 29.78300095),
+    ('BDW', -17.2866993, 127.4629974),
+    ('BDX', 45.47249985, -105.4540024),
+    ('BDY', 43.08649826, -124.4079971),
+    ('BDZ', -6.347222222, 146.9422222),
+    ('BEB', 57.48109818, -7.362780094),
+    ('BEC', 37.69449997, -97.21499634),
+    ('BED', 42.47000122, -71.28900146),
+    ('BEE', -17.0165, 122.6464),
+    ('BEF', 11.99100018, -83.77410126),
+    ('BEG', 44.81840134, 20.3090992),
+    ('BEH', 42.12860107, -86.42849731),
+    ('BEI', 9.386389732, 34.52190018),
+    ('BEJ', 2.155499935, 117.4319992),
+    ('BEK', 28.42210007, 79.45079803),
+    ('BEL', -1.37925005, -48.47629929),
+    ('BEM', 5.267000198, 17.63299942),
+    ('BEN', 32.09680176, 20.26950073),
+    ('BEO', -33.06669998, 151.647995),
+    ('BEP', 15.16279984, 76.88279724),
+    ('BEQ', 52.34260178, 0.772939026),
+    ('BES', 48.44789886, -4.418540001),
+    ('BET', 60.77980042, -161.8379974),
+    ('BEU', -24.34609985, 139.4600067),
+    ('BEV', 31.28700066, 34.72299957),
+    ('BEW', -19.79640007, 34.9076004),
+    ('BEX', 51.61640167, -1.095829964),
+    ('BEY', 33.82089996, 35.48839951),
+    ('BEZ', -1.354719996, 176.0070038),
+    ('BFA', -20.22459984, -58.17919922),
+    ('BFC', -15.87360001, 145.3300018),
+    ('BFD', 41.80310059, -78.64009857),
+    ('BFE', 51.96472168, 8.544444084),
+    ('BFF', 41.87400055, -103.5960007),
+    ('BFG', 37.54579926, -110.7129974),
+    ('BFH', -25.40509987, -49.23199844),
+    ('BFI', 47.52999878, -122.302002),
+    ('BFJ', -17.53505556, 177.6809167),
+    ('BFK', 39.7016983, -104.7519989),
+    ('BFL', 35.43360138, -119.0569992),
+    ('BFM', 30.62680054, -88.06809998),
+    ('BFN', -29.09269905, 26.30240059),
+    ('BFO', -21.00810051, 31.57859993),
+    ('BFP', 40.77249908, -80.3914032),
+    ('BFQ', 7.583000183, -78.19999695),
+    ('BFR', 38.84000015, -86.44539642),
+    ('BFS', 54.65750122, -6.215829849),
+    ('BFT', 32.41220093, -80.63439941),
+    ('BFU', 32.84773333, 117.3202444),
+    ('BFV', 15.22949982, 103.2529984),
+    ('BFW', 35.17179871, -0.593275011),
+    ('BFX', 5.536920071, 10.35459995),
+    ('BGA', 7.1265, -73.1848),
+    ('BGB', -0.100000001, 11.94999981),
+    ('BGC', 41.85779953, -6.707129955),
+    ('BGD', 35.70090103, -101.3939972),
+    ('BGE', 30.9715004, -84.63739777),
+    ('BGF', 4.398479939, 18.51880074),
+    ('BGG', 38.85926056, 40.59596252),
+    ('BGH', 16.63331413, -14.20000076),
+    ('BGI', 13.07460022, -59.49250031),
+    ('BGJ', 65.5164032, -13.80500031),
+    ('BGK', 16.51936913, -88.40791321),
+    ('BGL', 28.21279907, 83.66629791),
+    ('BGM', 42.20869827, -75.97979736),
+    ('BGN', 51.1996994, 6.132080078),
+    ('BGO', 60.29339981, 5.218140125),
+    ('BGP', -2.1713, 10.2088),
+    ('BGQ', 61.53609848, -149.8139954),
+    ('BGR', 44.80739975, -68.82810211),
+    ('BGS', 32.21260071, -101.5220032),
+    ('BGT', 34.59590149, -113.1699982),
+    ('BGU', 4.784999847, 22.78100014),
+    ('BGV', -29.1483326, -51.5363884),
+    ('BGW', 33.26250076, 44.23460007),
+    ('BGX', -31.39049911, -54.11220169),
+    ('BGY', 45.6739006, 9.704170227),
+    ('BGZ', 41.58710098, -8.445139885),
+    ('BHA', -0.608111024, -80.40270233),
+    ('BHB', 44.45000076, -68.3615036),
+    ('BHC', 33.96099854, 73.45200348),
+    ('BHD', 54.61809921, -5.872499943),
+    ('BHE', -41.5182991, 173.8699951),
+    ('BHF', 6.55, -77.3263),
+    ('BHG', 15.76309967, -84.54360199),
+    ('BHH', 19.9843998, 42.6208992),
+    ('BHI', -38.725, -62.1693),
+    ('BHJ', 23.28779984, 69.67019653),
+    ('BHK', 39.77500153, 64.48329926),
+    ('BHL', 28.97859955, -113.560997),
+    ('BHM', 33.56290054, -86.75350189),
+    ('BHN', 14.78199959, 45.7201004),
+    ('BHO', 23.28750038, 77.33740234),
+    ('BHP', 27.1473999, 87.05079651),
+    ('BHQ', -32.00139999, 141.4720001),
+    ('BHR', 27.67810059, 84.42939758),
+    ('BHS', -33.40940094, 149.6519928),
+    ('BHT', -23.36388889, 141.5627778),
+    ('BHU', 21.75219917, 72.18520355),
+    ('BHV', 29.34810066, 71.71800232),
+    ('BHW', 32.05609894, 72.9484024),
+    ('BHX', 52.45389938, -1.748029947),
+    ('BHY', 21.5394001, 109.2939987),
+    ('BIA', 42.55270004, 9.483730316),
+    ('BIB', 3.102220058, 43.62860107),
+    ('BID', 41.16809845, -71.57779694),
+    ('BIE', 40.30130005, -96.75409698),
+    ('BIF', 31.84950066, -106.3799973),
+    ('BIG', 63.99449921, -145.7220001),
+    ('BIH', 37.37310028, -118.3639984),
+    ('BII', 11.52250004, 165.5650024),
+    ('BIK', -1.190019965, 136.1080017),
+    ('BIL', 45.80770111, -108.5429993),
+    ('BIM', 25.69989967, -79.26470184),
+    ('BIN', 34.81700134, 67.81700134),
+    ('BIO', 43.30110168, -2.910609961),
+    ('BIP', -16.88080025, 143.4790039),
+    ('BIQ', 43.46839905, -1.52331996),
+    ('BIR', 26.48150063, 87.26399994),
+    ('BIS', 46.77270126, -100.7460022),
+    ('BIT', 29.46529961, 80.54920197),
+    ('BIU', 65.64129639, -23.5461998),
+    ('BIV', 6.527780056, 21.98940086),
+    ('BIW', -19.56669998, 127.6669998),
+    ('BIX', 30.41040039, -88.92440033),
+    ('BIY', -32.89709854, 27.27910042),
+    ('BJA', 36.7120018, 5.069920063),
+    ('BJB', 37.49300003, 57.30820084),
+    ('BJC', 39.90879822, -105.1169968),
+    ('BJD', 66.02189636, -14.82439995),
+    ('BJE', 11.19944444, 28.52305556),
+    ('BJF', 70.60050201, 29.69140053),
+    ('BJG', -0.97289598, 122.1449966),
+    ('BJH', 29.53899956, 81.18540192),
+    ('BJI', 47.50939941, -94.93370056),
+    ('BJJ', 40.87480164, -81.88829803),
+    ('BJK', -6.06619978, 134.2740021),
+    ('BJL', 13.3380003, -16.6522007),
+    ('BJM', -3.324019909, 29.31850052),
+    ('BJO', -22.77330017, -64.31289673),
+    ('BJP', -22.979167, -46.5375),
+    ('BJQ', 19.87305556, 56.06777778),
+    ('BJR', 11.60809994, 37.32160187),
+    ('BJU', 29.50200081, 81.66899872),
+    ('BJV', 37.25059891, 27.66430092),
+    ('BJW', -8.70743498, 121.0574269),
+    ('BJX', 20.99349976, -101.4810028),
+    ('BJY', 44.935299, 20.2575),
+    ('BJZ', 38.8913002, -6.82133007),
+    ('BKB', 28.07060051, 73.2071991),
+    ('BKC', 65.9815979, -161.1490021),
+    ('BKD', 32.71900177, -98.89099884),
+    ('BKE', 44.83729935, -117.8089981),
+    ('BKG', 36.532082, -93.200544),
+    ('BKH', 22.02280045, -159.7850037),
+    ('BKI', 5.937210083, 116.0510025),
+    ('BKJ', 10.96580029, -14.28110027),
+    ('BKK', 13.68109989, 100.7470016),
+    ('BKL', 41.51750183, -81.6832962),
+    ('BKM', 3.973999977, 115.6179962),
+    ('BKO', 12.53349972, -7.949940205),
+    ('BKP', -20.49583333, 138.4747222),
+    ('BKQ', -24.42779922, 145.4290009),
+    ('BKR', 12.38300037, 17.06699944),
+    ('BKS', -3.863699913, 102.3389969),
+    ('BKT', 37.07419968, -77.95749664),
+    ('BKU', -23.7329998, 44.38899994),
+    ('BKW', 37.78730011, -81.12419891),
+    ('BKX', 44.30479813, -96.81690216),
+    ('BKY', -2.308979988, 28.80879974),
+    ('BKZ', -1.332, 31.8212),
+    ('BLA', 10.10709953, -64.68920135),
+    ('BLB', 8.914790154, -79.59960175),
+    ('BLC', 5.895277778, 10.03388889),
+    ('BLD', 35.94749832, -114.8610001),
+    ('BLE', 60.42200089, 15.51519966),
+    ('BLF', 37.29579926, -81.20770264),
+    ('BLG', 2.650000095, 113.7669983),
+    ('BLH', 33.61920166, -114.7170029),
+    ('BLI', 48.7928009, -122.538002),
+    ('BLJ', 35.7521019, 6.308589935),
+    ('BLK', 53.77170181, -3.028609991),
+    ('BLL', 55.74029922, 9.151780128),
+    ('BLM', 40.18690109, -74.12490082),
+    ('BLN', -36.55189896, 146.0070038),
+    ('BLO', 65.64499664, -20.28750038),
+    ('BLP', -7.060560226, -76.5821991),
+    ('BLQ', 44.53540039, 11.2887001),
+    ('BLR', 13.19789982, 77.70629883),
+    ('BLS', -28.05830002, 147.4830017),
+    ('BLT', -23.60309982, 148.8070068),
+    ('BLU', 39.27500153, -120.7099991),
+    ('BLV', 38.54520035, -89.83519745),
+    ('BLX', 46.16749954, 12.24790001),
+    ('BLY', 54.22280121, -10.03079987),
+    ('BLZ', -15.67910004, 34.97399902),
+    ('BMA', 59.35440063, 17.94169998),
+    ('BMB', 2.182780027, 22.4817009),
+    ('BMC', 41.55239868, -112.0619965),
+    ('BMD', -19.68670082, 44.54190063),
+    ('BME', -17.94470024, 122.2320023),
+    ('BMF', 5.693999767, 22.8010006),
+    ('BMG', 39.14599991, -86.61669922),
+    ('BMH', -6.373166667, 144.637),
+    ('BMI', 40.47710037, -88.91590118),
+    ('BMJ', 7.370120049, -60.48799896),
+    ('BMK', 53.59638977, 6.709167004),
+    ('BML', 44.57540131, -71.17590332),
+    ('BMM', 2.075639963, 11.4932003),
+    ('BMN', 37.09880066, 43.26660156),
+    ('BMO', 24.2689991, 97.24620056),
+    ('BMP', -20.80330086, 149.2700043),
+    ('BMQ', -3.981909624, 39.7307682),
+    ('BMR', 53.72472382, 7.373332977),
+    ('BMS', -14.2553997, -41.81750107),
+    ('BMT', 30.07069969, -94.21579742),
+    ('BMU', -8.539649963, 118.6869965),
+    ('BMV', 12.66829968, 108.1200027),
+    ('BMW', 21.375, 0.923888981),
+    ('BMX', 59.36119843, -155.2590027),
+    ('BMY', -19.72060013, 163.6609955),
+    ('BMZ', -7.8485, 143.2433),
+    ('BNA', 36.12450027, -86.67819977),
+    ('BNB', -0.216999993, 20.85000038),
+    ('BNC', 0.574999988, 29.47389984),
+    ('BND', 27.21829987, 56.37779999),
+    ('BNE', -27.38419914, 153.1170044),
+    ('BNG', 33.92309952, -116.8509979),
+    ('BNI', 6.316979885, 5.599500179),
+    ('BNJ', 50.76889038, 7.163332939),
+    ('BNK', -28.83390045, 153.5619965),
+    ('BNL', 33.25780106, -81.38829803),
+    ('BNM', -9.107777778, 147.6667222),
+    ('BNN', 65.46109772, 12.21749973),
+    ('BNO', 43.59189987, -118.9550018),
+    ('BNP', 32.97290039, 70.5279007),
+    ('BNR', 10.68299961, -4.717000008),
+    ('BNS', 8.619569778, -70.22080231),
+    ('BNT', -5.742222222, 145.2275),
+    ('BNU', -26.83060074, -49.09030151),
+    ('BNW', 42.04959869, -93.84760284),
+    ('BNX', 44.94139862, 17.29750061),
+    ('BNY', -11.30222222, 159.7983333),
+    ('BOA', -5.854000092, 13.06400013),
+    ('BOB', -16.44440079, -151.7510071),
+    ('BOC', 9.340849876, -82.25080109),
+    ('BOD', 44.82830048, -0.715556026),
+    ('BOE', -1.032999992, 15.38300037),
+    ('BOG', 4.70159, -74.1469),
+    ('BOH', 50.77999878, -1.842499971),
+    ('BOI', 43.56439972, -116.2229996),
+    ('BOJ', 42.56959915, 27.51519966),
+    ('BOK', 42.07460022, -124.2900009),
+    ('BOL', 55.06029892, -7.020279884),
+    ('BOM', 19.08869934, 72.86789703),
+    ('BON', 12.13099957, -68.26850128),
+    ('BOO', 67.26920319, 14.36530018),
+    ('BOP', 5.958000183, 15.63700008),
+    ('BOR', 47.6556015, 7.010829926),
+    ('BOS', 42.36429977, -71.00520325),
+    ('BOT', -7.240833333, 141.0923333),
+    ('BOU', 47.05810165, 2.370280027),
+    ('BOV', -3.3834, 153.2812),
+    ('BOW', 27.94339943, -81.78340149),
+    ('BOX', -16.07530022, 136.302002),
+    ('BOY', 11.16009998, -4.33096981),
+    ('BOZ', 6.344170094, 16.32189941),
+    ('BPC', 6.039239883, 10.1225996),
+    ('BPD', -7.743611111, 147.0205556),
+    ('BPF', -8.562027778, 158.1193056),
+    ('BPG', -15.86130047, -52.38890076),
+    ('BPH', 8.195949554, 126.3219986),
+    ('BPI', 42.58509827, -110.1110001),
+    ('BPK', -5.526388889, 141.7445833),
+    ('BPL', 44.895, 82.3),
+    ('BPM', 17.4531002, 78.46759796),
+    ('BPN', -1.268270016, 116.8939972),
+    ('BPS', -16.43860054, -39.08089828),
+    ('BPT', 29.95079994, -94.02069855),
+    ('BPX', 30.55360031, 97.10829926),
+    ('BPY', -16.7445303, 44.48248386),
+    ('BQA', 15.72980022, 121.5),
+    ('BQB', -33.68842316, 115.4015961),
+    ('BQD', 65.07530212, -21.8003006),
+    ('BQE', 11.29735565, -15.83807945),
+    ('BQG', 52.38, 140.448),
+    ('BQH', 51.3307991, 0.032499999),
+    ('BQI', -18.11809921, 21.62439919),
+    ('BQK', 31.25880051, -81.46649933),
+    ('BQL', -22.91329956, 139.8999939),
+    ('BQN', 18.49489975, -67.12940216),
+    ('BQO', 9.277500153, -3.025279999),
+    ('BQQ', -11.08080006, -43.14749908),
+    ('BQS', 50.42539978, 127.4120026),
+    ('BQT', 52.10829926, 23.8980999),
+    ('BQU', 12.98840046, -61.26200104),
+    ('BQW', -20.14830017, 127.9729996),
+    ('BRA', -12.07890034, -45.00899887),
+    ('BRB', -2.755555556, -42.81),
+    ('BRC', -41.15119934, -71.15750122),
+    ('BRD', 46.39830017, -94.13809967),
+    ('BRE', 53.04750061, 8.786669731),
+    ('BRI', 41.13890076, 16.76059914),
+    ('BRK', -30.03919983, 145.9519958),
+    ('BRL', 40.78319931, -91.12550354),
+    ('BRM', 10.04274654, -69.35861969),
+    ('BRN', 46.91410065, 7.497149944),
+    ('BRO', 25.90679932, -97.42590332),
+    ('BRP', -7.6693, 146.7594),
+    ('BRQ', 49.15129852, 16.69440079),
+    ('BRR', 57.02280045, -7.443059921),
+    ('BRS', 51.38270187, -2.719089985),
+    ('BRT', -11.76920033, 130.6199951),
+    ('BRU', 50.90140152, 4.48443985),
+    ('BRV', 53.50694275, 8.572777748),
+    ('BRW', 71.28540039, -156.7660065),
+    ('BRX', 18.25149918, -71.12039948),
+    ('BRY', 37.81430054, -85.49960327),
+    ('BSA', 11.27530003, 49.1493988),
+    ('BSB', -15.86916733, -47.92083359),
+    ('BSC', 6.20292, -77.3947),
+    ('BSD', 25.05330086, 99.16829681),
+    ('BSE', 1.813609958, 109.7630005),
+    ('BSF', 19.76009941, -155.5540009),
+    ('BSG', 1.905470014, 9.805680275),
+    ('BSI', 40.44472222, -79.28863889),
+    ('BSJ', -37.88750076, 147.5679932),
+    ('BSK', 34.79330063, 5.738230228),
+    ('BSL', 47.58959961, 7.529910088),
+    ('BSM', 36.65510178, 52.34960175),
+    ('BSN', 6.492000103, 17.42900085),
+    ('BSO', 20.45129967, 121.9800034),
+    ('BSP', -8.858055556, 141.2594444),
+    ('BSQ', 31.36400032, -109.8830032),
+    ('BSR', 30.54910088, 47.66210175),
+    ('BSS', -7.526030064, -46.05329895),
+    ('BST', 31.55970001, 64.36499786),
+    ('BSU', 1.224720001, 19.78890038),
+    ('BSV', -15.6725, 47.06166667),
+    ('BSW', 60.42309952, -146.1459961),
+    ('BSX', 16.81520081, 94.7798996),
+    ('BSY', 2.336111, 42.307778),
+    ('BSZ', 58.21620178, -157.352005),
+    ('BTA', 4.54861021, 13.72609997),
+    ('BTB', 3.049999952, 18.5),
+    ('BTC', 7.705760002, 81.67880249),
+    ('BTD', -18.63999939, 135.9380035),
+    ('BTE', 7.532420158, -12.51889992),
+    ('BTF', 40.86940002, -111.927002),
+    ('BTG', 7.314109802, 18.30879974),
+    ('BTH', 1.121029973, 104.1190033),
+    ('BTI', 70.13400269, -143.5820007),
+    ('BTJ', 5.522872024, 95.42063713),
+    ('BTK', 56.37060165, 101.697998),
+    ('BTL', 42.30730057, -85.25150299),
+    ('BTM', 45.95479965, -112.4970016),
+    ('BTN', 34.62170029, -79.73439789),
+    ('BTO', 4.217511094, -55.44705391),
+    ('BTP', 40.77690125, -79.9496994),
+    ('BTQ', -2.595829964, 29.73670006),
+    ('BTR', 30.53319931, -91.14959717),
+    ('BTS', 48.17020035, 17.21269989),
+    ('BTT', 66.91390228, -151.529007),
+    ('BTU', 3.123850107, 113.0199966),
+    ('BTV', 44.47190094, -73.15329742),
+    ('BTW', -3.412410021, 115.9950027),
+    ('BTX', -25.64170074, 140.7830048),
+    ('BTY', 36.86109924, -116.7870026),
+    ('BTZ', 40.23329926, 29.00919914),
+    ('BUA', -5.422319889, 154.6730042),
+    ('BUB', 41.77669907, -99.14969635),
+    ('BUC', -17.74860001, 139.5339966),
+    ('BUD', 47.43690109, 19.25559998),
+    ('BUF', 42.94049835, -78.73220062),
+    ('BUG', -12.60900021, 13.40369987),
+    ('BUI', -3.6822, 138.6755),
+    ('BUJ', 35.33250046, 4.206389904),
+    ('BUK', 17.34670067, 44.62170029),
+    ('BUL', -7.216286671, 146.6495419),
+    ('BUM', 38.28979874, -94.34010315),
+    ('BUN', 3.81963, -76.9898),
+    ('BUO', 9.5275, 45.5549),
+    ('BUP', 30.27009964, 74.75579834),
+    ('BUQ', -20.01740074, 28.61790085),
+    ('BUR', 34.20069885, -118.3590012),
+    ('BUS', 41.61029816, 41.59970093),
+    ('BUV', -30.33333397, -57.08333206),
+    ('BUW', -5.486879826, 122.5690002),
+    ('BUX', 1.565719962, 30.2208004),
+    ('BUY', -33.37829971, 115.677002),
+    ('BUZ', 28.94479942, 50.83459854),
+    ('BVA', 49.45439911, 2.112780094),
+    ('BVB', 2.841388941, -60.6922226),
+    ('BVC', 16.1364994, -22.88890076),
+    ('BVE', 45.03972222, 1.485555556),
+    ('BVG', 70.87139893, 29.03420067),
+    ('BVH', -12.69439983, -60.09830093),
+    ('BVI', -25.89749908, 139.3480072),
+    ('BVK', -13.55000019, -63.7478981),
+    ('BVL', -13.58333302, -63.58333206),
+    ('BVM', -15.87170029, -38.87189865),
+    ('BVO', 36.76250076, -96.01119995),
+    ('BVR', 14.86430556, -24.746),
+    ('BVS', -1.636530042, -50.4435997),
+    ('BVU', 61.17219925, -151.0440063),
+    ('BVV', 44.91999817, 147.621994),
+    ('BVW', -12.65919971, 142.6750031),
+    ('BVX', 35.7262001, -91.64730072),
+    ('BVY', 42.58420181, -70.91649628),
+    ('BVZ', -16.73329926, 125.4329987),
+    ('BWA', 27.50569916, 83.41629791),
+    ('BWB', -20.86440086, 115.4059982),
+    ('BWC', 32.99290085, -115.5169983),
+    ('BWD', 31.79360008, -98.95649719),
+    ('BWE', 52.31919861, 10.55609989),
+    ('BWF', 54.13119888, -3.263669968),
+    ('BWG', 36.96450043, -86.41970062),
+    ('BWH', 5.465919971, 100.3909988),
+    ('BWI', 39.17539978, -76.66829681),
+    ('BWJ', -6.396944444, 146.8816667),
+    ('BWK', 43.28570175, 16.67970085),
+    ('BWL', 36.79589844, -97.31700134),
+    ('BWM', 46.18700027, -103.4280014),
+    ('BWN', 4.944200039, 114.9280014),
+    ('BWO', 51.85829926, 47.74560165),
+    ('BWP', -3.021666667, 141.1652778),
+    ('BWQ', -29.97389984, 146.8170013),
+    ('BWT', -40.99890137, 145.7310028),
+    ('BWU', -33.92440033, 150.9880066),
+    ('BWW', 22.62129974, -79.14720154),
+    ('BXA', 30.81369972, -89.86499786),
+    ('BXB', -2.532239914, 133.4389954),
+    ('BXD', -7.714630127, 139.5749969),
+    ('BXE', 14.84729958, -12.46829987),
+    ('BXF', -17.545, 128.305),
+    ('BXG', -36.73939896, 144.3300018),
+    ('BXH', 46.8932991, 75.00499725),
+    ('BXI', 9.532999992, -6.467000008),
+    ('BXJ', 43.3526001, 76.88369751),
+    ('BXK', 33.42039871, -112.685997),
+    ('BXM', -4.166669846, 140.8500061),
+    ('BXN', 37.14009857, 27.66970062),
+    ('BXO', 46.97444444, 8.396944444),
+    ('BXP', 52.00579834, 23.14360046),
+    ('BXR', 29.08419991, 58.45000076),
+    ('BXS', 33.25899887, -116.3209991),
+    ('BXT', 0.119690999, 117.4749985),
+    ('BXU', 8.9515, 125.4788),
+    ('BXV', 64.79000092, -14.02280045),
+    ('BXX', 9.9463, 43.1495),
+    ('BXZ', -5.7243, 147.8667),
+    ('BYA', 64.07830048, -141.1130066),
+    ('BYB', 25.6142292, 56.24440002),
+    ('BYC', -21.96089935, -63.65169907),
+    ('BYF', 49.9715004, 2.697659969),
+    ('BYG', 44.3810997, -106.7220001),
+    ('BYH', 35.96429825, -89.94400024),
+    ('BYI', 42.54259872, -113.7720032),
+    ('BYJ', 38.07889938, -7.932400227),
+    ('BYK', 7.738800049, -5.07366991),
+    ('BYL', 7.369166667, -9.993722222),
+    ('BYM', 20.39640045, -76.62139893),
+    ('BYN', 46.16329956, 100.7040024),
+    ('BYO', -21.2473, -56.4525),
+    ('BYQ', 3.455719948, 117.8669968),
+    ('BYR', 57.27719879, 11.00010014),
+    ('BYS', 35.2804985, -116.6299973),
+    ('BYT', 51.66859818, -9.48416996),
+    ('BYU', 49.98500061, 11.64000034),
+    ('BYW', 48.57899857, -122.8259964),
+    ('BYX', -13.19810009, 136.227005),
+    ('BZA', 13.94999981, -84.59999847),
+    ('BZB', -21.54109955, 35.47290039),
+    ('BZC', -22.77099991, -41.96289825),
+    ('BZD', -34.62360001, 143.5780029),
+    ('BZE', 17.53910065, -88.30819702),
+    ('BZF', 40.57490158, -122.4079971),
+    ('BZG', 53.09680176, 17.97769928),
+    ('BZH', -16.81699944, 28.35000038),
+    ('BZI', 39.61930084, 27.9260006),
+    ('BZK', 53.21419907, 34.17639923),
+    ('BZL', 22.8010006, 90.30120087),
+    ('BZM', -17.6925, 45.08883333),
+    ('BZN', 45.77750015, -111.1529999),
+    ('BZO', 46.46020126, 11.3263998),
+    ('BZP', -14.74027778, 144.1194444),
+    ('BZR', 43.32350159, 3.353899956),
+    ('BZU', 2.818350077, 24.79369926),
+    ('BZV', -4.251699924, 15.25300026),
+    ('BZY', 47.83810043, 27.78149986),
+    ('BZZ', 51.75, -1.583619952),
+    ('CAA', 14.91699982, -85.90000153),
+    ('CAB', -5.596990108, 12.18840027),
+    ('CAC', -25.00029945, -53.50080109),
+    ('CAD', 44.27529907, -85.41889954),
+    ('CAE', 33.93880081, -81.11949921),
+    ('CAF', -4.871520042, -66.89749908),
+    ('CAG', 39.25149918, 9.054280281),
+    ('CAH', 9.177666667, 105.1777778),
+    ('CAI', 30.12190056, 31.40559959),
+    ('CAJ', 6.231988907, -62.85443115),
+    ('CAK', 40.91609955, -81.44219971),
+    ('CAL', 55.43719864, -5.686389923),
+    ('CAM', -20.00639915, -63.52780151),
+    ('CAN', 23.39240074, 113.2990036),
+    ('CAO', 36.44620132, -103.1669998),
+    ('CAP', 19.7329998, -72.19470215),
+    ('CAQ', 7.96847, -75.1985),
+    ('CAR', 46.87149811, -68.01789856),
+    ('CAT', 24.31529999, -75.45230103),
+    ('CAU', -8.282389641, -36.01350021),
+    ('CAV', -11.89309978, 22.91640091),
+    ('CAW', -21.69829941, -41.30170059),
+    ('CAX', 54.9375, -2.809170008),
+    ('CAY', 4.819809914, -52.36040115),
+    ('CAZ', -31.53829956, 145.7940063),
+    ('CBB', -17.42110062, -66.17710114),
+    ('CBC', -18.91777778, 125.5377778),
+    ('CBD', 9.152509689, 92.81960297),
+    ('CBE', 39.61539841, -78.7609024),
+    ('CBF', 41.25920105, -95.76059723),
+    ('CBG', 52.20500183, 0.174999997),
+    ('CBH', 31.64570045, -2.269860029),
+    ('CBI', -40.39170074, 148.0169983),
+    ('CBJ', 17.92900085, -71.64479828),
+    ('CBK', 39.42750168, -101.0469971),
+    ('CBL', 8.122160912, -63.53695679),
+    ('CBM', 33.64379883, -88.44380188),
+    ('CBN', -6.756140232, 108.5400009),
+    ('CBO', 7.165239811, 124.2099991),
+    ('CBP', 40.15719986, -8.470000267),
+    ('CBQ', 4.976019859, 8.347200394),
+    ('CBR', -35.30690002, 149.1950073),
+    ('CBS', 10.33069992, -71.32250214),
+    ('CBT', -12.47920036, 13.48690033),
+    ('CBU', 51.88944244, 14.53194427),
+    ('CBV', 15.46899986, -90.40670013),
+    ('CBW', -24.00919914, -52.35680008),
+    ('CBX', -33.06439972, 147.2089996),
+    ('CBY', -19.47940063, 140.927002),
+    ('CCB', 34.11159897, -117.6880035),
+    ('CCC', 22.46100044, -78.32839966),
+    ('CCF', 43.2159996, 2.306319952),
+    ('CCG', 31.4151001, -102.362999),
+    ('CCH', -46.58330154, -71.68740082),
+    ('CCI', -27.18059921, -52.05270004),
+    ('CCJ', 11.13679981, 75.95529938),
+    ('CCK', -12.18830013, 96.83390045),
+    ('CCL', -26.77499962, 150.6170044),
+    ('CCM', -28.72444344, -49.42139053),
+    ('CCN', 34.53300095, 65.26699829),
+    ('CCO', 4.56417, -71.3364),
+    ('CCP', -36.77270126, -73.06310272),
+    ('CCR', 37.98970032, -122.0569992),
+    ('CCS', 10.60311699, -66.99058533),
+    ('CCU', 22.65469933, 88.44670105),
+    ('CCV', -16.26499939, 167.923996),
+    ('CCW', -33.66669846, 136.8919983),
+    ('CCX', -16.04360008, -57.62990189),
+    ('CCY', 43.07260132, -92.6108017),
+    ('CCZ', 25.41710091, -77.88089752),
+    ('CDA', -12.90330029, 132.5319977),
+    ('CDB', 55.20610046, -162.7250061),
+    ('CDC', 37.70100021, -113.098999),
+    ('CDD', 15.3166666, -83.59166718),
+    ('CDF', 46.57300186, 12.11800003),
+    ('CDG', 49.01279831, 2.549999952),
+    ('CDH', 33.62279892, -92.76339722),
+    ('CDI', -20.83429909, -41.18560028),
+    ('CDJ', -8.348349571, -49.30149841),
+    ('CDK', 29.13525676, -83.04839015),
+    ('CDL', 65.90769959, -161.9259949),
+    ('CDN', 34.28359985, -80.56490326),
+    ('CDO', -32.15670013, 25.64559937),
+    ('CDP', 14.51000023, 78.77279663),
+    ('CDQ', -18.22500038, 142.2579956),
+    ('CDR', 42.83760071, -103.0950012),
+    ('CDS', 34.43379974, -100.288002),
+    ('CDT', 39.99919891, 0.026110999),
+    ('CDU', -34.04029846, 150.6869965),
+    ('CDV', 60.4917984, -145.4779968),
+    ('CDW', 40.87519836, -74.28140259),
+    ('CDY', 7.013999939, 118.4960022),
+    ('CEA', 37.64860153, -97.25060272),
+    ('CEB', 10.30749989, 123.9789963),
+    ('CEC', 41.78020096, -124.2369995),
+    ('CED', -32.13059998, 133.7100067),
+    ('CEE', 59.27360153, 38.01580048),
+    ('CEF', 42.19400024, -72.53479767),
+    ('CEG', 53.17810059, -2.977780104),
+    ('CEH', -10.55000019, 33.79999924),
+    ('CEI', 19.95229912, 99.88289642),
+    ('CEK', 55.30580139, 61.50329971),
+    ('CEM', 65.57379913, -144.7830048),
+    ('CEN', 27.39259911, -109.8330002),
+    ('CEO', -11.42640018, 15.10140038),
+    ('CEP', -16.13829994, -62.02859879),
+    ('CEQ', 43.54199982, 6.953479767),
+    ('CER', 49.65010071, -1.470280051),
+    ('CES', -32.78749847, 151.3419952),
+    ('CET', 47.08209991, -0.87706399),
+    ('CEU', 34.6719017, -82.8864975),
+    ('CEV', 39.69850159, -85.12969971),
+    ('CEW', 30.77879906, -86.52210236),
+    ('CEX', 65.05180359, -146.0469971),
+    ('CEY', 36.66460037, -88.37280273),
+    ('CEZ', 37.3030014, -108.6279984),
+    ('CFB', -22.92169952, -42.07429886),
+    ('CFC', -26.78840065, -50.93980026),
+    ('CFD', 30.71570015, -96.33139801),
+    ('CFE', 45.7867012, 3.169169903),
+    ('CFF', -8.783610344, 17.98970032),
+    ('CFG', 22.14999962, -80.41419983),
+    ('CFH', -27.0182991, 138.8919983),
+    ('CFI', -17.02169991, 131.3269958),
+    ('CFK', 36.21269989, 1.331769943),
+    ('CFN', 55.0442009, -8.340999603),
+    ('CFO', -10.63440037, -51.56359863),
+    ('CFP', -18.7166996, 144.3170013),
+    ('CFR', 49.1733017, -0.449999988),
+    ('CFS', -30.32060051, 153.1159973),
+    ('CFT', 32.95280075, -109.2099991),
+    ('CFU', 39.60189819, 19.9116993),
+    ('CFV', 37.09400177, -95.57189941),
+    ('CGB', -15.65289974, -56.11669922),
+    ('CGC', -5.458965268, 148.4324169),
+    ('CGD', 28.91889954, 111.6399994),
+    ('CGE', 38.53929901, -76.03040314),
+    ('CGF', 41.56510162, -81.48639679),
+    ('CGG', 16.1941, 122.0651),
+    ('CGH', -23.62611008, -46.65638733),
+    ('CGI', 37.22529984, -89.57080078),
+    ('CGJ', -12.51669979, 27.88330078),
+    ('CGK', -6.12556982, 106.6559982),
+    ('CGM', 9.253520012, 124.7070007),
+    ('CGN', 50.86589813, 7.142739773),
+    ('CGO', 34.5196991, 113.8410034),
+    ('CGP', 22.24959946, 91.81330109),
+    ('CGQ', 43.99620056, 125.6849976),
+    ('CGR', -20.46870041, -54.67250061),
+    ('CGS', 38.98059845, -76.92230225),
+    ('CGT', 20.5055, -12.3978),
+    ('CGV', -32.26499939, 125.4929962),
+    ('CGY', 8.612203, 124.456496),
+    ('CHA', 35.03530121, -85.20379639),
+    ('CHB', 35.42670059, 74.08170319),
+    ('CHC', -43.48939896, 172.5319977),
+    ('CHE', 51.93333435, -10.23333263),
+    ('CHF', 35.14120102, 128.6959991),
+    ('CHG', 41.5381012, 120.4349976),
+    ('CHH', -6.201809883, -77.85610199),
+    ('CHJ', -20.20669937, 32.62829971),
+    ('CHK', 35.09740067, -97.96769714),
+    ('CHL', 44.52299881, -114.2180023),
+    ('CHM', -9.149609566, -78.52380371),
+    ('CHN', 35.8783989, 127.1200027),
+    ('CHO', 38.1385994, -78.45290375),
+    ('CHP', 65.48549652, -144.6109924),
+    ('CHQ', 35.53170013, 24.14970016),
+    ('CHR', 46.86220169, 1.730669975),
+    ('CHS', 32.89860153, -80.04049683),
+    ('CHT', -43.81000137, -176.4570007),
+    ('CHU', 61.57910156, -159.2160034),
+    ('CHV', 41.72232437, -7.463150024),
+    ('CHX', 9.458640099, -82.51679993),
+    ('CHY', -6.711944, 156.396111),
+    ('CHZ', 42.57944049, -121.8790627),
+    ('CIA', 41.79940033, 12.59490013),
+    ('CIB', 33.4048996, -118.4160004),
+    ('CIC', 39.79539871, -121.8580017),
+    ('CID', 41.88470078, -91.71080017),
+    ('CIE', -33.36669922, 116.2170029),
+    ('CIF', 42.23500061, 118.9079971),
+    ('CIG', 40.49520111, -107.5220032),
+    ('CIH', 36.24750137, 113.1259995),
+    ('CII', 37.81499863, 27.89529991),
+    ('CIJ', -11.04039955, -68.78299713),
+    ('CIK', 66.64499664, -143.7400055),
+    ('CIL', 64.89790344, -163.7030029),
+    ('CIM', 6.367, -73.967),
+    ('CIN', 42.0461998, -94.78900146),
+    ('CIO', -23.44000053, -57.43000031),
+    ('CIP', -13.55830002, 32.58720016),
+    ('CIQ', 14.83092, -89.520938),
+    ('CIR', 37.0644989, -89.21959686),
+    ('CIS', -2.76812005, -171.7100067),
+    ('CIT', 42.36420059, 69.47889709),
+    ('CIU', 46.25080109, -84.47239685),
+    ('CIW', 12.69900036, -61.3423996),
+    ('CIX', -6.787479877, -79.82810211),
+    ('CIY', 36.99460102, 14.60718155),
+    ('CIZ', -4.134059906, -63.13259888),
+    ('CJA', -7.139180183, -78.48940277),
+    ('CJB', 11.02999973, 77.04340363),
+    ('CJC', -22.49819946, -68.9036026),
+    ('CJH', 51.62609863, -124.1419983),
+    ('CJJ', 36.71659851, 127.4990005),
+    ('CJL', 35.88660049, 71.80059814),
+    ('CJM', 10.71119976, 99.36170197),
+    ('CJS', 31.63610077, -106.4290009),
+    ('CJT', 16.17670059, -92.05059814),
+    ('CJU', 33.51129913, 126.4929962),
+    ('CKA', 36.7439003, -98.12310028),
+    ('CKB', 39.29660034, -80.22810364),
+    ('CKC', 49.41559982, 31.99530029),
+    ('CKD', 61.86790085, -158.1349945),
+    ('CKG', 29.71920013, 106.6419983),
+    ('CKH', 70.62310028, 147.9019928),
+    ('CKI', -11.16499996, 132.4830017),
+    ('CKL', 55.87829971, 38.06169891),
+    ('CKM', 34.29970169, -90.51229858),
+    ('CKN', 47.84170151, -96.62159729),
+    ('CKO', -23.15250015, -50.60250092),
+    ('CKR', 48.5978, -122.9979),
+    ('CKS', -6.115277767, -50.00138855),
+    ('CKT', 36.50120163, 61.06489944),
+    ('CKU', 60.54389954, -145.727005),
+    ('CKV', 36.62189865, -87.41500092),
+    ('CKX', 64.07129669, -141.9519959),
+    ('CKY', 9.57689, -13.612),
+    ('CKZ', 40.13769913, 26.42679977),
+    ('CLA', 23.43720055, 91.18969727),
+    ('CLB', 53.84840012, -9.280369759),
+    ('CLD', 33.12829971, -117.2799988),
+    ('CLE', 41.4117012, -81.84980011),
+    ('CLG', 36.1631012, -120.2939987),
+    ('CLH', -31.77330017, 149.6100006),
+    ('CLI', 44.61380005, -88.73130035),
+    ('CLJ', 46.78519821, 23.68619919),
+    ('CLK', 35.53829956, -98.93270111),
+    ('CLL', 30.58860016, -96.36380005),
+    ('CLM', 48.12020111, -123.5),
+    ('CLN', -7.320439816, -47.45869827),
+    ('CLO', 3.54322, -76.3816),
+    ('CLP', 58.83369827, -158.529007),
+    ('CLQ', 19.27700043, -103.5770035),
+    ('CLR', 33.13150024, -115.5210037),
+    ('CLS', 46.67699814, -122.9830017),
+    ('CLT', 35.2140007, -80.94309998),
+    ('CLU', 39.26190186, -85.89630127),
+    ('CLV', -17.72529984, -48.60749817),
+    ('CLW', 27.97669983, -82.75869751),
+    ('CLX', -25.3036, -57.7344),
+    ('CLY', 42.53079987, 8.793190002),
+    ('CLZ', 8.924655914, -67.41709137),
+    ('CMA', -28.03000069, 145.621994),
+    ('CMB', 7.180759907, 79.88410187),
+    ('CMC', -2.896179914, -40.85800171),
+    ('CMD', -34.62390137, 148.0279999),
+    ('CME', 18.65369987, -91.7990036),
+    ('CMF', 45.63809967, 5.88022995),
+    ('CMG', -19.01194382, -57.67139053),
+    ('CMH', 39.9980011, -82.89189911),
+    ('CMI', 40.03919983, -88.27809906),
+    ('CMJ', 23.21310043, 119.4179993),
+    ('CMK', -14.30690002, 35.13249969),
+    ('CML', -19.9116993, 138.125),
+    ('CMM', 17.46120071, -90.05370331),
+    ('CMN', 33.36750031, -7.589970112),
+    ('CMO', 5.366666794, 48.51666641),
+    ('CMP', -9.319970131, -50.32849884),
+    ('CMQ', -22.7730999, 147.6210022),
+    ('CMR', 48.10990143, 7.35901022),
+    ('CMS', 10.30000019, 50.23300171),
+    ('CMU', -6.024290085, 144.970993),
+    ('CMV', -36.79169846, 175.5090027),
+    ('CMW', 21.42029953, -77.84750366),
+    ('CMX', 47.16839981, -88.4890976),
+    ('CMZ', -17.8331, 35.3341),
+    ('CNA', 31.06615015, -110.097878),
+    ('CNB', -30.98329926, 148.3760071),
+    ('CNC', -10.05000019, 143.0700073),
+    ('CND', 44.36220169, 28.48830032),
+    ('CNE', 38.4280014, -105.1060028),
+    ('CNF', -19.62444305, -43.9719429),
+    ('CNG', 45.65829849, -0.317499995),
+    ('CNH', 43.37039948, -72.36869812),
+    ('CNI', 39.26666667, 122.6669444),
+    ('CNJ', -20.66860008, 140.5039978),
+    ('CNK', 39.54930115, -97.65229797),
+    ('CNL', 57.50350189, 10.22939968),
+    ('CNM', 32.33750153, -104.2630005),
+    ('CNN', 56.91389847, 124.9140015),
+    ('CNO', 33.97470093, -117.637001),
+    ('CNP', 70.74310303, -22.65049934),
+    ('CNQ', -27.4455, -58.7619),
+    ('CNR', -26.33250046, -70.6072998),
+    ('CNS', -16.88579941, 145.7550049),
+    ('CNT', -27.2164, -61.2103),
+    ('CNU', 37.66880035, -95.48509979),
+    ('CNV', -15.66699982, -38.95470047),
+    ('CNW', 31.63780022, -97.07409668),
+    ('CNX', 18.76679993, 98.96260071),
+    ('CNY', 38.75500107, -109.7549973),
+    ('CNZ', -13.7105999, 19.86109924),
+    ('COA', 38.03039932, -120.4150009),
+    ('COB', -15.54829979, 130.9620056),
+    ('COC', -31.2969, -57.9966),
+    ('COD', 44.52019882, -109.0240021),
+    ('COE', 47.77429962, -116.8199997),
+    ('COF', 28.23489952, -80.61009979),
+    ('COG', 5.08333, -76.7),
+    ('COH', 26.33049965, 89.46720123),
+    ('COI', 28.34160042, -80.6855011),
+    ('COJ', -31.33250046, 149.2669983),
+    ('COK', 10.15200043, 76.40190125),
+    ('COL', 56.60189819, -6.617780209),
+    ('COM', 31.84110069, -99.4036026),
+    ('CON', 43.20270157, -71.50229645),
+    ('COO', 6.357230186, 2.384350061),
+    ('COP', 42.62919998, -74.89099884),
+    ('COQ', 48.13570023, 114.6460037),
+    ('COR', -31.32360077, -64.20800018),
+    ('COS', 38.80580139, -104.7009964),
+    ('COT', 28.45669937, -99.22029877),
+    ('COU', 38.81809998, -92.21959686),
+    ('COV', 40.26480103, -7.479959965),
+    ('COW', -30.198889, -71.246944),
+    ('COX', 24.15870094, -77.58979797),
+    ('COY', -21.7946, 117.755),
+    ('COZ', 18.90749931, -70.72190094),
+    ('CPA', 4.379020214, -7.696949959),
+    ('CPB', 8.63333, -77.35),
+    ('CPC', -40.07540131, -71.13729858),
+    ('CPD', -29.04000092, 134.720993),
+    ('CPE', 19.81679916, -90.50029755),
+    ('CPF', -7.194841667, 111.5481667),
+    ('CPG', -40.7781, -62.9803),
+    ('CPH', 55.61790085, 12.65600014),
+    ('CPI', -5.448333333, 152.0819444),
+    ('CPL', 3.717, -75.467),
+    ('CPM', 33.88999939, -118.2440033),
+    ('CPN', -10.179823, 148.3790302),
+    ('CPO', -27.26119995, -70.77919769),
+    ('CPQ', -22.85919952, -47.10820007),
+    ('CPR', 42.90800095, -106.4639969),
+    ('CPS', 38.5707016, -90.15619659),
+    ('CPT', -33.96480179, 18.60169983),
+    ('CPU', -1.821111, -44.866944),
+    ('CPV', -7.269919872, -35.89640045),
+    ('CPX', 18.313289, -65.304324),
+    ('CQA', -13.57444382, -52.2705574),
+    ('CQD', 32.29719925, 50.84220123),
+    ('CQF', 50.96210098, 1.954759955),
+    ('CQP', -14.970832, 145.311667),
+    ('CQS', -12.42109966, -64.25160217),
+    ('CQT', 2.033333, -74.216667),
+    ('CRA', 44.31809998, 23.8885994),
+    ('CRB', -29.52169991, 148.5820007),
+    ('CRC', 4.75818, -75.9557),
+    ('CRD', -45.7853, -67.4655),
+    ('CRE', 33.81169891, -78.72389984),
+    ('CRF', 4.936999798, 15.89400005),
+    ('CRG', 30.3362999, -81.51439667),
+    ('CRH', -28.4301, 152.08895),
+    ('CRI', 22.74559975, -74.18240356),
+    ('CRJ', -31.89439964, 132.2960052),
+    ('CRK', 15.18599987, 120.5599976),
+    ('CRL', 50.45920181, 4.453820229),
+    ('CRM', 12.5024004, 124.6360016),
+    ('CRO', 36.10250092, -119.5950012),
+    ('CRP', 27.77039909, -97.50119781),
+    ('CRQ', -17.65229988, -39.25310135),
+    ('CRR', -29.8631, -61.8728),
+    ('CRS', 32.02809906, -96.40059662),
+    ('CRT', 33.17829895, -91.88020325),
+    ('CRU', 12.47609997, -61.47280121),
+    ('CRV', 38.99720001, 17.0802002),
+    ('CRW', 38.37310028, -81.59320068),
+    ('CRX', 34.91500092, -88.60350037),
+    ('CRY', -15.50189972, 128.5339966),
+    ('CRZ', 39.08330154, 63.61330032),
+    ('CSA', 56.05749893, -6.243060112),
+    ('CSB', 45.41999817, 22.25329971),
+    ('CSC', 10.4307003, -85.17459869),
+    ('CSD', -17.94799995, 135.9160004),
+    ('CSE', 38.85189819, -106.93282),
+    ('CSF', 49.25350189, 2.519140005),
+    ('CSG', 32.5163002, -84.93890381),
+    ('CSH', 65.02999878, 35.73333359),
+    ('CSI', -28.88279915, 153.0670013),
+    ('CSK', 12.41020012, -16.74609947),
+    ('CSM', 35.33980179, -99.20050049),
+    ('CSN', 39.19219971, -119.7340012),
+    ('CSO', 51.85639954, 11.42029953),
+    ('CSQ', 41.02140045, -94.36329651),
+    ('CSR', 5.833333, -68.133333),
+    ('CSS', -19.14638901, -51.68527985),
+    ('CSU', -29.6840992, -52.41220093),
+    ('CSV', 35.95130157, -85.08499908),
+    ('CSX', 28.18919945, 113.2200012),
+    ('CSY', 56.09030151, 47.34730148),
+    ('CSZ', -37.44609833, -61.8893013),
+    ('CTA', 37.46680069, 15.06639957),
+    ('CTB', 48.60839844, -112.3759995),
+    ('CTC', -28.59560013, -65.75170136),
+    ('CTD', 7.987840176, -80.40969849),
+    ('CTE', 9.313469887, -79.10160065),
+    ('CTF', 14.69419956, -91.88249969),
+    ('CTG', 10.4424, -75.513),
+    ('CTH', 39.97900009, -75.8655014),
+    ('CTI', -15.16030025, 19.15609932),
+    ('CTL', -26.41329956, 146.2619934),
+    ('CTM', 18.50469971, -88.32679749),
+    ('CTN', -15.44470024, 145.1840057),
+    ('CTO', 40.9151001, -72.79190063),
+    ('CTP', -1.250277996, -46.01722336),
+    ('CTQ', -33.50222397, -53.3441658),
+    ('CTR', -17.60700035, 131.548996),
+    ('CTS', 42.77519989, 141.6920013),
+    ('CTT', 43.25249863, 5.785190105),
+    ('CTU', 30.57850075, 103.9469986),
+    ('CTY', 29.63549995, -83.10479736),
+    ('CTZ', 34.9756012, -78.36460114),
+    ('CUA', 25.05380058, -111.6149979),
+    ('CUB', 33.97050095, -80.99520111),
+    ('CUC', 7.92757, -72.5115),
+    ('CUD', -26.79999924, 153.1000061),
+    ('CUE', -2.8894701, -78.98439789),
+    ('CUF', 44.54700089, 7.623219967),
+    ('CUG', -33.27830124, 148.7630005),
+    ('CUH', 35.94990158, -96.77310181),
+    ('CUI', 4.666667, -72.0),
+    ('CUK', 17.73469925, -88.03250122),
+    ('CUL', 24.76449966, -107.4749985),
+    ('CUM', 10.45033264, -64.13047028),
+    ('CUN', 21.03650093, -86.87709808),
+    ('CUO', 1.0136, -71.2961),
+    ('CUP', 10.66001415, -63.2616806),
+    ('CUQ', -13.76080036, 143.1139984),
+    ('CUR', 12.18889999, -68.95980072),
+    ('CUT', -38.93970108, -69.26460266),
+    ('CUU', 28.70289993, -105.9649963),
+    ('CUV', 8.75813961, -72.53630066),
+    ('CUY', -27.44669914, 117.9179993),
+    ('CUZ', -13.53569984, -71.938797),
+    ('CVB', -4.806620121, 144.7149963),
+    ('CVC', -33.70970154, 136.5050049),
+    ('CVE', 9.40092, -75.6913),
+    ('CVF', 45.39670181, 6.634719849),
+    ('CVG', 39.04880142, -84.6678009),
+    ('CVH', -37.85139847, -71.00920105),
+    ('CVI', -46.43180084, -67.51860046),
+    ('CVJ', 18.83480072, -99.26129913),
+    ('CVL', -9.67, 150.0197222),
+    ('CVM', 23.70330048, -98.95649719),
+    ('CVN', 34.42509842, -103.0790024),
+    ('CVO', 44.49720001, -123.2900009),
+    ('CVQ', -24.88059998, 113.6719971),
+    ('CVS', 34.38280106, -103.3219986),
+    ('CVT', 52.36970139, -1.479719996),
+    ('CVU', 39.67150116, -31.11359978),
+    ('CWA', 44.77759933, -89.66680145),
+    ('CWB', -25.5284996, -49.17580032),
+    ('CWC', 48.25930023, 25.98080063),
+    ('CWE', 30.11639977, 30.91539955),
+    ('CWF', 30.2105999, -93.14320374),
+    ('CWI', 41.83110046, -90.32910156),
+    ('CWL', 51.39670181, -3.343329906),
+    ('CWP', 33.76670074, 72.36689758),
+    ('CWR', -27.71170044, 138.3280029),
+    ('CWS', 48.49010086, -122.8320007),
+    ('CWT', -33.84469986, 148.6490021),
+    ('CWW', -35.99470139, 146.3569946),
+    ('CWX', 32.24539948, -109.8949966),
+    ('CXA', 7.625510216, -66.16280365),
+    ('CXB', 21.45219994, 91.96389771),
+    ('CXC', 61.582901, -144.427002),
+    ('CXF', 67.25219727, -150.2039948),
+    ('CXI', 1.98616004, -157.3500061),
+    ('CXJ', -29.19709969, -51.1875),
+    ('CXL', 32.66949844, -115.5130005),
+    ('CXM', -8.373609543, 18.92359924),
+    ('CXN', 11.5, 49.91699982),
+    ('CXO', 30.35180092, -95.41449738),
+    ('CXP', -7.645060062, 109.0339966),
+    ('CXR', 11.99820042, 109.2190018),
+    ('CXT', -20.04310036, 146.272995),
+    ('CXY', 25.60000038, -79.26699829),
+    ('CYA', 18.27109909, -73.78829956),
+    ('CYB', 19.68700027, -79.88279724),
+    ('CYC', 17.70079994, -88.04109955),
+    ('CYF', 60.14920044, -164.2859955),
+    ('CYG', -36.18280029, 147.8880005),
+    ('CYI', 23.46179962, 120.3929977),
+    ('CYL', 15.44555569, -86.67527771),
+    ('CYO', 21.61650085, -81.54599762),
+    ('CYP', 12.07269955, 124.5449982),
+    ('CYR', -34.45640182, -57.77059937),
+    ('CYS', 41.15570068, -104.8119965),
+    ('CYT', 60.08200073, -142.4929962),
+    ('CYU', 10.85809994, 121.0690002),
+    ('CYW', 20.54599953, -100.887001),
+    ('CYX', 68.74060059, 161.3379974),
+    ('CYZ', 16.92989922, 121.7529984),
+    ('CZA', 20.6413002, -88.44619751),
+    ('CZC', 61.94120026, -145.2940063),
+    ('CZE', 11.4149437, -69.68090057),
+    ('CZF', 61.78030014, -166.0390015),
+    ('CZH', 18.38220024, -88.41190338),
+    ('CZJ', 9.4446, -78.5888),
+    ('CZL', 36.27600098, 6.620389938),
+    ('CZM', 20.5223999, -86.92559814),
+    ('CZN', 62.07120132, -142.0480042),
+    ('CZS', -7.599909782, -72.76950073),
+    ('CZU', 9.33274, -75.2856),
+    ('CZW', 50.88499832, 19.20470047),
+    ('CZX', 31.91970062, 119.7789993),
+    ('CZY', -24.51670074, 139.6170044),
+    ('DAA', 38.71500015, -77.18099976),
+    ('DAB', 29.17989922, -81.05809784),
+    ('DAC', 23.843347, 90.397783),
+    ('DAD', 16.04389954, 108.1989975),
+    ('DAF', -4.7403, 145.9515),
+    ('DAG', 34.85369873, -116.7870026),
+    ('DAK', 25.41160011, 29.00309944),
+    ('DAL', 32.8470993, -96.85179901),
+    ('DAM', 33.41149902, 36.5155983),
+    ('DAN', 36.57289886, -79.33609772),
+    ('DAO', -8.431944444, 147.8430556),
+    ('DAP', 29.6692009, 80.54840088),
+    ('DAR', -6.878109932, 39.20259857),
+    ('DAS', 66.70310211, -119.7070007),
+    ('DAT', 40.06029892, 113.4820023),
+    ('DAU', -9.086759567, 143.2079926),
+    ('DAV', 8.390999794, -82.43499756),
+    ('DAX', 31.1302, 107.4295),
+    ('DAY', 39.90240097, -84.2193985),
+    ('DAZ', 38.46110153, 70.88249969),
+    ('DBA', 28.87829971, 64.39980316),
+    ('DBB', 30.92449951, 28.46139908),
+    ('DBD', 23.83399963, 86.4253006),
+    ('DBM', 10.35000038, 37.71699905),
+    ('DBN', 32.56439972, -82.98529816),
+    ('DBO', -32.21670151, 148.5749969),
+    ('DBP', -6.308611111, 141.9055556),
+    ('DBQ', 42.40200043, -90.70950317),
+    ('DBS', 44.16659927, -112.2259979),
+    ('DBT', 11.96700001, 38.0),
+    ('DBV', 42.56140137, 18.26819992),
+    ('DBY', -27.15530014, 151.2669983),
+    ('DCA', 38.85210037, -77.03769684),
+    ('DCF', 15.33670044, -61.39220047),
+    ('DCI', 39.35419846, 8.97247982),
+    ('DCK', 66.94329834, -156.9049988),
+    ('DCM', 43.55630112, 2.28918004),
+    ('DCN', -17.58139992, 123.8280029),
+    ('DCR', 40.837548, -84.862464),
+    ('DCT', 22.18180084, -75.72949982),
+    ('DCU', 34.65269852, -86.94539642),
+    ('DDC', 37.76340103, -99.96559906),
+    ('DDG', 40.02470016, 124.2860031),
+    ('DDM', -8.177361111, 147.8094444),
+    ('DDN', -16.99169922, 141.3170013),
+    ('DDU', 26.74080086, 67.66660309),
+    ('DEA', 29.96100044, 70.48590088),
+    ('DEB', 47.48889923, 21.61529922),
+    ('DEC', 39.83459854, -88.86569977),
+    ('DED', 30.18969917, 78.18029785),
+    ('DEE', 43.95840073, 145.6829987),
+    ('DEF', 32.43439865, 48.39759827),
+    ('DEH', 43.27550125, -91.73940277),
+    ('DEI', -3.80222, 55.666901),
+    ('DEL', 28.56649971, 77.10310364),
+    ('DEM', 8.553999901, 34.85800171),
+    ('DEN', 39.86169815, -104.6729965),
+    ('DEP', 27.98550034, 94.22280121),
+    ('DER', -6.144722222, 147.1072222),
+    ('DES', -5.6967, 53.6558),
+    ('DET', 42.40919876, -83.00990295),
+    ('DEZ', 35.28540039, 40.17599869),
+    ('DFI', 41.33750153, -84.42880249),
+    ('DFP', -16.0529995, 143.0119934),
+    ('DFW', 32.89680099, -97.03800201),
+    ('DGA', 16.98250961, -88.23098755),
+    ('DGD', -27.83027778, 117.3163889),
+    ('DGE', -32.5625, 149.6109924),
+    ('DGF', 50.16546005, -120.1712734),
+    ('DGL', 31.34259987, -109.5059967),
+    ('DGN', 38.33250046, -77.03720093),
+    ('DGO', 24.12420082, -104.5279999),
+    ('DGP', 55.94472122, 26.66500092),
+    ('DGR', -35.93970108, 173.8939972),
+    ('DGT', 9.333709717, 123.3000031),
+    ('DGU', 12.45899963, -3.49000001),
+    ('DGW', 42.79719925, -105.3860016),
+    ('DGX', 51.40480042, -3.435750008),
+    ('DHA', 26.26539993, 50.15200043),
+    ('DHD', -27.07500076, 141.8999939),
+    ('DHF', 24.24819946, 54.54769897),
+    ('DHI', 28.75329971, 80.58190155),
+    ('DHM', 32.1651001, 76.26339722),
+    ('DHN', 31.32130051, -85.44960022),
+    ('DHR', 52.92340088, 4.780620098),
+    ('DHT', 36.02259827, -102.5469971),
+    ('DIB', 27.48390007, 95.01689911),
+    ('DIE', -12.34939957, 49.29169846),
+    ('DIG', 27.79360008, 99.67720032),
+    ('DIJ', 47.26890183, 5.090000153),
+    ('DIK', 46.79740143, -102.802002),
+    ('DIL', -8.54640007, 125.526001),
+    ('DIM', 6.651669979, -4.64056015),
+    ('DIN', 21.39749908, 103.0080032),
+    ('DIP', 12.05000019, 1.782999992),
+    ('DIQ', -20.1807003, -44.8708992),
+    ('DIR', 9.624699593, 41.85419846),
+    ('DIS', -4.20635, 12.6599),
+    ('DIU', 20.71310043, 70.9210968),
+    ('DIY', 37.89390183, 40.20100021),
+    ('DJA', 9.692083333, 1.637777778),
+    ('DJB', -1.638020039, 103.6439972),
+    ('DJE', 33.875, 10.7755003),
+    ('DJG', 24.2928009, 9.452440262),
+    ('DJJ', -2.576950073, 140.5160065),
+    ('DJM', -2.532999992, 14.75),
+    ('DJN', 64.05039978, -145.7169952),
+    ('DJO', 6.792809963, -6.473189831),
+    ('DJR', -21.70829964, 139.5330048),
+    ('DJU', 64.64420319, -14.28279972),
+    ('DKA', 13.0078001, 7.660449982),
+    ('DKI', -17.94169998, 146.1399994),
+    ('DKK', 42.49330139, -79.27200317),
+    ('DKR', 14.73970032, -17.49020004),
+    ('DKS', 73.51780701, 80.37966919),
+    ('DKV', -24.86000061, 129.0700073),
+    ('DLA', 4.006080151, 9.719479561),
+    ('DLC', 38.96569824, 121.5390015),
+    ('DLD', 60.41730118, 8.518349648),
+    ('DLE', 47.03900146, 5.427249908),
+    ('DLF', 29.35950089, -100.7779999),
+    ('DLG', 59.04470062, -158.5050049),
+    ('DLH', 46.84209824, -92.19360352),
+    ('DLI', 11.75, 108.3669968),
+    ('DLK', -29.01329994, 138.4810028),
+    ('DLL', 34.44910049, -79.36859894),
+    ('DLM', 36.71310043, 28.79249954),
+    ('DLN', 45.25540161, -112.5530014),
+    ('DLS', 45.61849976, -121.1669998),
+    ('DLU', 25.64940071, 100.3190002),
+    ('DLV', -12.55000019, 130.6849976),
+    ('DLX', 50.35129929, 80.23439789),
+    ('DLY', -18.76939964, 169.0010071),
+    ('DLZ', 43.59170151, 104.4300003),
+    ('DMA', 32.16650009, -110.8830032),
+    ('DMB', 42.85359955, 71.3035965),
+    ('DMD', -17.94029999, 138.8220062),
+    ('DME', 55.40879822, 37.90629959),
+    ('DMK', 13.91259956, 100.6070023),
+    ('DMM', 26.47120094, 49.79790115),
+    ('DMN', 32.26229858, -107.7210007),
+    ('DMO', 38.70740128, -93.17590332),
+    ('DMT', -14.37689972, -56.40039825),
+    ('DMU', 25.88389969, 93.77110291),
+    ('DNA', 26.35560036, 127.7679977),
+    ('DNB', -16.04999924, 142.3999939),
+    ('DND', 56.45249939, -3.02583003),
+    ('DNF', 32.54199982, 22.74500084),
+    ('DNH', 40.16109848, 94.80919647),
+    ('DNK', 48.35720062, 35.1006012),
+    ('DNL', 33.46649933, -82.03939819),
+    ('DNM', -25.89389992, 113.5770035),
+    ('DNN', 34.72290039, -84.87020111),
+    ('DNO', -11.59539986, -46.84669876),
+    ('DNP', 28.11109924, 82.29419708),
+    ('DNQ', -35.55939865, 144.9459991),
+    ('DNR', 48.58769989, -2.079960108),
+    ('DNS', 41.9864006, -95.38069916),
+    ('DNU', -6.1546, 146.6772),
+    ('DNV', 40.19919968, -87.59590149),
+    ('DNX', 12.53299999, 35.06700134),
+    ('DNZ', 37.78559875, 29.70129967),
+    ('DOA', -14.36810017, 49.51079941),
+    ('DOB', -5.772220135, 134.2120056),
+    ('DOC', 57.86899948, -4.022999763),
+    ('DOD', -6.170440197, 35.75260162),
+    ('DOE', 4.005712611, -55.48164368),
+    ('DOG', 19.15390015, 30.43009949),
+    ('DOH', 25.273056, 51.608056),
+    ('DOI', -10.7009, 150.7218),
+    ('DOK', 48.07360077, 37.73970032),
+    ('DOL', 49.36529922, 0.154305995),
+    ('DOM', 15.54699993, -61.29999924),
+    ('DON', 17.61240005, -89.68840027),
+    ('DOO', -9.461855, 147.920907),
+    ('DOP', 28.98570061, 82.81909943),
+    ('DOR', 14.03299999, -0.033),
+    ('DOU', -22.20190048, -54.92660141),
+    ('DOV', 39.12950134, -75.46600342),
+    ('DOX', -29.29999924, 114.9329987),
+    ('DOY', 37.50859833, 118.788002),
+    ('DPA', 41.90779877, -88.24859619),
+    ('DPE', 49.88249969, 1.085279942),
+    ('DPG', 40.19940186, -112.9369965),
+    ('DPL', 8.601983499, 123.3418751),
+    ('DPO', -41.16970062, 146.4299927),
+    ('DPS', -8.748169899, 115.1669998),
+    ('DQA', 46.74638889, 125.1405556),
+    ('DQH', 70.34429932, -150.9450073),
+    ('DRB', -17.37000084, 123.6610031),
+    ('DRC', -17.98192406, 20.76806068),
+    ('DRD', -16.5537, 141.8238),
+    ('DRE', 46.00930023, -83.74389648),
+    ('DRF', 60.58890152, -152.1620026),
+    ('DRG', 66.06960297, -162.7660065),
+    ('DRH', -3.2705, 138.6132),
+    ('DRI', 30.83169937, -93.33989716),
+    ('DRJ', 4.116666794, -54.66667175),
+    ('DRK', 8.71889019, -83.64170074),
+    ('DRN', -28.5916996, 148.2169952),
+    ('DRO', 37.1515007, -107.7539978),
+    ('DRR', -25.68499947, 140.2279968),
+    ('DRS', 51.13280106, 13.76720047),
+    ('DRT', 29.37420082, -100.927002),
+    ('DRU', 46.62080002, -113.2050018),
+    ('DRW', -12.41469955, 130.8769989),
+    ('DRY', -15.7135704, 126.3810968),
+    ('DSA', 53.48053781, -1.010656357),
+    ('DSC', 5.449999809, 10.06700039),
+    ('DSD', 16.2968998, -61.08440018),
+    ('DSE', 11.08250046, 39.71139908),
+    ('DSG', 16.44583333, 122.2068056),
+    ('DSI', 30.40010071, -86.47149658),
+    ('DSK', 31.90940094, 70.89659882),
+    ('DSM', 41.5340004, -93.6631012),
+    ('DSN', 39.49, 109.8613889),
+    ('DSO', 39.74520111, 127.473999),
+    ('DSV', 42.57089996, -77.71309662),
+    ('DTA', 39.38059998, -112.5080032),
+    ('DTD', 0.8106, 114.5306),
+    ('DTE', 14.12919998, 122.9800034),
+    ('DTI', -18.23200035, -43.65039825),
+    ('DTL', 46.82519913, -95.88569641),
+    ('DTM', 51.5182991, 7.612239838),
+    ('DTN', 32.54019928, -93.74500275),
+    ('DTR', 48.49980164, -122.814003),
+    ('DTW', 42.21239853, -83.35340118),
+    ('DUA', 33.94229889, -96.39450073),
+    ('DUB', 53.42129898, -6.270070076),
+    ('DUC', 34.47090149, -97.9598999),
+    ('DUD', -45.92810059, 170.197998),
+    ('DUE', -7.400889874, 20.81850052),
+    ('DUF', 36.25350189, -75.78849792),
+    ('DUG', 31.46899986, -109.6039963),
+    ('DUJ', 41.17829895, -78.8986969),
+    ('DUK', -28.36840057, 32.24810028),
+    ('DUM', 1.609189987, 101.4339981),
+    ('DUQ', 48.75453362, -123.7097025),
+    ('DUR', -29.61444444, 31.11972222),
+    ('DUS', 51.28950119, 6.7667799),
+    ('DUT', 53.90010071, -166.5440063),
+    ('DVK', 64.51139832, -110.2890015),
+    ('DVL', 48.11420059, -98.90879822),
+    ('DVN', 41.61029816, -90.58830261),
+    ('DVO', 7.125520229, 125.6460037),
+    ('DVP', -24.14999962, 141.1080017),
+    ('DVR', -13.7498064, 130.6938782),
+    ('DVT', 33.68830109, -112.0830002),
+    ('DWA', -12.51830006, 34.13190079),
+    ('DWB', -16.10169042, 45.35883665),
+    ('DWC', 24.89666667, 55.16138889),
+    ('DWD', 24.5, 44.40000153),
+    ('DWH', 30.0618, -95.55280304),
+    ('DWN', 35.44919968, -97.53309631),
+    ('DWR', 31.0, 64.0),
+    ('DXB', 25.25279999, 55.36439896),
+    ('DXD', -15.11749443, 143.3160496),
+    ('DXR', 41.37149811, -73.48220062),
+    ('DYA', -22.62220001, 148.3639984),
+    ('DYG', 29.10280037, 110.4430008),
+    ('DYL', 40.33300018, -75.12229919),
+    ('DYM', -23.76169968, 141.1450043),
+    ('DYR', 64.73490143, 177.7409973),
+    ('DYS', 32.42079926, -99.854599),
+    ('DYU', 38.54330063, 68.82499695),
+    ('DYW', -16.26469507, 133.383379),
+    ('DZA', -12.8046999, 45.28110123),
+    ('DZI', 9.6, -73.45),
+    ('DZN', 47.77080154, 67.67340088),
+    ('DZO', -33.35889816, -56.49919891),
+    ('DZU', 29.636227, 105.773621),
+    ('EAA', 64.77639771, -141.151001),
+    ('EAB', 15.30000019, 43.96699905),
+    ('EAE', -17.09029961, 168.3430023),
+    ('EAL', 9.319305556, 166.8477778),
+    ('EAM', 17.6114006, 44.4192009),
+    ('EAR', 40.72700119, -99.00679779),
+    ('EAS', 43.35649872, -1.790609956),
+    ('EAT', 47.39889908, -120.2070007),
+    ('EAU', 44.86579895, -91.48429871),
+    ('EBA', 42.76029968, 10.23939991),
+    ('EBB', 0.042385999, 32.44350052),
+    ('EBD', 13.15320015, 30.23270035),
+    ('EBG', 7.59647, -74.8089),
+    ('EBH', 33.72166667, 1.0925),
+    ('EBJ', 55.52590179, 8.55340004),
+    ('EBL', 36.23759842, 43.96319962),
+    ('EBM', 31.70429993, 9.254619598),
+    ('EBN', 9.330559731, 166.8200073),
+    ('EBO', 4.5982, 168.752),
+    ('EBS', 42.43659973, -93.86889648),
+    ('EBU', 45.54059982, 4.296390057),
+    ('EBW', 2.875999928, 11.18500042),
+    ('ECG', 36.26060104, -76.17459869),
+    ('ECH', -36.15719986, 144.7619934),
+    ('ECN', 35.15470123, 33.49610138),
+    ('ECO', -1.753333333, -73.20472222),
+    ('ECP', 30.3417, -85.7973),
+    ('ECR', 2.449444444, -78.09416667),
+    ('ECV', 40.37070084, -3.785140038),
+    ('EDB', 18.0146, 30.9595),
+    ('EDD', -25.2057991, 133.2539978),
+    ('EDE', 36.02769852, -76.56710052),
+    ('EDF', 61.25099945, -149.8070068),
+    ('EDI', 55.95000076, -3.372499943),
+    ('EDK', 37.77410126, -96.81759644),
+    ('EDL', 0.404457986, 35.23889923),
+    ('EDM', 46.70190048, -1.378630042),
+    ('EDO', 39.55459976, 27.01379967),
+    ('EDR', -14.89669991, 141.6089935),
+    ('EED', 34.7663002, -114.6230011),
+    ('EEK', 60.21367264, -162.0438843),
+    ('EEN', 42.89839935, -72.27079773),
+    ('EFD', 29.6072998, -95.15879822),
+    ('EFG', -9.153805556, 147.6598056),
+    ('EFK', 44.88880157, -72.22920227),
+    ('EFL', 38.12009811, 20.50049973),
+    ('EFW', 42.0102005, -94.34259796),
+    ('EGA', -6.9179, 146.1053),
+    ('EGC', 44.82529831, 0.518611014),
+    ('EGE', 39.64260101, -106.9179993),
+    ('EGH', 31.06900024, 34.12919998),
+    ('EGI', 30.65040016, -86.52290344),
+    ('EGL', 5.2897, 39.7023),
+    ('EGM', -8.578889847, 157.8760071),
+    ('EGN', 13.48169994, 22.46719933),
+    ('EGO', 50.64379883, 36.59009933),
+    ('EGP', 28.85720062, -100.512001),
+    ('EGS', 65.28330231, -14.40139961),
+    ('EGV', 45.93230057, -89.26830292),
+    ('EGX', 58.1855011, -157.375),
+    ('EHL', -41.94319916, -71.53230286),
+    ('EHM', 58.64640045, -162.0630035),
+    ('EIB', 50.99277878, 10.47277832),
+    ('EIE', 58.4742012, 92.11250305),
+    ('EIH', -18.50329971, 144.0939941),
+    ('EIK', 46.68, 38.21),
+    ('EIL', 64.66570282, -147.102005),
+    ('EIN', 51.45009995, 5.374529839),
+    ('EIS', 18.44479942, -64.54299927),
+    ('EIY', 30.62170029, 35.20330048),
+    ('EJA', 7.02433, -73.8068),
+    ('EJH', 26.19860077, 36.47639847),
+    ('EKA', 40.80339813, -124.112999),
+    ('EKB', 51.5909996, 75.21499634),
+    ('EKD', -21.17250061, 135.4440002),
+    ('EKI', 41.7193985, -86.00319672),
+    ('EKN', 38.88940048, -79.85710144),
+    ('EKO', 40.82490158, -115.7919998),
+    ('EKS', 49.19029999, 142.0829926),
+    ('EKT', 59.35110092, 16.70840073),
+    ('EKX', 37.68600082, -85.92500305),
+    ('ELA', 29.60059929, -96.32189941),
+    ('ELB', 9.04554, -73.9749),
+    ('ELC', -12.01939964, 135.5709991),
+    ('ELD', 33.22100067, -92.81330109),
+    ('ELE', 8.133000374, -77.69999695),
+    ('ELF', 13.61489964, 25.32460022),
+    ('ELG', 30.57130051, 2.859590054),
+    ('ELH', 25.47489929, -76.6835022),
+    ('ELI', 64.61470032, -162.2720032),
+    ('ELJ', 2.0, -74.133333),
+    ('ELK', 35.43080139, -99.39430237),
+    ('ELL', -23.72669983, 27.68829918),
+    ('ELM', 42.15990067, -76.89160156),
+    ('ELN', 47.03300095, -120.5309982),
+    ('ELO', -26.39749908, -54.5746994),
+    ('ELP', 31.80719948, -106.3779984),
+    ('ELQ', 26.30279922, 43.7743988),
+    ('ELR', -3.7826, 139.3861),
+    ('ELS', -33.03559875, 27.82589912),
+    ('ELT', 28.20899963, 33.64550018),
+    ('ELU', 33.51139832, 6.776790142),
+    ('ELY', 39.29970169, -114.8420029),
+    ('ELZ', 42.10950089, -77.98999786),
+    ('EMA', 52.83110046, -1.328060031),
+    ('EMD', -23.56749916, 148.1790009),
+    ('EME', 53.39110947, 7.227499962),
+    ('EMG', -28.71999931, 31.88999939),
+    ('EMI', -1.653949991, 149.9757667),
+    ('EMK', 62.78609848, -164.4909973),
+    ('EMM', 41.82410049, -110.5569992),
+    ('EMN', 16.62199974, -7.316599846),
+    ('EMO', -9.1234, 148.0415),
+    ('EMP', 38.33209991, -96.19120026),
+    ('EMS', -9.447, 148.7628),
+    ('EMT', 34.08610153, -118.0350037),
+    ('EMX', -42.02920151, -71.17250061),
+    ('EMY', 28.10129929, 30.7303009),
+    ('ENA', 60.57310104, -151.2449951),
+    ('ENB', -29.83250046, 115.2460022),
+    ('ENC', 48.69210052, 6.230460167),
+    ('END', 36.33919907, -97.91649628),
+    ('ENE', -8.849289894, 121.6610031),
+    ('ENF', 68.36260223, 23.42429924),
+    ('ENH', 30.32029915, 109.4850006),
+    ('ENI', 11.20199966, 119.4169998),
+    ('ENJ', 17.35280037, -90.15139771),
+    ('ENK', 54.39889908, -7.651669979),
+    ('ENL', 38.51509857, -89.0911026),
+    ('ENN', 64.54730225, -149.0740051),
+    ('ENO', -27.29999924, -55.90999985),
+    ('ENS', 52.27000046, 6.874169827),
+    ('ENT', 11.34070015, 162.3280029),
+    ('ENU', 6.474269867, 7.56196022),
+    ('ENV', 40.71870041, -114.0309982),
+    ('ENW', 42.59569931, -87.92780304),
+    ('ENY', 36.63690186, 109.5540009),
+    ('EOH', 6.220549, -75.590582),
+    ('EOI', 59.19060135, -2.772219896),
+    ('EOK', 40.4598999, -91.42849731),
+    ('EOR', 6.733333111, -61.58333206),
+    ('EOZ', 7.083333015, -69.53333282),
+    ('EPA', -34.6099, -58.6126),
+    ('EPG', 40.86750031, -96.11000061),
+    ('EPH', 47.30759811, -119.5159988),
+    ('EPL', 48.32500076, 6.069980145),
+    ('EPR', -33.68439865, 121.822998),
+    ('EPS', 19.32141504, -69.49591219),
+    ('EPT', -5.0412, 141.6779),
+    ('EPU', 58.41899872, 24.4727993),
+    ('EQS', -42.90800095, -71.13950348),
+    ('ERA', 10.64205055, 47.38798141),
+    ('ERB', -26.26329994, 132.1820068),
+    ('ERC', 39.71020126, 39.52700043),
+    ('ERD', 46.81499863, 36.7580986),
+    ('ERE', -6.606463154, 143.9002132),
+    ('ERF', 50.97980118, 10.95810032),
+    ('ERG', 61.27500153, 108.0299988),
+    ('ERH', 31.94750023, -4.398330212),
+    ('ERI', 42.08312701, -80.17386675),
+    ('ERL', 43.4225, 112.0966667),
+    ('ERM', -27.66189957, -52.2682991),
+    ('ERN', -6.639530182, -69.87979889),
+    ('ERR', 44.79249954, -71.16419983),
+    ('ERS', -22.61219978, 17.08040047),
+    ('ERT', 48.98305556, 104.1505556),
+    ('ERU', -8.2538, 146.9747),
+    ('ERV', 29.97669983, -99.08570099),
+    ('ERZ', 39.95650101, 41.17020035),
+    ('ESA', -9.874327023, 150.9542084),
+    ('ESB', 40.12810135, 32.99509811),
+    ('ESC', 45.72269821, -87.09369659),
+    ('ESD', 48.70819855, -122.9100037),
+    ('ESE', 31.79529953, -116.6029968),
+    ('ESF', 31.39489937, -92.29579926),
+    ('ESG', -22.04999924, -60.61999893),
+    ('ESH', 50.83560181, -0.297221988),
+    ('ESI', -14.93369444, -42.81),
+    ('ESK', 39.78409958, 30.58209991),
+    ('ESL', 46.37390137, 44.33089828),
+    ('ESM', 0.978519022, -79.62660217),
+    ('ESN', 38.80419922, -76.06900024),
+    ('ESO', 36.02999878, -106.0459976),
+    ('ESR', -26.31110001, -69.76519775),
+    ('ESS', 51.40230179, 6.937329769),
+    ('EST', 43.40739822, -94.74639893),
+    ('ESU', 31.39749908, -9.681670189),
+    ('ESW', 47.25419998, -121.185997),
+    ('ETB', 43.42219925, -88.12789917),
+    ('ETD', -28.74080086, 138.5890045),
+    ('ETE', 12.93299961, 36.16699982),
+    ('ETH', 29.56130028, 34.96009827),
+    ('ETN', 32.41350174, -98.80979919),
+    ('ETR', -3.435159922, -79.97779846),
+    ('ETS', 31.29969978, -85.89990234),
+    ('ETZ', 48.98210144, 6.251319885),
+    ('EUA', -21.37829971, -174.9579926),
+    ('EUC', -31.70000076, 128.8829956),
+    ('EUE', 39.60419846, -116.0049973),
+    ('EUF', 31.95129967, -85.12889862),
+    ('EUG', 44.12459946, -123.211998),
+    ('EUM', 54.07944489, 9.941389084),
+    ('EUN', 27.15169907, -13.21920013),
+    ('EUO', 4.383333, -73.2),
+    ('EUQ', 10.76599979, 121.9329987),
+    ('EUX', 17.49650002, -62.97940063),
+    ('EVD', -18.00099945, 134.8630066),
+    ('EVE', 68.49130249, 16.67810059),
+    ('EVG', 62.04779816, 14.4229002),
+    ('EVH', -29.09329987, 153.4199982),
+    ('EVM', 47.42509842, -92.49849701),
+    ('EVN', 40.14730072, 44.39590073),
+    ('EVV', 38.03699875, -87.53240204),
+    ('EVW', 41.27479935, -111.0350037),
+    ('EVX', 49.02870178, 1.219859958),
+    ('EWB', 41.67610168, -70.95690155),
+    ('EWE', -5.494, 138.083),
+    ('EWI', -3.925899982, 136.3769989),
+    ('EWK', 38.05820084, -97.27449799),
+    ('EWN', 35.07300186, -77.04290009),
+    ('EWO', -0.883000016, 14.80000019),
+    ('EWR', 40.69250107, -74.16870117),
+    ('EXM', -22.0333004, 114.0999985),
+    ('EXT', 50.7344017, -3.413889885),
+    ('EYK', 63.68690109, 66.69860077),
+    ('EYL', 15.13300037, -10.56700039),
+    ('EYP', 5.31911, -72.384),
+    ('EYR', 39.0041008, -119.1579971),
+    ('EYS', 3.216670036, 35.96670151),
+    ('EYW', 24.55610085, -81.75959778),
+    ('EZE', -34.8222, -58.5358),
+    ('EZS', 38.60689926, 39.29140091),
+    ('EZV', 63.92100143, 65.03050232),
+    ('FAA', 10.03549957, -10.76980019),
+    ('FAB', 51.2757988, -0.776332974),
+    ('FAC', -16.68670082, -145.3289948),
+    ('FAE', 62.06359863, -7.277219772),
+    ('FAF', 37.13249969, -76.6088028),
+    ('FAG', 63.87469864, -16.64109993),
+    ('FAH', 32.36700058, 62.18299866),
+    ('FAI', 64.81510162, -147.8560028),
+    ('FAJ', 18.30890083, -65.66190338),
+    ('FAM', 37.76110077, -90.4285965),
+    ('FAN', 58.09949875, 6.626049995),
+    ('FAO', 37.01440048, -7.965909958),
+    ('FAQ', -4.610468, 141.960138),
+    ('FAR', 46.92070007, -96.81580353),
+    ('FAS', 64.93170166, -14.06060028),
+    ('FAT', 36.77619934, -119.7180023),
+    ('FAU', 22.35475932, 56.48414612),
+    ('FAV', -16.05410004, -145.6569977),
+    ('FAY', 34.99119949, -78.88030243),
+    ('FAZ', 28.89179993, 53.72330093),
+    ('FBA', -2.53260994, -66.08319855),
+    ('FBD', 37.12110138, 70.51809692),
+    ('FBE', -26.05920029, -53.06349945),
+    ('FBG', 35.13180161, -78.93669891),
+    ('FBK', 64.83750153, -147.6139984),
+    ('FBL', 44.3246994, -93.31079865),
+    ('FBM', -11.59130001, 27.53089905),
+    ('FBR', 41.39189911, -110.4069977),
+    ('FBY', 40.18299866, -97.16929626),
+    ('FCA', 48.3105011, -114.2559967),
+    ('FCB', -28.82309914, 27.90889931),
+    ('FCH', 36.73239899, -119.8199997),
+    ('FCM', 44.82720184, -93.45709991),
+    ('FCN', 53.7677002, 8.658499718),
+    ('FCO', 41.80450058, 12.25080013),
+    ('FCS', 38.67839813, -104.7570038),
+    ('FCY', 34.94200134, -90.77500153),
+    ('FDA', 10.5333, -74.2),
+    ('FDE', 61.39110184, 5.756939888),
+    ('FDF', 14.5909996, -61.00320053),
+    ('FDH', 47.67129898, 9.511489868),
+    ('FDK', 39.41759872, -77.3742981),
+    ('FDO', -34.4532, -58.5896),
+    ('FDR', 34.35200119, -98.98390198),
+    ('FDU', -3.311320066, 17.38170052),
+    ('FDY', 41.01350021, -83.66870117),
+    ('FEA', 60.60333333, -0.872777778),
+    ('FEB', 29.2329998, 81.21700287),
+    ('FEC', -12.20030022, -38.90679932),
+    ('FEG', 40.35879898, 71.74500275),
+    ('FEJ', -8.165579796, -70.35299683),
+    ('FEK', 9.6, -5.1833333),
+    ('FEL', 48.20555496, 11.26694393),
+    ('FEN', -3.854929924, -32.4233017),
+    ('FEP', 42.24620056, -89.58200073),
+    ('FET', 41.44910049, -96.52020264),
+    ('FEZ', 33.9272995, -4.97796011),
+    ('FFA', 36.01819992, -75.6713028),
+    ('FFD', 51.68220139, -1.790030003),
+    ('FFL', 41.05329895, -91.97889709),
+    ('FFM', 46.28440094, -96.15670013),
+    ('FFO', 39.8260994, -84.0483017),
+    ('FFT', 38.18249893, -84.90470123),
+    ('FFU', -43.18920135, -71.85109711),
+    ('FGD', 22.66699982, -12.7329998),
+    ('FGI', -13.84869957, -171.7400055),
+    ('FGU', -15.81989956, -140.8869934),
+    ('FHB', 30.61179924, -81.4611969),
+    ('FHU', 31.58849907, -110.3440018),
+    ('FHZ', -15.9921999, -140.1649933),
+    ('FID', 41.25130081, -72.03160095),
+    ('FIE', 59.53580093, -1.628059983),
+    ('FIG', 10.35060024, -13.56919956),
+    ('FIH', -4.385749817, 15.44460011),
+    ('FIK', -25.59469986, 134.5829926),
+    ('FIL', 38.95830154, -112.362999),
+    ('FIN', -6.621750109, 147.85405),
+    ('FIZ', -18.18190002, 125.5589981),
+    ('FJR', 25.11219978, 56.32400131),
+    ('FKB', 48.77939987, 8.080499649),
+    ('FKI', 0.481638998, 25.33799934),
+    ('FKJ', 36.14279938, 136.223999),
+    ('FKL', 41.37789917, -79.86039734),
+    ('FKN', 36.69810104, -76.90380096),
+    ('FKQ', -2.920190096, 132.2669983),
+    ('FKS', 37.22740173, 140.4309998),
+    ('FLA', 1.58919, -75.5644),
+    ('FLB', -6.846389771, -43.07730103),
+    ('FLD', 43.77119827, -88.48840332),
+    ('FLF', 54.7733345, 9.378889084),
+    ('FLG', 35.13850021, -111.6709976),
+    ('FLH', 58.82580185, -3.142780066),
+    ('FLI', 66.01418043, -23.4416914),
+    ('FLL', 26.07259941, -80.15270233),
+    ('FLM', -22.36000061, -60.04999924),
+    ('FLN', -27.67027855, -48.55250168),
+    ('FLO', 34.1853981, -79.72389984),
+    ('FLP', 36.29090118, -92.59030151),
+    ('FLR', 43.81000137, 11.20510006),
+    ('FLS', -40.09170151, 147.9929962),
+    ('FLT', 62.45259857, -157.9889984),
+    ('FLV', 39.36830139, -94.91470337),
+    ('FLW', 39.45529938, -31.13139915),
+    ('FLX', 39.49909973, -118.7490005),
+    ('FLY', -35.66669846, 145.5500031),
+    ('FMA', -26.2127, -58.2281),
+    ('FMC', 65.92700195, -149.8399963),
+    ('FME', 39.08539963, -76.75939941),
+    ('FMG', 10.41860008, -85.78269958),
+    ('FMH', 41.65840149, -70.52140045),
+    ('FMI', -5.875559807, 29.25),
+    ('FMM', 47.98880005, 10.23950005),
+    ('FMN', 36.74119949, -108.2300034),
+    ('FMO', 52.13460159, 7.684830189),
+    ('FMS', 40.65930176, -91.32679749),
+    ('FMU', 43.98279953, -124.1110001),
+    ('FMY', 26.58659935, -81.86329651),
+    ('FNA', 8.616439819, -13.19550037),
+    ('FNB', 53.60219955, 13.30599976),
+    ('FNC', 32.69789886, -16.77449989),
+    ('FNE', -8.549277778, 147.0858333),
+    ('FNG', 12.03299999, 0.349999994),
+    ('FNH', 9.583000183, 37.34999847),
+    ('FNI', 43.75740051, 4.416349888),
+    ('FNJ', 39.22409821, 125.6699982),
+    ('FNL', 40.4518013, -105.0110016),
+    ('FNT', 42.9654007, -83.74359894),
+    ('FNU', 39.895308, 8.642661),
+    ('FOA', 60.12099838, -2.052000046),
+    ('FOB', 39.47430038, -123.7959976),
+    ('FOC', 25.93510056, 119.663002),
+    ('FOD', 42.55149841, -94.19259644),
+    ('FOE', 38.95090103, -95.66359711),
+    ('FOG', 41.43289948, 15.53499985),
+    ('FOK', 40.84370041, -72.63179779),
+    ('FOM', 5.636919975, 10.75080013),
+    ('FON', 10.47799969, -84.6344986),
+    ('FOO', -0.936325014, 134.871994),
+    ('FOR', -3.776279926, -38.5326004),
+    ('FOS', -30.83810043, 128.1150055),
+    ('FOT', -32.20420074, 152.4790039),
+    ('FOU', -1.282999992, 10.61699963),
+    ('FOY', 8.3513, -10.2269),
+    ('FPO', 26.55870056, -78.69560242),
+    ('FPR', 27.49510002, -80.36830139),
+    ('FPY', 30.0692997, -83.58059692),
+    ('FRA', 50.02640152, 8.543129921),
+    ('FRB', -33.36360168, 147.9349976),
+    ('FRC', -20.59219933, -47.38290024),
+    ('FRD', 48.52199936, -123.0240021),
+    ('FRE', -8.107500076, 159.5769958),
+    ('FRF', 52.03805542, 11.20555592),
+    ('FRG', 40.72880173, -73.41339874),
+    ('FRH', 38.50619888, -86.63690186),
+    ('FRI', 39.05530167, -96.76450348),
+    ('FRK', -4.583, 55.950001),
+    ('FRL', 44.19480133, 12.07009983),
+    ('FRM', 43.64390183, -94.41560364),
+    ('FRO', 61.58359909, 5.024720192),
+    ('FRQ', -5.208, 141.6988),
+    ('FRR', 38.91749954, -78.25350189),
+    ('FRS', 16.91379929, -89.86640167),
+    ('FRT', -41.11700058, -73.05000305),
+    ('FRU', 43.06129837, 74.4776001),
+    ('FRW', -21.1595993, 27.47450066),
+    ('FRY', 43.99110031, -70.94789886),
+    ('FRZ', 51.1146, 9.286),
+    ('FSC', 41.50059891, 9.097780228),
+    ('FSD', 43.58200073, -96.74189758),
+    ('FSI', 34.64979935, -98.40219879),
+    ('FSK', 37.79840088, -94.76940155),
+    ('FSL', -18.1321, 125.7873),
+    ('FSM', 35.33660126, -94.36740112),
+    ('FSP', 46.76290131, -56.17309952),
+    ('FSS', 57.6493988, -3.560640097),
+    ('FST', 30.91570091, -102.9160004),
+    ('FSU', 34.48339844, -104.2170029),
+    ('FSZ', 34.79604347, 138.1877518),
+    ('FTA', -19.51639938, 170.2319946),
+    ('FTE', -50.28030014, -72.05310059),
+    ('FTI', -14.21720028, -169.4250031),
+    ('FTK', 37.90710068, -85.9720993),
+    ('FTU', -25.03809929, 46.95610046),
+    ('FTW', 32.81980133, -97.36239624),
+    ('FTX', -0.531350017, 15.95009995),
+    ('FTY', 33.77909851, -84.52140045),
+    ('FUB', -6.1518, 150.6264),
+    ('FUE', 28.45269966, -13.86380005),
+    ('FUG', 32.882157, 115.734364),
+    ('FUJ', 32.66630173, 128.8329926),
+    ('FUK', 33.58589935, 130.451004),
+    ('FUL', 33.87200165, -117.9800034),
+    ('FUM', -6.3933, 142.4408),
+    ('FUN', -8.525, 179.195999),
+    ('FUO', 23.08329964, 113.0699997),
+    ('FUT', -14.31140041, -178.0659943),
+    ('FVL', -18.2833004, 128.4170074),
+    ('FVM', -0.309722222, 73.435),
+    ('FVR', -15.1647, 127.8401),
+    ('FWA', 40.97850037, -85.19509888),
+    ('FWH', 32.76919937, -97.4414978),
+    ('FWL', 62.50659943, -153.8939972),
+    ('FXE', 26.19729996, -80.17070007),
+    ('FXM', 70.1895, -146.0209),
+    ('FXO', -14.81499958, 36.52999878),
+    ('FXY', 43.23469925, -93.62409973),
+    ('FYM', 35.05970001, -86.56400299),
+    ('FYT', 17.91710091, 19.11109924),
+    ('FYU', 66.57150269, -145.25),
+    ('FYV', 36.00510025, -94.17009735),
+    ('FZB', -33.14300156, -58.29410172),
+    ('FZO', 51.51940155, -2.590830088),
+    ('GAB', 38.92409897, -117.9589996),
+    ('GAE', 33.87689972, 10.10330009),
+    ('GAF', 34.42200089, 8.822500229),
+    ('GAG', 36.29550171, -99.77639771),
+    ('GAH', -25.61440086, 151.6190033),
+    ('GAI', 39.16830063, -77.16600037),
+    ('GAJ', 38.41189957, 140.3710022),
+    ('GAL', 64.73619843, -156.9369965),
+    ('GAM', 63.76679993, -171.7330017),
+    ('GAN', -0.69334197, 73.1556015),
+    ('GAO', 20.08530045, -75.15830231),
+    ('GAP', -6.053196, 145.959047),
+    ('GAQ', 16.24839973, -0.005456),
+    ('GAR', -7.875625027, 147.1412659),
+    ('GAS', -0.46350801, 39.64830017),
+    ('GAT', 44.45500183, 6.037779808),
+    ('GAU', 26.10610008, 91.58589935),
+    ('GAV', -0.400555998, 129.8950043),
+    ('GAW', 22.17469978, 94.13439941),
+    ('GAX', -2.710160017, 9.960619926),
+    ('GAY', 24.74430084, 84.95120239),
+    ('GAZ', -9.225918, 152.944352),
+    ('GBB', 40.82666667, 47.7125),
+    ('GBC', -6.1028, 141.7393),
+    ('GBD', 38.34429932, -98.85919952),
+    ('GBE', -24.55520058, 25.91819954),
+    ('GBF', -6.567778, 144.703058),
+    ('GBG', 40.93799973, -90.43109894),
+    ('GBH', 68.47969818, -149.4900055),
+    ('GBI', 26.63190079, -78.35919952),
+    ('GBJ', 15.86870003, -61.27000046),
+    ('GBK', 7.767000198, -12.38300037),
+    ('GBL', -11.64999962, 133.3820038),
+    ('GBP', -16.54999924, 143.6670074),
+    ('GBQ', 47.20660019, 6.083680153),
+    ('GBR', 42.18420029, -73.40319824),
+    ('GBT', 36.90940094, 54.40129852),
+    ('GBU', 14.92500019, 35.87799835),
+    ('GBV', -16.42329979, 126.4449997),
+    ('GBZ', -36.24140167, 175.4720001),
+    ('GCA', 2.283333, -74.95),
+    ('GCC', 44.34889984, -105.5390015),
+    ('GCD', 47.92200089, -119.0830002),
+    ('GCH', 30.33760071, 50.82799912),
+    ('GCI', 49.43500137, -2.601969957),
+    ('GCJ', -25.98629951, 28.14010048),
+    ('GCK', 37.92750168, -100.723999),
+    ('GCM', 19.2928009, -81.35769653),
+    ('GCN', 35.95240021, -112.1470032),
+    ('GCV', -29.9494, -50.98505),
+    ('GCW', 35.99039841, -113.8160019),
+    ('GCY', 36.19300079, -82.81510162),
+    ('GDC', 34.75830078, -82.37640381),
+    ('GDD', -18.67810059, 128.5919952),
+    ('GDE', 5.935130119, 43.57860184),
+    ('GDG', 53.47330093, 125.7949982),
+    ('GDI', 9.583000183, 21.7329998),
+    ('GDJ', -6.732999802, 23.95000076),
+    ('GDL', 20.52179909, -103.310997),
+    ('GDM', 42.54999924, -72.01609802),
+    ('GDN', 54.37760162, 18.46619987),
+    ('GDO', 7.233333111, -70.80000305),
+    ('GDP', -6.782219887, -43.5821991),
+    ('GDQ', 12.51990032, 37.43399811),
+    ('GDT', 21.44449997, -71.14230347),
+    ('GDV', 47.13869858, -104.8069992),
+    ('GDW', 43.97060013, -84.47499847),
+    ('GDX', 59.9109993, 150.7200012),
+    ('GDZ', 44.58209263, 38.01248074),
+    ('GEA', -22.25830078, 166.4730072),
+    ('GEB', -0.078888997, 129.4579926),
+    ('GEC', 35.23594666, 33.72435761),
+    ('GED', 38.68920135, -75.35890198),
+    ('GEE', -41.08000183, 146.8399963),
+    ('GEF', -7.575829983, 156.5970001),
+    ('GEG', 47.61989975, -117.5339966),
+    ('GEL', -28.28170013, -54.16910172),
+    ('GEO', 6.498549938, -58.2541008),
+    ('GER', 21.83469963, -82.78379822),
+    ('GES', 6.058000088, 125.0960007),
+    ('GET', -28.79610062, 114.7070007),
+    ('GEV', 67.13240051, 20.81459999),
+    ('GEW', -9.2263, 148.4949),
+    ('GEX', -38.22499847, 144.3329926),
+    ('GEY', 44.51679993, -108.0830002),
+    ('GFD', 39.79029846, -85.73609924),
+    ('GFE', -34.0, 148.1329956),
+    ('GFF', -34.25080109, 146.0670013),
+    ('GFK', 47.94929886, -97.17610168),
+    ('GFL', 43.34120178, -73.61029816),
+    ('GFN', -29.75939941, 153.0299988),
+    ('GFO', 6.374770164, -58.63809967),
+    ('GFR', 48.8830986, -1.564170003),
+    ('GFY', -19.60219955, 18.12269974),
+    ('GGC', -12.63987732, 22.5868206),
+    ('GGD', -18.625, 139.2330017),
+    ('GGE', 33.31169891, -79.31960297),
+    ('GGG', 32.38399887, -94.71150208),
+    ('GGL', 8.333333, -77.083333),
+    ('GGM', 0.271342009, 34.78730011),
+    ('GGN', 6.132999897, -5.949999809),
+    ('GGO', 6.524990082, -7.478610039),
+    ('GGS', -48.78310013, -70.15000153),
+    ('GGT', 23.56259918, -75.87799835),
+    ('GGW', 48.21250153, -106.6149979),
+    ('GHA', 32.38410187, 3.79411006),
+    ('GHB', 25.28470039, -76.33100128),
+    ('GHC', 25.73830032, -77.84010315),
+    ('GHE', 8.0644, -78.3673),
+    ('GHF', 49.64805603, 9.966388702),
+    ('GHM', 35.83739853, -87.44539642),
+    ('GHN', 30.9485, 104.3296),
+    ('GHT', 25.14559937, 10.14260006),
+    ('GHU', -33.0103, -58.6131),
+    ('GIB', 36.15119934, -5.34965992),
+    ('GIC', -9.232780457, 142.2180023),
+    ('GID', -3.417209387, 29.91130829),
+    ('GIF', 28.06290054, -81.75330353),
+    ('GIG', -22.80999947, -43.25055695),
+    ('GII', 11.43299961, -9.166999817),
+    ('GIL', 35.91880035, 74.33360291),
+    ('GIR', 4.27624, -74.7967),
+    ('GIS', -38.66329956, 177.9779968),
+    ('GIT', -2.871360064, 32.17169952),
+    ('GIU', 7.956669807, 80.72850037),
+    ('GIY', -23.2833004, 30.64999962),
+    ('GIZ', 16.90110016, 42.58580017),
+    ('GJA', 16.44540024, -85.90660095),
+    ('GJL', 36.79510117, 5.87361002),
+    ('GJM', -10.78639984, -65.28479767),
+    ('GJR', 65.99530029, -21.32690048),
+    ('GJT', 39.12239838, -108.5270004),
+    ('GKA', -6.081689835, 145.3919983),
+    ('GKD', 40.20449829, 25.88330078),
+    ('GKE', 50.96080017, 6.04241991),
+    ('GKH', 28.04999924, 84.48300171),
+    ('GKK', 0.7324, 73.4336),
+    ('GKL', -23.18330002, 150.9420013),
+    ('GKN', 62.1548996, -145.4570007),
+    ('GKT', 35.85779953, -83.52870178),
+    ('GLA', 55.87189865, -4.433060169),
+    ('GLB', 33.35309982, -110.6669998),
+    ('GLC', 6.98443985, 46.42139816),
+    ('GLD', 39.37060165, -101.6989975),
+    ('GLE', 33.65140152, -97.1969986),
+    ('GLF', 8.654009819, -83.18219757),
+    ('GLG', -24.80830002, 139.6000061),
+    ('GLH', 33.48289871, -90.98560333),
+    ('GLI', -29.67499924, 151.6889954),
+    ('GLJ', 2.1464, -75.6944),
+    ('GLK', 6.780829906, 47.45470047),
+    ('GLL', 60.79140091, 9.050559998),
+    ('GLM', -22.88829994, 138.8249969),
+    ('GLN', 29.02669907, -10.05029964),
+    ('GLO', 51.89419937, -2.167220116),
+    ('GLP', -5.2808, 141.5411),
+    ('GLR', 45.01350021, -84.70359802),
+    ('GLS', 29.26530075, -94.86039734),
+    ('GLT', -23.86969948, 151.2230072),
+    ('GLU', 26.88456, 90.46412),
+    ('GLV', 64.55049896, -163.0070038),
+    ('GLW', 37.03179932, -85.9536972),
+    ('GLX', 1.83833003, 127.7860031),
+    ('GLZ', 51.56740189, 4.931829929),
+    ('GMA', 3.235369921, 19.77129936),
+    ('GMB', 8.128760338, 34.56309891),
+    ('GMD', 33.65539932, -7.221449852),
+    ('GME', 52.52700043, 31.01670074),
+    ('GMI', -6.271110058, 150.3309937),
+    ('GML', 50.60350037, 30.19190025),
+    ('GMM', -1.829403, 15.885237),
+    ('GMN', -42.46170044, 171.1900024),
+    ('GMO', 10.29833333, 10.89638889),
+    ('GMP', 37.55830002, 126.7910004),
+    ('GMR', -23.07990074, -134.8899994),
+    ('GMS', -2.100276, -44.617221),
+    ('GMT', 65.40209961, -161.2810059),
+    ('GMU', 34.84790039, -82.34999847),
+    ('GMV', 37.01670074, -110.2009964),
+    ('GMZ', 28.02960014, -17.21459961),
+    ('GNA', 53.60200119, 24.05380058),
+    ('GNB', 45.36289978, 5.329370022),
+    ('GND', 12.00419998, -61.78620148),
+    ('GNF', 39.94390106, -120.9449997),
+    ('GNG', 42.91719818, -114.7649994),
+    ('GNI', 22.6739006, 121.4660034),
+    ('GNM', -14.20820045, -42.74610138),
+    ('GNN', 7.150000095, 40.71699905),
+    ('GNR', -39.0007019, -67.62049866),
+    ('GNS', 1.166380048, 97.70469666),
+    ('GNT', 35.16730118, -107.9020004),
+    ('GNU', 59.11740112, -161.5769959),
+    ('GNV', 29.69009972, -82.27179718),
+    ('GNY', 37.44566345, 38.89559174),
+    ('GNZ', -21.69249916, 21.65810013),
+    ('GOA', 44.41329956, 8.837499619),
+    ('GOB', 7.1160634, 40.0463033),
+    ('GOC', -9.0021, 148.2364),
+    ('GOE', -5.527716614, 151.5733333),
+    ('GOG', -22.50440025, 18.97310066),
+    ('GOH', 64.19090271, -51.67810059),
+    ('GOI', 15.38080025, 73.83139801),
+    ('GOJ', 56.23009872, 43.7840004),
+    ('GOK', 35.84980011, -97.41560364),
+    ('GOL', 42.41339874, -124.4240036),
+    ('GOM', -1.670809984, 29.2385006),
+    ('GON', 41.33010101, -72.04509735),
+    ('GOO', -28.52140045, 150.3200073),
+    ('GOP', 26.73970032, 83.4496994),
+    ('GOQ', 36.40060043, 94.78610229),
+    ('GOR', 8.1614, 35.5529),
+    ('GOT', 57.66279984, 12.27980042),
+    ('GOU', 9.335889816, 13.37010002),
+    ('GOV', -12.26939964, 136.8179932),
+    ('GOZ', 43.15140152, 25.71290016),
+    ('GPA', 38.15110016, 21.42560005),
+    ('GPB', -25.38750076, -51.52019882),
+    ('GPD', -19.77265, 139.40425),
+    ('GPI', 2.57013, -77.8986),
+    ('GPL', 10.21720028, -83.79699707),
+    ('GPN', -11.40250015, 130.4219971),
+    ('GPO', -35.69620132, -63.75830078),
+    ('GPS', -0.453758001, -90.26589966),
+    ('GPT', 30.40730095, -89.07009888),
+    ('GPZ', 47.21110153, -93.50980377),
+    ('GQQ', 40.7533989, -82.72380066),
+    ('GRB', 44.48509979, -88.12960052),
+    ('GRC', 4.571, -8.2076),
+    ('GRD', 34.24869919, -82.15910339),
+    ('GRE', 38.83620071, -89.37840271),
+    ('GRF', 47.07920074, -122.5810013),
+    ('GRG', 33.63150024, 69.23940277),
+    ('GRI', 40.96749878, -98.30960083),
+    ('GRJ', -34.00559998, 22.37890053),
+    ('GRK', 31.06719971, -97.8289032),
+    ('GRL', -7.980713, 147.213461),
+    ('GRM', 47.8382988, -90.38289642),
+    ('GRN', 42.80599976, -102.1750031),
+    ('GRO', 41.90100098, 2.760550022),
+    ('GRP', -11.73960018, -49.13219833),
+    ('GRQ', 53.11970139, 6.579440117),
+    ('GRR', 42.88079834, -85.52279663),
+    ('GRS', 42.75970078, 11.07190037),
+    ('GRT', 32.60309982, 74.07710266),
+    ('GRU', -23.43555641, -46.47305679),
+    ('GRV', 43.38830185, 45.69860077),
+    ('GRW', 39.09220123, -28.02980042),
+    ('GRX', 37.18870163, -3.777359962),
+    ('GRY', 66.5458, -18.0173),
+    ('GRZ', 46.99110031, 15.43959999),
+    ('GSA', 4.400000095, 115.7170029),
+    ('GSB', 35.33940125, -77.96060181),
+    ('GSC', -25.0546, 115.2026),
+    ('GSE', 57.77470016, 11.87040043),
+    ('GSH', 41.52640152, -85.79290009),
+    ('GSJ', 13.93620014, -90.83580017),
+    ('GSL', 62.59809875, -111.5429993),
+    ('GSM', 26.94869995, 56.26879883),
+    ('GSN', -31.45969963, 137.173996),
+    ('GSO', 36.09780121, -79.93730164),
+    ('GSP', 34.89569855, -82.21890259),
+    ('GSQ', 22.58569908, 28.71660042),
+    ('GSR', 9.517000198, 49.08300018),
+    ('GSS', -24.94737543, 31.44884777),
+    ('GST', 58.4253006, -135.7070007),
+    ('GSU', 14.13300037, 35.31700134),
+    ('GTA', -8.739167, 158.203056),
+    ('GTE', -13.97500038, 136.4600067),
+    ('GTF', 47.48199844, -111.3710022),
+    ('GTG', 45.79809952, -92.66439819),
+    ('GTI', 54.3833313, 13.3255558),
+    ('GTK', 2.599999905, 102.9166641),
+    ('GTN', -43.90670013, 170.128006),
+    ('GTO', 0.637118995, 122.8499985),
+    ('GTP', 42.51010132, -123.3880005),
+    ('GTR', 33.45029831, -88.59140015),
+    ('GTS', -26.94829941, 133.6069946),
+    ('GTT', -18.30500031, 143.5299988),
+    ('GTW', 49.31439972, 17.56889915),
+    ('GTY', 39.84090042, -77.27420044),
+    ('GTZ', -2.160833333, 34.22555556),
+    ('GUA', 14.58329964, -90.52749634),
+    ('GUB', 28.02610016, -114.0240021),
+    ('GUC', 38.53390121, -106.9329987),
+    ('GUD', 16.3614006, -3.599720001),
+    ('GUE', -3.583167, 141.5895),
+    ('GUF', 30.29050064, -87.67179871),
+    ('GUG', -8.1286, 146.8722),
+    ('GUH', -30.96109962, 150.2510071),
+    ('GUI', 10.57407761, -62.31266785),
+    ('GUJ', -22.79159927, -45.20479965),
+    ('GUL', -34.81029892, 149.7259979),
+    ('GUM', 13.48340034, 144.7960052),
+    ('GUP', 35.51110077, -108.7890015),
+    ('GUQ', 9.02694416, -69.75514984),
+    ('GUR', -10.3114996, 150.3339996),
+    ('GUS', 40.64810181, -86.15209961),
+    ('GUT', 51.92279816, 8.306329727),
+    ('GUU', 64.99140167, -23.22470093),
+    ('GUV', -6.280859, 142.420775),
+    ('GUW', 47.12189865, 51.82139969),
+    ('GUX', 24.65469933, 77.34729767),
+    ('GUY', 36.68510056, -101.5080032),
+    ('GUZ', -20.64649963, -40.4919014),
+    ('GVA', 46.23809814, 6.108950138),
+    ('GVE', 38.15599823, -78.165802),
+    ('GVI', -3.902242899, 141.170517),
+    ('GVL', 34.27259827, -83.8302002),
+    ('GVN', 48.92699814, 140.0339966),
+    ('GVP', -18.98329926, 145.1170044),
+    ('GVR', -18.89520073, -41.98220062),
+    ('GVT', 33.06779861, -96.06529999),
+    ('GVX', 60.59329987, 16.95140076),
+    ('GWA', 17.60000038, 94.58329773),
+    ('GWD', 25.23329926, 62.32949829),
+    ('GWE', -19.43639946, 29.86190033),
+    ('GWL', 26.29330063, 78.22779846),
+    ('GWO', 33.49430084, -90.08470154),
+    ('GWS', 39.50830078, -107.310997),
+    ('GWT', 54.91320038, 8.340470314),
+    ('GWV', 39.94869995, -80.7594986),
+    ('GWY', 53.30020142, -8.941590309),
+    ('GXF', 15.96609974, 48.78829956),
+    ('GXG', -7.754509926, 15.2876997),
+    ('GXH', 34.8105, 102.6447),
+    ('GXQ', -45.59420013, -72.10610199),
+    ('GXX', 10.35610008, 15.23719978),
+    ('GXY', 40.43740082, -104.6330032),
+    ('GYA', -10.82059956, -65.34559631),
+    ('GYD', 40.46749878, 50.04669952),
+    ('GYE', -2.15741992, -79.88359833),
+    ('GYI', -1.67719996, 29.25889969),
+    ('GYL', -16.63689995, 128.451004),
+    ('GYM', 27.96899986, -110.9250031),
+    ('GYN', -16.63199997, -49.22069931),
+    ('GYP', -26.28280067, 152.7019958),
+    ('GYR', 33.42250061, -112.3759995),
+    ('GYS', 32.39110184, 105.7020035),
+    ('GYU', 36.07888889, 106.2169444),
+    ('GYY', 41.61629868, -87.41280365),
+    ('GZI', 33.53120041, 68.41290283),
+    ('GZO', -8.097780228, 156.8639984),
+    ('GZP', 36.29921722, 32.30059814),
+    ('GZT', 36.94720078, 37.47869873),
+    ('HAA', 70.48670197, 22.13969994),
+    ('HAB', 34.11759949, -87.99819946),
+    ('HAC', 33.11500168, 139.7859955),
+    ('HAD', 56.69110107, 12.82019997),
+    ('HAF', 37.51340103, -122.5009995),
+    ('HAH', -11.53369999, 43.27190018),
+    ('HAI', 41.95980072, -85.59339905),
+    ('HAJ', 52.46110153, 9.685079575),
+    ('HAK', 19.93490028, 110.4589996),
+    ('HAL', -19.0285, 16.4585),
+    ('HAM', 53.63040161, 9.988229752),
+    ('HAN', 21.22120094, 105.8069992),
+    ('HAO', 39.36380005, -84.52200317),
+    ('HAQ', 6.744229794, 73.17050171),
+    ('HAR', 40.21709824, -76.85150146),
+    ('HAS', 27.43790054, 41.68629837),
+    ('HAT', -11.73690033, 142.5769959),
+    ('HAU', 59.34529877, 5.208360195),
+    ('HAV', 22.98920059, -82.40910339),
+    ('HAW', 51.83309937, -4.961110115),
+    ('HAY', 65.2009964, -161.1569977),
+    ('HAZ', -4.4033, 145.2056),
+    ('HBA', -42.83610153, 147.5099945),
+    ('HBD', -6.32, 142.4893),
+    ('HBE', 30.91769981, 29.69639969),
+    ('HBK', 34.94070053, -110.1380005),
+    ('HBU', 46.1006012, 91.584198),
+    ('HBX', 15.36170006, 75.0848999),
+    ('HCC', 42.29130173, -73.71029663),
+    ('HCM', 7.916999817, 49.79999924),
+    ('HCN', 22.04109955, 120.7300034),
+    ('HCQ', -18.23390007, 127.6699982),
+    ('HCR', 62.18830109, -159.7749939),
+    ('HCW', 34.71289825, -79.95700073),
+    ('HDD', 25.31809998, 68.3660965),
+    ('HDF', 53.87870026, 14.15229988),
+    ('HDG', 36.52583333, 114.4255556),
+    ('HDH', 21.5795002, -158.1970062),
+    ('HDM', 34.86920166, 48.55250168),
+    ('HDN', 40.48120117, -107.2180023),
+    ('HDR', 27.1583004, 56.17250061),
+    ('HDS', -24.36860085, 31.04870033),
+    ('HDY', 6.933209896, 100.3929977),
+    ('HEA', 34.20999908, 62.22829819),
+    ('HEB', 17.63332939, 95.46669769),
+    ('HED', 55.80139923, -160.8990021),
+    ('HEG', 9.994933, 29.397718),
+    ('HEH', 20.74699974, 96.79199982),
+    ('HEI', 54.15333176, 8.901666641),
+    ('HEK', 50.17162094, 127.3088837),
+    ('HEL', 60.31719971, 24.9633007),
+    ('HEM', 60.25460052, 25.0428009),
+    ('HEO', -9.13658, 147.598434),
+    ('HER', 35.33969879, 25.18029976),
+    ('HET', 40.85139847, 111.8239975),
+    ('HEV', 37.36429977, -6.920939922),
+    ('HEW', 37.8932991, 23.72610092),
+    ('HFA', 32.80939865, 35.04309845),
+    ('HFE', 31.78000069, 117.2979965),
+    ('HFN', 64.29560089, -15.22719955),
+    ('HFS', 60.02009964, 13.57890034),
+    ('HFT', 70.67970276, 23.66860008),
+    ('HGA', 9.518170357, 44.08879852),
+    ('HGD', -20.81500053, 144.2250061),
+    ('HGH', 30.22949982, 120.4339981),
+    ('HGI', 10.52916667, 32.50055556),
+    ('HGL', 54.18527985, 7.915832996),
+    ('HGN', 19.30130005, 97.97579956),
+    ('HGO', 9.387180328, -5.556660175),
+    ('HGR', 39.707901, -77.72949982),
+    ('HGS', 8.397130013, -13.12909985),
+    ('HGU', -5.826789856, 144.2960052),
+    ('HGZ', 66.2161026, -155.6690063),
+    ('HHE', 40.5564003, 141.4660034),
+    ('HHH', 32.22439957, -80.69750214),
+    ('HHI', 21.48349953, -158.0399933),
+    ('HHN', 49.94869995, 7.26388979),
+    ('HHQ', 12.63619995, 99.95149994),
+    ('HHZ', -17.54470062, -142.6139984),
+    ('HIA', 33.79083333, 119.125),
+    ('HIB', 47.38660049, -92.83899689),
+    ('HID', -10.58640003, 142.2899933),
+    ('HIG', -16.42440033, 143.1459961),
+    ('HIJ', 34.43610001, 132.9190063),
+    ('HIL', 6.083330154, 44.76670074),
+    ('HIN', 35.08850098, 128.0700073),
+    ('HIP', -21.33329964, 138.2830048),
+    ('HIR', -9.42800045, 160.0549927),
+    ('HIT', -6.9406, 143.059),
+    ('HIW', 34.3669014, 132.4140015),
+    ('HJJ', 27.44111111, 109.7),
+    ('HJR', 24.81719971, 79.91860199),
+    ('HJT', 46.92580032, 102.7730026),
+    ('HKA', 35.94039917, -89.83080292),
+    ('HKB', 63.9958, -144.6926),
+    ('HKD', 41.77000046, 140.8220062),
+    ('HKG', 22.30890083, 113.9150009),
+    ('HKK', -42.71360016, 170.9850006),
+    ('HKN', -5.462170124, 150.4049988),
+    ('HKS', 32.33449936, -90.22219849),
+    ('HKT', 8.113200188, 98.31690216),
+    ('HKV', 41.87179947, 25.60479927),
+    ('HKY', 35.74110031, -81.38950348),
+    ('HLA', -25.93849945, 27.92609978),
+    ('HLB', 39.3445015, -85.25830078),
+    ('HLC', 39.37879944, -99.83149719),
+    ('HLD', 49.20500183, 119.8249969),
+    ('HLF', 57.5257988, 15.82330036),
+    ('HLG', 40.17499924, -80.64630127),
+    ('HLH', 46.195333, 122.008333),
+    ('HLI', 36.8932991, -121.4100037),
+    ('HLJ', 56.0705986, 23.55809975),
+    ('HLL', -21.7244, 119.3922),
+    ('HLM', 42.79589844, -86.16200256),
+    ('HLN', 46.60680008, -111.9830017),
+    ('HLO', 66.05000305, -23.69610023),
+    ('HLP', -6.266610146, 106.8909988),
+    ('HLR', 31.13870049, -97.71450043),
+    ('HLS', -41.33670044, 148.2819977),
+    ('HLT', -37.64889908, 142.0650024),
+    ('HLU', -21.25639915, 165.6170044),
+    ('HLV', -15.6857996, 145.2149963),
+    ('HLW', -28.01660499, 32.27517128),
+    ('HLZ', -37.86669922, 175.3320007),
+    ('HMA', 61.0284996, 69.08609772),
+    ('HMB', 26.34277778, 31.74277778),
+    ('HME', 31.67300034, 6.140439987),
+    ('HMG', -23.93000031, 132.8049927),
+    ('HMI', 42.84140015, 93.66919708),
+    ('HMJ', 49.35969925, 26.9333992),
+    ('HMN', 32.85250092, -106.1070023),
+    ('HMO', 29.09589958, -111.0479965),
+    ('HMR', 60.81809998, 11.06799984),
+    ('HMT', 33.73400116, -117.0230026),
+    ('HMV', 65.80609894, 15.08279991),
+    ('HNA', 39.42860031, 141.1349945),
+    ('HNB', 38.24900055, -86.9536972),
+    ('HNC', 35.23279953, -75.61779785),
+    ('HND', 35.5522995, 139.7799988),
+    ('HNE', 61.90140152, -147.3040009),
+    ('HNH', 58.09609985, -135.4100037),
+    ('HNI', -7.4223, 146.4276),
+    ('HNL', 21.31870079, -157.9219971),
+    ('HNM', 20.79560089, -156.0140076),
+    ('HNN', -16.2457, 142.1771),
+    ('HNS', 59.24380112, -135.5240021),
+    ('HNY', 26.90530014, 112.6279984),
+    ('HOA', -1.521999955, 40.00400162),
+    ('HOB', 32.6875, -103.2170029),
+    ('HOC', -7.3984, 145.8827),
+    ('HOD', 14.75300026, 42.97629929),
+    ('HOE', 20.25729942, 100.4369965),
+    ('HOF', 25.2852993, 49.48519897),
+    ('HOG', 20.78560066, -76.31510162),
+    ('HOH', 47.38499832, 9.699999809),
+    ('HOI', -18.07480049, -140.9459991),
+    ('HOK', -18.33670044, 130.6380005),
+    ('HOM', 59.64559937, -151.477005),
+    ('HON', 44.3852005, -98.22850037),
+    ('HOO', 11.9787, 107.5638),
+    ('HOP', 36.66859818, -87.49620056),
+    ('HOQ', 50.28861237, 11.85638905),
+    ('HOR', 38.51990128, -28.71590042),
+    ('HOS', -37.44469833, -70.22250366),
+    ('HOT', 34.47800064, -93.09619904),
+    ('HOU', 29.64539909, -95.27890015),
+    ('HOV', 62.18000031, 6.074100018),
+    ('HOX', 24.89959908, 94.91400146),
+    ('HOY', 58.79177893, -3.216075897),
+    ('HPA', -19.77700043, -174.3410034),
+    ('HPB', 61.52389908, -166.1470032),
+    ('HPE', -15.2923, 145.1035),
+    ('HPH', 20.81940079, 106.7249985),
+    ('HPN', 41.06700134, -73.70760345),
+    ('HPT', 42.72370148, -93.2263031),
+    ('HPV', 22.20919991, -159.4459991),
+    ('HPY', 29.78610039, -94.95269775),
+    ('HQM', 46.97119904, -123.9369965),
+    ('HRA', 34.33332062, 73.20020294),
+    ('HRB', 45.62340164, 126.25),
+    ('HRC', 48.3974, 70.1915),
+    ('HRE', -17.93180084, 31.09280014),
+    ('HRG', 27.17830086, 33.79940033),
+    ('HRI', 6.284467, 81.124128),
+    ('HRK', 49.92480087, 36.29000092),
+    ('HRL', 26.22850037, -97.65440369),
+    ('HRM', 32.93040085, 3.311539888),
+    ('HRO', 36.26150131, -93.15470123),
+    ('HRR', 3.216667, -75.85),
+    ('HRS', -28.23509979, 29.10619926),
+    ('HRT', 54.0489006, -1.252750039),
+    ('HRY', -24.584, 133.236),
+    ('HRZ', -27.63829994, -54.33909988),
+    ('HSB', 37.81129837, -88.5503006),
+    ('HSC', 24.97859955, 113.4209976),
+    ('HSG', 33.14970016, 130.302002),
+    ('HSH', 35.97280121, -115.1340027),
+    ('HSI', 40.6053009, -98.42790222),
+    ('HSK', 42.0760994, -0.316666991),
+    ('HSL', 65.69789886, -156.3509979),
+    ('HSM', -36.66970062, 142.1730042),
+    ('HSN', 29.93420029, 122.3619995),
+    ('HSP', 37.95140076, -79.83390045),
+    ('HSS', 29.17939949, 75.75530243),
+    ('HST', 25.48859978, -80.38359833),
+    ('HSV', 34.6371994, -86.77510071),
+    ('HSZ', 24.81800079, 120.939003),
+    ('HTA', 52.02629852, 113.3059998),
+    ('HTG', 71.97810364, 102.4909973),
+    ('HTI', -20.35810089, 148.9519959),
+    ('HTM', 50.435988, 100.139532),
+    ('HTN', 37.03850174, 79.86489868),
+    ('HTO', 40.95959854, -72.25180054),
+    ('HTR', 24.05890083, 123.8059998),
+    ('HTS', 38.36669922, -82.55799866),
+    ('HTU', -35.71530151, 142.3600006),
+    ('HTV', 30.74690056, -95.58719635),
+    ('HTY', 36.36277771, 36.28222275),
+    ('HTZ', 6.15, -71.75),
+    ('HUA', 34.67869949, -86.68479919),
+    ('HUB', -16.4896698, 130.6302795),
+    ('HUC', 18.13809967, -65.80130005),
+    ('HUD', 42.73609924, -94.24520111),
+    ('HUE', 14.25, 36.58300018),
+    ('HUF', 39.45149994, -87.30760193),
+    ('HUG', 15.32740021, -91.46240234),
+    ('HUH', -16.68720055, -151.0220032),
+    ('HUI', 16.4015007, 107.7030029),
+    ('HUJ', 34.03480148, -95.54190063),
+    ('HUK', -23.98970032, 21.75810051),
+    ('HUL', 46.12310028, -67.792099),
+    ('HUM', 29.56649971, -90.66040039),
+    ('HUN', 24.0230999, 121.6179962),
+    ('HUQ', 29.11009979, 15.96560001),
+    ('HUS', 66.04109955, -154.2630005),
+    ('HUT', 38.06549835, -97.86060333),
+    ('HUU', -9.878809929, -76.20480347),
+    ('HUW', -7.532120228, -63.07210159),
+    ('HUX', 15.77530003, -96.26260376),
+    ('HUY', 53.57440186, -0.350832999),
+    ('HUZ', 23.04999924, 114.5999985),
+    ('HVA', -14.62969971, 47.76380157),
+    ('HVB', -25.31889915, 152.8800049),
+    ('HVD', 47.95410156, 91.62819672),
+    ('HVE', 38.41799927, -110.7040024),
+    ('HVG', 71.00969696, 25.98360062),
+    ('HVK', 65.70469666, -21.69639969),
+    ('HVM', 65.2664032, -20.84690094),
+    ('HVN', 41.26369858, -72.88680267),
+    ('HVR', 48.54299927, -109.762001),
+    ('HVS', 34.40309906, -80.11920166),
+    ('HWA', -7.392994, 146.003487),
+    ('HWD', 37.65919876, -122.1220016),
+    ('HWK', -31.85590744, 138.4680786),
+    ('HWN', -18.62989998, 27.02099991),
+    ('HWO', 26.00119972, -80.24069977),
+    ('HXX', -34.53139877, 144.8300018),
+    ('HYA', 41.66930008, -70.28040314),
+    ('HYC', 51.61169815, -0.80833298),
+    ('HYD', 17.23131752, 78.42985535),
+    ('HYF', -3.69838, 143.057902),
+    ('HYN', 28.56220055, 121.4290009),
+    ('HYR', 46.02519989, -91.44429779),
+    ('HYS', 38.84220123, -99.27320099),
+    ('HYV', 60.65439987, 24.8810997),
+    ('HZB', 50.61840057, 2.642240047),
+    ('HZG', 33.06359863, 107.0080032),
+    ('HZH', 26.32217, 109.1499),
+    ('HZK', 65.95230103, -17.4260006),
+    ('HZL', 40.98680115, -75.99490356),
+    ('HZP', 57.38169861, -111.7009964),
+    ('HZV', -25.05010033, 31.13190079),
+    ('IAA', 67.43720245, 86.62190247),
+    ('IAB', 37.62189865, -97.26820374),
+    ('IAD', 38.94449997, -77.45580292),
+    ('IAG', 43.1072998, -78.94619751),
+    ('IAH', 29.9843998, -95.34140015),
+    ('IAL', -6.2782, 143.9944),
+    ('IAM', 28.05150032, 9.642910004),
+    ('IAN', 66.97599792, -160.4369965),
+    ('IAO', 9.859100342, 126.0139999),
+    ('IAR', 57.56069946, 40.15739822),
+    ('IAS', 47.17850113, 27.62059975),
+    ('IBA', 7.362460136, 3.978329897),
+    ('IBB', -0.942628026, -90.95300293),
+    ('IBE', 4.42161, -75.1333),
+    ('IBI', -5.5536, 149.19),
+    ('IBL', -21.70800018, 35.45280075),
+    ('IBP', -11.41160011, -69.48870087),
+    ('IBR', 36.18109894, 140.4149933),
+    ('IBZ', 38.8728981, 1.37311995),
+    ('ICA', 4.336319923, -61.73960114),
+    ('ICI', -17.74329948, -179.3419952),
+    ('ICK', 5.955560207, -57.03939819),
+    ('ICL', 40.72180176, -95.02639771),
+    ('ICN', 37.46910095, 126.4509964),
+    ('ICO', 11.4595, 123.2506),
+    ('ICR', 20.68860054, -75.53150177),
+    ('ICS', 44.49380112, -116.0159988),
+    ('ICT', 37.64989853, -97.43309784),
+    ('ICY', 59.96900177, -141.6620026),
+    ('IDA', 43.51459885, -112.0709991),
+    ('IDB', 61.86970139, 12.68939972),
+    ('IDF', -5.0, 19.60000038),
+    ('IDH', 45.94260025, -116.1230011),
+    ('IDI', 40.63219833, -79.10549927),
+    ('IDK', -26.9666996, 133.3249969),
+    ('IDN', -6.22663, 147.244),
+    ('IDO', -11.57229996, -50.66619873),
+    ('IDP', 37.15840149, -95.77839661),
+    ('IDR', 22.72179985, 75.80110168),
+    ('IDY', 46.71860123, -2.391109943),
+    ('IEG', 52.13850021, 15.7986002),
+    ('IEJ', 26.72200012, 127.7850037),
+    ('IES', 51.29360962, 13.35611057),
+    ('IEV', 50.40169907, 30.4496994),
+    ('IFA', 42.47079849, -93.26999664),
+    ('IFF', -18.89999962, 141.2169952),
+    ('IFH', 32.92890167, 51.56110001),
+    ('IFJ', 66.05809784, -23.13529968),
+    ('IFL', -17.55940056, 146.0119934),
+    ('IFN', 32.75080109, 51.86130142),
+    ('IFO', 48.88420105, 24.68610001),
+    ('IFP', 35.15739822, -114.5599976),
+    ('IGA', 20.97500038, -73.66690063),
+    ('IGB', -41.32089996, -69.57489777),
+    ('IGD', 39.97662735, 43.87664795),
+    ('IGE', -1.9223, 9.3092),
+    ('IGG', 59.32400131, -155.9019928),
+    ('IGH', -18.66060066, 146.1519928),
+    ('IGL', 38.51300049, 27.01009941),
+    ('IGM', 35.2594986, -113.9380035),
+    ('IGN', 8.130490303, 124.2149963),
+    ('IGO', 7.68038, -76.6865),
+    ('IGR', -25.73730087, -54.47340012),
+    ('IGS', 48.71569824, 11.5340004),
+    ('IGT', 43.32229996, 45.01259995),
+    ('IGU', -25.60027885, -54.48500061),
+    ('IHC', -25.9971447, 32.92935181),
+    ('IHN', 15.41699982, 51.68299866),
+    ('IHO', -22.4047202, 46.16493702),
+    ('IHR', 27.23609924, 60.72000122),
+    ('IHU', -7.89756012, 145.3959961),
+    ('IIA', 53.0929985, -9.568059921),
+    ('IIL', 33.58660126, 46.40480042),
+    ('IIS', -4.499720097, 154.2259979),
+    ('IJK', 56.8280983, 53.45750046),
+    ('IJU', -28.36870003, -53.84659958),
+    ('IKA', 35.41609955, 51.15219879),
+    ('IKB', 36.22280121, -81.09829712),
+    ('IKI', 33.74900055, 129.7850037),
+    ('IKK', 41.07139969, -87.84629822),
+    ('IKL', -1.200000048, 23.28300095),
+    ('IKO', 52.9416008, -168.848999),
+    ('IKP', -16.27499962, 141.4420013),
+    ('IKS', 71.6977005, 128.9029999),
+    ('IKT', 52.26800156, 104.3889999),
+    ('ILA', -3.97648, 137.6225),
+    ('ILD', 41.728185, 0.535023),
+    ('ILE', 31.08580017, -97.68650055),
+    ('ILF', 56.06140137, -95.61389923),
+    ('ILG', 39.67869949, -75.60649872),
+    ('ILI', 59.75439835, -154.9109955),
+    ('ILK', -19.58300018, 48.8030014),
+    ('ILL', 45.11769867, -95.13040161),
+    ('ILM', 34.27059937, -77.90260315),
+    ('ILN', 39.42789841, -83.792099),
+    ('ILO', 10.833017, 122.493358),
+    ('ILP', -22.58889961, 167.4559937),
+    ('ILQ', -17.69499969, -71.34400177),
+    ('ILR', 8.440210342, 4.493919849),
+    ('ILU', -2.910609961, 38.06520081),
+    ('ILY', 55.68190002, -6.256669998),
+    ('ILZ', 49.23149872, 18.6135006),
+    ('IMA', -9.5107, 150.5246),
+    ('IMB', 5.708109856, -60.2942009),
+    ('IMD', -3.328, 141.1573),
+    ('IMF', 24.76000023, 93.896698),
+    ('IMG', -18.41, 35.0045),
+    ('IMK', 29.97109985, 81.81890106),
+    ('IML', 40.50930023, -101.6210022),
+    ('IMM', 26.43320084, -81.40100098),
+    ('IMN', -6.7524, 146.1072),
+    ('IMO', 5.050000191, 25.14999962),
+    ('IMP', -5.531290054, -47.45999908),
+    ('IMT', 45.81840134, -88.11450195),
+    ('INA', 66.05483246, 60.11032104),
+    ('INB', 16.53450012, -88.44129944),
+    ('INC', 38.48189926, 106.0090027),
+    ('IND', 39.71730042, -86.29440308),
+    ('INE', -18.59, 36.4489),
+    ('INF', 19.56699944, 5.75),
+    ('ING', -50.33610153, -72.24859619),
+    ('INH', -23.87639999, 35.40850067),
+    ('INI', 43.337299, 21.853701),
+    ('INJ', -25.85000038, 148.5330048),
+    ('INK', 31.77960014, -103.2009964),
+    ('INL', 48.56620026, -93.40309906),
+    ('INM', -27.70000076, 140.7330017),
+    ('INN', 47.2602005, 11.34399986),
+    ('INO', -1.947219968, 18.28580093),
+    ('INQ', 53.06470108, -9.510899544),
+    ('INS', 36.58720016, -115.6729965),
+    ('INT', 36.13370132, -80.22200012),
+    ('INU', -0.547458, 166.919006),
+    ('INV', 57.54249954, -4.047500134),
+    ('INW', 35.02190018, -110.7229996),
+    ('INX', -2.128099918, 132.1609955),
+    ('INY', -24.77770042, 31.38549995),
+    ('INZ', 27.25099945, 2.512020111),
+    ('IOA', 39.69639969, 20.82250023),
+    ('IOK', -8.401, 146.277),
+    ('IOM', 54.08330154, -4.623889923),
+    ('ION', 1.616999984, 18.06699944),
+    ('IOP', -8.3614, 147.84),
+    ('IOR', 53.1067009, -9.653610229),
+    ('IOS', -14.81599998, -39.03319931),
+    ('IOU', -22.45999908, 166.7830048),
+    ('IOW', 41.6391983, -91.54650116),
+    ('IPA', -18.856389, 169.283333),
+    ('IPC', -27.16480064, -109.4219971),
+    ('IPE', 7.785560131, 122.6019974),
+    ('IPG', -2.939069986, -69.69400024),
+    ('IPH', 4.567969799, 101.0920029),
+    ('IPI', 0.861925, -77.6718),
+    ('IPL', 32.83420181, -115.5790024),
+    ('IPN', -19.47069931, -42.48759842),
+    ('IPT', 41.2417984, -76.9210968),
+    ('IPU', -14.1338892, -39.73389053),
+    ('IQA', 33.78559875, 42.44120026),
+    ('IQM', 38.1493988, 85.53279877),
+    ('IQN', 35.79970169, 107.6029968),
+    ('IQQ', -20.53520012, -70.1812973),
+    ('IQT', -3.784739971, -73.30879974),
+    ('IRA', -10.44970036, 161.897995),
+    ('IRB', 30.90570068, -101.8919983),
+    ('IRC', 65.83049774, -144.076004),
+    ('IRD', 24.15250015, 89.04940033),
+    ('IRE', -11.33990002, -41.84700012),
+    ('IRG', -12.78689957, 143.3049927),
+    ('IRI', -7.668630123, 35.7521019),
+    ('IRJ', -29.38159943, -66.79579926),
+    ('IRK', 40.09349823, -92.54489899),
+    ('IRM', 63.19879913, 64.43930054),
+    ('IRN', 15.93916702, -85.13722229),
+    ('IRO', 10.23639965, 22.71689987),
+    ('IRP', 2.827610016, 27.5883007),
+    ('IRS', 41.81330109, -85.43900299),
+    ('IRZ', -0.3786, -64.9923),
+    ('ISA', -20.66390038, 139.4889984),
+    ('ISB', 33.61669922, 73.09919739),
+    ('ISC', 49.91329956, -6.291669846),
+    ('ISE', 37.85540009, 30.36840057),
+    ('ISG', 24.34449959, 124.1869965),
+    ('ISI', -24.25830078, 144.4250031),
+    ('ISJ', 21.24500084, -86.73999786),
+    ('ISK', 19.96369934, 73.80760193),
+    ('isl', 10.93666744, -65.22333527),
+    ('ISM', 28.28980064, -81.43710327),
+    ('ISN', 48.17789841, -103.6419983),
+    ('ISO', 35.33140183, -77.6088028),
+    ('ISP', 40.79520035, -73.10019684),
+    ('ISQ', 45.97460175, -86.17179871),
+    ('ISS', 43.96139908, -69.71260071),
+    ('IST', 40.97689819, 28.81459999),
+    ('ISU', 35.5617485, 45.31673813),
+    ('ISW', 44.36029816, -89.83899689),
+    ('ITA', -3.12725997, -58.48120117),
+    ('ITB', -4.242340088, -56.0007019),
+    ('ITE', -13.7322216, -39.14166641),
+    ('ITH', 42.49100113, -76.45839691),
+    ('ITI', -8.699999809, -51.17416763),
+    ('ITK', -9.201527, 148.264261),
+    ('ITM', 34.78549957, 135.4380035),
+    ('ITO', 19.72139931, -155.0480042),
+    ('ITP', -21.21929932, -41.87590027),
+    ('ITQ', -29.17309952, -56.5367012),
+    ('ITR', -18.44470024, -49.21340179),
+    ('IUE', -19.07903099, -169.9255981),
+    ('IUL', -3.7051, 138.2002),
+    ('IVA', -13.64570531, 48.45942736),
+    ('IVC', -46.41239929, 168.3130035),
+    ('IVG', 42.8390007, 19.86199951),
+    ('IVL', 68.6072998, 27.40530014),
+    ('IVR', -29.88829994, 151.1439972),
+    ('IVW', -17.84110069, 129.6430054),
+    ('IWA', 56.93939972, 40.94079971),
+    ('IWD', 46.52750015, -90.13140106),
+    ('IWJ', 34.67639923, 131.7899933),
+    ('IWO', 24.7840004, 141.322998),
+    ('IWS', 29.81819916, -95.67259979),
+    ('IXA', 23.88699913, 91.24040222),
+    ('IXB', 26.68120003, 88.32859802),
+    ('IXC', 30.67350006, 76.78849792),
+    ('IXD', 25.44009972, 81.73390198),
+    ('IXE', 12.9612999, 74.89009857),
+    ('IXG', 15.85929966, 74.61830139),
+    ('IXH', 24.30820084, 92.00720215),
+    ('IXI', 27.2954998, 94.09760284),
+    ('IXJ', 32.68909836, 74.83740234),
+    ('IXK', 21.31710052, 70.270401),
+    ('IXL', 34.13589859, 77.54650116),
+    ('IXM', 9.83450985, 78.09339905),
+    ('IXN', 24.06189919, 91.60389709),
+    ('IXP', 32.23379898, 75.63459778),
+    ('IXQ', 24.13170052, 91.81420135),
+    ('IXR', 23.31430054, 85.32170105),
+    ('IXS', 24.91290092, 92.97869873),
+    ('IXT', 28.06609917, 95.33560181),
+    ('IXU', 19.86269951, 75.39810181),
+    ('IXV', 28.1753006, 94.80200195),
+    ('IXW', 22.8132, 86.16880035),
+    ('IXY', 23.11269951, 70.10030365),
+    ('IXZ', 11.64120007, 92.72969818),
+    ('IYK', 35.65879822, -117.8300018),
+    ('IZA', -21.5130558, -43.17305756),
+    ('IZO', 35.41360092, 132.8899994),
+    ('IZT', 16.44930077, -95.09369659),
+    ('JAA', 34.39979935, 70.49859619),
+    ('JAB', -12.6583004, 132.8930054),
+    ('JAC', 43.6072998, -110.737999),
+    ('JAD', -32.09749985, 115.8809967),
+    ('JAF', 9.792329788, 80.07009888),
+    ('JAG', 28.28420067, 68.4496994),
+    ('JAI', 26.82419968, 75.81220245),
+    ('JAK', 18.24110031, -72.51850128),
+    ('JAL', 19.47509956, -96.79750061),
+    ('JAM', 42.45489883, 26.35219955),
+    ('JAN', 32.31119919, -90.07589722),
+    ('JAP', 9.981410027, -84.77269745),
+    ('JAQ', -5.652500153, 151.5070038),
+    ('JAR', 28.58670044, 53.57910156),
+    ('JAS', 30.88570023, -94.03489685),
+    ('JAT', 7.749774756, 168.9778376),
+    ('JAU', -11.78310013, -75.4733963),
+    ('JAV', 69.24320221, -51.05709839),
+    ('JAX', 30.49410057, -81.68789673),
+    ('JBQ', 18.57250023, -69.98560333),
+    ('JBR', 35.83169937, -90.64640045),
+    ('JCB', -27.17140007, -51.55329895),
+    ('JCI', 38.83089828, -94.89029694),
+    ('JCK', -20.66830063, 141.7230072),
+    ('JCM', -11.16320038, -40.55310059),
+    ('JCR', -6.233160019, -57.77690125),
+    ('JCT', 30.51129913, -99.7634964),
+    ('JCY', 30.25180054, -98.62249756),
+    ('JDA', 44.40420151, -118.9629974),
+    ('JDF', -21.79150009, -43.38679886),
+    ('JDG', 33.39960098, 126.711998),
+    ('JDH', 26.25110054, 73.04889679),
+    ('JDN', 47.3288002, -106.9530029),
+    ('JDO', -7.218959808, -39.27009964),
+    ('JDR', -21.08499908, -44.22472382),
+    ('JDZ', 29.33860016, 117.1760025),
+    ('JED', 21.67959976, 39.15650177),
+    ('JEE', 18.66309929, -74.17030334),
+    ('JEF', 38.59120178, -92.15609741),
+    ('JEG', 68.72180176, -52.78469849),
+    ('JEJ', 7.565350056, 168.9620056),
+    ('JEQ', -13.87769985, -40.07160187),
+    ('JER', 49.207901, -2.195509911),
+    ('JFK', 40.63980103, -73.77890015),
+    ('JFN', 41.77799988, -80.69550323),
+    ('JFR', 62.01473618, -49.67093658),
+    ('JGA', 22.46549988, 70.01260376),
+    ('JGB', 19.07430077, 82.03679657),
+    ('JGD', 50.375, 124.1166667),
+    ('JGN', 39.85689926, 98.34140015),
+    ('JGS', 26.85689926, 114.7369995),
+    ('JHB', 1.641309977, 103.6699982),
+    ('JHG', 21.97389984, 100.7600021),
+    ('JHL', 57.22389984, -111.4189987),
+    ('JHM', 20.96290016, -156.6730042),
+    ('JHQ', -20.277221, 148.755556),
+    ('JHS', 66.95130157, -53.72930145),
+    ('JHW', 42.15340042, -79.25800323),
+    ('JIA', -11.41944408, -58.70166779),
+    ('JIB', 11.54730034, 43.15950012),
+    ('JIC', 38.54222222, 102.3483333),
+    ('JIJ', 9.3325, 42.9121),
+    ('JIK', 37.68270111, 26.3470993),
+    ('JIL', 44.00220108, 126.3960037),
+    ('JIM', 7.666090012, 36.8166008),
+    ('JIN', 0.449999988, 33.20000076),
+    ('JIP', -1.0, -80.66666412),
+    ('JIQ', 29.51333333, 108.8311111),
+    ('JIR', 27.61700058, 86.21700287),
+    ('JIU', 29.476944, 115.801111),
+    ('JIW', 25.06780052, 61.80540085),
+    ('JJI', -7.169099808, -76.72859955),
+    ('JJM', 0.165083006, 38.19514084),
+    ('JJN', 24.79640007, 118.5899963),
+    ('JKG', 57.75759888, 14.06869984),
+    ('JKH', 38.34320068, 26.1406002),
+    ('JKL', 36.9632988, 26.94059944),
+    ('JKR', 26.70879936, 85.92240143),
+    ('JKV', 31.86930084, -95.2173996),
+    ('JLA', 60.48270035, -149.7189941),
+    ('JLN', 37.15179825, -94.49829865),
+    ('JLR', 23.17779922, 80.05200195),
+    ('JLS', -20.29299927, -50.54639816),
+    ('JMB', -14.6981926, 16.07014847),
+    ('JMK', 37.43510056, 25.34810066),
+    ('JMO', 28.780426, 83.723),
+    ('JMS', 46.92969894, -98.67819977),
+    ('JMU', 46.84339905, 130.4649963),
+    ('JNA', -15.47379971, -44.38550186),
+    ('JNB', -26.13920021, 28.24600029),
+    ('JNG', 35.29277778, 116.3466667),
+    ('JNI', -34.5459, -60.9306),
+    ('JNJ', 19.50222222, 57.63444444),
+    ('JNU', 58.35499954, -134.576004),
+    ('JNX', 37.08110046, 25.36809921),
+    ('JNZ', 41.10139847, 121.0619965),
+    ('JOE', 62.66289902, 29.60750008),
+    ('JOG', -7.788179874, 110.4319992),
+    ('JOH', -31.60589981, 29.51980019),
+    ('JOI', -26.22450066, -48.79740143),
+    ('JOJ', 68.12527466, -106.5852814),
+    ('JOK', 56.70059967, 47.90470123),
+    ('JOL', 6.05366993, 121.0110016),
+    ('JOM', -9.350000381, 34.79999924),
+    ('JOP', -4.747083333, 145.0070833),
+    ('JOS', 9.639829636, 8.869050026),
+    ('JOT', 41.51779938, -88.17549896),
+    ('JPA', -7.145833015, -34.94861221),
+    ('JPR', -10.87080002, -61.8465004),
+    ('JQA', 70.73419952, -52.69620132),
+    ('JQE', 7.517779827, -78.15720367),
+    ('JRF', 21.30739975, -158.0700073),
+    ('JRH', 26.73150063, 94.17549896),
+    ('JRN', -10.30583286, -58.48944473),
+    ('JRO', -3.429409981, 37.07450104),
+    ('JSA', 26.88870049, 70.86499786),
+    ('JSH', 35.21609879, 26.10129929),
+    ('JSI', 39.17710114, 23.50370026),
+    ('JSM', -44.04859924, -70.45890045),
+    ('JSR', 23.18379974, 89.16079712),
+    ('JSS', 37.34889984, 23.25399971),
+    ('JST', 40.31610107, -78.83390045),
+    ('JSU', 65.41249847, -52.93939972),
+    ('JSY', 37.42279816, 24.95089912),
+    ('JTC', -22.16685914, -49.05028667),
+    ('JTI', -17.82990074, -51.77299881),
+    ('JTR', 36.39920044, 25.47929955),
+    ('JTY', 36.57989883, 26.37579918),
+    ('JUA', -11.2966, -57.5495),
+    ('JUB', 4.872010231, 31.60110092),
+    ('JUH', 30.7403, 117.6856),
+    ('JUI', 53.68111038, 7.055832863),
+    ('JUJ', -24.39279938, -65.09780121),
+    ('JUL', -15.46710014, -70.15820313),
+    ('JUM', 29.27420044, 82.19329834),
+    ('JUN', -24.8416996, 143.0579987),
+    ('JUR', -30.29999924, 115.0329971),
+    ('JUT', 14.65260029, -86.22029877),
+    ('JUV', 72.79019928, -56.13059998),
+    ('JUZ', 28.96579933, 118.8990021),
+    ('JVA', -18.80500952, 45.2734673),
+    ('JVI', 40.5243988, -74.5983963),
+    ('JVL', 42.62030029, -89.04160309),
+    ('JWA', -24.60230064, 24.69099998),
+    ('JWN', 36.77370071, 48.3594017),
+    ('JXA', 45.293, 131.193),
+    ('JXN', 42.25979996, -84.45939636),
+    ('JYR', 28.7269001, 57.67029953),
+    ('JYV', 62.3995018, 25.67830086),
+    ('JZH', 32.85333333, 103.6822222),
+    ('KAA', -10.2166996, 31.13330078),
+    ('KAB', -16.51980019, 28.88500023),
+    ('KAC', 37.02059937, 41.19139862),
+    ('KAD', 10.6960001, 7.320109844),
+    ('KAF', -6.2655, 155.3052),
+    ('KAG', 37.75360107, 128.9440002),
+    ('KAI', 5.172754765, -59.49148178),
+    ('KAJ', 64.28549957, 27.69239998),
+    ('KAL', 64.31909943, -158.7409973),
+    ('KAM', 15.36330032, 42.60499954),
+    ('KAN', 12.04759979, 8.524620056),
+    ('KAO', 65.98760223, 29.23940086),
+    ('KAP', -8.350000381, 22.58300018),
+    ('KAQ', -8.150694444, 146.834),
+    ('KAR', 5.865340233, -60.61420059),
+    ('KAS', -28.0297, 18.7385),
+    ('KAT', -35.06999969, 173.2850037),
+    ('KAU', 63.1271019, 23.05139923),
+    ('KAV', 5.632999897, -61.78300095),
+    ('KAW', 10.04930019, 98.53800201),
+    ('KAX', -27.69000053, 114.262001),
+    ('KAY', -17.61700058, 179.0169983),
+    ('KAZ', 1.185279965, 127.8960037),
+    ('KBA', 9.638322913, -11.51556015),
+    ('KBB', -17.7791996, 129.2100067),
+    ('KBC', 66.27400208, -145.8240051),
+    ('KBD', -17.39780045, 124.3550034),
+    ('KBF', -3.684, 138.479),
+    ('KBG', 2.326390028, 31.49780083),
+    ('KBH', 29.13333321, 66.51667023),
+    ('KBI', 2.873889923, 9.977780342),
+    ('KBJ', -24.26000023, 131.4900055),
+    ('KBL', 34.5658989, 69.21230316),
+    ('KBM', -6.155472222, 147.1914722),
+    ('KBN', -6.132999897, 24.4829998),
+    ('KBO', -6.083000183, 26.91699982),
+    ('KBP', 50.34500122, 30.8946991),
+    ('KBQ', -13.0145998, 33.46860123),
+    ('KBR', 6.16685009, 102.2929993),
+    ('KBS', 7.944399834, -11.76099968),
+    ('KBT', 8.900560379, 170.8439941),
+    ('KBU', -3.294719934, 116.1650009),
+    ('KBV', 8.09912014, 98.98619843),
+    ('KBX', -1.3169, 132.2857),
+    ('KBY', -32.83580017, 134.2929993),
+    ('KBZ', -42.42499924, 173.6049957),
+    ('KCA', 41.7181015, 82.98690033),
+    ('KCB', 3.150000095, -55.71699905),
+    ('KCD', -6.1851, 138.6372),
+    ('KCE', -20.59670067, 147.8600006),
+    ('KCF', 27.16670036, 69.3167038),
+    ('KCH', 1.484699965, 110.3470001),
+    ('KCI', -8.350000381, 127.0500031),
+    ('KCJ', -7.2714, 143.5952),
+    ('KCK', 57.773, 108.064),
+    ('KCL', 56.31119919, -158.5359955),
+    ('KCM', 37.53882599, 36.95352173),
+    ('KCO', 40.73500061, 30.08329964),
+    ('KCQ', 56.25500107, -158.7749939),
+    ('KCR', 63.56769943, -155.9889984),
+    ('KCS', -24.42329979, 131.8350067),
+    ('KCT', 5.99368, 80.32029724),
+    ('KCU', 1.758059978, 31.73670006),
+    ('KCZ', 33.54610062, 133.6690063),
+    ('KDA', 12.89850044, -14.96809959),
+    ('KDB', -31.1907, 121.5978),
+    ('KDC', 11.13300037, 2.933000088),
+    ('KDD', 27.79059982, 66.64730072),
+    ('KDE', -5.6952, 142.7441),
+    ('KDH', 31.50580025, 65.84780121),
+    ('KDI', -4.081610203, 122.4179993),
+    ('KDJ', -0.182999998, 10.75),
+    ('KDK', 57.80590057, -152.3739929),
+    ('KDL', 58.99079895, 22.83069992),
+    ('KDM', 0.488130987, 72.99690247),
+    ('KDN', -2.400000095, 11.36699963),
+    ('KDO', 1.85916996, 73.52189636),
+    ('KDP', -5.840611111, 143.5072222),
+    ('KDQ', -3.600556, 141.051667),
+    ('KDR', -6.19217, 149.54783),
+    ('KDS', -24.3388, 139.2785),
+    ('KDT', 14.10200024, 99.91719818),
+    ('KDU', 35.33549881, 75.53600311),
+    ('KDV', -19.05809975, 178.1569977),
+    ('KDX', 11.13799953, 29.7010994),
+    ('KDY', 62.78900146, 136.8549957),
+    ('KEA', -7.666669846, 140.5),
+    ('KEB', 59.35210037, -151.9250031),
+    ('KEC', -10.35000038, 28.63299942),
+    ('KED', 16.15950012, -13.50759983),
+    ('KEE', -0.082999997, 14.53299999),
+    ('KEF', 63.98500061, -22.60560036),
+    ('KEG', -5.832777778, 145.0972222),
+    ('KEI', -6.5418, 139.3318),
+    ('KEJ', 55.27009964, 86.10720062),
+    ('KEK', 59.35680008, -157.470993),
+    ('KEL', 54.37944412, 10.14527798),
+    ('KEM', 65.77870178, 24.58209991),
+    ('KEN', 7.891290188, -11.17660046),
+    ('KEO', 9.5, -7.566999912),
+    ('KEP', 28.10359955, 81.66699982),
+    ('KEQ', -0.637100995, 133.128006),
+    ('KER', 30.27440071, 56.9510994),
+    ('KES', 56.03749847, -96.50969696),
+    ('KET', 21.3015995, 99.63600159),
+    ('KEV', 61.85599899, 24.78660011),
+    ('KEW', 52.99110031, -92.83640289),
+    ('KEX', -7.538888889, 145.905),
+    ('KEY', -0.416999996, 35.25),
+    ('KFA', 16.59000015, -11.40620041),
+    ('KFE', -22.28545556, 119.4291611),
+    ('KFG', -17.43190002, 130.8079987),
+    ('KFP', 54.84740067, -163.4100037),
+    ('KFS', 41.31420135, 33.79579926),
+    ('KGA', -5.900050163, 22.46920013),
+    ('KGB', -6.2239, 147.2152),
+    ('KGC', -35.71390152, 137.5209961),
+    ('KGD', 54.88999939, 20.59259987),
+    ('KGE', -7.3305, 157.585),
+    ('KGF', 49.67079926, 73.33439636),
+    ('KGG', 12.57229996, -12.22029972),
+    ('KGH', -8.5289, 147.4603),
+    ('KGI', -30.7894001, 121.461998),
+    ('KGJ', -9.953570366, 33.89300156),
+    ('KGK', 59.72660065, -157.2590027),
+    ('KGL', -1.968629956, 30.13949966),
+    ('KGM', -5.671, 141.03),
+    ('KGO', 48.5428009, 32.28499985),
+    ('KGP', 62.19039917, 74.53379822),
+    ('KGR', -25.84280014, 133.2920074),
+    ('KGS', 36.79330063, 27.0916996),
+    ('KGU', 5.357490063, 116.1620026),
+    ('KGW', -9.135916667, 147.6694444),
+    ('KGX', 62.89459991, -160.0650024),
+    ('KGY', -26.5807991, 151.8410034),
+    ('KGZ', 61.45510101, -142.3809967),
+    ('KHA', 36.73334122, 45.15000153),
+    ('KHC', 45.37250137, 36.40140152),
+    ('KHD', 33.4353981, 48.28290176),
+    ('KHE', 46.67580032, 32.50640106),
+    ('KHG', 39.54290009, 76.01999664),
+    ('KHH', 22.57710075, 120.3499985),
+    ('KHI', 24.90649986, 67.16079712),
+    ('KHJ', 62.46250153, 22.39310074),
+    ('KHK', 29.26029968, 50.32389832),
+    ('KHM', 25.98830032, 95.67440033),
+    ('KHN', 28.86499977, 115.9000015),
+    ('KHO', -24.59300041, 31.4151001),
+    ('KHR', 47.246667, 102.826111),
+    ('KHS', 26.17099953, 56.24060059),
+    ('KHT', 33.33340073, 69.95200348),
+    ('KHU', 49.1342, 33.4766),
+    ('KHV', 48.52799988, 135.1880035),
+    ('KHW', -19.14999962, 23.78300095),
+    ('KHY', 38.42750168, 44.97359848),
+    ('KHZ', -15.78079987, -145.1239929),
+    ('KIC', 36.22800064, -121.1220016),
+    ('KID', 55.92169952, 14.08549976),
+    ('KIF', 53.01250076, -89.8553009),
+    ('KIG', -30.18779945, 17.2791996),
+    ('KIH', 26.52619934, 53.98020172),
+    ('KII', -9.006528, 142.698056),
+    ('KIJ', 37.95589828, 139.1210022),
+    ('KIK', 35.4695015, 44.34889984),
+    ('KIL', -9.2886, 28.3269),
+    ('KIM', -28.80279922, 24.76519966),
+    ('KIN', 17.93569946, -76.78749847),
+    ('KIO', 5.644515038, 169.1195068),
+    ('KIP', 33.85779953, -98.49040222),
+    ('KIQ', -8.065111111, 147.3320278),
+    ('KIR', 52.18090057, -9.523779869),
+    ('KIS', -0.086139001, 34.72890091),
+    ('KIT', 36.27429962, 23.0170002),
+    ('KIU', -1.743829012, 41.48434448),
+    ('KIV', 46.92770004, 28.93099976),
+    ('KIW', -12.9005003, 28.14990044),
+    ('KIX', 34.4272995, 135.2440033),
+    ('KIY', -8.932999611, 39.51699829),
+    ('KJA', 56.17290115, 92.49330139),
+    ('KJH', 26.972, 107.988),
+    ('KJI', 48.2223, 86.9959),
+    ('KJK', 50.81719971, 3.20472002),
+    ('KJP', 26.16830063, 127.2929993),
+    ('KKA', 64.9394989, -161.154007),
+    ('KKC', 16.46660042, 102.7839966),
+    ('KKD', -8.884678841, 147.730957),
+    ('KKE', -35.26279831, 173.9120026),
+    ('KKG', 5.2684, -58.995),
+    ('KKH', 59.96080017, -162.8809967),
+    ('KKJ', 33.84590149, 131.0350037),
+    ('KKK', 64.4166257, -156.8203926),
+    ('KKM', 14.94939995, 100.6429977),
+    ('KKN', 69.72579956, 29.8913002),
+    ('KKO', -35.4510994, 173.8170013),
+    ('KKP', -15.31890011, 143.9550018),
+    ('KKQ', 65.717, 82.455),
+    ('KKR', -15.66329956, -146.8849945),
+    ('KKT', 40.75870132, -87.42819977),
+    ('KKU', 58.81119919, -158.5590057),
+    ('KKW', -5.035769939, 18.78560066),
+    ('KKX', 28.32130051, 129.9279938),
+    ('KKY', 52.6507988, -7.296110153),
+    ('KKZ', 11.61339712, 102.9970837),
+    ('KLB', -14.9982996, 22.64539909),
+    ('KLC', 14.14690018, -16.05130005),
+    ('KLD', 56.8246994, 35.75770187),
+    ('KLE', 10.09249973, 14.44559956),
+    ('KLF', 54.54999924, 36.3666687),
+    ('KLG', 61.53630066, -160.3410034),
+    ('KLH', 16.66469955, 74.28939819),
+    ('KLI', 4.15763998, 21.65089989),
+    ('KLJ', 55.7118988, 21.24279976),
+    ('KLK', 3.48300004, 35.83300018),
+    ('KLL', 59.12810135, -156.8589935),
+    ('KLM', 37.38330078, 55.45199966),
+    ('KLN', 57.53509903, -153.9779968),
+    ('KLO', 11.67940044, 122.3759995),
+    ('KLQ', -2.623529911, 103.9550018),
+    ('KLR', 56.6855011, 16.28759956),
+    ('KLS', 46.11800003, -122.8980026),
+    ('KLU', 46.64250183, 14.33769989),
+    ('KLV', 50.20299911, 12.91499996),
+    ('KLW', 55.57920074, -133.076004),
+    ('KLX', 37.06829834, 22.02549934),
+    ('KLY', -2.578000069, 26.73399925),
+    ('KLZ', -29.68840027, 17.09399986),
+    ('KMA', -7.963610172, 145.7709961),
+    ('KMB', -5.4875, 144.6069444),
+    ('KMC', 27.90089989, 45.52819824),
+    ('KME', -2.462239981, 28.90789986),
+    ('KMF', -7.64925, 145.9569444),
+    ('KMG', 24.99239922, 102.7440033),
+    ('KMH', -27.45669937, 23.41139984),
+    ('KMI', 31.87719917, 131.4490051),
+    ('KMJ', 32.83729935, 130.8549957),
+    ('KMK', -3.48300004, 12.61699963),
+    ('KML', -19.375, 140.0570068),
+    ('KMM', -3.666670084, 136.1666718),
+    ('KMN', -8.642020226, 25.25289917),
+    ('KMO', 58.99020004, -159.0500031),
+    ('KMP', -26.53980064, 18.1114006),
+    ('KMQ', 36.39459991, 136.4069977),
+    ('KMR', -6.4921, 144.823),
+    ('KMS', 6.714560032, -1.590819955),
+    ('KMU', -0.377353013, 42.45920181),
+    ('KMV', 23.18880081, 94.05110168),
+    ('KMW', 57.7969017, 41.01940155),
+    ('KMX', 18.29730034, 42.80350113),
+    ('KMZ', -14.80000019, 24.78300095),
+    ('KNA', -32.94960022, -71.47859955),
+    ('KND', -2.919179916, 25.91539955),
+    ('KNE', -5.2903, 144.7072),
+    ('KNF', 52.64839935, 0.550692022),
+    ('KNG', -3.644520044, 133.6959991),
+    ('KNH', 24.42790031, 118.3590012),
+    ('KNI', -33.71670151, 117.6330032),
+    ('KNJ', -3.950000048, 14.5170002),
+    ('KNK', 59.43320084, -154.8040009),
+    ('KNM', -7.583000183, 24.14999962),
+    ('KNN', 10.39400005, -9.303799629),
+    ('KNO', 3.642222, 98.885278),
+    ('KNP', -9.769370079, 15.4553194),
+    ('KNQ', -21.05430031, 164.8370056),
+    ('KNR', 27.82049942, 52.35219955),
+    ('KNS', -39.87749863, 143.878006),
+    ('KNT', 36.22589874, -90.03659821),
+    ('KNU', 26.44140053, 80.36489868),
+    ('KNW', 59.44990158, -157.3280029),
+    ('KNX', -15.77810001, 128.7079926),
+    ('KNZ', 12.83300018, -11.25),
+    ('KOA', 19.73880005, -156.0460052),
+    ('KOC', -20.54630089, 164.2559967),
+    ('KOD', -0.266669989, 116.5833359),
+    ('KOE', -10.17160034, 123.6709976),
+    ('KOF', -25.44029999, 31.93000031),
+    ('KOH', -15.88860035, 142.4389954),
+    ('KOI', 58.95780182, -2.904999971),
+    ('KOJ', 31.80340004, 130.7189941),
+    ('KOK', 63.72119904, 23.14310074),
+    ('KOM', -6.0682, 142.8598),
+    ('KON', 14.35000038, 108.0169983),
+    ('KOO', -5.394440174, 26.98999977),
+    ('KOP', 17.38380051, 104.6429977),
+    ('KOQ', 51.7211113, 11.95277786),
+    ('KOR', -7.834666667, 146.5335),
+    ('KOS', 10.57970047, 103.637001),
+    ('KOT', 63.0306015, -163.5330048),
+    ('KOU', -1.184610009, 12.44130039),
+    ('KOV', 53.32910156, 69.59459686),
+    ('KOW', 25.853333, 114.778889),
+    ('KOX', -4.71075, 136.43515),
+    ('KOZ', 57.92290115, -152.5010071),
+    ('KPA', -5.3883, 142.4977),
+    ('KPC', 65.25370026, -166.8589935),
+    ('KPE', -4.628055556, 141.0941667),
+    ('KPF', -8.5336, 142.5049),
+    ('KPI', 2.010523745, 112.9314682),
+    ('KPL', -8.630184, 142.823756),
+    ('KPM', -5.381666667, 143.9247222),
+    ('KPN', 59.93299866, -164.0310059),
+    ('KPO', 35.98789978, 129.4199982),
+    ('KPP', -14.89, 144.22),
+    ('KPS', -31.07439995, 152.7700043),
+    ('KPT', 41.97600174, -114.6579971),
+    ('KPV', 55.90599823, -159.1629944),
+    ('KQL', -5.731166667, 144.846),
+    ('KQT', 37.86640167, 68.86470032),
+    ('KRA', -35.75139999, 143.9389954),
+    ('KRB', -17.45669937, 140.8300018),
+    ('KRC', -2.093, 101.4683),
+    ('KRD', -20.51, 134.6706),
+    ('KRE', -2.544772, 30.094575),
+    ('KRF', 63.04859924, 17.76889992),
+    ('KRG', 4.033329964, -59.53329849),
+    ('KRH', 51.21360016, -0.138611004),
+    ('KRI', -7.424379826, 144.2500763),
+    ('KRJ', -4.596666667, 143.5225),
+    ('KRK', 50.07770157, 19.78479958),
+    ('KRL', 41.69779968, 86.12889862),
+    ('KRM', 3.751940012, -59.30970001),
+    ('KRN', 67.8219986, 20.33679962),
+    ('KRO', 55.47529984, 65.41560364),
+    ('KRP', 56.29750061, 9.124629974),
+    ('KRQ', 48.70560074, 37.62889862),
+    ('KRR', 45.03469849, 39.17050171),
+    ('KRS', 58.20420074, 8.085370064),
+    ('KRT', 15.58950043, 32.55319977),
+    ('KRU', -8.271612, 147.071899),
+    ('KRV', 0.319638014, 35.66255951),
+    ('KRW', 40.06330109, 53.00719833),
+    ('KRX', -4.557, 145.9404),
+    ('KRY', 45.46655, 84.9527),
+    ('KRZ', -1.434999943, 19.02400017),
+    ('KSA', 5.356979847, 162.9579926),
+    ('KSB', -6.3819, 146.9859),
+    ('KSC', 48.6631012, 21.24110031),
+    ('KSD', 59.44469833, 13.33740044),
+    ('KSE', 0.182999998, 30.10000038),
+    ('KSF', 51.40833282, 9.37749958),
+    ('KSH', 34.34590149, 47.15810013),
+    ('KSI', 9.160559654, -10.12440014),
+    ('KSJ', 35.42139816, 26.90999985),
+    ('KSK', 59.34590149, 14.49590015),
+    ('KSL', 15.38749981, 36.3288002),
+    ('KSM', 62.0605011, -163.302002),
+    ('KSN', 53.20690155, 63.5503006),
+    ('KSO', 40.44630051, 21.28219986),
+    ('KSP', -8.450716, 147.209195),
+    ('KSQ', 38.83359909, 65.92150116),
+    ('KSS', 11.33300018, -5.699999809),
+    ('KST', 13.18299961, 32.73300171),
+    ('KSU', 63.11180115, 7.824520111),
+    ('KSV', -23.54999924, 140.6999969),
+    ('KSW', 33.21670151, 35.59999847),
+    ('KSX', -6.6015, 146.1813),
+    ('KSY', 40.56219864, 43.11500168),
+    ('KSZ', 61.2358017, 46.69749832),
+    ('KTA', -20.71220016, 116.7730026),
+    ('KTC', 8.1329, -5.0657),
+    ('KTD', 25.94470024, 131.3269959),
+    ('KTE', 4.537220001, 103.427002),
+    ('KTF', -40.81330109, 172.7749939),
+    ('KTG', -1.816640019, 109.9629974),
+    ('KTI', 12.48799992, 106.0550003),
+    ('KTL', 0.971988976, 34.95859909),
+    ('KTM', 27.69659996, 85.35910034),
+    ('KTN', 55.35559845, -131.7140045),
+    ('KTO', 4.649159908, -59.8321991),
+    ('KTP', 17.98859978, -76.82379913),
+    ('KTQ', 62.16609955, 30.07360077),
+    ('KTR', -14.52110004, 132.378006),
+    ('KTS', 65.33129883, -166.4660034),
+    ('KTT', 67.7009964, 24.84679985),
+    ('KTU', 25.16020012, 75.84559631),
+    ('KTV', 5.75, -62.41600037),
+    ('KTW', 50.47430038, 19.07999992),
+    ('KTX', 12.38300037, -5.467000008),
+    ('KTY', 6.552120209, 79.97750092),
+    ('KUA', 3.77538991, 103.2089996),
+    ('KUC', 0.218611002, 173.4420013),
+    ('KUD', 6.922500134, 116.8359985),
+    ('KUE', -8.0262, 156.94783),
+    ('KUF', 53.50490189, 50.16429901),
+    ('KUG', -10.22500038, 142.2180023),
+    ('KUH', 43.04100037, 144.1929932),
+    ('KUK', 60.87440109, -162.5240021),
+    ('KUL', 2.745579958, 101.7099991),
+    ('KUM', 30.38559914, 130.6589966),
+    ('KUN', 54.96390152, 24.08480072),
+    ('KUO', 63.00709915, 27.79780006),
+    ('KUP', -10.0736, 148.218),
+    ('KUQ', -7.135083, 143.276111),
+    ('KUS', 65.57360077, -37.12360001),
+    ('KUT', 42.17670059, 42.48260117),
+    ('KUU', 31.87669945, 77.15440369),
+    ('KUV', 35.90380096, 126.6159973),
+    ('KUX', -5.372361111, 141.6238889),
+    ('KUY', -7.420347, 143.121811),
+    ('KVA', 40.91329956, 24.61919975),
+    ('KVB', 58.45640182, 13.97270012),
+    ('KVC', 55.11629868, -162.2660065),
+    ('KVD', 40.73770142, 46.31760025),
+    ('KVE', -8.6285, 151.327),
+    ('KVG', -2.579400063, 150.8079987),
+    ('KVK', 67.46330261, 33.5882988),
+    ('KVL', 67.73619843, -164.5630035),
+    ('KVR', 44.2726, 135.029),
+    ('KVX', 58.50329971, 49.34830093),
+    ('KWA', 8.72012043, 167.7319946),
+    ('KWB', -5.80091, 110.47838),
+    ('KWE', 26.53849983, 106.8010025),
+    ('KWG', 48.04330063, 33.20999908),
+    ('KWH', 37.88299942, 70.21700287),
+    ('KWI', 29.22660065, 47.96889877),
+    ('KWJ', 35.12639999, 126.8089981),
+    ('KWL', 25.21809959, 110.0390015),
+    ('KWM', -15.48560047, 141.7510071),
+    ('KWN', 59.75510025, -161.8450012),
+    ('KWO', -7.977006912, 142.8235779),
+    ('KWS', -8.360508, 160.775127),
+    ('KWT', 60.79029846, -161.4440002),
+    ('KWY', -1.960559964, 41.29750061),
+    ('KWZ', -10.76589966, 25.50569916),
+    ('KXE', -26.87109947, 26.71800041),
+    ('KXF', -17.3458004, 179.4219971),
+    ('KXK', 50.4090004, 136.9340057),
+    ('KXU', -16.33939934, -144.4029999),
+    ('KYA', 37.97900009, 32.56190109),
+    ('KYD', 22.02700043, 121.5350037),
+    ('KYE', 34.58929825, 36.01129913),
+    ('KYF', -27.27706004, 120.0956726),
+    ('KYI', -31.47060013, 131.8249969),
+    ('KYK', 57.56710052, -154.4499969),
+    ('KYO', 28.22130013, -82.37449646),
+    ('KYP', 19.42639923, 93.53479767),
+    ('KYS', 14.48120022, -11.40439987),
+    ('KYT', 21.39999962, 94.13330078),
+    ('KYU', 64.87609863, -157.727005),
+    ('KYX', -6.09, 147.0117),
+    ('KYZ', 51.66939926, 94.40059662),
+    ('KZC', 12.25520039, 104.564003),
+    ('KZD', 12.5385, 104.1486),
+    ('KZF', -7.50025, 146.0338333),
+    ('KZI', 40.28609848, 21.84079933),
+    ('KZN', 55.60620117, 49.27870178),
+    ('KZO', 44.70690155, 65.59249878),
+    ('KZS', 36.14170074, 29.57640076),
+    ('LAA', 38.06969833, -102.6880035),
+    ('LAB', -5.7204, 148.0566),
+    ('LAC', 7.37157011, 113.8440018),
+    ('LAD', -8.858369827, 13.23120022),
+    ('LAE', -6.569829941, 146.7259979),
+    ('LAF', 40.41230011, -86.93689728),
+    ('LAG', 10.53332996, -67.03333282),
+    ('LAH', -0.635258973, 127.5019989),
+    ('LAI', 48.75439835, -3.471659899),
+    ('LAJ', -27.78210068, -50.28150177),
+    ('LAK', 68.22329712, -135.0059967),
+    ('LAL', 27.98889923, -82.01860046),
+    ('LAM', 35.87979889, -106.2689972),
+    ('LAN', 42.77870178, -84.58740234),
+    ('LAO', 18.17810059, 120.5319977),
+    ('LAP', 24.0727005, -110.3619995),
+    ('LAQ', 32.7887001, 21.96430016),
+    ('LAR', 41.31209946, -105.6750031),
+    ('LAS', 36.08010101, -115.1520004),
+    ('LAU', -2.252419949, 40.9131012),
+    ('LAW', 34.56769943, -98.41660309),
+    ('LAX', 33.94250107, -118.4079971),
+    ('LAY', -28.58169937, 29.74970055),
+    ('LAZ', -13.26210022, -43.40810013),
+    ('LBA', 53.86589813, -1.660570025),
+    ('LBB', 33.66360092, -101.822998),
+    ('LBC', 53.80540085, 10.71920013),
+    ('LBD', 40.2154007, 69.69470215),
+    ('LBE', 40.27590179, -79.40480042),
+    ('LBF', 41.12620163, -100.6839981),
+    ('LBG', 48.9693985, 2.441390038),
+    ('LBI', 43.91389847, 2.113059998),
+    ('LBJ', -8.486660004, 119.8889999),
+    ('LBK', 0.348333001, 40.88169861),
+    ('LBL', 37.0442009, -100.9599991),
+    ('LBM', -18.4139, 36.1068),
+    ('LBN', 0.666103005, 36.10419083),
+    ('LBO', -4.961669922, 23.37829971),
+    ('LBP', 3.202, 115.4018),
+    ('LBQ', -0.704388976, 10.24569988),
+    ('LBR', -7.278969765, -64.76950073),
+    ('LBS', -16.4666996, 179.3399963),
+    ('LBT', 34.60990143, -79.05940247),
+    ('LBU', 5.300680161, 115.25),
+    ('LBV', 0.458600014, 9.412280083),
+    ('LBW', 3.9028, 115.6921),
+    ('LBX', 13.85540009, 120.1050034),
+    ('LBY', 47.28939819, -2.346390009),
+    ('LBZ', -8.445727348, 20.73208618),
+    ('LCA', 34.87509918, 33.62490082),
+    ('LCB', -15.1934, -59.3848),
+    ('LCC', 40.23920059, 18.13330078),
+    ('LCD', -23.06189919, 29.86470032),
+    ('LCE', 15.74250031, -86.85299683),
+    ('LCF', 15.66889954, -88.957901),
+    ('LCG', 43.30210114, -8.377260208),
+    ('LCH', 30.12610054, -93.22329712),
+    ('LCI', 43.5727005, -71.41889954),
+    ('LCJ', 51.72190094, 19.3980999),
+    ('LCK', 39.81380081, -82.92780304),
+    ('LCL', 22.33609962, -83.64189911),
+    ('LCM', -31.00580025, -64.5318985),
+    ('LCN', -30.53499985, 139.3370056),
+    ('LCO', -2.450000048, 14.53299999),
+    ('LCP', -38.08190155, -70.64389801),
+    ('LCQ', 30.18199921, -82.57689667),
+    ('LCR', -0.733333, -73.01667),
+    ('LCV', 43.82820129, 10.5781002),
+    ('LCX', 25.67469978, 116.7470016),
+    ('LCY', 51.50529861, 0.055277999),
+    ('LDA', 25.03300095, 88.13300323),
+    ('LDB', -23.33359909, -51.13010025),
+    ('LDC', -20.45359993, 149.0399933),
+    ('LDE', 43.17869949, -0.006439),
+    ('LDG', 64.89600372, 45.72299957),
+    ('LDH', -31.53829956, 159.0769959),
+    ('LDI', -9.851110458, 39.75780106),
+    ('LDJ', 40.61740112, -74.24459839),
+    ('LDK', 58.46549988, 13.17440033),
+    ('LDM', 43.96250153, -86.40789795),
+    ('LDN', 27.25309944, 86.66999817),
+    ('LDO', 4.376108283, -55.40705681),
+    ('LDS', 47.75205556, 129.019125),
+    ('LDU', 5.032249928, 118.3239975),
+    ('LDV', 48.53030014, -4.151639938),
+    ('LDW', -17.6128, 126.743),
+    ('LDX', 5.483059883, -54.03440094),
+    ('LDY', 55.0428009, -7.161109924),
+    ('LDZ', -24.75020027, 31.47570038),
+    ('LEA', -22.23559952, 114.0889969),
+    ('LEB', 43.62609863, -72.30419922),
+    ('LEC', -12.4822998, -41.27700043),
+    ('LED', 59.8003006, 30.26250076),
+    ('LEE', 28.82309914, -81.80870056),
+    ('LEF', -29.89080048, 28.65559959),
+    ('LEH', 49.53390121, 0.088055998),
+    ('LEI', 36.84389877, -2.370100021),
+    ('LEJ', 51.43239975, 12.24160004),
+    ('LEK', 11.32610035, -12.28680038),
+    ('LEL', -12.49890041, 135.8059998),
+    ('LEM', 45.91870117, -102.1060028),
+    ('LEN', 42.5890007, -5.655560017),
+    ('LEO', -1.5724, 14.2878),
+    ('LEP', -21.46610069, -42.72700119),
+    ('LEQ', 50.10279846, -5.670559883),
+    ('LER', -27.84329987, 120.7030029),
+    ('LES', -29.78289986, 28.31669998),
+    ('LET', -4.19355, -69.9432),
+    ('LEU', 42.33860016, 1.409170032),
+    ('LEV', -17.71109962, 178.7590027),
+    ('LEW', 44.04850006, -70.28350067),
+    ('LEX', 38.03649902, -84.60590363),
+    ('LEY', 52.46030045, 5.527219772),
+    ('LEZ', 14.29111099, -88.17500305),
+    ('LFB', -15.03310013, 40.67169952),
+    ('LFI', 37.082901, -76.36049652),
+    ('LFK', 31.23399925, -94.75),
+    ('LFM', 27.37269974, 53.18880081),
+    ('LFN', 36.02330017, -78.33029938),
+    ('LFO', 5.657000065, 44.34999847),
+    ('LFP', -14.9207, 144.203),
+    ('LFR', 8.239167213, -72.27102661),
+    ('LFT', 30.20529938, -91.98760223),
+    ('LFW', 6.165609837, 1.254510045),
+    ('LGA', 40.77719879, -73.87259674),
+    ('LGB', 33.81769943, -118.1520004),
+    ('LGC', 33.00889969, -85.07260132),
+    ('LGD', 45.29019928, -118.0070038),
+    ('LGE', -20.1089, 127.619),
+    ('LGF', 32.86000061, -114.3970032),
+    ('LGG', 50.63740158, 5.443220139),
+    ('LGH', -30.59830093, 138.4259949),
+    ('LGI', 23.17900085, -75.09359741),
+    ('LGK', 6.329730034, 99.72869873),
+    ('LGL', 3.421000004, 115.1539993),
+    ('LGN', -5.531944444, 149.7341667),
+    ('LGO', 53.74250031, 7.497777939),
+    ('LGP', 13.1575, 123.735),
+    ('LGQ', 0.093056001, -76.86750031),
+    ('LGR', -47.24380112, -72.58840179),
+    ('LGS', -35.49359894, -69.57430267),
+    ('LGT', 4.549722, -70.925),
+    ('LGU', 41.79119873, -111.8519974),
+    ('LGW', 51.14810181, -0.190277994),
+    ('LGX', 3.8124, 42.5459),
+    ('LGY', 10.1239996, -71.23799896),
+    ('LHA', 48.36930084, 7.827720165),
+    ('LHE', 31.52160072, 74.4036026),
+    ('LHG', -29.45669937, 147.9839935),
+    ('LHI', -3.0795, 139.952),
+    ('LHK', 32.38940048, 111.6949997),
+    ('LHR', 51.47060013, -0.461941004),
+    ('LHS', -46.53829956, -68.96530151),
+    ('LHU', -18.11669922, 23.3932991),
+    ('LHV', 41.13560104, -77.42230225),
+    ('LHW', 36.51520157, 103.6200027),
+    ('LIA', 30.67939949, 107.7860031),
+    ('LIB', -17.23559952, 129.8820038),
+    ('LIC', 39.27479935, -103.6660004),
+    ('LIE', 3.632999897, 18.63299942),
+    ('LIF', -20.77479935, 167.2400055),
+    ('LIG', 45.8628006, 1.179440022),
+    ('LIH', 21.97599983, -159.3390045),
+    ('LII', -3.7018, 137.957),
+    ('LIK', 9.823160172, 169.3079987),
+    ('LIL', 50.56190109, 3.089440107),
+    ('LIM', -12.02190018, -77.11430359),
+    ('LIN', 45.44509888, 9.276740074),
+    ('LIO', 9.957960129, -83.02200317),
+    ('LIP', -21.66399956, -49.73049927),
+    ('LIQ', 2.170660019, 21.49690056),
+    ('LIR', 10.59329987, -85.54440308),
+    ('LIS', 38.78129959, -9.135919571),
+    ('LIT', 34.72940063, -92.22429657),
+    ('LIV', 65.467, -148.6534),
+    ('LIW', 19.69149971, 97.21479797),
+    ('LIX', -12.08300018, 34.73300171),
+    ('LIY', 31.88909912, -81.56230164),
+    ('LIZ', 46.95040131, -67.8859024),
+    ('LJA', -3.417000055, 23.45000076),
+    ('LJG', 26.68000031, 100.2460022),
+    ('LJN', 29.10860062, -95.46209717),
+    ('LJU', 46.22370148, 14.45759964),
+    ('LKA', -8.274424, 123.002),
+    ('LKB', -18.19919968, -178.8170013),
+    ('LKC', -2.313, 14.606),
+    ('LKD', -15.83329964, 144.8500061),
+    ('LKG', 4.204120159, 34.34820175),
+    ('LKH', 3.299999952, 114.7829971),
+    ('LKK', 58.98210144, -155.1210022),
+    ('LKL', 70.06880188, 24.9734993),
+    ('LKN', 68.15249634, 13.6093998),
+    ('LKO', 26.76059914, 80.88929749),
+    ('LKP', 44.26449966, -73.9618988),
+    ('LKU', 3.416666985, 35.8833313),
+    ('LKV', 42.16109848, -120.3990021),
+    ('LKW', 22.80498468, 55.37336826),
+    ('LKY', -3.37631011, 35.81829834),
+    ('LKZ', 52.40930176, 0.56099999),
+    ('LLA', 65.54380035, 22.12199974),
+    ('LLE', -25.43000031, 31.57670021),
+    ('LLF', 26.338661, 111.610043),
+    ('LLG', -17.14279938, 144.529007),
+    ('LLH', 15.4422, -87.8988),
+    ('LLI', 11.97500038, 38.97999954),
+    ('LLJ', 25.88750076, 89.43309784),
+    ('LLK', 38.74639893, 48.81800079),
+    ('LLL', -16.661, 128.594),
+    ('LLM', 3.766669989, 114.4666672),
+    ('LLN', -3.75, 138.6666718),
+    ('LLS', -24.72139931, -60.54880142),
+    ('LLW', -13.7894001, 33.78099823),
+    ('LLX', 44.56909943, -72.01799774),
+    ('LLY', 39.94290161, -74.84570313),
+    ('LMB', -13.7558927, 34.58418846),
+    ('LMC', 2.17565, -73.78674),
+    ('LMD', -40.81769943, -68.0746994),
+    ('LME', 47.94860077, 0.201666996),
+    ('LMI', -3.476839511, 142.0412922),
+    ('LML', 8.921110153, 166.2649994),
+    ('LMM', 25.68519974, -109.0810013),
+    ('LMN', 4.808300018, 115.0100021),
+    ('LMO', 57.7052002, -3.339169979),
+    ('LMP', 35.4978981, 12.61810017),
+    ('LMQ', 30.37809944, 19.57640076),
+    ('LMR', -28.36009979, 23.43910027),
+    ('LMS', 33.14619827, -89.0625),
+    ('LMT', 42.15610123, -121.7330017),
+    ('LMY', -7.00992012, 141.4940033),
+    ('LMZ', -10.7506, 40.4702),
+    ('LNA', 26.59300041, -80.08509827),
+    ('LNB', -16.58419991, 168.1589966),
+    ('LNC', -6.384611111, 147.3686389),
+    ('LND', 42.81520081, -108.7300034),
+    ('LNE', -15.86559963, 168.1719971),
+    ('LNF', -4.855941, 141.220165),
+    ('LNH', -20.9807, 137.9178),
+    ('LNI', 70.91069794, -153.2420044),
+    ('LNJ', 23.73810005, 100.0250015),
+    ('LNK', 40.85100174, -96.75920105),
+    ('LNM', -7.223138889, 146.2270833),
+    ('LNN', 41.68399811, -81.38970184),
+    ('LNO', -28.87809944, 121.3150024),
+    ('LNP', 36.98749924, -82.52999878),
+    ('LNR', 43.21170044, -90.18160248),
+    ('LNS', 40.12170029, -76.2960968),
+    ('LNV', -3.043610096, 152.6289978),
+    ('LNX', 54.745, 32.065),
+    ('LNY', 20.78560066, -156.951004),
+    ('LNZ', 48.23320007, 14.1875),
+    ('LOA', -18.99329948, 139.9069977),
+    ('LOB', -32.81420135, -70.646698),
+    ('LOC', -33.5442009, 135.6929932),
+    ('LOD', -15.30669975, 167.9669952),
+    ('LOE', 17.43910027, 101.7220001),
+    ('LOH', -3.995889902, -79.37190247),
+    ('LOI', -27.15999985, -49.54249954),
+    ('LOK', 3.121969938, 35.6086998),
+    ('LOL', 40.06639862, -118.5650024),
+    ('LOM', 21.2581, -101.9441),
+    ('LOO', 33.76440048, 2.928339958),
+    ('LOP', -8.757322, 116.276675),
+    ('LOS', 6.577370167, 3.321160078),
+    ('LOU', 38.22800064, -85.66369629),
+    ('LOV', 26.95569992, -101.4700012),
+    ('LOY', 2.75, 36.71699905),
+    ('LPA', 27.93190002, -15.38659954),
+    ('LPB', -16.51329994, -68.19229889),
+    ('LPD', -1.32861, -69.5797),
+    ('LPG', -34.9722, -57.8947),
+    ('LPI', 58.40620041, 15.68050003),
+    ('LPJ', 6.578050137, -66.81690216),
+    ('LPK', 52.70280075, 39.53779984),
+    ('LPL', 53.33359909, -2.849720001),
+    ('LPM', -16.45400047, 167.822998),
+    ('LPP', 61.04460144, 28.14439964),
+    ('LPQ', 19.89730072, 102.1610031),
+    ('LPS', 48.48389816, -122.9380035),
+    ('LPT', 18.27090073, 99.5042038),
+    ('LPU', 1.704486, 114.970297),
+    ('LPX', 56.51750183, 21.09690094),
+    ('LPY', 45.08069992, 3.7628901),
+    ('LQM', -0.182278, -74.7708),
+    ('LQN', 34.98500061, 63.11780167),
+    ('LRA', 39.650253, 22.4655),
+    ('LRB', -28.85560036, 28.05279922),
+    ('LRD', 27.54380035, -99.46160126),
+    ('LRE', -23.43420029, 144.2799988),
+    ('LRG', 30.35549927, 68.6135025),
+    ('LRH', 46.17919922, -1.195279956),
+    ('LRL', 9.76733017, 1.091249943),
+    ('LRM', 18.45070076, -68.91179657),
+    ('LRR', 27.67469978, 54.38330078),
+    ('LRS', 37.18489838, 26.8003006),
+    ('LRT', 47.76060104, -3.440000057),
+    ('LRU', 32.28939819, -106.9219971),
+    ('LRV', 11.94999981, -66.66999817),
+    ('LSA', -8.505820274, 151.0809937),
+    ('LSC', -29.91620064, -71.19950104),
+    ('LSE', 43.87900162, -91.25669861),
+    ('LSH', 22.97789955, 97.75219727),
+    ('LSI', 59.87889862, -1.295560002),
+    ('LSL', 11.03530025, -84.70610046),
+    ('LSM', 4.217000008, 115.5999985),
+    ('LSO', 46.47689819, -1.722779989),
+    ('LSP', 11.78077507, -70.15149689),
+    ('LSQ', -37.40169907, -72.42539978),
+    ('LSS', 15.86439991, -61.58060074),
+    ('LST', -41.54529953, 147.2140045),
+    ('LSU', 4.552219868, 115.4940033),
+    ('LSW', 5.226679802, 96.95030212),
+    ('LSX', 5.069509983, 97.25920105),
+    ('LSY', -28.83029938, 153.2599945),
+    ('LSZ', 44.56579971, 14.39309978),
+    ('LTA', -23.82439995, 30.32929993),
+    ('LTC', 9.3979, 16.3125),
+    ('LTD', 30.15169907, 9.715310097),
+    ('LTF', -2.8337, 141.6257),
+    ('LTG', 28.20000076, 85.58300018),
+    ('LTI', 46.37639999, 96.22109985),
+    ('LTK', 35.40110016, 35.94869995),
+    ('LTL', -0.826667011, 12.74860001),
+    ('LTM', 3.372760057, -59.78939819),
+    ('LTN', 51.87469864, -0.368333012),
+    ('LTO', 25.98920059, -111.3479996),
+    ('LTP', -19.19580078, 144.3710022),
+    ('LTQ', 50.51739883, 1.620589972),
+    ('LTR', 54.95130157, -7.672830105),
+    ('LTS', 34.667099, -99.26670074),
+    ('LTT', 43.20539856, 6.481999874),
+    ('LTU', 18.41150093, 76.46469879),
+    ('LTV', -17.04829979, 141.3760071),
+    ('LTW', 38.31539917, -76.55010223),
+    ('LTX', -0.906832993, -78.61579895),
+    ('LUA', 27.68689919, 86.72969818),
+    ('LUB', 2.393929958, -59.44100189),
+    ('LUC', -16.74810028, -179.6670074),
+    ('LUD', -26.68740082, 15.24289989),
+    ('LUE', 48.33940125, 19.73579979),
+    ('LUF', 33.53499985, -112.3830032),
+    ('LUG', 46.00429916, 8.910579681),
+    ('LUH', 30.85470009, 75.95259857),
+    ('LUJ', -31.36669922, 29.58329964),
+    ('LUK', 39.10329819, -84.41860199),
+    ('LUL', 31.67259979, -89.17220306),
+    ('LUM', 24.40110016, 98.53170013),
+    ('LUN', -15.33080006, 28.45260048),
+    ('LUO', -11.76809978, 19.89769936),
+    ('LUP', 21.21100044, -156.973999),
+    ('LUQ', -33.27320099, -66.35639954),
+    ('LUR', 68.87509918, -166.1100006),
+    ('LUS', -4.800000191, 18.71699905),
+    ('LUT', -15.18330002, 144.3670044),
+    ('LUU', -15.55000019, 144.4499969),
+    ('LUV', -5.66162014, 132.7310028),
+    ('LUW', -1.038920045, 122.7720032),
+    ('LUX', 49.62659836, 6.211520195),
+    ('LUY', -4.783259869, 38.30419922),
+    ('LUZ', 51.23194444, 22.69027778),
+    ('LVA', 48.03139877, -0.742986023),
+    ('LVD', 61.35910034, -155.4400024),
+    ('LVI', -17.82180023, 25.8227005),
+    ('LVK', 37.69340134, -121.8199997),
+    ('LVL', 36.77280045, -77.79429626),
+    ('LVM', 45.69940186, -110.447998),
+    ('LVO', -28.61359978, 122.4240036),
+    ('LVP', 26.81030083, 53.35630035),
+    ('LVR', -13.03786111, -55.95025),
+    ('LVS', 35.65420151, -105.1419983),
+    ('LWA', 6.6739, 124.0581),
+    ('LWB', 37.85829926, -80.39949799),
+    ('LWC', 39.01119995, -95.21659851),
+    ('LWE', -8.3629, 123.438),
+    ('LWH', -18.56830025, 138.6349945),
+    ('LWI', -6.3344, 146.6458),
+    ('LWK', 60.19219971, -1.243610024),
+    ('LWL', 41.11709976, -114.9219971),
+    ('LWM', 42.71720123, -71.12339783),
+    ('LWN', 40.75040054, 43.85929871),
+    ('LWO', 49.8125, 23.95610046),
+    ('LWR', 53.22859955, 5.760560036),
+    ('LWS', 46.37450027, -117.0149994),
+    ('LWT', 47.04930115, -109.4670029),
+    ('LWV', 38.7643013, -87.60549927),
+    ('LWY', 4.849170208, 115.4079971),
+    ('LXA', 29.29780006, 90.91190338),
+    ('LXG', 20.96699905, 101.4000015),
+    ('LXN', 40.79100037, -99.77729797),
+    ('LXR', 25.67099953, 32.70660019),
+    ('LXS', 39.917099, 25.23629951),
+    ('LXU', -14.37479973, 23.24950027),
+    ('LXV', 39.22029877, -106.3170013),
+    ('LYA', 34.74110031, 112.3880005),
+    ('LYB', 19.66699982, -80.09999847),
+    ('LYC', 64.5483017, 18.71619987),
+    ('LYE', 51.50510025, -1.993430018),
+    ('LYG', 34.571667, 118.873611),
+    ('LYH', 37.3266983, -79.20040131),
+    ('LYI', 35.04610062, 118.4120026),
+    ('LYK', -8.9889, 117.2158),
+    ('LYN', 45.72719955, 4.944270134),
+    ('LYO', 38.34280014, -98.22689819),
+    ('LYP', 31.36499977, 72.99479675),
+    ('LYR', 78.24610138, 15.46560001),
+    ('LYS', 45.72639847, 5.090829849),
+    ('LYT', -24.1129, 152.7156),
+    ('LYU', 47.82450104, -91.83070374),
+    ('LYX', 50.95610046, 0.939167023),
+    ('LZA', -7.183000088, 22.39999962),
+    ('LZC', 18.00169945, -102.2210007),
+    ('LZH', 24.20750046, 109.3909988),
+    ('LZI', -4.949999809, 14.13300037),
+    ('LZM', -9.115960121, 18.04929924),
+    ('LZN', 26.15979958, 119.9580002),
+    ('LZO', 28.85219955, 105.3929977),
+    ('LZR', -14.673273, 145.454571),
+    ('LZU', 33.97809982, -83.96240234),
+    ('LZY', 29.30330086, 94.33529663),
+    ('MAA', 12.99000549, 80.16929626),
+    ('MAB', -5.368589878, -49.13800049),
+    ('MAC', 32.82210159, -83.56199646),
+    ('MAD', 40.4936, -3.56676),
+    ('MAE', 36.98860168, -120.1119995),
+    ('MAF', 31.94249916, -102.2020035),
+    ('MAG', -5.207079887, 145.7890015),
+    ('MAH', 39.86259842, 4.218649864),
+    ('MAI', -14.4829998, 35.26699829),
+    ('MAJ', 7.064760208, 171.2720032),
+    ('MAK', 9.558970451, 31.6522007),
+    ('MAL', -1.87579, 125.8300018),
+    ('MAM', 25.76989937, -97.52529907),
+    ('MAN', 53.35369873, -2.274950027),
+    ('MAO', -3.038609982, -60.04970169),
+    ('MAP', -10.29083333, 149.5191667),
+    ('MAQ', 16.69989967, 98.54509735),
+    ('MAR', 10.55820847, -71.7278595),
+    ('MAS', -2.061889887, 147.423996),
+    ('MAT', -5.799610138, 13.44040012),
+    ('MAU', -16.42650032, -152.2440033),
+    ('MAV', 8.704440117, 171.2299957),
+    ('MAW', 36.6006012, -89.99220276),
+    ('MAX', 15.59360027, -13.32279968),
+    ('MAY', 24.28770065, -77.68460083),
+    ('MAZ', 18.25569916, -67.14849854),
+    ('MBA', -4.034830093, 39.59420013),
+    ('MBB', -21.16329956, 119.8330002),
+    ('MBC', -1.883000016, 11.93299961),
+    ('MBD', -25.79840088, 25.54800034),
+    ('MBE', 44.30390167, 143.404007),
+    ('MBH', -25.51329994, 152.7149963),
+    ('MBI', -8.916999817, 33.46699905),
+    ('MBJ', 18.50370026, -77.91339874),
+    ('MBK', -10.1702776, -54.95277786),
+    ('MBL', 44.2723999, -86.24690247),
+    ('MBM', -31.2833004, 29.9666996),
+    ('MBO', 13.20810032, 120.6050034),
+    ('MBP', -6.01888895, -76.98832703),
+    ('MBQ', -0.555278003, 30.59939957),
+    ('MBS', 43.53290176, -84.07959747),
+    ('MBT', 12.36940002, 123.6289978),
+    ('MBU', -9.74750042, 159.8390045),
+    ('MBW', -37.97579956, 145.102005),
+    ('MBX', 46.47990036, 15.68610001),
+    ('MBZ', -3.372169971, -57.72480011),
+    ('MCA', 8.481857022, -9.525070847),
+    ('MCE', 37.28469849, -120.5139999),
+    ('MCG', 62.95289993, -155.6060028),
+    ('MCH', -3.268899918, -79.96160126),
+    ('MCI', 39.29759979, -94.71389771),
+    ('MCJ', 11.2325, -72.4901),
+    ('MCK', 40.20629883, -100.5920029),
+    ('MCL', 63.73260117, -148.9109955),
+    ('MCN', 32.69279861, -83.64920044),
+    ('MCO', 28.42939949, -81.30899811),
+    ('MCP', 0.050664, -51.07220078),
+    ('MCQ', 48.13690186, 20.79140091),
+    ('MCR', 17.06860924, -89.15222168),
+    ('MCS', -30.2719, -57.6402),
+    ('MCT', 23.59329987, 58.28440094),
+    ('MCU', 46.22259903, 2.363960028),
+    ('MCV', -16.44249916, 136.0839996),
+    ('MCW', 43.15779877, -93.33129883),
+    ('MCX', 42.81679916, 47.65230179),
+    ('MCY', -26.60330009, 153.0910034),
+    ('MCZ', -9.510809898, -35.79169846),
+    ('MDB', 17.00429916, -88.30419922),
+    ('MDC', 1.54926002, 124.9260025),
+    ('MDE', 6.16454, -75.4231),
+    ('MDG', 44.52410126, 129.5690002),
+    ('MDH', 37.77809906, -89.2519989),
+    ('MDI', 7.703879833, 8.613940239),
+    ('MDJ', 44.67020035, -121.1549988),
+    ('MDK', 0.022600001, 18.2887001),
+    ('MDL', 21.70219994, 95.97789764),
+    ('MDO', 59.44990158, -146.3070068),
+    ('MDP', -5.75, 140.3670044),
+    ('MDQ', -37.9342, -57.5733),
+    ('MDR', 63.10601982, -154.719429),
+    ('MDS', 21.82602, -71.8025),
+    ('MDT', 40.19350052, -76.76339722),
+    ('MDU', -6.147739887, 143.6569977),
+    ('MDW', 41.7859993, -87.75240326),
+    ('MDX', -29.22139931, -58.08779907),
+    ('MDY', 28.20170021, -177.3809967),
+    ('MDZ', -32.83169937, -68.79290009),
+    ('MEA', -22.34300041, -41.76599884),
+    ('MEB', -37.72809982, 144.9019928),
+    ('MEC', -0.946078002, -80.67880249),
+    ('MED', 24.55340004, 39.70510101),
+    ('MEE', -21.4817009, 168.0379944),
+    ('MEG', -9.525090218, 16.31240082),
+    ('MEH', 71.02970123, 27.82670021),
+    ('MEI', 32.33259964, -88.75189972),
+    ('MEJ', 41.62649918, -80.21469879),
+    ('MEK', 33.8791008, -5.515120029),
+    ('MEL', -37.6733017, 144.8430023),
+    ('MEM', 35.04240036, -89.97669983),
+    ('MEN', 44.5021019, 3.532819986),
+    ('MEP', 2.382999897, 103.8669968),
+    ('MEQ', 4.25, 96.21700287),
+    ('MER', -33.25, -58.06700134),
+    ('MES', 3.559167, 98.671111),
+    ('MET', -12.44419956, 142.6380005),
+    ('MEU', -0.889838994, -52.60219955),
+    ('MEW', -4.849999905, 21.54999924),
+    ('MEX', 19.43630028, -99.07209778),
+    ('MEY', 27.5774, 84.22875),
+    ('MEZ', -22.3560009, 29.98620033),
+    ('MFA', -7.916999817, 39.66699982),
+    ('MFC', -29.80109978, 27.24360085),
+    ('MFE', 26.17580032, -98.23860168),
+    ('MFF', -1.532999992, 13.2670002),
+    ('MFG', 34.3390007, 73.50859833),
+    ('MFJ', -18.56669998, 179.951004),
+    ('MFK', 26.2241993, 120.0029984),
+    ('MFM', 22.14959908, 113.5920029),
+    ('MFN', -44.6733017, 167.9230042),
+    ('MFO', -5.577778, 151.792333),
+    ('MFP', -22.10000038, 137.9830017),
+    ('MFQ', 13.50249958, 7.126749992),
+    ('MFR', 42.37419891, -122.8730011),
+    ('MFS', 1.35, -71.9444),
+    ('MFT', -13.11666679, -72.56667328),
+    ('MFU', -13.25889969, 31.93659973),
+    ('MFX', 45.4068985, 6.580560207),
+    ('MGA', 12.14150047, -86.16819763),
+    ('MGB', -37.74560165, 140.7850037),
+    ('MGD', -13.26074838, -64.06076431),
+    ('MGF', -23.4794445, -52.01222229),
+    ('MGH', -30.85740089, 30.34300041),
+    ('MGK', 20.29669952, 98.89890289),
+    ('MGL', 51.23027802, 6.504444122),
+    ('MGM', 32.30059814, -86.39399719),
+    ('MGN', 9.28474, -74.8461),
+    ('MGQ', 2.01444006, 45.30469894),
+    ('MGS', -21.89598656, -157.906662),
+    ('MGT', -12.09440041, 134.8939972),
+    ('MGU', 18.8458004, 93.68890381),
+    ('MGV', -18.62170029, 126.8830032),
+    ('MGW', 39.64289856, -79.91629791),
+    ('MGX', -2.433000088, 11.0),
+    ('MGZ', 12.43980026, 98.62149811),
+    ('MHA', 5.277490139, -59.15110016),
+    ('MHC', -42.340388, -73.715693),
+    ('MHD', 36.23519897, 59.64099884),
+    ('MHG', 49.47305679, 8.514166832),
+    ('MHH', 26.51140022, -77.08350372),
+    ('MHI', 11.71666718, 43.20000076),
+    ('MHK', 39.14099884, -96.67079926),
+    ('MHO', -17.05500031, 125.7099991),
+    ('MHP', 53.86449814, 27.53969955),
+    ('MHQ', 60.12220001, 19.89819908),
+    ('MHT', 42.93259811, -71.43569946),
+    ('MHU', -37.04750061, 147.3339996),
+    ('MHV', 35.05939865, -118.1520004),
+    ('MHW', -19.82699966, -63.96099854),
+    ('MHX', -10.3767004, -161.0019989),
+    ('MHY', -8.714111111, 141.6444444),
+    ('MHZ', 52.36190033, 0.486405998),
+    ('MIA', 25.79319954, -80.29060364),
+    ('MID', 20.93700027, -89.65769959),
+    ('MIE', 40.24229813, -85.39589691),
+    ('MIG', 31.42810059, 104.7409973),
+    ('MIH', -14.79139996, 125.8239975),
+    ('MII', -22.19689941, -49.92639923),
+    ('MIJ', 6.083330154, 171.7330017),
+    ('MIK', 61.68659973, 27.20179939),
+    ('MIM', -36.90859985, 149.901001),
+    ('MIN', -32.84329987, 135.1450043),
+    ('MIP', 30.77610016, 34.66669846),
+    ('MIR', 35.7580986, 10.75469971),
+    ('MIS', -10.6892004, 152.8379974),
+    ('MIU', 11.85529995, 13.08090019),
+    ('MIY', -18.8093, 137.0815),
+    ('MIZ', -14.0533, 134.0942),
+    ('MJA', -21.42610525, 44.31650877),
+    ('MJB', 10.2833, 170.869),
+    ('MJC', 7.272069931, -7.587359905),
+    ('MJD', 27.33519936, 68.14309692),
+    ('MJE', 7.76291, 168.26576),
+    ('MJF', 65.78399658, 13.21490002),
+    ('MJG', 22.23080063, -79.06220245),
+    ('MJI', 32.89410019, 13.27600002),
+    ('MJL', -1.84513998, 11.05669975),
+    ('MJM', -6.121240139, 23.56900024),
+    ('MJN', -15.66684174, 46.35123253),
+    ('MJP', -34.26530075, 116.1399994),
+    ('MJR', -38.2271, -57.8697),
+    ('MJT', 39.05670166, 26.59830093),
+    ('MJU', -2.583333, 119.033333),
+    ('MJV', 37.77500153, -0.812389016),
+    ('MJY', -2.733330011, 103.5670013),
+    ('MJZ', 62.53469849, 114.0390015),
+    ('MKA', 49.92279816, 12.72469997),
+    ('MKB', 1.01699996, 13.93299961),
+    ('MKC', 39.12319946, -94.59279633),
+    ('MKE', 42.94720078, -87.89659882),
+    ('MKG', 43.16949844, -86.23819733),
+    ('MKH', -29.28179932, 29.07279968),
+    ('MKI', 5.333010197, 25.93190002),
+    ('MKJ', -0.017000001, 15.58300018),
+    ('MKK', 21.1529007, -157.095993),
+    ('MKL', 35.59989929, -88.91560364),
+    ('MKM', 2.906389952, 112.0800018),
+    ('MKN', -4.023430556, 153.6572778),
+    ('MKP', -16.58390045, -143.6580048),
+    ('MKQ', -8.520290375, 140.4179993),
+    ('MKR', -26.61170006, 118.5479965),
+    ('MKU', 0.579210997, 12.89089966),
+    ('MKW', -0.891833007, 134.048996),
+    ('MKY', -21.17169952, 149.1799927),
+    ('MKZ', 2.263360024, 102.2519989),
+    ('MLA', 35.857498, 14.4775),
+    ('MLB', 28.10280037, -80.64530182),
+    ('MLE', 4.191830158, 73.52909851),
+    ('MLG', -7.926559925, 112.7149963),
+    ('MLI', 41.44850159, -90.50749969),
+    ('MLM', 19.84989929, -101.0250015),
+    ('MLN', 35.27980042, -2.956259966),
+    ('MLO', 36.69689941, 24.4769001),
+    ('MLP', 7.617219925, 124.0589981),
+    ('MLQ', -8.071388889, 146.1554722),
+    ('MLR', -37.58359909, 140.3659973),
+    ('MLS', 46.4280014, -105.8860016),
+    ('MLU', 32.51089859, -92.03769684),
+    ('MLV', -13.0649, 142.4536),
+    ('MLW', 6.289060116, -10.75870037),
+    ('MLX', 38.43529892, 38.0909996),
+    ('MLY', 64.99759674, -150.6439972),
+    ('MLZ', -32.33789825, -54.21670151),
+    ('MMB', 43.88059998, 144.1640015),
+    ('MMC', 22.74317741, -99.01737213),
+    ('MMD', 25.8465004, 131.2630005),
+    ('MME', 54.50920105, -1.429409981),
+    ('MMF', 5.704170227, 9.306389809),
+    ('MMG', -28.11610031, 117.8420029),
+    ('MMH', 37.62409973, -118.8379974),
+    ('MMJ', 36.16680145, 137.9230042),
+    ('MMK', 68.78170013, 32.75080109),
+    ('MMM', -22.80249977, 148.7050018),
+    ('MMO', 15.1559, -23.21369934),
+    ('MMP', 9.26878, -74.6523),
+    ('MMQ', -8.85916996, 31.33639908),
+    ('MMU', 40.79940033, -74.41490173),
+    ('MMV', -1.394444444, 144.1711111),
+    ('MMX', 55.53630536, 13.37619781),
+    ('MMY', 24.78280067, 125.2949982),
+    ('MMZ', 35.93080139, 64.7609024),
+    ('MNA', 4.006939888, 126.6729965),
+    ('MNB', -5.930860043, 12.35179996),
+    ('MNC', -14.48820019, 40.71220016),
+    ('MNE', -28.00919914, 138.6569977),
+    ('MNF', -17.67309952, 177.0980072),
+    ('MNG', -12.05609989, 134.2339935),
+    ('MNH', 8.04981041, 80.98139954),
+    ('MNI', 16.79140091, -62.19329834),
+    ('MNJ', -21.20179939, 48.35829926),
+    ('MNK', 1.003610015, 173.0310059),
+    ('MNL', 14.50860024, 121.0199966),
+    ('MNO', -7.288889885, 27.39439964),
+    ('MNQ', -24.88579941, 151.1000061),
+    ('MNR', -15.25450039, 23.16230011),
+    ('MNS', -11.13700008, 28.87260056),
+    ('MNU', 16.44470024, 97.66069794),
+    ('MNW', -22.44400024, 135.1990051),
+    ('MNX', -5.81137991, -61.27830124),
+    ('MNY', -7.416940212, 155.5650024),
+    ('MOA', 20.65390015, -74.92220306),
+    ('MOB', 30.69120026, -88.24279785),
+    ('MOC', -16.70689964, -43.81890106),
+    ('MOD', 37.62580109, -120.9540024),
+    ('MOE', 23.09250069, 96.64530182),
+    ('MOF', -8.640649796, 122.2369995),
+    ('MOG', 20.51679993, 99.25679779),
+    ('MOI', -19.84250069, -157.7030029),
+    ('MOJ', 5.6076, -54.4003),
+    ('MOL', 62.74470139, 7.262499809),
+    ('MOM', 17.75, -12.5),
+    ('MON', -43.76499939, 170.1329956),
+    ('MOO', -28.09939957, 140.1970062),
+    ('MOQ', -20.28470039, 44.31760025),
+    ('MOT', 48.25939941, -101.2799988),
+    ('MOU', 62.09540176, -163.6820068),
+    ('MOV', -22.05780029, 148.0769959),
+    ('MOZ', -17.48999977, -149.7619934),
+    ('MPA', -17.63439941, 24.17670059),
+    ('MPC', -2.541860104, 101.0879974),
+    ('MPD', 25.68250084, 69.07279968),
+    ('MPF', -7.979, 143.1694),
+    ('MPG', -6.532222222, 147.6511667),
+    ('MPH', 11.92450047, 121.9540024),
+    ('MPL', 43.57619858, 3.963010073),
+    ('MPM', -25.92079926, 32.57260132),
+    ('MPN', -51.82279968, -58.44720078),
+    ('MPT', -8.167739868, 125.0090027),
+    ('MPU', -2.811388889, 151.9911111),
+    ('MPV', 44.20349884, -72.56230164),
+    ('MPW', 47.0760994, 37.44960022),
+    ('MPX', -4.903055556, 141.6208333),
+    ('MPY', 3.657500029, -54.03720093),
+    ('MQA', -19.73830032, 120.8379974),
+    ('MQC', 47.09550095, -56.38029861),
+    ('MQD', -41.24309921, -68.70780182),
+    ('MQE', -22.80579948, 137.2510071),
+    ('MQF', 53.39310074, 58.75569916),
+    ('MQH', -13.54909992, -48.19530106),
+    ('MQK', -16.33919907, -58.40190125),
+    ('MQL', -34.22919846, 142.0859985),
+    ('MQM', 37.22330093, 40.63169861),
+    ('MQN', 66.36389923, 14.30140018),
+    ('MQO', -8.709166667, 142.6497222),
+    ('MQP', -25.38319969, 31.10560036),
+    ('MQQ', 8.624409676, 16.07139969),
+    ('MQS', 12.88790035, -61.18019867),
+    ('MQT', 46.35359955, -87.395401),
+    ('MQU', 5.21256, -74.8836),
+    ('MQV', 35.90879822, 0.149382994),
+    ('MQX', 13.4673996, 39.53350067),
+    ('MQZ', -33.93059921, 115.0999985),
+    ('MRA', 32.32500076, 15.06099987),
+    ('MRD', 8.58207798, -71.16104126),
+    ('MRE', -1.406111002, 35.00805664),
+    ('MRG', -17.06920052, 145.4190063),
+    ('MRH', -4.3615, 141.785),
+    ('MRI', 61.21350098, -149.8439941),
+    ('MRO', -40.97330093, 175.6340027),
+    ('MRP', -27.33329964, 133.6269989),
+    ('MRQ', 13.36100006, 121.8259964),
+    ('MRR', -4.378230095, -79.94100189),
+    ('MRS', 43.43927192, 5.221424103),
+    ('MRU', -20.43020058, 57.68360138),
+    ('MRV', 44.22510147, 43.08190155),
+    ('MRW', 54.69929886, 11.44009972),
+    ('MRX', 30.55620003, 49.15190125),
+    ('MRY', 36.5870018, -121.8430023),
+    ('MRZ', -29.49889946, 149.8450012),
+    ('MSA', 53.44139862, -91.76280212),
+    ('MSC', 33.46080017, -111.7279968),
+    ('MSE', 51.34220123, 1.346109986),
+    ('MSH', 20.67539978, 58.89049911),
+    ('MSI', -5.583330154, 114.4329987),
+    ('MSJ', 40.70320129, 141.3679962),
+    ('MSL', 34.74530029, -87.61019897),
+    ('MSM', -4.782999992, 17.85000038),
+    ('MSN', 43.13990021, -89.33750153),
+    ('MSO', 46.91630173, -114.0910034),
+    ('MSP', 44.88199997, -93.22180176),
+    ('MSQ', 53.88249969, 28.03070068),
+    ('MSR', 38.74779892, 41.66120148),
+    ('MSS', 44.93579865, -74.84559631),
+    ('MST', 50.9117012, 5.770140171),
+    ('MSU', -29.46229935, 27.55249977),
+    ('MSW', 15.67000008, 39.37009811),
+    ('MSX', -2.950000048, 12.69999981),
+    ('MSY', 29.99340057, -90.25800323),
+    ('MSZ', -15.26119995, 12.14680004),
+    ('MTA', -37.7344017, 175.7420044),
+    ('MTB', 7.97174, -75.4325),
+    ('MTD', -16.97830009, 130.5549927),
+    ('MTE', -1.995800018, -54.07419968),
+    ('MTF', 6.9571, 35.5547),
+    ('MTI', 15.04500008, -24.33919907),
+    ('MTJ', 38.50979996, -107.8939972),
+    ('MTK', 3.374439955, 172.9920044),
+    ('MTL', -32.70330048, 151.4880066),
+    ('MTQ', -26.48329926, 147.9369965),
+    ('MTR', 8.82374, -75.8258),
+    ('MTS', -26.52899933, 31.30750084),
+    ('MTT', 18.10339928, -94.58070374),
+    ('MTU', -13.1218996, 39.05279922),
+    ('MTV', -13.66600037, 167.7120056),
+    ('MTX', 64.80680084, -147.7619934),
+    ('MTY', 25.7784996, -100.1070023),
+    ('MTZ', 31.32819939, 35.3885994),
+    ('MUA', -8.327969551, 157.2630005),
+    ('MUB', -19.97260094, 23.43110085),
+    ('MUC', 48.35380173, 11.78610039),
+    ('MUD', -11.6729002, 39.56309891),
+    ('MUE', 20.00130081, -155.6679993),
+    ('MUF', -7.3147, 140.5668),
+    ('MUG', 26.89731026, -111.95755),
+    ('MUH', 31.3253994, 27.22170067),
+    ('MUJ', 5.8646, 35.7485),
+    ('MUK', -20.13610077, -157.3450012),
+    ('MUM', 0.333330005, 34.48333359),
+    ('MUN', 9.754529953, -63.1473999),
+    ('MUP', -25.86000061, 131.6499939),
+    ('MUQ', -20.65889931, 120.0670013),
+    ('MUR', 4.178979874, 114.3290024),
+    ('MUS', 24.28969955, 153.9790039),
+    ('MUW', 35.20769882, 0.147141993),
+    ('MUX', 30.20319939, 71.4190979),
+    ('MUY', -4.014873981, 13.96611214),
+    ('MUZ', -1.503, 33.8021),
+    ('MVA', 65.65579987, -16.91810036),
+    ('MVB', -1.656159997, 13.43799973),
+    ('MVC', 31.45800018, -87.35099792),
+    ('MVD', -34.83840179, -56.03079987),
+    ('MVF', -5.201920033, -37.36429977),
+    ('MVK', -28.3477993, 138.6499939),
+    ('MVL', 44.5345993, -72.61399841),
+    ('MVO', 12.16699982, 18.67499924),
+    ('MVP', 1.25366, -70.2339),
+    ('MVQ', 53.95489883, 30.0951004),
+    ('MVR', 10.4513998, 14.25739956),
+    ('MVS', -18.0489006, -39.86420059),
+    ('MVT', -14.86810017, -148.7169952),
+    ('MVV', 45.82080078, 6.652219772),
+    ('MVX', 2.150000095, 12.13300037),
+    ('MVY', 41.39310074, -70.61430359),
+    ('MVZ', -20.05529976, 30.85910034),
+    ('MWA', 37.75500107, -89.01110077),
+    ('MWB', -29.20170021, 116.0220032),
+    ('MWD', 32.56309891, 71.5707016),
+    ('MWE', 18.45000076, 31.83300018),
+    ('MWF', -15.0, 168.0829926),
+    ('MWG', -6.9736, 145.8849),
+    ('MWI', -5.13, 143.4829),
+    ('MWJ', 7.488112, -60.184777),
+    ('MWK', 3.348119974, 106.2580032),
+    ('MWL', 32.78160095, -98.06020355),
+    ('MWN', -3.5, 33.61700058),
+    ('MWP', 28.0, 85.33300018),
+    ('MWQ', 20.16559982, 94.94139862),
+    ('MWX', 34.991406, 126.382814),
+    ('MWY', -17.32889938, 141.8860016),
+    ('MWZ', -2.444489956, 32.93270111),
+    ('MXB', -2.558039904, 120.3239975),
+    ('MXD', -23.36669922, 139.6670074),
+    ('MXH', -6.363329887, 143.2380066),
+    ('MXI', 6.949369907, 126.2730026),
+    ('MXJ', 9.652170181, 6.462259769),
+    ('MXK', -6.471666667, 147.4411389),
+    ('MXL', 32.63059998, -115.2419968),
+    ('MXM', -21.75390053, 43.37549973),
+    ('MXN', 48.60319901, -3.815779924),
+    ('MXP', 45.63059998, 8.728110313),
+    ('MXS', -13.74230003, -172.2579956),
+    ('MXT', -18.04999924, 44.03300095),
+    ('MXU', -28.47500038, 115.5169983),
+    ('MXV', 49.66329956, 100.098999),
+    ('MXW', 45.73809814, 106.2689972),
+    ('MXX', 60.957901, 14.51140022),
+    ('MXZ', 24.35000038, 116.1330032),
+    ('MYA', -35.89780045, 150.1439972),
+    ('MYB', -3.4584198, 10.67407608),
+    ('MYC', 10.24997807, -67.64942169),
+    ('MYD', -3.229310036, 40.10169983),
+    ('MYE', 34.07360077, 139.5599976),
+    ('MYG', 22.37949944, -73.0134964),
+    ('MYI', -9.916669846, 144.0549927),
+    ('MYJ', 33.82720184, 132.6999969),
+    ('MYM', 4.483329773, -59.68330002),
+    ('MYN', 15.46920013, 45.32690048),
+    ('MYO', -18.12470055, 124.2720032),
+    ('MYP', 37.61940002, 61.89670181),
+    ('MYQ', 12.30720043, 76.64969635),
+    ('MYR', 33.67969894, -78.92829895),
+    ('MYT', 25.38360023, 97.35189819),
+    ('MYU', 60.37139893, -166.2709961),
+    ('MYW', -10.33909988, 40.18180084),
+    ('MYX', -7.211666667, 146.0193611),
+    ('MYY', 4.32201004, 113.9869995),
+    ('MYZ', -14.08360004, 34.91970062),
+    ('MZB', -11.36180019, 40.35490036),
+    ('MZC', 0.782999992, 11.55000019),
+    ('MZD', -2.733330011, -78.3167038),
+    ('MZE', 17.27846, -89.0238),
+    ('MZG', 23.56870079, 119.6279984),
+    ('MZH', 40.82939911, 35.52199936),
+    ('MZI', 14.51280022, -4.079559803),
+    ('MZJ', 32.51060104, -111.3280029),
+    ('MZK', 2.058609962, 173.2709961),
+    ('MZL', 5.0296, -75.4647),
+    ('MZM', 49.07170105, 6.131669998),
+    ('MZO', 20.28809929, -77.08920288),
+    ('MZP', -41.12329865, 172.9889984),
+    ('MZQ', -27.62610054, 32.04430008),
+    ('MZR', 36.70690155, 67.20970154),
+    ('MZS', 4.616666794, 118.1500015),
+    ('MZT', 23.16139984, -106.2659988),
+    ('MZU', 26.11910057, 85.31369781),
+    ('MZV', 4.04832983, 114.8050003),
+    ('MZW', 33.53590012, -0.242353007),
+    ('MZY', -34.15829849, 22.05859947),
+    ('MZZ', 40.48989868, -85.67970276),
+    ('NAA', -30.31920052, 149.8269959),
+    ('NAC', -36.98529816, 140.7250061),
+    ('NAD', 2.566667, -67.583333),
+    ('NAE', 10.31700039, 1.383000016),
+    ('NAF', 2.723050117, 117.1259995),
+    ('NAG', 21.09219933, 79.04720306),
+    ('NAH', 3.683209896, 125.5279999),
+    ('NAI', 3.959439993, -59.12419891),
+    ('NAJ', 39.18880081, 45.45840073),
+    ('NAK', 14.94950008, 102.3130035),
+    ('NAL', 43.51290131, 43.63660049),
+    ('NAM', -3.235569954, 127.0999985),
+    ('NAN', -17.7553997, 177.4429932),
+    ('NAO', 30.79545, 106.1626),
+    ('NAP', 40.88600159, 14.29080009),
+    ('NAQ', 77.48860168, -69.38870239),
+    ('NAR', 6.21002, -74.5906),
+    ('NAS', 25.03899956, -77.46620178),
+    ('NAT', -5.768056, -35.376111),
+    ('NAU', -14.17679977, -141.2669983),
+    ('NAV', 38.77190018, 34.53450012),
+    ('NAW', 6.519919872, 101.7429962),
+    ('NAY', 39.78279877, 116.3880005),
+    ('NAZ', -10.6758, 162.2049),
+    ('NBA', -6.2412, 145.2424),
+    ('NBB', 3.483333, -69.8),
+    ('NBC', 55.56470108, 52.09249878),
+    ('NBE', 36.075833, 10.438611),
+    ('NBG', 29.82530022, -90.03500366),
+    ('NBH', -30.64999962, 153.0),
+    ('NBL', 9.44960022, -78.97949982),
+    ('NBO', -1.319239974, 36.92779922),
+    ('NBS', 42.06694444, 127.6022222),
+    ('NBW', 19.90649986, -75.20709991),
+    ('NBX', -3.368180037, 135.4960022),
+    ('NCA', 21.91749954, -71.93959808),
+    ('NCE', 43.65840149, 7.215869904),
+    ('NCG', 30.3973999, -107.875),
+    ('NCH', -10.35750008, 38.77920151),
+    ('NCI', 8.45, -76.7833),
+    ('NCJ', -30.9575, -61.5283),
+    ('NCL', 55.03749847, -1.691669941),
+    ('NCN', 60.07730103, -147.9920044),
+    ('NCR', 11.13339996, -84.76999664),
+    ('NCS', -27.77059937, 29.9769001),
+    ('NCT', 10.13939953, -85.44580078),
+    ('NCU', 42.48839951, 59.62329865),
+    ('NCY', 45.92919922, 6.098760128),
+    ('NDA', -4.533329964, 129.8999939),
+    ('NDB', 20.93309975, -17.03000069),
+    ('NDC', 19.18330002, 77.3167038),
+    ('NDD', -11.16790009, 13.84749985),
+    ('NDE', 3.933000088, 41.84999847),
+    ('NDF', -9.275188446, 14.97724247),
+    ('NDG', 47.23960114, 123.9179993),
+    ('NDI', -9.47375, 148.3294167),
+    ('NDJ', 12.13370037, 15.0340004),
+    ('NDK', 5.631669998, 168.125),
+    ('NDL', 8.427206039, 20.63515663),
+    ('NDM', 9.767000198, 35.09999847),
+    ('NDN', -9.143555556, 147.68425),
+    ('NDR', 34.98880005, -3.028209925),
+    ('NDS', -27.97999954, 119.2969971),
+    ('NDU', -17.9564991, 19.71940041),
+    ('NDY', 59.25030136, -2.576669931),
+    ('NDZ', 53.76722336, 8.643610954),
+    ('NEC', -38.4831, -58.8172),
+    ('NEF', 56.10689926, 54.34719849),
+    ('NEG', 18.34280014, -78.33209991),
+    ('NEJ', 9.550000191, 35.46699905),
+    ('NEK', 9.050000191, 36.59999847),
+    ('NEL', 40.03329849, -74.353302),
+    ('NEU', 20.41839981, 104.0670013),
+    ('NEV', 17.20569992, -62.58990097),
+    ('NFG', 61.10829926, 72.65000153),
+    ('NFO', -15.57079983, -175.6329956),
+    ('NGA', -34.25559998, 148.2480011),
+    ('NGB', 29.82670021, 121.461998),
+    ('NGD', 18.72719955, -64.32969666),
+    ('NGE', 7.357009888, 13.55920029),
+    ('NGI', -18.11560059, 179.3399963),
+    ('NGK', 51.78010178, 143.1390076),
+    ('NGL', -24.38780022, 31.32579994),
+    ('NGO', 34.85839844, 136.8049927),
+    ('NGQ', 32.1, 80.05305556),
+    ('NGS', 32.91690063, 129.9140015),
+    ('NGX', 28.64139938, 84.08920288),
+    ('NHA', 12.22749996, 109.1920013),
+    ('NHD', 25.02680016, 55.36619949),
+    ('NHF', 15.35560036, 35.72779846),
+    ('NHS', 29.53899956, 66.02330017),
+    ('NHT', 51.5530014, -0.418166995),
+    ('NHV', -8.795599937, -140.2290039),
+    ('NIA', 7.5, -8.600000381),
+    ('NIF', -21.67169952, 121.586998),
+    ('NIG', -1.314440012, 176.4100037),
+    ('NIM', 13.48149967, 2.183609962),
+    ('NIO', -2.717499971, 17.68470001),
+    ('NIS', -2.662222222, 151.9977778),
+    ('NIT', 46.31129837, -0.401502997),
+    ('NIU', -16.1191, -146.3683),
+    ('NIX', 15.23810005, -9.576109886),
+    ('NJA', 35.45460129, 139.4499969),
+    ('NJC', 60.94929886, 76.4835968),
+    ('NJF', 31.989853, 44.404317),
+    ('NKB', -18.49469948, 124.8519974),
+    ('NKC', 18.09819984, -15.94849968),
+    ('NKD', -3.822, 137.8406),
+    ('NKG', 31.74200058, 118.8619995),
+    ('NKL', -5.449999809, 14.83300018),
+    ('NKM', 35.25500107, 136.923996),
+    ('NKS', 4.949999809, 9.932999611),
+    ('NKU', -30.02169991, 28.19689941),
+    ('NKW', -7.313270092, 72.41110229),
+    ('NKY', -4.217000008, 13.28299999),
+    ('NLA', -12.99810028, 28.66489983),
+    ('NLD', 27.44389915, -99.57050323),
+    ('NLF', -9.583330154, 143.7669983),
+    ('NLK', -29.04159927, 167.9389954),
+    ('NLL', -21.91329956, 120.197998),
+    ('NLO', -4.326660156, 15.32750034),
+    ('NLP', -25.5, 30.91379929),
+    ('NLS', -18.04999924, 128.8999939),
+    ('NLU', 19.75530052, -99.0164032),
+    ('NLV', 47.05789948, 31.9197998),
+    ('NMA', 40.98460007, 71.55670166),
+    ('NMB', 20.43440056, 72.84320068),
+    ('NMC', 24.59429932, -76.82019806),
+    ('NML', 57.05559921, -111.5739975),
+    ('NMN', -6.32183, 145.070667),
+    ('NMR', -27.55830002, 141.1329956),
+    ('NMS', 20.89049911, 97.73590088),
+    ('NMT', 23.08300018, 97.38300323),
+    ('NNA', 34.2989006, -6.595880032),
+    ('NNB', -10.847994, 162.454108),
+    ('NNG', 22.60829926, 108.1719971),
+    ('NNI', -18.8064003, 16.92720032),
+    ('NNM', 67.63999939, 53.12189865),
+    ('NNR', 53.2303009, -9.467780113),
+    ('NNT', 18.80789948, 100.7829971),
+    ('NNU', -17.82329941, -40.32989883),
+    ('NNX', 4.133333333, 117.6666667),
+    ('NNY', 32.98080063, 112.6149979),
+    ('NOA', -34.94889832, 150.5370026),
+    ('NOB', 9.976490021, -85.65299988),
+    ('NOC', 53.91030121, -8.818490028),
+    ('NOD', 53.63305664, 7.190278053),
+    ('NOG', 31.22610092, -110.9759979),
+    ('NOJ', 63.18330002, 75.26999664),
+    ('NOK', -14.69830036, -52.34640121),
+    ('NOM', -6.294, 142.2341667),
+    ('NON', -0.63972199, 174.4279938),
+    ('NOO', -9.254455, 147.621352),
+    ('NOR', 65.13189697, -13.74639988),
+    ('NOS', -13.31210041, 48.31480026),
+    ('NOU', -22.01460075, 166.2129974),
+    ('NOV', -12.80889988, 15.76049995),
+    ('NOZ', 53.81140137, 86.87719727),
+    ('NPE', -39.46580124, 176.8699951),
+    ('NPG', -6.1454, 143.4531),
+    ('NPL', -39.00859833, 174.1790009),
+    ('NPO', -0.348868996, 111.7480011),
+    ('NPT', 41.53239822, -71.28150177),
+    ('NQL', -14.43490028, -48.49150085),
+    ('NQN', -38.94900131, -68.15570068),
+    ('NQT', 52.91999817, -1.079169989),
+    ('NQU', 5.6964, -77.2806),
+    ('NQY', 50.44060135, -4.995409966),
+    ('NRA', -34.70220184, 146.5119934),
+    ('NRD', 53.70694351, 7.230000019),
+    ('NRE', -3.8548, 126.7012),
+    ('NRK', 58.5862999, 16.25060081),
+    ('NRL', 59.36750031, -2.434439898),
+    ('NRM', 15.21700001, -7.267000198),
+    ('NRN', 51.60240173, 6.142169952),
+    ('NRT', 35.76470184, 140.3860016),
+    ('NSA', -5.911419868, -35.24769974),
+    ('NSH', 36.66329956, 51.46469879),
+    ('NSI', 3.722559929, 11.5532999),
+    ('NSK', 69.31109619, 87.3321991),
+    ('NSM', -32.20999908, 121.7549973),
+    ('NSN', -41.2983017, 173.220993),
+    ('NSO', -32.03720093, 150.8320007),
+    ('NSP', 14.49540043, 120.9039993),
+    ('NST', 8.539620399, 99.94470215),
+    ('NSV', -26.42329979, 153.0630035),
+    ('NSY', 37.40169907, 14.92240047),
+    ('NTB', 59.56570053, 9.212220192),
+    ('NTE', 47.15319824, -1.610730052),
+    ('NTG', 32.07080078, 120.9759979),
+    ('NTI', -2.1033, 133.5164),
+    ('NTL', -32.79499817, 151.8339996),
+    ('NTN', -17.68359947, 141.0700073),
+    ('NTO', 17.20280075, -25.09059906),
+    ('NTQ', 37.29309845, 136.9620056),
+    ('NTR', 25.86560059, -100.2369995),
+    ('NTT', -15.97733765, -173.7910295),
+    ('NTX', 3.908710003, 108.3880005),
+    ('NTY', -25.33379936, 27.17340088),
+    ('NUB', -14.27169991, 135.7169952),
+    ('NUD', 12.66699982, 28.33300018),
+    ('NUE', 49.49869919, 11.06690025),
+    ('NUG', -3.4075, 154.7383),
+    ('NUI', 70.20999908, -151.0059967),
+    ('NUK', -19.28499985, -138.7720032),
+    ('NUP', 60.90579987, -162.4389954),
+    ('NUR', -31.44169998, 130.9019928),
+    ('NUS', -16.07970047, 167.401001),
+    ('NUU', -0.298067003, 36.15930176),
+    ('NUX', 66.06939697, 76.52030182),
+    ('NVA', 2.95015, -75.294),
+    ('NVG', 11.66666698, -84.44999695),
+    ('NVI', 40.11719894, 65.17079926),
+    ('NVJ', 26.99220085, -109.4160004),
+    ('NVK', 68.43689728, 17.38669968),
+    ('NVN', 39.81850052, -120.3529968),
+    ('NVP', -5.118030071, -60.36489868),
+    ('NVS', 47.00260162, 3.113329887),
+    ('NVT', -26.87999916, -48.65140152),
+    ('NVY', 11.61295605, 79.5273819),
+    ('NWA', -12.29810047, 43.76639938),
+    ('NWI', 52.67580032, 1.282780051),
+    ('NWT', -9.984166667, 149.7291667),
+    ('NYA', 62.11000061, 65.61499786),
+    ('NYE', -0.364414006, 36.97848511),
+    ('NYI', 7.361830235, -2.328759909),
+    ('NYK', -0.062398899, 37.041008),
+    ('NYM', 65.48090363, 72.69889832),
+    ('NYN', -31.55109978, 147.2030029),
+    ('NYO', 58.78860092, 16.91220093),
+    ('NYT', 19.62350082, 96.2009964),
+    ('NYU', 21.17880058, 94.93019867),
+    ('NZA', -7.716939926, 21.35820007),
+    ('NZC', -14.85400009, -74.96150208),
+    ('NZE', 7.806019306, -8.701797485),
+    ('NZY', 32.69919968, -117.2149963),
+    ('OAA', 33.9225, 69.07722222),
+    ('OAG', -33.38169861, 149.1329956),
+    ('OAH', 33.3913002, 62.26100159),
+    ('OAI', 34.94609833, 69.26499939),
+    ('OAJ', 34.82920074, -77.61209869),
+    ('OAK', 37.72129822, -122.2210007),
+    ('OAL', -11.496, -61.4508),
+    ('OAM', -44.97000122, 171.0820007),
+    ('OAR', 36.68190002, -121.762001),
+    ('OAS', 33.12575, 68.838517),
+    ('OAX', 16.99990082, -96.72660065),
+    ('OAZ', 31.86380005, 64.22460175),
+    ('OBC', 11.96700001, 43.26699829),
+    ('OBD', -3.9106, 136.2306),
+    ('OBE', 27.26280022, -80.84980011),
+    ('OBF', 48.08140182, 11.28310013),
+    ('OBI', 2.223469973, -55.94609833),
+    ('OBL', 51.26470184, 4.753330231),
+    ('OBM', -7.74, 147.59),
+    ('OBN', 56.46350098, -5.399670124),
+    ('OBO', 42.73329926, 143.2169952),
+    ('OBS', 44.5442009, 4.372189999),
+    ('OBU', 66.91230011, -156.8970032),
+    ('OBX', -7.590622421, 141.3242626),
+    ('OCC', -0.462886006, -76.98680115),
+    ('OCJ', 18.4041996, -76.96900177),
+    ('OCV', 8.31506, -73.3583),
+    ('ODA', 8.010560036, 22.39859962),
+    ('ODB', 37.84199905, -4.848879814),
+    ('ODC', 37.75630188, -120.8000031),
+    ('ODD', -27.56170082, 135.4470062),
+    ('ODE', 55.47669983, 10.33090019),
+    ('ODH', 51.23410034, -0.942825019),
+    ('ODJ', 8.899999619, 22.78300095),
+    ('ODL', -26.74530029, 140.6380005),
+    ('ODN', 3.967000008, 115.0500031),
+    ('ODO', 57.86610031, 114.2429962),
+    ('ODR', -17.34079933, 128.9120026),
+    ('ODS', 46.42679977, 30.67650032),
+    ('ODW', 48.25149918, -122.6740036),
+    ('ODY', 20.6826992, 101.9940033),
+    ('OEC', -9.198060036, 124.3430023),
+    ('OEL', 52.93470001, 36.00220108),
+    ('OEM', 3.345279932, -55.44250107),
+    ('OER', 63.40829849, 18.98999977),
+    ('OES', -40.7512, -65.0343),
+    ('OFI', 9.600000381, -4.050000191),
+    ('OFJ', 66.08329773, -18.66670036),
+    ('OFU', -14.1843996, -169.6699982),
+    ('OGE', -6.46675, 147.3641667),
+    ('OGG', 20.89859962, -156.4299927),
+    ('OGL', 6.806280136, -58.10589981),
+    ('OGN', 24.46689987, 122.9779968),
+    ('OGO', 6.715559959, -3.470279932),
+    ('OGR', 10.28810024, 15.3810997),
+    ('OGS', 44.68190002, -75.46549988),
+    ('OGX', 31.91720009, 5.412779808),
+    ('OGZ', 43.20510101, 44.60660172),
+    ('OHA', -40.20600128, 175.3880005),
+    ('OHD', 41.18000031, 20.74230003),
+    ('OHE', 52.91277778, 122.43),
+    ('OHH', 53.52000046, 142.8899994),
+    ('OHO', 59.4100647, 143.0565033),
+    ('OHR', 54.68444443, 8.52833271),
+    ('OHT', 33.56999969, 71.44000244),
+    ('OIA', -6.763100147, -51.04990005),
+    ('OIM', 34.7820015, 139.3600006),
+    ('OIR', 42.07170105, 139.4329987),
+    ('OIT', 33.47940063, 131.7369995),
+    ('OKA', 26.19580078, 127.6460037),
+    ('OKB', -24.95940018, 153.3150024),
+    ('OKC', 35.39310074, -97.60070038),
+    ('OKD', 43.11610031, 141.3800049),
+    ('OKE', 27.42550087, 128.701004),
+    ('OKF', -19.14920044, 15.91189957),
+    ('OKG', -1.448330045, 15.07330036),
+    ('OKH', 52.7356987, -0.648769021),
+    ('OKI', 36.18109894, 133.3249969),
+    ('OKJ', 34.75690079, 133.8549957),
+    ('OKL', -5.091060162, 140.6100006),
+    ('OKN', -0.665214002, 13.67310047),
+    ('OKO', 35.74850082, 139.3480072),
+    ('OKP', -5.2261, 142.2259),
+    ('OKQ', -8.0946, 139.7233),
+    ('OKR', -9.757029533, 143.4109955),
+    ('OKT', 54.43999863, 53.38830185),
+    ('OKU', -18.81279945, 17.05940056),
+    ('OKV', -5.556666667, 141.0327778),
+    ('OKY', -27.41139984, 151.7350006),
+    ('OLA', 63.69889832, 9.604000092),
+    ('OLB', 40.89870071, 9.517629623),
+    ('OLC', -3.467929508, -68.92041206),
+    ('OLD', 44.95280075, -68.67430115),
+    ('OLF', 48.0945015, -105.5749969),
+    ('OLI', 64.91139984, -23.82309914),
+    ('OLJ', -14.88169956, 166.5579987),
+    ('OLK', -21.04520035, -57.88249969),
+    ('OLM', 46.9693985, -122.9029999),
+    ('OLN', -45.582371, -68.999841),
+    ('OLP', -30.48500061, 136.8769989),
+    ('OLQ', -5.389722222, 141.5152778),
+    ('OLR', 33.36380005, 69.95610046),
+    ('OLV', 34.97869873, -89.78690338),
+    ('OMA', 41.30319977, -95.89409637),
+    ('OMB', -1.574730039, 9.26268959),
+    ('OMC', 11.05799961, 124.5650024),
+    ('OMD', -28.58469963, 16.44669914),
+    ('OME', 64.5121994, -165.4450073),
+    ('OMF', 32.35639954, 36.25920105),
+    ('OMG', -18.03030014, 22.18969917),
+    ('OMH', 37.66809845, 45.06869888),
+    ('OMI', 30.83519936, 49.53490067),
+    ('OMM', 18.13599968, 55.18209839),
+    ('OMO', 43.28290176, 17.84589958),
+    ('OMR', 47.02529907, 21.90250015),
+    ('OMS', 54.96699905, 73.3105011),
+    ('OMY', 13.7597, 104.97173),
+    ('ONB', -8.674361111, 147.2624167),
+    ('OND', -17.87820053, 15.95259953),
+    ('ONG', -16.66250038, 139.1779938),
+    ('ONI', -3.983402014, 136.0832977),
+    ('ONJ', 40.19189835, 140.3710022),
+    ('ONQ', 41.50640106, 32.08860016),
+    ('ONR', -24.81669998, 140.5330048),
+    ('ONT', 34.05599976, -117.6009979),
+    ('ONU', -20.64999962, -178.6999969),
+    ('ONX', 9.356639862, -79.86740112),
+    ('OOK', 60.54140091, -165.0870056),
+    ('OOL', -28.1644001, 153.5050049),
+    ('OOM', -36.30059814, 148.973999),
+    ('OOR', -25.25, 140.9830017),
+    ('OOT', -1.796110034, 175.526001),
+    ('OOX', 46.88000107, 35.30500031),
+    ('OPA', 66.31079865, -16.4666996),
+    ('OPI', -12.32499981, 133.0059967),
+    ('OPO', 41.24810028, -8.681389809),
+    ('OPS', -11.88500023, -55.58610916),
+    ('OPU', -8.050000191, 142.9329987),
+    ('ORA', -23.15279961, -64.32920074),
+    ('ORB', 59.22370148, 15.03800011),
+    ('ORC', 4.79222, -71.3564),
+    ('ORD', 41.97859955, -87.90480042),
+    ('ORE', 47.9878006, 1.760560036),
+    ('ORF', 36.89459991, -76.20120239),
+    ('ORG', 5.811079979, -55.19070053),
+    ('ORH', 42.26729965, -71.8757019),
+    ('ORJ', 4.725269794, -60.03499985),
+    ('ORK', 51.84130096, -8.491109848),
+    ('ORM', 52.30530167, -0.793056011),
+    ('ORN', 35.62390137, -0.621182978),
+    ('ORP', -21.26670074, 25.31669998),
+    ('ORR', -35.0, 137.6170044),
+    ('ORT', 62.9612999, -141.9290009),
+    ('ORU', -17.96260071, -67.07620239),
+    ('ORV', 66.81790161, -161.0189972),
+    ('ORW', 25.27470016, 64.58599854),
+    ('ORX', -1.714079976, -55.83620071),
+    ('ORY', 48.72529984, 2.359440088),
+    ('ORZ', 18.04676628, -88.58386993),
+    ('OSB', 36.30580139, 43.1473999),
+    ('OSD', 63.19440079, 14.50030041),
+    ('OSE', -7.827777778, 147.0806389),
+    ('OSG', -2.915555556, 141.2973056),
+    ('OSH', 43.9844017, -88.55699921),
+    ('OSI', 45.46269989, 18.81019974),
+    ('OSK', 57.3504982, 16.49799919),
+    ('OSL', 60.19390106, 11.10039997),
+    ('OSN', 37.09059906, 127.0299988),
+    ('OSO', -22.08169937, 140.5549927),
+    ('OSR', 49.69630051, 18.11109924),
+    ('OSS', 40.60900116, 72.79329681),
+    ('OST', 51.19889832, 2.862220049),
+    ('OSW', 51.07249832, 58.59560013),
+    ('OSY', 64.47219849, 11.57859993),
+    ('OSZ', 54.04249954, 16.2656002),
+    ('OTC', 13.44330025, 14.73939991),
+    ('OTD', 8.628760338, -79.03469849),
+    ('OTH', 43.417099, -124.2460022),
+    ('OTI', 2.04598999, 128.3249969),
+    ('OTK', 45.41820145, -123.814003),
+    ('OTL', 17.53300095, -14.68299961),
+    ('OTM', 41.10660172, -92.44789886),
+    ('OTP', 44.57220078, 26.10219955),
+    ('OTR', 8.601559639, -82.96859741),
+    ('OTT', -9.898611111, -58.58194444),
+    ('OTZ', 66.88469696, -162.598999),
+    ('OUA', 12.35319996, -1.512420058),
+    ('OUD', 34.78720093, -1.923990011),
+    ('OUE', 1.615990043, 16.03790092),
+    ('OUG', 13.56700039, -2.417000055),
+    ('OUH', -33.60699844, 22.18899918),
+    ('OUK', 60.41699982, -0.75),
+    ('OUL', 64.93009949, 25.35460091),
+    ('OUR', 4.474999905, 14.36250019),
+    ('OUS', -22.96649933, -49.91329956),
+    ('OUT', 10.4829998, 16.71699905),
+    ('OUZ', 22.75639915, -12.48359966),
+    ('OVA', -24.23569475, 45.30452728),
+    ('OVB', 55.01259995, 82.65070343),
+    ('OVD', 43.56359863, -6.034619808),
+    ('OVG', -34.55490112, 20.2507),
+    ('OVL', -30.55920029, -71.17559814),
+    ('OVR', -36.88999939, -60.21659851),
+    ('OVS', 61.32662201, 63.60191345),
+    ('OWB', 37.74010086, -87.16680145),
+    ('OWD', 42.19049835, -71.17289734),
+    ('OXB', 11.89480019, -15.65369987),
+    ('OXF', 51.8368988, -1.320000052),
+    ('OXP', 3.897599936, -51.80410004),
+    ('OXY', -25.35829926, 141.4329987),
+    ('OYA', -29.1058, -59.2189),
+    ('OYE', 1.543110013, 11.58139992),
+    ('OYG', 3.64440012, 31.76276207),
+    ('OYK', 3.855489969, -51.7969017),
+    ('OYL', 3.469719887, 39.10139847),
+    ('OYN', -35.08901243, 142.3544884),
+    ('OYO', -38.3869, -60.3297),
+    ('OZC', 8.178509712, 123.8420029),
+    ('OZG', 30.32029915, -5.866670132),
+    ('OZH', 47.86700058, 35.31570053),
+    ('OZP', 37.17490005, -5.615940094),
+    ('OZZ', 30.93910027, -6.909430027),
+    ('PAA', 16.89369965, 97.67459869),
+    ('PAB', 21.98839951, 82.11100006),
+    ('PAC', 8.973340034, -79.55560303),
+    ('PAD', 51.61410141, 8.616319656),
+    ('PAE', 47.90629959, -122.2819977),
+    ('PAF', 2.202222, 31.554444),
+    ('PAG', 7.830731448, 123.4611797),
+    ('PAH', 37.06079865, -88.77380371),
+    ('PAJ', 33.90209961, 70.07160187),
+    ('PAK', 21.89690018, -159.6029968),
+    ('PAL', 5.48361, -74.6574),
+    ('PAM', 30.06959915, -85.57540131),
+    ('PAN', 6.785459995, 101.1539993),
+    ('PAO', 37.46110153, -122.1149979),
+    ('PAP', 18.57999992, -72.29250336),
+    ('PAQ', 61.59489822, -149.0890045),
+    ('PAS', 37.01029968, 25.12809944),
+    ('PAT', 25.59129906, 85.08799744),
+    ('PAU', 21.44919968, 94.48690033),
+    ('PAV', -9.40087986, -38.25059891),
+    ('PAX', 19.93359947, -72.84860229),
+    ('PAY', 5.999300003, 117.3999176),
+    ('PAZ', 20.60269928, -97.46080017),
+    ('PBB', -19.65119934, -51.19940186),
+    ('PBC', 19.15810013, -98.37139893),
+    ('PBD', 21.64870071, 69.65720367),
+    ('PBE', 6.46034, -74.4105),
+    ('PBF', 34.17309952, -91.93560028),
+    ('PBG', 44.65090179, -73.4681015),
+    ('PBH', 27.40320015, 89.42459869),
+    ('PBI', 26.68320084, -80.09559631),
+    ('PBJ', -16.43899918, 168.2570038),
+    ('PBL', 10.48050022, -68.07299805),
+    ('PBM', 5.452829838, -55.18780136),
+    ('PBN', -10.72200012, 13.76550007),
+    ('PBO', -23.17110062, 117.7450027),
+    ('PBP', 9.856109619, -85.3707962),
+    ('PBQ', -11.64159966, -61.17910004),
+    ('PBR', 15.73089981, -88.58380127),
+    ('PBT', -20.90460014, -57.92710114),
+    ('PBU', 27.32990074, 97.42630005),
+    ('PBZ', -34.08816017, 23.32872343),
+    ('PCA', 58.90650177, -157.7140045),
+    ('PCB', -6.336959839, 106.7649994),
+    ('PCF', -26.67099953, 27.08189964),
+    ('PCG', 17.26390076, -90.25630188),
+    ('PCJ', -22.29520035, -57.86410141),
+    ('PCL', -8.377940178, -74.57430267),
+    ('PCM', 20.62249947, -87.0821991),
+    ('PCN', -41.34609985, 173.9559937),
+    ('PCO', 23.575011, -109.535826),
+    ('PCP', 1.662940025, 7.411739826),
+    ('PCQ', 21.6475, 101.9),
+    ('PCR', 6.18472, -67.4932),
+    ('PCS', -7.062059879, -41.52370071),
+    ('PCV', 27.06920052, -111.961998),
+    ('PDA', 3.85353, -67.9062),
+    ('PDB', 59.78960037, -154.1239929),
+    ('PDC', -21.31640053, 164.9989929),
+    ('PDE', -26.11669922, 139.3999939),
+    ('PDF', -17.29669952, -39.27119827),
+    ('PDG', -0.874988973, 100.3519974),
+    ('PDI', -6.445138889, 147.5158333),
+    ('PDK', 33.87559891, -84.30200195),
+    ('PDL', 37.74119949, -25.69790077),
+    ('PDO', -3.286070108, 103.8799973),
+    ('PDP', -34.85509872, -55.09429932),
+    ('PDS', 28.62739944, -100.5350037),
+    ('PDT', 45.69509888, -118.8410034),
+    ('PDU', -32.36330032, -58.06190109),
+    ('PDV', 42.06779861, 24.85079956),
+    ('PDX', 45.58869934, -122.5979996),
+    ('PDZ', 9.979240417, -62.22859955),
+    ('PEA', -35.75584629, 137.9628754),
+    ('PED', 50.01340103, 15.73859978),
+    ('PEE', 57.91450119, 56.02119827),
+    ('PEF', 54.15777969, 13.77444363),
+    ('PEG', 43.09590149, 12.51319981),
+    ('PEH', -35.8446, -61.8576),
+    ('PEI', 4.81267, -75.7395),
+    ('PEK', 40.08010101, 116.5849991),
+    ('PEL', -29.12059975, 28.50530052),
+    ('PEM', -12.61359978, -69.22859955),
+    ('PEN', 5.297140121, 100.2770004),
+    ('PEP', -14.14420033, 130.0910034),
+    ('PER', -31.94029999, 115.9670029),
+    ('PES', 61.8852005, 34.15470123),
+    ('PET', -31.71839905, -52.32770157),
+    ('PEU', 15.26220036, -83.78119659),
+    ('PEV', 45.99089813, 18.24099922),
+    ('PEW', 33.9939003, 71.51460266),
+    ('PEX', 65.12110138, 57.13079834),
+    ('PEZ', 53.11059952, 45.02109909),
+    ('PFB', -28.24399948, -52.32659912),
+    ('PFC', 45.19979858, -123.961998),
+    ('PFJ', 65.55580139, -23.96500015),
+    ('PFN', 30.21209908, -85.68280029),
+    ('PFO', 34.7179985, 32.4856987),
+    ('PFQ', 39.60359955, 47.88150024),
+    ('PFR', -4.333000183, 20.58300018),
+    ('PGA', 36.92610168, -111.447998),
+    ('PGB', -7.0226, 141.5605),
+    ('PGE', -7.134444444, 146.1563889),
+    ('PGF', 42.74039841, 2.87067008),
+    ('PGH', 29.03339958, 79.47370148),
+    ('PGI', -7.358890057, 20.80470085),
+    ('PGK', -2.162199974, 106.1389999),
+    ('PGO', 37.28630066, -107.0559998),
+    ('PGV', 35.6352005, -77.38529968),
+    ('PGX', 45.19810104, 0.81555599),
+    ('PGZ', -25.18470001, -50.14410019),
+    ('PHA', 11.6335001, 108.9520035),
+    ('PHB', -2.893749952, -41.73199844),
+    ('PHC', 5.015490055, 6.949590206),
+    ('PHE', -20.37779999, 118.6259995),
+    ('PHF', 37.13190079, -76.49299622),
+    ('PHG', 4.846111111, 7.021388889),
+    ('PHH', 10.90639973, 108.0690002),
+    ('PHI', -2.483611107, -45.0672226),
+    ('PHL', 39.87189865, -75.2410965),
+    ('PHO', 68.34880066, -166.798996),
+    ('PHS', 16.78289986, 100.2789993),
+    ('PHT', 36.33819962, -88.38289642),
+    ('PHW', -23.93720055, 31.15539932),
+    ('PHX', 33.43429947, -112.012001),
+    ('PHY', 16.6760006, 101.1949997),
+    ('PIA', 40.66419983, -89.69329834),
+    ('PIB', 31.46710014, -89.33709717),
+    ('PIC', 21.89999962, -72.09999847),
+    ('PID', 25.08300018, -77.30000305),
+    ('PIE', 27.91020012, -82.68740082),
+    ('PIF', 22.70019913, 120.4820023),
+    ('PIH', 42.90980148, -112.5960007),
+    ('PIK', 55.50939941, -4.586669922),
+    ('PIL', -26.87999916, -58.31999969),
+    ('PIN', -2.673019886, -56.77719879),
+    ('PIO', -13.74489975, -76.22029877),
+    ('PIP', 57.58039856, -157.5720062),
+    ('PIR', 44.38270187, -100.2860031),
+    ('PIS', 46.58769989, 0.306665987),
+    ('PIT', 40.49150085, -80.23290253),
+    ('PIU', -5.205749989, -80.61640167),
+    ('PIV', -17.31690025, -44.86029816),
+    ('PIW', 55.58890152, -97.16419983),
+    ('PIX', 38.5542984, -28.44129944),
+    ('PIZ', 69.73290253, -163.0050049),
+    ('PJA', 67.24559784, 23.06889915),
+    ('PJC', -22.63999939, -55.83000183),
+    ('PJG', 26.9545002, 64.13249969),
+    ('PJM', 8.533329964, -83.30000305),
+    ('PKA', 60.70289993, -161.7779999),
+    ('PKB', 39.3451004, -81.43920135),
+    ('PKC', 53.16790009, 158.4539948),
+    ('PKE', -33.13140106, 148.2389984),
+    ('PKG', 4.244719982, 100.5530014),
+    ('PKH', 37.29750061, 23.14780045),
+    ('PKJ', 15.99750042, -90.74169922),
+    ('PKK', 21.4043, 95.11125),
+    ('PKN', -2.705199957, 111.6729965),
+    ('PKO', 9.357689857, 2.609679937),
+    ('PKP', -14.80949974, -138.8130035),
+    ('PKR', 28.20089912, 83.98210144),
+    ('PKT', -14.25, 129.529007),
+    ('PKU', 0.460786015, 101.4449997),
+    ('PKV', 57.78390121, 28.39559937),
+    ('PKW', -22.05830002, 27.8288002),
+    ('PKY', -2.225130081, 113.9430008),
+    ('PKZ', 15.13210011, 105.7809982),
+    ('PLD', 10.25, -85.41699982),
+    ('PLE', -5.3725, 142.9761111),
+    ('PLF', 9.378060341, 14.92500019),
+    ('PLH', 50.42279816, -4.105830193),
+    ('PLJ', 16.53695679, -88.36151123),
+    ('PLL', -3.146039963, -59.98630142),
+    ('PLM', -2.898250103, 104.6999969),
+    ('PLN', 45.57089996, -84.79669952),
+    ('PLO', -34.6053009, 135.8800049),
+    ('PLP', 8.406669617, -78.14170074),
+    ('PLQ', 55.97320175, 21.09390068),
+    ('PLS', 21.77359962, -72.26589966),
+    ('PLT', 9.8, -74.7833),
+    ('PLU', -19.8512001, -43.95059967),
+    ('PLV', 49.5685997, 34.39720154),
+    ('PLW', -0.918542027, 119.9100037),
+    ('PLZ', -33.98490143, 25.61730003),
+    ('PMA', -5.257259846, 39.81140137),
+    ('PMC', -41.43889999, -73.09400177),
+    ('PMD', 34.62939835, -118.0849991),
+    ('PMF', 44.82450104, 10.29640007),
+    ('PMG', -22.5496006, -55.70259857),
+    ('PMI', 39.55170059, 2.738810062),
+    ('PMK', -18.75530052, 146.5809937),
+    ('PML', 56.00600052, -160.5610046),
+    ('PMN', -9.7469, 149.4766),
+    ('PMO', 38.17599869, 13.0909996),
+    ('PMP', -6.499166667, 143.5102778),
+    ('PMQ', -46.53789902, -70.97869873),
+    ('PMR', -40.3205986, 175.6170044),
+    ('PMS', 34.55739975, 38.31689835),
+    ('PMV', 10.91260338, -63.96659851),
+    ('PMW', -10.29150009, -48.35699844),
+    ('PMX', 42.22330093, -72.31140137),
+    ('PMY', -42.7592, -65.1027),
+    ('PMZ', 8.951029778, -83.46859741),
+    ('PNA', 42.77000046, -1.646329999),
+    ('PNB', -10.71940041, -48.39970016),
+    ('PND', 16.10239983, -88.8082962),
+    ('PNG', -25.5401001, -48.53120041),
+    ('PNH', 11.54660034, 104.8440018),
+    ('PNI', 6.985099792, 158.2089996),
+    ('PNK', -0.150711, 109.4039993),
+    ('PNL', 36.81650162, 11.96889973),
+    ('PNO', 16.35000038, -98.06199646),
+    ('PNP', -8.80453968, 148.3090057),
+    ('PNQ', 18.58209991, 73.91970062),
+    ('PNR', -4.816030025, 11.88659954),
+    ('PNS', 30.47340012, -87.18659973),
+    ('PNT', -51.67150116, -72.52839661),
+    ('PNV', 55.72940063, 24.46080017),
+    ('PNY', 11.96870041, 79.81009674),
+    ('PNZ', -9.362409592, -40.56909943),
+    ('POA', -29.99440002, -51.17139816),
+    ('POD', 16.68300056, -14.96700001),
+    ('POG', -0.711739004, 8.754380226),
+    ('POI', -19.54310036, -65.72370148),
+    ('POJ', -18.67280006, -46.49119949),
+    ('POL', -12.99176216, 40.52401352),
+    ('POM', -9.443380356, 147.2200012),
+    ('PON', 16.32579994, -89.41609955),
+    ('POO', -21.84300041, -46.56790161),
+    ('POP', 19.75790024, -70.56999969),
+    ('POR', 61.46170044, 21.79999924),
+    ('POS', 10.59539986, -61.33720016),
+    ('POT', 18.19879913, -76.53450012),
+    ('POU', 41.62659836, -73.88420105),
+    ('POV', 49.02970123, 21.31559944),
+    ('POW', 45.47340012, 13.61499977),
+    ('POX', 49.09659958, 2.040829897),
+    ('POZ', 52.42100143, 16.82629967),
+    ('PPB', -22.17510033, -51.42459869),
+    ('PPD', 18.08620071, -65.79650116),
+    ('PPE', 31.3562023, -113.5256767),
+    ('PPG', -14.33100033, -170.7100067),
+    ('PPH', 4.56666708, -61.48333359),
+    ('PPI', -33.23889923, 137.9949951),
+    ('PPJ', -5.644444, 106.5625),
+    ('PPK', 54.77470016, 69.18389893),
+    ('PPL', 27.51778688, 86.58445358),
+    ('PPN', 2.4544, -76.6093),
+    ('PPP', -20.49500084, 148.552002),
+    ('PPQ', -40.90470123, 174.9889984),
+    ('PPR', 0.84543103, 100.3700027),
+    ('PPS', 9.742119789, 118.7590027),
+    ('PPT', -17.55369949, -149.6069946),
+    ('PPU', 18.06669998, 97.44979858),
+    ('PPW', 59.35169983, -2.900279999),
+    ('PPY', -22.28919983, -45.91910172),
+    ('PQC', 10.22700024, 103.9670029),
+    ('PQI', 46.68899918, -68.0447998),
+    ('PQM', 17.53339958, -91.98449707),
+    ('PQQ', -31.43580055, 152.8630066),
+    ('PRA', -31.7948, -60.4804),
+    ('PRC', 34.65449905, -112.4199982),
+    ('PRD', -20.11750031, 119.5899963),
+    ('PRG', 50.10079956, 14.26000023),
+    ('PRH', 18.13220024, 100.1650009),
+    ('PRI', -4.319290161, 55.69139862),
+    ('PRK', -29.68359947, 22.77059937),
+    ('PRM', 37.14929962, -8.583959579),
+    ('PRN', 42.57279968, 21.03580093),
+    ('PRP', 41.66059875, 8.889749527),
+    ('PRQ', -26.75361061, -60.49222183),
+    ('PRR', 5.815450191, -61.05540085),
+    ('PRS', -9.641670227, 161.4250031),
+    ('PRU', 18.82449913, 95.26599884),
+    ('PRV', 49.42580032, 17.40469933),
+    ('PRY', -25.65390015, 28.2241993),
+    ('PSA', 43.68389893, 10.3927002),
+    ('PSC', 46.26470184, -119.1190033),
+    ('PSD', 31.27939987, 32.24000168),
+    ('PSE', 18.00830078, -66.56300354),
+    ('PSG', 56.80170059, -132.9450073),
+    ('PSH', 54.30888748, 8.686944008),
+    ('PSI', 25.29050064, 63.3451004),
+    ('PSJ', -1.416749954, 120.6579971),
+    ('PSL', 56.43920135, -3.372220039),
+    ('PSM', 43.07789993, -70.82330322),
+    ('PSO', 1.39625, -77.2915),
+    ('PSP', 33.82970047, -116.5070038),
+    ('PSR', 42.43170166, 14.18109989),
+    ('PSS', -27.3858, -55.9707),
+    ('PST', 20.73500061, -75.65830231),
+    ('PSU', 0.835578024, 112.9369965),
+    ('PSV', 60.32170105, -1.69306004),
+    ('PSW', -20.73220062, -46.66180038),
+    ('PSY', -51.68569946, -57.77759933),
+    ('PSZ', -18.97529984, -57.8205986),
+    ('PTA', 60.20429993, -154.3190002),
+    ('PTF', -17.7779007, 177.1970062),
+    ('PTG', -23.84530067, 29.45859909),
+    ('PTH', 56.95909882, -158.6329956),
+    ('PTJ', -38.31809998, 141.470993),
+    ('PTM', 7.566669941, -70.1832962),
+    ('PTO', -26.21780014, -52.69430161),
+    ('PTP', 16.26530075, -61.53179932),
+    ('PTQ', -1.741449952, -52.23609924),
+    ('PTU', 59.01139832, -161.8200073),
+    ('PTX', 1.85777, -76.0857),
+    ('PTY', 9.071359634, -79.38349915),
+    ('PTZ', -1.505239964, -78.06269836),
+    ('PUA', -2.395, 150.2361),
+    ('PUB', 38.28910065, -104.4970016),
+    ('PUD', -47.7353, -65.9041),
+    ('PUF', 43.38000107, -0.41861099),
+    ('PUG', -32.50690079, 137.7169952),
+    ('PUJ', 18.56739998, -68.36340332),
+    ('PUK', -18.29560089, -137.0169983),
+    ('PUM', -4.181089878, 121.6179962),
+    ('PUN', -1.366999984, 26.33300018),
+    ('PUP', 11.14999962, -1.149999976),
+    ('PUQ', -53.00260162, -70.854599),
+    ('PUR', -11.10766315, -67.55115509),
+    ('PUS', 35.17950058, 128.9380035),
+    ('PUT', 14.14929962, 77.79109955),
+    ('PUU', 0.505228, -76.5008),
+    ('PUV', -20.28919983, 164.098999),
+    ('PUW', 46.7439003, -117.1100006),
+    ('PUX', -41.34939957, -72.94670105),
+    ('PUY', 44.89350128, 13.9222002),
+    ('PUZ', 14.0472002, -83.38670349),
+    ('PVA', 13.3569, -81.3583),
+    ('PVC', 42.07189941, -70.2213974),
+    ('PVD', 41.73260117, -71.42040253),
+    ('PVG', 31.14340019, 121.8050003),
+    ('PVH', -8.709289551, -63.90230179),
+    ('PVI', -23.08989906, -52.48849869),
+    ('PVK', 38.92549896, 20.76530075),
+    ('PVN', 43.45140076, 24.50279999),
+    ('PVO', -1.041650057, -80.47219849),
+    ('PVR', 20.68009949, -105.2539978),
+    ('PVS', 64.37809753, -173.2429962),
+    ('PWE', 69.78330231, 170.5970001),
+    ('PWL', -7.461669922, 109.4169998),
+    ('PWM', 43.64619827, -70.30930328),
+    ('PWN', 22.82970047, -74.34609985),
+    ('PWO', -8.467000008, 28.88299942),
+    ('PWQ', 52.19499969, 77.07389832),
+    ('PWT', 47.49020004, -122.7649994),
+    ('PXL', 35.79169846, -110.4229965),
+    ('PXM', 15.87689972, -97.0891037),
+    ('PXO', 33.07339859, -16.35000038),
+    ('PXU', 14.00450039, 108.0169983),
+    ('PYB', 18.87999916, 82.55200195),
+    ('PYC', 9.305184161, -78.23587418),
+    ('PYE', -9.014369965, -158.0324097),
+    ('PYH', 5.619989872, -67.60610199),
+    ('PYJ', 66.40039825, 112.0299988),
+    ('PYO', 0.083333001, -75.90000153),
+    ('PYR', 37.92070007, 21.29260063),
+    ('PYY', 19.37199974, 98.43699646),
+    ('PZA', 5.87615, -71.8866),
+    ('PZB', -29.64900017, 30.39870071),
+    ('PZH', 31.35840034, 69.46360016),
+    ('PZI', 26.54, 101.79852),
+    ('PZL', -27.84939957, 32.30970001),
+    ('PZO', 8.28853035, -62.76039886),
+    ('PZU', 19.43359947, 37.23410034),
+    ('PZY', 48.62519836, 17.82839966),
+    ('QAC', -24.80750084, -49.96030045),
+    ('QAK', -21.26720047, -43.76110077),
+    ('QAM', 49.8730011, 2.387069941),
+    ('QAQ', 42.37990189, 13.30920029),
+    ('QAR', 52.06060028, 5.873060226),
+    ('QBC', 52.38750076, -126.5960007),
+    ('QBG', 44.9, 20.641),
+    ('QBX', -3.67888999, -40.33679962),
+    ('QCH', -19.48699951, -40.57939911),
+    ('QCJ', -22.93950081, -48.4679985),
+    ('QCN', -29.3701992, -50.83200073),
+    ('QCO', 22.71109962, -80.92279816),
+    ('QCP', -6.280832767, -36.54027939),
+    ('QCR', -27.28249931, -50.6114006),
+    ('QCY', 53.0929985, -0.166014001),
+    ('QDB', -30.00189972, -52.94079971),
+    ('QDC', -21.46050072, -51.60689926),
+    ('QDF', -20.73859978, -43.79740143),
+    ('QDJ', 34.6657, 3.351),
+    ('QEF', 49.95999908, 8.645833015),
+    ('QEO', 49.93999863, 19.02199936),
+    ('QFD', 36.54579926, 2.876110077),
+    ('QFO', 52.09080124, 0.131944001),
+    ('QFR', 41.64640045, 13.29979992),
+    ('QGA', -24.08110046, -54.19169998),
+    ('QGB', -22.603889, -47.411944),
+    ('QGC', -22.57839966, -48.77460098),
+    ('QGF', -29.71940041, -51.48939896),
+    ('QGP', -8.834280014, -36.47159958),
+    ('QGS', -12.17510033, -38.38019943),
+    ('QGU', 35.39410019, 136.8699951),
+    ('QGY', 47.62440109, 17.81360054),
+    ('QHA', 50.97000122, 5.375070095),
+    ('QHB', -22.71150017, -47.61819839),
+    ('QHN', -12.43388939, -46.40055466),
+    ('QHP', -23.0401001, -45.51599884),
+    ('QHR', 8.7163, 39.0059),
+    ('QHU', 54.50999832, 9.138333321),
+    ('QHV', -29.69610023, -51.08169937),
+    ('QID', -21.79059982, -45.26919937),
+    ('QIE', 43.52270126, 4.923840046),
+    ('QIG', -6.34664011, -39.29380035),
+    ('QIQ', -22.430104, -47.562113),
+    ('QIT', -15.24450016, -40.27719879),
+    ('QIZ', 37.24539948, 9.791449547),
+    ('QJB', 27.03899956, 49.40510178),
+    ('QJD', -36.42670059, 148.602005),
+    ('QKX', 69.04029846, 23.0340004),
+    ('QLA', 51.18719864, -1.033499956),
+    ('QLC', 50.26940155, 18.67280006),
+    ('QLD', 36.50360107, 2.814169884),
+    ('QLF', 61.14419937, 25.69350052),
+    ('QLP', 44.08800125, 9.987950325),
+    ('QLS', 46.54529953, 6.616670132),
+    ('QLT', 41.54240036, 12.9090004),
+    ('QLU', 51.22190094, 22.3946991),
+    ('QMF', -26.15889931, -49.8321991),
+    ('QMH', 35.87969971, 7.270800114),
+    ('QMM', 43.98600006, 10.14280033),
+    ('QMZ', 49.96749878, 8.147221565),
+    ('QNC', 46.95750046, 6.864719868),
+    ('QND', 45.385799, 19.839199),
+    ('QNJ', 46.19200134, 6.268390179),
+    ('QNM', 50.48799896, 4.768919945),
+    ('QNS', -29.94589996, -51.14440155),
+    ('QNV', -22.74530029, -43.46030045),
+    ('QNX', 46.29510117, 4.795770168),
+    ('QOA', -21.4878006, -47.03440094),
+    ('QOB', 49.36111069, 10.66944408),
+    ('QOE', 50.83119965, 6.658170223),
+    ('QOJ', -28.6548996, -56.0345993),
+    ('QON', 49.66279984, 5.886940002),
+    ('QOW', 5.427060127, 7.206029892),
+    ('QPA', 45.39580154, 11.84790039),
+    ('QPD', 22.42140007, -83.67839813),
+    ('QPG', 1.360419989, 103.9100037),
+    ('QPH', -22.56699944, 27.14999962),
+    ('QPM', 50.63330078, 17.78170013),
+    ('QPS', -21.98460007, -47.33480072),
+    ('QPZ', 44.9131012, 9.723329544),
+    ('QRA', -26.24250031, 28.15119934),
+    ('QRC', -34.17369843, -70.77570343),
+    ('QRF', -35.144, -60.4803),
+    ('QRM', -32.21469879, 148.2250061),
+    ('QRO', 20.61730003, -100.185997),
+    ('QRR', -31.73329926, 147.8029938),
+    ('QRT', 42.42720032, 12.85169983),
+    ('QRW', 5.596109867, 5.817780018),
+    ('QRZ', -22.47850037, -44.4803009),
+    ('QSA', 41.52090073, 2.105079889),
+    ('QSC', -21.87540054, -47.90370178),
+    ('QSF', 36.17810059, 5.32449007),
+    ('QSI', -3.363329887, 37.32690048),
+    ('QSN', 22.7560997, -81.92089844),
+    ('QSR', 40.62039948, 14.91129971),
+    ('QSX', 6.244324, -57.474172),
+    ('QTJ', 48.45890045, 1.523890018),
+    ('QUB', 26.56749916, 12.82310009),
+    ('QUG', 50.8594017, -0.759167016),
+    ('QUN', 37.88380051, 127.7180023),
+    ('QUO', 4.8725, 8.093),
+    ('QUS', 12.17169952, 6.696109772),
+    ('QUT', 36.51449966, 139.8710022),
+    ('QUY', 52.35720062, -0.107832998),
+    ('QVA', 45.74219894, 8.888230324),
+    ('QVB', -26.23340034, -51.06779861),
+    ('QVE', 60.80189896, 23.64559937),
+    ('QVP', -23.09250069, -48.98740005),
+    ('QWG', 35.21379852, -80.67009735),
+    ('QWS', 49.46279907, 20.0503006),
+    ('QWV', 44.29781667, 20.02191111),
+    ('QXC', -9.375277519, -35.54166794),
+    ('QXH', 52.20360947, 13.15638924),
+    ('QYD', 54.57970047, 18.51720047),
+    ('QYR', 48.32210159, 4.016699791),
+    ('QYY', 53.10139847, 23.17060089),
+    ('QZD', 46.24689865, 20.09079933),
+    ('QZN', 35.75220108, 0.626272023),
+    ('QZO', 43.45589828, 11.84700012),
+    ('RAA', -4.2096, 152.4385),
+    ('RAB', -4.340459824, 152.3800049),
+    ('RAE', 30.90660095, 41.13819885),
+    ('RAG', -37.80469894, 174.8600006),
+    ('RAH', 29.62639999, 43.49060059),
+    ('RAI', 14.92450047, -23.49349976),
+    ('RAJ', 22.30920029, 70.77950287),
+    ('RAK', 31.60689926, -8.036299706),
+    ('RAL', 33.95190048, -117.4449997),
+    ('RAM', -12.35639954, 134.897995),
+    ('RAN', 44.36429977, 12.22490025),
+    ('RAO', -21.13638878, -47.77666855),
+    ('RAP', 44.04529953, -103.0569992),
+    ('RAQ', -4.860070229, 122.7200012),
+    ('RAR', -21.20269966, -159.8059998),
+    ('RAS', 37.323333, 49.617778),
+    ('RAV', 6.31684, -70.2107),
+    ('RAW', -6.220555556, 155.5713889),
+    ('RAX', -9.632916667, 148.0480556),
+    ('RAZ', 33.84970093, 73.79810333),
+    ('RBA', 34.05149841, -6.751520157),
+    ('RBB', -4.406340122, -59.60240173),
+    ('RBC', -34.65000153, 142.7830048),
+    ('RBD', 32.68090057, -96.86820221),
+    ('RBE', 13.72999954, 106.9869995),
+    ('RBF', 34.26380157, -116.8560028),
+    ('RBG', 43.23880005, -123.3560028),
+    ('RBI', -16.5337, 179.9757),
+    ('RBJ', 45.45500183, 141.0390015),
+    ('RBK', 33.57419968, -117.1279984),
+    ('RBM', 48.90083313, 12.51666737),
+    ('RBO', -18.32920074, -59.76499939),
+    ('RBP', -9.971111111, 149.8322222),
+    ('RBQ', -14.42790031, -67.49680328),
+    ('RBR', -9.868888855, -67.89805603),
+    ('RBS', -37.79000092, 148.6100006),
+    ('RBT', 2.349999905, 37.98300171),
+    ('RBU', -20.76169968, 117.1569977),
+    ('RBV', -8.168060303, 157.6430054),
+    ('RBW', 32.92100143, -80.64060211),
+    ('RBX', 6.824999809, 29.66900063),
+    ('RBY', 64.72720337, -155.4700012),
+    ('RCB', -28.74099922, 32.09209824),
+    ('RCH', 11.5262, -72.926),
+    ('RCL', -15.47200012, 167.8350067),
+    ('RCM', -20.70190048, 143.1150055),
+    ('RCO', 45.88779831, -0.983056009),
+    ('RCQ', -29.2103, -59.68),
+    ('RCR', 41.06560135, -86.18170166),
+    ('RCS', 51.35189819, 0.503332973),
+    ('RCT', 43.90000153, -85.51670074),
+    ('RCU', -33.08509827, -64.26129913),
+    ('RCY', 23.68440056, -74.8361969),
+    ('RDA', -18.95330048, 135.201004),
+    ('RDB', 68.03209686, -162.8990021),
+    ('RDC', -8.033289909, -49.97990036),
+    ('RDD', 40.50899887, -122.2929993),
+    ('RDE', -1.583330035, 133.3329926),
+    ('RDG', 40.37850189, -75.96520233),
+    ('RDM', 44.2541008, -121.1500015),
+    ('RDN', 5.76527977, 103.0070038),
+    ('RDR', 47.96110153, -97.40119934),
+    ('RDS', -37.3905983, -68.90419769),
+    ('RDT', 16.43300056, -15.64999962),
+    ('RDU', 35.87760162, -78.78749847),
+    ('RDV', 61.7881012, -157.3500061),
+    ('RDZ', 44.40790176, 2.482670069),
+    ('REA', -18.46590042, -136.4400024),
+    ('REB', 53.30638886, 12.75222206),
+    ('REC', -8.126489639, -34.92359924),
+    ('REG', 38.07120132, 15.65159988),
+    ('REI', 4.314720154, -52.13169861),
+    ('REL', -43.2105, -65.2703),
+    ('REN', 51.79579926, 55.45669937),
+    ('REP', 13.41069984, 103.8130035),
+    ('REQ', 28.9708004, 62.43330002),
+    ('RER', 14.52099991, -91.69730377),
+    ('RES', -27.45, -59.0561),
+    ('RET', 67.52780151, 12.10330009),
+    ('REU', 41.1473999, 1.167170048),
+    ('REX', 26.00889969, -98.22850037),
+    ('REY', -14.30440044, -67.35340118),
+    ('RFA', 4.988609791, 23.92779922),
+    ('RFD', 42.19540024, -89.09719849),
+    ('RFN', 66.40640259, -15.91829967),
+    ('RFP', -16.72290039, -151.4660034),
+    ('RFR', 10.32740021, -83.88760376),
+    ('RFS', 13.88969994, -84.4088974),
+    ('RGA', -53.7777, -67.7494),
+    ('RGH', 25.26169968, 88.79560089),
+    ('RGI', -14.95429993, -147.6609955),
+    ('RGK', 51.96670151, 85.83329773),
+    ('RGL', -51.6089, -69.3126),
+    ('RGN', 16.90730095, 96.1332016),
+    ('RGO', 41.42810059, 129.6470032),
+    ('RGS', 42.35760117, -3.620759964),
+    ('RGT', -0.352807999, 102.3349991),
+    ('RHA', 65.45262909, -22.20611572),
+    ('RHD', -27.47369957, -64.90550232),
+    ('RHE', 49.31000137, 4.050000191),
+    ('RHG', -1.5, 29.63299942),
+    ('RHI', 45.63119888, -89.46749878),
+    ('RHL', -22.62581589, 119.9590302),
+    ('RHO', 36.40539932, 28.08620071),
+    ('RHP', 27.3939991, 86.06140137),
+    ('RIA', -29.71139908, -53.6882019),
+    ('RIB', -11.0, -66.0),
+    ('RIC', 37.50519943, -77.31970215),
+    ('RIG', -32.081699, -52.163299),
+    ('RIJ', -6.067860126, -77.16000366),
+    ('RIK', 9.870510101, -85.48139954),
+    ('RIM', -6.392310143, -77.50119781),
+    ('RIN', -8.126390457, 157.1430054),
+    ('RIS', 45.24200058, 141.1860046),
+    ('RIW', 43.06420136, -108.4599991),
+    ('RIX', 56.92359924, 23.97109985),
+    ('RIY', 14.66259956, 49.375),
+    ('RJA', 17.11039925, 81.81819916),
+    ('RJB', 26.5170002, 86.75),
+    ('RJH', 24.43720055, 88.61650085),
+    ('RJI', 33.37789917, 74.31520081),
+    ('RJK', 45.21689987, 14.5703001),
+    ('RJL', 42.46095349, -2.322235107),
+    ('RJN', 30.29770088, 56.05110168),
+    ('RKA', -15.48530006, -145.4700012),
+    ('RKD', 44.06010056, -69.09919739),
+    ('RKE', 55.58560181, 12.13140011),
+    ('RKI', -0.949999988, 100.75),
+    ('RKO', -2.099119902, 99.70069885),
+    ('RKS', 41.59420013, -109.0650024),
+    ('RKT', 25.6135006, 55.93880081),
+    ('RKU', -8.817000054, 146.5243833),
+    ('RKV', 64.12999725, -21.94059944),
+    ('RLA', 37.93569946, -91.81349945),
+    ('RLG', 53.91820145, 12.27830029),
+    ('RLO', -32.38470078, -65.18650055),
+    ('RMA', -26.54500008, 148.7749939),
+    ('RMB', 24.2329998, 55.78300095),
+    ('RMF', 25.5571003, 34.58369827),
+    ('RMI', 44.02030182, 12.61170006),
+    ('RMK', -34.19639969, 140.673996),
+    ('RML', 6.821990013, 79.88619995),
+    ('RMN', -5.897222222, 141.2716667),
+    ('RMQ', 24.26469994, 120.6210022),
+    ('RMS', 49.43690109, 7.600279808),
+    ('RMT', -22.63725, -152.8059),
+    ('RMU', 37.803, -1.125),
+    ('RMY', 37.51089859, -120.0400009),
+    ('RNA', -9.860543583, 161.9795465),
+    ('RNB', 56.26670074, 15.26500034),
+    ('RNE', 46.05830002, 4.00138998),
+    ('RNI', 12.16289997, -83.063797),
+    ('RNJ', 27.04400063, 128.4019928),
+    ('RNL', -11.53390026, 160.0630035),
+    ('RNM', 21.38299942, 57.04999924),
+    ('RNN', 55.06330109, 14.75959969),
+    ('RNO', 39.49909973, -119.7679977),
+    ('RNP', 11.15719986, 166.8869934),
+    ('RNS', 48.06949997, -1.734789968),
+    ('RNU', 5.949999809, 116.6669998),
+    ('ROA', 37.32550049, -79.97540283),
+    ('ROB', 6.233789921, -10.36229992),
+    ('ROC', 43.1189003, -77.67240143),
+    ('ROD', -33.81219864, 19.90279961),
+    ('ROF', 41.73040009, -122.5459976),
+    ('ROH', -18.84499931, 143.7100067),
+    ('ROI', 16.11680031, 103.7740021),
+    ('ROK', -23.38190079, 150.4750061),
+    ('ROO', -16.58600044, -54.72480011),
+    ('ROP', 14.17430019, 145.2429962),
+    ('ROR', 7.367650032, 134.5440063),
+    ('ROS', -32.9036, -60.785),
+    ('ROT', -38.10919952, 176.3170013),
+    ('ROV', 47.2582016, 39.81809998),
+    ('ROW', 33.30160141, -104.5309982),
+    ('ROY', -45.70389938, -70.24559784),
+    ('ROZ', 36.64519882, -6.349460125),
+    ('RPA', 28.2670002, 82.76699829),
+    ('RPB', -14.734814, 134.525485),
+    ('RPM', -14.72280025, 134.746994),
+    ('RPN', 32.98099899, 35.57189941),
+    ('RPR', 21.18040085, 81.73880005),
+    ('RPX', 46.475095, -108.541497),
+    ('RQO', 35.47269821, -98.00579834),
+    ('RQW', 35.76720047, 43.12509918),
+    ('RRE', -29.66329956, 138.0650024),
+    ('RRG', -19.75769997, 63.36100006),
+    ('RRK', 22.25670052, 84.81459808),
+    ('RRR', -16.045, -142.476944),
+    ('RRS', 62.57839966, 11.34230042),
+    ('RSA', -36.5882988, -64.27570343),
+    ('RSB', -25.83329964, 139.6499939),
+    ('RSD', 24.89507873, -76.17688179),
+    ('RSK', -1.508450031, 134.1869965),
+    ('RSS', 11.78590012, 34.33670044),
+    ('RST', 43.90829849, -92.5),
+    ('RSU', 34.84230042, 127.6169968),
+    ('RSW', 26.53619957, -81.75520325),
+    ('RTA', -12.48250008, 177.0709991),
+    ('RTB', 16.31679916, -86.52300262),
+    ('RTC', 17.0135994, 73.32779694),
+    ('RTG', -8.597009659, 120.4769974),
+    ('RTI', -10.48429966, 123.3740005),
+    ('RTM', 51.95690155, 4.437220097),
+    ('RTP', -15.64330006, 141.8430023),
+    ('RTS', -32.00669861, 115.5400009),
+    ('RTW', 51.56499863, 46.04669952),
+    ('RTY', -28.58329964, 140.3170013),
+    ('RUA', 3.049999952, 30.91699982),
+    ('RUD', 36.4253006, 55.10419846),
+    ('RUE', 0.117142, 29.312992),
+    ('RUF', -3.633330107, 140.9329987),
+    ('RUG', 32.25788498, 120.5016556),
+    ('RUH', 24.95759964, 46.69879913),
+    ('RUK', 28.62700081, 82.19499969),
+    ('RUM', 27.30349922, 86.55039978),
+    ('RUN', -20.88710022, 55.51029968),
+    ('RUP', 26.13969994, 89.91000366),
+    ('RUR', -22.4340992, -151.3609924),
+    ('RUS', -9.86166954, 160.8249969),
+    ('RUT', 43.52939987, -72.94960022),
+    ('RUU', -5.334444444, 144.2566667),
+    ('RUV', 15.99199963, -90.44529724),
+    ('RUY', 14.84029961, -89.14399719),
+    ('RVA', -22.80529976, 47.8205986),
+    ('RVC', 5.471326, -9.585373),
+    ('RVD', -17.83472252, -50.95611191),
+    ('RVK', 64.83830261, 11.14610004),
+    ('RVN', 66.56479645, 25.83040047),
+    ('RVO', -27.54719925, 24.17250061),
+    ('RVR', 38.96139908, -110.2269974),
+    ('RVT', -33.79719925, 120.2080002),
+    ('RVV', -23.8852005, -147.6620026),
+    ('RVY', -30.97459984, -55.4762001),
+    ('RWI', 35.85630035, -77.89189911),
+    ('RWN', 50.60710144, 26.14159966),
+    ('RXE', 43.83390045, -111.8050003),
+    ('RXS', 11.59770012, 122.7519989),
+    ('RYB', 58.10419846, 38.9294014),
+    ('RYG', 59.37889862, 10.78559971),
+    ('RYK', 28.38389969, 70.27960205),
+    ('RYN', 45.62810135, -0.972500026),
+    ('RYO', -51.60919952, -72.22170258),
+    ('RZA', -50.0165, -68.5792),
+    ('RZE', 50.11000061, 22.0189991),
+    ('RZN', 54.55590057, 39.85520172),
+    ('RZP', 10.81874, 119.507697),
+    ('RZR', 36.90990067, 50.67959976),
+    ('RZS', -8.132780075, 115.1729965),
+    ('SAB', 17.64500046, -63.22000122),
+    ('SAC', 38.51250076, -121.4929962),
+    ('SAD', 32.85480118, -109.6350021),
+    ('SAE', -8.366669655, 118.3333588),
+    ('SAF', 35.61709976, -106.0889969),
+    ('SAH', 15.47630024, 44.21969986),
+    ('SAK', 65.73169708, -19.57279968),
+    ('SAL', 13.44089985, -89.05570221),
+    ('SAM', -9.6701, 150.7903),
+    ('SAN', 32.73360062, -117.1900024),
+    ('SAP', 15.45259953, -87.92359924),
+    ('SAQ', 25.05380058, -78.0490036),
+    ('SAR', 38.14889908, -89.69869995),
+    ('SAS', 33.24140167, -115.9520035),
+    ('SAT', 29.53370094, -98.46980286),
+    ('SAU', -10.4924, 121.8482),
+    ('SAV', 32.12760162, -81.20210266),
+    ('SAW', 40.89860153, 29.30920029),
+    ('SAX', 8.017000198, -78.19999695),
+    ('SAY', 43.25630188, 11.25500011),
+    ('SAZ', 4.666666985, -8.433333397),
+    ('SBA', 34.42620087, -119.8399963),
+    ('SBB', 7.803514004, -71.16571808),
+    ('SBC', -5.315, 141.752),
+    ('SBD', 34.09540176, -117.2350006),
+    ('SBE', -6.104444444, 142.2783333),
+    ('SBF', 33.3207016, 68.6364975),
+    ('SBG', 5.874129772, 95.33969879),
+    ('SBH', 17.90439987, -62.84360123),
+    ('SBI', 12.57269955, -13.35849953),
+    ('SBJ', -18.72130013, -39.83369827),
+    ('SBK', 48.53779984, -2.854439974),
+    ('SBL', -13.76220036, -65.43520355),
+    ('SBM', 43.76959991, -87.85140228),
+    ('SBN', 41.70869827, -86.31729889),
+    ('SBO', 39.02909851, -111.8379974),
+    ('SBP', 35.23680115, -120.6419983),
+    ('SBQ', 29.57119942, 67.84790039),
+    ('SBR', -9.378330231, 142.625),
+    ('SBS', 40.5163002, -106.8659973),
+    ('SBU', -29.68930054, 17.93959999),
+    ('SBV', -5.5765, 155.0489),
+    ('SBW', 2.261600018, 111.9850006),
+    ('SBX', 48.54069901, -111.8710022),
+    ('SBY', 38.34049988, -75.51029968),
+    ('SBZ', 45.78559875, 24.09129906),
+    ('SCC', 70.19470215, -148.4649963),
+    ('SCD', 14.90719986, -87.26339722),
+    ('SCE', 40.84930038, -77.84870148),
+    ('SCG', -18.63330078, 144.5670013),
+    ('SCI', 7.801320076, -72.20290375),
+    ('SCL', -33.39300156, -70.78579712),
+    ('SCM', 61.84529877, -165.5709991),
+    ('SCN', 49.21459961, 7.109509945),
+    ('SCO', 43.86009979, 51.09199905),
+    ('SCP', 44.7016983, 6.600279808),
+    ('SCQ', 42.89630127, -8.415140152),
+    ('SCS', 60.43280029, -1.296110034),
+    ('SCT', 12.63070011, 53.90579987),
+    ('SCU', 19.96980095, -75.83540344),
+    ('SCV', 47.6875, 26.35409927),
+    ('SCW', 61.64699936, 50.8451004),
+    ('SCX', 16.21260071, -95.20159912),
+    ('SCY', -0.91020602, -89.61740112),
+    ('SCZ', -10.72029972, 165.7949982),
+    ('SDB', -32.96889877, 18.1602993),
+    ('SDD', -14.92469978, 13.57499981),
+    ('SDE', -27.76555634, -64.30999756),
+    ('SDF', 38.17440033, -85.73600006),
+    ('SDG', 35.2458992, 47.00920105),
+    ('SDH', 14.8149004, -88.75150299),
+    ('SDI', -5.627138889, 146.4627778),
+    ('SDJ', 38.13970184, 140.9170074),
+    ('SDK', 5.900899887, 118.0589981),
+    ('SDL', 62.52809906, 17.44389915),
+    ('SDN', 61.83000183, 6.105830193),
+    ('SDP', 55.31499863, -160.522995),
+    ('SDQ', 18.42970085, -69.66889954),
+    ('SDR', 43.42710114, -3.820009947),
+    ('SDS', 38.06019974, 138.4140015),
+    ('SDT', 34.81359863, 72.35279846),
+    ('SDU', -22.91049957, -43.1631012),
+    ('SDV', 32.11470032, 34.78219986),
+    ('SDY', 47.70690155, -104.1930008),
+    ('SEA', 47.44900131, -122.3089981),
+    ('SEB', 26.98699951, 14.47249985),
+    ('SEH', -3.433330059, 140.8170013),
+    ('SEN', 51.57139969, 0.695555985),
+    ('SEO', 7.968329906, -6.710830212),
+    ('SEQ', 1.370000005, 102.1399994),
+    ('SEU', -2.458060026, 34.82249832),
+    ('SEV', 48.9003301, 38.54166985),
+    ('SEW', 29.34550095, 25.50670052),
+    ('SEY', 15.1796999, -12.20730019),
+    ('SEZ', -4.674339771, 55.52180099),
+    ('SFA', 34.7179985, 10.69099998),
+    ('SFB', 28.77759933, -81.23750305),
+    ('SFC', 16.25779915, -61.26250076),
+    ('SFD', 7.883319855, -67.44400024),
+    ('SFE', 16.59560013, 120.3030014),
+    ('SFG', 18.09989929, -63.04719925),
+    ('SFH', 30.93020058, -114.8089981),
+    ('SFI', 32.28329849, -9.233329773),
+    ('SFJ', 67.0122219, -50.71160316),
+    ('SFK', -0.699431002, -48.52099991),
+    ('SFL', 14.88500023, -24.47999954),
+    ('SFN', -31.7117, -60.8117),
+    ('SFO', 37.61899948, -122.375),
+    ('SFQ', 37.09429932, 38.8470993),
+    ('SFS', 14.79440022, 120.2710037),
+    ('SFT', 64.62480164, 21.07690048),
+    ('SFU', -9.593055556, 148.6361111),
+    ('SFX', 8.350000381, -62.63333511),
+    ('SFZ', 41.92079926, -71.49140167),
+    ('SGA', 37.56700134, 71.5),
+    ('SGC', 61.34370041, 73.40180206),
+    ('SGD', 54.96440125, 9.791729927),
+    ('SGE', 50.70769882, 8.082969666),
+    ('SGF', 37.24570084, -93.38860321),
+    ('SGG', 1.217000008, 111.4499969),
+    ('SGI', 32.04859924, 72.66500092),
+    ('SGK', -5.125833333, 144.3236111),
+    ('SGM', 27.29660034, -112.9380035),
+    ('SGN', 10.81879997, 106.6520004),
+    ('SGO', -28.04969978, 148.5950012),
+    ('SGP', -20.42469978, 120.1409988),
+    ('SGQ', 0.3847, 117.543),
+    ('SGS', 5.046989918, 119.7429962),
+    ('SGU', 37.03638889, -113.5103056),
+    ('SGV', -41.59170151, -65.33940125),
+    ('SGX', -10.68299961, 35.58300018),
+    ('SGY', 59.46009827, -135.3159943),
+    ('SGZ', 7.186560154, 100.6080017),
+    ('SHA', 31.19790077, 121.3359985),
+    ('SHB', 43.57749939, 144.9600067),
+    ('SHC', 14.0781002, 38.27249908),
+    ('SHD', 38.26380157, -78.89640045),
+    ('SHE', 41.63980103, 123.4830017),
+    ('SHH', 66.24960327, -166.0890045),
+    ('SHI', 24.82670021, 125.1449966),
+    ('SHJ', 25.32859993, 55.51720047),
+    ('SHK', -29.73089981, 28.76889992),
+    ('SHL', 25.70359993, 91.97869873),
+    ('SHM', 33.66220093, 135.3639984),
+    ('SHO', 38.14260101, 128.598999),
+    ('SHP', 39.9681015, 119.7310028),
+    ('SHQ', -27.91500092, 153.3730011),
+    ('SHR', 44.76919937, -106.9800034),
+    ('SHS', 30.32439995, 112.2809982),
+    ('SHT', -36.42890167, 145.3930054),
+    ('SHU', -11.14999962, 132.1499939),
+    ('SHV', 32.44660187, -93.82559967),
+    ('SHW', 17.46689987, 47.12139893),
+    ('SHX', 62.69229889, -159.5690002),
+    ('SHY', -3.6093, 33.5035),
+    ('SHZ', -29.26759911, 28.5522995),
+    ('SIA', 34.37670135, 109.1200027),
+    ('SIB', -3.683000088, 13.35000038),
+    ('SIC', 42.01580048, 35.06639862),
+    ('SID', 16.74139977, -22.94939995),
+    ('SIE', 37.94169235, -8.817300797),
+    ('SIF', 27.15950012, 84.98010254),
+    ('SIG', 18.45680046, -66.09809875),
+    ('SIH', 29.26309967, 80.93599701),
+    ('SII', 29.36669922, -10.18780041),
+    ('SIJ', 66.13330078, -18.91670036),
+    ('SIL', -9.073555556, 148.38925),
+    ('SIM', -5.278611111, 144.5447222),
+    ('SIN', 1.350190043, 103.9940033),
+    ('SIO', -40.83499908, 145.0839996),
+    ('SIP', 45.05220032, 33.97510147),
+    ('SIQ', -0.479189008, 104.5790024),
+    ('SIR', 46.21960068, 7.326759815),
+    ('SIS', -27.64859962, 22.9993),
+    ('SIT', 57.04710007, -135.3619995),
+    ('SIU', 13.72722244, -84.77777863),
+    ('SIX', -32.600832, 151.193056),
+    ('SIZ', -2.999444444, 142.0445),
+    ('SJA', -15.35249996, -75.1371994),
+    ('SJB', -13.05280018, -64.66169739),
+    ('SJC', 37.36259842, -121.9290009),
+    ('SJD', 23.15180016, -109.7210007),
+    ('SJE', 2.57969, -72.6394),
+    ('SJH', 10.766667, -73.016667),
+    ('SJI', 12.36149979, 121.0469971),
+    ('SJJ', 43.82460022, 18.3314991),
+    ('SJK', -23.22920036, -45.86149979),
+    ('SJL', -0.14835, -66.9855),
+    ('SJN', 34.51860046, -109.3789978),
+    ('SJO', 9.993860245, -84.20880127),
+    ('SJP', -20.8166008, -49.40650177),
+    ('SJQ', -17.47629929, 24.30470085),
+    ('SJR', 8.766667, -76.533333),
+    ('SJS', -17.8307991, -60.74309921),
+    ('SJT', 31.35770035, -100.4960022),
+    ('SJU', 18.43939972, -66.00180054),
+    ('SJV', -16.27079964, -62.47029877),
+    ('SJW', 38.28070068, 114.6969986),
+    ('SJX', 18.35610008, -88.13079834),
+    ('SJY', 62.69210052, 22.83230019),
+    ('SJZ', 38.66550064, -28.17580032),
+    ('SKA', 47.61510086, -117.6559982),
+    ('SKB', 17.31119919, -62.71870041),
+    ('SKC', -8.0466, 141.7222),
+    ('SKD', 39.70050049, 66.9838028),
+    ('SKE', 59.18500137, 9.566940308),
+    ('SKF', 29.38419914, -98.58110046),
+    ('SKG', 40.5196991, 22.97089958),
+    ('SKH', 28.58600044, 81.63600159),
+    ('SKK', 64.37110138, -161.223999),
+    ('SKL', 57.2533989, -5.827899933),
+    ('SKM', 5.8599, -57.14894),
+    ('SKN', 68.5788269, 15.03341675),
+    ('SKO', 12.91629982, 5.207190037),
+    ('SKP', 41.96160126, 21.62140083),
+    ('SKQ', -30.03890038, 28.37030029),
+    ('SKS', 55.2256012, 9.263930321),
+    ('SKT', 32.53555679, 74.3638916),
+    ('SKU', 38.96760178, 24.48719978),
+    ('SKV', 28.68530083, 34.0625),
+    ('SKW', 61.96530151, -151.1909943),
+    ('SKX', 54.1251297, 45.21225739),
+    ('SKY', 41.4333992, -82.65229797),
+    ('SKZ', 27.72200012, 68.79170227),
+    ('SLA', -24.8560009, -65.48619843),
+    ('SLB', 42.59730148, -95.24069977),
+    ('SLC', 40.78839874, -111.9779968),
+    ('SLD', 48.63779831, 19.13409996),
+    ('SLE', 44.90950012, -123.0029984),
+    ('SLF', 20.4647007, 45.61959839),
+    ('SLG', 36.19189835, -94.48999786),
+    ('SLH', -13.85169983, 167.5370026),
+    ('SLI', -12.17370033, 26.36510086),
+    ('SLJ', -22.2554, 117.7627),
+    ('SLK', 44.38529968, -74.20619965),
+    ('SLL', 17.0387001, 54.09130096),
+    ('SLM', 40.95209885, -5.501989841),
+    ('SLN', 38.79100037, -97.65219879),
+    ('SLP', 22.25429916, -100.9309998),
+    ('SLS', 44.05519867, 27.17880058),
+    ('SLU', 14.0202, -60.992901),
+    ('SLV', 31.08180046, 77.06800079),
+    ('SLW', 25.54949951, -100.9290009),
+    ('SLX', 21.33300018, -71.19999695),
+    ('SLY', 66.59079742, 66.61100006),
+    ('SLZ', -2.58536005, -44.23410034),
+    ('SMA', 36.97140121, -25.17060089),
+    ('SMB', -52.73669815, -69.33360291),
+    ('SMF', 38.69540024, -121.5910034),
+    ('SMG', -11.98333359, -77.0),
+    ('SMH', -6.075277778, 146.8111111),
+    ('SMI', 37.68999863, 26.9116993),
+    ('SMJ', -7.75, 146.9273),
+    ('SML', 23.5823168, -75.26862144),
+    ('SMM', 4.449999809, 118.5830002),
+    ('SMQ', -2.499190092, 112.9749985),
+    ('SMR', 11.1196, -74.2306),
+    ('SMS', -17.09390068, 49.81579971),
+    ('SMU', 61.81200027, -147.5070038),
+    ('SMV', 46.53409958, 9.884110451),
+    ('SMW', 26.73180008, -11.68470001),
+    ('SMX', 34.89889908, -120.4570007),
+    ('SMY', 13.04679966, -13.29539967),
+    ('SMZ', 4.349999905, -54.41666794),
+    ('SNA', 33.67570114, -117.8679962),
+    ('SNB', -11.42280006, 130.654007),
+    ('SNC', -2.20498991, -80.98889923),
+    ('SNE', 16.58839989, -24.28470039),
+    ('SNF', 10.27869987, -68.75520325),
+    ('SNG', -16.38360023, -60.96279907),
+    ('SNH', -28.62030029, 151.9909973),
+    ('SNI', 5.034309864, -9.066800117),
+    ('SNJ', 22.09530067, -84.15200043),
+    ('SNM', -14.0, -65.6339035),
+    ('SNN', 52.70199966, -8.924819946),
+    ('SNO', 17.19510078, 104.1190033),
+    ('SNP', 57.16730118, -170.2200012),
+    ('SNQ', 30.5288, -115.9462),
+    ('SNR', 47.31219864, -2.149179935),
+    ('SNT', 7.38322, -73.505402),
+    ('SNU', 22.49220085, -79.94360352),
+    ('SNV', 4.554999828, -61.15000153),
+    ('SNW', 18.46069908, 94.30010223),
+    ('SNX', 19.06189919, -69.3852005),
+    ('SNZ', -22.93239975, -43.71910095),
+    ('SOB', 46.68640137, 17.15909958),
+    ('SOC', -7.516089916, 110.7570038),
+    ('SOD', -23.47800064, -47.49000168),
+    ('SOE', 2.066999912, 14.13300037),
+    ('SOF', 42.69669342, 23.41143608),
+    ('SOG', 61.15610123, 7.13778019),
+    ('SOJ', 69.78679657, 20.95940018),
+    ('SOK', -29.83860016, 28.05999947),
+    ('SOM', 8.945146561, -64.1510849),
+    ('SON', -15.50500011, 167.2200012),
+    ('SOO', 61.26150131, 17.09910011),
+    ('SOQ', -0.926357985, 131.1210022),
+    ('SOT', 67.39499664, 26.61910057),
+    ('SOU', 50.95029831, -1.35679996),
+    ('SOW', 34.26549912, -110.0059967),
+    ('SOX', 5.67732, -72.9703),
+    ('SOY', 59.15530014, -2.641390085),
+    ('SOZ', 41.92440033, 9.406000137),
+    ('SPC', 28.62649918, -17.75559998),
+    ('SPD', 25.75919914, 88.9088974),
+    ('SPE', 4.732999802, 116.4670029),
+    ('SPH', -8.3038, 147.1659),
+    ('SPI', 39.84410095, -89.67790222),
+    ('SPJ', 36.97389984, 22.52630043),
+    ('SPM', 49.97269821, 6.692500114),
+    ('SPN', 15.11900043, 145.7290039),
+    ('SPP', -14.6576004, 17.71980095),
+    ('SPR', 17.91390038, -87.97109985),
+    ('SPS', 33.98880005, -98.49189758),
+    ('SPT', 5.083333015, 115.5500031),
+    ('SPU', 43.53889847, 16.29800034),
+    ('SPY', 4.746719837, -6.660820007),
+    ('SPZ', 36.17639923, -94.11930084),
+    ('SQA', 34.60680008, -120.0759964),
+    ('SQB', 4.408333, -75.021667),
+    ('SQC', -31.23999977, 119.3600006),
+    ('SQD', -6.616680145, -77.76670074),
+    ('SQG', 0.063619003, 111.4729996),
+    ('SQH', 21.21699905, 104.0329971),
+    ('SQL', 61.70050049, -157.1660004),
+    ('SQM', -13.33129978, -50.19760132),
+    ('SQN', -2.080509901, 125.9670029),
+    ('SQO', 64.96089935, 17.69659996),
+    ('SQQ', 55.89390182, 23.39500046),
+    ('SQR', -2.531199932, 121.3580017),
+    ('SQS', 17.1859, -89.0098),
+    ('SQT', -10.56277778, 150.6906944),
+    ('SQU', -6.960030079, -76.7684021),
+    ('SQW', 56.55020142, 9.172980309),
+    ('SQX', -26.78160095, -53.50350189),
+    ('SQY', -31.38330078, -52.03279877),
+    ('SQZ', 53.30780029, -0.550832987),
+    ('SRA', -27.90670013, -54.520401),
+    ('SRB', -14.06620026, -66.78679657),
+    ('SRD', -13.2638998, -64.60389709),
+    ('SRE', -19.00709915, -65.28869629),
+    ('SRG', -6.97273016, 110.375),
+    ('SRH', 9.144439697, 18.37439919),
+    ('SRI', -0.484530985, 117.1569977),
+    ('SRJ', -14.85919952, -66.73750305),
+    ('SRN', -42.15499878, 145.2920074),
+    ('SRP', 59.79190063, 5.340849876),
+    ('SRQ', 27.39539909, -82.55439758),
+    ('SRT', 1.727689981, 33.62279892),
+    ('SRV', 61.78969955, -156.5890045),
+    ('SRX', 31.06349945, 16.59499931),
+    ('SRY', 36.63579941, 53.1935997),
+    ('SRZ', -17.81159973, -63.17150116),
+    ('SSA', -12.9086113, -38.32249832),
+    ('SSD', -32.74580002, -70.70500183),
+    ('SSE', 17.62800026, 75.93479919),
+    ('SSG', 3.755270004, 8.708720207),
+    ('SSH', 27.97730064, 34.39500046),
+    ('SSJ', 65.95680237, 12.46889973),
+    ('SSK', -19.16640091, 128.173996),
+    ('SSN', 37.44580078, 127.1139984),
+    ('SSO', -22.09090042, -45.04449844),
+    ('SSR', -15.4708004, 168.1519928),
+    ('SST', -36.5423, -56.7218),
+    ('SSX', 41.27864456, 36.3051796),
+    ('SSY', -6.269899845, 14.24699974),
+    ('SSZ', -23.92805672, -46.29972076),
+    ('STA', 55.99010086, 8.353910446),
+    ('STB', 8.974550247, -71.94325256),
+    ('STC', 45.54660034, -94.05989838),
+    ('STD', 7.565380096, -72.03510284),
+    ('STG', 56.57830048, -169.6620026),
+    ('STH', -17.85000038, 142.5670013),
+    ('STI', 19.46990013, -70.70059967),
+    ('STL', 38.74869919, -90.37000275),
+    ('STM', -2.424721956, -54.78583145),
+    ('STN', 51.88499832, 0.234999999),
+    ('STP', 44.93450165, -93.05999756),
+    ('STR', 48.68989944, 9.221960068),
+    ('STS', 38.50899887, -122.8130035),
+    ('STT', 18.33729935, -64.9733963),
+    ('STU', 18.27210045, -88.45629883),
+    ('STV', 21.1140995, 72.7417984),
+    ('STW', 45.10919952, 42.1128006),
+    ('STX', 17.70190048, -64.79859924),
+    ('STY', -31.43849945, -57.98529816),
+    ('STZ', -10.46472168, -50.51861191),
+    ('SUB', -7.379829884, 112.7870026),
+    ('SUF', 38.90539932, 16.24230003),
+    ('SUG', 9.755838326, 125.4809475),
+    ('SUH', 22.53300095, 59.48300171),
+    ('SUI', 42.85820007, 41.12810135),
+    ('SUJ', 47.70330048, 22.88570023),
+    ('SUL', 28.64509964, 69.17690277),
+    ('SUN', 43.50439835, -114.2959976),
+    ('SUO', 43.87630081, -121.4530029),
+    ('SUP', -7.0242, 113.89023),
+    ('SUQ', -2.48300004, -78.16699982),
+    ('SUR', 52.70859909, -88.54190063),
+    ('SUT', -7.967000008, 31.66699982),
+    ('SUV', -18.04330063, 178.5590057),
+    ('SUX', 42.40259933, -96.38439941),
+    ('SVA', 63.68640137, -170.4929962),
+    ('SVB', -14.27859974, 50.17470169),
+    ('SVC', 32.63650131, -108.1559982),
+    ('SVD', 13.14430046, -61.21089935),
+    ('SVF', 8.018170357, 2.464580059),
+    ('SVG', 58.87670135, 5.63778019),
+    ('SVI', 2.15217, -74.7663),
+    ('SVJ', 68.24330139, 14.66919994),
+    ('SVK', 16.72529984, -88.33999634),
+    ('SVL', 61.94309998, 28.94510078),
+    ('SVO', 55.97259903, 37.41460037),
+    ('SVP', -12.40460014, 16.94739914),
+    ('SVQ', 37.41799927, -5.893109798),
+    ('SVU', -16.80279922, 179.3410034),
+    ('SVV', 7.0, -62.0),
+    ('SVW', 61.09740067, -155.5740051),
+    ('SVX', 56.74309921, 60.80270004),
+    ('SVZ', 7.840829849, -72.43969727),
+    ('SWA', 23.42690086, 116.762001),
+    ('SWB', -21.51029968, 119.3619995),
+    ('SWC', -37.07170105, 142.7409973),
+    ('SWD', 60.12689972, -149.4190063),
+    ('SWE', -6.284639, 147.580833),
+    ('SWF', 41.5041008, -74.10479736),
+    ('SWG', -6.139555556, 147.2791667),
+    ('SWH', -35.37580109, 143.5330048),
+    ('SWJ', -16.4864, 167.4472),
+    ('SWN', 31.88944435, 72.39167023),
+    ('SWP', -22.66189957, 14.56809998),
+    ('SWQ', -8.489040375, 117.4120026),
+    ('SWR', -4.529888889, 153.0544444),
+    ('SWS', 51.6053009, -4.067830086),
+    ('SWT', 60.70940018, 77.66000366),
+    ('SWU', 37.23939896, 127.0070038),
+    ('SWX', -18.37389946, 21.83259964),
+    ('SWY', 4.216388226, 100.6986084),
+    ('SXA', -6.0908, 147.5955),
+    ('SXB', 48.53829956, 7.628230095),
+    ('SXE', -38.090827, 146.965335),
+    ('SXF', 52.38000107, 13.52250004),
+    ('SXG', -16.10000038, 23.2670002),
+    ('SXH', -9.964527778, 151.1618611),
+    ('SXI', 25.90889931, 54.53939819),
+    ('SXJ', 42.9117012, 90.24749756),
+    ('SXK', -7.988609791, 131.3059998),
+    ('SXL', 54.28020096, -8.599209785),
+    ('SXM', 18.04100037, -63.10889816),
+    ('SXN', -20.55340004, 26.11580086),
+    ('SXO', -11.63239956, -50.6896019),
+    ('SXQ', 60.47570038, -151.0339966),
+    ('SXR', 33.98709869, 74.77420044),
+    ('SXS', 5.087779999, 119.0940018),
+    ('SXT', 4.33027792, 102.3949966),
+    ('SXU', 6.816999912, 37.75),
+    ('SXV', 11.7833004, 78.06559753),
+    ('SXX', -6.6413, -51.9523),
+    ('SXZ', 37.97890091, 41.8404007),
+    ('SYA', 52.71229935, 174.1139984),
+    ('SYB', 58.3733, -152.2018),
+    ('SYC', -11.89999962, -69.16699982),
+    ('SYD', -33.94609833, 151.177002),
+    ('SYE', 16.96699905, 43.73300171),
+    ('SYJ', 29.55089951, 55.67269897),
+    ('SYK', 65.05809784, -22.7942009),
+    ('SYM', 22.79330063, 100.9589996),
+    ('SYN', 44.4754982, -93.01629639),
+    ('SYO', 38.81219864, 139.7870026),
+    ('SYP', 8.085599899, -80.94529724),
+    ('SYQ', 9.957050323, -84.13980103),
+    ('SYR', 43.11119843, -76.10630035),
+    ('SYT', 46.41249847, 4.013259888),
+    ('SYU', -10.20829964, 142.8249969),
+    ('SYW', 26.47310066, 67.71720123),
+    ('SYX', 18.30290031, 109.4120026),
+    ('SYY', 58.21559906, -6.331110001),
+    ('SYZ', 29.53919983, 52.58980179),
+    ('SZA', -6.141089916, 12.37180042),
+    ('SZB', 3.130579948, 101.5490036),
+    ('SZF', 41.25450134, 36.56710052),
+    ('SZG', 47.79330063, 13.00430012),
+    ('SZJ', 21.64249992, -82.95510101),
+    ('SZK', -24.96089935, 31.58869934),
+    ('SZN', 34.06060028, -119.9150009),
+    ('SZP', 34.34719849, -119.060997),
+    ('SZR', 42.37670136, 25.65519905),
+    ('SZS', -46.89970016, 168.1009979),
+    ('SZT', 16.69029999, -92.53009796),
+    ('SZV', 31.26309967, 120.401001),
+    ('SZW', 53.42699814, 11.78339958),
+    ('SZX', 22.63929939, 113.810997),
+    ('SZZ', 53.58470154, 14.90219975),
+    ('TAB', 11.14970016, -60.8321991),
+    ('TAC', 11.2276001, 125.0279999),
+    ('TAD', 37.25939941, -104.3410034),
+    ('TAE', 35.89410019, 128.6589966),
+    ('TAF', 35.54240036, -0.532278001),
+    ('TAG', 9.664079666, 123.8529968),
+    ('TAH', -19.45509911, 169.223999),
+    ('TAI', 13.68599987, 44.13909912),
+    ('TAJ', -3.198191804, 142.4309635),
+    ('TAK', 34.21419907, 134.0160065),
+    ('TAL', 65.17440033, -152.1089935),
+    ('TAM', 22.29640007, -97.86589813),
+    ('TAN', -27.12999916, 153.3630066),
+    ('TAO', 36.26610184, 120.3740005),
+    ('TAP', 14.79430008, -92.37000275),
+    ('TAQ', -30.70330048, 134.5839996),
+    ('TAR', 40.51750183, 17.40320015),
+    ('TAS', 41.25790024, 69.28119659),
+    ('TAT', 49.07360077, 20.24110031),
+    ('TAU', 5.01281, -72.7424),
+    ('TAV', -14.22920036, -169.5110016),
+    ('TAW', -31.74900055, -55.92580032),
+    ('TAX', -1.642629981, 124.5589981),
+    ('TAY', 58.30749893, 26.69039917),
+    ('TAZ', 41.76110077, 59.8266983),
+    ('TBB', 13.04959965, 109.3339996),
+    ('TBC', 36.09280014, -111.3830032),
+    ('TBE', -4.196633, 143.519222),
+    ('TBF', -1.224470019, 174.776001),
+    ('TBG', -5.278610229, 141.2259979),
+    ('TBH', 12.31099987, 122.0849991),
+    ('TBJ', 36.97999954, 8.876939774),
+    ('TBK', -15.61999989, 130.4450073),
+    ('TBL', -17.2833004, 126.9000015),
+    ('TBM', -1.4694, 113.0833),
+    ('TBN', 37.74160004, -92.14070129),
+    ('TBO', -5.07638979, 32.83330154),
+    ('TBP', -3.55253005, -80.38140106),
+    ('TBQ', -6.466666667, 145.5322222),
+    ('TBR', 32.48270035, -81.73690033),
+    ('TBS', 41.6692009, 44.95470047),
+    ('TBT', -4.255670071, -69.93579865),
+    ('TBU', -21.24119949, -175.1499939),
+    ('TBV', 8.3027, 171.1615),
+    ('TBW', 52.80609894, 41.48279953),
+    ('TBY', -26.0333004, 22.39999962),
+    ('TBZ', 38.13389969, 46.23500061),
+    ('TCA', -19.63439941, 134.1829987),
+    ('TCB', 26.74530029, -77.39129639),
+    ('TCC', 35.18280029, -103.6029968),
+    ('TCD', -2.867, -69.733002),
+    ('TCE', 45.0625, 28.71430016),
+    ('TCG', 46.67250061, 83.34079742),
+    ('TCH', -2.849999905, 11.0170002),
+    ('TCJ', -4.0165, 143.1329),
+    ('TCK', -4.0949, 143.3838),
+    ('TCL', 33.22060013, -87.61139679),
+    ('TCM', 47.13769913, -122.4759979),
+    ('TCN', 18.49720001, -97.41989899),
+    ('TCO', 1.81442, -78.7492),
+    ('TCP', 29.58779907, 34.77809906),
+    ('TCQ', -18.05330086, -70.27580261),
+    ('TCS', 33.23690033, -107.2720032),
+    ('TCT', 62.99269867, -156.0679932),
+    ('TCU', -29.31780052, 26.82279968),
+    ('TCW', -35.81169891, 145.6080017),
+    ('TCX', 33.6678009, 56.8927002),
+    ('TCY', -19.9713, 13.0249),
+    ('TCZ', 24.93805556, 98.48583333),
+    ('TDA', 5.43278, -71.6625),
+    ('TDB', -9.1586, 148.0686),
+    ('TDD', -14.81869984, -64.91799927),
+    ('TDG', 9.072110176, 126.1709976),
+    ('TDJ', 11.78299999, 42.91699982),
+    ('TDK', 45.12620163, 78.4469986),
+    ('TDL', -37.23740005, -59.22790146),
+    ('TDN', -14.78810024, 126.4960022),
+    ('TDO', 46.47719955, -122.8059998),
+    ('TDP', -3.806010008, -75.03929901),
+    ('TDR', -24.99329948, 150.0930023),
+    ('TDS', -7.6217, 142.868),
+    ('TDT', -24.53359985, 31.29999924),
+    ('TDV', -21.70000076, 43.73300171),
+    ('TDW', 35.16989899, -101.8259964),
+    ('TDX', 12.27460003, 102.3190002),
+    ('TDZ', 41.56489944, -83.4822998),
+    ('TEA', 15.77589989, -87.47579956),
+    ('TEB', 40.85010147, -74.06079865),
+    ('TEC', -24.31780052, -50.65159988),
+    ('TED', 57.06880188, 8.705220222),
+    ('TEE', 35.43159866, 8.12071991),
+    ('TEF', -21.71500015, 122.2289963),
+    ('TEG', 11.80000019, -0.367000014),
+    ('TEI', 27.94120026, 96.13439941),
+    ('TEK', 60.8689003, -146.6860046),
+    ('TEL', 5.628610134, 117.1259995),
+    ('TEM', -34.42139816, 147.5119934),
+    ('TEN', 27.883333, 109.308889),
+    ('TEO', -8.169722222, 146.1944444),
+    ('TEP', -5.9553, 146.5595),
+    ('TEQ', 41.13819885, 27.91909981),
+    ('TER', 38.76179886, -27.09079933),
+    ('TES', 15.11699963, 36.68299866),
+    ('TET', -16.10479927, 33.64020157),
+    ('TEU', -45.53310013, 167.6499939),
+    ('TEX', 37.9538002, -107.9079971),
+    ('TEY', 65.87030029, -23.55999947),
+    ('TEZ', 26.70910072, 92.78469849),
+    ('TFB', -5.1173, 141.419),
+    ('TFF', -3.382940054, -64.72409821),
+    ('TFI', -9.075954437, 149.3198395),
+    ('TFL', -17.89229965, -41.5135994),
+    ('TFM', -5.126080464, 141.641922),
+    ('TFN', 28.48270035, -16.34149933),
+    ('TFS', 28.04450035, -16.57250023),
+    ('TFT', 28.96419907, 61.59540176),
+    ('TFY', 27.9487, -12.9166),
+    ('TGA', 1.38725996, 103.7089996),
+    ('TGC', 2.177839994, 111.2020035),
+    ('TGD', 42.3594017, 19.25189972),
+    ('TGE', 32.4919014, -85.77559662),
+    ('TGG', 5.382639885, 103.1029968),
+    ('TGH', -16.89109993, 168.5509949),
+    ('TGI', -9.133000374, -75.94999695),
+    ('TGJ', -21.09609985, 167.8040009),
+    ('TGK', 47.20000076, 38.84999847),
+    ('TGL', -11.33111111, 153.2029167),
+    ('TGM', 46.46770096, 24.41250038),
+    ('TGN', -38.2071991, 146.4700012),
+    ('TGO', 43.55670166, 122.1999969),
+    ('TGQ', -14.6619997, -57.44350052),
+    ('TGR', 33.06779861, 6.088669777),
+    ('TGS', -24.52059937, 32.96530151),
+    ('TGT', -5.09236002, 39.07120132),
+    ('TGU', 14.06089973, -87.21720123),
+    ('TGV', 43.30659866, 26.70089912),
+    ('TGZ', 16.56360054, -93.02249908),
+    ('THA', 35.38010025, -86.24639893),
+    ('THB', -29.52280045, 28.61580086),
+    ('THC', 6.045650482, -8.138723373),
+    ('THE', -5.059939861, -42.82350159),
+    ('THG', -24.4939003, 150.576004),
+    ('THH', -38.18109894, 174.7079926),
+    ('THI', 18.45000076, -9.517000198),
+    ('THK', 17.39999962, 104.8000031),
+    ('THL', 20.48380089, 99.93540192),
+    ('THN', 58.31809998, 12.34500027),
+    ('THO', 66.21849823, -15.3355999),
+    ('THQ', 34.55939865, 105.8600006),
+    ('THR', 35.68920135, 51.31340027),
+    ('THS', 17.23800087, 99.81819916),
+    ('THT', 17.2329998, -10.81700039),
+    ('THU', 76.53119659, -68.70320129),
+    ('THX', 65.79720306, 87.93530273),
+    ('THY', -23.07690048, 30.38360023),
+    ('THZ', 14.8757, 5.265359879),
+    ('TIA', 41.41469955, 19.72060013),
+    ('TIB', 8.63152, -72.7304),
+    ('TIC', 7.133329868, 171.9170074),
+    ('TID', 35.34109879, 1.463150024),
+    ('TIE', 7.2024, 35.415),
+    ('TIF', 21.48340034, 40.54430008),
+    ('TIH', -15.1196003, -148.2310028),
+    ('TII', 32.60419846, 65.86579895),
+    ('TIJ', 32.54109955, -116.9700012),
+    ('TIM', -4.528279781, 136.8869934),
+    ('TIN', 27.7003994, -8.167099953),
+    ('TIO', 21.70000076, 94.09999847),
+    ('TIP', 32.66350174, 13.1590004),
+    ('TIQ', 14.99919987, 145.6190033),
+    ('TIR', 13.63249969, 79.54329681),
+    ('TIU', -44.30279922, 171.2250061),
+    ('TIV', 42.40470123, 18.72330093),
+    ('TIY', 18.57010078, -11.42350006),
+    ('TIZ', -5.84499979, 142.947998),
+    ('TJA', -21.5557003, -64.70130157),
+    ('TJB', 1.0527, 103.3931),
+    ('TJG', -2.216559887, 115.435997),
+    ('TJH', 35.51279831, 134.7870026),
+    ('TJI', 15.92679977, -85.9382019),
+    ('TJK', 40.30743027, 36.36740875),
+    ('TJM', 57.1896019, 65.32430267),
+    ('TJN', -15.85470009, -142.2680054),
+    ('TJQ', -2.74571991, 107.7549973),
+    ('TJS', 2.835833333, 117.3736111),
+    ('TJU', 37.98809814, 69.80500031),
+    ('TJV', 10.72239971, 79.10160065),
+    ('TKB', -7.6808, 146.5503),
+    ('TKC', 4.089190006, 9.3605299),
+    ('TKD', 4.89605999, -1.774760008),
+    ('TKF', 39.31999969, -120.1399994),
+    ('TKG', -5.240556, 105.175556),
+    ('TKH', 15.27729988, 100.2959976),
+    ('TKJ', 63.303333, -143.001111),
+    ('TKK', 7.461870193, 151.8430023),
+    ('TKM', 17.22638893, -89.60500336),
+    ('TKN', 27.83639908, 128.8809967),
+    ('TKO', -29.2329998, 28.88299942),
+    ('TKP', -14.70950031, -145.2460022),
+    ('TKQ', -4.8862, 29.6709),
+    ('TKR', 26.01639938, 88.4036026),
+    ('TKS', 34.13280106, 134.6069946),
+    ('TKT', 16.89599991, 99.25330353),
+    ('TKU', 60.51409912, 22.26280022),
+    ('TKV', -17.3553009, -138.4450073),
+    ('TKW', -5.243666667, 142.1651944),
+    ('TKX', -14.45580006, -145.0249939),
+    ('TKY', -17.04080009, 128.2059937),
+    ('TKZ', -38.23669815, 175.8919983),
+    ('TLA', 65.24040222, -166.3390045),
+    ('TLB', 33.98609924, 72.61139679),
+    ('TLC', 19.33709908, -99.56600189),
+    ('TLD', -22.18919945, 29.12689972),
+    ('TLE', -23.38339996, 43.72850037),
+    ('TLH', 30.39649963, -84.35030365),
+    ('TLI', -1.029770017, 120.8170013),
+    ('TLJ', 62.89440155, -155.977005),
+    ('TLL', 59.41329956, 24.83279991),
+    ('TLM', 35.01670074, -1.450000048),
+    ('TLN', 43.09730148, 6.146029949),
+    ('TLO', -4.980830193, 152.0099945),
+    ('TLP', -4.7748, 141.0133),
+    ('TLS', 43.6291008, 1.363819957),
+    ('TLT', 61.09680176, -160.9689941),
+    ('TLU', 9.50945, -75.5854),
+    ('TLV', 32.01139832, 34.88669968),
+    ('TLX', -35.37779999, -71.60169983),
+    ('TLZ', -18.21680069, -47.89970016),
+    ('TMA', 31.42900085, -83.4885025),
+    ('TMB', 25.64789963, -80.43280029),
+    ('TMC', -9.409720421, 119.2440033),
+    ('TMD', 16.2329998, -8.166999817),
+    ('TME', 6.45108, -71.7603),
+    ('TMG', 5.400000095, 118.6500015),
+    ('TMH', -6.099219799, 140.2980042),
+    ('TMI', 27.31500053, 87.19329834),
+    ('TMJ', 37.2867012, 67.30999756),
+    ('TML', 9.557189941, -0.863214016),
+    ('TMM', -18.10950089, 49.39250183),
+    ('TMN', -2.485830069, 175.9700012),
+    ('TMO', 7.24938, -61.52893),
+    ('TMP', 61.41410065, 23.60440063),
+    ('TMQ', 14.80000019, 0.050000001),
+    ('TMR', 22.81150055, 5.451079845),
+    ('TMS', 0.37817499, 6.712150097),
+    ('TMT', -1.489599943, -56.39680099),
+    ('TMU', 9.738519669, -85.01380157),
+    ('TMW', -31.08390045, 150.8470001),
+    ('TMX', 29.2371006, 0.276033014),
+    ('TMY', -3.9256, 138.4555),
+    ('TMZ', -37.15670013, 175.5500031),
+    ('TNA', 36.85720062, 117.2160034),
+    ('TNB', -1.910130024, 116.2020035),
+    ('TNC', 65.56310272, -167.9219971),
+    ('TND', 21.78829956, -79.99720001),
+    ('TNE', 30.60510063, 130.9909973),
+    ('TNF', 48.75189972, 2.106189966),
+    ('TNG', 35.72689819, -5.916890144),
+    ('TNH', 42.25388889, 125.7033333),
+    ('TNI', 24.56229973, 80.85489655),
+    ('TNJ', 0.922683001, 104.5319977),
+    ('TNL', 49.52420044, 25.70009995),
+    ('TNM', -62.19079971, -58.98669815),
+    ('TNN', 22.9503994, 120.2060013),
+    ('TNO', 10.35569954, -85.85289764),
+    ('TNR', -18.7968998, 47.47880173),
+    ('TNV', 3.89944005, -159.3890076),
+    ('TNW', -1.0626, -77.5736),
+    ('TNX', 13.53190041, 106.0149994),
+    ('TNZ', 48.73887, 98.2941),
+    ('TOB', 31.86100006, 23.90699959),
+    ('TOD', 2.818180084, 104.1600037),
+    ('TOE', 33.93970108, 8.110560417),
+    ('TOF', 56.38029861, 85.20829773),
+    ('TOG', 59.05279922, -160.3970032),
+    ('TOH', -13.32800007, 166.6380005),
+    ('TOJ', 40.49670029, -3.445869923),
+    ('TOL', 41.58679962, -83.80780029),
+    ('TOM', 16.73049927, -3.007580042),
+    ('TON', -6.6552, 155.4326),
+    ('TOO', 8.826109886, -82.95890045),
+    ('TOQ', -22.14109993, -70.06289673),
+    ('TOS', 69.6832962, 18.91889954),
+    ('TOT', 5.865829945, -56.32749939),
+    ('TOU', -20.79000092, 165.2590027),
+    ('TOW', -24.68630028, -53.69749832),
+    ('TOX', 58.13579941, 68.23190308),
+    ('TOY', 36.64830017, 137.1880035),
+    ('TPA', 27.97550011, -82.53320313),
+    ('TPC', -0.122956, -76.33779907),
+    ('TPE', 25.07769966, 121.2330017),
+    ('TPG', 4.867000103, 100.7170029),
+    ('TPI', -8.356666667, 146.9891667),
+    ('TPJ', 27.35000038, 84.66699982),
+    ('TPK', 3.1707, 97.2869),
+    ('TPL', 31.15250015, -97.40779877),
+    ('TPN', -0.776111007, -75.52639771),
+    ('TPP', -6.508739948, -76.37319946),
+    ('TPQ', 21.41950035, -104.8430023),
+    ('TPR', -22.74600029, 117.8690033),
+    ('TPS', 37.91139984, 12.48799992),
+    ('TPX', -16.2423, -151.8338),
+    ('TQL', 64.93080139, 77.81809998),
+    ('TQN', 36.77069855, 69.53199768),
+    ('TQS', 0.7459, -75.234),
+    ('TRA', 24.65390015, 124.6750031),
+    ('TRB', 8.07453, -76.7415),
+    ('TRC', 25.56830025, -103.4110031),
+    ('TRD', 63.45780182, 10.92399979),
+    ('TRE', 56.49919891, -6.869170189),
+    ('TRF', 59.18669891, 10.25860024),
+    ('TRG', -37.6719017, 176.1959991),
+    ('TRI', 36.47520065, -82.40740204),
+    ('TRJ', -5.614, 141.0421),
+    ('TRK', 3.326666667, 117.5694444),
+    ('TRN', 45.20080185, 7.64963007),
+    ('TRO', -31.8885994, 152.5140076),
+    ('TRQ', -8.155260086, -70.78330231),
+    ('TRR', 8.538510323, 81.18190002),
+    ('TRS', 45.82749939, 13.47220039),
+    ('TRU', -8.081410408, -79.1088028),
+    ('TRV', 8.48211956, 76.92009735),
+    ('TRW', 1.381639957, 173.1470032),
+    ('TRY', 0.683000028, 34.16699982),
+    ('TRZ', 10.76539993, 78.70970154),
+    ('TSA', 25.06940079, 121.552002),
+    ('TSB', -19.26189995, 17.73250008),
+    ('TSC', -2.381669998, -77.50279999),
+    ('TSD', -22.61930084, 30.17560005),
+    ('TSE', 51.02220154, 71.46690369),
+    ('TSF', 45.64839935, 12.19439983),
+    ('TSG', 63.37440109, -143.3359985),
+    ('TSH', -6.438330173, 20.79470062),
+    ('TSJ', 34.28490067, 129.3309937),
+    ('TSL', 22.03829956, -98.8065033),
+    ('TSM', 36.45819855, -105.6719971),
+    ('TSN', 39.12440109, 117.3460007),
+    ('TSR', 45.80989838, 21.33790016),
+    ('TST', 7.508739948, 99.61660004),
+    ('TSU', -1.474439979, 175.0639954),
+    ('TSV', -19.25250053, 146.7649994),
+    ('TSW', -7.0695, 146.1272),
+    ('TSX', -0.092973001, 117.4530029),
+    ('TSY', -7.346600056, 108.2460022),
+    ('TSZ', 47.46544, 101.47755),
+    ('TTA', 28.44820023, -11.16129971),
+    ('TTB', 39.91880035, 9.682979584),
+    ('TTC', -25.56430054, -70.37590027),
+    ('TTE', 0.831413984, 127.3809967),
+    ('TTG', -22.6196003, -63.79370117),
+    ('TTH', 17.66600037, 54.02460098),
+    ('TTI', -17.01329994, -149.5870056),
+    ('TTJ', 35.53010178, 134.1670074),
+    ('TTN', 40.27669907, -74.81349945),
+    ('TTQ', 10.56900024, -83.51480103),
+    ('TTR', -3.044739962, 119.8219986),
+    ('TTS', -16.7510644, 47.61901617),
+    ('TTT', 22.75499916, 121.1019974),
+    ('TTU', 35.59429932, -5.320020199),
+    ('TUA', 0.809505999, -77.70809937),
+    ('TUB', -23.36540031, -149.5240021),
+    ('TUC', -26.84090042, -65.10489655),
+    ('TUD', 13.73680019, -13.65310001),
+    ('TUF', 47.43220139, 0.727605999),
+    ('TUG', 17.64336768, 121.7331505),
+    ('TUI', 31.69269943, 38.73120117),
+    ('TUK', 25.9864006, 63.03020096),
+    ('TUL', 36.19839859, -95.88809967),
+    ('TUM', -35.26279831, 148.2409973),
+    ('TUN', 36.85100174, 10.22719955),
+    ('TUO', -38.73970032, 176.0839996),
+    ('TUP', 34.26810074, -88.76989746),
+    ('TUQ', 13.06700039, -3.066999912),
+    ('TUR', -3.786010027, -49.72029877),
+    ('TUS', 32.11610031, -110.9410019),
+    ('TUU', 28.36540031, 36.6189003),
+    ('TUV', 9.088994026, -62.09417343),
+    ('TUY', 20.22730064, -87.4382019),
+    ('TVA', -17.85008346, 44.92046714),
+    ('TVC', 44.74140167, -85.5821991),
+    ('TVF', 48.06570053, -96.18499756),
+    ('TVL', 38.89390182, -119.9950027),
+    ('TVS', 39.71780014, 118.0026245),
+    ('TVU', -16.69059944, -179.8769989),
+    ('TVY', 14.10389996, 98.20359802),
+    ('TWB', -27.5428009, 151.9160004),
+    ('TWD', 48.05379868, -122.810997),
+    ('TWE', 65.6792984, -164.798996),
+    ('TWF', 42.48180008, -114.487999),
+    ('TWP', -17.36330032, 143.75),
+    ('TWU', 4.320159912, 118.1279984),
+    ('TWY', -7.4463, 146.1067),
+    ('TWZ', -44.23500061, 170.1179962),
+    ('TXG', 24.18630028, 120.6539993),
+    ('TXK', 33.45370102, -93.99099731),
+    ('TXL', 52.55970001, 13.2876997),
+    ('TXM', -1.44472003, 132.0209961),
+    ('TXN', 29.73329926, 118.2559967),
+    ('TXU', 4.437809467, -7.362728119),
+    ('TYA', 54.239, 37.6),
+    ('TYB', -29.4510994, 142.0579987),
+    ('TYD', 55.28419876, 124.7789993),
+    ('TYF', 60.1576004, 12.99129963),
+    ('TYG', -26.08329964, 143.4669952),
+    ('TYL', -4.576640129, -81.25409698),
+    ('TYM', 24.16909981, -76.43910217),
+    ('TYN', 37.74689865, 112.6279984),
+    ('TYP', -22.25580025, 137.9530029),
+    ('TYR', 32.35409927, -95.40239716),
+    ('TYS', 35.81100082, -83.9940033),
+    ('TYT', -33.20000076, -54.34999847),
+    ('TYZ', 34.45280075, -110.1149979),
+    ('TZA', 17.51638985, -88.19444275),
+    ('TZL', 44.45869827, 18.72480011),
+    ('TZR', 46.39310074, 17.91749954),
+    ('TZX', 40.99509811, 39.78969955),
+    ('UAB', 37.0021019, 35.42589951),
+    ('UAC', 32.44530106, -114.7979965),
+    ('UAE', -6.234166667, 144.6647222),
+    ('UAH', -8.936109543, -139.552002),
+    ('UAI', -9.303310394, 125.2870026),
+    ('UAK', 61.16049957, -45.42599869),
+    ('UAL', -10.71580029, 22.23110008),
+    ('UAM', 13.58399963, 144.9299927),
+    ('UAP', -9.351670265, -140.0780029),
+    ('UAQ', -31.57150078, -68.41819763),
+    ('UAR', 32.51430556, -1.983055556),
+    ('UAS', 0.530583024, 37.53419495),
+    ('UAX', 17.39388847, -89.63274384),
+    ('UBA', -19.76472282, -47.96611023),
+    ('UBB', -9.949999809, 142.1829987),
+    ('UBI', -6.729166667, 155.6833333),
+    ('UBJ', 33.93000031, 131.279007),
+    ('UBP', 15.25129986, 104.8700027),
+    ('UBR', -3.67565, 140.8838),
+    ('UBS', 33.4654007, -88.38030243),
+    ('UBT', -23.44109917, -45.07559967),
+    ('UBU', -14.28829956, 126.6320038),
+    ('UCK', 50.67840411, 25.48716545),
+    ('UCN', 5.167650223, -9.283470154),
+    ('UCT', 63.56689835, 53.80469894),
+    ('UCZ', -8.467000008, -76.34999847),
+    ('UDA', -18.20000076, 144.6000061),
+    ('UDE', 51.65639877, 5.708610058),
+    ('UDI', -18.88361168, -48.22527695),
+    ('UDJ', 48.63430023, 22.26339912),
+    ('UDN', 46.03219986, 13.1868),
+    ('UDR', 24.61770058, 73.89610291),
+    ('UEE', -42.07500076, 145.5319977),
+    ('UEL', -17.85549927, 36.86909866),
+    ('UEN', 65.95999908, 78.43699646),
+    ('UEO', 26.3635006, 126.7139969),
+    ('UET', 30.25139999, 66.93779755),
+    ('UFA', 54.55749893, 55.87440109),
+    ('UGA', 48.85499954, 103.4759979),
+    ('UGB', 57.42539978, -157.7400055),
+    ('UGC', 41.58430099, 60.64170074),
+    ('UGO', -7.603069782, 15.02779961),
+    ('UGT', 43.74930418, 104.1149855),
+    ('UGU', -3.739559889, 137.0319977),
+    ('UHE', 49.02939987, 17.43969917),
+    ('UIB', 5.69076, -76.6412),
+    ('UIH', 13.95499992, 109.0419998),
+    ('UII', 16.11310005, -86.88030243),
+    ('UIK', 58.13610077, 102.5650024),
+    ('UIN', 39.94269943, -91.19460297),
+    ('UIO', -0.129166667, -78.3575),
+    ('UIP', 47.97499847, -4.167789936),
+    ('UIQ', -17.54000092, 168.4420013),
+    ('UIR', -31.49060059, 150.5140076),
+    ('UIT', 5.909239769, 169.6369934),
+    ('UJE', 8.928059578, 165.7619934),
+    ('UJN', 36.77666667, 129.4619444),
+    ('UKA', -4.293330193, 39.57109833),
+    ('UKB', 34.63280106, 135.223999),
+    ('UKH', 19.38638889, 56.40138889),
+    ('UKK', 50.03659821, 82.49420166),
+    ('UKS', 44.68899918, 33.57099915),
+    ('UKU', -3.676651, 142.484334),
+    ('UKX', 56.8567009, 105.7300034),
+    ('ULA', -49.3068, -67.8026),
+    ('ULB', -16.3297, 168.3011),
+    ('ULD', -28.32060051, 31.41650009),
+    ('ULE', -4.974720001, 151.2969971),
+    ('ULG', 48.96699905, 89.96700287),
+    ('ULH', 26.48, 38.12888889),
+    ('ULI', 10.01980019, 139.7899933),
+    ('ULK', 60.72060013, 114.8259964),
+    ('ULL', 56.51750183, -5.914169788),
+    ('ULN', 47.8431015, 106.7669983),
+    ('ULO', 49.973333, 92.079722),
+    ('ULP', -26.61219978, 144.253006),
+    ('ULQ', 4.08836, -76.2351),
+    ('ULU', 2.805560112, 32.27180099),
+    ('ULV', 54.2682991, 48.22669983),
+    ('ULX', -24.78540039, 31.35490036),
+    ('ULY', 54.40100098, 48.80270004),
+    ('ULZ', 47.7093, 96.5258),
+    ('UMA', 20.25060081, -74.15049744),
+    ('UMC', -7.0214, 145.966),
+    ('UME', 63.79180145, 20.28280067),
+    ('UMI', -13.23330021, -70.75330353),
+    ('UMM', 63.33150101, -149.1269989),
+    ('UMR', -31.14419937, 136.8170013),
+    ('UMT', 69.37110138, -152.1360016),
+    ('UMU', -23.79870033, -53.31380081),
+    ('UMY', 50.85829926, 34.76250076),
+    ('UNA', -15.35519981, -38.99900055),
+    ('UND', 36.6651001, 68.91079712),
+    ('UNE', -30.11170006, 28.6718998),
+    ('UNG', -6.125710011, 141.2819977),
+    ('UNI', 12.60013485, -61.41194534),
+    ('UNK', 63.88840103, -160.798996),
+    ('UNN', 9.777620316, 98.58550262),
+    ('UNR', 47.30486, 110.6092),
+    ('UNT', 60.74720001, -0.853850007),
+    ('UOL', 1.1027, 121.4141),
+    ('UPB', 23.03280067, -82.57939911),
+    ('UPG', -5.061629772, 119.5540009),
+    ('UPL', 10.89220047, -85.0161972),
+    ('UPN', 19.39669991, -102.0390015),
+    ('UPP', 20.26530075, -155.8600006),
+    ('UPR', -8.5411, 142.6503),
+    ('UPV', 51.28620148, -1.782019973),
+    ('URA', 51.1507988, 51.54309845),
+    ('URC', 43.90710068, 87.47419739),
+    ('URE', 58.22990036, 22.5095005),
+    ('URG', -29.78219986, -57.03820038),
+    ('URJ', 60.10329819, 64.8266983),
+    ('URM', 5.333333015, -62.76666641),
+    ('URN', 32.93180084, 69.15630341),
+    ('URO', 49.38420105, 1.174800038),
+    ('URR', 6.32883, -76.1425),
+    ('URS', 51.75059891, 36.29560089),
+    ('URT', 9.132599831, 99.13559723),
+    ('URU', -9.5162, 148.5591),
+    ('URY', 31.41189957, 37.27949905),
+    ('URZ', 32.90299988, 66.63089752),
+    ('USH', -54.8433, -68.2958),
+    ('USI', 8.199999809, -59.78329849),
+    ('USK', 66.00469971, 57.36719894),
+    ('USL', -26.16670036, 113.4000015),
+    ('USM', 9.547789574, 100.0619965),
+    ('USN', 35.59349823, 129.352005),
+    ('USQ', 38.68149948, 29.47170067),
+    ('USS', 21.97039986, -79.44270325),
+    ('USU', 12.12150002, 120.0999985),
+    ('UTA', -18.99749947, 32.62720108),
+    ('UTB', -22.58329964, 144.5330048),
+    ('UTE', -28.273369, 26.135835),
+    ('UTG', -30.40749931, 27.69330025),
+    ('UTH', 17.38640022, 102.788002),
+    ('UTI', 60.89640045, 26.93840027),
+    ('UTK', 11.22200012, 169.852005),
+    ('UTN', -28.39909935, 21.2602005),
+    ('UTO', 65.99279785, -153.7039948),
+    ('UTP', 12.67990017, 101.0049973),
+    ('UTR', 17.61700058, 100.0999985),
+    ('UTS', 65.43729401, 52.20033646),
+    ('UTT', -31.54636318, 28.6733551),
+    ('UTW', -31.92020035, 26.88220024),
+    ('UUA', 54.63999939, 52.80170059),
+    ('UUD', 51.80780029, 107.4380035),
+    ('UUK', 70.33080292, -149.5980072),
+    ('UUN', 46.66030121, 113.2850037),
+    ('UUS', 46.88869858, 142.7180023),
+    ('UVA', 29.2112999, -99.74359894),
+    ('UVE', -20.6406002, 166.572998),
+    ('UVF', 13.7332, -60.952599),
+    ('UVL', 25.47360039, 30.59070015),
+    ('UVO', -6.0178, 150.9557),
+    ('UYL', 12.05350018, 24.95619965),
+    ('UYN', 38.26919937, 109.7310028),
+    ('UYU', -20.44630051, -66.8483963),
+    ('UZC', 43.898899, 19.697701),
+    ('UZM', 68.156, -106.618),
+    ('UZR', 47.09115, 81.66521),
+    ('UZU', -29.7706, -57.9789),
+    ('VAA', 63.05070114, 21.7621994),
+    ('VAC', 52.90833282, 8.040555954),
+    ('VAD', 30.96780014, -83.19300079),
+    ('VAF', 44.92160034, 4.969900131),
+    ('VAG', -21.59009933, -45.47330093),
+    ('VAH', -18.48250008, -64.09940338),
+    ('VAI', -2.697170019, 141.302002),
+    ('VAK', 61.5409, -165.6005),
+    ('VAL', -13.29650021, -38.99240112),
+    ('VAM', 3.470555556, 72.83583333),
+    ('VAN', 38.46820068, 43.33229828),
+    ('VAO', -7.585559845, 158.7310028),
+    ('VAP', -33.06809998, -71.55750275),
+    ('VAR', 43.23210144, 27.82509995),
+    ('VAS', 39.81380081, 36.9034996),
+    ('VAT', -19.383333, 48.95),
+    ('VAU', -17.5, 177.8419952),
+    ('VAV', -18.58530045, -173.9620056),
+    ('VAW', 70.35540009, 31.04490089),
+    ('VBG', 34.73730087, -120.5839996),
+    ('VBS', 45.42890167, 10.33059978),
+    ('VBV', -17.2689991, -178.9759979),
+    ('VBY', 57.66279984, 18.34620094),
+    ('VCA', 10.08510017, 105.711998),
+    ('VCD', -16.4021244, 131.0049744),
+    ('VCE', 45.50529861, 12.3519001),
+    ('VCF', -40.70000076, -66.15000153),
+    ('VCH', -31.7670002, -54.61700058),
+    ('VCL', 15.40330029, 108.7060013),
+    ('VCP', -23.00740051, -47.1344986),
+    ('VCR', 10.17560291, -70.06521606),
+    ('VCS', 8.731829643, 106.6330032),
+    ('VCT', 28.8526001, -96.91850281),
+    ('VCV', 34.59749985, -117.3830032),
+    ('VDA', 29.94029999, 34.93579865),
+    ('VDB', 61.0155983, 9.288060188),
+    ('VDC', -14.86279964, -40.86309814),
+    ('VDE', 27.81480026, -17.88710022),
+    ('VDH', 17.515, 106.590556),
+    ('VDM', -40.8692, -63.0004),
+    ('VDP', 9.222027779, -65.99358368),
+    ('VDR', -31.94519997, -65.14630127),
+    ('VDS', 70.06529999, 29.84469986),
+    ('VDY', 15.17496667, 76.63494722),
+    ('VDZ', 61.13389969, -146.2480011),
+    ('VEE', 67.00869751, -146.3659973),
+    ('VEL', 40.4408989, -109.5100021),
+    ('VER', 19.14590073, -96.18730164),
+    ('VEV', -7.912779808, 156.7059937),
+    ('VEY', 63.42430115, -20.27890015),
+    ('VFA', -18.09589958, 25.8390007),
+    ('VGA', 16.53039932, 80.79679871),
+    ('VGD', 59.28250122, 39.94440079),
+    ('VGO', 42.23180008, -8.62677002),
+    ('VGS', -35.0, -63.0),
+    ('VHC', -9.689069748, 20.43190002),
+    ('VHM', 64.57910156, 16.83359909),
+    ('VHY', 46.16970062, 3.403739929),
+    ('VHZ', -18.78000069, -138.8529968),
+    ('VIA', -26.99970055, -51.14189911),
+    ('VIC', 45.57339859, 11.52950001),
+    ('VID', 44.0223999, 22.81609917),
+    ('VIE', 48.11029816, 16.56970024),
+    ('VIG', 8.624138832, -71.67266846),
+    ('VII', 18.73760033, 105.6709976),
+    ('VIJ', 18.44639969, -64.42749786),
+    ('VIL', 23.71829987, -15.93200016),
+    ('VIN', 49.242531, 28.613778),
+    ('VIQ', -8.883799553, 126.3730011),
+    ('VIR', -29.77059937, 31.0583992),
+    ('VIS', 36.31869888, -119.3929977),
+    ('VIT', 42.88280106, -2.7244699),
+    ('VIU', -8.5085, 157.69),
+    ('VIV', -9.303333333, 150.3188889),
+    ('VIX', -20.25805664, -40.2863884),
+    ('VIY', 48.7743988, 2.201539993),
+    ('VJB', -25.03779984, 33.62739944),
+    ('VKG', 9.958029972, 105.1323795),
+    ('VKO', 55.59149933, 37.26150131),
+    ('VKT', 67.48860168, 63.99309921),
+    ('VKX', 38.74760056, -76.9571991),
+    ('VLC', 39.48929977, -0.481624991),
+    ('VLD', 30.78249931, -83.27670288),
+    ('VLG', -37.2354, -57.0292),
+    ('VLI', -17.69930077, 168.3200073),
+    ('VLK', 47.68209839, 42.07279968),
+    ('VLL', 41.70610046, -4.851940155),
+    ('VLM', -21.25519943, -63.4056015),
+    ('VLN', 10.14973259, -67.92839813),
+    ('VLP', -9.97944355, -51.14222336),
+    ('VLR', -28.59639931, -70.7559967),
+    ('VLS', -16.79610062, 168.177002),
+    ('VLU', 56.3810997, 30.60810089),
+    ('VLV', 9.340477943, -70.58406067),
+    ('VLY', 53.24810028, -4.535339832),
+    ('VME', -33.63499832, -65.41870117),
+    ('VMI', -22.15910896, -57.94258118),
+    ('VMU', -7.496860027, 144.8199005),
+    ('VNA', 15.70943921, 106.4106989),
+    ('VND', -23.35076659, 47.58170128),
+    ('VNE', 47.72330093, -2.71855998),
+    ('VNO', 54.63410187, 25.28580093),
+    ('VNR', -16.9633007, 141.9499969),
+    ('VNS', 25.45240021, 82.85929871),
+    ('VNT', 57.35779953, 21.5442009),
+    ('VNX', -22.01840019, 35.31330109),
+    ('VOD', 50.21659851, 14.39579964),
+    ('VOG', 48.78250122, 44.34550095),
+    ('VOH', -13.37580013, 50.00279999),
+    ('VOI', 8.399999619, -9.767000198),
+    ('VOL', 39.21960068, 22.79430008),
+    ('VOT', -20.46319962, -50.00450134),
+    ('VOZ', 51.81420135, 39.229599),
+    ('VPE', -17.0435009, 15.68379974),
+    ('VPG', -3.806666667, 39.79738889),
+    ('VPN', 65.72059631, -14.85060024),
+    ('VPS', 30.48320007, -86.52539825),
+    ('VPY', -19.15130043, 33.42900085),
+    ('VQQ', 30.21870041, -81.87670136),
+    ('VQS', 18.13479996, -65.49359894),
+    ('VRA', 23.03440094, -81.43530273),
+    ('VRB', 27.65559959, -80.41790009),
+    ('VRC', 13.5763998, 124.2060013),
+    ('VRE', -31.64100075, 18.5447998),
+    ('VRK', 62.17110062, 27.86860085),
+    ('VRL', 41.27429962, -7.720469952),
+    ('VRN', 45.39569855, 10.88850021),
+    ('VRO', 23.12400055, -81.3015976),
+    ('VRU', -26.98240089, 24.72879982),
+    ('VSA', 17.99699974, -92.81739807),
+    ('VSE', 40.7254982, -7.888989925),
+    ('VSF', 43.34360123, -72.51730347),
+    ('VSG', 48.41740036, 39.37409973),
+    ('VSK', 46.21860123, -119.2099991),
+    ('VST', 59.58940125, 16.63360023),
+    ('VTB', 55.12649918, 30.34959984),
+    ('VTE', 17.98830032, 102.5630035),
+    ('VTF', -18.51250076, 177.6390076),
+    ('VTG', 10.37250042, 107.0950012),
+    ('VTL', 48.22389984, 5.935279846),
+    ('VTM', 31.20829964, 35.01229858),
+    ('VTU', 20.98760033, -76.93579865),
+    ('VTZ', 17.72120094, 83.22450256),
+    ('VUP', 10.435, -73.2495),
+    ('VUS', 60.78829956, 46.25999832),
+    ('VUU', -14.83861111, 35.30138889),
+    ('VVB', -19.83300018, 48.79999924),
+    ('VVC', 4.16787, -73.6138),
+    ('VVI', -17.64480019, -63.13539886),
+    ('VVK', 57.77999878, 16.52359962),
+    ('VVN', -11.85486111, -72.93933333),
+    ('VVO', 43.39899826, 132.147995),
+    ('VVZ', 26.7234993, 8.622650146),
+    ('VXC', -13.27400017, 35.2663002),
+    ('VXE', 16.83320045, -25.05529976),
+    ('VXO', 56.92910004, 14.72799969),
+    ('VYD', -27.78689957, 30.79640007),
+    ('WAA', 65.62259674, -168.0950012),
+    ('WAC', 7.166999817, 37.16699982),
+    ('WAE', 20.50429916, 45.19960022),
+    ('WAF', 32.30469894, 69.57039642),
+    ('WAG', -39.96220016, 175.0249939),
+    ('WAH', 46.24409866, -96.60739899),
+    ('WAI', -14.8987999, 47.9939003),
+    ('WAK', -22.29644235, 44.5315361),
+    ('WAL', 37.94020081, -75.46640015),
+    ('WAM', -17.79537761, 48.44258308),
+    ('WAO', -6.989444444, 145.0751111),
+    ('WAP', -43.61190033, -71.80609894),
+    ('WAQ', -18.70127324, 44.61492062),
+    ('WAR', -3.222429991, 140.9790039),
+    ('WAT', 52.18719864, -7.086959839),
+    ('WAV', -17.3932991, 131.1179962),
+    ('WAW', 52.16569901, 20.96710014),
+    ('WAX', 32.95230103, 12.01550007),
+    ('WAY', 39.90010071, -80.13310242),
+    ('WAZ', -28.14940071, 151.9429932),
+    ('WBA', -2.8114, 129.484),
+    ('WBB', 63.51599884, -162.2779999),
+    ('WBC', -9.3376, 150.5093),
+    ('WBD', -15.19999981, 48.48300171),
+    ('WBG', 54.45930099, 9.516329765),
+    ('WBM', -5.643300056, 143.8950043),
+    ('WBO', -21.60698376, 45.13602018),
+    ('WBQ', 66.36219788, -147.4069977),
+    ('WBR', 43.72259903, -85.50409698),
+    ('WBU', 40.03939819, -105.2259979),
+    ('WBW', 41.29719925, -75.85119629),
+    ('WCA', -42.49029922, -73.77279663),
+    ('WCH', -42.93280029, -72.69909668),
+    ('WCR', 67.50450134, -148.4830017),
+    ('WDA', 14.86699963, 45.54999924),
+    ('WDG', 36.37919998, -97.79109955),
+    ('WDH', -22.47990036, 17.47089958),
+    ('WDI', -26.2833004, 151.8580017),
+    ('WDN', 48.71179962, -123.0179977),
+    ('WDR', 33.98289871, -83.66739655),
+    ('WEA', 32.74629974, -97.68240356),
+    ('WEF', 36.64670181, 119.1190033),
+    ('WEH', 37.18709946, 122.2289963),
+    ('WEI', -12.67860031, 141.9250031),
+    ('WET', -4.044229984, 136.2779999),
+    ('WEW', -30.25830078, 149.4080048),
+    ('WEX', 52.33333206, -6.466667175),
+    ('WFI', -21.4416008, 47.11169815),
+    ('WFK', 47.28549957, -68.31279755),
+    ('WGA', -35.16529846, 147.4660034),
+    ('WGB', 29.94630051, 73.24909973),
+    ('WGC', 17.9144001, 79.60220337),
+    ('WGE', -30.03280067, 148.1260071),
+    ('WGO', 39.14350128, -78.14440155),
+    ('WGP', -9.669219971, 120.302002),
+    ('WGT', -36.41579819, 146.3070068),
+    ('WHF', 21.80269814, 31.52157784),
+    ('WHK', -37.92060089, 176.9140015),
+    ('WHO', -43.36309814, 170.1340027),
+    ('WHP', 34.25930023, -118.413002),
+    ('WHS', 60.37749863, -0.925556004),
+    ('WHT', 29.25429916, -96.15440369),
+    ('WHU', 31.3906002, 118.4089966),
+    ('WIB', 34.22570038, -99.28379822),
+    ('WIC', 58.45890045, -3.093060017),
+    ('WIE', 50.04980087, 8.325400352),
+    ('WIK', -36.80889893, 175.0859985),
+    ('WIL', -1.321720004, 36.81480026),
+    ('WIN', -22.36359978, 143.0859985),
+    ('WIO', -31.52639961, 143.375),
+    ('WIR', -39.00690079, 177.4069977),
+    ('WIT', -22.21829987, 118.3479996),
+    ('WIX', 19.58390045, -103.3840027),
+    ('WJA', 7.450833333, 168.55),
+    ('WJF', 34.74110031, -118.2190018),
+    ('WJR', 1.733240008, 40.09159851),
+    ('WJU', 37.43809891, 127.9599991),
+    ('WKA', -44.72219849, 169.2460022),
+    ('WKB', -36.32109833, 142.4190063),
+    ('WKF', -25.82999992, 28.22249985),
+    ('WKI', -18.36249924, 26.51670074),
+    ('WKJ', 45.40420151, 141.8009949),
+    ('WKK', 59.2826004, -158.6179962),
+    ('WKR', 27.26670074, -78.39969635),
+    ('WLA', -19.77359962, 120.6490021),
+    ('WLC', -31.0, 151.5670013),
+    ('WLD', 37.16859818, -97.03759766),
+    ('WLG', -41.32720184, 174.8049927),
+    ('WLH', -15.4119997, 167.6909943),
+    ('WLK', 66.60009766, -159.9859924),
+    ('WLL', -17.2199, 137.93453),
+    ('WLO', -16.62999916, 129.3200073),
+    ('WLS', -13.23830032, -176.1990051),
+    ('WLW', 39.51639938, -122.2180023),
+    ('WMA', -15.83304941, 48.83328438),
+    ('WMB', -38.29529953, 142.4470062),
+    ('WMC', 40.89659882, -117.8059998),
+    ('WMD', -21.04630493, 44.94041204),
+    ('WME', -27.28639984, 120.5550003),
+    ('WMH', 36.3689003, -92.47049713),
+    ('WMI', 52.4510994, 20.65180016),
+    ('WML', -20.35539029, 45.54337263),
+    ('WMN', -15.43669987, 49.68830109),
+    ('WMO', 64.68920136, -163.4129944),
+    ('WMP', -16.07226934, 47.64416456),
+    ('WMR', -16.16390038, 49.7737999),
+    ('WMX', -4.102509975, 138.9570007),
+    ('WNA', 60.69029999, -161.9790039),
+    ('WND', -28.47500038, 122.2419968),
+    ('WNN', 52.89390182, -89.28919983),
+    ('WNP', 13.5848999, 123.2699966),
+    ('WNR', -25.41309929, 142.6670074),
+    ('WNS', 26.21940041, 68.39009857),
+    ('WNU', -4.8961, 145.3213),
+    ('WNZ', 27.91220093, 120.8519974),
+    ('WOA', -6.796861111, 145.8919444),
+    ('WOE', 51.44910049, 4.342030048),
+    ('WOK', 5.25, -61.73333359),
+    ('WOL', -34.56110001, 150.7890015),
+    ('WON', -18.57500076, 140.8919983),
+    ('WOS', 39.16680145, 127.4860001),
+    ('WOT', 23.36737251, 119.5027771),
+    ('WOW', 61.75419998, -150.052002),
+    ('WPA', -45.39920044, -72.67030334),
+    ('WPB', -15.58428647, 47.62358665),
+    ('WPC', 49.52059937, -113.9970016),
+    ('WPK', -16.6583004, 144.0019989),
+    ('WPM', -8.788220406, 142.8820038),
+    ('WPO', 38.83169937, -107.6460037),
+    ('WPR', -53.25370026, -70.31919861),
+    ('WPU', -54.93109894, -67.626297),
+    ('WRA', 6.9724, 45.3334),
+    ('WRB', 32.64009857, -83.59190369),
+    ('WRE', -35.7682991, 174.3650055),
+    ('WRG', 56.48429871, -132.3699951),
+    ('WRI', 40.0155983, -74.59169769),
+    ('WRL', 43.96569824, -107.9509964),
+    ('WRO', 51.10269928, 16.88579941),
+    ('WRW', -20.84420013, 120.7020035),
+    ('WRY', 59.35029984, -2.950000048),
+    ('WRZ', 6.254489899, 81.23519897),
+    ('WSA', -8.2836, 142.8697),
+    ('WSD', 32.34149933, -106.4029999),
+    ('WSG', 40.13650131, -80.29019928),
+    ('WSH', 40.82189941, -72.86940002),
+    ('WSM', 67.40460205, -150.1230011),
+    ('WSN', 58.70339966, -157.0079956),
+    ('WSO', 5.250150204, -57.17380142),
+    ('WSP', 14.73919964, -83.9693985),
+    ('WSR', -2.721, 134.5061),
+    ('WST', 41.34960175, -71.80339813),
+    ('WSU', -5.961709449, 147.1982253),
+    ('WSY', -20.27611, 148.755),
+    ('WSZ', -41.73809814, 171.5809937),
+    ('WTA', -17.47610092, 43.97280121),
+    ('WTD', 26.68530083, -78.97499847),
+    ('WTE', 9.458333, 170.238611),
+    ('WTK', 67.56610107, -162.9750061),
+    ('WTL', 60.33530045, -162.6670074),
+    ('WTN', 53.16619873, -0.523810983),
+    ('WTO', 10.17329979, 166.003006),
+    ('WTP', -8.545833333, 147.2525),
+    ('WTS', -18.75967656, 46.05406523),
+    ('WTT', -6.1325, 146.4677778),
+    ('WTZ', -36.83169937, 175.6790009),
+    ('WUA', 39.7934, 106.7993),
+    ('WUD', -33.04330063, 135.4470062),
+    ('WUG', -7.345555556, 146.7186111),
+    ('WUH', 30.78380013, 114.2080002),
+    ('WUN', -26.62919998, 120.2210007),
+    ('WUS', 27.70190048, 118.0009995),
+    ('WUU', 7.725830078, 27.97500038),
+    ('WUV', -1.736111111, 142.8366667),
+    ('WUX', 31.49440002, 120.4290009),
+    ('WUZ', 23.45669937, 111.2480011),
+    ('WVB', -22.97990036, 14.64529991),
+    ('WVI', 36.93569946, -121.7900009),
+    ('WVK', -22.11969948, 48.02170181),
+    ('WVL', 44.53319931, -69.67549896),
+    ('WVN', 53.50222015, 8.052222252),
+    ('WWA', 61.57170105, -149.5399933),
+    ('WWD', 39.00849915, -74.90830231),
+    ('WWI', -21.66279984, 121.2340012),
+    ('WWK', -3.583830118, 143.6690063),
+    ('WWR', 36.438, -99.5226667),
+    ('WWY', -33.93719864, 147.1909943),
+    ('WXF', 51.97190094, 0.509443998),
+    ('WXN', 30.8017, 108.433),
+    ('WYA', -33.05889893, 137.5140076),
+    ('WYE', 8.610469818, -11.04539967),
+    ('WYN', -15.51140022, 128.1529999),
+    ('WYS', 44.68840027, -111.1179962),
+    ('XAB', 50.14350128, 1.831889987),
+    ('XAC', 44.59640121, -1.110829949),
+    ('XAL', 27.03569984, -108.947998),
+    ('XAN', 48.44749832, 0.109167002),
+    ('XAP', -27.13419914, -52.65660095),
+    ('XAR', 14.21700001, -0.883000016),
+    ('XAS', 44.06969833, 4.142119884),
+    ('XAU', 3.613610029, -53.20420074),
+    ('XAV', 45.62720108, 6.32972002),
+    ('XBE', 53.96559906, -91.02719879),
+    ('XBG', 12.9829998, -0.166999996),
+    ('XBJ', 32.89810181, 59.26610184),
+    ('XBN', -9.6425, 149.3038889),
+    ('XBO', 12.64999962, -0.566999972),
+    ('XBQ', 47.67850113, 1.208840013),
+    ('XBR', 44.63940048, -75.75029755),
+    ('XBV', 47.00590134, 4.893420219),
+    ('XBX', 49.10279846, 0.56666702),
+    ('XCB', 50.22180176, 3.154239893),
+    ('XCH', -10.45059967, 105.6900024),
+    ('XCL', 58.39110184, -109.5159988),
+    ('XCM', 42.3064003, -82.08190155),
+    ('XCO', -38.2867012, 143.6799927),
+    ('XCP', 49.43439865, 2.806109905),
+    ('XCR', 48.77610016, 4.184490204),
+    ('XCW', 48.0862999, 5.049019814),
+    ('XCX', 46.78139877, 0.551944017),
+    ('XCZ', 49.78390121, 4.647079945),
+    ('XDA', 43.68920135, -1.068889976),
+    ('XDE', 10.94999981, -3.25),
+    ('XDJ', 14.10000038, -1.633000016),
+    ('XDK', 51.04059982, 2.550280094),
+    ('XEN', 40.58029938, 120.697998),
+    ('XFN', 32.15060043, 112.2910004),
+    ('XFW', 53.53527832, 9.83555603),
+    ('XGA', 10.29920006, -3.250829935),
+    ('XGG', 14.44999981, -0.232999995),
+    ('XGN', -16.7553997, 14.96529961),
+    ('XGR', 58.71139908, -65.99279785),
+    ('XGT', 46.17919922, 1.958330035),
+    ('XIC', 27.9890995, 102.1839981),
+    ('XIJ', 28.93479919, 47.79190063),
+    ('XIL', 43.91559982, 115.9639969),
+    ('XIN', 24.14920044, 115.7580032),
+    ('XIY', 34.44710159, 108.7519989),
+    ('XJD', 25.11730003, 51.31499863),
+    ('XJM', 33.05009842, 73.63839722),
+    ('XKA', 12.46700001, 1.5),
+    ('XKH', 19.45000076, 103.1579971),
+    ('XKS', 53.52470016, -88.64279938),
+    ('XKY', 13.06700039, -1.100000024),
+    ('XLB', 58.61750031, -101.4690018),
+    ('XLN', 49.59579849, 3.631669998),
+    ('XLR', 44.98249817, -0.134721994),
+    ('XLS', 16.05080032, -16.46319962),
+    ('XLU', 11.10000038, -2.099999905),
+    ('XLW', 53.1446991, 8.624440193),
+    ('XMA', 7.7538, 125.0333),
+    ('XMC', -37.59830093, 149.7200012),
+    ('XMD', 44.01599884, -97.08589935),
+    ('XME', 50.3105011, 4.033120155),
+    ('XMF', 47.48699951, 6.790540218),
+    ('XMG', 28.96319962, 80.14790344),
+    ('XMH', -14.4368, -146.0700073),
+    ('XMI', -10.7329998, 38.76699829),
+    ('XMJ', 43.9117012, -0.507499993),
+    ('XMK', 44.58359909, 4.740560055),
+    ('XML', -34.75, 137.5330048),
+    ('XMN', 24.54400063, 118.1279984),
+    ('XMP', 63.18109894, -130.2019959),
+    ('XMS', -2.299170017, -78.1207962),
+    ('XMW', 44.02569962, 1.378039956),
+    ('XMY', -9.901109695, 142.776001),
+    ('XNA', 36.2818985, -94.30680084),
+    ('XNN', 36.52750015, 102.0429993),
+    ('XNT', 36.8831, 114.4293),
+    ('XNU', 12.75, -3.867000103),
+    ('XOG', 44.14049911, 4.8667202),
+    ('XPA', 11.25, 0.699999988),
+    ('XPK', 55.74919891, -101.2659988),
+    ('XPL', 14.38239956, -87.62120056),
+    ('XPP', 52.99652588, -97.27419376),
+    ('XPR', 43.02249908, -102.5110016),
+    ('XQC', 33.94020081, 44.36159897),
+    ('XQP', 9.443160057, -84.12979889),
+    ('XRH', -33.6006012, 150.7810059),
+    ('XRR', 61.97060013, -132.4230042),
+    ('XRY', 36.74459839, -6.060110092),
+    ('XSC', 21.51569939, -71.52850342),
+    ('XSD', 37.79880142, -116.7809982),
+    ('XSE', 13.44999981, 0.51700002),
+    ('XSI', 56.7928009, -98.90720367),
+    ('XSJ', 49.86849976, 3.029580116),
+    ('XSL', 44.79330063, 1.244719982),
+    ('XSO', 7.710481328, 122.1611881),
+    ('XSP', 1.416949987, 103.8679962),
+    ('XSU', 47.25680161, -0.115142003),
+    ('XTB', 43.21609879, 0.078611001),
+    ('XTG', -27.9864006, 143.8110046),
+    ('XTL', 58.70610046, -98.5121994),
+    ('XTO', -25.80170059, 149.8999939),
+    ('XTR', -27.15670013, 150.477005),
+    ('XUZ', 34.059056, 117.555278),
+    ('XVL', 10.2509, 105.9445),
+    ('XVN', 49.12239838, 5.469049931),
+    ('XVO', 47.63759995, 6.203919888),
+    ('XVS', 50.32580185, 3.46126008),
+    ('XVZ', 47.19469833, 2.066669941),
+    ('XWP', 56.13440699, 13.87564659),
+    ('XXN', 24.70980072, 46.72520065),
+    ('XYA', -9.092816, 159.21841),
+    ('XYE', 15.30000019, 97.86699677),
+    ('XYR', -3.883986, 141.792234),
+    ('XZA', 11.16699982, -0.616999984),
+    ('YAA', 52.45249939, -125.3030014),
+    ('YAC', 51.72719955, -91.82440186),
+    ('YAG', 48.65420151, -93.43969727),
+    ('YAH', 53.75469971, -73.6753006),
+    ('YAI', -36.58250046, -72.03140259),
+    ('YAK', 59.50329971, -139.6600037),
+    ('YAL', 50.5821991, -126.9160004),
+    ('YAM', 46.48500061, -84.50939941),
+    ('YAN', 0.782999992, 24.46699905),
+    ('YAO', 3.83604002, 11.52350044),
+    ('YAP', 9.49890995, 138.0829926),
+    ('YAR', 53.57170105, -76.1964035),
+    ('YAS', -16.75889969, 177.5449982),
+    ('YAT', 52.92750168, -82.43190002),
+    ('YAU', 61.66220093, -73.3214035),
+    ('YAX', 53.8492012, -89.57939911),
+    ('YAY', 51.39189911, -56.08309937),
+    ('YAZ', 49.07982555, -125.7756042),
+    ('YBA', 51.20734047, -115.5418611),
+    ('YBB', 68.53440094, -89.80809784),
+    ('YBC', 49.13249969, -68.20439911),
+    ('YBE', 59.56140137, -108.4810028),
+    ('YBG', 48.33060074, -70.99639893),
+    ('YBI', 53.4693985, -55.78499985),
+    ('YBK', 64.29889679, -96.07779694),
+    ('YBL', 49.95080185, -125.2710037),
+    ('YBO', 56.96670151, -130.25),
+    ('YBP', 28.80055556, 104.545),
+    ('YBR', 49.90999985, -99.95189667),
+    ('YBS', 52.6067009, -90.37689972),
+    ('YBT', 57.88940048, -101.6790009),
+    ('YBV', 52.35889816, -97.01830292),
+    ('YBX', 51.4435997, -57.18529892),
+    ('YBY', 54.30419922, -110.7440033),
+    ('YCB', 69.10810089, -105.1380005),
+    ('YCC', 45.09280014, -74.56330109),
+    ('YCD', 49.05497022, -123.8698626),
+    ('YCE', 43.28559875, -81.50830078),
+    ('YCG', 49.29639816, -117.6320038),
+    ('YCH', 47.00780106, -65.44920349),
+    ('YCK', 67.03330231, -126.0830002),
+    ('YCL', 47.99079895, -66.33029938),
+    ('YCM', 43.19169998, -79.17169952),
+    ('YCN', 49.10559845, -81.01360321),
+    ('YCO', 67.8167038, -115.1439972),
+    ('YCQ', 55.68719864, -121.6269989),
+    ('YCR', 54.61059952, -97.76080322),
+    ('YCS', 63.34690094, -90.73110199),
+    ('YCT', 52.07500076, -111.4449997),
+    ('YCU', 35.116391, 111.0313889),
+    ('YCW', 49.15280151, -121.939003),
+    ('YCY', 70.48609924, -68.51670074),
+    ('YCZ', 50.33029938, -115.8730011),
+    ('YDA', 64.04309845, -139.128006),
+    ('YDB', 61.37110138, -139.0410004),
+    ('YDF', 49.21080017, -57.39139938),
+    ('YDG', 44.54584504, -65.78542471),
+    ('YDL', 58.42219925, -130.0319977),
+    ('YDN', 51.10079956, -100.052002),
+    ('YDO', 48.7784996, -72.375),
+    ('YDP', 56.54919815, -61.68030167),
+    ('YDQ', 55.74229813, -120.1829987),
+    ('YDT', 49.07419968, -123.012001),
+    ('YDV', 51.784568, -96.692305),
+    ('YEB', 46.42029953, -84.09220123),
+    ('YEC', 36.63190079, 128.3549957),
+    ('YEG', 53.30970001, -113.5800018),
+    ('YEH', 27.37960052, 52.73770142),
+    ('YEI', 40.25519943, 29.56259918),
+    ('YEK', 61.09420013, -94.07080078),
+    ('YEL', 46.35139847, -82.56140137),
+    ('YEM', 45.84280014, -81.85810089),
+    ('YEN', 49.21030045, -102.9660034),
+    ('YEO', 51.00939941, -2.638819933),
+    ('YEQ', -5.109722222, 143.9176667),
+    ('YER', 56.01890182, -87.67610168),
+    ('YET', 53.57889938, -116.4649963),
+    ('YEU', 79.99469757, -85.81420136),
+    ('YEV', 68.30419922, -133.4830017),
+    ('YEY', 48.56389999, -78.24970245),
+    ('YFA', 52.20140076, -81.69689941),
+    ('YFB', 63.75640106, -68.55580139),
+    ('YFC', 45.8689003, -66.53720093),
+    ('YFE', 48.74610138, -69.09719849),
+    ('YFH', 51.56190109, -87.90779877),
+    ('YFI', 57.27583333, -110.9766667),
+    ('YFO', 54.67810059, -101.6819992),
+    ('YFR', 61.18080139, -113.6900024),
+    ('YFS', 61.7602005, -121.2369995),
+    ('YFX', 52.37279892, -55.6739006),
+    ('YGB', 49.69419861, -124.5179977),
+    ('YGH', 66.24079895, -128.651001),
+    ('YGJ', 35.49219894, 133.2359924),
+    ('YGK', 44.22529984, -76.59690094),
+    ('YGL', 53.62530136, -77.70420074),
+    ('YGM', 50.62810135, -97.04329681),
+    ('YGO', 54.55889893, -94.49140167),
+    ('YGP', 48.77529907, -64.47859955),
+    ('YGQ', 49.77830124, -86.93939972),
+    ('YGR', 47.42470169, -61.77809906),
+    ('YGT', 69.36470032, -81.81610107),
+    ('YGV', 50.2818985, -63.6114006),
+    ('YGW', 55.2818985, -77.76529694),
+    ('YGX', 56.35749817, -94.71060181),
+    ('YGZ', 76.42610168, -82.90920258),
+    ('YHA', 52.52809906, -56.28609848),
+    ('YHB', 52.81669998, -102.310997),
+    ('YHD', 49.83169937, -92.74420166),
+    ('YHE', 49.36830139, -121.4980011),
+    ('YHF', 49.71419907, -83.68609619),
+    ('YHI', 70.76280212, -117.8059998),
+    ('YHK', 68.63559723, -95.84970093),
+    ('YHM', 43.17359924, -79.93499756),
+    ('YHN', 49.19309998, -84.7589035),
+    ('YHO', 55.44829941, -60.22859955),
+    ('YHP', 52.11330032, -94.25559998),
+    ('YHR', 50.46889877, -59.63669968),
+    ('YHT', 60.78919983, -137.5460052),
+    ('YHU', 45.51750183, -73.41690063),
+    ('YHY', 60.83969879, -115.7829971),
+    ('YHZ', 44.88079834, -63.50859833),
+    ('YIB', 48.77389908, -91.63860321),
+    ('YIF', 51.21170044, -58.65829849),
+    ('YIH', 30.55654972, 111.4799883),
+    ('YIK', 62.41730118, -77.9253006),
+    ('YIN', 43.9557991, 81.33029938),
+    ('YIO', 72.6832962, -77.96669769),
+    ('YIP', 42.23789978, -83.53040314),
+    ('YIV', 53.85720062, -94.6536026),
+    ('YIW', 29.34469986, 120.0319977),
+    ('YJF', 60.2358017, -123.4690018),
+    ('YJN', 45.29439926, -73.28109741),
+    ('YJS', 41.90589905, 128.4100037),
+    ('YJT', 48.5442009, -58.54999924),
+    ('YKA', 50.70220184, -120.4440002),
+    ('YKC', 58.23609924, -103.6780014),
+    ('YKD', 44.20140076, -81.60669708),
+    ('YKF', 43.46080017, -80.37860107),
+    ('YKG', 60.02719879, -69.99919891),
+    ('YKJ', 57.2560997, -105.6179962),
+    ('YKL', 54.80530167, -66.80529785),
+    ('YKM', 46.56819916, -120.5439987),
+    ('YKQ', 51.47330093, -78.75830078),
+    ('YKS', 62.09329987, 129.7709961),
+    ('YKU', 53.80559921, -78.91690063),
+    ('YKX', 48.21030045, -79.98139954),
+    ('YKY', 51.51750183, -109.1809998),
+    ('YKZ', 43.86220169, -79.37000275),
+    ('YLB', 54.77030182, -112.0319977),
+    ('YLC', 62.84999847, -69.88330078),
+    ('YLD', 47.81999969, -83.34670258),
+    ('YLE', 63.13169861, -117.2460022),
+    ('YLG', -28.3553009, 116.6839981),
+    ('YLH', 52.1955986, -87.93419647),
+    ('YLI', 64.0605011, 24.7159996),
+    ('YLJ', 54.12530136, -108.5230026),
+    ('YLK', 44.48529816, -79.55560303),
+    ('YLL', 53.30920029, -110.072998),
+    ('YLP', 50.2869, -64.1528),
+    ('YLQ', 47.40969849, -72.78890228),
+    ('YLR', 56.51330185, -99.98529816),
+    ('YLT', 82.51779938, -62.2806015),
+    ('YLW', 49.95610046, -119.3779984),
+    ('YLY', 49.10079956, -122.6309967),
+    ('YMA', 63.61640167, -135.8679962),
+    ('YME', 48.85689926, -67.45330048),
+    ('YMG', 49.08390045, -85.86060333),
+    ('YMH', 52.30279922, -55.84719849),
+    ('YMJ', 50.33029938, -105.5589981),
+    ('YMK', 68.48300171, 73.56700134),
+    ('YML', 47.59749985, -70.22389984),
+    ('YMM', 56.65330124, -111.2220001),
+    ('YMN', 55.07690048, -59.18640137),
+    ('YMO', 51.29109955, -80.60780334),
+    ('YMS', -5.893770218, -76.11820221),
+    ('YMT', 49.77190018, -74.52809906),
+    ('YMV', 71.32416667, -79.35694444),
+    ('YMW', 46.27280045, -75.99060059),
+    ('YMX', 45.67950058, -74.03869629),
+    ('YNA', 50.18999863, -61.78919983),
+    ('YNB', 24.14419937, 38.06340027),
+    ('YNC', 53.01060104, -78.83110046),
+    ('YND', 45.52170181, -75.56359863),
+    ('YNE', 53.95830154, -97.84420013),
+    ('YNG', 41.26070023, -80.67910004),
+    ('YNH', 56.03559875, -121.9759979),
+    ('YNJ', 42.88280106, 129.451004),
+    ('YNL', 58.27669907, -104.0820007),
+    ('YNM', 49.76169968, -77.80280304),
+    ('YNO', 52.49000168, -92.97109985),
+    ('YNS', 51.69110107, -76.13559723),
+    ('YNT', 37.40169907, 121.3720016),
+    ('YNX', 63.59360123, -110.9059982),
+    ('YNY', 38.06129837, 128.6690063),
+    ('YNZ', 33.425833, 120.203056),
+    ('YOA', 64.69889832, -110.6149979),
+    ('YOC', 67.57060242, -139.8390045),
+    ('YOD', 54.40499878, -110.2789993),
+    ('YOG', 51.65859985, -85.90170288),
+    ('YOH', 54.93330002, -95.27890015),
+    ('YOI', 52.22194444, -76.61194444),
+    ('YOJ', 58.62139893, -117.1650009),
+    ('YOL', 9.25755024, 12.43039989),
+    ('YOO', 43.92279816, -78.89499664),
+    ('YOP', 58.49140167, -119.4079971),
+    ('YOS', 44.59030151, -80.83750153),
+    ('YOT', 29.90620041, 35.06669998),
+    ('YOW', 45.32249832, -75.66919708),
+    ('YPA', 53.21419907, -105.6729965),
+    ('YPC', 69.36083812, -124.07547),
+    ('YPE', 56.22689819, -117.4469986),
+    ('YPG', 49.90309906, -98.27390289),
+    ('YPH', 58.47190094, -78.07689667),
+    ('YPJ', 59.29669952, -69.59970093),
+    ('YPL', 51.44639969, -90.21420288),
+    ('YPM', 51.81969833, -93.97329712),
+    ('YPN', 49.83639908, -64.28859711),
+    ('YPO', 54.98809814, -85.44329834),
+    ('YPQ', 44.22999954, -78.36329651),
+    ('YPR', 54.28609848, -130.4450073),
+    ('YPS', 45.65670013, -61.36809921),
+    ('YPW', 49.83420181, -124.5),
+    ('YPX', 60.05059814, -77.28690338),
+    ('YPY', 58.76720047, -111.1169968),
+    ('YPZ', 54.37639999, -125.9509964),
+    ('YQA', 44.97470093, -79.30329895),
+    ('YQB', 46.79109955, -71.39330292),
+    ('YQC', 61.04639816, -69.61779785),
+    ('YQD', 53.97140121, -101.0910034),
+    ('YQF', 52.18220139, -113.8939972),
+    ('YQG', 42.27560043, -82.95559692),
+    ('YQH', 60.11640167, -128.8220062),
+    ('YQI', 43.82690048, -66.08809662),
+    ('YQK', 49.78829956, -94.36309814),
+    ('YQL', 49.63029861, -112.8000031),
+    ('YQM', 46.11220169, -64.6785965),
+    ('YQN', 50.18280029, -86.6964035),
+    ('YQQ', 49.71080017, -124.887001),
+    ('YQR', 50.43190002, -104.6660004),
+    ('YQS', 42.77000046, -81.1108017),
+    ('YQT', 48.37189865, -89.32389832),
+    ('YQU', 55.17969894, -118.8850021),
+    ('YQV', 51.26470184, -102.461998),
+    ('YQW', 52.76919937, -108.2440033),
+    ('YQX', 48.93690109, -54.56809998),
+    ('YQY', 46.16139984, -60.04779816),
+    ('YQZ', 53.02610016, -122.5100021),
+    ('YRA', 64.1160965, -117.3099976),
+    ('YRB', 74.71690369, -94.9693985),
+    ('YRF', 53.68280029, -57.04190063),
+    ('YRG', 54.17969894, -58.45750046),
+    ('YRI', 47.76440048, -69.58470154),
+    ('YRJ', 48.52000046, -72.26560211),
+    ('YRL', 51.06689835, -93.79309845),
+    ('YRM', 52.42969894, -114.9039993),
+    ('YRO', 45.46030045, -75.64610291),
+    ('YRQ', 46.35279846, -72.67939758),
+    ('YRS', 54.16719818, -93.55719757),
+    ('YRT', 62.81140137, -92.11579895),
+    ('YRV', 50.96670151, -118.1829987),
+    ('YSB', 46.625, -80.79889679),
+    ('YSC', 45.43859863, -71.69139862),
+    ('YSE', 49.78170013, -123.1620026),
+    ('YSF', 59.25030136, -105.8410034),
+    ('YSG', 62.41830063, -110.6819992),
+    ('YSH', 44.94580078, -75.94059753),
+    ('YSJ', 45.31610107, -65.89029694),
+    ('YSK', 56.53779984, -79.24669647),
+    ('YSL', 47.15750122, -67.83470154),
+    ('YSM', 60.02030182, -111.961998),
+    ('YSN', 50.68280029, -119.2289963),
+    ('YSP', 48.75529861, -86.3443985),
+    ('YST', 53.84560013, -94.85189819),
+    ('YSU', 46.44060135, -63.83359909),
+    ('YSY', 71.99389648, -125.2429962),
+    ('YTA', 45.86439896, -77.25170135),
+    ('YTD', 55.31890106, -97.70780182),
+    ('YTE', 64.23000336, -76.52670288),
+    ('YTF', 48.50889969, -71.64189911),
+    ('YTH', 55.80110168, -97.86419678),
+    ('YTL', 53.81779861, -89.89689636),
+    ('YTM', 46.40940094, -74.77999878),
+    ('YTQ', 58.6678009, -69.95580292),
+    ('YTR', 44.1189003, -77.52809906),
+    ('YTS', 48.56969833, -81.37670136),
+    ('YTZ', 43.62749863, -79.39620209),
+    ('YUB', 69.4332962, -133.026001),
+    ('YUD', 56.53609848, -76.51830292),
+    ('YUE', -22.25419998, 131.7819977),
+    ('YUL', 45.47060013, -73.74079895),
+    ('YUM', 32.65660095, -114.6060028),
+    ('YUS', 32.83638889, 97.03638889),
+    ('YUT', 66.52140045, -86.22470093),
+    ('YUX', 68.77610016, -81.2425),
+    ('YUY', 48.20610046, -78.83560181),
+    ('YVA', -11.71080017, 43.2439003),
+    ('YVB', 48.07109833, -65.46029663),
+    ('YVC', 55.15140152, -105.262001),
+    ('YVD', -7.548, 146.188),
+    ('YVE', 50.24810028, -119.3310013),
+    ('YVG', 53.35580063, -110.8239975),
+    ('YVM', 67.54579926, -64.03140259),
+    ('YVO', 48.05329895, -77.78279877),
+    ('YVP', 58.09609985, -68.42690277),
+    ('YVQ', 65.28160095, -126.7979965),
+    ('YVR', 49.19390106, -123.1839981),
+    ('YVT', 55.84189987, -108.4179993),
+    ('YVV', 44.74580002, -81.10720062),
+    ('YVZ', 52.65579987, -94.06140137),
+    ('YWA', 45.95220184, -77.31919861),
+    ('YWB', 61.58860016, -71.92939758),
+    ('YWG', 49.90999985, -97.23989868),
+    ('YWJ', 65.21109772, -123.435997),
+    ('YWK', 52.9219017, -66.86440277),
+    ('YWL', 52.18310165, -122.0540009),
+    ('YWM', 52.56689835, -55.78469849),
+    ('YWP', 52.9593934, -87.37486839),
+    ('YWY', 63.20940018, -123.4369965),
+    ('YXC', 49.6108017, -115.7819977),
+    ('YXE', 52.17079926, -106.6999969),
+    ('YXH', 50.01890182, -110.7210007),
+    ('YXJ', 56.23809814, -120.7399979),
+    ('YXK', 48.47809982, -68.49690247),
+    ('YXL', 50.11389923, -91.90529633),
+    ('YXN', 62.24000168, -92.59809875),
+    ('YXP', 66.14499664, -65.71360016),
+    ('YXQ', 62.41030121, -140.8670044),
+    ('YXR', 47.69740065, -79.84734535),
+    ('YXS', 53.88940048, -122.6790009),
+    ('YXT', 54.46849823, -128.576004),
+    ('YXU', 43.03559875, -81.15390015),
+    ('YXX', 49.02529907, -122.3610001),
+    ('YXY', 60.70959854, -135.0670013),
+    ('YXZ', 47.96670151, -84.78669739),
+    ('YYB', 46.36360168, -79.42279816),
+    ('YYC', 51.11389923, -114.0199966),
+    ('YYD', 54.8246994, -127.1829987),
+    ('YYE', 58.83639908, -122.5970001),
+    ('YYF', 49.46310043, -119.6019974),
+    ('YYG', 46.29000092, -63.12110138),
+    ('YYH', 69.54669952, -93.5766983),
+    ('YYJ', 48.64690018, -123.4260025),
+    ('YYL', 56.86389923, -101.0759964),
+    ('YYM', 49.63639832, -114.0940018),
+    ('YYN', 50.29190063, -107.6910019),
+    ('YYQ', 58.73920059, -94.06500244),
+    ('YYR', 53.31919861, -60.42580032),
+    ('YYT', 47.61859894, -52.75189972),
+    ('YYU', 49.41389847, -82.46749878),
+    ('YYW', 50.29029846, -88.90969849),
+    ('YYY', 48.60860062, -68.20809937),
+    ('YYZ', 43.67720032, -79.63059998),
+    ('YZD', 43.74250031, -79.46559906),
+    ('YZE', 45.88529968, -82.56780243),
+    ('YZF', 62.46279907, -114.4400024),
+    ('YZG', 62.1794014, -75.66719818),
+    ('YZH', 55.29309845, -114.7770004),
+    ('YZP', 53.25429916, -131.8139954),
+    ('YZR', 42.99940109, -82.30889893),
+    ('YZS', 64.19329834, -83.35939789),
+    ('YZT', 50.68059921, -127.3669968),
+    ('YZU', 54.14390182, -115.7870026),
+    ('YZV', 50.22330093, -66.26560211),
+    ('YZW', 60.17279816, -132.7429962),
+    ('YZX', 44.9844017, -64.91690063),
+    ('YZY', 38.80189896, 100.6750031),
+    ('YZZ', 49.05559921, -117.6090012),
+    ('ZAC', 56.08940125, -96.08920288),
+    ('ZAD', 44.10829926, 15.34669971),
+    ('ZAG', 45.74290085, 16.06879997),
+    ('ZAH', 29.47570038, 60.90620041),
+    ('ZAJ', 30.972222, 61.865833),
+    ('ZAL', -39.65000153, -73.08609772),
+    ('ZAM', 6.922420025, 122.0599976),
+    ('ZAO', 44.35139847, 1.475280046),
+    ('ZAR', 11.13020039, 7.685810089),
+    ('ZAT', 27.32559967, 103.7549973),
+    ('ZAZ', 41.66619873, -1.04155004),
+    ('ZBE', 49.92829895, 18.07830048),
+    ('ZBF', 47.62969971, -65.73889923),
+    ('ZBK', 43.1166687, 19.23333359),
+    ('ZBM', 45.29079819, -72.74140167),
+    ('ZBO', -20.0182991, 148.2149963),
+    ('ZBR', 25.44330025, 60.38209915),
+    ('ZBY', 19.2436, 101.7093),
+    ('ZCD', 49.92055511, 10.9141674),
+    ('ZCL', 22.89710045, -102.6869965),
+    ('ZCN', 52.59120178, 10.02210045),
+    ('ZCO', -38.76679993, -72.63710022),
+    ('ZEC', -26.52409935, 29.17009926),
+    ('ZEG', -5.6908, 139.3502),
+    ('ZEL', 52.13970184, -128.0639954),
+    ('ZEM', 52.22639847, -78.52249908),
+    ('ZEN', -6.952222222, 146.61625),
+    ('ZER', 27.5883007, 93.82810211),
+    ('ZFA', 62.20750046, -133.3760071),
+    ('ZFD', 59.33440018, -107.1819992),
+    ('ZFM', 67.40750122, -134.8609924),
+    ('ZFN', 64.90969849, -125.572998),
+    ('ZFW', 56.08140183, -118.4349976),
+    ('ZGF', 49.0155983, -118.4309998),
+    ('ZGI', 54.83969879, -94.07859802),
+    ('ZGL', -25.68330002, 142.1080017),
+    ('ZGM', -15.96580029, 25.93330002),
+    ('ZGQ', 50.52970123, 3.49454999),
+    ('ZGR', 52.04560089, -95.46579742),
+    ('ZGU', -14.21809959, 167.5870056),
+    ('ZHA', 21.21439934, 110.3580017),
+    ('ZHI', 47.18159866, 7.417190075),
+    ('ZHM', 24.41699982, 91.88300323),
+    ('ZHP', 55.39360046, -116.4749985),
+    ('ZHY', 37.573125, 105.154454),
+    ('ZIA', 46.02140045, 11.12419987),
+    ('ZIC', -38.24560165, -72.34860229),
+    ('ZIG', 12.55560017, -16.28179932),
+    ('ZIH', 17.60160065, -101.4609985),
+    ('ZIN', 46.67660141, 7.879079819),
+    ('ZJG', 54.51890182, -98.0460968),
+    ('ZJI', 46.16080093, 8.878609657),
+    ('ZJN', 52.12060165, -101.2360001),
+    ('ZKB', -8.524999619, 30.66300011),
+    ('ZKE', 52.28250122, -81.67780304),
+    ('ZKG', 50.19580078, -61.26580048),
+    ('ZKM', -2.532999992, 9.767000198),
+    ('ZKP', -12.57279968, 27.89389992),
+    ('ZLO', 19.14480019, -104.5589981),
+    ('ZLR', -35.86169815, -71.54859924),
+    ('ZLT', 50.8307991, -58.9756012),
+    ('ZMG', 52.07361221, 11.62638855),
+    ('ZMH', 51.73609924, -121.3330002),
+    ('ZMM', 20.04500008, -102.276001),
+    ('ZMT', 54.02750015, -132.125),
+    ('ZNC', 60.98070145, -159.9940033),
+    ('ZND', 13.77900028, 8.98375988),
+    ('ZNE', -23.4178009, 119.8030014),
+    ('ZNF', 50.1692009, 8.961589813),
+    ('ZNZ', -6.222020149, 39.22489929),
+    ('ZOJ', 51.64720154, 7.163330078),
+    ('ZOS', -40.61119843, -73.06099701),
+    ('ZOW', 52.45750046, 7.182221889),
+    ('ZPB', 53.89110184, -92.1964035),
+    ('ZPC', -39.2928009, -71.91590118),
+    ('ZPH', 28.22820091, -82.15589905),
+    ('ZPO', 55.52809906, -106.5820007),
+    ('ZPQ', 52.29119873, 7.387000084),
+    ('ZQL', 47.97333145, 8.522221565),
+    ('ZQN', -45.02109909, 168.7389984),
+    ('ZQW', 49.20940018, 7.400559902),
+    ('ZQZ', 40.73860168, 114.9300003),
+    ('ZRE', 45.339756, 20.454078),
+    ('ZRH', 47.46469879, 8.54916954),
+    ('ZRI', -1.875579953, 136.2409973),
+    ('ZRJ', 52.9435997, -91.31279755),
+    ('ZRM', -1.86954999, 138.75),
+    ('ZSA', 24.06329918, -74.52400208),
+    ('ZSE', -21.32089996, 55.42499924),
+    ('ZSJ', 53.06420135, -93.3443985),
+    ('ZSP', 53.99330139, -111.3799973),
+    ('ZSS', 4.928329945, -6.132780075),
+    ('ZST', 55.93541045, -129.9824345),
+    ('ZTA', -20.78969955, -138.5700073),
+    ('ZTB', 50.67440033, -59.38359833),
+    ('ZTH', 37.75090027, 20.88430023),
+    ('ZTM', 55.86560059, -92.08139801),
+    ('ZTR', 50.270556, 28.738611),
+    ('ZTU', 41.56222153, 46.66722107),
+    ('ZUC', 49.42969894, -91.71779633),
+    ('ZUD', -41.90390015, -73.79669952),
+    ('ZUH', 22.00639915, 113.3759995),
+    ('ZUL', 26.35000038, 44.83300018),
+    ('ZUM', 53.56190109, -64.10639954),
+    ('ZVA', -19.56279945, 45.45080185),
+    ('ZVG', -17.78689957, 127.6699982),
+    ('ZVK', 16.55660057, 104.7600021),
+    ('ZWA', -14.65170002, 49.62060165),
+    ('ZWL', 58.10689926, -103.1719971),
+    ('ZXT', 40.49554222, 49.97680664),
+    ('ZYI', 27.5895, 107.0007),
+    ('ZYL', 24.96319962, 91.8667984),
+    ('ZZO', 50.6692009, 142.7610016),
+    ('ZZU', -11.44470024, 34.01179886),
+    ('ZZV', 39.94440079, -81.89209747)
+`
@@ -0,0 +1,181 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+// offersChannel is the channel the offers triggers notify with the IDs
+// of saved offers.
+const offersChannel = "offers_saved"
+
+// subscriberBuffer is the number of events a subscriber may fall behind
+// before events are dropped.
+const subscriberBuffer = 100
+
+// SubscribeOffers streams offers saved by any server sharing the
+// database, using LISTEN/NOTIFY. Offers saved while the listener is
+// reconnecting are missed.
+func (s *Store) SubscribeOffers(ctx context.Context) (<-chan transitdb.OfferEvent, error) {
+	s.streamMu.Lock()
+	defer s.streamMu.Unlock()
+
+	if s.listener == nil {
+		l := pq.NewListener(s.url, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "[error]", err)
+			}
+		})
+		if err := l.Listen(offersChannel); err != nil {
+			l.Close()
+			return nil, err
+		}
+		s.listener = l
+		s.subscribers = make(map[chan transitdb.OfferEvent]bool)
+		go s.dispatchOffers(l)
+	}
+
+	ch := make(chan transitdb.OfferEvent, subscriberBuffer)
+	s.subscribers[ch] = true
+
+	go func() {
+		<-ctx.Done()
+
+		s.streamMu.Lock()
+		delete(s.subscribers, ch)
+		close(ch)
+		s.streamMu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// maxDispatchBatch is the most offers dispatchOffers looks up at once.
+const maxDispatchBatch = 1000
+
+// dispatchOffers looks up the offers named by l's notifications and
+// sends them to subscribers until l is closed.
+func (s *Store) dispatchOffers(l *pq.Listener) {
+	for n := range l.Notify {
+		// Notifications arrive one per row, so look up whatever else
+		// has queued up along with this one.
+		ids := appendOfferID(nil, n)
+	drain:
+		for len(ids) < maxDispatchBatch {
+			select {
+			case n, ok := <-l.Notify:
+				if !ok {
+					break drain
+				}
+				ids = appendOfferID(ids, n)
+			default:
+				break drain
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		events, err := s.offerEvents(context.Background(), ids)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "[error]", err)
+			continue
+		}
+
+		s.streamMu.Lock()
+		for _, e := range events {
+			for ch := range s.subscribers {
+				select {
+				case ch <- e:
+				default:
+				}
+			}
+		}
+		s.streamMu.Unlock()
+	}
+}
+
+// appendOfferID appends the offer ID carried by n. A nil n means the
+// listener reconnected and carries nothing.
+func appendOfferID(ids []int64, n *pq.Notification) []int64 {
+	if n == nil {
+		return ids
+	}
+	id, err := strconv.ParseInt(n.Extra, 10, 64)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[error] bad %s payload %q\n", offersChannel, n.Extra)
+		return ids
+	}
+	return append(ids, id)
+}
+
+func (s *Store) offerEvents(ctx context.Context, ids []int64) ([]transitdb.OfferEvent, error) {
+	rows, err := s.db.QueryContext(ctx, offerEventsSQL, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.OfferEvent
+	for rows.Next() {
+		var (
+			res                    transitdb.OfferEvent
+			availableTo, expiresAt pq.NullTime
+		)
+
+		err = rows.Scan(
+			&res.ID,
+			&res.OriginAirport,
+			&res.OriginCountry,
+			&res.DestinationAirport,
+			&res.DestCountry,
+			&res.Cost,
+			&res.Currency,
+			&res.Source,
+			&res.AvailableFrom,
+			&availableTo,
+			&res.OfferedAt,
+			&expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		res.AvailableTo = transitdb.Date(availableTo.Time)
+		res.ExpiresAt = expiresAt.Time
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const offerEventsSQL = `
+SELECT offer_id,
+       origin.iata_code,
+       origin.country,
+       dest.iata_code,
+       dest.country,
+       cost,
+       currency,
+       source,
+       start_time,
+       end_time,
+       created_at,
+       expires_at
+FROM offers
+     JOIN places AS origin
+          ON origin.place_id = offers.origin_id
+     JOIN places AS dest
+          ON dest.place_id = offers.dest_id
+WHERE offer_id = ANY($1)
+ORDER BY offer_id
+`
@@ -0,0 +1,164 @@
+package pg
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) ListRoundTrips(ctx context.Context, q transitdb.RoundTripRequest) ([]transitdb.RoundTrip, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	currency := q.Currency
+	if currency == "" {
+		currency = transitdb.BaseCurrency
+	}
+	perUSD, err := s.exchangeRate(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, listRoundTripsSQL,
+		q.StartDate, q.EndDate,
+		strings.Join(q.Origins, ","),
+		strings.Join(q.Destinations, ","),
+		q.MinStay, q.MaxStay,
+		q.Limit,
+		q.Offset,
+		perUSD)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.RoundTrip
+	for rows.Next() {
+		var (
+			res                        transitdb.RoundTrip
+			outEndDate, outExpiresAt   pq.NullTime
+			backEndDate, backExpiresAt pq.NullTime
+		)
+
+		err = rows.Scan(
+			&res.Cost,
+			&res.Outbound.Origin,
+			&res.Outbound.OriginIATA,
+			&res.Outbound.OriginCountry,
+			&res.Outbound.Dest,
+			&res.Outbound.DestIATA,
+			&res.Outbound.DestCountry,
+			&res.Outbound.Cost,
+			&res.Outbound.Date,
+			&res.Outbound.OfferID,
+			&res.Outbound.Source,
+			&outEndDate,
+			&res.Outbound.OfferedAt,
+			&outExpiresAt,
+			&res.Return.Cost,
+			&res.Return.Date,
+			&res.Return.OfferID,
+			&res.Return.Source,
+			&backEndDate,
+			&res.Return.OfferedAt,
+			&backExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		res.Outbound.EndDate = transitdb.Date(outEndDate.Time)
+		res.Outbound.ExpiresAt = outExpiresAt.Time
+		res.Return.EndDate = transitdb.Date(backEndDate.Time)
+		res.Return.ExpiresAt = backExpiresAt.Time
+
+		res.Outbound.Currency = currency
+		res.Return.Currency = currency
+		res.Return.Origin = res.Outbound.Dest
+		res.Return.OriginIATA = res.Outbound.DestIATA
+		res.Return.OriginCountry = res.Outbound.DestCountry
+		res.Return.Dest = res.Outbound.Origin
+		res.Return.DestIATA = res.Outbound.OriginIATA
+		res.Return.DestCountry = res.Outbound.OriginCountry
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+const listRoundTripsSQL = `
+WITH
+
+live_offers AS (
+    SELECT offers.*,
+           offer_costs.cost_usd
+      FROM offers
+           JOIN offer_costs USING (offer_id)
+     WHERE expires_at > NOW()
+),
+
+-- Outbound legs matching the request, like matching_offers in
+-- listQuotesSQL.
+--
+outbound AS (
+    SELECT live_offers.*
+      FROM live_offers
+           JOIN places AS origin
+              ON origin.place_id = live_offers.origin_id
+           JOIN places AS dest
+              ON dest.place_id = live_offers.dest_id
+     WHERE (start_time BETWEEN $1 AND $2)
+       AND ($3 = '' OR origin.iata_code = ANY(expand_place_codes(string_to_array($3, ','))))
+       AND ($4 = '' OR dest.iata_code = ANY(expand_place_codes(string_to_array($4, ','))))
+)
+
+-- Pair each outbound leg with every return on the reverse route
+-- within the stay bounds, cheapest first, converted to the requested
+-- currency.
+--
+SELECT
+	ROUND((outbound.cost_usd + back.cost_usd) * $9::numeric) AS total_cost,
+	origin.name,
+	origin.iata_code,
+	origin.country,
+	dest.name,
+	dest.iata_code,
+	dest.country,
+	ROUND(outbound.cost_usd * $9::numeric),
+	outbound.start_time,
+	outbound.offer_id,
+	outbound.source,
+	outbound.end_time,
+	outbound.created_at,
+	outbound.expires_at,
+	ROUND(back.cost_usd * $9::numeric),
+	back.start_time,
+	back.offer_id,
+	back.source,
+	back.end_time,
+	back.created_at,
+	back.expires_at
+FROM outbound
+     JOIN live_offers AS back
+          ON back.origin_id = outbound.dest_id
+         AND back.dest_id = outbound.origin_id
+         AND back.start_time BETWEEN outbound.start_time + $5::int
+                                 AND outbound.start_time + $6::int
+     JOIN places AS origin
+          ON origin.place_id = outbound.origin_id
+     JOIN places AS dest
+          ON dest.place_id = outbound.dest_id
+ORDER BY outbound.cost_usd + back.cost_usd ASC,
+         outbound.start_time ASC,
+         back.start_time ASC,
+         outbound.offer_id,
+         back.offer_id
+LIMIT $7
+OFFSET $8;
+`
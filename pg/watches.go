@@ -0,0 +1,312 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/maxhawkins/transitdb"
+)
+
+func (s *Store) CreateWatch(ctx context.Context, w transitdb.Watch) (transitdb.Watch, error) {
+	if w.Currency == "" {
+		w.Currency = transitdb.BaseCurrency
+	}
+
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO watches
+		(origins, destinations, origin_countries, dest_countries,
+		 start_date, end_date, max_cost, currency, webhook_url)
+		VALUES
+		(COALESCE($1, '{}'), COALESCE($2, '{}'), COALESCE($3, '{}'), COALESCE($4, '{}'),
+		 $5, $6, $7, $8, $9)
+		RETURNING watch_id, created_at`,
+		pq.Array(w.Origins),
+		pq.Array(w.Destinations),
+		pq.Array(w.OriginCountries),
+		pq.Array(w.DestCountries),
+		time.Time(w.StartDate),
+		time.Time(w.EndDate),
+		w.MaxCost,
+		w.Currency,
+		w.WebhookURL).Scan(&w.ID, &w.CreatedAt)
+	if err != nil {
+		return w, err
+	}
+
+	return w, nil
+}
+
+func (s *Store) ListWatches(ctx context.Context) ([]transitdb.Watch, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT watch_id,
+		       origins,
+		       destinations,
+		       origin_countries,
+		       dest_countries,
+		       start_date,
+		       end_date,
+		       max_cost,
+		       currency,
+		       webhook_url,
+		       created_at
+		FROM watches
+		ORDER BY watch_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []transitdb.Watch
+	for rows.Next() {
+		var (
+			res                transitdb.Watch
+			startDate, endDate time.Time
+		)
+
+		err = rows.Scan(
+			&res.ID,
+			pq.Array(&res.Origins),
+			pq.Array(&res.Destinations),
+			pq.Array(&res.OriginCountries),
+			pq.Array(&res.DestCountries),
+			&startDate,
+			&endDate,
+			&res.MaxCost,
+			&res.Currency,
+			&res.WebhookURL,
+			&res.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		res.StartDate = transitdb.Date(startDate)
+		res.EndDate = transitdb.Date(endDate)
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DeleteWatch deletes a watch and its deliveries. It returns
+// transitdb.ErrNotFound if there's no such watch.
+func (s *Store) DeleteWatch(ctx context.Context, id int) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM watches WHERE watch_id = $1`, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) ListDeliveries(ctx context.Context, q transitdb.ListDeliveriesRequest) ([]transitdb.Delivery, error) {
+	if err := transitdb.CheckPage(q.Limit, q.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+deliveryColumns+`
+		FROM watch_deliveries AS d
+		     JOIN watches USING (watch_id)
+		WHERE ($1 = 0 OR d.watch_id = $1)
+		  AND ($2 = '' OR d.status = $2)
+		ORDER BY d.created_at DESC, d.delivery_id DESC
+		LIMIT $3
+		OFFSET $4`,
+		q.WatchID,
+		string(q.Status),
+		q.Limit,
+		q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDeliveries(rows)
+}
+
+func (s *Store) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]transitdb.Delivery, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		UPDATE watch_deliveries AS d
+		   SET next_attempt_at = NOW() + $2::float8 * INTERVAL '1 second'
+		  FROM watches
+		 WHERE watches.watch_id = d.watch_id
+		   AND d.delivery_id IN (
+		           SELECT delivery_id
+		             FROM watch_deliveries
+		            WHERE status = 'pending'
+		              AND next_attempt_at <= NOW()
+		         ORDER BY next_attempt_at, delivery_id
+		            LIMIT $1
+		              FOR UPDATE SKIP LOCKED)
+		RETURNING `+deliveryColumns,
+		limit,
+		lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDeliveries(rows)
+}
+
+func (s *Store) UpdateDelivery(ctx context.Context, d transitdb.Delivery) error {
+	deliveredAt := pq.NullTime{Time: d.DeliveredAt, Valid: !d.DeliveredAt.IsZero()}
+
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE watch_deliveries
+		   SET status = $2,
+		       attempts = $3,
+		       next_attempt_at = $4,
+		       last_error = $5,
+		       delivered_at = $6
+		 WHERE delivery_id = $1`,
+		d.ID,
+		string(d.Status),
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastError,
+		deliveredAt)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return transitdb.ErrNotFound
+	}
+	return nil
+}
+
+// deliveryColumns are read by scanDeliveries. The query must alias
+// watch_deliveries as d and join watches.
+const deliveryColumns = `
+	d.delivery_id,
+	d.watch_id,
+	watches.webhook_url,
+	d.offer_id,
+	d.origin_iata,
+	d.dest_iata,
+	d.cost,
+	d.currency,
+	d.source,
+	d.start_time,
+	d.end_time,
+	d.offered_at,
+	d.expires_at,
+	d.status,
+	d.attempts,
+	d.next_attempt_at,
+	d.last_error,
+	d.created_at,
+	d.delivered_at`
+
+func scanDeliveries(rows *sql.Rows) ([]transitdb.Delivery, error) {
+	var results []transitdb.Delivery
+	for rows.Next() {
+		var (
+			res                             transitdb.Delivery
+			status                          string
+			startTime                       time.Time
+			endTime, expiresAt, deliveredAt pq.NullTime
+		)
+
+		err := rows.Scan(
+			&res.ID,
+			&res.WatchID,
+			&res.WebhookURL,
+			&res.Offer.ID,
+			&res.Offer.OriginAirport,
+			&res.Offer.DestinationAirport,
+			&res.Offer.Cost,
+			&res.Offer.Currency,
+			&res.Offer.Source,
+			&startTime,
+			&endTime,
+			&res.Offer.OfferedAt,
+			&expiresAt,
+			&status,
+			&res.Attempts,
+			&res.NextAttemptAt,
+			&res.LastError,
+			&res.CreatedAt,
+			&deliveredAt)
+		if err != nil {
+			return nil, err
+		}
+		res.Status = transitdb.DeliveryStatus(status)
+		res.Offer.AvailableFrom = transitdb.Date(startTime)
+		if endTime.Valid {
+			res.Offer.AvailableTo = transitdb.Date(endTime.Time)
+		}
+		if expiresAt.Valid {
+			res.Offer.ExpiresAt = expiresAt.Time
+		}
+		if deliveredAt.Valid {
+			res.DeliveredAt = deliveredAt.Time
+		}
+
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// matchWatchesSQL queues a delivery for every watch matched by the
+// staged offers whose sequence numbers are in $1, saved under the
+// offer IDs at the same positions in $2. Only live offers
+// match, and costs are compared after converting to the watch's
+// currency, so offers in currencies without a rate never match.
+const matchWatchesSQL = `
+INSERT INTO watch_deliveries
+(watch_id, offer_id, origin_iata, dest_iata, cost, currency, source,
+ start_time, end_time, offered_at, expires_at)
+SELECT watches.watch_id,
+       changed.offer_id,
+       staged.origin_iata,
+       staged.dest_iata,
+       staged.cost,
+       staged.currency,
+       staged.source,
+       staged.start_time,
+       staged.end_time,
+       staged.created_at,
+       staged.expires_at
+FROM unnest($1::int[], $2::int[]) AS changed (seq, offer_id)
+     JOIN offers_staging AS staged
+          ON staged.seq = changed.seq
+     JOIN places AS origin
+          ON origin.iata_code = staged.origin_iata
+     JOIN places AS dest
+          ON dest.iata_code = staged.dest_iata
+     JOIN exchange_rates AS offer_rate
+          ON offer_rate.currency = staged.currency
+     JOIN watches
+          ON staged.start_time BETWEEN watches.start_date AND watches.end_date
+     JOIN exchange_rates AS watch_rate
+          ON watch_rate.currency = watches.currency
+WHERE staged.expires_at > NOW()
+  AND (watches.origins = '{}' OR staged.origin_iata = ANY(expand_place_codes(watches.origins)))
+  AND (watches.destinations = '{}' OR staged.dest_iata = ANY(expand_place_codes(watches.destinations)))
+  AND (watches.origin_countries = '{}' OR origin.country = ANY(watches.origin_countries))
+  AND (watches.dest_countries = '{}' OR dest.country = ANY(watches.dest_countries))
+  AND staged.cost / offer_rate.per_usd * watch_rate.per_usd <= watches.max_cost
+ORDER BY staged.seq, watches.watch_id
+`
@@ -0,0 +1,90 @@
+package transitdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// A PlaceGroup lets one code stand for several airports wherever
+// offers are filtered by origin or destination. Metro groups use IATA
+// metropolitan area codes, such as NYC for JFK, LGA and EWR. Other
+// groups are named by users and have lower-case codes, so they never
+// clash with an airport.
+//
+// Filters match a group's members as well as any airport with the same
+// code, so SHA matches both Shanghai airports. Results always name the
+// airport an offer is for.
+type PlaceGroup struct {
+	Code    string   `json:"code"`
+	Name    string   `json:"name"`
+	Metro   bool     `json:"metro"`
+	Members []string `json:"members"` // IATA codes
+}
+
+// maxGroupCode is the longest code a user-defined group may have.
+const maxGroupCode = 32
+
+func (g *PlaceGroup) Validate() error {
+	if g.Metro {
+		if !validCode(g.Code, 3) {
+			return fmt.Errorf("invalid metro code %q", g.Code)
+		}
+	} else if !validGroupCode(g.Code) {
+		return fmt.Errorf("invalid code %q: must be lower-case letters, digits and dashes", g.Code)
+	}
+	if g.Name == "" {
+		return errors.New("missing name")
+	}
+	if len(g.Name) > maxPlaceName {
+		return errors.New("invalid name")
+	}
+	if len(g.Members) == 0 {
+		return errors.New("missing members")
+	}
+	seen := make(map[string]bool)
+	for _, code := range g.Members {
+		if !validCode(code, 3) {
+			return fmt.Errorf("invalid member %q", code)
+		}
+		if seen[code] {
+			return fmt.Errorf("duplicate member %q", code)
+		}
+		seen[code] = true
+	}
+	return nil
+}
+
+// validGroupCode reports whether code can name a user-defined group.
+func validGroupCode(code string) bool {
+	if code == "" || len(code) > maxGroupCode {
+		return false
+	}
+	for i, c := range code {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ExpandPlaceCodes returns codes followed by the members of any groups
+// among them. Stores expand groups in their own queries; this is for
+// filtering offers outside a store. An empty list stays empty.
+func ExpandPlaceCodes(ctx context.Context, s Store, codes []string) ([]string, error) {
+	expanded := append([]string(nil), codes...)
+	for _, code := range codes {
+		g, err := s.GetPlaceGroup(ctx, code)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, g.Members...)
+	}
+	return expanded, nil
+}
@@ -0,0 +1,88 @@
+package transitdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// A Place is an airport or station that offers can start or end at.
+// Inactive places keep their offers but can't be named by new ones.
+type Place struct {
+	ID        int     `json:"id"`
+	IATACode  string  `json:"iataCode,omitempty"` // empty for places without one
+	Name      string  `json:"name"`
+	Country   string  `json:"country"` // ISO 3166-1 alpha-2
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Active    bool    `json:"active"`
+}
+
+// maxPlaceName is the longest name a place may have.
+const maxPlaceName = 100
+
+func (p *Place) Validate() error {
+	if p.Name == "" {
+		return errors.New("missing name")
+	}
+	if len(p.Name) > maxPlaceName {
+		return errors.New("invalid name")
+	}
+	if !validCode(p.Country, 2) {
+		return fmt.Errorf("invalid country %q", p.Country)
+	}
+	if p.IATACode != "" && !validCode(p.IATACode, 3) {
+		return fmt.Errorf("invalid iataCode %q", p.IATACode)
+	}
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	return nil
+}
+
+// validCode reports whether code is n upper-case letters.
+func validCode(code string, n int) bool {
+	if len(code) != n {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ListPlacesRequest pages through places in IATA code order. Empty
+// filters match anything.
+type ListPlacesRequest struct {
+	Country         string `json:"country"`
+	Query           string `json:"query"` // matches part of the name or IATA code
+	IncludeInactive bool   `json:"includeInactive"`
+	Limit           int    `json:"limit"`
+	Offset          int    `json:"offset"`
+}
+
+func (l *ListPlacesRequest) FromHTTP(r *http.Request) error {
+	includeInactive, _ := strconv.ParseBool(r.FormValue("include_inactive"))
+
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	if limit == 0 {
+		limit = 100
+	}
+
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	l.Country = strings.ToUpper(r.FormValue("country"))
+	l.Query = r.FormValue("q")
+	l.IncludeInactive = includeInactive
+	l.Limit = limit
+	l.Offset = offset
+
+	return nil
+}
@@ -1,5 +1,5 @@
 INSERT INTO places
-	(iata_code, country, latitude, longitude, name)
+	(iata_code, country, longitude, latitude, name)
 VALUES
 
 	('AAA', 'PF', -145.5099945, -17.3526001, 'Anaa Airport'),
@@ -36,6 +36,7 @@ type Offer struct {
 	StartTime   *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
 	EndTime     *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
 	CreatedAt   *google_protobuf.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	Currency    string                     `protobuf:"bytes,7,opt,name=currency" json:"currency,omitempty"`
 }
 
 func (m *Offer) Reset()                    { *m = Offer{} }
@@ -71,18 +72,19 @@ func init() {
 func init() { proto.RegisterFile("transitdb.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x8e, 0xb1, 0x6a, 0xc4, 0x30,
-	0x10, 0x44, 0xd1, 0xe5, 0xec, 0xe4, 0xf6, 0x8a, 0x80, 0x8a, 0x20, 0xae, 0x89, 0x48, 0xe5, 0x4a,
-	0x07, 0x09, 0x29, 0xae, 0xcc, 0x2f, 0x98, 0xf4, 0x46, 0xb6, 0xd6, 0x46, 0x10, 0x6b, 0x8d, 0xb4,
-	0xf9, 0x81, 0x7c, 0x79, 0xb0, 0x6c, 0xc3, 0x75, 0xee, 0x76, 0x76, 0x66, 0x98, 0x07, 0xcf, 0x1c,
-	0x6d, 0x48, 0x9e, 0x5d, 0x6b, 0xa6, 0x48, 0x4c, 0x97, 0xd7, 0x81, 0x68, 0xf8, 0xc1, 0x6b, 0x56,
-	0xed, 0x6f, 0x7f, 0x65, 0x3f, 0x62, 0x62, 0x3b, 0x4e, 0x4b, 0xe0, 0xed, 0xef, 0x00, 0x05, 0xf5,
-	0x3d, 0x46, 0xf9, 0x02, 0x25, 0x45, 0x3f, 0xf8, 0xa0, 0x84, 0x16, 0xd5, 0xa9, 0x5e, 0x95, 0xd4,
-	0x70, 0x76, 0x98, 0xd8, 0x07, 0xcb, 0x9e, 0x82, 0x3a, 0x64, 0xf3, 0xfe, 0x25, 0x25, 0x1c, 0x3b,
-	0x4a, 0xac, 0x1e, 0xb4, 0xa8, 0x8a, 0x3a, 0xdf, 0xf2, 0x06, 0x90, 0xd8, 0x46, 0x6e, 0xe6, 0x41,
-	0x75, 0xd4, 0xa2, 0x3a, 0xbf, 0x5f, 0xcc, 0x42, 0x63, 0x36, 0x1a, 0xf3, 0xbd, 0xd1, 0xd4, 0xa7,
-	0x9c, 0x9e, 0xb5, 0xfc, 0x84, 0x27, 0x0c, 0x6e, 0x29, 0x16, 0xbb, 0xc5, 0x47, 0x0c, 0x2e, 0xd7,
-	0x6e, 0x00, 0x5d, 0x44, 0xcb, 0xe8, 0x1a, 0xcb, 0xaa, 0xdc, 0x5f, 0x5c, 0xd3, 0x5f, 0xdc, 0x96,
-	0xd9, 0xfe, 0xf8, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xd1, 0x5f, 0xd1, 0x3f, 0x01, 0x00, 0x00,
+	// 214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x85, 0x8f, 0xb1, 0x0e, 0x82, 0x30,
+	0x18, 0x84, 0x03, 0x02, 0xe2, 0xef, 0x60, 0xd2, 0xc1, 0x34, 0x2c, 0x1a, 0x27, 0x27, 0x4c, 0x34,
+	0x0e, 0x8e, 0xbe, 0x02, 0x71, 0x27, 0xa5, 0x2d, 0xa4, 0x89, 0xb4, 0xa4, 0xfc, 0x0e, 0xbe, 0x8c,
+	0xcf, 0x6a, 0x29, 0x68, 0xdc, 0xdc, 0x7a, 0xbd, 0xfb, 0x72, 0xf7, 0xc3, 0x0a, 0x2d, 0xd3, 0xbd,
+	0x42, 0x51, 0xe5, 0x9d, 0x35, 0x68, 0xb2, 0x4d, 0x63, 0x4c, 0x73, 0x97, 0x07, 0xaf, 0xaa, 0x47,
+	0x7d, 0x40, 0xd5, 0xca, 0x1e, 0x59, 0xdb, 0x8d, 0x81, 0xdd, 0x2b, 0x84, 0xd8, 0xd4, 0xb5, 0xb4,
+	0x64, 0x0d, 0x89, 0xb1, 0xaa, 0x51, 0x9a, 0x06, 0xdb, 0x60, 0xbf, 0x28, 0x26, 0x45, 0xb6, 0xb0,
+	0x14, 0x0e, 0x51, 0x9a, 0xa1, 0x32, 0x9a, 0x86, 0xde, 0xfc, 0xfd, 0x22, 0x04, 0x22, 0x6e, 0x7a,
+	0xa4, 0x33, 0x67, 0xc5, 0x85, 0x7f, 0x93, 0x0b, 0x80, 0xab, 0xb1, 0x58, 0x0e, 0x85, 0x34, 0x72,
+	0xce, 0xf2, 0x98, 0xe5, 0xe3, 0x9a, 0xfc, 0xb3, 0x26, 0xbf, 0x7d, 0xd6, 0x14, 0x0b, 0x9f, 0x1e,
+	0x34, 0x39, 0x43, 0x2a, 0xb5, 0x18, 0xc1, 0xf8, 0x2f, 0x38, 0x77, 0x59, 0x8f, 0xb9, 0x46, 0x6e,
+	0x25, 0x43, 0x29, 0x4a, 0x86, 0x34, 0xf9, 0xdf, 0x38, 0xa5, 0xaf, 0x48, 0x32, 0x48, 0xf9, 0xc3,
+	0x5a, 0xa9, 0xf9, 0x93, 0xce, 0xfd, 0x7d, 0x5f, 0x5d, 0x25, 0x1e, 0x3d, 0xbd, 0x01, 0x60, 0xd5,
+	0xe1, 0x52, 0x5b, 0x01, 0x00, 0x00,
 }
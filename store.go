@@ -5,9 +5,101 @@ import (
 	"time"
 )
 
+// Store methods return ErrNotFound for missing records,
+// *ErrUnknownAirport for offers naming airports that aren't in places,
+// and *ValidationError for malformed requests.
 type Store interface {
+	// AirportIDByIATA returns the ID of the active place with the given
+	// IATA code.
 	AirportIDByIATA(ctx context.Context, iata string) (int, error)
-	SaveOffer(context.Context, Offer) error
-	CheapestPerRoute(ctx context.Context, start, end time.Time) ([]Quote, error)
+	ListPlaces(context.Context, ListPlacesRequest) ([]Place, error)
+	GetPlace(ctx context.Context, id int) (Place, error)
+	// CreatePlace adds an active place. It returns ErrConflict if
+	// another place has the same IATA code, as does UpdatePlace.
+	CreatePlace(context.Context, Place) (Place, error)
+	// UpdatePlace replaces a place's fields, including Active.
+	UpdatePlace(context.Context, Place) (Place, error)
+	DeactivatePlace(ctx context.Context, id int) error
+
+	// Filters on origin and destination codes match the members of
+	// place groups with those codes.
+	ListPlaceGroups(context.Context) ([]PlaceGroup, error)
+	GetPlaceGroup(ctx context.Context, code string) (PlaceGroup, error)
+	// SavePlaceGroup creates a group or replaces the one with the same
+	// code.
+	SavePlaceGroup(context.Context, PlaceGroup) (PlaceGroup, error)
+	DeletePlaceGroup(ctx context.Context, code string) error
+
+	// SaveOffer and SaveOffers return a *ValidationError if an offer's
+	// currency has no exchange rate, since its cost couldn't be
+	// compared.
+	SaveOffer(context.Context, Offer) (SaveResult, error)
+	SaveOffers(context.Context, []Offer) ([]SaveResult, error)
+	// Offers saved with a BatchID are attributed to that batch when
+	// they're inserted or change price.
+	CreateBatch(context.Context, Batch) (Batch, error)
+	// UpdateBatch saves a batch's Sources and counts.
+	UpdateBatch(context.Context, Batch) error
+	ListBatches(context.Context, ListBatchesRequest) ([]Batch, error)
+	// DeleteBatch deletes the offers belonging to a batch and marks it
+	// deleted, returning the number of offers deleted.
+	DeleteBatch(ctx context.Context, id int) (int, error)
+
+	// Begin starts a unit of work for saving several batches of offers
+	// all or nothing.
+	Begin(context.Context) (Tx, error)
+	CheapestPerRoute(context.Context, CheapestPerRouteRequest) ([]Quote, error)
 	ListQuotes(context.Context, ListQuotesRequest) ([]Quote, error)
+	ListOffers(context.Context, ListOffersRequest) ([]Offer, error)
+	GetOffer(ctx context.Context, id int) (Offer, error)
+	// DeleteOffer deletes an offer along with its price history.
+	DeleteOffer(ctx context.Context, id int) error
+	// ExpireOffers marks the matching live offers expired as of now,
+	// returning how many there were.
+	ExpireOffers(context.Context, ExpireOffersRequest) (int, error)
+	ListRoundTrips(context.Context, RoundTripRequest) ([]RoundTrip, error)
+	PriceCalendar(context.Context, PriceCalendarRequest) ([]CalendarDay, error)
+	PriceHistory(context.Context, PriceHistoryRequest) ([]PricePoint, error)
+	SaveExchangeRates(context.Context, []ExchangeRate) error
+	ListExchangeRates(context.Context) ([]ExchangeRate, error)
+
+	// CreateAPIKey stores a key, which must have its Hash set.
+	CreateAPIKey(context.Context, APIKey) (APIKey, error)
+	ListAPIKeys(context.Context) ([]APIKey, error)
+	// LookupAPIKey returns the unrevoked key with the given hash, or
+	// ErrNotFound.
+	LookupAPIKey(ctx context.Context, hash string) (APIKey, error)
+	// RevokeAPIKey returns ErrNotFound if there's no such key.
+	RevokeAPIKey(ctx context.Context, id int) error
+
+	// SubscribeOffers returns a channel of events for offers inserted
+	// or repriced from now on, including ones saved through other
+	// stores sharing the same database. The channel is closed when ctx
+	// is done. Events are dropped if the receiver falls behind.
+	SubscribeOffers(context.Context) (<-chan OfferEvent, error)
+
+	// Saving an offer that's new or has a new price queues a Delivery
+	// for each watch it matches.
+	CreateWatch(context.Context, Watch) (Watch, error)
+	ListWatches(context.Context) ([]Watch, error)
+	DeleteWatch(ctx context.Context, id int) error
+	ListDeliveries(context.Context, ListDeliveriesRequest) ([]Delivery, error)
+
+	// ClaimDeliveries returns up to limit pending deliveries that are
+	// due, and postpones their next attempt by lease so that other
+	// callers skip them.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+	// UpdateDelivery records the outcome of an attempt. Only Status,
+	// Attempts, NextAttemptAt, LastError and DeliveredAt are saved.
+	UpdateDelivery(context.Context, Delivery) error
+}
+
+// A Tx saves offers as one unit of work. Nothing it saves is visible to
+// the store until Commit succeeds. If SaveOffers fails, the Tx can only
+// be rolled back. Saving outside the Tx may block until it ends, so a Tx
+// is rolled back when the context passed to Begin is done.
+type Tx interface {
+	SaveOffers(context.Context, []Offer) ([]SaveResult, error)
+	Commit() error
+	Rollback() error
 }
@@ -0,0 +1,1413 @@
+// Package storetest checks implementations of transitdb.Store against
+// the behavior the rest of transitdb depends on.
+//
+// A backend's tests call Run with a function returning a fresh store,
+// seeded with places.sql and holding no offers:
+//
+//	func TestStore(t *testing.T) {
+//		storetest.Run(t, func() transitdb.Store { return memstore.New() })
+//	}
+package storetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maxhawkins/transitdb"
+)
+
+// Run runs the conformance suite, calling newStore once per subtest.
+func Run(t *testing.T, newStore func() transitdb.Store) {
+	tests := []struct {
+		name string
+		fn   func(*testing.T, transitdb.Store)
+	}{
+		{"AirportIDByIATA", testAirportIDByIATA},
+		{"Places", testPlaces},
+		{"PlaceGroups", testPlaceGroups},
+		{"SaveOfferUnknownAirport", testSaveOfferUnknownAirport},
+		{"SaveOffers", testSaveOffers},
+		{"SaveOffersUnknownAirport", testSaveOffersUnknownAirport},
+		{"SaveOffersUnknownCurrency", testSaveOffersUnknownCurrency},
+		{"SaveOfferUpsert", testSaveOfferUpsert},
+		{"Batches", testBatches},
+		{"TxCommit", testTxCommit},
+		{"TxRollback", testTxRollback},
+		{"TxContextDone", testTxContextDone},
+		{"ListQuotesOrigins", testListQuotesOrigins},
+		{"ListQuotesDestinations", testListQuotesDestinations},
+		{"ListQuotesNear", testListQuotesNear},
+		{"ListQuotesRegions", testListQuotesRegions},
+		{"ListQuotesDateRange", testListQuotesDateRange},
+		{"ListQuotesExpired", testListQuotesExpired},
+		{"ListQuotesCheapestEarliest", testListQuotesCheapestEarliest},
+		{"ListQuotesPagination", testListQuotesPagination},
+		{"CheapestPerRouteWindow", testCheapestPerRouteWindow},
+		{"CheapestPerRouteFilters", testCheapestPerRouteFilters},
+		{"ListOffers", testListOffers},
+		{"ListOffersFilters", testListOffersFilters},
+		{"GetDeleteOffer", testGetDeleteOffer},
+		{"ExpireOffers", testExpireOffers},
+		{"ListRoundTrips", testListRoundTrips},
+		{"ConvertedCosts", testConvertedCosts},
+		{"PriceCalendar", testPriceCalendar},
+		{"PriceHistory", testPriceHistory},
+		{"Watches", testWatches},
+		{"WatchDelivery", testWatchDelivery},
+		{"SubscribeOffers", testSubscribeOffers},
+		{"APIKeys", testAPIKeys},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStore())
+		})
+	}
+}
+
+// Place names as they appear in places.sql. ListQuotes reports names
+// rather than IATA codes.
+const (
+	nameLGB = "Long Beach /Daugherty Field/ Airport"
+	nameLAS = "McCarran International Airport"
+	nameNRT = "Narita International Airport"
+	nameJFK = "John F Kennedy International Airport"
+)
+
+func date(s string) transitdb.Date {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return transitdb.Date(t)
+}
+
+// offer returns a valid offer that expires a day from now.
+func offer(origin, dest string, cost int, day string) transitdb.Offer {
+	return transitdb.Offer{
+		OriginAirport:      origin,
+		DestinationAirport: dest,
+		Cost:               cost,
+		Source:             "storetest",
+		AvailableFrom:      date(day),
+		OfferedAt:          time.Now().Add(-time.Hour),
+		ExpiresAt:          time.Now().Add(24 * time.Hour),
+	}
+}
+
+func save(t *testing.T, s transitdb.Store, offers ...transitdb.Offer) {
+	t.Helper()
+
+	for _, o := range offers {
+		if _, err := s.SaveOffer(context.Background(), o); err != nil {
+			t.Fatalf("SaveOffer(%v): %v", o, err)
+		}
+	}
+}
+
+// summary is the part of a Quote the suite compares.
+type summary struct {
+	Cost   int
+	Origin string
+	Dest   string
+	Date   string
+}
+
+func summarize(quotes []transitdb.Quote) []summary {
+	var out []summary
+	for _, q := range quotes {
+		out = append(out, summary{
+			Cost:   q.Cost,
+			Origin: q.Origin,
+			Dest:   q.Dest,
+			Date:   time.Time(q.Date).Format("2006-01-02"),
+		})
+	}
+	return out
+}
+
+func listQuotes(t *testing.T, s transitdb.Store, req transitdb.ListQuotesRequest) []summary {
+	t.Helper()
+
+	if req.StartDate.IsZero() {
+		req.StartDate = time.Time(date("2030-01-01"))
+	}
+	if req.EndDate.IsZero() {
+		req.EndDate = time.Time(date("2030-12-31"))
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+
+	quotes, err := s.ListQuotes(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListQuotes(%+v): %v", req, err)
+	}
+	return summarize(quotes)
+}
+
+func check(t *testing.T, got, want interface{}) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func testAirportIDByIATA(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	lgb, err := s.AirportIDByIATA(ctx, "LGB")
+	if err != nil {
+		t.Fatalf("AirportIDByIATA(LGB): %v", err)
+	}
+	las, err := s.AirportIDByIATA(ctx, "LAS")
+	if err != nil {
+		t.Fatalf("AirportIDByIATA(LAS): %v", err)
+	}
+	if lgb <= 0 || las <= 0 || lgb == las {
+		t.Errorf("got IDs %d and %d, want distinct positive IDs", lgb, las)
+	}
+
+	if _, err := s.AirportIDByIATA(ctx, "XXX"); err != transitdb.ErrNotFound {
+		t.Errorf("AirportIDByIATA(XXX) = %v, want ErrNotFound", err)
+	}
+}
+
+func testPlaces(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	p, err := s.CreatePlace(ctx, transitdb.Place{
+		IATACode:  "QQQ",
+		Name:      "Test Field",
+		Country:   "US",
+		Latitude:  34,
+		Longitude: -118,
+	})
+	if err != nil {
+		t.Fatalf("CreatePlace: %v", err)
+	}
+	if !p.Active || p.ID == 0 {
+		t.Errorf("CreatePlace = %+v, want an active place with an ID", p)
+	}
+	if _, err := s.CreatePlace(ctx, transitdb.Place{IATACode: "QQQ", Name: "Again", Country: "US"}); !errors.Is(err, transitdb.ErrConflict) {
+		t.Errorf("CreatePlace with a taken IATA code = %v, want ErrConflict", err)
+	}
+
+	got, err := s.GetPlace(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("GetPlace: %v", err)
+	}
+	check(t, got, p)
+
+	// New places can be named by offers right away.
+	save(t, s, offer("QQQ", "LAS", 100, "2030-06-01"))
+
+	list, err := s.ListPlaces(ctx, transitdb.ListPlacesRequest{Query: "test field", Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPlaces: %v", err)
+	}
+	check(t, list, []transitdb.Place{p})
+
+	p.Name = "Test Airfield"
+	p.Latitude = 35
+	updated, err := s.UpdatePlace(ctx, p)
+	if err != nil {
+		t.Fatalf("UpdatePlace: %v", err)
+	}
+	check(t, updated, p)
+
+	if err := s.DeactivatePlace(ctx, p.ID); err != nil {
+		t.Fatalf("DeactivatePlace: %v", err)
+	}
+	_, err = s.SaveOffer(ctx, offer("QQQ", "LAS", 90, "2030-06-02"))
+	checkUnknownAirport(t, err, "QQQ", "origin")
+	if _, err := s.AirportIDByIATA(ctx, "QQQ"); err != transitdb.ErrNotFound {
+		t.Errorf("AirportIDByIATA of inactive place = %v, want ErrNotFound", err)
+	}
+
+	list, err = s.ListPlaces(ctx, transitdb.ListPlacesRequest{Query: "QQQ", Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPlaces: %v", err)
+	}
+	check(t, len(list), 0)
+	list, err = s.ListPlaces(ctx, transitdb.ListPlacesRequest{Query: "QQQ", IncludeInactive: true, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPlaces: %v", err)
+	}
+	if len(list) != 1 || list[0].Active {
+		t.Errorf("ListPlaces = %+v, want the inactive place", list)
+	}
+
+	if err := s.DeactivatePlace(ctx, p.ID+100000); err != transitdb.ErrNotFound {
+		t.Errorf("DeactivatePlace of missing place = %v, want ErrNotFound", err)
+	}
+}
+
+func testPlaceGroups(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	nyc, err := s.GetPlaceGroup(ctx, "NYC")
+	if err != nil {
+		t.Fatalf("GetPlaceGroup: %v", err)
+	}
+	check(t, nyc.Members, []string{"EWR", "JFK", "LGA"})
+
+	g, err := s.SavePlaceGroup(ctx, transitdb.PlaceGroup{
+		Code:    "west",
+		Name:    "West Coast",
+		Members: []string{"LGB", "LAS"},
+	})
+	if err != nil {
+		t.Fatalf("SavePlaceGroup: %v", err)
+	}
+	check(t, g.Members, []string{"LAS", "LGB"})
+
+	save(t, s,
+		offer("LGB", "JFK", 100, "2030-06-01"),
+		offer("LAS", "LGA", 90, "2030-06-01"),
+		offer("NRT", "EWR", 300, "2030-06-01"),
+		offer("LGB", "HND", 500, "2030-06-01"))
+
+	// Results name the airport, not the group.
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{
+		Origins:      []string{"west"},
+		Destinations: []string{"NYC"},
+	})
+	check(t, got, []summary{
+		{90, nameLAS, "La Guardia Airport", "2030-06-01"},
+		{100, nameLGB, nameJFK, "2030-06-01"},
+	})
+
+	quotes, err := s.CheapestPerRoute(ctx, transitdb.CheapestPerRouteRequest{
+		StartDate:    time.Time(date("2030-06-01")),
+		EndDate:      time.Time(date("2030-06-30")),
+		Destinations: []string{"TYO"},
+	})
+	if err != nil {
+		t.Fatalf("CheapestPerRoute: %v", err)
+	}
+	if len(quotes) != 1 || quotes[0].DestIATA != "HND" {
+		t.Errorf("CheapestPerRoute to TYO = %+v, want LGB-HND", quotes)
+	}
+
+	offers, err := s.ListOffers(ctx, transitdb.ListOffersRequest{Destinations: []string{"NYC"}})
+	if err != nil {
+		t.Fatalf("ListOffers: %v", err)
+	}
+	check(t, len(offers), 3)
+
+	if err := s.DeletePlaceGroup(ctx, "west"); err != nil {
+		t.Fatalf("DeletePlaceGroup: %v", err)
+	}
+	if _, err := s.GetPlaceGroup(ctx, "west"); err != transitdb.ErrNotFound {
+		t.Errorf("GetPlaceGroup of deleted group = %v, want ErrNotFound", err)
+	}
+	if err := s.DeletePlaceGroup(ctx, "west"); err != transitdb.ErrNotFound {
+		t.Errorf("DeletePlaceGroup of missing group = %v, want ErrNotFound", err)
+	}
+}
+
+func testSaveOfferUnknownAirport(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	_, err := s.SaveOffer(ctx, offer("XXX", "LAS", 100, "2030-06-01"))
+	checkUnknownAirport(t, err, "XXX", "origin")
+	_, err = s.SaveOffer(ctx, offer("LGB", "XXX", 100, "2030-06-01"))
+	checkUnknownAirport(t, err, "XXX", "destination")
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary(nil))
+}
+
+// checkUnknownAirport checks that err is an *ErrUnknownAirport for the
+// given airport.
+func checkUnknownAirport(t *testing.T, err error, code, role string) {
+	t.Helper()
+
+	var unknown *transitdb.ErrUnknownAirport
+	if !errors.As(err, &unknown) {
+		t.Errorf("got error %v, want *ErrUnknownAirport", err)
+		return
+	}
+	check(t, *unknown, transitdb.ErrUnknownAirport{Code: code, Role: role})
+}
+
+func testSaveOffers(t *testing.T, s transitdb.Store) {
+	results, err := s.SaveOffers(context.Background(), []transitdb.Offer{
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-01"),
+	})
+	if err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+	check(t, results, []transitdb.SaveResult{transitdb.OfferInserted, transitdb.OfferInserted})
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-01"},
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+}
+
+func testSaveOffersUnknownAirport(t *testing.T, s transitdb.Store) {
+	_, err := s.SaveOffers(context.Background(), []transitdb.Offer{
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "XXX", 500, "2030-06-01"),
+	})
+	checkUnknownAirport(t, err, "XXX", "destination")
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary(nil))
+}
+
+func testSaveOffersUnknownCurrency(t *testing.T, s transitdb.Store) {
+	yen := offer("LGB", "NRT", 50000, "2030-06-01")
+	yen.Currency = "JPY"
+	_, err := s.SaveOffers(context.Background(), []transitdb.Offer{
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		yen,
+	})
+	var invalid *transitdb.ValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("SaveOffers in a currency without a rate: got error %v, want *ValidationError", err)
+	}
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary(nil))
+}
+
+func testSaveOfferUpsert(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	first := offer("LGB", "LAS", 100, "2030-06-01")
+	cheaper := first
+	cheaper.Cost = 80
+	otherDay := offer("LGB", "LAS", 90, "2030-06-02")
+
+	for _, tt := range []struct {
+		offer transitdb.Offer
+		want  transitdb.SaveResult
+	}{
+		{first, transitdb.OfferInserted},
+		{first, transitdb.OfferUnchanged},
+		{cheaper, transitdb.OfferUpdated},
+		{otherDay, transitdb.OfferInserted},
+	} {
+		got, err := s.SaveOffer(ctx, tt.offer)
+		if err != nil {
+			t.Fatalf("SaveOffer(%v): %v", tt.offer, err)
+		}
+		if got != tt.want {
+			t.Errorf("SaveOffer(%v) = %v, want %v", tt.offer, got, tt.want)
+		}
+	}
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary{
+		{80, nameLGB, nameLAS, "2030-06-01"},
+	})
+}
+
+func testBatches(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	inBatch := func(b transitdb.Batch, offers ...transitdb.Offer) []transitdb.Offer {
+		for i := range offers {
+			offers[i].BatchID = b.ID
+		}
+		return offers
+	}
+
+	good, err := s.CreateBatch(ctx, transitdb.Batch{Label: "good"})
+	if err != nil {
+		t.Fatalf("CreateBatch: %v", err)
+	}
+	save(t, s, inBatch(good,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-01"))...)
+
+	good.Sources = []string{"storetest"}
+	good.Lines = 2
+	good.Inserted = 2
+	if err := s.UpdateBatch(ctx, good); err != nil {
+		t.Fatalf("UpdateBatch: %v", err)
+	}
+
+	// The bad batch reprices one offer, re-reports another unchanged
+	// and adds a third. Only the unchanged one survives deleting it.
+	bad, err := s.CreateBatch(ctx, transitdb.Batch{Label: "bad"})
+	if err != nil {
+		t.Fatalf("CreateBatch: %v", err)
+	}
+	save(t, s, inBatch(bad,
+		offer("LGB", "LAS", 1, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-01"),
+		offer("LGB", "JFK", 2, "2030-06-01"))...)
+
+	deleted, err := s.DeleteBatch(ctx, bad.ID)
+	if err != nil {
+		t.Fatalf("DeleteBatch: %v", err)
+	}
+	check(t, deleted, 2)
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary{
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+
+	if _, err := s.DeleteBatch(ctx, bad.ID+100); err != transitdb.ErrNotFound {
+		t.Errorf("DeleteBatch of missing batch = %v, want transitdb.ErrNotFound", err)
+	}
+
+	batches, err := s.ListBatches(ctx, transitdb.ListBatchesRequest{Limit: 100})
+	if err != nil {
+		t.Fatalf("ListBatches: %v", err)
+	}
+	type batch struct {
+		Label   string
+		Sources []string
+		Lines   int
+		Offers  int
+		Deleted bool
+	}
+	var got []batch
+	for _, b := range batches {
+		got = append(got, batch{b.Label, b.Sources, b.Lines, b.Offers, !b.DeletedAt.IsZero()})
+	}
+	check(t, got, []batch{
+		{"bad", []string{}, 0, 0, true},
+		{"good", []string{"storetest"}, 2, 1, false},
+	})
+}
+
+func testTxCommit(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.SaveOffers(ctx, []transitdb.Offer{offer("LGB", "LAS", 100, "2030-06-01")}); err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+	// Later batches see earlier ones.
+	results, err := tx.SaveOffers(ctx, []transitdb.Offer{
+		offer("LGB", "LAS", 90, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-01"),
+	})
+	if err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+	check(t, results, []transitdb.SaveResult{transitdb.OfferUpdated, transitdb.OfferInserted})
+
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary(nil))
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary{
+		{90, nameLGB, nameLAS, "2030-06-01"},
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+}
+
+func testTxRollback(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+
+	if _, err := tx.SaveOffers(ctx, []transitdb.Offer{offer("LGB", "LAS", 100, "2030-06-01")}); err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+	if _, err := tx.SaveOffers(ctx, []transitdb.Offer{offer("LGB", "ZZZ", 100, "2030-06-01")}); err == nil {
+		t.Fatal("SaveOffers with unknown airport succeeded")
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary(nil))
+
+	// The store is usable again once the Tx is over.
+	save(t, s, offer("LGB", "NRT", 500, "2030-06-01"))
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary{
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+}
+
+func testTxContextDone(t *testing.T, s transitdb.Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if _, err := tx.SaveOffers(ctx, []transitdb.Offer{offer("LGB", "LAS", 100, "2030-06-01")}); err != nil {
+		t.Fatalf("SaveOffers: %v", err)
+	}
+
+	// Ending the context rolls the Tx back and lets other saves in.
+	cancel()
+	save(t, s, offer("LGB", "LAS", 80, "2030-06-01"))
+	if err := tx.Commit(); err == nil {
+		t.Error("Commit succeeded after the context was canceled")
+	}
+
+	check(t, listQuotes(t, s, transitdb.ListQuotesRequest{}), []summary{
+		{80, nameLGB, nameLAS, "2030-06-01"},
+	})
+}
+
+func testListQuotesOrigins(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("JFK", "LAS", 50, "2030-06-01"),
+		offer("NRT", "LAS", 75, "2030-06-01"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{
+		Origins: []string{"LGB", "NRT"},
+	})
+	check(t, got, []summary{
+		{75, nameNRT, nameLAS, "2030-06-01"},
+		{100, nameLGB, nameLAS, "2030-06-01"},
+	})
+
+	quotes, err := s.ListQuotes(context.Background(), transitdb.ListQuotesRequest{
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-01")),
+		Origins:   []string{"NRT"},
+		Limit:     1,
+	})
+	if err != nil {
+		t.Fatalf("ListQuotes: %v", err)
+	}
+	if len(quotes) != 1 || quotes[0].OriginIATA != "NRT" || quotes[0].DestIATA != "LAS" {
+		t.Errorf("ListQuotes from NRT = %+v, want NRT-LAS with codes", quotes)
+	}
+}
+
+func testListQuotesDestinations(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "JFK", 50, "2030-06-01"),
+		offer("LGB", "NRT", 75, "2030-06-01"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{
+		Destinations: []string{"NRT"},
+	})
+	check(t, got, []summary{
+		{75, nameLGB, nameNRT, "2030-06-01"},
+	})
+}
+
+func testListQuotesNear(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "JFK", 300, "2030-06-01"),
+		offer("LAS", "JFK", 50, "2030-06-01"),
+		offer("NRT", "LGA", 75, "2030-06-01"))
+
+	// Downtown Los Angeles and Manhattan.
+	la := &transitdb.Radius{Latitude: 34.05, Longitude: -118.24, Km: 150}
+	nyc := &transitdb.Radius{Latitude: 40.71, Longitude: -74.01, Km: 50}
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{OriginNear: la})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-01"},
+		{300, nameLGB, nameJFK, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{DestNear: nyc})
+	check(t, got, []summary{
+		{50, nameLAS, nameJFK, "2030-06-01"},
+		{75, nameNRT, "La Guardia Airport", "2030-06-01"},
+		{300, nameLGB, nameJFK, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{
+		OriginNear:   la,
+		DestNear:     nyc,
+		Destinations: []string{"JFK"},
+	})
+	check(t, got, []summary{
+		{300, nameLGB, nameJFK, "2030-06-01"},
+	})
+}
+
+func testListQuotesRegions(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-01"),
+		offer("NRT", "JFK", 300, "2030-06-01"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{
+		OriginCountries: []string{"US"},
+	})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-01"},
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{
+		ExcludeDestCountries: []string{"US"},
+	})
+	check(t, got, []summary{
+		{500, nameLGB, nameNRT, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{
+		DestContinents: []string{"NA"},
+	})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-01"},
+		{300, nameNRT, nameJFK, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{
+		OriginContinents:        []string{"NA", "AS"},
+		ExcludeOriginContinents: []string{"AS"},
+		ExcludeDestCountries:    []string{"JP"},
+	})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-01"},
+	})
+}
+
+func testListQuotesDateRange(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 10, "2030-05-31"),
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 90, "2030-06-30"),
+		offer("LGB", "LAS", 10, "2030-07-01"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-30")),
+	})
+	check(t, got, []summary{
+		{90, nameLGB, nameLAS, "2030-06-30"},
+	})
+}
+
+func testListQuotesExpired(t *testing.T, s transitdb.Store) {
+	expired := offer("LGB", "LAS", 10, "2030-06-01")
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+	save(t, s,
+		expired,
+		offer("LGB", "LAS", 100, "2030-06-02"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary{
+		{100, nameLGB, nameLAS, "2030-06-02"},
+	})
+}
+
+func testListQuotesCheapestEarliest(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 50, "2030-06-20"),
+		offer("LGB", "LAS", 50, "2030-06-10"),
+		offer("LGB", "LAS", 50, "2030-06-15"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{})
+	check(t, got, []summary{
+		{50, nameLGB, nameLAS, "2030-06-10"},
+	})
+}
+
+func testListQuotesPagination(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 10, "2030-06-01"),
+		offer("LGB", "JFK", 20, "2030-06-01"),
+		offer("LGB", "NRT", 30, "2030-06-01"),
+		offer("LAS", "NRT", 40, "2030-06-01"))
+
+	got := listQuotes(t, s, transitdb.ListQuotesRequest{Limit: 2})
+	check(t, got, []summary{
+		{10, nameLGB, nameLAS, "2030-06-01"},
+		{20, nameLGB, nameJFK, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{Limit: 2, Offset: 2})
+	check(t, got, []summary{
+		{30, nameLGB, nameNRT, "2030-06-01"},
+		{40, nameLAS, nameNRT, "2030-06-01"},
+	})
+
+	got = listQuotes(t, s, transitdb.ListQuotesRequest{Limit: 2, Offset: 4})
+	check(t, got, []summary(nil))
+}
+
+func testCheapestPerRouteWindow(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 10, "2030-05-31"),
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 80, "2030-06-05"),
+		offer("LGB", "NRT", 500, "2030-06-10"),
+		offer("LGB", "JFK", 10, "2030-07-01"))
+
+	quotes, err := s.CheapestPerRoute(context.Background(), transitdb.CheapestPerRouteRequest{
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-30")),
+	})
+	if err != nil {
+		t.Fatalf("CheapestPerRoute: %v", err)
+	}
+
+	var got []string
+	for _, q := range quotes {
+		got = append(got, q.OriginIATA+"-"+q.DestIATA)
+	}
+	check(t, got, []string{"LGB-LAS", "LGB-NRT"})
+
+	if len(quotes) > 0 {
+		// The date and provenance are those of the cheapest offer,
+		// not the earliest one.
+		q := quotes[0]
+		check(t, q.Cost, 80)
+		check(t, q.Date, date("2030-06-05"))
+		if q.OfferID == 0 || q.Source == "" || q.OfferedAt.IsZero() {
+			t.Errorf("quote %+v is missing its offer's provenance", q)
+		}
+	}
+}
+
+func testConvertedCosts(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	if err := s.SaveExchangeRates(ctx, []transitdb.ExchangeRate{{Currency: "EUR", PerUSD: 0.5}}); err != nil {
+		t.Fatalf("SaveExchangeRates: %v", err)
+	}
+	euros := offer("LAS", "LGB", 40, "2030-06-05")
+	euros.Currency = "EUR"
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		euros)
+
+	quotes, err := s.CheapestPerRoute(ctx, transitdb.CheapestPerRouteRequest{
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-30")),
+		Currency:  "EUR",
+	})
+	if err != nil {
+		t.Fatalf("CheapestPerRoute: %v", err)
+	}
+	var costs []string
+	for _, q := range quotes {
+		costs = append(costs, fmt.Sprintf("%s-%s %d %s", q.OriginIATA, q.DestIATA, q.Cost, q.Currency))
+	}
+	check(t, costs, []string{"LAS-LGB 40 EUR", "LGB-LAS 50 EUR"})
+
+	trips, err := s.ListRoundTrips(ctx, transitdb.RoundTripRequest{
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-01")),
+		MaxStay:   10,
+		Limit:     100,
+		Currency:  "EUR",
+	})
+	if err != nil {
+		t.Fatalf("ListRoundTrips: %v", err)
+	}
+	costs = nil
+	for _, trip := range trips {
+		costs = append(costs, fmt.Sprintf("%d = %d + %d %s", trip.Cost, trip.Outbound.Cost, trip.Return.Cost, trip.Return.Currency))
+	}
+	check(t, costs, []string{"90 = 50 + 40 EUR"})
+
+	days, err := s.PriceCalendar(ctx, transitdb.PriceCalendarRequest{
+		StartDate:    time.Time(date("2030-06-01")),
+		EndDate:      time.Time(date("2030-06-01")),
+		Origins:      []string{"LGB"},
+		Destinations: []string{"LAS"},
+		Currency:     "EUR",
+	})
+	if err != nil {
+		t.Fatalf("PriceCalendar: %v", err)
+	}
+	if len(days) != 1 || days[0].Quote == nil || days[0].Quote.Cost != 50 || days[0].Quote.Currency != "EUR" {
+		t.Errorf("PriceCalendar in EUR = %+v, want LGB-LAS at 50 EUR", days)
+	}
+
+	_, err = s.CheapestPerRoute(ctx, transitdb.CheapestPerRouteRequest{Currency: "JPY"})
+	var invalid *transitdb.ValidationError
+	if !errors.As(err, &invalid) {
+		t.Errorf("CheapestPerRoute in JPY: got error %v, want *ValidationError", err)
+	}
+}
+
+func testCheapestPerRouteFilters(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "NRT", 500, "2030-06-02"),
+		offer("LAS", "JFK", 200, "2030-06-03"),
+		offer("JFK", "NRT", 300, "2030-06-04"))
+
+	routes := func(req transitdb.CheapestPerRouteRequest) []string {
+		t.Helper()
+
+		req.StartDate = time.Time(date("2030-06-01"))
+		req.EndDate = time.Time(date("2030-06-30"))
+		quotes, err := s.CheapestPerRoute(context.Background(), req)
+		if err != nil {
+			t.Fatalf("CheapestPerRoute: %v", err)
+		}
+		var got []string
+		for _, q := range quotes {
+			got = append(got, q.OriginIATA+"-"+q.DestIATA)
+		}
+		return got
+	}
+
+	check(t, routes(transitdb.CheapestPerRouteRequest{Origins: []string{"LGB"}}),
+		[]string{"LGB-LAS", "LGB-NRT"})
+	check(t, routes(transitdb.CheapestPerRouteRequest{Destinations: []string{"NRT"}}),
+		[]string{"JFK-NRT", "LGB-NRT"})
+	check(t, routes(transitdb.CheapestPerRouteRequest{DestCountries: []string{"JP"}}),
+		[]string{"JFK-NRT", "LGB-NRT"})
+	check(t, routes(transitdb.CheapestPerRouteRequest{OriginCountries: []string{"JP"}}),
+		[]string(nil))
+	check(t, routes(transitdb.CheapestPerRouteRequest{ExcludeDestCountries: []string{"US"}}),
+		[]string{"JFK-NRT", "LGB-NRT"})
+	check(t, routes(transitdb.CheapestPerRouteRequest{DestContinents: []string{"AS"}, ExcludeOriginCountries: []string{"US"}}),
+		[]string(nil))
+	check(t, routes(transitdb.CheapestPerRouteRequest{ExcludeOriginContinents: []string{"NA"}}),
+		[]string(nil))
+	check(t, routes(transitdb.CheapestPerRouteRequest{OriginContinents: []string{"NA"}, ExcludeDestContinents: []string{"AS"}}),
+		[]string{"LGB-LAS", "LAS-JFK"})
+	check(t, routes(transitdb.CheapestPerRouteRequest{Limit: 2, Offset: 1}),
+		[]string{"LAS-JFK", "JFK-NRT"})
+}
+
+func testPriceCalendar(t *testing.T, s transitdb.Store) {
+	expired := offer("LGB", "LAS", 10, "2030-06-02")
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 90, "2030-06-01"),
+		expired,
+		offer("LGB", "JFK", 10, "2030-06-02"),
+		offer("LGB", "LAS", 70, "2030-06-03"))
+
+	days, err := s.PriceCalendar(context.Background(), transitdb.PriceCalendarRequest{
+		StartDate:    time.Time(date("2030-06-01")),
+		EndDate:      time.Time(date("2030-06-04")),
+		Origins:      []string{"LGB"},
+		Destinations: []string{"LAS"},
+	})
+	if err != nil {
+		t.Fatalf("PriceCalendar: %v", err)
+	}
+
+	var got []string
+	for _, d := range days {
+		day := time.Time(d.Date).Format("2006-01-02")
+		if d.Quote == nil {
+			got = append(got, day+" none")
+			continue
+		}
+		got = append(got, fmt.Sprintf("%s %d", day, d.Quote.Cost))
+	}
+	check(t, got, []string{
+		"2030-06-01 90",
+		"2030-06-02 none",
+		"2030-06-03 70",
+		"2030-06-04 none",
+	})
+}
+
+func testPriceHistory(t *testing.T, s transitdb.Store) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var reports []transitdb.Offer
+	for i, cost := range []int{100, 90, 90, 120} {
+		o := offer("LGB", "LAS", cost, "2030-06-01")
+		o.OfferedAt = start.Add(time.Duration(i) * time.Hour)
+		reports = append(reports, o)
+	}
+	other := offer("LGB", "NRT", 500, "2030-06-01")
+
+	save(t, s, append(reports, other)...)
+
+	points, err := s.PriceHistory(context.Background(), transitdb.PriceHistoryRequest{
+		Origin:    "LGB",
+		Dest:      "LAS",
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-30")),
+	})
+	if err != nil {
+		t.Fatalf("PriceHistory: %v", err)
+	}
+
+	type observation struct {
+		Cost       int
+		ObservedAt time.Time
+	}
+	var got []observation
+	for _, p := range points {
+		got = append(got, observation{p.Cost, p.ObservedAt.UTC()})
+	}
+	// Repeated reports of the same price only refresh the time it was
+	// last observed.
+	check(t, got, []observation{
+		{100, start},
+		{90, start.Add(2 * time.Hour)},
+		{120, start.Add(3 * time.Hour)},
+	})
+}
+
+func testListOffers(t *testing.T, s transitdb.Store) {
+	expired := offer("LGB", "NRT", 10, "2030-06-03")
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-02"),
+		offer("LAS", "LGB", 80, "2030-06-01"),
+		offer("LGB", "JFK", 90, "2030-07-01"),
+		expired)
+
+	list := func(req transitdb.ListOffersRequest) []string {
+		t.Helper()
+
+		req.StartDate = time.Time(date("2030-06-01"))
+		req.EndDate = time.Time(date("2030-06-30"))
+
+		offers, err := s.ListOffers(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListOffers(%+v): %v", req, err)
+		}
+
+		var got []string
+		for _, o := range offers {
+			got = append(got, o.OriginAirport+"-"+o.DestinationAirport)
+		}
+		return got
+	}
+
+	check(t, list(transitdb.ListOffersRequest{}),
+		[]string{"LAS-LGB", "LGB-LAS"})
+	check(t, list(transitdb.ListOffersRequest{IncludeExpired: true}),
+		[]string{"LAS-LGB", "LGB-LAS", "LGB-NRT"})
+	check(t, list(transitdb.ListOffersRequest{IncludeExpired: true, Limit: 1, Offset: 1}),
+		[]string{"LGB-LAS"})
+	check(t, list(transitdb.ListOffersRequest{Origins: []string{"LGB"}, Destinations: []string{"LAS"}}),
+		[]string{"LGB-LAS"})
+}
+
+func testListOffersFilters(t *testing.T, s transitdb.Store) {
+	other := offer("LGB", "LAS", 90, "2030-06-01")
+	other.Source = "other"
+	old := offer("LGB", "NRT", 500, "2030-06-02")
+	old.OfferedAt = time.Now().Add(-48 * time.Hour)
+
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LAS", "LGB", 80, "2030-06-03"),
+		other,
+		old)
+
+	list := func(req transitdb.ListOffersRequest) []int {
+		t.Helper()
+
+		offers, err := s.ListOffers(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListOffers(%+v): %v", req, err)
+		}
+
+		var got []int
+		for _, o := range offers {
+			got = append(got, o.Cost)
+		}
+		return got
+	}
+
+	check(t, list(transitdb.ListOffersRequest{}),
+		[]int{100, 90, 500, 80})
+	check(t, list(transitdb.ListOffersRequest{Source: "other"}),
+		[]int{90})
+	check(t, list(transitdb.ListOffersRequest{Origins: []string{"LGB"}, Destinations: []string{"LAS"}}),
+		[]int{100, 90})
+	check(t, list(transitdb.ListOffersRequest{Origins: []string{"LGB", "LAS"}, Destinations: []string{"NRT", "LGB"}}),
+		[]int{500, 80})
+	check(t, list(transitdb.ListOffersRequest{CreatedBefore: time.Now().Add(-24 * time.Hour)}),
+		[]int{500})
+	check(t, list(transitdb.ListOffersRequest{CreatedAfter: time.Now().Add(-24 * time.Hour)}),
+		[]int{100, 90, 80})
+	check(t, list(transitdb.ListOffersRequest{StartDate: time.Time(date("2030-06-02"))}),
+		[]int{500, 80})
+}
+
+func testGetDeleteOffer(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	save(t, s, offer("LGB", "LAS", 100, "2030-06-01"))
+	save(t, s, offer("LGB", "LAS", 90, "2030-06-01"))
+
+	offers, err := s.ListOffers(ctx, transitdb.ListOffersRequest{})
+	if err != nil {
+		t.Fatalf("ListOffers: %v", err)
+	}
+	if len(offers) != 1 {
+		t.Fatalf("got %d offers, want 1", len(offers))
+	}
+	id := offers[0].ID
+
+	got, err := s.GetOffer(ctx, id)
+	if err != nil {
+		t.Fatalf("GetOffer: %v", err)
+	}
+	check(t, got.Cost, 90)
+	check(t, got.OriginAirport, "LGB")
+	check(t, got.DestinationAirport, "LAS")
+
+	if err := s.DeleteOffer(ctx, id); err != nil {
+		t.Fatalf("DeleteOffer: %v", err)
+	}
+	if _, err := s.GetOffer(ctx, id); err != transitdb.ErrNotFound {
+		t.Errorf("GetOffer of deleted offer = %v, want transitdb.ErrNotFound", err)
+	}
+	if err := s.DeleteOffer(ctx, id); err != transitdb.ErrNotFound {
+		t.Errorf("DeleteOffer of deleted offer = %v, want transitdb.ErrNotFound", err)
+	}
+
+	history, err := s.PriceHistory(ctx, transitdb.PriceHistoryRequest{
+		Origin:    "LGB",
+		Dest:      "LAS",
+		StartDate: time.Time(date("2030-06-01")),
+		EndDate:   time.Time(date("2030-06-01")),
+	})
+	if err != nil {
+		t.Fatalf("PriceHistory: %v", err)
+	}
+	check(t, len(history), 0)
+}
+
+func testExpireOffers(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	other := offer("LGB", "LAS", 90, "2030-06-02")
+	other.Source = "other"
+
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 110, "2030-06-03"),
+		offer("LGB", "NRT", 500, "2030-06-01"),
+		other)
+
+	req := transitdb.ExpireOffersRequest{Source: "storetest", Origin: "LGB", Dest: "LAS"}
+	n, err := s.ExpireOffers(ctx, req)
+	if err != nil {
+		t.Fatalf("ExpireOffers: %v", err)
+	}
+	check(t, n, 2)
+
+	// Expired offers aren't expired again.
+	n, err = s.ExpireOffers(ctx, req)
+	if err != nil {
+		t.Fatalf("ExpireOffers: %v", err)
+	}
+	check(t, n, 0)
+
+	offers, err := s.ListOffers(ctx, transitdb.ListOffersRequest{})
+	if err != nil {
+		t.Fatalf("ListOffers: %v", err)
+	}
+	var costs []int
+	for _, o := range offers {
+		costs = append(costs, o.Cost)
+	}
+	check(t, costs, []int{500, 90})
+}
+
+func testListRoundTrips(t *testing.T, s transitdb.Store) {
+	save(t, s,
+		offer("LGB", "LAS", 100, "2030-06-01"),
+		offer("LGB", "LAS", 150, "2030-06-02"),
+		offer("LAS", "LGB", 50, "2030-06-02"), // too soon
+		offer("LAS", "LGB", 80, "2030-06-05"), // 4 nights after the 1st
+		offer("LAS", "LGB", 60, "2030-06-20"), // too late
+		offer("NRT", "LGB", 10, "2030-06-05")) // wrong route
+
+	trips, err := s.ListRoundTrips(context.Background(), transitdb.RoundTripRequest{
+		StartDate:    time.Time(date("2030-06-01")),
+		EndDate:      time.Time(date("2030-06-30")),
+		Origins:      []string{"LGB"},
+		Destinations: []string{"LAS"},
+		MinStay:      3,
+		MaxStay:      10,
+		Limit:        100,
+	})
+	if err != nil {
+		t.Fatalf("ListRoundTrips: %v", err)
+	}
+
+	type pair struct {
+		Cost     int
+		Outbound summary
+		Return   summary
+	}
+	var got []pair
+	for _, trip := range trips {
+		legs := summarize([]transitdb.Quote{trip.Outbound, trip.Return})
+		got = append(got, pair{trip.Cost, legs[0], legs[1]})
+	}
+	check(t, got, []pair{
+		{180, summary{100, nameLGB, nameLAS, "2030-06-01"}, summary{80, nameLAS, nameLGB, "2030-06-05"}},
+		{230, summary{150, nameLGB, nameLAS, "2030-06-02"}, summary{80, nameLAS, nameLGB, "2030-06-05"}},
+	})
+}
+
+// watch returns a watch for fares from LGB to Japan in July and August
+// 2030 that posts to url.
+func watch(url string) transitdb.Watch {
+	return transitdb.Watch{
+		Origins:       []string{"LGB"},
+		DestCountries: []string{"JP"},
+		StartDate:     date("2030-07-01"),
+		EndDate:       date("2030-08-31"),
+		MaxCost:       500,
+		Currency:      "USD",
+		WebhookURL:    url,
+	}
+}
+
+func testWatches(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	w, err := s.CreateWatch(ctx, watch("http://localhost/hook"))
+	if err != nil {
+		t.Fatalf("CreateWatch: %v", err)
+	}
+
+	save(t, s,
+		offer("LGB", "NRT", 400, "2030-07-10"),
+		offer("LGB", "NRT", 600, "2030-07-11"), // too expensive
+		offer("LGB", "LAS", 100, "2030-07-10"), // not in Japan
+		offer("LAS", "NRT", 100, "2030-07-10"), // wrong origin
+		offer("LGB", "NRT", 300, "2030-09-01"), // too late
+	)
+	// Unchanged re-reports aren't news, but price changes are.
+	save(t, s, offer("LGB", "NRT", 400, "2030-07-10"))
+	save(t, s, offer("LGB", "NRT", 450, "2030-07-10"))
+
+	deliveries, err := s.ListDeliveries(ctx, transitdb.ListDeliveriesRequest{WatchID: w.ID, Limit: 100})
+	if err != nil {
+		t.Fatalf("ListDeliveries: %v", err)
+	}
+	var costs []int
+	for _, d := range deliveries {
+		if d.Status != transitdb.DeliveryPending {
+			t.Errorf("delivery %d has status %q, want pending", d.ID, d.Status)
+		}
+		costs = append(costs, d.Offer.Cost)
+	}
+	check(t, costs, []int{450, 400})
+	// Both deliveries are for the same stored offer.
+	if len(deliveries) == 2 && (deliveries[0].Offer.ID == 0 || deliveries[0].Offer.ID != deliveries[1].Offer.ID) {
+		t.Errorf("delivered offer IDs = %d, %d, want the same stored offer", deliveries[0].Offer.ID, deliveries[1].Offer.ID)
+	}
+
+	watches, err := s.ListWatches(ctx)
+	if err != nil {
+		t.Fatalf("ListWatches: %v", err)
+	}
+	if len(watches) != 1 || watches[0].ID != w.ID {
+		t.Errorf("ListWatches = %+v, want [%+v]", watches, w)
+	}
+
+	if err := s.DeleteWatch(ctx, w.ID); err != nil {
+		t.Fatalf("DeleteWatch: %v", err)
+	}
+	if err := s.DeleteWatch(ctx, w.ID); err != transitdb.ErrNotFound {
+		t.Errorf("DeleteWatch of deleted watch = %v, want transitdb.ErrNotFound", err)
+	}
+	watches, err = s.ListWatches(ctx)
+	if err != nil {
+		t.Fatalf("ListWatches: %v", err)
+	}
+	check(t, len(watches), 0)
+}
+
+func testWatchDelivery(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	var (
+		mu       sync.Mutex
+		received []transitdb.WatchNotification
+	)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n transitdb.WatchNotification
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			t.Errorf("bad notification: %v", err)
+		}
+		mu.Lock()
+		received = append(received, n)
+		mu.Unlock()
+	}))
+	defer ok.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "down", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	good, err := s.CreateWatch(ctx, watch(ok.URL))
+	if err != nil {
+		t.Fatalf("CreateWatch: %v", err)
+	}
+	bad, err := s.CreateWatch(ctx, watch(failing.URL))
+	if err != nil {
+		t.Fatalf("CreateWatch: %v", err)
+	}
+
+	save(t, s, offer("LGB", "NRT", 400, "2030-07-10"))
+
+	n := &transitdb.Notifier{
+		Store:       s,
+		MaxAttempts: 2,
+		// Schedule retries in the past so they're due right away.
+		Now: func() time.Time { return time.Now().Add(-time.Hour) },
+	}
+
+	delivered, err := n.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+	check(t, delivered, 1)
+
+	mu.Lock()
+	if len(received) != 1 {
+		t.Fatalf("webhook got %d notifications, want 1", len(received))
+	}
+	check(t, received[0].WatchID, good.ID)
+	check(t, received[0].Offer.Cost, 400)
+	check(t, received[0].Offer.DestinationAirport, "NRT")
+	mu.Unlock()
+
+	// The second failure uses up the failing watch's attempts.
+	if _, err := n.DeliverDue(ctx); err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+
+	dead, err := s.ListDeliveries(ctx, transitdb.ListDeliveriesRequest{
+		Status: transitdb.DeliveryDead,
+		Limit:  100,
+	})
+	if err != nil {
+		t.Fatalf("ListDeliveries: %v", err)
+	}
+	if len(dead) != 1 {
+		t.Fatalf("got %d dead deliveries, want 1", len(dead))
+	}
+	check(t, dead[0].WatchID, bad.ID)
+	check(t, dead[0].Attempts, 2)
+	if dead[0].LastError == "" {
+		t.Error("dead delivery has no error")
+	}
+
+	delivered, err = n.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+	check(t, delivered, 0)
+}
+
+func testSubscribeOffers(t *testing.T, s transitdb.Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := s.SubscribeOffers(ctx)
+	if err != nil {
+		t.Fatalf("SubscribeOffers: %v", err)
+	}
+
+	save(t, s, offer("LGB", "NRT", 400, "2030-07-10"))
+	save(t, s, offer("LGB", "NRT", 400, "2030-07-10")) // unchanged
+	save(t, s, offer("LGB", "NRT", 450, "2030-07-10"))
+
+	type event struct {
+		Cost          int
+		OriginCountry string
+		DestCountry   string
+	}
+	var got []event
+	for len(got) < 2 {
+		select {
+		case e := <-events:
+			got = append(got, event{e.Cost, e.OriginCountry, e.DestCountry})
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after %d events", len(got))
+		}
+	}
+	check(t, got, []event{
+		{400, "US", "JP"},
+		{450, "US", "JP"},
+	})
+
+	cancel()
+	for range events {
+		// Drain until the store closes the channel.
+	}
+}
+
+func testAPIKeys(t *testing.T, s transitdb.Store) {
+	ctx := context.Background()
+
+	key := transitdb.APIKey{
+		Name:    "scraper",
+		Role:    transitdb.RoleWrite,
+		Sources: []string{"kiwi"},
+	}
+	token, err := transitdb.NewAPIKey(&key)
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	key, err = s.CreateAPIKey(ctx, key)
+	if err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+
+	got, err := s.LookupAPIKey(ctx, transitdb.HashAPIToken(token))
+	if err != nil {
+		t.Fatalf("LookupAPIKey: %v", err)
+	}
+	check(t, got.ID, key.ID)
+	check(t, got.Role, transitdb.RoleWrite)
+	check(t, got.Sources, []string{"kiwi"})
+
+	if _, err := s.LookupAPIKey(ctx, transitdb.HashAPIToken("wrong")); err != transitdb.ErrNotFound {
+		t.Errorf("LookupAPIKey of unknown token = %v, want transitdb.ErrNotFound", err)
+	}
+
+	if err := s.RevokeAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("RevokeAPIKey: %v", err)
+	}
+	if _, err := s.LookupAPIKey(ctx, key.Hash); err != transitdb.ErrNotFound {
+		t.Errorf("LookupAPIKey of revoked key = %v, want transitdb.ErrNotFound", err)
+	}
+	if err := s.RevokeAPIKey(ctx, key.ID+100); err != transitdb.ErrNotFound {
+		t.Errorf("RevokeAPIKey of unknown key = %v, want transitdb.ErrNotFound", err)
+	}
+
+	keys, err := s.ListAPIKeys(ctx)
+	if err != nil {
+		t.Fatalf("ListAPIKeys: %v", err)
+	}
+	if len(keys) != 1 || keys[0].RevokedAt.IsZero() {
+		t.Errorf("ListAPIKeys = %+v, want one revoked key", keys)
+	}
+}
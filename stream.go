@@ -0,0 +1,88 @@
+package transitdb
+
+import (
+	"net/http"
+)
+
+// An OfferEvent reports an offer that was just inserted or whose price
+// changed. It carries the countries of the offer's airports so
+// subscribers can filter on them.
+type OfferEvent struct {
+	Offer
+	OriginCountry string `json:"originCountry"`
+	DestCountry   string `json:"destCountry"`
+}
+
+// StreamOffersRequest filters a stream of offer events. Empty lists
+// match anything, and places in excluded countries or continents never
+// match, as in ListQuotesRequest.
+type StreamOffersRequest struct {
+	Origins                 []string `json:"origins"`
+	Destinations            []string `json:"destinations"`
+	OriginCountries         []string `json:"originCountries"`
+	DestCountries           []string `json:"destCountries"`
+	ExcludeOriginCountries  []string `json:"excludeOriginCountries,omitempty"`
+	ExcludeDestCountries    []string `json:"excludeDestCountries,omitempty"`
+	OriginContinents        []string `json:"originContinents,omitempty"`
+	DestContinents          []string `json:"destContinents,omitempty"`
+	ExcludeOriginContinents []string `json:"excludeOriginContinents,omitempty"`
+	ExcludeDestContinents   []string `json:"excludeDestContinents,omitempty"`
+}
+
+func (s *StreamOffersRequest) FromHTTP(r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	countries, err := parseCountries(r)
+	if err != nil {
+		return err
+	}
+	continents, err := parseContinents(r)
+	if err != nil {
+		return err
+	}
+
+	s.Origins = r.Form["origin"]
+	s.Destinations = r.Form["dest"]
+	s.OriginCountries = countries["origin_country"]
+	s.DestCountries = countries["dest_country"]
+	s.ExcludeOriginCountries = countries["exclude_origin_country"]
+	s.ExcludeDestCountries = countries["exclude_dest_country"]
+	s.OriginContinents = continents["origin_continent"]
+	s.DestContinents = continents["dest_continent"]
+	s.ExcludeOriginContinents = continents["exclude_origin_continent"]
+	s.ExcludeDestContinents = continents["exclude_dest_continent"]
+
+	return nil
+}
+
+// Match reports whether e passes the request's filters.
+func (s *StreamOffersRequest) Match(e OfferEvent) bool {
+	originContinent, destContinent := Continent(e.OriginCountry), Continent(e.DestCountry)
+	return matchAny(s.Origins, e.OriginAirport) &&
+		matchAny(s.Destinations, e.DestinationAirport) &&
+		matchAny(s.OriginCountries, e.OriginCountry) &&
+		matchAny(s.DestCountries, e.DestCountry) &&
+		!contains(s.ExcludeOriginCountries, e.OriginCountry) &&
+		!contains(s.ExcludeDestCountries, e.DestCountry) &&
+		matchAny(s.OriginContinents, originContinent) &&
+		matchAny(s.DestContinents, destContinent) &&
+		!contains(s.ExcludeOriginContinents, originContinent) &&
+		!contains(s.ExcludeDestContinents, destContinent)
+}
+
+// matchAny reports whether v is in list, or list is empty.
+func matchAny(list []string, v string) bool {
+	return len(list) == 0 || contains(list, v)
+}
+
+// contains reports whether v is in list.
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
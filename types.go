@@ -2,11 +2,14 @@ package transitdb
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/mux"
 	pb "github.com/maxhawkins/transitdb/proto"
 )
 
@@ -17,6 +20,29 @@ type ListQuotesRequest struct {
 	Destinations []string  `json:"destinations"`
 	Limit        int       `json:"limit"`
 	Offset       int       `json:"offset"`
+
+	// OriginNear and DestNear, if set, limit quotes to places within
+	// a radius, in addition to any Origins and Destinations. Over HTTP
+	// they're given as origin_near=lat,lon with origin_radius_km, and
+	// dest_near=lat,lon with dest_radius_km.
+	OriginNear *Radius `json:"originNear,omitempty"`
+	DestNear   *Radius `json:"destNear,omitempty"`
+
+	// Places must be in one of the listed countries and continents,
+	// if any are listed, and in none of the excluded ones. Continents
+	// are the codes used by Continent.
+	OriginCountries         []string `json:"originCountries,omitempty"`
+	DestCountries           []string `json:"destCountries,omitempty"`
+	ExcludeOriginCountries  []string `json:"excludeOriginCountries,omitempty"`
+	ExcludeDestCountries    []string `json:"excludeDestCountries,omitempty"`
+	OriginContinents        []string `json:"originContinents,omitempty"`
+	DestContinents          []string `json:"destContinents,omitempty"`
+	ExcludeOriginContinents []string `json:"excludeOriginContinents,omitempty"`
+	ExcludeDestContinents   []string `json:"excludeDestContinents,omitempty"`
+
+	// Currency is the currency quotes are converted to. Offers are
+	// ranked by their converted costs.
+	Currency string `json:"currency"`
 }
 
 func (l *ListQuotesRequest) FromHTTP(r *http.Request) error {
@@ -31,6 +57,27 @@ func (l *ListQuotesRequest) FromHTTP(r *http.Request) error {
 	origins := r.Form["origin"]
 	dests := r.Form["dest"]
 
+	if r.Form["near"] != nil || r.Form["radius_km"] != nil {
+		return errors.New("'near' and 'radius_km' need a side: use 'origin_near' and 'origin_radius_km' or 'dest_near' and 'dest_radius_km'")
+	}
+	originNear, err := ParseRadius(r.FormValue("origin_near"), r.FormValue("origin_radius_km"))
+	if err != nil {
+		return fmt.Errorf("invalid 'origin_near': %v", err)
+	}
+	destNear, err := ParseRadius(r.FormValue("dest_near"), r.FormValue("dest_radius_km"))
+	if err != nil {
+		return fmt.Errorf("invalid 'dest_near': %v", err)
+	}
+
+	countries, err := parseCountries(r)
+	if err != nil {
+		return err
+	}
+	continents, err := parseContinents(r)
+	if err != nil {
+		return err
+	}
+
 	limit, _ := strconv.Atoi(r.FormValue("limit"))
 	if limit == 0 {
 		limit = 100
@@ -38,23 +85,485 @@ func (l *ListQuotesRequest) FromHTTP(r *http.Request) error {
 
 	offset, _ := strconv.Atoi(r.FormValue("offset"))
 
+	currency, err := parseCurrency(r)
+	if err != nil {
+		return err
+	}
+
 	l.StartDate = startDate
 	l.EndDate = endDate
 	l.Origins = origins
 	l.Destinations = dests
+	l.OriginNear = originNear
+	l.DestNear = destNear
+	l.OriginCountries = countries["origin_country"]
+	l.DestCountries = countries["dest_country"]
+	l.ExcludeOriginCountries = countries["exclude_origin_country"]
+	l.ExcludeDestCountries = countries["exclude_dest_country"]
+	l.OriginContinents = continents["origin_continent"]
+	l.DestContinents = continents["dest_continent"]
+	l.ExcludeOriginContinents = continents["exclude_origin_continent"]
+	l.ExcludeDestContinents = continents["exclude_dest_continent"]
+	l.Limit = limit
+	l.Offset = offset
+	l.Currency = currency
+
+	return nil
+}
+
+// regionParam returns the query parameter of the first radius, country
+// or continent filter set in l, or "" if none are.
+func (l *ListQuotesRequest) regionParam() string {
+	switch {
+	case l.OriginNear != nil:
+		return "origin_near"
+	case l.DestNear != nil:
+		return "dest_near"
+	case len(l.OriginCountries) > 0:
+		return "origin_country"
+	case len(l.DestCountries) > 0:
+		return "dest_country"
+	case len(l.ExcludeOriginCountries) > 0:
+		return "exclude_origin_country"
+	case len(l.ExcludeDestCountries) > 0:
+		return "exclude_dest_country"
+	case len(l.OriginContinents) > 0:
+		return "origin_continent"
+	case len(l.DestContinents) > 0:
+		return "dest_continent"
+	case len(l.ExcludeOriginContinents) > 0:
+		return "exclude_origin_continent"
+	case len(l.ExcludeDestContinents) > 0:
+		return "exclude_dest_continent"
+	}
+	return ""
+}
+
+// CheapestPerRouteRequest asks for the cheapest fare on each route with
+// offers departing between StartDate and EndDate. Empty lists match
+// anything, and places in excluded countries or continents never match.
+// A zero Limit means no limit.
+type CheapestPerRouteRequest struct {
+	StartDate               time.Time `json:"startDate"`
+	EndDate                 time.Time `json:"endDate"`
+	Origins                 []string  `json:"origins"`
+	Destinations            []string  `json:"destinations"`
+	OriginCountries         []string  `json:"originCountries"`
+	DestCountries           []string  `json:"destCountries"`
+	ExcludeOriginCountries  []string  `json:"excludeOriginCountries,omitempty"`
+	ExcludeDestCountries    []string  `json:"excludeDestCountries,omitempty"`
+	OriginContinents        []string  `json:"originContinents,omitempty"`
+	DestContinents          []string  `json:"destContinents,omitempty"`
+	ExcludeOriginContinents []string  `json:"excludeOriginContinents,omitempty"`
+	ExcludeDestContinents   []string  `json:"excludeDestContinents,omitempty"`
+	Limit                   int       `json:"limit"`
+	Offset                  int       `json:"offset"`
+
+	// Currency is the currency quotes are converted to. Routes are
+	// ranked by their converted costs.
+	Currency string `json:"currency"`
+}
+
+// cheapestPerRouteDays is the default window of a
+// CheapestPerRouteRequest, starting today.
+const cheapestPerRouteDays = 30
+
+func (c *CheapestPerRouteRequest) FromHTTP(r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	startDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if v := r.FormValue("start"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return errors.New("invalid 'start'")
+		}
+		startDate = t
+	}
+	endDate := startDate.AddDate(0, 0, cheapestPerRouteDays)
+	if v := r.FormValue("end"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return errors.New("invalid 'end'")
+		}
+		endDate = t
+	}
+
+	countries, err := parseCountries(r)
+	if err != nil {
+		return err
+	}
+	continents, err := parseContinents(r)
+	if err != nil {
+		return err
+	}
+
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		return err
+	}
+
+	c.StartDate = startDate
+	c.EndDate = endDate
+	c.Origins = r.Form["origin"]
+	c.Destinations = r.Form["dest"]
+	c.OriginCountries = countries["origin_country"]
+	c.DestCountries = countries["dest_country"]
+	c.ExcludeOriginCountries = countries["exclude_origin_country"]
+	c.ExcludeDestCountries = countries["exclude_dest_country"]
+	c.OriginContinents = continents["origin_continent"]
+	c.DestContinents = continents["dest_continent"]
+	c.ExcludeOriginContinents = continents["exclude_origin_continent"]
+	c.ExcludeDestContinents = continents["exclude_dest_continent"]
+	c.Limit = limit
+	c.Offset = offset
+	c.Currency = currency
+
+	return nil
+}
+
+// parseCurrency returns the upper-cased 'currency' form value, or
+// BaseCurrency if there isn't one.
+func parseCurrency(r *http.Request) (string, error) {
+	currency := strings.ToUpper(r.FormValue("currency"))
+	if currency == "" {
+		currency = BaseCurrency
+	}
+	if !validCurrency(currency) {
+		return "", errors.New("invalid 'currency'")
+	}
+	return currency, nil
+}
+
+// parseCountries returns the countries listed in each of the form
+// values that take them, upper-cased and keyed by name.
+func parseCountries(r *http.Request) (map[string][]string, error) {
+	return parseCodes(r, validCountry,
+		"origin_country",
+		"dest_country",
+		"exclude_origin_country",
+		"exclude_dest_country")
+}
+
+// parseContinents returns the continents listed in each of the form
+// values that take them, upper-cased and keyed by name.
+func parseContinents(r *http.Request) (map[string][]string, error) {
+	return parseCodes(r, validContinent,
+		"origin_continent",
+		"dest_continent",
+		"exclude_origin_continent",
+		"exclude_dest_continent")
+}
+
+// parseCodes returns the codes listed in the named form values,
+// upper-cased and keyed by name. It fails if valid rejects one.
+func parseCodes(r *http.Request, valid func(string) bool, names ...string) (map[string][]string, error) {
+	codes := make(map[string][]string)
+	for _, name := range names {
+		for _, v := range r.Form[name] {
+			code := strings.ToUpper(v)
+			if !valid(code) {
+				return nil, fmt.Errorf("invalid '%s'", name)
+			}
+			codes[name] = append(codes[name], code)
+		}
+	}
+	return codes, nil
+}
+
+// RoundTripRequest asks for pairs of offers from an origin to a
+// destination and back. The outbound leg departs between StartDate and
+// EndDate and the return leg departs MinStay to MaxStay nights later.
+type RoundTripRequest struct {
+	StartDate    time.Time `json:"startDate"`
+	EndDate      time.Time `json:"endDate"`
+	Origins      []string  `json:"origins"`
+	Destinations []string  `json:"destinations"`
+	MinStay      int       `json:"minStay"`
+	MaxStay      int       `json:"maxStay"`
+	Limit        int       `json:"limit"`
+	Offset       int       `json:"offset"`
+
+	// Currency is the currency both legs and the total are converted
+	// to.
+	Currency string `json:"currency"`
+}
+
+func (rt *RoundTripRequest) FromHTTP(r *http.Request) error {
+	var quotes ListQuotesRequest
+	if err := quotes.FromHTTP(r); err != nil {
+		return err
+	}
+	if name := quotes.regionParam(); name != "" {
+		return fmt.Errorf("'%s' isn't supported for round trips", name)
+	}
+
+	minStay := 0
+	if v := r.FormValue("min_stay"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return errors.New("invalid 'min_stay'")
+		}
+		minStay = n
+	}
+
+	maxStay := 30
+	if v := r.FormValue("max_stay"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < minStay {
+			return errors.New("invalid 'max_stay'")
+		}
+		maxStay = n
+	}
+
+	rt.StartDate = quotes.StartDate
+	rt.EndDate = quotes.EndDate
+	rt.Origins = quotes.Origins
+	rt.Destinations = quotes.Destinations
+	rt.MinStay = minStay
+	rt.MaxStay = maxStay
+	rt.Limit = quotes.Limit
+	rt.Offset = quotes.Offset
+	rt.Currency = quotes.Currency
+
+	return nil
+}
+
+// A RoundTrip pairs an outbound offer with a return offer on the
+// reverse route. Cost is the sum of both legs.
+type RoundTrip struct {
+	Cost     int   `json:"cost"`
+	Outbound Quote `json:"outbound"`
+	Return   Quote `json:"return"`
+}
+
+// ListOffersRequest selects stored offers by travel date, source, route
+// and when they were offered. A zero time leaves that end of its range
+// open, an empty string or list matches anything, and a zero Limit
+// means no limit.
+type ListOffersRequest struct {
+	StartDate      time.Time `json:"startDate"`
+	EndDate        time.Time `json:"endDate"`
+	Source         string    `json:"source"`
+	Origins        []string  `json:"origins"`
+	Destinations   []string  `json:"destinations"`
+	CreatedAfter   time.Time `json:"createdAfter"`
+	CreatedBefore  time.Time `json:"createdBefore"`
+	IncludeExpired bool      `json:"includeExpired"`
+	Limit          int       `json:"limit"`
+	Offset         int       `json:"offset"`
+}
+
+func (l *ListOffersRequest) FromHTTP(r *http.Request) error {
+	var startDate, endDate time.Time
+	if v := r.FormValue("start"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return errors.New("invalid 'start'")
+		}
+		startDate = t
+	}
+	if v := r.FormValue("end"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return errors.New("invalid 'end'")
+		}
+		endDate = t
+	}
+
+	var createdAfter, createdBefore time.Time
+	if v := r.FormValue("created_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return errors.New("invalid 'created_after'")
+		}
+		createdAfter = t
+	}
+	if v := r.FormValue("created_before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return errors.New("invalid 'created_before'")
+		}
+		createdBefore = t
+	}
+
+	includeExpired, _ := strconv.ParseBool(r.FormValue("include_expired"))
+
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	if limit == 0 {
+		limit = 100
+	}
+
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	l.StartDate = startDate
+	l.EndDate = endDate
+	l.Source = r.FormValue("source")
+	l.Origins = r.Form["origin"]
+	l.Destinations = r.Form["dest"]
+	l.CreatedAfter = createdAfter
+	l.CreatedBefore = createdBefore
+	l.IncludeExpired = includeExpired
 	l.Limit = limit
 	l.Offset = offset
 
 	return nil
 }
 
+// ExpireOffersRequest retracts the live offers a source made for a
+// route.
+type ExpireOffersRequest struct {
+	Source string `json:"source"`
+	Origin string `json:"origin"`
+	Dest   string `json:"dest"`
+}
+
+func (e *ExpireOffersRequest) FromHTTP(r *http.Request) error {
+	source := r.FormValue("source")
+	if source == "" {
+		return errors.New("missing 'source'")
+	}
+	origin := r.FormValue("origin")
+	if origin == "" {
+		return errors.New("missing 'origin'")
+	}
+	dest := r.FormValue("dest")
+	if dest == "" {
+		return errors.New("missing 'dest'")
+	}
+
+	e.Source = source
+	e.Origin = origin
+	e.Dest = dest
+
+	return nil
+}
+
+// ExpireOffersResult reports how many offers an ExpireOffersRequest
+// expired.
+type ExpireOffersResult struct {
+	Expired int `json:"expired"`
+}
+
+// PriceCalendarRequest asks for the cheapest fare on each day between
+// StartDate and EndDate from any of Origins to any of Destinations.
+type PriceCalendarRequest struct {
+	StartDate    time.Time `json:"startDate"`
+	EndDate      time.Time `json:"endDate"`
+	Origins      []string  `json:"origins"`
+	Destinations []string  `json:"destinations"`
+
+	// Currency is the currency fares are converted to. The cheapest
+	// fare is the same in any currency.
+	Currency string `json:"currency"`
+}
+
+// maxCalendarDays limits the size of a price calendar.
+const maxCalendarDays = 366
+
+func (p *PriceCalendarRequest) FromHTTP(r *http.Request) error {
+	startDate, err := time.Parse("2006-01-02", r.FormValue("start"))
+	if err != nil {
+		return errors.New("invalid 'start'")
+	}
+	endDate, err := time.Parse("2006-01-02", r.FormValue("end"))
+	if err != nil || endDate.Before(startDate) {
+		return errors.New("invalid 'end'")
+	}
+	if endDate.Sub(startDate) > maxCalendarDays*24*time.Hour {
+		return fmt.Errorf("calendar may span at most %d days", maxCalendarDays)
+	}
+	origins := r.Form["origin"]
+	if len(origins) == 0 {
+		return errors.New("missing 'origin'")
+	}
+	dests := r.Form["dest"]
+	if len(dests) == 0 {
+		return errors.New("missing 'dest'")
+	}
+	currency, err := parseCurrency(r)
+	if err != nil {
+		return err
+	}
+
+	p.StartDate = startDate
+	p.EndDate = endDate
+	p.Origins = origins
+	p.Destinations = dests
+	p.Currency = currency
+
+	return nil
+}
+
+// A CalendarDay holds the cheapest live fare departing on Date, or a
+// nil Quote if there's no data for that day.
+type CalendarDay struct {
+	Date  Date   `json:"date"`
+	Quote *Quote `json:"quote"`
+}
+
+type PriceHistoryRequest struct {
+	Origin    string    `json:"origin"`
+	Dest      string    `json:"dest"`
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+}
+
+func (p *PriceHistoryRequest) FromHTTP(r *http.Request) error {
+	startDate, err := time.Parse("2006-01-02", r.FormValue("start"))
+	if err != nil {
+		return errors.New("invalid 'start'")
+	}
+	endDate, err := time.Parse("2006-01-02", r.FormValue("end"))
+	if err != nil {
+		return errors.New("invalid 'end'")
+	}
+
+	vars := mux.Vars(r)
+
+	p.Origin = vars["origin"]
+	p.Dest = vars["dest"]
+	p.StartDate = startDate
+	p.EndDate = endDate
+
+	return nil
+}
+
+// A PricePoint is one observation of an offer's price. Date is the
+// travel date and ObservedAt is when the source reported the price.
+type PricePoint struct {
+	OfferID    int       `json:"offerID"`
+	Source     string    `json:"source"`
+	Cost       int       `json:"cost"`
+	Currency   string    `json:"currency"`
+	Date       Date      `json:"date"`
+	ObservedAt time.Time `json:"observedAt"`
+}
+
 type Quote struct {
-	Cost          int    `json:"cost"`
+	Cost     int    `json:"cost"`
+	Currency string `json:"currency"`
+
+	// Origin and Dest are place names, and OriginIATA and DestIATA
+	// their codes.
 	Origin        string `json:"origin"`
+	OriginIATA    string `json:"originIATA"`
 	OriginCountry string `json:"originCountry"`
 	Dest          string `json:"dest"`
+	DestIATA      string `json:"destIATA"`
 	DestCountry   string `json:"destCountry"`
 	Date          Date   `json:"date"`
+
+	// The offer the price came from.
+	OfferID   int       `json:"offerID"`
+	Source    string    `json:"source"`
+	EndDate   Date      `json:"endDate,omitempty"`
+	OfferedAt time.Time `json:"offeredAt"`
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
 }
 
 type Date time.Time
@@ -82,14 +591,104 @@ type Offer struct {
 	OriginAirport      string `json:"originAirport,omitempty"`
 	DestinationAirport string `json:"destinationAirport,omitempty"`
 
-	Cost   int    `json:"cost"`
-	Source string `json:"source"`
+	Cost     int    `json:"cost"`
+	Currency string `json:"currency,omitempty"` // BaseCurrency if empty
+	Source   string `json:"source"`
 
 	AvailableFrom Date `json:"availableFrom,omitempty"`
 	AvailableTo   Date `json:"availableTo,omitempty"`
 
 	OfferedAt time.Time `json:"offeredAt"`
 	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+
+	// BatchID is the ingestion batch that last set the offer's price.
+	// It's assigned by the server.
+	BatchID int `json:"batchID,omitempty"`
+}
+
+// SaveResult reports what saving an offer did to the store.
+type SaveResult int
+
+const (
+	// OfferInserted means no stored offer had the same key.
+	OfferInserted SaveResult = iota
+	// OfferUpdated means a stored offer with the same key had a
+	// different cost or currency. The old cost was recorded in the
+	// price history.
+	OfferUpdated
+	// OfferUnchanged means a stored offer with the same key already
+	// had this cost. Its offeredAt and expiresAt were still refreshed.
+	OfferUnchanged
+)
+
+func (r SaveResult) String() string {
+	switch r {
+	case OfferInserted:
+		return "inserted"
+	case OfferUpdated:
+		return "updated"
+	case OfferUnchanged:
+		return "unchanged"
+	}
+	return "SaveResult(" + strconv.Itoa(int(r)) + ")"
+}
+
+// OfferKey lists the offer fields that identify the same fare across
+// reports. Saving an offer whose key matches a stored offer updates the
+// stored offer in place instead of adding a new one.
+type OfferKey []string
+
+// The fields an OfferKey may contain.
+const (
+	KeySource    = "source"
+	KeyOrigin    = "origin"
+	KeyDest      = "dest"
+	KeyStartTime = "start_time"
+	KeyEndTime   = "end_time"
+)
+
+// DefaultOfferKey is the key stores use when none is configured.
+var DefaultOfferKey = OfferKey{KeySource, KeyOrigin, KeyDest, KeyStartTime, KeyEndTime}
+
+// ParseOfferKey parses a comma-separated list of key fields.
+func ParseOfferKey(s string) (OfferKey, error) {
+	var key OfferKey
+	for _, field := range strings.Split(s, ",") {
+		key = append(key, strings.TrimSpace(field))
+	}
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (k OfferKey) Validate() error {
+	if len(k) == 0 {
+		return errors.New("empty offer key")
+	}
+	seen := make(map[string]bool)
+	for _, field := range k {
+		switch field {
+		case KeySource, KeyOrigin, KeyDest, KeyStartTime, KeyEndTime:
+		default:
+			return fmt.Errorf("unknown offer key field %q", field)
+		}
+		if seen[field] {
+			return fmt.Errorf("duplicate offer key field %q", field)
+		}
+		seen[field] = true
+	}
+	return nil
+}
+
+// Has reports whether field is part of the key.
+func (k OfferKey) Has(field string) bool {
+	for _, f := range k {
+		if f == field {
+			return true
+		}
+	}
+	return false
 }
 
 func (o *Offer) ToProto() (*pb.Offer, error) {
@@ -112,6 +711,7 @@ func (o *Offer) ToProto() (*pb.Offer, error) {
 		Origin:      o.OriginAirport,
 		Destination: o.DestinationAirport,
 		Cost:        int32(o.Cost),
+		Currency:    o.Currency,
 		StartTime:   startTimePb,
 		EndTime:     endTimePb,
 		CreatedAt:   createdAtPb,
@@ -130,6 +730,9 @@ func (o *Offer) Validate() error {
 	if o.Cost <= 0 {
 		return errors.New("missing cost")
 	}
+	if o.Currency != "" && !validCurrency(o.Currency) {
+		return errors.New("invalid currency")
+	}
 	if o.Source == "" {
 		return errors.New("missing source")
 	}
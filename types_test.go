@@ -0,0 +1,111 @@
+package transitdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListQuotesRequestRegions(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    ListQuotesRequest
+		wantErr bool
+	}{
+		{
+			query: "dest_country=jp&exclude_origin_country=US&origin_continent=na",
+			want: ListQuotesRequest{
+				DestCountries:          []string{"JP"},
+				ExcludeOriginCountries: []string{"US"},
+				OriginContinents:       []string{"NA"},
+			},
+		},
+		{query: "origin_country=USA", wantErr: true},
+		{query: "dest_country=ZZ", wantErr: true},
+		{query: "exclude_dest_continent=EA", wantErr: true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/quotes?start=2030-01-01&end=2030-12-31&"+tt.query, nil)
+
+		var got ListQuotesRequest
+		err := got.FromHTTP(r)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("FromHTTP(%q) error = %v, want error %v", tt.query, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		regions := ListQuotesRequest{
+			OriginCountries:         got.OriginCountries,
+			DestCountries:           got.DestCountries,
+			ExcludeOriginCountries:  got.ExcludeOriginCountries,
+			ExcludeDestCountries:    got.ExcludeDestCountries,
+			OriginContinents:        got.OriginContinents,
+			DestContinents:          got.DestContinents,
+			ExcludeOriginContinents: got.ExcludeOriginContinents,
+			ExcludeDestContinents:   got.ExcludeDestContinents,
+		}
+		if !reflect.DeepEqual(regions, tt.want) {
+			t.Errorf("FromHTTP(%q) regions = %+v, want %+v", tt.query, regions, tt.want)
+		}
+	}
+}
+
+func TestStreamOffersRequestMatch(t *testing.T) {
+	r := httptest.NewRequest("GET", "/offers/stream?origin_continent=na&exclude_dest_country=kr&exclude_dest_continent=eu", nil)
+
+	var q StreamOffersRequest
+	if err := q.FromHTTP(r); err != nil {
+		t.Fatalf("FromHTTP: %v", err)
+	}
+
+	tests := []struct {
+		origin, dest string
+		want         bool
+	}{
+		{"US", "JP", true},
+		{"CA", "KR", false}, // excluded country
+		{"US", "FR", false}, // excluded continent
+		{"JP", "US", false}, // origin not in North America
+	}
+	for _, tt := range tests {
+		e := OfferEvent{OriginCountry: tt.origin, DestCountry: tt.dest}
+		if got := q.Match(e); got != tt.want {
+			t.Errorf("Match(%s to %s) = %v, want %v", tt.origin, tt.dest, got, tt.want)
+		}
+	}
+
+	r = httptest.NewRequest("GET", "/offers/stream?exclude_origin_continent=XX", nil)
+	if err := q.FromHTTP(r); err == nil {
+		t.Errorf("FromHTTP with an unknown continent succeeded, want an error")
+	}
+}
+
+func TestRequestsRejectUnsupportedParams(t *testing.T) {
+	const base = "?start=2030-01-01&end=2030-12-31&origin=LGB&dest=NRT&"
+	tests := []struct {
+		name  string
+		query string
+		parse func(r *http.Request) error
+	}{
+		{"quotes with a bare radius", "near=33.8,-118.2&radius_km=150", new(ListQuotesRequest).FromHTTP},
+		{"round trips near a place", "origin_near=33.8,-118.2&origin_radius_km=150", new(RoundTripRequest).FromHTTP},
+		{"round trips to a continent", "dest_continent=AS", new(RoundTripRequest).FromHTTP},
+		{"itineraries to a country", "dest_country=JP", new(ItineraryRequest).FromHTTP},
+		{"itineraries near a place", "dest_near=35.7,139.7&dest_radius_km=100", new(ItineraryRequest).FromHTTP},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/"+base+tt.query, nil)
+		if err := tt.parse(r); err == nil {
+			t.Errorf("%s: FromHTTP succeeded, want an error", tt.name)
+		}
+	}
+
+	r := httptest.NewRequest("GET", "/trips"+base, nil)
+	if err := new(RoundTripRequest).FromHTTP(r); err != nil {
+		t.Errorf("RoundTripRequest.FromHTTP without filters: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package transitdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// A Watch asks to be told about newly saved offers departing between
+// StartDate and EndDate that cost at most MaxCost in Currency. Empty
+// lists match anything, so a watch for "LGB to anywhere in Japan" sets
+// Origins and DestCountries only.
+//
+// Matching offers are posted to WebhookURL as a WatchNotification.
+type Watch struct {
+	ID              int      `json:"id"`
+	Origins         []string `json:"origins,omitempty"`
+	Destinations    []string `json:"destinations,omitempty"`
+	OriginCountries []string `json:"originCountries,omitempty"`
+	DestCountries   []string `json:"destCountries,omitempty"`
+
+	StartDate Date   `json:"startDate"`
+	EndDate   Date   `json:"endDate"`
+	MaxCost   int    `json:"maxCost"`
+	Currency  string `json:"currency"` // BaseCurrency if empty
+
+	WebhookURL string    `json:"webhookURL"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (w *Watch) Validate() error {
+	if w.WebhookURL == "" {
+		return errors.New("missing webhookURL")
+	}
+	u, err := url.Parse(w.WebhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("invalid webhookURL")
+	}
+	if time.Time(w.StartDate).IsZero() {
+		return errors.New("missing startDate")
+	}
+	if time.Time(w.EndDate).IsZero() {
+		return errors.New("missing endDate")
+	}
+	if time.Time(w.EndDate).Before(time.Time(w.StartDate)) {
+		return errors.New("endDate is before startDate")
+	}
+	if w.MaxCost <= 0 {
+		return errors.New("maxCost must be positive")
+	}
+	if w.Currency != "" && !validCurrency(w.Currency) {
+		return fmt.Errorf("invalid currency %q", w.Currency)
+	}
+	return nil
+}
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are waiting for their next attempt.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered deliveries were accepted by the webhook.
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead deliveries failed too many times and won't be
+	// retried. They're kept as a dead-letter log.
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// A Delivery is a queued notification that Offer matched a watch. The
+// offer is a snapshot taken when it was saved; it has no ID.
+type Delivery struct {
+	ID         int    `json:"id"`
+	WatchID    int    `json:"watchID"`
+	WebhookURL string `json:"webhookURL"`
+	Offer      Offer  `json:"offer"`
+
+	Status        DeliveryStatus `json:"status"`
+	Attempts      int            `json:"attempts"`
+	NextAttemptAt time.Time      `json:"nextAttemptAt"`
+	LastError     string         `json:"lastError,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	DeliveredAt   time.Time      `json:"deliveredAt,omitempty"`
+}
+
+// WatchNotification is the body posted to a watch's webhook.
+type WatchNotification struct {
+	WatchID    int   `json:"watchID"`
+	DeliveryID int   `json:"deliveryID"`
+	Offer      Offer `json:"offer"`
+}
+
+// ListDeliveriesRequest selects deliveries, newest first. Zero values
+// of WatchID and Status match any watch or status.
+type ListDeliveriesRequest struct {
+	WatchID int            `json:"watchID"`
+	Status  DeliveryStatus `json:"status"`
+	Limit   int            `json:"limit"`
+	Offset  int            `json:"offset"`
+}
+
+func (l *ListDeliveriesRequest) FromHTTP(r *http.Request) error {
+	watchID := 0
+	if v := r.FormValue("watch"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.New("invalid 'watch'")
+		}
+		watchID = n
+	}
+
+	status := DeliveryStatus(r.FormValue("status"))
+	switch status {
+	case "", DeliveryPending, DeliveryDelivered, DeliveryDead:
+	default:
+		return errors.New("invalid 'status'")
+	}
+
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	if limit == 0 {
+		limit = 100
+	}
+
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	l.WatchID = watchID
+	l.Status = status
+	l.Limit = limit
+	l.Offset = offset
+
+	return nil
+}